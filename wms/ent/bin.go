@@ -22,6 +22,12 @@ type Bin struct {
 	Code string `json:"code,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// Aisle holds the value of the "aisle" field.
+	Aisle int `json:"aisle,omitempty"`
+	// Position holds the value of the "position" field.
+	Position int `json:"position,omitempty"`
+	// Level holds the value of the "level" field.
+	Level int `json:"level,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the BinQuery when eager-loading is set.
 	Edges         BinEdges `json:"edges"`
@@ -79,7 +85,7 @@ func (*Bin) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case bin.FieldID:
+		case bin.FieldID, bin.FieldAisle, bin.FieldPosition, bin.FieldLevel:
 			values[i] = new(sql.NullInt64)
 		case bin.FieldCode, bin.FieldName:
 			values[i] = new(sql.NullString)
@@ -120,6 +126,24 @@ func (_m *Bin) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Name = value.String
 			}
+		case bin.FieldAisle:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field aisle", values[i])
+			} else if value.Valid {
+				_m.Aisle = int(value.Int64)
+			}
+		case bin.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				_m.Position = int(value.Int64)
+			}
+		case bin.FieldLevel:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field level", values[i])
+			} else if value.Valid {
+				_m.Level = int(value.Int64)
+			}
 		case bin.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field location_bins", value)
@@ -190,6 +214,15 @@ func (_m *Bin) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("aisle=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Aisle))
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Position))
+	builder.WriteString(", ")
+	builder.WriteString("level=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Level))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -16,6 +16,12 @@ const (
 	FieldCode = "code"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldAisle holds the string denoting the aisle field in the database.
+	FieldAisle = "aisle"
+	// FieldPosition holds the string denoting the position field in the database.
+	FieldPosition = "position"
+	// FieldLevel holds the string denoting the level field in the database.
+	FieldLevel = "level"
 	// EdgeLocation holds the string denoting the location edge name in mutations.
 	EdgeLocation = "location"
 	// EdgeZone holds the string denoting the zone edge name in mutations.
@@ -50,6 +56,9 @@ var Columns = []string{
 	FieldID,
 	FieldCode,
 	FieldName,
+	FieldAisle,
+	FieldPosition,
+	FieldLevel,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "bins"
@@ -83,6 +92,18 @@ func ValidColumn(column string) bool {
 var (
 	// CodeValidator is a validator for the "code" field. It is called by the builders before save.
 	CodeValidator func(string) error
+	// DefaultAisle holds the default value on creation for the "aisle" field.
+	DefaultAisle int
+	// AisleValidator is a validator for the "aisle" field. It is called by the builders before save.
+	AisleValidator func(int) error
+	// DefaultPosition holds the default value on creation for the "position" field.
+	DefaultPosition int
+	// PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	PositionValidator func(int) error
+	// DefaultLevel holds the default value on creation for the "level" field.
+	DefaultLevel int
+	// LevelValidator is a validator for the "level" field. It is called by the builders before save.
+	LevelValidator func(int) error
 )
 
 // OrderOption defines the ordering options for the Bin queries.
@@ -103,6 +124,21 @@ func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
 }
 
+// ByAisle orders the results by the aisle field.
+func ByAisle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAisle, opts...).ToFunc()
+}
+
+// ByPosition orders the results by the position field.
+func ByPosition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPosition, opts...).ToFunc()
+}
+
+// ByLevel orders the results by the level field.
+func ByLevel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLevel, opts...).ToFunc()
+}
+
 // ByLocationField orders the results by location field.
 func ByLocationField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
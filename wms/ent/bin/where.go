@@ -63,6 +63,21 @@ func Name(v string) predicate.Bin {
 	return predicate.Bin(sql.FieldEQ(FieldName, v))
 }
 
+// Aisle applies equality check predicate on the "aisle" field. It's identical to AisleEQ.
+func Aisle(v int) predicate.Bin {
+	return predicate.Bin(sql.FieldEQ(FieldAisle, v))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.Bin {
+	return predicate.Bin(sql.FieldEQ(FieldPosition, v))
+}
+
+// Level applies equality check predicate on the "level" field. It's identical to LevelEQ.
+func Level(v int) predicate.Bin {
+	return predicate.Bin(sql.FieldEQ(FieldLevel, v))
+}
+
 // CodeEQ applies the EQ predicate on the "code" field.
 func CodeEQ(v string) predicate.Bin {
 	return predicate.Bin(sql.FieldEQ(FieldCode, v))
@@ -203,6 +218,126 @@ func NameContainsFold(v string) predicate.Bin {
 	return predicate.Bin(sql.FieldContainsFold(FieldName, v))
 }
 
+// AisleEQ applies the EQ predicate on the "aisle" field.
+func AisleEQ(v int) predicate.Bin {
+	return predicate.Bin(sql.FieldEQ(FieldAisle, v))
+}
+
+// AisleNEQ applies the NEQ predicate on the "aisle" field.
+func AisleNEQ(v int) predicate.Bin {
+	return predicate.Bin(sql.FieldNEQ(FieldAisle, v))
+}
+
+// AisleIn applies the In predicate on the "aisle" field.
+func AisleIn(vs ...int) predicate.Bin {
+	return predicate.Bin(sql.FieldIn(FieldAisle, vs...))
+}
+
+// AisleNotIn applies the NotIn predicate on the "aisle" field.
+func AisleNotIn(vs ...int) predicate.Bin {
+	return predicate.Bin(sql.FieldNotIn(FieldAisle, vs...))
+}
+
+// AisleGT applies the GT predicate on the "aisle" field.
+func AisleGT(v int) predicate.Bin {
+	return predicate.Bin(sql.FieldGT(FieldAisle, v))
+}
+
+// AisleGTE applies the GTE predicate on the "aisle" field.
+func AisleGTE(v int) predicate.Bin {
+	return predicate.Bin(sql.FieldGTE(FieldAisle, v))
+}
+
+// AisleLT applies the LT predicate on the "aisle" field.
+func AisleLT(v int) predicate.Bin {
+	return predicate.Bin(sql.FieldLT(FieldAisle, v))
+}
+
+// AisleLTE applies the LTE predicate on the "aisle" field.
+func AisleLTE(v int) predicate.Bin {
+	return predicate.Bin(sql.FieldLTE(FieldAisle, v))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.Bin {
+	return predicate.Bin(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.Bin {
+	return predicate.Bin(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.Bin {
+	return predicate.Bin(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.Bin {
+	return predicate.Bin(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.Bin {
+	return predicate.Bin(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.Bin {
+	return predicate.Bin(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.Bin {
+	return predicate.Bin(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.Bin {
+	return predicate.Bin(sql.FieldLTE(FieldPosition, v))
+}
+
+// LevelEQ applies the EQ predicate on the "level" field.
+func LevelEQ(v int) predicate.Bin {
+	return predicate.Bin(sql.FieldEQ(FieldLevel, v))
+}
+
+// LevelNEQ applies the NEQ predicate on the "level" field.
+func LevelNEQ(v int) predicate.Bin {
+	return predicate.Bin(sql.FieldNEQ(FieldLevel, v))
+}
+
+// LevelIn applies the In predicate on the "level" field.
+func LevelIn(vs ...int) predicate.Bin {
+	return predicate.Bin(sql.FieldIn(FieldLevel, vs...))
+}
+
+// LevelNotIn applies the NotIn predicate on the "level" field.
+func LevelNotIn(vs ...int) predicate.Bin {
+	return predicate.Bin(sql.FieldNotIn(FieldLevel, vs...))
+}
+
+// LevelGT applies the GT predicate on the "level" field.
+func LevelGT(v int) predicate.Bin {
+	return predicate.Bin(sql.FieldGT(FieldLevel, v))
+}
+
+// LevelGTE applies the GTE predicate on the "level" field.
+func LevelGTE(v int) predicate.Bin {
+	return predicate.Bin(sql.FieldGTE(FieldLevel, v))
+}
+
+// LevelLT applies the LT predicate on the "level" field.
+func LevelLT(v int) predicate.Bin {
+	return predicate.Bin(sql.FieldLT(FieldLevel, v))
+}
+
+// LevelLTE applies the LTE predicate on the "level" field.
+func LevelLTE(v int) predicate.Bin {
+	return predicate.Bin(sql.FieldLTE(FieldLevel, v))
+}
+
 // HasLocation applies the HasEdge predicate on the "location" edge.
 func HasLocation() predicate.Bin {
 	return predicate.Bin(func(s *sql.Selector) {
@@ -42,6 +42,48 @@ func (_c *BinCreate) SetNillableName(v *string) *BinCreate {
 	return _c
 }
 
+// SetAisle sets the "aisle" field.
+func (_c *BinCreate) SetAisle(v int) *BinCreate {
+	_c.mutation.SetAisle(v)
+	return _c
+}
+
+// SetNillableAisle sets the "aisle" field if the given value is not nil.
+func (_c *BinCreate) SetNillableAisle(v *int) *BinCreate {
+	if v != nil {
+		_c.SetAisle(*v)
+	}
+	return _c
+}
+
+// SetPosition sets the "position" field.
+func (_c *BinCreate) SetPosition(v int) *BinCreate {
+	_c.mutation.SetPosition(v)
+	return _c
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_c *BinCreate) SetNillablePosition(v *int) *BinCreate {
+	if v != nil {
+		_c.SetPosition(*v)
+	}
+	return _c
+}
+
+// SetLevel sets the "level" field.
+func (_c *BinCreate) SetLevel(v int) *BinCreate {
+	_c.mutation.SetLevel(v)
+	return _c
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (_c *BinCreate) SetNillableLevel(v *int) *BinCreate {
+	if v != nil {
+		_c.SetLevel(*v)
+	}
+	return _c
+}
+
 // SetLocationID sets the "location" edge to the Location entity by ID.
 func (_c *BinCreate) SetLocationID(id int) *BinCreate {
 	_c.mutation.SetLocationID(id)
@@ -86,6 +128,7 @@ func (_c *BinCreate) Mutation() *BinMutation {
 
 // Save creates the Bin in the database.
 func (_c *BinCreate) Save(ctx context.Context) (*Bin, error) {
+	_c.defaults()
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -111,6 +154,22 @@ func (_c *BinCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_c *BinCreate) defaults() {
+	if _, ok := _c.mutation.Aisle(); !ok {
+		v := bin.DefaultAisle
+		_c.mutation.SetAisle(v)
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		v := bin.DefaultPosition
+		_c.mutation.SetPosition(v)
+	}
+	if _, ok := _c.mutation.Level(); !ok {
+		v := bin.DefaultLevel
+		_c.mutation.SetLevel(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_c *BinCreate) check() error {
 	if _, ok := _c.mutation.Code(); !ok {
@@ -121,6 +180,30 @@ func (_c *BinCreate) check() error {
 			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Bin.code": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Aisle(); !ok {
+		return &ValidationError{Name: "aisle", err: errors.New(`ent: missing required field "Bin.aisle"`)}
+	}
+	if v, ok := _c.mutation.Aisle(); ok {
+		if err := bin.AisleValidator(v); err != nil {
+			return &ValidationError{Name: "aisle", err: fmt.Errorf(`ent: validator failed for field "Bin.aisle": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "Bin.position"`)}
+	}
+	if v, ok := _c.mutation.Position(); ok {
+		if err := bin.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "Bin.position": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Level(); !ok {
+		return &ValidationError{Name: "level", err: errors.New(`ent: missing required field "Bin.level"`)}
+	}
+	if v, ok := _c.mutation.Level(); ok {
+		if err := bin.LevelValidator(v); err != nil {
+			return &ValidationError{Name: "level", err: fmt.Errorf(`ent: validator failed for field "Bin.level": %w`, err)}
+		}
+	}
 	if len(_c.mutation.LocationIDs()) == 0 {
 		return &ValidationError{Name: "location", err: errors.New(`ent: missing required edge "Bin.location"`)}
 	}
@@ -161,6 +244,18 @@ func (_c *BinCreate) createSpec() (*Bin, *sqlgraph.CreateSpec) {
 		_spec.SetField(bin.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := _c.mutation.Aisle(); ok {
+		_spec.SetField(bin.FieldAisle, field.TypeInt, value)
+		_node.Aisle = value
+	}
+	if value, ok := _c.mutation.Position(); ok {
+		_spec.SetField(bin.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if value, ok := _c.mutation.Level(); ok {
+		_spec.SetField(bin.FieldLevel, field.TypeInt, value)
+		_node.Level = value
+	}
 	if nodes := _c.mutation.LocationIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -232,6 +327,7 @@ func (_c *BinCreateBulk) Save(ctx context.Context) ([]*Bin, error) {
 	for i := range _c.builders {
 		func(i int, root context.Context) {
 			builder := _c.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*BinMutation)
 				if !ok {
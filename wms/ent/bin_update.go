@@ -64,6 +64,69 @@ func (_u *BinUpdate) ClearName() *BinUpdate {
 	return _u
 }
 
+// SetAisle sets the "aisle" field.
+func (_u *BinUpdate) SetAisle(v int) *BinUpdate {
+	_u.mutation.ResetAisle()
+	_u.mutation.SetAisle(v)
+	return _u
+}
+
+// SetNillableAisle sets the "aisle" field if the given value is not nil.
+func (_u *BinUpdate) SetNillableAisle(v *int) *BinUpdate {
+	if v != nil {
+		_u.SetAisle(*v)
+	}
+	return _u
+}
+
+// AddAisle adds value to the "aisle" field.
+func (_u *BinUpdate) AddAisle(v int) *BinUpdate {
+	_u.mutation.AddAisle(v)
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *BinUpdate) SetPosition(v int) *BinUpdate {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *BinUpdate) SetNillablePosition(v *int) *BinUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *BinUpdate) AddPosition(v int) *BinUpdate {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetLevel sets the "level" field.
+func (_u *BinUpdate) SetLevel(v int) *BinUpdate {
+	_u.mutation.ResetLevel()
+	_u.mutation.SetLevel(v)
+	return _u
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (_u *BinUpdate) SetNillableLevel(v *int) *BinUpdate {
+	if v != nil {
+		_u.SetLevel(*v)
+	}
+	return _u
+}
+
+// AddLevel adds value to the "level" field.
+func (_u *BinUpdate) AddLevel(v int) *BinUpdate {
+	_u.mutation.AddLevel(v)
+	return _u
+}
+
 // SetLocationID sets the "location" edge to the Location entity by ID.
 func (_u *BinUpdate) SetLocationID(id int) *BinUpdate {
 	_u.mutation.SetLocationID(id)
@@ -173,6 +236,21 @@ func (_u *BinUpdate) check() error {
 			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Bin.code": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Aisle(); ok {
+		if err := bin.AisleValidator(v); err != nil {
+			return &ValidationError{Name: "aisle", err: fmt.Errorf(`ent: validator failed for field "Bin.aisle": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Position(); ok {
+		if err := bin.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "Bin.position": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Level(); ok {
+		if err := bin.LevelValidator(v); err != nil {
+			return &ValidationError{Name: "level", err: fmt.Errorf(`ent: validator failed for field "Bin.level": %w`, err)}
+		}
+	}
 	if _u.mutation.LocationCleared() && len(_u.mutation.LocationIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Bin.location"`)
 	}
@@ -203,6 +281,24 @@ func (_u *BinUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.NameCleared() {
 		_spec.ClearField(bin.FieldName, field.TypeString)
 	}
+	if value, ok := _u.mutation.Aisle(); ok {
+		_spec.SetField(bin.FieldAisle, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAisle(); ok {
+		_spec.AddField(bin.FieldAisle, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(bin.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(bin.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Level(); ok {
+		_spec.SetField(bin.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLevel(); ok {
+		_spec.AddField(bin.FieldLevel, field.TypeInt, value)
+	}
 	if _u.mutation.LocationCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -360,6 +456,69 @@ func (_u *BinUpdateOne) ClearName() *BinUpdateOne {
 	return _u
 }
 
+// SetAisle sets the "aisle" field.
+func (_u *BinUpdateOne) SetAisle(v int) *BinUpdateOne {
+	_u.mutation.ResetAisle()
+	_u.mutation.SetAisle(v)
+	return _u
+}
+
+// SetNillableAisle sets the "aisle" field if the given value is not nil.
+func (_u *BinUpdateOne) SetNillableAisle(v *int) *BinUpdateOne {
+	if v != nil {
+		_u.SetAisle(*v)
+	}
+	return _u
+}
+
+// AddAisle adds value to the "aisle" field.
+func (_u *BinUpdateOne) AddAisle(v int) *BinUpdateOne {
+	_u.mutation.AddAisle(v)
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *BinUpdateOne) SetPosition(v int) *BinUpdateOne {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *BinUpdateOne) SetNillablePosition(v *int) *BinUpdateOne {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *BinUpdateOne) AddPosition(v int) *BinUpdateOne {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetLevel sets the "level" field.
+func (_u *BinUpdateOne) SetLevel(v int) *BinUpdateOne {
+	_u.mutation.ResetLevel()
+	_u.mutation.SetLevel(v)
+	return _u
+}
+
+// SetNillableLevel sets the "level" field if the given value is not nil.
+func (_u *BinUpdateOne) SetNillableLevel(v *int) *BinUpdateOne {
+	if v != nil {
+		_u.SetLevel(*v)
+	}
+	return _u
+}
+
+// AddLevel adds value to the "level" field.
+func (_u *BinUpdateOne) AddLevel(v int) *BinUpdateOne {
+	_u.mutation.AddLevel(v)
+	return _u
+}
+
 // SetLocationID sets the "location" edge to the Location entity by ID.
 func (_u *BinUpdateOne) SetLocationID(id int) *BinUpdateOne {
 	_u.mutation.SetLocationID(id)
@@ -482,6 +641,21 @@ func (_u *BinUpdateOne) check() error {
 			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Bin.code": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Aisle(); ok {
+		if err := bin.AisleValidator(v); err != nil {
+			return &ValidationError{Name: "aisle", err: fmt.Errorf(`ent: validator failed for field "Bin.aisle": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Position(); ok {
+		if err := bin.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "Bin.position": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Level(); ok {
+		if err := bin.LevelValidator(v); err != nil {
+			return &ValidationError{Name: "level", err: fmt.Errorf(`ent: validator failed for field "Bin.level": %w`, err)}
+		}
+	}
 	if _u.mutation.LocationCleared() && len(_u.mutation.LocationIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Bin.location"`)
 	}
@@ -529,6 +703,24 @@ func (_u *BinUpdateOne) sqlSave(ctx context.Context) (_node *Bin, err error) {
 	if _u.mutation.NameCleared() {
 		_spec.ClearField(bin.FieldName, field.TypeString)
 	}
+	if value, ok := _u.mutation.Aisle(); ok {
+		_spec.SetField(bin.FieldAisle, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAisle(); ok {
+		_spec.AddField(bin.FieldAisle, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(bin.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(bin.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Level(); ok {
+		_spec.SetField(bin.FieldLevel, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLevel(); ok {
+		_spec.AddField(bin.FieldLevel, field.TypeInt, value)
+	}
 	if _u.mutation.LocationCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -0,0 +1,183 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mxV03/wms/ent/bin"
+	"github.com/mxV03/wms/ent/binstock"
+	"github.com/mxV03/wms/ent/item"
+)
+
+// BinStock is the model entity for the BinStock schema.
+type BinStock struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity int `json:"quantity,omitempty"`
+	// ReceivedAt holds the value of the "received_at" field.
+	ReceivedAt time.Time `json:"received_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BinStockQuery when eager-loading is set.
+	Edges          BinStockEdges `json:"edges"`
+	bin_stock_bin  *int
+	bin_stock_item *int
+	selectValues   sql.SelectValues
+}
+
+// BinStockEdges holds the relations/edges for other nodes in the graph.
+type BinStockEdges struct {
+	// Bin holds the value of the bin edge.
+	Bin *Bin `json:"bin,omitempty"`
+	// Item holds the value of the item edge.
+	Item *Item `json:"item,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// BinOrErr returns the Bin value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BinStockEdges) BinOrErr() (*Bin, error) {
+	if e.Bin != nil {
+		return e.Bin, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: bin.Label}
+	}
+	return nil, &NotLoadedError{edge: "bin"}
+}
+
+// ItemOrErr returns the Item value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BinStockEdges) ItemOrErr() (*Item, error) {
+	if e.Item != nil {
+		return e.Item, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: item.Label}
+	}
+	return nil, &NotLoadedError{edge: "item"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BinStock) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case binstock.FieldID, binstock.FieldQuantity:
+			values[i] = new(sql.NullInt64)
+		case binstock.FieldReceivedAt:
+			values[i] = new(sql.NullTime)
+		case binstock.ForeignKeys[0]: // bin_stock_bin
+			values[i] = new(sql.NullInt64)
+		case binstock.ForeignKeys[1]: // bin_stock_item
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BinStock fields.
+func (_m *BinStock) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case binstock.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case binstock.FieldQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				_m.Quantity = int(value.Int64)
+			}
+		case binstock.FieldReceivedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field received_at", values[i])
+			} else if value.Valid {
+				_m.ReceivedAt = value.Time
+			}
+		case binstock.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field bin_stock_bin", value)
+			} else if value.Valid {
+				_m.bin_stock_bin = new(int)
+				*_m.bin_stock_bin = int(value.Int64)
+			}
+		case binstock.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field bin_stock_item", value)
+			} else if value.Valid {
+				_m.bin_stock_item = new(int)
+				*_m.bin_stock_item = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BinStock.
+// This includes values selected through modifiers, order, etc.
+func (_m *BinStock) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryBin queries the "bin" edge of the BinStock entity.
+func (_m *BinStock) QueryBin() *BinQuery {
+	return NewBinStockClient(_m.config).QueryBin(_m)
+}
+
+// QueryItem queries the "item" edge of the BinStock entity.
+func (_m *BinStock) QueryItem() *ItemQuery {
+	return NewBinStockClient(_m.config).QueryItem(_m)
+}
+
+// Update returns a builder for updating this BinStock.
+// Note that you need to call BinStock.Unwrap() before calling this method if this BinStock
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BinStock) Update() *BinStockUpdateOne {
+	return NewBinStockClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BinStock entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BinStock) Unwrap() *BinStock {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BinStock is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BinStock) String() string {
+	var builder strings.Builder
+	builder.WriteString("BinStock(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("received_at=")
+	builder.WriteString(_m.ReceivedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BinStocks is a parsable slice of BinStock.
+type BinStocks []*BinStock
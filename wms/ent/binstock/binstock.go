@@ -0,0 +1,123 @@
+// Code generated by ent, DO NOT EDIT.
+
+package binstock
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the binstock type in the database.
+	Label = "bin_stock"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldReceivedAt holds the string denoting the received_at field in the database.
+	FieldReceivedAt = "received_at"
+	// EdgeBin holds the string denoting the bin edge name in mutations.
+	EdgeBin = "bin"
+	// EdgeItem holds the string denoting the item edge name in mutations.
+	EdgeItem = "item"
+	// Table holds the table name of the binstock in the database.
+	Table = "bin_stocks"
+	// BinTable is the table that holds the bin relation/edge.
+	BinTable = "bin_stocks"
+	// BinInverseTable is the table name for the Bin entity.
+	// It exists in this package in order to avoid circular dependency with the "bin" package.
+	BinInverseTable = "bins"
+	// BinColumn is the table column denoting the bin relation/edge.
+	BinColumn = "bin_stock_bin"
+	// ItemTable is the table that holds the item relation/edge.
+	ItemTable = "bin_stocks"
+	// ItemInverseTable is the table name for the Item entity.
+	// It exists in this package in order to avoid circular dependency with the "item" package.
+	ItemInverseTable = "items"
+	// ItemColumn is the table column denoting the item relation/edge.
+	ItemColumn = "bin_stock_item"
+)
+
+// Columns holds all SQL columns for binstock fields.
+var Columns = []string{
+	FieldID,
+	FieldQuantity,
+	FieldReceivedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "bin_stocks"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"bin_stock_bin",
+	"bin_stock_item",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	QuantityValidator func(int) error
+	// DefaultReceivedAt holds the default value on creation for the "received_at" field.
+	DefaultReceivedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the BinStock queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByQuantity orders the results by the quantity field.
+func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
+}
+
+// ByReceivedAt orders the results by the received_at field.
+func ByReceivedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceivedAt, opts...).ToFunc()
+}
+
+// ByBinField orders the results by bin field.
+func ByBinField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBinStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByItemField orders the results by item field.
+func ByItemField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newItemStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newBinStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BinInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, BinTable, BinColumn),
+	)
+}
+func newItemStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ItemInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ItemTable, ItemColumn),
+	)
+}
@@ -0,0 +1,284 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/bin"
+	"github.com/mxV03/wms/ent/binstock"
+	"github.com/mxV03/wms/ent/item"
+)
+
+// BinStockCreate is the builder for creating a BinStock entity.
+type BinStockCreate struct {
+	config
+	mutation *BinStockMutation
+	hooks    []Hook
+}
+
+// SetQuantity sets the "quantity" field.
+func (_c *BinStockCreate) SetQuantity(v int) *BinStockCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (_c *BinStockCreate) SetReceivedAt(v time.Time) *BinStockCreate {
+	_c.mutation.SetReceivedAt(v)
+	return _c
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (_c *BinStockCreate) SetNillableReceivedAt(v *time.Time) *BinStockCreate {
+	if v != nil {
+		_c.SetReceivedAt(*v)
+	}
+	return _c
+}
+
+// SetBinID sets the "bin" edge to the Bin entity by ID.
+func (_c *BinStockCreate) SetBinID(id int) *BinStockCreate {
+	_c.mutation.SetBinID(id)
+	return _c
+}
+
+// SetBin sets the "bin" edge to the Bin entity.
+func (_c *BinStockCreate) SetBin(v *Bin) *BinStockCreate {
+	return _c.SetBinID(v.ID)
+}
+
+// SetItemID sets the "item" edge to the Item entity by ID.
+func (_c *BinStockCreate) SetItemID(id int) *BinStockCreate {
+	_c.mutation.SetItemID(id)
+	return _c
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_c *BinStockCreate) SetItem(v *Item) *BinStockCreate {
+	return _c.SetItemID(v.ID)
+}
+
+// Mutation returns the BinStockMutation object of the builder.
+func (_c *BinStockCreate) Mutation() *BinStockMutation {
+	return _c.mutation
+}
+
+// Save creates the BinStock in the database.
+func (_c *BinStockCreate) Save(ctx context.Context) (*BinStock, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BinStockCreate) SaveX(ctx context.Context) *BinStock {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BinStockCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BinStockCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BinStockCreate) defaults() {
+	if _, ok := _c.mutation.ReceivedAt(); !ok {
+		v := binstock.DefaultReceivedAt()
+		_c.mutation.SetReceivedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BinStockCreate) check() error {
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "BinStock.quantity"`)}
+	}
+	if v, ok := _c.mutation.Quantity(); ok {
+		if err := binstock.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "BinStock.quantity": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ReceivedAt(); !ok {
+		return &ValidationError{Name: "received_at", err: errors.New(`ent: missing required field "BinStock.received_at"`)}
+	}
+	if len(_c.mutation.BinIDs()) == 0 {
+		return &ValidationError{Name: "bin", err: errors.New(`ent: missing required edge "BinStock.bin"`)}
+	}
+	if len(_c.mutation.ItemIDs()) == 0 {
+		return &ValidationError{Name: "item", err: errors.New(`ent: missing required edge "BinStock.item"`)}
+	}
+	return nil
+}
+
+func (_c *BinStockCreate) sqlSave(ctx context.Context) (*BinStock, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BinStockCreate) createSpec() (*BinStock, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BinStock{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(binstock.Table, sqlgraph.NewFieldSpec(binstock.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(binstock.FieldQuantity, field.TypeInt, value)
+		_node.Quantity = value
+	}
+	if value, ok := _c.mutation.ReceivedAt(); ok {
+		_spec.SetField(binstock.FieldReceivedAt, field.TypeTime, value)
+		_node.ReceivedAt = value
+	}
+	if nodes := _c.mutation.BinIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   binstock.BinTable,
+			Columns: []string{binstock.BinColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bin.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.bin_stock_bin = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   binstock.ItemTable,
+			Columns: []string{binstock.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.bin_stock_item = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BinStockCreateBulk is the builder for creating many BinStock entities in bulk.
+type BinStockCreateBulk struct {
+	config
+	err      error
+	builders []*BinStockCreate
+}
+
+// Save creates the BinStock entities in the database.
+func (_c *BinStockCreateBulk) Save(ctx context.Context) ([]*BinStock, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BinStock, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BinStockMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BinStockCreateBulk) SaveX(ctx context.Context) []*BinStock {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BinStockCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BinStockCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
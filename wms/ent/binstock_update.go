@@ -0,0 +1,488 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/bin"
+	"github.com/mxV03/wms/ent/binstock"
+	"github.com/mxV03/wms/ent/item"
+	"github.com/mxV03/wms/ent/predicate"
+)
+
+// BinStockUpdate is the builder for updating BinStock entities.
+type BinStockUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BinStockMutation
+}
+
+// Where appends a list predicates to the BinStockUpdate builder.
+func (_u *BinStockUpdate) Where(ps ...predicate.BinStock) *BinStockUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *BinStockUpdate) SetQuantity(v int) *BinStockUpdate {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *BinStockUpdate) SetNillableQuantity(v *int) *BinStockUpdate {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *BinStockUpdate) AddQuantity(v int) *BinStockUpdate {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (_u *BinStockUpdate) SetReceivedAt(v time.Time) *BinStockUpdate {
+	_u.mutation.SetReceivedAt(v)
+	return _u
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (_u *BinStockUpdate) SetNillableReceivedAt(v *time.Time) *BinStockUpdate {
+	if v != nil {
+		_u.SetReceivedAt(*v)
+	}
+	return _u
+}
+
+// SetBinID sets the "bin" edge to the Bin entity by ID.
+func (_u *BinStockUpdate) SetBinID(id int) *BinStockUpdate {
+	_u.mutation.SetBinID(id)
+	return _u
+}
+
+// SetBin sets the "bin" edge to the Bin entity.
+func (_u *BinStockUpdate) SetBin(v *Bin) *BinStockUpdate {
+	return _u.SetBinID(v.ID)
+}
+
+// SetItemID sets the "item" edge to the Item entity by ID.
+func (_u *BinStockUpdate) SetItemID(id int) *BinStockUpdate {
+	_u.mutation.SetItemID(id)
+	return _u
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_u *BinStockUpdate) SetItem(v *Item) *BinStockUpdate {
+	return _u.SetItemID(v.ID)
+}
+
+// Mutation returns the BinStockMutation object of the builder.
+func (_u *BinStockUpdate) Mutation() *BinStockMutation {
+	return _u.mutation
+}
+
+// ClearBin clears the "bin" edge to the Bin entity.
+func (_u *BinStockUpdate) ClearBin() *BinStockUpdate {
+	_u.mutation.ClearBin()
+	return _u
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (_u *BinStockUpdate) ClearItem() *BinStockUpdate {
+	_u.mutation.ClearItem()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BinStockUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BinStockUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BinStockUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BinStockUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BinStockUpdate) check() error {
+	if v, ok := _u.mutation.Quantity(); ok {
+		if err := binstock.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "BinStock.quantity": %w`, err)}
+		}
+	}
+	if _u.mutation.BinCleared() && len(_u.mutation.BinIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BinStock.bin"`)
+	}
+	if _u.mutation.ItemCleared() && len(_u.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BinStock.item"`)
+	}
+	return nil
+}
+
+func (_u *BinStockUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(binstock.Table, binstock.Columns, sqlgraph.NewFieldSpec(binstock.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(binstock.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(binstock.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ReceivedAt(); ok {
+		_spec.SetField(binstock.FieldReceivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.BinCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   binstock.BinTable,
+			Columns: []string{binstock.BinColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bin.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BinIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   binstock.BinTable,
+			Columns: []string{binstock.BinColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bin.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   binstock.ItemTable,
+			Columns: []string{binstock.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   binstock.ItemTable,
+			Columns: []string{binstock.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{binstock.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BinStockUpdateOne is the builder for updating a single BinStock entity.
+type BinStockUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BinStockMutation
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *BinStockUpdateOne) SetQuantity(v int) *BinStockUpdateOne {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *BinStockUpdateOne) SetNillableQuantity(v *int) *BinStockUpdateOne {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *BinStockUpdateOne) AddQuantity(v int) *BinStockUpdateOne {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (_u *BinStockUpdateOne) SetReceivedAt(v time.Time) *BinStockUpdateOne {
+	_u.mutation.SetReceivedAt(v)
+	return _u
+}
+
+// SetNillableReceivedAt sets the "received_at" field if the given value is not nil.
+func (_u *BinStockUpdateOne) SetNillableReceivedAt(v *time.Time) *BinStockUpdateOne {
+	if v != nil {
+		_u.SetReceivedAt(*v)
+	}
+	return _u
+}
+
+// SetBinID sets the "bin" edge to the Bin entity by ID.
+func (_u *BinStockUpdateOne) SetBinID(id int) *BinStockUpdateOne {
+	_u.mutation.SetBinID(id)
+	return _u
+}
+
+// SetBin sets the "bin" edge to the Bin entity.
+func (_u *BinStockUpdateOne) SetBin(v *Bin) *BinStockUpdateOne {
+	return _u.SetBinID(v.ID)
+}
+
+// SetItemID sets the "item" edge to the Item entity by ID.
+func (_u *BinStockUpdateOne) SetItemID(id int) *BinStockUpdateOne {
+	_u.mutation.SetItemID(id)
+	return _u
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_u *BinStockUpdateOne) SetItem(v *Item) *BinStockUpdateOne {
+	return _u.SetItemID(v.ID)
+}
+
+// Mutation returns the BinStockMutation object of the builder.
+func (_u *BinStockUpdateOne) Mutation() *BinStockMutation {
+	return _u.mutation
+}
+
+// ClearBin clears the "bin" edge to the Bin entity.
+func (_u *BinStockUpdateOne) ClearBin() *BinStockUpdateOne {
+	_u.mutation.ClearBin()
+	return _u
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (_u *BinStockUpdateOne) ClearItem() *BinStockUpdateOne {
+	_u.mutation.ClearItem()
+	return _u
+}
+
+// Where appends a list predicates to the BinStockUpdate builder.
+func (_u *BinStockUpdateOne) Where(ps ...predicate.BinStock) *BinStockUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BinStockUpdateOne) Select(field string, fields ...string) *BinStockUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BinStock entity.
+func (_u *BinStockUpdateOne) Save(ctx context.Context) (*BinStock, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BinStockUpdateOne) SaveX(ctx context.Context) *BinStock {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BinStockUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BinStockUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BinStockUpdateOne) check() error {
+	if v, ok := _u.mutation.Quantity(); ok {
+		if err := binstock.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "BinStock.quantity": %w`, err)}
+		}
+	}
+	if _u.mutation.BinCleared() && len(_u.mutation.BinIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BinStock.bin"`)
+	}
+	if _u.mutation.ItemCleared() && len(_u.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BinStock.item"`)
+	}
+	return nil
+}
+
+func (_u *BinStockUpdateOne) sqlSave(ctx context.Context) (_node *BinStock, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(binstock.Table, binstock.Columns, sqlgraph.NewFieldSpec(binstock.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BinStock.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, binstock.FieldID)
+		for _, f := range fields {
+			if !binstock.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != binstock.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(binstock.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(binstock.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ReceivedAt(); ok {
+		_spec.SetField(binstock.FieldReceivedAt, field.TypeTime, value)
+	}
+	if _u.mutation.BinCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   binstock.BinTable,
+			Columns: []string{binstock.BinColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bin.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BinIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   binstock.BinTable,
+			Columns: []string{binstock.BinColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bin.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   binstock.ItemTable,
+			Columns: []string{binstock.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   binstock.ItemTable,
+			Columns: []string{binstock.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BinStock{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{binstock.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
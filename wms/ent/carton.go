@@ -0,0 +1,301 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mxV03/wms/ent/carton"
+	"github.com/mxV03/wms/ent/cartontype"
+	"github.com/mxV03/wms/ent/order"
+	"github.com/mxV03/wms/ent/tracking"
+)
+
+// Carton is the model entity for the Carton schema.
+type Carton struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Number holds the value of the "number" field.
+	Number string `json:"number,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// Length holds the value of the "length" field.
+	Length float64 `json:"length,omitempty"`
+	// Width holds the value of the "width" field.
+	Width float64 `json:"width,omitempty"`
+	// Height holds the value of the "height" field.
+	Height float64 `json:"height,omitempty"`
+	// Weight holds the value of the "weight" field.
+	Weight float64 `json:"weight,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ClosedAt holds the value of the "closed_at" field.
+	ClosedAt *time.Time `json:"closed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CartonQuery when eager-loading is set.
+	Edges               CartonEdges `json:"edges"`
+	carton_tracking     *int
+	carton_type_cartons *int
+	order_cartons       *int
+	selectValues        sql.SelectValues
+}
+
+// CartonEdges holds the relations/edges for other nodes in the graph.
+type CartonEdges struct {
+	// Order holds the value of the order edge.
+	Order *Order `json:"order,omitempty"`
+	// CartonType holds the value of the carton_type edge.
+	CartonType *CartonType `json:"carton_type,omitempty"`
+	// Lines holds the value of the lines edge.
+	Lines []*CartonLine `json:"lines,omitempty"`
+	// Tracking holds the value of the tracking edge.
+	Tracking *Tracking `json:"tracking,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// OrderOrErr returns the Order value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CartonEdges) OrderOrErr() (*Order, error) {
+	if e.Order != nil {
+		return e.Order, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: order.Label}
+	}
+	return nil, &NotLoadedError{edge: "order"}
+}
+
+// CartonTypeOrErr returns the CartonType value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CartonEdges) CartonTypeOrErr() (*CartonType, error) {
+	if e.CartonType != nil {
+		return e.CartonType, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: cartontype.Label}
+	}
+	return nil, &NotLoadedError{edge: "carton_type"}
+}
+
+// LinesOrErr returns the Lines value or an error if the edge
+// was not loaded in eager-loading.
+func (e CartonEdges) LinesOrErr() ([]*CartonLine, error) {
+	if e.loadedTypes[2] {
+		return e.Lines, nil
+	}
+	return nil, &NotLoadedError{edge: "lines"}
+}
+
+// TrackingOrErr returns the Tracking value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CartonEdges) TrackingOrErr() (*Tracking, error) {
+	if e.Tracking != nil {
+		return e.Tracking, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: tracking.Label}
+	}
+	return nil, &NotLoadedError{edge: "tracking"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Carton) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case carton.FieldLength, carton.FieldWidth, carton.FieldHeight, carton.FieldWeight:
+			values[i] = new(sql.NullFloat64)
+		case carton.FieldID:
+			values[i] = new(sql.NullInt64)
+		case carton.FieldNumber, carton.FieldStatus:
+			values[i] = new(sql.NullString)
+		case carton.FieldCreatedAt, carton.FieldClosedAt:
+			values[i] = new(sql.NullTime)
+		case carton.ForeignKeys[0]: // carton_tracking
+			values[i] = new(sql.NullInt64)
+		case carton.ForeignKeys[1]: // carton_type_cartons
+			values[i] = new(sql.NullInt64)
+		case carton.ForeignKeys[2]: // order_cartons
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Carton fields.
+func (_m *Carton) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case carton.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case carton.FieldNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field number", values[i])
+			} else if value.Valid {
+				_m.Number = value.String
+			}
+		case carton.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case carton.FieldLength:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field length", values[i])
+			} else if value.Valid {
+				_m.Length = value.Float64
+			}
+		case carton.FieldWidth:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field width", values[i])
+			} else if value.Valid {
+				_m.Width = value.Float64
+			}
+		case carton.FieldHeight:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field height", values[i])
+			} else if value.Valid {
+				_m.Height = value.Float64
+			}
+		case carton.FieldWeight:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field weight", values[i])
+			} else if value.Valid {
+				_m.Weight = value.Float64
+			}
+		case carton.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case carton.FieldClosedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field closed_at", values[i])
+			} else if value.Valid {
+				_m.ClosedAt = new(time.Time)
+				*_m.ClosedAt = value.Time
+			}
+		case carton.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field carton_tracking", value)
+			} else if value.Valid {
+				_m.carton_tracking = new(int)
+				*_m.carton_tracking = int(value.Int64)
+			}
+		case carton.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field carton_type_cartons", value)
+			} else if value.Valid {
+				_m.carton_type_cartons = new(int)
+				*_m.carton_type_cartons = int(value.Int64)
+			}
+		case carton.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field order_cartons", value)
+			} else if value.Valid {
+				_m.order_cartons = new(int)
+				*_m.order_cartons = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Carton.
+// This includes values selected through modifiers, order, etc.
+func (_m *Carton) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryOrder queries the "order" edge of the Carton entity.
+func (_m *Carton) QueryOrder() *OrderQuery {
+	return NewCartonClient(_m.config).QueryOrder(_m)
+}
+
+// QueryCartonType queries the "carton_type" edge of the Carton entity.
+func (_m *Carton) QueryCartonType() *CartonTypeQuery {
+	return NewCartonClient(_m.config).QueryCartonType(_m)
+}
+
+// QueryLines queries the "lines" edge of the Carton entity.
+func (_m *Carton) QueryLines() *CartonLineQuery {
+	return NewCartonClient(_m.config).QueryLines(_m)
+}
+
+// QueryTracking queries the "tracking" edge of the Carton entity.
+func (_m *Carton) QueryTracking() *TrackingQuery {
+	return NewCartonClient(_m.config).QueryTracking(_m)
+}
+
+// Update returns a builder for updating this Carton.
+// Note that you need to call Carton.Unwrap() before calling this method if this Carton
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Carton) Update() *CartonUpdateOne {
+	return NewCartonClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Carton entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Carton) Unwrap() *Carton {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Carton is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Carton) String() string {
+	var builder strings.Builder
+	builder.WriteString("Carton(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("number=")
+	builder.WriteString(_m.Number)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("length=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Length))
+	builder.WriteString(", ")
+	builder.WriteString("width=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Width))
+	builder.WriteString(", ")
+	builder.WriteString("height=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Height))
+	builder.WriteString(", ")
+	builder.WriteString("weight=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Weight))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ClosedAt; v != nil {
+		builder.WriteString("closed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Cartons is a parsable slice of Carton.
+type Cartons []*Carton
@@ -0,0 +1,235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package carton
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the carton type in the database.
+	Label = "carton"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldNumber holds the string denoting the number field in the database.
+	FieldNumber = "number"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldLength holds the string denoting the length field in the database.
+	FieldLength = "length"
+	// FieldWidth holds the string denoting the width field in the database.
+	FieldWidth = "width"
+	// FieldHeight holds the string denoting the height field in the database.
+	FieldHeight = "height"
+	// FieldWeight holds the string denoting the weight field in the database.
+	FieldWeight = "weight"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldClosedAt holds the string denoting the closed_at field in the database.
+	FieldClosedAt = "closed_at"
+	// EdgeOrder holds the string denoting the order edge name in mutations.
+	EdgeOrder = "order"
+	// EdgeCartonType holds the string denoting the carton_type edge name in mutations.
+	EdgeCartonType = "carton_type"
+	// EdgeLines holds the string denoting the lines edge name in mutations.
+	EdgeLines = "lines"
+	// EdgeTracking holds the string denoting the tracking edge name in mutations.
+	EdgeTracking = "tracking"
+	// Table holds the table name of the carton in the database.
+	Table = "cartons"
+	// OrderTable is the table that holds the order relation/edge.
+	OrderTable = "cartons"
+	// OrderInverseTable is the table name for the Order entity.
+	// It exists in this package in order to avoid circular dependency with the "order" package.
+	OrderInverseTable = "orders"
+	// OrderColumn is the table column denoting the order relation/edge.
+	OrderColumn = "order_cartons"
+	// CartonTypeTable is the table that holds the carton_type relation/edge.
+	CartonTypeTable = "cartons"
+	// CartonTypeInverseTable is the table name for the CartonType entity.
+	// It exists in this package in order to avoid circular dependency with the "cartontype" package.
+	CartonTypeInverseTable = "carton_types"
+	// CartonTypeColumn is the table column denoting the carton_type relation/edge.
+	CartonTypeColumn = "carton_type_cartons"
+	// LinesTable is the table that holds the lines relation/edge.
+	LinesTable = "carton_lines"
+	// LinesInverseTable is the table name for the CartonLine entity.
+	// It exists in this package in order to avoid circular dependency with the "cartonline" package.
+	LinesInverseTable = "carton_lines"
+	// LinesColumn is the table column denoting the lines relation/edge.
+	LinesColumn = "carton_lines"
+	// TrackingTable is the table that holds the tracking relation/edge.
+	TrackingTable = "cartons"
+	// TrackingInverseTable is the table name for the Tracking entity.
+	// It exists in this package in order to avoid circular dependency with the "tracking" package.
+	TrackingInverseTable = "trackings"
+	// TrackingColumn is the table column denoting the tracking relation/edge.
+	TrackingColumn = "carton_tracking"
+)
+
+// Columns holds all SQL columns for carton fields.
+var Columns = []string{
+	FieldID,
+	FieldNumber,
+	FieldStatus,
+	FieldLength,
+	FieldWidth,
+	FieldHeight,
+	FieldWeight,
+	FieldCreatedAt,
+	FieldClosedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "cartons"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"carton_tracking",
+	"carton_type_cartons",
+	"order_cartons",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NumberValidator is a validator for the "number" field. It is called by the builders before save.
+	NumberValidator func(string) error
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultLength holds the default value on creation for the "length" field.
+	DefaultLength float64
+	// DefaultWidth holds the default value on creation for the "width" field.
+	DefaultWidth float64
+	// DefaultHeight holds the default value on creation for the "height" field.
+	DefaultHeight float64
+	// DefaultWeight holds the default value on creation for the "weight" field.
+	DefaultWeight float64
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Carton queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByNumber orders the results by the number field.
+func ByNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNumber, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByLength orders the results by the length field.
+func ByLength(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLength, opts...).ToFunc()
+}
+
+// ByWidth orders the results by the width field.
+func ByWidth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWidth, opts...).ToFunc()
+}
+
+// ByHeight orders the results by the height field.
+func ByHeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeight, opts...).ToFunc()
+}
+
+// ByWeight orders the results by the weight field.
+func ByWeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWeight, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByClosedAt orders the results by the closed_at field.
+func ByClosedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClosedAt, opts...).ToFunc()
+}
+
+// ByOrderField orders the results by order field.
+func ByOrderField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrderStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByCartonTypeField orders the results by carton_type field.
+func ByCartonTypeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCartonTypeStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByLinesCount orders the results by lines count.
+func ByLinesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLinesStep(), opts...)
+	}
+}
+
+// ByLines orders the results by lines terms.
+func ByLines(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLinesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTrackingField orders the results by tracking field.
+func ByTrackingField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTrackingStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newOrderStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrderInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OrderTable, OrderColumn),
+	)
+}
+func newCartonTypeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CartonTypeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CartonTypeTable, CartonTypeColumn),
+	)
+}
+func newLinesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LinesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LinesTable, LinesColumn),
+	)
+}
+func newTrackingStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TrackingInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, TrackingTable, TrackingColumn),
+	)
+}
@@ -0,0 +1,489 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/carton"
+	"github.com/mxV03/wms/ent/cartonline"
+	"github.com/mxV03/wms/ent/cartontype"
+	"github.com/mxV03/wms/ent/order"
+	"github.com/mxV03/wms/ent/tracking"
+)
+
+// CartonCreate is the builder for creating a Carton entity.
+type CartonCreate struct {
+	config
+	mutation *CartonMutation
+	hooks    []Hook
+}
+
+// SetNumber sets the "number" field.
+func (_c *CartonCreate) SetNumber(v string) *CartonCreate {
+	_c.mutation.SetNumber(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *CartonCreate) SetStatus(v string) *CartonCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *CartonCreate) SetNillableStatus(v *string) *CartonCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetLength sets the "length" field.
+func (_c *CartonCreate) SetLength(v float64) *CartonCreate {
+	_c.mutation.SetLength(v)
+	return _c
+}
+
+// SetNillableLength sets the "length" field if the given value is not nil.
+func (_c *CartonCreate) SetNillableLength(v *float64) *CartonCreate {
+	if v != nil {
+		_c.SetLength(*v)
+	}
+	return _c
+}
+
+// SetWidth sets the "width" field.
+func (_c *CartonCreate) SetWidth(v float64) *CartonCreate {
+	_c.mutation.SetWidth(v)
+	return _c
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (_c *CartonCreate) SetNillableWidth(v *float64) *CartonCreate {
+	if v != nil {
+		_c.SetWidth(*v)
+	}
+	return _c
+}
+
+// SetHeight sets the "height" field.
+func (_c *CartonCreate) SetHeight(v float64) *CartonCreate {
+	_c.mutation.SetHeight(v)
+	return _c
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (_c *CartonCreate) SetNillableHeight(v *float64) *CartonCreate {
+	if v != nil {
+		_c.SetHeight(*v)
+	}
+	return _c
+}
+
+// SetWeight sets the "weight" field.
+func (_c *CartonCreate) SetWeight(v float64) *CartonCreate {
+	_c.mutation.SetWeight(v)
+	return _c
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (_c *CartonCreate) SetNillableWeight(v *float64) *CartonCreate {
+	if v != nil {
+		_c.SetWeight(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CartonCreate) SetCreatedAt(v time.Time) *CartonCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CartonCreate) SetNillableCreatedAt(v *time.Time) *CartonCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_c *CartonCreate) SetClosedAt(v time.Time) *CartonCreate {
+	_c.mutation.SetClosedAt(v)
+	return _c
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_c *CartonCreate) SetNillableClosedAt(v *time.Time) *CartonCreate {
+	if v != nil {
+		_c.SetClosedAt(*v)
+	}
+	return _c
+}
+
+// SetOrderID sets the "order" edge to the Order entity by ID.
+func (_c *CartonCreate) SetOrderID(id int) *CartonCreate {
+	_c.mutation.SetOrderID(id)
+	return _c
+}
+
+// SetOrder sets the "order" edge to the Order entity.
+func (_c *CartonCreate) SetOrder(v *Order) *CartonCreate {
+	return _c.SetOrderID(v.ID)
+}
+
+// SetCartonTypeID sets the "carton_type" edge to the CartonType entity by ID.
+func (_c *CartonCreate) SetCartonTypeID(id int) *CartonCreate {
+	_c.mutation.SetCartonTypeID(id)
+	return _c
+}
+
+// SetNillableCartonTypeID sets the "carton_type" edge to the CartonType entity by ID if the given value is not nil.
+func (_c *CartonCreate) SetNillableCartonTypeID(id *int) *CartonCreate {
+	if id != nil {
+		_c = _c.SetCartonTypeID(*id)
+	}
+	return _c
+}
+
+// SetCartonType sets the "carton_type" edge to the CartonType entity.
+func (_c *CartonCreate) SetCartonType(v *CartonType) *CartonCreate {
+	return _c.SetCartonTypeID(v.ID)
+}
+
+// AddLineIDs adds the "lines" edge to the CartonLine entity by IDs.
+func (_c *CartonCreate) AddLineIDs(ids ...int) *CartonCreate {
+	_c.mutation.AddLineIDs(ids...)
+	return _c
+}
+
+// AddLines adds the "lines" edges to the CartonLine entity.
+func (_c *CartonCreate) AddLines(v ...*CartonLine) *CartonCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLineIDs(ids...)
+}
+
+// SetTrackingID sets the "tracking" edge to the Tracking entity by ID.
+func (_c *CartonCreate) SetTrackingID(id int) *CartonCreate {
+	_c.mutation.SetTrackingID(id)
+	return _c
+}
+
+// SetNillableTrackingID sets the "tracking" edge to the Tracking entity by ID if the given value is not nil.
+func (_c *CartonCreate) SetNillableTrackingID(id *int) *CartonCreate {
+	if id != nil {
+		_c = _c.SetTrackingID(*id)
+	}
+	return _c
+}
+
+// SetTracking sets the "tracking" edge to the Tracking entity.
+func (_c *CartonCreate) SetTracking(v *Tracking) *CartonCreate {
+	return _c.SetTrackingID(v.ID)
+}
+
+// Mutation returns the CartonMutation object of the builder.
+func (_c *CartonCreate) Mutation() *CartonMutation {
+	return _c.mutation
+}
+
+// Save creates the Carton in the database.
+func (_c *CartonCreate) Save(ctx context.Context) (*Carton, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CartonCreate) SaveX(ctx context.Context) *Carton {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CartonCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CartonCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CartonCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := carton.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Length(); !ok {
+		v := carton.DefaultLength
+		_c.mutation.SetLength(v)
+	}
+	if _, ok := _c.mutation.Width(); !ok {
+		v := carton.DefaultWidth
+		_c.mutation.SetWidth(v)
+	}
+	if _, ok := _c.mutation.Height(); !ok {
+		v := carton.DefaultHeight
+		_c.mutation.SetHeight(v)
+	}
+	if _, ok := _c.mutation.Weight(); !ok {
+		v := carton.DefaultWeight
+		_c.mutation.SetWeight(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := carton.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CartonCreate) check() error {
+	if _, ok := _c.mutation.Number(); !ok {
+		return &ValidationError{Name: "number", err: errors.New(`ent: missing required field "Carton.number"`)}
+	}
+	if v, ok := _c.mutation.Number(); ok {
+		if err := carton.NumberValidator(v); err != nil {
+			return &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "Carton.number": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Carton.status"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Carton.created_at"`)}
+	}
+	if len(_c.mutation.OrderIDs()) == 0 {
+		return &ValidationError{Name: "order", err: errors.New(`ent: missing required edge "Carton.order"`)}
+	}
+	return nil
+}
+
+func (_c *CartonCreate) sqlSave(ctx context.Context) (*Carton, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CartonCreate) createSpec() (*Carton, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Carton{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(carton.Table, sqlgraph.NewFieldSpec(carton.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Number(); ok {
+		_spec.SetField(carton.FieldNumber, field.TypeString, value)
+		_node.Number = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(carton.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Length(); ok {
+		_spec.SetField(carton.FieldLength, field.TypeFloat64, value)
+		_node.Length = value
+	}
+	if value, ok := _c.mutation.Width(); ok {
+		_spec.SetField(carton.FieldWidth, field.TypeFloat64, value)
+		_node.Width = value
+	}
+	if value, ok := _c.mutation.Height(); ok {
+		_spec.SetField(carton.FieldHeight, field.TypeFloat64, value)
+		_node.Height = value
+	}
+	if value, ok := _c.mutation.Weight(); ok {
+		_spec.SetField(carton.FieldWeight, field.TypeFloat64, value)
+		_node.Weight = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(carton.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.ClosedAt(); ok {
+		_spec.SetField(carton.FieldClosedAt, field.TypeTime, value)
+		_node.ClosedAt = &value
+	}
+	if nodes := _c.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carton.OrderTable,
+			Columns: []string{carton.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.order_cartons = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.CartonTypeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carton.CartonTypeTable,
+			Columns: []string{carton.CartonTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cartontype.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.carton_type_cartons = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   carton.LinesTable,
+			Columns: []string{carton.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cartonline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TrackingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   carton.TrackingTable,
+			Columns: []string{carton.TrackingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tracking.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.carton_tracking = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CartonCreateBulk is the builder for creating many Carton entities in bulk.
+type CartonCreateBulk struct {
+	config
+	err      error
+	builders []*CartonCreate
+}
+
+// Save creates the Carton entities in the database.
+func (_c *CartonCreateBulk) Save(ctx context.Context) ([]*Carton, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Carton, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CartonMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CartonCreateBulk) SaveX(ctx context.Context) []*Carton {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CartonCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CartonCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1124 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/carton"
+	"github.com/mxV03/wms/ent/cartonline"
+	"github.com/mxV03/wms/ent/cartontype"
+	"github.com/mxV03/wms/ent/order"
+	"github.com/mxV03/wms/ent/predicate"
+	"github.com/mxV03/wms/ent/tracking"
+)
+
+// CartonUpdate is the builder for updating Carton entities.
+type CartonUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CartonMutation
+}
+
+// Where appends a list predicates to the CartonUpdate builder.
+func (_u *CartonUpdate) Where(ps ...predicate.Carton) *CartonUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetNumber sets the "number" field.
+func (_u *CartonUpdate) SetNumber(v string) *CartonUpdate {
+	_u.mutation.SetNumber(v)
+	return _u
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (_u *CartonUpdate) SetNillableNumber(v *string) *CartonUpdate {
+	if v != nil {
+		_u.SetNumber(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CartonUpdate) SetStatus(v string) *CartonUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CartonUpdate) SetNillableStatus(v *string) *CartonUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetLength sets the "length" field.
+func (_u *CartonUpdate) SetLength(v float64) *CartonUpdate {
+	_u.mutation.ResetLength()
+	_u.mutation.SetLength(v)
+	return _u
+}
+
+// SetNillableLength sets the "length" field if the given value is not nil.
+func (_u *CartonUpdate) SetNillableLength(v *float64) *CartonUpdate {
+	if v != nil {
+		_u.SetLength(*v)
+	}
+	return _u
+}
+
+// AddLength adds value to the "length" field.
+func (_u *CartonUpdate) AddLength(v float64) *CartonUpdate {
+	_u.mutation.AddLength(v)
+	return _u
+}
+
+// ClearLength clears the value of the "length" field.
+func (_u *CartonUpdate) ClearLength() *CartonUpdate {
+	_u.mutation.ClearLength()
+	return _u
+}
+
+// SetWidth sets the "width" field.
+func (_u *CartonUpdate) SetWidth(v float64) *CartonUpdate {
+	_u.mutation.ResetWidth()
+	_u.mutation.SetWidth(v)
+	return _u
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (_u *CartonUpdate) SetNillableWidth(v *float64) *CartonUpdate {
+	if v != nil {
+		_u.SetWidth(*v)
+	}
+	return _u
+}
+
+// AddWidth adds value to the "width" field.
+func (_u *CartonUpdate) AddWidth(v float64) *CartonUpdate {
+	_u.mutation.AddWidth(v)
+	return _u
+}
+
+// ClearWidth clears the value of the "width" field.
+func (_u *CartonUpdate) ClearWidth() *CartonUpdate {
+	_u.mutation.ClearWidth()
+	return _u
+}
+
+// SetHeight sets the "height" field.
+func (_u *CartonUpdate) SetHeight(v float64) *CartonUpdate {
+	_u.mutation.ResetHeight()
+	_u.mutation.SetHeight(v)
+	return _u
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (_u *CartonUpdate) SetNillableHeight(v *float64) *CartonUpdate {
+	if v != nil {
+		_u.SetHeight(*v)
+	}
+	return _u
+}
+
+// AddHeight adds value to the "height" field.
+func (_u *CartonUpdate) AddHeight(v float64) *CartonUpdate {
+	_u.mutation.AddHeight(v)
+	return _u
+}
+
+// ClearHeight clears the value of the "height" field.
+func (_u *CartonUpdate) ClearHeight() *CartonUpdate {
+	_u.mutation.ClearHeight()
+	return _u
+}
+
+// SetWeight sets the "weight" field.
+func (_u *CartonUpdate) SetWeight(v float64) *CartonUpdate {
+	_u.mutation.ResetWeight()
+	_u.mutation.SetWeight(v)
+	return _u
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (_u *CartonUpdate) SetNillableWeight(v *float64) *CartonUpdate {
+	if v != nil {
+		_u.SetWeight(*v)
+	}
+	return _u
+}
+
+// AddWeight adds value to the "weight" field.
+func (_u *CartonUpdate) AddWeight(v float64) *CartonUpdate {
+	_u.mutation.AddWeight(v)
+	return _u
+}
+
+// ClearWeight clears the value of the "weight" field.
+func (_u *CartonUpdate) ClearWeight() *CartonUpdate {
+	_u.mutation.ClearWeight()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *CartonUpdate) SetCreatedAt(v time.Time) *CartonUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *CartonUpdate) SetNillableCreatedAt(v *time.Time) *CartonUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_u *CartonUpdate) SetClosedAt(v time.Time) *CartonUpdate {
+	_u.mutation.SetClosedAt(v)
+	return _u
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_u *CartonUpdate) SetNillableClosedAt(v *time.Time) *CartonUpdate {
+	if v != nil {
+		_u.SetClosedAt(*v)
+	}
+	return _u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (_u *CartonUpdate) ClearClosedAt() *CartonUpdate {
+	_u.mutation.ClearClosedAt()
+	return _u
+}
+
+// SetOrderID sets the "order" edge to the Order entity by ID.
+func (_u *CartonUpdate) SetOrderID(id int) *CartonUpdate {
+	_u.mutation.SetOrderID(id)
+	return _u
+}
+
+// SetOrder sets the "order" edge to the Order entity.
+func (_u *CartonUpdate) SetOrder(v *Order) *CartonUpdate {
+	return _u.SetOrderID(v.ID)
+}
+
+// SetCartonTypeID sets the "carton_type" edge to the CartonType entity by ID.
+func (_u *CartonUpdate) SetCartonTypeID(id int) *CartonUpdate {
+	_u.mutation.SetCartonTypeID(id)
+	return _u
+}
+
+// SetNillableCartonTypeID sets the "carton_type" edge to the CartonType entity by ID if the given value is not nil.
+func (_u *CartonUpdate) SetNillableCartonTypeID(id *int) *CartonUpdate {
+	if id != nil {
+		_u = _u.SetCartonTypeID(*id)
+	}
+	return _u
+}
+
+// SetCartonType sets the "carton_type" edge to the CartonType entity.
+func (_u *CartonUpdate) SetCartonType(v *CartonType) *CartonUpdate {
+	return _u.SetCartonTypeID(v.ID)
+}
+
+// AddLineIDs adds the "lines" edge to the CartonLine entity by IDs.
+func (_u *CartonUpdate) AddLineIDs(ids ...int) *CartonUpdate {
+	_u.mutation.AddLineIDs(ids...)
+	return _u
+}
+
+// AddLines adds the "lines" edges to the CartonLine entity.
+func (_u *CartonUpdate) AddLines(v ...*CartonLine) *CartonUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLineIDs(ids...)
+}
+
+// SetTrackingID sets the "tracking" edge to the Tracking entity by ID.
+func (_u *CartonUpdate) SetTrackingID(id int) *CartonUpdate {
+	_u.mutation.SetTrackingID(id)
+	return _u
+}
+
+// SetNillableTrackingID sets the "tracking" edge to the Tracking entity by ID if the given value is not nil.
+func (_u *CartonUpdate) SetNillableTrackingID(id *int) *CartonUpdate {
+	if id != nil {
+		_u = _u.SetTrackingID(*id)
+	}
+	return _u
+}
+
+// SetTracking sets the "tracking" edge to the Tracking entity.
+func (_u *CartonUpdate) SetTracking(v *Tracking) *CartonUpdate {
+	return _u.SetTrackingID(v.ID)
+}
+
+// Mutation returns the CartonMutation object of the builder.
+func (_u *CartonUpdate) Mutation() *CartonMutation {
+	return _u.mutation
+}
+
+// ClearOrder clears the "order" edge to the Order entity.
+func (_u *CartonUpdate) ClearOrder() *CartonUpdate {
+	_u.mutation.ClearOrder()
+	return _u
+}
+
+// ClearCartonType clears the "carton_type" edge to the CartonType entity.
+func (_u *CartonUpdate) ClearCartonType() *CartonUpdate {
+	_u.mutation.ClearCartonType()
+	return _u
+}
+
+// ClearLines clears all "lines" edges to the CartonLine entity.
+func (_u *CartonUpdate) ClearLines() *CartonUpdate {
+	_u.mutation.ClearLines()
+	return _u
+}
+
+// RemoveLineIDs removes the "lines" edge to CartonLine entities by IDs.
+func (_u *CartonUpdate) RemoveLineIDs(ids ...int) *CartonUpdate {
+	_u.mutation.RemoveLineIDs(ids...)
+	return _u
+}
+
+// RemoveLines removes "lines" edges to CartonLine entities.
+func (_u *CartonUpdate) RemoveLines(v ...*CartonLine) *CartonUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLineIDs(ids...)
+}
+
+// ClearTracking clears the "tracking" edge to the Tracking entity.
+func (_u *CartonUpdate) ClearTracking() *CartonUpdate {
+	_u.mutation.ClearTracking()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CartonUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CartonUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CartonUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CartonUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CartonUpdate) check() error {
+	if v, ok := _u.mutation.Number(); ok {
+		if err := carton.NumberValidator(v); err != nil {
+			return &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "Carton.number": %w`, err)}
+		}
+	}
+	if _u.mutation.OrderCleared() && len(_u.mutation.OrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Carton.order"`)
+	}
+	return nil
+}
+
+func (_u *CartonUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(carton.Table, carton.Columns, sqlgraph.NewFieldSpec(carton.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Number(); ok {
+		_spec.SetField(carton.FieldNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(carton.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Length(); ok {
+		_spec.SetField(carton.FieldLength, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLength(); ok {
+		_spec.AddField(carton.FieldLength, field.TypeFloat64, value)
+	}
+	if _u.mutation.LengthCleared() {
+		_spec.ClearField(carton.FieldLength, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Width(); ok {
+		_spec.SetField(carton.FieldWidth, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedWidth(); ok {
+		_spec.AddField(carton.FieldWidth, field.TypeFloat64, value)
+	}
+	if _u.mutation.WidthCleared() {
+		_spec.ClearField(carton.FieldWidth, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Height(); ok {
+		_spec.SetField(carton.FieldHeight, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedHeight(); ok {
+		_spec.AddField(carton.FieldHeight, field.TypeFloat64, value)
+	}
+	if _u.mutation.HeightCleared() {
+		_spec.ClearField(carton.FieldHeight, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Weight(); ok {
+		_spec.SetField(carton.FieldWeight, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedWeight(); ok {
+		_spec.AddField(carton.FieldWeight, field.TypeFloat64, value)
+	}
+	if _u.mutation.WeightCleared() {
+		_spec.ClearField(carton.FieldWeight, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(carton.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClosedAt(); ok {
+		_spec.SetField(carton.FieldClosedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosedAtCleared() {
+		_spec.ClearField(carton.FieldClosedAt, field.TypeTime)
+	}
+	if _u.mutation.OrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carton.OrderTable,
+			Columns: []string{carton.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carton.OrderTable,
+			Columns: []string{carton.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CartonTypeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carton.CartonTypeTable,
+			Columns: []string{carton.CartonTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cartontype.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CartonTypeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carton.CartonTypeTable,
+			Columns: []string{carton.CartonTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cartontype.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   carton.LinesTable,
+			Columns: []string{carton.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cartonline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLinesIDs(); len(nodes) > 0 && !_u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   carton.LinesTable,
+			Columns: []string{carton.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cartonline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   carton.LinesTable,
+			Columns: []string{carton.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cartonline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TrackingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   carton.TrackingTable,
+			Columns: []string{carton.TrackingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tracking.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TrackingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   carton.TrackingTable,
+			Columns: []string{carton.TrackingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tracking.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{carton.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CartonUpdateOne is the builder for updating a single Carton entity.
+type CartonUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CartonMutation
+}
+
+// SetNumber sets the "number" field.
+func (_u *CartonUpdateOne) SetNumber(v string) *CartonUpdateOne {
+	_u.mutation.SetNumber(v)
+	return _u
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (_u *CartonUpdateOne) SetNillableNumber(v *string) *CartonUpdateOne {
+	if v != nil {
+		_u.SetNumber(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CartonUpdateOne) SetStatus(v string) *CartonUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CartonUpdateOne) SetNillableStatus(v *string) *CartonUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetLength sets the "length" field.
+func (_u *CartonUpdateOne) SetLength(v float64) *CartonUpdateOne {
+	_u.mutation.ResetLength()
+	_u.mutation.SetLength(v)
+	return _u
+}
+
+// SetNillableLength sets the "length" field if the given value is not nil.
+func (_u *CartonUpdateOne) SetNillableLength(v *float64) *CartonUpdateOne {
+	if v != nil {
+		_u.SetLength(*v)
+	}
+	return _u
+}
+
+// AddLength adds value to the "length" field.
+func (_u *CartonUpdateOne) AddLength(v float64) *CartonUpdateOne {
+	_u.mutation.AddLength(v)
+	return _u
+}
+
+// ClearLength clears the value of the "length" field.
+func (_u *CartonUpdateOne) ClearLength() *CartonUpdateOne {
+	_u.mutation.ClearLength()
+	return _u
+}
+
+// SetWidth sets the "width" field.
+func (_u *CartonUpdateOne) SetWidth(v float64) *CartonUpdateOne {
+	_u.mutation.ResetWidth()
+	_u.mutation.SetWidth(v)
+	return _u
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (_u *CartonUpdateOne) SetNillableWidth(v *float64) *CartonUpdateOne {
+	if v != nil {
+		_u.SetWidth(*v)
+	}
+	return _u
+}
+
+// AddWidth adds value to the "width" field.
+func (_u *CartonUpdateOne) AddWidth(v float64) *CartonUpdateOne {
+	_u.mutation.AddWidth(v)
+	return _u
+}
+
+// ClearWidth clears the value of the "width" field.
+func (_u *CartonUpdateOne) ClearWidth() *CartonUpdateOne {
+	_u.mutation.ClearWidth()
+	return _u
+}
+
+// SetHeight sets the "height" field.
+func (_u *CartonUpdateOne) SetHeight(v float64) *CartonUpdateOne {
+	_u.mutation.ResetHeight()
+	_u.mutation.SetHeight(v)
+	return _u
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (_u *CartonUpdateOne) SetNillableHeight(v *float64) *CartonUpdateOne {
+	if v != nil {
+		_u.SetHeight(*v)
+	}
+	return _u
+}
+
+// AddHeight adds value to the "height" field.
+func (_u *CartonUpdateOne) AddHeight(v float64) *CartonUpdateOne {
+	_u.mutation.AddHeight(v)
+	return _u
+}
+
+// ClearHeight clears the value of the "height" field.
+func (_u *CartonUpdateOne) ClearHeight() *CartonUpdateOne {
+	_u.mutation.ClearHeight()
+	return _u
+}
+
+// SetWeight sets the "weight" field.
+func (_u *CartonUpdateOne) SetWeight(v float64) *CartonUpdateOne {
+	_u.mutation.ResetWeight()
+	_u.mutation.SetWeight(v)
+	return _u
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (_u *CartonUpdateOne) SetNillableWeight(v *float64) *CartonUpdateOne {
+	if v != nil {
+		_u.SetWeight(*v)
+	}
+	return _u
+}
+
+// AddWeight adds value to the "weight" field.
+func (_u *CartonUpdateOne) AddWeight(v float64) *CartonUpdateOne {
+	_u.mutation.AddWeight(v)
+	return _u
+}
+
+// ClearWeight clears the value of the "weight" field.
+func (_u *CartonUpdateOne) ClearWeight() *CartonUpdateOne {
+	_u.mutation.ClearWeight()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *CartonUpdateOne) SetCreatedAt(v time.Time) *CartonUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *CartonUpdateOne) SetNillableCreatedAt(v *time.Time) *CartonUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_u *CartonUpdateOne) SetClosedAt(v time.Time) *CartonUpdateOne {
+	_u.mutation.SetClosedAt(v)
+	return _u
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_u *CartonUpdateOne) SetNillableClosedAt(v *time.Time) *CartonUpdateOne {
+	if v != nil {
+		_u.SetClosedAt(*v)
+	}
+	return _u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (_u *CartonUpdateOne) ClearClosedAt() *CartonUpdateOne {
+	_u.mutation.ClearClosedAt()
+	return _u
+}
+
+// SetOrderID sets the "order" edge to the Order entity by ID.
+func (_u *CartonUpdateOne) SetOrderID(id int) *CartonUpdateOne {
+	_u.mutation.SetOrderID(id)
+	return _u
+}
+
+// SetOrder sets the "order" edge to the Order entity.
+func (_u *CartonUpdateOne) SetOrder(v *Order) *CartonUpdateOne {
+	return _u.SetOrderID(v.ID)
+}
+
+// SetCartonTypeID sets the "carton_type" edge to the CartonType entity by ID.
+func (_u *CartonUpdateOne) SetCartonTypeID(id int) *CartonUpdateOne {
+	_u.mutation.SetCartonTypeID(id)
+	return _u
+}
+
+// SetNillableCartonTypeID sets the "carton_type" edge to the CartonType entity by ID if the given value is not nil.
+func (_u *CartonUpdateOne) SetNillableCartonTypeID(id *int) *CartonUpdateOne {
+	if id != nil {
+		_u = _u.SetCartonTypeID(*id)
+	}
+	return _u
+}
+
+// SetCartonType sets the "carton_type" edge to the CartonType entity.
+func (_u *CartonUpdateOne) SetCartonType(v *CartonType) *CartonUpdateOne {
+	return _u.SetCartonTypeID(v.ID)
+}
+
+// AddLineIDs adds the "lines" edge to the CartonLine entity by IDs.
+func (_u *CartonUpdateOne) AddLineIDs(ids ...int) *CartonUpdateOne {
+	_u.mutation.AddLineIDs(ids...)
+	return _u
+}
+
+// AddLines adds the "lines" edges to the CartonLine entity.
+func (_u *CartonUpdateOne) AddLines(v ...*CartonLine) *CartonUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLineIDs(ids...)
+}
+
+// SetTrackingID sets the "tracking" edge to the Tracking entity by ID.
+func (_u *CartonUpdateOne) SetTrackingID(id int) *CartonUpdateOne {
+	_u.mutation.SetTrackingID(id)
+	return _u
+}
+
+// SetNillableTrackingID sets the "tracking" edge to the Tracking entity by ID if the given value is not nil.
+func (_u *CartonUpdateOne) SetNillableTrackingID(id *int) *CartonUpdateOne {
+	if id != nil {
+		_u = _u.SetTrackingID(*id)
+	}
+	return _u
+}
+
+// SetTracking sets the "tracking" edge to the Tracking entity.
+func (_u *CartonUpdateOne) SetTracking(v *Tracking) *CartonUpdateOne {
+	return _u.SetTrackingID(v.ID)
+}
+
+// Mutation returns the CartonMutation object of the builder.
+func (_u *CartonUpdateOne) Mutation() *CartonMutation {
+	return _u.mutation
+}
+
+// ClearOrder clears the "order" edge to the Order entity.
+func (_u *CartonUpdateOne) ClearOrder() *CartonUpdateOne {
+	_u.mutation.ClearOrder()
+	return _u
+}
+
+// ClearCartonType clears the "carton_type" edge to the CartonType entity.
+func (_u *CartonUpdateOne) ClearCartonType() *CartonUpdateOne {
+	_u.mutation.ClearCartonType()
+	return _u
+}
+
+// ClearLines clears all "lines" edges to the CartonLine entity.
+func (_u *CartonUpdateOne) ClearLines() *CartonUpdateOne {
+	_u.mutation.ClearLines()
+	return _u
+}
+
+// RemoveLineIDs removes the "lines" edge to CartonLine entities by IDs.
+func (_u *CartonUpdateOne) RemoveLineIDs(ids ...int) *CartonUpdateOne {
+	_u.mutation.RemoveLineIDs(ids...)
+	return _u
+}
+
+// RemoveLines removes "lines" edges to CartonLine entities.
+func (_u *CartonUpdateOne) RemoveLines(v ...*CartonLine) *CartonUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLineIDs(ids...)
+}
+
+// ClearTracking clears the "tracking" edge to the Tracking entity.
+func (_u *CartonUpdateOne) ClearTracking() *CartonUpdateOne {
+	_u.mutation.ClearTracking()
+	return _u
+}
+
+// Where appends a list predicates to the CartonUpdate builder.
+func (_u *CartonUpdateOne) Where(ps ...predicate.Carton) *CartonUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CartonUpdateOne) Select(field string, fields ...string) *CartonUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Carton entity.
+func (_u *CartonUpdateOne) Save(ctx context.Context) (*Carton, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CartonUpdateOne) SaveX(ctx context.Context) *Carton {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CartonUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CartonUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CartonUpdateOne) check() error {
+	if v, ok := _u.mutation.Number(); ok {
+		if err := carton.NumberValidator(v); err != nil {
+			return &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "Carton.number": %w`, err)}
+		}
+	}
+	if _u.mutation.OrderCleared() && len(_u.mutation.OrderIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Carton.order"`)
+	}
+	return nil
+}
+
+func (_u *CartonUpdateOne) sqlSave(ctx context.Context) (_node *Carton, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(carton.Table, carton.Columns, sqlgraph.NewFieldSpec(carton.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Carton.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, carton.FieldID)
+		for _, f := range fields {
+			if !carton.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != carton.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Number(); ok {
+		_spec.SetField(carton.FieldNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(carton.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Length(); ok {
+		_spec.SetField(carton.FieldLength, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLength(); ok {
+		_spec.AddField(carton.FieldLength, field.TypeFloat64, value)
+	}
+	if _u.mutation.LengthCleared() {
+		_spec.ClearField(carton.FieldLength, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Width(); ok {
+		_spec.SetField(carton.FieldWidth, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedWidth(); ok {
+		_spec.AddField(carton.FieldWidth, field.TypeFloat64, value)
+	}
+	if _u.mutation.WidthCleared() {
+		_spec.ClearField(carton.FieldWidth, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Height(); ok {
+		_spec.SetField(carton.FieldHeight, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedHeight(); ok {
+		_spec.AddField(carton.FieldHeight, field.TypeFloat64, value)
+	}
+	if _u.mutation.HeightCleared() {
+		_spec.ClearField(carton.FieldHeight, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Weight(); ok {
+		_spec.SetField(carton.FieldWeight, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedWeight(); ok {
+		_spec.AddField(carton.FieldWeight, field.TypeFloat64, value)
+	}
+	if _u.mutation.WeightCleared() {
+		_spec.ClearField(carton.FieldWeight, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(carton.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClosedAt(); ok {
+		_spec.SetField(carton.FieldClosedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosedAtCleared() {
+		_spec.ClearField(carton.FieldClosedAt, field.TypeTime)
+	}
+	if _u.mutation.OrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carton.OrderTable,
+			Columns: []string{carton.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carton.OrderTable,
+			Columns: []string{carton.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CartonTypeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carton.CartonTypeTable,
+			Columns: []string{carton.CartonTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cartontype.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CartonTypeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   carton.CartonTypeTable,
+			Columns: []string{carton.CartonTypeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cartontype.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   carton.LinesTable,
+			Columns: []string{carton.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cartonline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLinesIDs(); len(nodes) > 0 && !_u.mutation.LinesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   carton.LinesTable,
+			Columns: []string{carton.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cartonline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   carton.LinesTable,
+			Columns: []string{carton.LinesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cartonline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TrackingCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   carton.TrackingTable,
+			Columns: []string{carton.TrackingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tracking.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TrackingIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   carton.TrackingTable,
+			Columns: []string{carton.TrackingColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tracking.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Carton{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{carton.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,169 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mxV03/wms/ent/carton"
+	"github.com/mxV03/wms/ent/cartonline"
+	"github.com/mxV03/wms/ent/item"
+)
+
+// CartonLine is the model entity for the CartonLine schema.
+type CartonLine struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity int `json:"quantity,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CartonLineQuery when eager-loading is set.
+	Edges            CartonLineEdges `json:"edges"`
+	carton_lines     *int
+	carton_line_item *int
+	selectValues     sql.SelectValues
+}
+
+// CartonLineEdges holds the relations/edges for other nodes in the graph.
+type CartonLineEdges struct {
+	// Carton holds the value of the carton edge.
+	Carton *Carton `json:"carton,omitempty"`
+	// Item holds the value of the item edge.
+	Item *Item `json:"item,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// CartonOrErr returns the Carton value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CartonLineEdges) CartonOrErr() (*Carton, error) {
+	if e.Carton != nil {
+		return e.Carton, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: carton.Label}
+	}
+	return nil, &NotLoadedError{edge: "carton"}
+}
+
+// ItemOrErr returns the Item value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CartonLineEdges) ItemOrErr() (*Item, error) {
+	if e.Item != nil {
+		return e.Item, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: item.Label}
+	}
+	return nil, &NotLoadedError{edge: "item"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CartonLine) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case cartonline.FieldID, cartonline.FieldQuantity:
+			values[i] = new(sql.NullInt64)
+		case cartonline.ForeignKeys[0]: // carton_lines
+			values[i] = new(sql.NullInt64)
+		case cartonline.ForeignKeys[1]: // carton_line_item
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CartonLine fields.
+func (_m *CartonLine) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case cartonline.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case cartonline.FieldQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				_m.Quantity = int(value.Int64)
+			}
+		case cartonline.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field carton_lines", value)
+			} else if value.Valid {
+				_m.carton_lines = new(int)
+				*_m.carton_lines = int(value.Int64)
+			}
+		case cartonline.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field carton_line_item", value)
+			} else if value.Valid {
+				_m.carton_line_item = new(int)
+				*_m.carton_line_item = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CartonLine.
+// This includes values selected through modifiers, order, etc.
+func (_m *CartonLine) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryCarton queries the "carton" edge of the CartonLine entity.
+func (_m *CartonLine) QueryCarton() *CartonQuery {
+	return NewCartonLineClient(_m.config).QueryCarton(_m)
+}
+
+// QueryItem queries the "item" edge of the CartonLine entity.
+func (_m *CartonLine) QueryItem() *ItemQuery {
+	return NewCartonLineClient(_m.config).QueryItem(_m)
+}
+
+// Update returns a builder for updating this CartonLine.
+// Note that you need to call CartonLine.Unwrap() before calling this method if this CartonLine
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CartonLine) Update() *CartonLineUpdateOne {
+	return NewCartonLineClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CartonLine entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CartonLine) Unwrap() *CartonLine {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CartonLine is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CartonLine) String() string {
+	var builder strings.Builder
+	builder.WriteString("CartonLine(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CartonLines is a parsable slice of CartonLine.
+type CartonLines []*CartonLine
@@ -0,0 +1,111 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cartonline
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the cartonline type in the database.
+	Label = "carton_line"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// EdgeCarton holds the string denoting the carton edge name in mutations.
+	EdgeCarton = "carton"
+	// EdgeItem holds the string denoting the item edge name in mutations.
+	EdgeItem = "item"
+	// Table holds the table name of the cartonline in the database.
+	Table = "carton_lines"
+	// CartonTable is the table that holds the carton relation/edge.
+	CartonTable = "carton_lines"
+	// CartonInverseTable is the table name for the Carton entity.
+	// It exists in this package in order to avoid circular dependency with the "carton" package.
+	CartonInverseTable = "cartons"
+	// CartonColumn is the table column denoting the carton relation/edge.
+	CartonColumn = "carton_lines"
+	// ItemTable is the table that holds the item relation/edge.
+	ItemTable = "carton_lines"
+	// ItemInverseTable is the table name for the Item entity.
+	// It exists in this package in order to avoid circular dependency with the "item" package.
+	ItemInverseTable = "items"
+	// ItemColumn is the table column denoting the item relation/edge.
+	ItemColumn = "carton_line_item"
+)
+
+// Columns holds all SQL columns for cartonline fields.
+var Columns = []string{
+	FieldID,
+	FieldQuantity,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "carton_lines"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"carton_lines",
+	"carton_line_item",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	QuantityValidator func(int) error
+)
+
+// OrderOption defines the ordering options for the CartonLine queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByQuantity orders the results by the quantity field.
+func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
+}
+
+// ByCartonField orders the results by carton field.
+func ByCartonField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCartonStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByItemField orders the results by item field.
+func ByItemField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newItemStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newCartonStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CartonInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CartonTable, CartonColumn),
+	)
+}
+func newItemStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ItemInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ItemTable, ItemColumn),
+	)
+}
@@ -0,0 +1,252 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/carton"
+	"github.com/mxV03/wms/ent/cartonline"
+	"github.com/mxV03/wms/ent/item"
+)
+
+// CartonLineCreate is the builder for creating a CartonLine entity.
+type CartonLineCreate struct {
+	config
+	mutation *CartonLineMutation
+	hooks    []Hook
+}
+
+// SetQuantity sets the "quantity" field.
+func (_c *CartonLineCreate) SetQuantity(v int) *CartonLineCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetCartonID sets the "carton" edge to the Carton entity by ID.
+func (_c *CartonLineCreate) SetCartonID(id int) *CartonLineCreate {
+	_c.mutation.SetCartonID(id)
+	return _c
+}
+
+// SetCarton sets the "carton" edge to the Carton entity.
+func (_c *CartonLineCreate) SetCarton(v *Carton) *CartonLineCreate {
+	return _c.SetCartonID(v.ID)
+}
+
+// SetItemID sets the "item" edge to the Item entity by ID.
+func (_c *CartonLineCreate) SetItemID(id int) *CartonLineCreate {
+	_c.mutation.SetItemID(id)
+	return _c
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_c *CartonLineCreate) SetItem(v *Item) *CartonLineCreate {
+	return _c.SetItemID(v.ID)
+}
+
+// Mutation returns the CartonLineMutation object of the builder.
+func (_c *CartonLineCreate) Mutation() *CartonLineMutation {
+	return _c.mutation
+}
+
+// Save creates the CartonLine in the database.
+func (_c *CartonLineCreate) Save(ctx context.Context) (*CartonLine, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CartonLineCreate) SaveX(ctx context.Context) *CartonLine {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CartonLineCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CartonLineCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CartonLineCreate) check() error {
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "CartonLine.quantity"`)}
+	}
+	if v, ok := _c.mutation.Quantity(); ok {
+		if err := cartonline.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "CartonLine.quantity": %w`, err)}
+		}
+	}
+	if len(_c.mutation.CartonIDs()) == 0 {
+		return &ValidationError{Name: "carton", err: errors.New(`ent: missing required edge "CartonLine.carton"`)}
+	}
+	if len(_c.mutation.ItemIDs()) == 0 {
+		return &ValidationError{Name: "item", err: errors.New(`ent: missing required edge "CartonLine.item"`)}
+	}
+	return nil
+}
+
+func (_c *CartonLineCreate) sqlSave(ctx context.Context) (*CartonLine, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CartonLineCreate) createSpec() (*CartonLine, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CartonLine{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(cartonline.Table, sqlgraph.NewFieldSpec(cartonline.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(cartonline.FieldQuantity, field.TypeInt, value)
+		_node.Quantity = value
+	}
+	if nodes := _c.mutation.CartonIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cartonline.CartonTable,
+			Columns: []string{cartonline.CartonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(carton.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.carton_lines = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   cartonline.ItemTable,
+			Columns: []string{cartonline.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.carton_line_item = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CartonLineCreateBulk is the builder for creating many CartonLine entities in bulk.
+type CartonLineCreateBulk struct {
+	config
+	err      error
+	builders []*CartonLineCreate
+}
+
+// Save creates the CartonLine entities in the database.
+func (_c *CartonLineCreateBulk) Save(ctx context.Context) ([]*CartonLine, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CartonLine, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CartonLineMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CartonLineCreateBulk) SaveX(ctx context.Context) []*CartonLine {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CartonLineCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CartonLineCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
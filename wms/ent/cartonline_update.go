@@ -0,0 +1,453 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/carton"
+	"github.com/mxV03/wms/ent/cartonline"
+	"github.com/mxV03/wms/ent/item"
+	"github.com/mxV03/wms/ent/predicate"
+)
+
+// CartonLineUpdate is the builder for updating CartonLine entities.
+type CartonLineUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CartonLineMutation
+}
+
+// Where appends a list predicates to the CartonLineUpdate builder.
+func (_u *CartonLineUpdate) Where(ps ...predicate.CartonLine) *CartonLineUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *CartonLineUpdate) SetQuantity(v int) *CartonLineUpdate {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *CartonLineUpdate) SetNillableQuantity(v *int) *CartonLineUpdate {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *CartonLineUpdate) AddQuantity(v int) *CartonLineUpdate {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetCartonID sets the "carton" edge to the Carton entity by ID.
+func (_u *CartonLineUpdate) SetCartonID(id int) *CartonLineUpdate {
+	_u.mutation.SetCartonID(id)
+	return _u
+}
+
+// SetCarton sets the "carton" edge to the Carton entity.
+func (_u *CartonLineUpdate) SetCarton(v *Carton) *CartonLineUpdate {
+	return _u.SetCartonID(v.ID)
+}
+
+// SetItemID sets the "item" edge to the Item entity by ID.
+func (_u *CartonLineUpdate) SetItemID(id int) *CartonLineUpdate {
+	_u.mutation.SetItemID(id)
+	return _u
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_u *CartonLineUpdate) SetItem(v *Item) *CartonLineUpdate {
+	return _u.SetItemID(v.ID)
+}
+
+// Mutation returns the CartonLineMutation object of the builder.
+func (_u *CartonLineUpdate) Mutation() *CartonLineMutation {
+	return _u.mutation
+}
+
+// ClearCarton clears the "carton" edge to the Carton entity.
+func (_u *CartonLineUpdate) ClearCarton() *CartonLineUpdate {
+	_u.mutation.ClearCarton()
+	return _u
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (_u *CartonLineUpdate) ClearItem() *CartonLineUpdate {
+	_u.mutation.ClearItem()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CartonLineUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CartonLineUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CartonLineUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CartonLineUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CartonLineUpdate) check() error {
+	if v, ok := _u.mutation.Quantity(); ok {
+		if err := cartonline.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "CartonLine.quantity": %w`, err)}
+		}
+	}
+	if _u.mutation.CartonCleared() && len(_u.mutation.CartonIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CartonLine.carton"`)
+	}
+	if _u.mutation.ItemCleared() && len(_u.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CartonLine.item"`)
+	}
+	return nil
+}
+
+func (_u *CartonLineUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(cartonline.Table, cartonline.Columns, sqlgraph.NewFieldSpec(cartonline.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(cartonline.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(cartonline.FieldQuantity, field.TypeInt, value)
+	}
+	if _u.mutation.CartonCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cartonline.CartonTable,
+			Columns: []string{cartonline.CartonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(carton.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CartonIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cartonline.CartonTable,
+			Columns: []string{cartonline.CartonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(carton.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   cartonline.ItemTable,
+			Columns: []string{cartonline.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   cartonline.ItemTable,
+			Columns: []string{cartonline.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cartonline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CartonLineUpdateOne is the builder for updating a single CartonLine entity.
+type CartonLineUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CartonLineMutation
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *CartonLineUpdateOne) SetQuantity(v int) *CartonLineUpdateOne {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *CartonLineUpdateOne) SetNillableQuantity(v *int) *CartonLineUpdateOne {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *CartonLineUpdateOne) AddQuantity(v int) *CartonLineUpdateOne {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetCartonID sets the "carton" edge to the Carton entity by ID.
+func (_u *CartonLineUpdateOne) SetCartonID(id int) *CartonLineUpdateOne {
+	_u.mutation.SetCartonID(id)
+	return _u
+}
+
+// SetCarton sets the "carton" edge to the Carton entity.
+func (_u *CartonLineUpdateOne) SetCarton(v *Carton) *CartonLineUpdateOne {
+	return _u.SetCartonID(v.ID)
+}
+
+// SetItemID sets the "item" edge to the Item entity by ID.
+func (_u *CartonLineUpdateOne) SetItemID(id int) *CartonLineUpdateOne {
+	_u.mutation.SetItemID(id)
+	return _u
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_u *CartonLineUpdateOne) SetItem(v *Item) *CartonLineUpdateOne {
+	return _u.SetItemID(v.ID)
+}
+
+// Mutation returns the CartonLineMutation object of the builder.
+func (_u *CartonLineUpdateOne) Mutation() *CartonLineMutation {
+	return _u.mutation
+}
+
+// ClearCarton clears the "carton" edge to the Carton entity.
+func (_u *CartonLineUpdateOne) ClearCarton() *CartonLineUpdateOne {
+	_u.mutation.ClearCarton()
+	return _u
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (_u *CartonLineUpdateOne) ClearItem() *CartonLineUpdateOne {
+	_u.mutation.ClearItem()
+	return _u
+}
+
+// Where appends a list predicates to the CartonLineUpdate builder.
+func (_u *CartonLineUpdateOne) Where(ps ...predicate.CartonLine) *CartonLineUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CartonLineUpdateOne) Select(field string, fields ...string) *CartonLineUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CartonLine entity.
+func (_u *CartonLineUpdateOne) Save(ctx context.Context) (*CartonLine, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CartonLineUpdateOne) SaveX(ctx context.Context) *CartonLine {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CartonLineUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CartonLineUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CartonLineUpdateOne) check() error {
+	if v, ok := _u.mutation.Quantity(); ok {
+		if err := cartonline.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "CartonLine.quantity": %w`, err)}
+		}
+	}
+	if _u.mutation.CartonCleared() && len(_u.mutation.CartonIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CartonLine.carton"`)
+	}
+	if _u.mutation.ItemCleared() && len(_u.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CartonLine.item"`)
+	}
+	return nil
+}
+
+func (_u *CartonLineUpdateOne) sqlSave(ctx context.Context) (_node *CartonLine, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(cartonline.Table, cartonline.Columns, sqlgraph.NewFieldSpec(cartonline.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CartonLine.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, cartonline.FieldID)
+		for _, f := range fields {
+			if !cartonline.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != cartonline.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(cartonline.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(cartonline.FieldQuantity, field.TypeInt, value)
+	}
+	if _u.mutation.CartonCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cartonline.CartonTable,
+			Columns: []string{cartonline.CartonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(carton.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CartonIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cartonline.CartonTable,
+			Columns: []string{cartonline.CartonColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(carton.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   cartonline.ItemTable,
+			Columns: []string{cartonline.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   cartonline.ItemTable,
+			Columns: []string{cartonline.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CartonLine{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cartonline.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
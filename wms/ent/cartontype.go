@@ -0,0 +1,186 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mxV03/wms/ent/cartontype"
+)
+
+// CartonType is the model entity for the CartonType schema.
+type CartonType struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Code holds the value of the "code" field.
+	Code string `json:"code,omitempty"`
+	// Length holds the value of the "length" field.
+	Length float64 `json:"length,omitempty"`
+	// Width holds the value of the "width" field.
+	Width float64 `json:"width,omitempty"`
+	// Height holds the value of the "height" field.
+	Height float64 `json:"height,omitempty"`
+	// MaxWeight holds the value of the "max_weight" field.
+	MaxWeight float64 `json:"max_weight,omitempty"`
+	// TareWeight holds the value of the "tare_weight" field.
+	TareWeight float64 `json:"tare_weight,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CartonTypeQuery when eager-loading is set.
+	Edges        CartonTypeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CartonTypeEdges holds the relations/edges for other nodes in the graph.
+type CartonTypeEdges struct {
+	// Cartons holds the value of the cartons edge.
+	Cartons []*Carton `json:"cartons,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CartonsOrErr returns the Cartons value or an error if the edge
+// was not loaded in eager-loading.
+func (e CartonTypeEdges) CartonsOrErr() ([]*Carton, error) {
+	if e.loadedTypes[0] {
+		return e.Cartons, nil
+	}
+	return nil, &NotLoadedError{edge: "cartons"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CartonType) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case cartontype.FieldLength, cartontype.FieldWidth, cartontype.FieldHeight, cartontype.FieldMaxWeight, cartontype.FieldTareWeight:
+			values[i] = new(sql.NullFloat64)
+		case cartontype.FieldID:
+			values[i] = new(sql.NullInt64)
+		case cartontype.FieldCode:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CartonType fields.
+func (_m *CartonType) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case cartontype.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case cartontype.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				_m.Code = value.String
+			}
+		case cartontype.FieldLength:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field length", values[i])
+			} else if value.Valid {
+				_m.Length = value.Float64
+			}
+		case cartontype.FieldWidth:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field width", values[i])
+			} else if value.Valid {
+				_m.Width = value.Float64
+			}
+		case cartontype.FieldHeight:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field height", values[i])
+			} else if value.Valid {
+				_m.Height = value.Float64
+			}
+		case cartontype.FieldMaxWeight:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_weight", values[i])
+			} else if value.Valid {
+				_m.MaxWeight = value.Float64
+			}
+		case cartontype.FieldTareWeight:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field tare_weight", values[i])
+			} else if value.Valid {
+				_m.TareWeight = value.Float64
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CartonType.
+// This includes values selected through modifiers, order, etc.
+func (_m *CartonType) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryCartons queries the "cartons" edge of the CartonType entity.
+func (_m *CartonType) QueryCartons() *CartonQuery {
+	return NewCartonTypeClient(_m.config).QueryCartons(_m)
+}
+
+// Update returns a builder for updating this CartonType.
+// Note that you need to call CartonType.Unwrap() before calling this method if this CartonType
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CartonType) Update() *CartonTypeUpdateOne {
+	return NewCartonTypeClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CartonType entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CartonType) Unwrap() *CartonType {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CartonType is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CartonType) String() string {
+	var builder strings.Builder
+	builder.WriteString("CartonType(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("code=")
+	builder.WriteString(_m.Code)
+	builder.WriteString(", ")
+	builder.WriteString("length=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Length))
+	builder.WriteString(", ")
+	builder.WriteString("width=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Width))
+	builder.WriteString(", ")
+	builder.WriteString("height=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Height))
+	builder.WriteString(", ")
+	builder.WriteString("max_weight=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxWeight))
+	builder.WriteString(", ")
+	builder.WriteString("tare_weight=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TareWeight))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CartonTypes is a parsable slice of CartonType.
+type CartonTypes []*CartonType
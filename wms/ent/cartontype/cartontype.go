@@ -0,0 +1,133 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cartontype
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the cartontype type in the database.
+	Label = "carton_type"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCode holds the string denoting the code field in the database.
+	FieldCode = "code"
+	// FieldLength holds the string denoting the length field in the database.
+	FieldLength = "length"
+	// FieldWidth holds the string denoting the width field in the database.
+	FieldWidth = "width"
+	// FieldHeight holds the string denoting the height field in the database.
+	FieldHeight = "height"
+	// FieldMaxWeight holds the string denoting the max_weight field in the database.
+	FieldMaxWeight = "max_weight"
+	// FieldTareWeight holds the string denoting the tare_weight field in the database.
+	FieldTareWeight = "tare_weight"
+	// EdgeCartons holds the string denoting the cartons edge name in mutations.
+	EdgeCartons = "cartons"
+	// Table holds the table name of the cartontype in the database.
+	Table = "carton_types"
+	// CartonsTable is the table that holds the cartons relation/edge.
+	CartonsTable = "cartons"
+	// CartonsInverseTable is the table name for the Carton entity.
+	// It exists in this package in order to avoid circular dependency with the "carton" package.
+	CartonsInverseTable = "cartons"
+	// CartonsColumn is the table column denoting the cartons relation/edge.
+	CartonsColumn = "carton_type_cartons"
+)
+
+// Columns holds all SQL columns for cartontype fields.
+var Columns = []string{
+	FieldID,
+	FieldCode,
+	FieldLength,
+	FieldWidth,
+	FieldHeight,
+	FieldMaxWeight,
+	FieldTareWeight,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	CodeValidator func(string) error
+	// LengthValidator is a validator for the "length" field. It is called by the builders before save.
+	LengthValidator func(float64) error
+	// WidthValidator is a validator for the "width" field. It is called by the builders before save.
+	WidthValidator func(float64) error
+	// HeightValidator is a validator for the "height" field. It is called by the builders before save.
+	HeightValidator func(float64) error
+	// MaxWeightValidator is a validator for the "max_weight" field. It is called by the builders before save.
+	MaxWeightValidator func(float64) error
+	// DefaultTareWeight holds the default value on creation for the "tare_weight" field.
+	DefaultTareWeight float64
+)
+
+// OrderOption defines the ordering options for the CartonType queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCode orders the results by the code field.
+func ByCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCode, opts...).ToFunc()
+}
+
+// ByLength orders the results by the length field.
+func ByLength(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLength, opts...).ToFunc()
+}
+
+// ByWidth orders the results by the width field.
+func ByWidth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWidth, opts...).ToFunc()
+}
+
+// ByHeight orders the results by the height field.
+func ByHeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeight, opts...).ToFunc()
+}
+
+// ByMaxWeight orders the results by the max_weight field.
+func ByMaxWeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxWeight, opts...).ToFunc()
+}
+
+// ByTareWeight orders the results by the tare_weight field.
+func ByTareWeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTareWeight, opts...).ToFunc()
+}
+
+// ByCartonsCount orders the results by cartons count.
+func ByCartonsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCartonsStep(), opts...)
+	}
+}
+
+// ByCartons orders the results by cartons terms.
+func ByCartons(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCartonsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newCartonsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CartonsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CartonsTable, CartonsColumn),
+	)
+}
@@ -0,0 +1,387 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cartontype
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/mxV03/wms/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.CartonType {
+	return predicate.CartonType(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.CartonType {
+	return predicate.CartonType(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.CartonType {
+	return predicate.CartonType(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.CartonType {
+	return predicate.CartonType(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.CartonType {
+	return predicate.CartonType(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.CartonType {
+	return predicate.CartonType(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.CartonType {
+	return predicate.CartonType(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.CartonType {
+	return predicate.CartonType(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.CartonType {
+	return predicate.CartonType(sql.FieldLTE(FieldID, id))
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.CartonType {
+	return predicate.CartonType(sql.FieldEQ(FieldCode, v))
+}
+
+// Length applies equality check predicate on the "length" field. It's identical to LengthEQ.
+func Length(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldEQ(FieldLength, v))
+}
+
+// Width applies equality check predicate on the "width" field. It's identical to WidthEQ.
+func Width(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldEQ(FieldWidth, v))
+}
+
+// Height applies equality check predicate on the "height" field. It's identical to HeightEQ.
+func Height(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldEQ(FieldHeight, v))
+}
+
+// MaxWeight applies equality check predicate on the "max_weight" field. It's identical to MaxWeightEQ.
+func MaxWeight(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldEQ(FieldMaxWeight, v))
+}
+
+// TareWeight applies equality check predicate on the "tare_weight" field. It's identical to TareWeightEQ.
+func TareWeight(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldEQ(FieldTareWeight, v))
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.CartonType {
+	return predicate.CartonType(sql.FieldEQ(FieldCode, v))
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.CartonType {
+	return predicate.CartonType(sql.FieldNEQ(FieldCode, v))
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.CartonType {
+	return predicate.CartonType(sql.FieldIn(FieldCode, vs...))
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.CartonType {
+	return predicate.CartonType(sql.FieldNotIn(FieldCode, vs...))
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.CartonType {
+	return predicate.CartonType(sql.FieldGT(FieldCode, v))
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.CartonType {
+	return predicate.CartonType(sql.FieldGTE(FieldCode, v))
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.CartonType {
+	return predicate.CartonType(sql.FieldLT(FieldCode, v))
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.CartonType {
+	return predicate.CartonType(sql.FieldLTE(FieldCode, v))
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.CartonType {
+	return predicate.CartonType(sql.FieldContains(FieldCode, v))
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.CartonType {
+	return predicate.CartonType(sql.FieldHasPrefix(FieldCode, v))
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.CartonType {
+	return predicate.CartonType(sql.FieldHasSuffix(FieldCode, v))
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.CartonType {
+	return predicate.CartonType(sql.FieldEqualFold(FieldCode, v))
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.CartonType {
+	return predicate.CartonType(sql.FieldContainsFold(FieldCode, v))
+}
+
+// LengthEQ applies the EQ predicate on the "length" field.
+func LengthEQ(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldEQ(FieldLength, v))
+}
+
+// LengthNEQ applies the NEQ predicate on the "length" field.
+func LengthNEQ(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldNEQ(FieldLength, v))
+}
+
+// LengthIn applies the In predicate on the "length" field.
+func LengthIn(vs ...float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldIn(FieldLength, vs...))
+}
+
+// LengthNotIn applies the NotIn predicate on the "length" field.
+func LengthNotIn(vs ...float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldNotIn(FieldLength, vs...))
+}
+
+// LengthGT applies the GT predicate on the "length" field.
+func LengthGT(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldGT(FieldLength, v))
+}
+
+// LengthGTE applies the GTE predicate on the "length" field.
+func LengthGTE(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldGTE(FieldLength, v))
+}
+
+// LengthLT applies the LT predicate on the "length" field.
+func LengthLT(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldLT(FieldLength, v))
+}
+
+// LengthLTE applies the LTE predicate on the "length" field.
+func LengthLTE(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldLTE(FieldLength, v))
+}
+
+// WidthEQ applies the EQ predicate on the "width" field.
+func WidthEQ(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldEQ(FieldWidth, v))
+}
+
+// WidthNEQ applies the NEQ predicate on the "width" field.
+func WidthNEQ(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldNEQ(FieldWidth, v))
+}
+
+// WidthIn applies the In predicate on the "width" field.
+func WidthIn(vs ...float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldIn(FieldWidth, vs...))
+}
+
+// WidthNotIn applies the NotIn predicate on the "width" field.
+func WidthNotIn(vs ...float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldNotIn(FieldWidth, vs...))
+}
+
+// WidthGT applies the GT predicate on the "width" field.
+func WidthGT(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldGT(FieldWidth, v))
+}
+
+// WidthGTE applies the GTE predicate on the "width" field.
+func WidthGTE(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldGTE(FieldWidth, v))
+}
+
+// WidthLT applies the LT predicate on the "width" field.
+func WidthLT(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldLT(FieldWidth, v))
+}
+
+// WidthLTE applies the LTE predicate on the "width" field.
+func WidthLTE(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldLTE(FieldWidth, v))
+}
+
+// HeightEQ applies the EQ predicate on the "height" field.
+func HeightEQ(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldEQ(FieldHeight, v))
+}
+
+// HeightNEQ applies the NEQ predicate on the "height" field.
+func HeightNEQ(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldNEQ(FieldHeight, v))
+}
+
+// HeightIn applies the In predicate on the "height" field.
+func HeightIn(vs ...float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldIn(FieldHeight, vs...))
+}
+
+// HeightNotIn applies the NotIn predicate on the "height" field.
+func HeightNotIn(vs ...float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldNotIn(FieldHeight, vs...))
+}
+
+// HeightGT applies the GT predicate on the "height" field.
+func HeightGT(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldGT(FieldHeight, v))
+}
+
+// HeightGTE applies the GTE predicate on the "height" field.
+func HeightGTE(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldGTE(FieldHeight, v))
+}
+
+// HeightLT applies the LT predicate on the "height" field.
+func HeightLT(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldLT(FieldHeight, v))
+}
+
+// HeightLTE applies the LTE predicate on the "height" field.
+func HeightLTE(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldLTE(FieldHeight, v))
+}
+
+// MaxWeightEQ applies the EQ predicate on the "max_weight" field.
+func MaxWeightEQ(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldEQ(FieldMaxWeight, v))
+}
+
+// MaxWeightNEQ applies the NEQ predicate on the "max_weight" field.
+func MaxWeightNEQ(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldNEQ(FieldMaxWeight, v))
+}
+
+// MaxWeightIn applies the In predicate on the "max_weight" field.
+func MaxWeightIn(vs ...float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldIn(FieldMaxWeight, vs...))
+}
+
+// MaxWeightNotIn applies the NotIn predicate on the "max_weight" field.
+func MaxWeightNotIn(vs ...float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldNotIn(FieldMaxWeight, vs...))
+}
+
+// MaxWeightGT applies the GT predicate on the "max_weight" field.
+func MaxWeightGT(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldGT(FieldMaxWeight, v))
+}
+
+// MaxWeightGTE applies the GTE predicate on the "max_weight" field.
+func MaxWeightGTE(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldGTE(FieldMaxWeight, v))
+}
+
+// MaxWeightLT applies the LT predicate on the "max_weight" field.
+func MaxWeightLT(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldLT(FieldMaxWeight, v))
+}
+
+// MaxWeightLTE applies the LTE predicate on the "max_weight" field.
+func MaxWeightLTE(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldLTE(FieldMaxWeight, v))
+}
+
+// TareWeightEQ applies the EQ predicate on the "tare_weight" field.
+func TareWeightEQ(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldEQ(FieldTareWeight, v))
+}
+
+// TareWeightNEQ applies the NEQ predicate on the "tare_weight" field.
+func TareWeightNEQ(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldNEQ(FieldTareWeight, v))
+}
+
+// TareWeightIn applies the In predicate on the "tare_weight" field.
+func TareWeightIn(vs ...float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldIn(FieldTareWeight, vs...))
+}
+
+// TareWeightNotIn applies the NotIn predicate on the "tare_weight" field.
+func TareWeightNotIn(vs ...float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldNotIn(FieldTareWeight, vs...))
+}
+
+// TareWeightGT applies the GT predicate on the "tare_weight" field.
+func TareWeightGT(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldGT(FieldTareWeight, v))
+}
+
+// TareWeightGTE applies the GTE predicate on the "tare_weight" field.
+func TareWeightGTE(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldGTE(FieldTareWeight, v))
+}
+
+// TareWeightLT applies the LT predicate on the "tare_weight" field.
+func TareWeightLT(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldLT(FieldTareWeight, v))
+}
+
+// TareWeightLTE applies the LTE predicate on the "tare_weight" field.
+func TareWeightLTE(v float64) predicate.CartonType {
+	return predicate.CartonType(sql.FieldLTE(FieldTareWeight, v))
+}
+
+// HasCartons applies the HasEdge predicate on the "cartons" edge.
+func HasCartons() predicate.CartonType {
+	return predicate.CartonType(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CartonsTable, CartonsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCartonsWith applies the HasEdge predicate on the "cartons" edge with a given conditions (other predicates).
+func HasCartonsWith(preds ...predicate.Carton) predicate.CartonType {
+	return predicate.CartonType(func(s *sql.Selector) {
+		step := newCartonsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CartonType) predicate.CartonType {
+	return predicate.CartonType(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CartonType) predicate.CartonType {
+	return predicate.CartonType(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CartonType) predicate.CartonType {
+	return predicate.CartonType(sql.NotPredicates(p))
+}
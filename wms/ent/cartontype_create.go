@@ -0,0 +1,323 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/carton"
+	"github.com/mxV03/wms/ent/cartontype"
+)
+
+// CartonTypeCreate is the builder for creating a CartonType entity.
+type CartonTypeCreate struct {
+	config
+	mutation *CartonTypeMutation
+	hooks    []Hook
+}
+
+// SetCode sets the "code" field.
+func (_c *CartonTypeCreate) SetCode(v string) *CartonTypeCreate {
+	_c.mutation.SetCode(v)
+	return _c
+}
+
+// SetLength sets the "length" field.
+func (_c *CartonTypeCreate) SetLength(v float64) *CartonTypeCreate {
+	_c.mutation.SetLength(v)
+	return _c
+}
+
+// SetWidth sets the "width" field.
+func (_c *CartonTypeCreate) SetWidth(v float64) *CartonTypeCreate {
+	_c.mutation.SetWidth(v)
+	return _c
+}
+
+// SetHeight sets the "height" field.
+func (_c *CartonTypeCreate) SetHeight(v float64) *CartonTypeCreate {
+	_c.mutation.SetHeight(v)
+	return _c
+}
+
+// SetMaxWeight sets the "max_weight" field.
+func (_c *CartonTypeCreate) SetMaxWeight(v float64) *CartonTypeCreate {
+	_c.mutation.SetMaxWeight(v)
+	return _c
+}
+
+// SetTareWeight sets the "tare_weight" field.
+func (_c *CartonTypeCreate) SetTareWeight(v float64) *CartonTypeCreate {
+	_c.mutation.SetTareWeight(v)
+	return _c
+}
+
+// SetNillableTareWeight sets the "tare_weight" field if the given value is not nil.
+func (_c *CartonTypeCreate) SetNillableTareWeight(v *float64) *CartonTypeCreate {
+	if v != nil {
+		_c.SetTareWeight(*v)
+	}
+	return _c
+}
+
+// AddCartonIDs adds the "cartons" edge to the Carton entity by IDs.
+func (_c *CartonTypeCreate) AddCartonIDs(ids ...int) *CartonTypeCreate {
+	_c.mutation.AddCartonIDs(ids...)
+	return _c
+}
+
+// AddCartons adds the "cartons" edges to the Carton entity.
+func (_c *CartonTypeCreate) AddCartons(v ...*Carton) *CartonTypeCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCartonIDs(ids...)
+}
+
+// Mutation returns the CartonTypeMutation object of the builder.
+func (_c *CartonTypeCreate) Mutation() *CartonTypeMutation {
+	return _c.mutation
+}
+
+// Save creates the CartonType in the database.
+func (_c *CartonTypeCreate) Save(ctx context.Context) (*CartonType, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CartonTypeCreate) SaveX(ctx context.Context) *CartonType {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CartonTypeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CartonTypeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CartonTypeCreate) defaults() {
+	if _, ok := _c.mutation.TareWeight(); !ok {
+		v := cartontype.DefaultTareWeight
+		_c.mutation.SetTareWeight(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CartonTypeCreate) check() error {
+	if _, ok := _c.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "CartonType.code"`)}
+	}
+	if v, ok := _c.mutation.Code(); ok {
+		if err := cartontype.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "CartonType.code": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Length(); !ok {
+		return &ValidationError{Name: "length", err: errors.New(`ent: missing required field "CartonType.length"`)}
+	}
+	if v, ok := _c.mutation.Length(); ok {
+		if err := cartontype.LengthValidator(v); err != nil {
+			return &ValidationError{Name: "length", err: fmt.Errorf(`ent: validator failed for field "CartonType.length": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Width(); !ok {
+		return &ValidationError{Name: "width", err: errors.New(`ent: missing required field "CartonType.width"`)}
+	}
+	if v, ok := _c.mutation.Width(); ok {
+		if err := cartontype.WidthValidator(v); err != nil {
+			return &ValidationError{Name: "width", err: fmt.Errorf(`ent: validator failed for field "CartonType.width": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Height(); !ok {
+		return &ValidationError{Name: "height", err: errors.New(`ent: missing required field "CartonType.height"`)}
+	}
+	if v, ok := _c.mutation.Height(); ok {
+		if err := cartontype.HeightValidator(v); err != nil {
+			return &ValidationError{Name: "height", err: fmt.Errorf(`ent: validator failed for field "CartonType.height": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MaxWeight(); !ok {
+		return &ValidationError{Name: "max_weight", err: errors.New(`ent: missing required field "CartonType.max_weight"`)}
+	}
+	if v, ok := _c.mutation.MaxWeight(); ok {
+		if err := cartontype.MaxWeightValidator(v); err != nil {
+			return &ValidationError{Name: "max_weight", err: fmt.Errorf(`ent: validator failed for field "CartonType.max_weight": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TareWeight(); !ok {
+		return &ValidationError{Name: "tare_weight", err: errors.New(`ent: missing required field "CartonType.tare_weight"`)}
+	}
+	return nil
+}
+
+func (_c *CartonTypeCreate) sqlSave(ctx context.Context) (*CartonType, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CartonTypeCreate) createSpec() (*CartonType, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CartonType{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(cartontype.Table, sqlgraph.NewFieldSpec(cartontype.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Code(); ok {
+		_spec.SetField(cartontype.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := _c.mutation.Length(); ok {
+		_spec.SetField(cartontype.FieldLength, field.TypeFloat64, value)
+		_node.Length = value
+	}
+	if value, ok := _c.mutation.Width(); ok {
+		_spec.SetField(cartontype.FieldWidth, field.TypeFloat64, value)
+		_node.Width = value
+	}
+	if value, ok := _c.mutation.Height(); ok {
+		_spec.SetField(cartontype.FieldHeight, field.TypeFloat64, value)
+		_node.Height = value
+	}
+	if value, ok := _c.mutation.MaxWeight(); ok {
+		_spec.SetField(cartontype.FieldMaxWeight, field.TypeFloat64, value)
+		_node.MaxWeight = value
+	}
+	if value, ok := _c.mutation.TareWeight(); ok {
+		_spec.SetField(cartontype.FieldTareWeight, field.TypeFloat64, value)
+		_node.TareWeight = value
+	}
+	if nodes := _c.mutation.CartonsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cartontype.CartonsTable,
+			Columns: []string{cartontype.CartonsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(carton.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CartonTypeCreateBulk is the builder for creating many CartonType entities in bulk.
+type CartonTypeCreateBulk struct {
+	config
+	err      error
+	builders []*CartonTypeCreate
+}
+
+// Save creates the CartonType entities in the database.
+func (_c *CartonTypeCreateBulk) Save(ctx context.Context) ([]*CartonType, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CartonType, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CartonTypeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CartonTypeCreateBulk) SaveX(ctx context.Context) []*CartonType {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CartonTypeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CartonTypeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,708 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/carton"
+	"github.com/mxV03/wms/ent/cartontype"
+	"github.com/mxV03/wms/ent/predicate"
+)
+
+// CartonTypeUpdate is the builder for updating CartonType entities.
+type CartonTypeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CartonTypeMutation
+}
+
+// Where appends a list predicates to the CartonTypeUpdate builder.
+func (_u *CartonTypeUpdate) Where(ps ...predicate.CartonType) *CartonTypeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *CartonTypeUpdate) SetCode(v string) *CartonTypeUpdate {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *CartonTypeUpdate) SetNillableCode(v *string) *CartonTypeUpdate {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetLength sets the "length" field.
+func (_u *CartonTypeUpdate) SetLength(v float64) *CartonTypeUpdate {
+	_u.mutation.ResetLength()
+	_u.mutation.SetLength(v)
+	return _u
+}
+
+// SetNillableLength sets the "length" field if the given value is not nil.
+func (_u *CartonTypeUpdate) SetNillableLength(v *float64) *CartonTypeUpdate {
+	if v != nil {
+		_u.SetLength(*v)
+	}
+	return _u
+}
+
+// AddLength adds value to the "length" field.
+func (_u *CartonTypeUpdate) AddLength(v float64) *CartonTypeUpdate {
+	_u.mutation.AddLength(v)
+	return _u
+}
+
+// SetWidth sets the "width" field.
+func (_u *CartonTypeUpdate) SetWidth(v float64) *CartonTypeUpdate {
+	_u.mutation.ResetWidth()
+	_u.mutation.SetWidth(v)
+	return _u
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (_u *CartonTypeUpdate) SetNillableWidth(v *float64) *CartonTypeUpdate {
+	if v != nil {
+		_u.SetWidth(*v)
+	}
+	return _u
+}
+
+// AddWidth adds value to the "width" field.
+func (_u *CartonTypeUpdate) AddWidth(v float64) *CartonTypeUpdate {
+	_u.mutation.AddWidth(v)
+	return _u
+}
+
+// SetHeight sets the "height" field.
+func (_u *CartonTypeUpdate) SetHeight(v float64) *CartonTypeUpdate {
+	_u.mutation.ResetHeight()
+	_u.mutation.SetHeight(v)
+	return _u
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (_u *CartonTypeUpdate) SetNillableHeight(v *float64) *CartonTypeUpdate {
+	if v != nil {
+		_u.SetHeight(*v)
+	}
+	return _u
+}
+
+// AddHeight adds value to the "height" field.
+func (_u *CartonTypeUpdate) AddHeight(v float64) *CartonTypeUpdate {
+	_u.mutation.AddHeight(v)
+	return _u
+}
+
+// SetMaxWeight sets the "max_weight" field.
+func (_u *CartonTypeUpdate) SetMaxWeight(v float64) *CartonTypeUpdate {
+	_u.mutation.ResetMaxWeight()
+	_u.mutation.SetMaxWeight(v)
+	return _u
+}
+
+// SetNillableMaxWeight sets the "max_weight" field if the given value is not nil.
+func (_u *CartonTypeUpdate) SetNillableMaxWeight(v *float64) *CartonTypeUpdate {
+	if v != nil {
+		_u.SetMaxWeight(*v)
+	}
+	return _u
+}
+
+// AddMaxWeight adds value to the "max_weight" field.
+func (_u *CartonTypeUpdate) AddMaxWeight(v float64) *CartonTypeUpdate {
+	_u.mutation.AddMaxWeight(v)
+	return _u
+}
+
+// SetTareWeight sets the "tare_weight" field.
+func (_u *CartonTypeUpdate) SetTareWeight(v float64) *CartonTypeUpdate {
+	_u.mutation.ResetTareWeight()
+	_u.mutation.SetTareWeight(v)
+	return _u
+}
+
+// SetNillableTareWeight sets the "tare_weight" field if the given value is not nil.
+func (_u *CartonTypeUpdate) SetNillableTareWeight(v *float64) *CartonTypeUpdate {
+	if v != nil {
+		_u.SetTareWeight(*v)
+	}
+	return _u
+}
+
+// AddTareWeight adds value to the "tare_weight" field.
+func (_u *CartonTypeUpdate) AddTareWeight(v float64) *CartonTypeUpdate {
+	_u.mutation.AddTareWeight(v)
+	return _u
+}
+
+// AddCartonIDs adds the "cartons" edge to the Carton entity by IDs.
+func (_u *CartonTypeUpdate) AddCartonIDs(ids ...int) *CartonTypeUpdate {
+	_u.mutation.AddCartonIDs(ids...)
+	return _u
+}
+
+// AddCartons adds the "cartons" edges to the Carton entity.
+func (_u *CartonTypeUpdate) AddCartons(v ...*Carton) *CartonTypeUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCartonIDs(ids...)
+}
+
+// Mutation returns the CartonTypeMutation object of the builder.
+func (_u *CartonTypeUpdate) Mutation() *CartonTypeMutation {
+	return _u.mutation
+}
+
+// ClearCartons clears all "cartons" edges to the Carton entity.
+func (_u *CartonTypeUpdate) ClearCartons() *CartonTypeUpdate {
+	_u.mutation.ClearCartons()
+	return _u
+}
+
+// RemoveCartonIDs removes the "cartons" edge to Carton entities by IDs.
+func (_u *CartonTypeUpdate) RemoveCartonIDs(ids ...int) *CartonTypeUpdate {
+	_u.mutation.RemoveCartonIDs(ids...)
+	return _u
+}
+
+// RemoveCartons removes "cartons" edges to Carton entities.
+func (_u *CartonTypeUpdate) RemoveCartons(v ...*Carton) *CartonTypeUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCartonIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CartonTypeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CartonTypeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CartonTypeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CartonTypeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CartonTypeUpdate) check() error {
+	if v, ok := _u.mutation.Code(); ok {
+		if err := cartontype.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "CartonType.code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Length(); ok {
+		if err := cartontype.LengthValidator(v); err != nil {
+			return &ValidationError{Name: "length", err: fmt.Errorf(`ent: validator failed for field "CartonType.length": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Width(); ok {
+		if err := cartontype.WidthValidator(v); err != nil {
+			return &ValidationError{Name: "width", err: fmt.Errorf(`ent: validator failed for field "CartonType.width": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Height(); ok {
+		if err := cartontype.HeightValidator(v); err != nil {
+			return &ValidationError{Name: "height", err: fmt.Errorf(`ent: validator failed for field "CartonType.height": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxWeight(); ok {
+		if err := cartontype.MaxWeightValidator(v); err != nil {
+			return &ValidationError{Name: "max_weight", err: fmt.Errorf(`ent: validator failed for field "CartonType.max_weight": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CartonTypeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(cartontype.Table, cartontype.Columns, sqlgraph.NewFieldSpec(cartontype.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(cartontype.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Length(); ok {
+		_spec.SetField(cartontype.FieldLength, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLength(); ok {
+		_spec.AddField(cartontype.FieldLength, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Width(); ok {
+		_spec.SetField(cartontype.FieldWidth, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedWidth(); ok {
+		_spec.AddField(cartontype.FieldWidth, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Height(); ok {
+		_spec.SetField(cartontype.FieldHeight, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedHeight(); ok {
+		_spec.AddField(cartontype.FieldHeight, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MaxWeight(); ok {
+		_spec.SetField(cartontype.FieldMaxWeight, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxWeight(); ok {
+		_spec.AddField(cartontype.FieldMaxWeight, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.TareWeight(); ok {
+		_spec.SetField(cartontype.FieldTareWeight, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTareWeight(); ok {
+		_spec.AddField(cartontype.FieldTareWeight, field.TypeFloat64, value)
+	}
+	if _u.mutation.CartonsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cartontype.CartonsTable,
+			Columns: []string{cartontype.CartonsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(carton.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCartonsIDs(); len(nodes) > 0 && !_u.mutation.CartonsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cartontype.CartonsTable,
+			Columns: []string{cartontype.CartonsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(carton.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CartonsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cartontype.CartonsTable,
+			Columns: []string{cartontype.CartonsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(carton.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cartontype.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CartonTypeUpdateOne is the builder for updating a single CartonType entity.
+type CartonTypeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CartonTypeMutation
+}
+
+// SetCode sets the "code" field.
+func (_u *CartonTypeUpdateOne) SetCode(v string) *CartonTypeUpdateOne {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *CartonTypeUpdateOne) SetNillableCode(v *string) *CartonTypeUpdateOne {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetLength sets the "length" field.
+func (_u *CartonTypeUpdateOne) SetLength(v float64) *CartonTypeUpdateOne {
+	_u.mutation.ResetLength()
+	_u.mutation.SetLength(v)
+	return _u
+}
+
+// SetNillableLength sets the "length" field if the given value is not nil.
+func (_u *CartonTypeUpdateOne) SetNillableLength(v *float64) *CartonTypeUpdateOne {
+	if v != nil {
+		_u.SetLength(*v)
+	}
+	return _u
+}
+
+// AddLength adds value to the "length" field.
+func (_u *CartonTypeUpdateOne) AddLength(v float64) *CartonTypeUpdateOne {
+	_u.mutation.AddLength(v)
+	return _u
+}
+
+// SetWidth sets the "width" field.
+func (_u *CartonTypeUpdateOne) SetWidth(v float64) *CartonTypeUpdateOne {
+	_u.mutation.ResetWidth()
+	_u.mutation.SetWidth(v)
+	return _u
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (_u *CartonTypeUpdateOne) SetNillableWidth(v *float64) *CartonTypeUpdateOne {
+	if v != nil {
+		_u.SetWidth(*v)
+	}
+	return _u
+}
+
+// AddWidth adds value to the "width" field.
+func (_u *CartonTypeUpdateOne) AddWidth(v float64) *CartonTypeUpdateOne {
+	_u.mutation.AddWidth(v)
+	return _u
+}
+
+// SetHeight sets the "height" field.
+func (_u *CartonTypeUpdateOne) SetHeight(v float64) *CartonTypeUpdateOne {
+	_u.mutation.ResetHeight()
+	_u.mutation.SetHeight(v)
+	return _u
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (_u *CartonTypeUpdateOne) SetNillableHeight(v *float64) *CartonTypeUpdateOne {
+	if v != nil {
+		_u.SetHeight(*v)
+	}
+	return _u
+}
+
+// AddHeight adds value to the "height" field.
+func (_u *CartonTypeUpdateOne) AddHeight(v float64) *CartonTypeUpdateOne {
+	_u.mutation.AddHeight(v)
+	return _u
+}
+
+// SetMaxWeight sets the "max_weight" field.
+func (_u *CartonTypeUpdateOne) SetMaxWeight(v float64) *CartonTypeUpdateOne {
+	_u.mutation.ResetMaxWeight()
+	_u.mutation.SetMaxWeight(v)
+	return _u
+}
+
+// SetNillableMaxWeight sets the "max_weight" field if the given value is not nil.
+func (_u *CartonTypeUpdateOne) SetNillableMaxWeight(v *float64) *CartonTypeUpdateOne {
+	if v != nil {
+		_u.SetMaxWeight(*v)
+	}
+	return _u
+}
+
+// AddMaxWeight adds value to the "max_weight" field.
+func (_u *CartonTypeUpdateOne) AddMaxWeight(v float64) *CartonTypeUpdateOne {
+	_u.mutation.AddMaxWeight(v)
+	return _u
+}
+
+// SetTareWeight sets the "tare_weight" field.
+func (_u *CartonTypeUpdateOne) SetTareWeight(v float64) *CartonTypeUpdateOne {
+	_u.mutation.ResetTareWeight()
+	_u.mutation.SetTareWeight(v)
+	return _u
+}
+
+// SetNillableTareWeight sets the "tare_weight" field if the given value is not nil.
+func (_u *CartonTypeUpdateOne) SetNillableTareWeight(v *float64) *CartonTypeUpdateOne {
+	if v != nil {
+		_u.SetTareWeight(*v)
+	}
+	return _u
+}
+
+// AddTareWeight adds value to the "tare_weight" field.
+func (_u *CartonTypeUpdateOne) AddTareWeight(v float64) *CartonTypeUpdateOne {
+	_u.mutation.AddTareWeight(v)
+	return _u
+}
+
+// AddCartonIDs adds the "cartons" edge to the Carton entity by IDs.
+func (_u *CartonTypeUpdateOne) AddCartonIDs(ids ...int) *CartonTypeUpdateOne {
+	_u.mutation.AddCartonIDs(ids...)
+	return _u
+}
+
+// AddCartons adds the "cartons" edges to the Carton entity.
+func (_u *CartonTypeUpdateOne) AddCartons(v ...*Carton) *CartonTypeUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCartonIDs(ids...)
+}
+
+// Mutation returns the CartonTypeMutation object of the builder.
+func (_u *CartonTypeUpdateOne) Mutation() *CartonTypeMutation {
+	return _u.mutation
+}
+
+// ClearCartons clears all "cartons" edges to the Carton entity.
+func (_u *CartonTypeUpdateOne) ClearCartons() *CartonTypeUpdateOne {
+	_u.mutation.ClearCartons()
+	return _u
+}
+
+// RemoveCartonIDs removes the "cartons" edge to Carton entities by IDs.
+func (_u *CartonTypeUpdateOne) RemoveCartonIDs(ids ...int) *CartonTypeUpdateOne {
+	_u.mutation.RemoveCartonIDs(ids...)
+	return _u
+}
+
+// RemoveCartons removes "cartons" edges to Carton entities.
+func (_u *CartonTypeUpdateOne) RemoveCartons(v ...*Carton) *CartonTypeUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCartonIDs(ids...)
+}
+
+// Where appends a list predicates to the CartonTypeUpdate builder.
+func (_u *CartonTypeUpdateOne) Where(ps ...predicate.CartonType) *CartonTypeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CartonTypeUpdateOne) Select(field string, fields ...string) *CartonTypeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CartonType entity.
+func (_u *CartonTypeUpdateOne) Save(ctx context.Context) (*CartonType, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CartonTypeUpdateOne) SaveX(ctx context.Context) *CartonType {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CartonTypeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CartonTypeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CartonTypeUpdateOne) check() error {
+	if v, ok := _u.mutation.Code(); ok {
+		if err := cartontype.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "CartonType.code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Length(); ok {
+		if err := cartontype.LengthValidator(v); err != nil {
+			return &ValidationError{Name: "length", err: fmt.Errorf(`ent: validator failed for field "CartonType.length": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Width(); ok {
+		if err := cartontype.WidthValidator(v); err != nil {
+			return &ValidationError{Name: "width", err: fmt.Errorf(`ent: validator failed for field "CartonType.width": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Height(); ok {
+		if err := cartontype.HeightValidator(v); err != nil {
+			return &ValidationError{Name: "height", err: fmt.Errorf(`ent: validator failed for field "CartonType.height": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxWeight(); ok {
+		if err := cartontype.MaxWeightValidator(v); err != nil {
+			return &ValidationError{Name: "max_weight", err: fmt.Errorf(`ent: validator failed for field "CartonType.max_weight": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CartonTypeUpdateOne) sqlSave(ctx context.Context) (_node *CartonType, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(cartontype.Table, cartontype.Columns, sqlgraph.NewFieldSpec(cartontype.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CartonType.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, cartontype.FieldID)
+		for _, f := range fields {
+			if !cartontype.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != cartontype.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(cartontype.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Length(); ok {
+		_spec.SetField(cartontype.FieldLength, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLength(); ok {
+		_spec.AddField(cartontype.FieldLength, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Width(); ok {
+		_spec.SetField(cartontype.FieldWidth, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedWidth(); ok {
+		_spec.AddField(cartontype.FieldWidth, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Height(); ok {
+		_spec.SetField(cartontype.FieldHeight, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedHeight(); ok {
+		_spec.AddField(cartontype.FieldHeight, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MaxWeight(); ok {
+		_spec.SetField(cartontype.FieldMaxWeight, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxWeight(); ok {
+		_spec.AddField(cartontype.FieldMaxWeight, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.TareWeight(); ok {
+		_spec.SetField(cartontype.FieldTareWeight, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTareWeight(); ok {
+		_spec.AddField(cartontype.FieldTareWeight, field.TypeFloat64, value)
+	}
+	if _u.mutation.CartonsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cartontype.CartonsTable,
+			Columns: []string{cartontype.CartonsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(carton.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCartonsIDs(); len(nodes) > 0 && !_u.mutation.CartonsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cartontype.CartonsTable,
+			Columns: []string{cartontype.CartonsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(carton.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CartonsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   cartontype.CartonsTable,
+			Columns: []string{cartontype.CartonsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(carton.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CartonType{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cartontype.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
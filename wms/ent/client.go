@@ -17,17 +17,32 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/mxV03/wms/ent/auditevent"
 	"github.com/mxV03/wms/ent/bin"
+	"github.com/mxV03/wms/ent/binstock"
+	"github.com/mxV03/wms/ent/carton"
+	"github.com/mxV03/wms/ent/cartonline"
+	"github.com/mxV03/wms/ent/cartontype"
+	"github.com/mxV03/wms/ent/eventrule"
 	"github.com/mxV03/wms/ent/item"
 	"github.com/mxV03/wms/ent/location"
 	"github.com/mxV03/wms/ent/order"
 	"github.com/mxV03/wms/ent/orderline"
 	"github.com/mxV03/wms/ent/picklist"
 	"github.com/mxV03/wms/ent/picktask"
+	"github.com/mxV03/wms/ent/putawaytask"
+	"github.com/mxV03/wms/ent/receipt"
+	"github.com/mxV03/wms/ent/reorderpolicy"
+	"github.com/mxV03/wms/ent/replenishmentrule"
+	"github.com/mxV03/wms/ent/replenishmenttask"
+	"github.com/mxV03/wms/ent/sequence"
+	"github.com/mxV03/wms/ent/stockdiscrepancy"
 	"github.com/mxV03/wms/ent/stockmovement"
 	"github.com/mxV03/wms/ent/tracking"
 	"github.com/mxV03/wms/ent/user"
 	"github.com/mxV03/wms/ent/warehouse"
 	"github.com/mxV03/wms/ent/warehouselocation"
+	"github.com/mxV03/wms/ent/wave"
+	"github.com/mxV03/wms/ent/wavepick"
+	"github.com/mxV03/wms/ent/wavetask"
 	"github.com/mxV03/wms/ent/zone"
 )
 
@@ -40,6 +55,16 @@ type Client struct {
 	AuditEvent *AuditEventClient
 	// Bin is the client for interacting with the Bin builders.
 	Bin *BinClient
+	// BinStock is the client for interacting with the BinStock builders.
+	BinStock *BinStockClient
+	// Carton is the client for interacting with the Carton builders.
+	Carton *CartonClient
+	// CartonLine is the client for interacting with the CartonLine builders.
+	CartonLine *CartonLineClient
+	// CartonType is the client for interacting with the CartonType builders.
+	CartonType *CartonTypeClient
+	// EventRule is the client for interacting with the EventRule builders.
+	EventRule *EventRuleClient
 	// Item is the client for interacting with the Item builders.
 	Item *ItemClient
 	// Location is the client for interacting with the Location builders.
@@ -52,6 +77,20 @@ type Client struct {
 	PickList *PickListClient
 	// PickTask is the client for interacting with the PickTask builders.
 	PickTask *PickTaskClient
+	// PutawayTask is the client for interacting with the PutawayTask builders.
+	PutawayTask *PutawayTaskClient
+	// Receipt is the client for interacting with the Receipt builders.
+	Receipt *ReceiptClient
+	// ReorderPolicy is the client for interacting with the ReorderPolicy builders.
+	ReorderPolicy *ReorderPolicyClient
+	// ReplenishmentRule is the client for interacting with the ReplenishmentRule builders.
+	ReplenishmentRule *ReplenishmentRuleClient
+	// ReplenishmentTask is the client for interacting with the ReplenishmentTask builders.
+	ReplenishmentTask *ReplenishmentTaskClient
+	// Sequence is the client for interacting with the Sequence builders.
+	Sequence *SequenceClient
+	// StockDiscrepancy is the client for interacting with the StockDiscrepancy builders.
+	StockDiscrepancy *StockDiscrepancyClient
 	// StockMovement is the client for interacting with the StockMovement builders.
 	StockMovement *StockMovementClient
 	// Tracking is the client for interacting with the Tracking builders.
@@ -62,6 +101,12 @@ type Client struct {
 	Warehouse *WarehouseClient
 	// WarehouseLocation is the client for interacting with the WarehouseLocation builders.
 	WarehouseLocation *WarehouseLocationClient
+	// Wave is the client for interacting with the Wave builders.
+	Wave *WaveClient
+	// WavePick is the client for interacting with the WavePick builders.
+	WavePick *WavePickClient
+	// WaveTask is the client for interacting with the WaveTask builders.
+	WaveTask *WaveTaskClient
 	// Zone is the client for interacting with the Zone builders.
 	Zone *ZoneClient
 }
@@ -77,17 +122,32 @@ func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.AuditEvent = NewAuditEventClient(c.config)
 	c.Bin = NewBinClient(c.config)
+	c.BinStock = NewBinStockClient(c.config)
+	c.Carton = NewCartonClient(c.config)
+	c.CartonLine = NewCartonLineClient(c.config)
+	c.CartonType = NewCartonTypeClient(c.config)
+	c.EventRule = NewEventRuleClient(c.config)
 	c.Item = NewItemClient(c.config)
 	c.Location = NewLocationClient(c.config)
 	c.Order = NewOrderClient(c.config)
 	c.OrderLine = NewOrderLineClient(c.config)
 	c.PickList = NewPickListClient(c.config)
 	c.PickTask = NewPickTaskClient(c.config)
+	c.PutawayTask = NewPutawayTaskClient(c.config)
+	c.Receipt = NewReceiptClient(c.config)
+	c.ReorderPolicy = NewReorderPolicyClient(c.config)
+	c.ReplenishmentRule = NewReplenishmentRuleClient(c.config)
+	c.ReplenishmentTask = NewReplenishmentTaskClient(c.config)
+	c.Sequence = NewSequenceClient(c.config)
+	c.StockDiscrepancy = NewStockDiscrepancyClient(c.config)
 	c.StockMovement = NewStockMovementClient(c.config)
 	c.Tracking = NewTrackingClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.Warehouse = NewWarehouseClient(c.config)
 	c.WarehouseLocation = NewWarehouseLocationClient(c.config)
+	c.Wave = NewWaveClient(c.config)
+	c.WavePick = NewWavePickClient(c.config)
+	c.WaveTask = NewWaveTaskClient(c.config)
 	c.Zone = NewZoneClient(c.config)
 }
 
@@ -183,17 +243,32 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		config:            cfg,
 		AuditEvent:        NewAuditEventClient(cfg),
 		Bin:               NewBinClient(cfg),
+		BinStock:          NewBinStockClient(cfg),
+		Carton:            NewCartonClient(cfg),
+		CartonLine:        NewCartonLineClient(cfg),
+		CartonType:        NewCartonTypeClient(cfg),
+		EventRule:         NewEventRuleClient(cfg),
 		Item:              NewItemClient(cfg),
 		Location:          NewLocationClient(cfg),
 		Order:             NewOrderClient(cfg),
 		OrderLine:         NewOrderLineClient(cfg),
 		PickList:          NewPickListClient(cfg),
 		PickTask:          NewPickTaskClient(cfg),
+		PutawayTask:       NewPutawayTaskClient(cfg),
+		Receipt:           NewReceiptClient(cfg),
+		ReorderPolicy:     NewReorderPolicyClient(cfg),
+		ReplenishmentRule: NewReplenishmentRuleClient(cfg),
+		ReplenishmentTask: NewReplenishmentTaskClient(cfg),
+		Sequence:          NewSequenceClient(cfg),
+		StockDiscrepancy:  NewStockDiscrepancyClient(cfg),
 		StockMovement:     NewStockMovementClient(cfg),
 		Tracking:          NewTrackingClient(cfg),
 		User:              NewUserClient(cfg),
 		Warehouse:         NewWarehouseClient(cfg),
 		WarehouseLocation: NewWarehouseLocationClient(cfg),
+		Wave:              NewWaveClient(cfg),
+		WavePick:          NewWavePickClient(cfg),
+		WaveTask:          NewWaveTaskClient(cfg),
 		Zone:              NewZoneClient(cfg),
 	}, nil
 }
@@ -216,17 +291,32 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		config:            cfg,
 		AuditEvent:        NewAuditEventClient(cfg),
 		Bin:               NewBinClient(cfg),
+		BinStock:          NewBinStockClient(cfg),
+		Carton:            NewCartonClient(cfg),
+		CartonLine:        NewCartonLineClient(cfg),
+		CartonType:        NewCartonTypeClient(cfg),
+		EventRule:         NewEventRuleClient(cfg),
 		Item:              NewItemClient(cfg),
 		Location:          NewLocationClient(cfg),
 		Order:             NewOrderClient(cfg),
 		OrderLine:         NewOrderLineClient(cfg),
 		PickList:          NewPickListClient(cfg),
 		PickTask:          NewPickTaskClient(cfg),
+		PutawayTask:       NewPutawayTaskClient(cfg),
+		Receipt:           NewReceiptClient(cfg),
+		ReorderPolicy:     NewReorderPolicyClient(cfg),
+		ReplenishmentRule: NewReplenishmentRuleClient(cfg),
+		ReplenishmentTask: NewReplenishmentTaskClient(cfg),
+		Sequence:          NewSequenceClient(cfg),
+		StockDiscrepancy:  NewStockDiscrepancyClient(cfg),
 		StockMovement:     NewStockMovementClient(cfg),
 		Tracking:          NewTrackingClient(cfg),
 		User:              NewUserClient(cfg),
 		Warehouse:         NewWarehouseClient(cfg),
 		WarehouseLocation: NewWarehouseLocationClient(cfg),
+		Wave:              NewWaveClient(cfg),
+		WavePick:          NewWavePickClient(cfg),
+		WaveTask:          NewWaveTaskClient(cfg),
 		Zone:              NewZoneClient(cfg),
 	}, nil
 }
@@ -257,9 +347,12 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.AuditEvent, c.Bin, c.Item, c.Location, c.Order, c.OrderLine, c.PickList,
-		c.PickTask, c.StockMovement, c.Tracking, c.User, c.Warehouse,
-		c.WarehouseLocation, c.Zone,
+		c.AuditEvent, c.Bin, c.BinStock, c.Carton, c.CartonLine, c.CartonType,
+		c.EventRule, c.Item, c.Location, c.Order, c.OrderLine, c.PickList, c.PickTask,
+		c.PutawayTask, c.Receipt, c.ReorderPolicy, c.ReplenishmentRule,
+		c.ReplenishmentTask, c.Sequence, c.StockDiscrepancy, c.StockMovement,
+		c.Tracking, c.User, c.Warehouse, c.WarehouseLocation, c.Wave, c.WavePick,
+		c.WaveTask, c.Zone,
 	} {
 		n.Use(hooks...)
 	}
@@ -269,9 +362,12 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.AuditEvent, c.Bin, c.Item, c.Location, c.Order, c.OrderLine, c.PickList,
-		c.PickTask, c.StockMovement, c.Tracking, c.User, c.Warehouse,
-		c.WarehouseLocation, c.Zone,
+		c.AuditEvent, c.Bin, c.BinStock, c.Carton, c.CartonLine, c.CartonType,
+		c.EventRule, c.Item, c.Location, c.Order, c.OrderLine, c.PickList, c.PickTask,
+		c.PutawayTask, c.Receipt, c.ReorderPolicy, c.ReplenishmentRule,
+		c.ReplenishmentTask, c.Sequence, c.StockDiscrepancy, c.StockMovement,
+		c.Tracking, c.User, c.Warehouse, c.WarehouseLocation, c.Wave, c.WavePick,
+		c.WaveTask, c.Zone,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -284,6 +380,16 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.AuditEvent.mutate(ctx, m)
 	case *BinMutation:
 		return c.Bin.mutate(ctx, m)
+	case *BinStockMutation:
+		return c.BinStock.mutate(ctx, m)
+	case *CartonMutation:
+		return c.Carton.mutate(ctx, m)
+	case *CartonLineMutation:
+		return c.CartonLine.mutate(ctx, m)
+	case *CartonTypeMutation:
+		return c.CartonType.mutate(ctx, m)
+	case *EventRuleMutation:
+		return c.EventRule.mutate(ctx, m)
 	case *ItemMutation:
 		return c.Item.mutate(ctx, m)
 	case *LocationMutation:
@@ -296,6 +402,20 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.PickList.mutate(ctx, m)
 	case *PickTaskMutation:
 		return c.PickTask.mutate(ctx, m)
+	case *PutawayTaskMutation:
+		return c.PutawayTask.mutate(ctx, m)
+	case *ReceiptMutation:
+		return c.Receipt.mutate(ctx, m)
+	case *ReorderPolicyMutation:
+		return c.ReorderPolicy.mutate(ctx, m)
+	case *ReplenishmentRuleMutation:
+		return c.ReplenishmentRule.mutate(ctx, m)
+	case *ReplenishmentTaskMutation:
+		return c.ReplenishmentTask.mutate(ctx, m)
+	case *SequenceMutation:
+		return c.Sequence.mutate(ctx, m)
+	case *StockDiscrepancyMutation:
+		return c.StockDiscrepancy.mutate(ctx, m)
 	case *StockMovementMutation:
 		return c.StockMovement.mutate(ctx, m)
 	case *TrackingMutation:
@@ -306,6 +426,12 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Warehouse.mutate(ctx, m)
 	case *WarehouseLocationMutation:
 		return c.WarehouseLocation.mutate(ctx, m)
+	case *WaveMutation:
+		return c.Wave.mutate(ctx, m)
+	case *WavePickMutation:
+		return c.WavePick.mutate(ctx, m)
+	case *WaveTaskMutation:
+		return c.WaveTask.mutate(ctx, m)
 	case *ZoneMutation:
 		return c.Zone.mutate(ctx, m)
 	default:
@@ -627,107 +753,107 @@ func (c *BinClient) mutate(ctx context.Context, m *BinMutation) (Value, error) {
 	}
 }
 
-// ItemClient is a client for the Item schema.
-type ItemClient struct {
+// BinStockClient is a client for the BinStock schema.
+type BinStockClient struct {
 	config
 }
 
-// NewItemClient returns a client for the Item from the given config.
-func NewItemClient(c config) *ItemClient {
-	return &ItemClient{config: c}
+// NewBinStockClient returns a client for the BinStock from the given config.
+func NewBinStockClient(c config) *BinStockClient {
+	return &BinStockClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `item.Hooks(f(g(h())))`.
-func (c *ItemClient) Use(hooks ...Hook) {
-	c.hooks.Item = append(c.hooks.Item, hooks...)
+// A call to `Use(f, g, h)` equals to `binstock.Hooks(f(g(h())))`.
+func (c *BinStockClient) Use(hooks ...Hook) {
+	c.hooks.BinStock = append(c.hooks.BinStock, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `item.Intercept(f(g(h())))`.
-func (c *ItemClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Item = append(c.inters.Item, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `binstock.Intercept(f(g(h())))`.
+func (c *BinStockClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BinStock = append(c.inters.BinStock, interceptors...)
 }
 
-// Create returns a builder for creating a Item entity.
-func (c *ItemClient) Create() *ItemCreate {
-	mutation := newItemMutation(c.config, OpCreate)
-	return &ItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a BinStock entity.
+func (c *BinStockClient) Create() *BinStockCreate {
+	mutation := newBinStockMutation(c.config, OpCreate)
+	return &BinStockCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Item entities.
-func (c *ItemClient) CreateBulk(builders ...*ItemCreate) *ItemCreateBulk {
-	return &ItemCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of BinStock entities.
+func (c *BinStockClient) CreateBulk(builders ...*BinStockCreate) *BinStockCreateBulk {
+	return &BinStockCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ItemClient) MapCreateBulk(slice any, setFunc func(*ItemCreate, int)) *ItemCreateBulk {
+func (c *BinStockClient) MapCreateBulk(slice any, setFunc func(*BinStockCreate, int)) *BinStockCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ItemCreateBulk{err: fmt.Errorf("calling to ItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BinStockCreateBulk{err: fmt.Errorf("calling to BinStockClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ItemCreate, rv.Len())
+	builders := make([]*BinStockCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ItemCreateBulk{config: c.config, builders: builders}
+	return &BinStockCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Item.
-func (c *ItemClient) Update() *ItemUpdate {
-	mutation := newItemMutation(c.config, OpUpdate)
-	return &ItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for BinStock.
+func (c *BinStockClient) Update() *BinStockUpdate {
+	mutation := newBinStockMutation(c.config, OpUpdate)
+	return &BinStockUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ItemClient) UpdateOne(_m *Item) *ItemUpdateOne {
-	mutation := newItemMutation(c.config, OpUpdateOne, withItem(_m))
-	return &ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BinStockClient) UpdateOne(_m *BinStock) *BinStockUpdateOne {
+	mutation := newBinStockMutation(c.config, OpUpdateOne, withBinStock(_m))
+	return &BinStockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ItemClient) UpdateOneID(id int) *ItemUpdateOne {
-	mutation := newItemMutation(c.config, OpUpdateOne, withItemID(id))
-	return &ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BinStockClient) UpdateOneID(id int) *BinStockUpdateOne {
+	mutation := newBinStockMutation(c.config, OpUpdateOne, withBinStockID(id))
+	return &BinStockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Item.
-func (c *ItemClient) Delete() *ItemDelete {
-	mutation := newItemMutation(c.config, OpDelete)
-	return &ItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for BinStock.
+func (c *BinStockClient) Delete() *BinStockDelete {
+	mutation := newBinStockMutation(c.config, OpDelete)
+	return &BinStockDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ItemClient) DeleteOne(_m *Item) *ItemDeleteOne {
+func (c *BinStockClient) DeleteOne(_m *BinStock) *BinStockDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ItemClient) DeleteOneID(id int) *ItemDeleteOne {
-	builder := c.Delete().Where(item.ID(id))
+func (c *BinStockClient) DeleteOneID(id int) *BinStockDeleteOne {
+	builder := c.Delete().Where(binstock.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ItemDeleteOne{builder}
+	return &BinStockDeleteOne{builder}
 }
 
-// Query returns a query builder for Item.
-func (c *ItemClient) Query() *ItemQuery {
-	return &ItemQuery{
+// Query returns a query builder for BinStock.
+func (c *BinStockClient) Query() *BinStockQuery {
+	return &BinStockQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeItem},
+		ctx:    &QueryContext{Type: TypeBinStock},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Item entity by its id.
-func (c *ItemClient) Get(ctx context.Context, id int) (*Item, error) {
-	return c.Query().Where(item.ID(id)).Only(ctx)
+// Get returns a BinStock entity by its id.
+func (c *BinStockClient) Get(ctx context.Context, id int) (*BinStock, error) {
+	return c.Query().Where(binstock.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ItemClient) GetX(ctx context.Context, id int) *Item {
+func (c *BinStockClient) GetX(ctx context.Context, id int) *BinStock {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -735,31 +861,15 @@ func (c *ItemClient) GetX(ctx context.Context, id int) *Item {
 	return obj
 }
 
-// QueryMovements queries the movements edge of a Item.
-func (c *ItemClient) QueryMovements(_m *Item) *StockMovementQuery {
-	query := (&StockMovementClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(item.Table, item.FieldID, id),
-			sqlgraph.To(stockmovement.Table, stockmovement.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, item.MovementsTable, item.MovementsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryOrderLines queries the order_lines edge of a Item.
-func (c *ItemClient) QueryOrderLines(_m *Item) *OrderLineQuery {
-	query := (&OrderLineClient{config: c.config}).Query()
+// QueryBin queries the bin edge of a BinStock.
+func (c *BinStockClient) QueryBin(_m *BinStock) *BinQuery {
+	query := (&BinClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(item.Table, item.FieldID, id),
-			sqlgraph.To(orderline.Table, orderline.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, item.OrderLinesTable, item.OrderLinesColumn),
+			sqlgraph.From(binstock.Table, binstock.FieldID, id),
+			sqlgraph.To(bin.Table, bin.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, binstock.BinTable, binstock.BinColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -767,15 +877,15 @@ func (c *ItemClient) QueryOrderLines(_m *Item) *OrderLineQuery {
 	return query
 }
 
-// QueryBins queries the bins edge of a Item.
-func (c *ItemClient) QueryBins(_m *Item) *BinQuery {
-	query := (&BinClient{config: c.config}).Query()
+// QueryItem queries the item edge of a BinStock.
+func (c *BinStockClient) QueryItem(_m *BinStock) *ItemQuery {
+	query := (&ItemClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(item.Table, item.FieldID, id),
-			sqlgraph.To(bin.Table, bin.FieldID),
-			sqlgraph.Edge(sqlgraph.M2M, true, item.BinsTable, item.BinsPrimaryKey...),
+			sqlgraph.From(binstock.Table, binstock.FieldID, id),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, binstock.ItemTable, binstock.ItemColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -784,131 +894,131 @@ func (c *ItemClient) QueryBins(_m *Item) *BinQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *ItemClient) Hooks() []Hook {
-	return c.hooks.Item
+func (c *BinStockClient) Hooks() []Hook {
+	return c.hooks.BinStock
 }
 
 // Interceptors returns the client interceptors.
-func (c *ItemClient) Interceptors() []Interceptor {
-	return c.inters.Item
+func (c *BinStockClient) Interceptors() []Interceptor {
+	return c.inters.BinStock
 }
 
-func (c *ItemClient) mutate(ctx context.Context, m *ItemMutation) (Value, error) {
+func (c *BinStockClient) mutate(ctx context.Context, m *BinStockMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BinStockCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BinStockUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BinStockUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BinStockDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Item mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown BinStock mutation op: %q", m.Op())
 	}
 }
 
-// LocationClient is a client for the Location schema.
-type LocationClient struct {
+// CartonClient is a client for the Carton schema.
+type CartonClient struct {
 	config
 }
 
-// NewLocationClient returns a client for the Location from the given config.
-func NewLocationClient(c config) *LocationClient {
-	return &LocationClient{config: c}
+// NewCartonClient returns a client for the Carton from the given config.
+func NewCartonClient(c config) *CartonClient {
+	return &CartonClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `location.Hooks(f(g(h())))`.
-func (c *LocationClient) Use(hooks ...Hook) {
-	c.hooks.Location = append(c.hooks.Location, hooks...)
+// A call to `Use(f, g, h)` equals to `carton.Hooks(f(g(h())))`.
+func (c *CartonClient) Use(hooks ...Hook) {
+	c.hooks.Carton = append(c.hooks.Carton, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `location.Intercept(f(g(h())))`.
-func (c *LocationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Location = append(c.inters.Location, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `carton.Intercept(f(g(h())))`.
+func (c *CartonClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Carton = append(c.inters.Carton, interceptors...)
 }
 
-// Create returns a builder for creating a Location entity.
-func (c *LocationClient) Create() *LocationCreate {
-	mutation := newLocationMutation(c.config, OpCreate)
-	return &LocationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Carton entity.
+func (c *CartonClient) Create() *CartonCreate {
+	mutation := newCartonMutation(c.config, OpCreate)
+	return &CartonCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Location entities.
-func (c *LocationClient) CreateBulk(builders ...*LocationCreate) *LocationCreateBulk {
-	return &LocationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Carton entities.
+func (c *CartonClient) CreateBulk(builders ...*CartonCreate) *CartonCreateBulk {
+	return &CartonCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LocationClient) MapCreateBulk(slice any, setFunc func(*LocationCreate, int)) *LocationCreateBulk {
+func (c *CartonClient) MapCreateBulk(slice any, setFunc func(*CartonCreate, int)) *CartonCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LocationCreateBulk{err: fmt.Errorf("calling to LocationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CartonCreateBulk{err: fmt.Errorf("calling to CartonClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LocationCreate, rv.Len())
+	builders := make([]*CartonCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LocationCreateBulk{config: c.config, builders: builders}
+	return &CartonCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Location.
-func (c *LocationClient) Update() *LocationUpdate {
-	mutation := newLocationMutation(c.config, OpUpdate)
-	return &LocationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Carton.
+func (c *CartonClient) Update() *CartonUpdate {
+	mutation := newCartonMutation(c.config, OpUpdate)
+	return &CartonUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LocationClient) UpdateOne(_m *Location) *LocationUpdateOne {
-	mutation := newLocationMutation(c.config, OpUpdateOne, withLocation(_m))
-	return &LocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CartonClient) UpdateOne(_m *Carton) *CartonUpdateOne {
+	mutation := newCartonMutation(c.config, OpUpdateOne, withCarton(_m))
+	return &CartonUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LocationClient) UpdateOneID(id int) *LocationUpdateOne {
-	mutation := newLocationMutation(c.config, OpUpdateOne, withLocationID(id))
-	return &LocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CartonClient) UpdateOneID(id int) *CartonUpdateOne {
+	mutation := newCartonMutation(c.config, OpUpdateOne, withCartonID(id))
+	return &CartonUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Location.
-func (c *LocationClient) Delete() *LocationDelete {
-	mutation := newLocationMutation(c.config, OpDelete)
-	return &LocationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Carton.
+func (c *CartonClient) Delete() *CartonDelete {
+	mutation := newCartonMutation(c.config, OpDelete)
+	return &CartonDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LocationClient) DeleteOne(_m *Location) *LocationDeleteOne {
+func (c *CartonClient) DeleteOne(_m *Carton) *CartonDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LocationClient) DeleteOneID(id int) *LocationDeleteOne {
-	builder := c.Delete().Where(location.ID(id))
+func (c *CartonClient) DeleteOneID(id int) *CartonDeleteOne {
+	builder := c.Delete().Where(carton.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LocationDeleteOne{builder}
+	return &CartonDeleteOne{builder}
 }
 
-// Query returns a query builder for Location.
-func (c *LocationClient) Query() *LocationQuery {
-	return &LocationQuery{
+// Query returns a query builder for Carton.
+func (c *CartonClient) Query() *CartonQuery {
+	return &CartonQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLocation},
+		ctx:    &QueryContext{Type: TypeCarton},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Location entity by its id.
-func (c *LocationClient) Get(ctx context.Context, id int) (*Location, error) {
-	return c.Query().Where(location.ID(id)).Only(ctx)
+// Get returns a Carton entity by its id.
+func (c *CartonClient) Get(ctx context.Context, id int) (*Carton, error) {
+	return c.Query().Where(carton.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LocationClient) GetX(ctx context.Context, id int) *Location {
+func (c *CartonClient) GetX(ctx context.Context, id int) *Carton {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -916,31 +1026,15 @@ func (c *LocationClient) GetX(ctx context.Context, id int) *Location {
 	return obj
 }
 
-// QueryMovements queries the movements edge of a Location.
-func (c *LocationClient) QueryMovements(_m *Location) *StockMovementQuery {
-	query := (&StockMovementClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(location.Table, location.FieldID, id),
-			sqlgraph.To(stockmovement.Table, stockmovement.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, location.MovementsTable, location.MovementsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryOrderLines queries the order_lines edge of a Location.
-func (c *LocationClient) QueryOrderLines(_m *Location) *OrderLineQuery {
-	query := (&OrderLineClient{config: c.config}).Query()
+// QueryOrder queries the order edge of a Carton.
+func (c *CartonClient) QueryOrder(_m *Carton) *OrderQuery {
+	query := (&OrderClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(location.Table, location.FieldID, id),
-			sqlgraph.To(orderline.Table, orderline.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, location.OrderLinesTable, location.OrderLinesColumn),
+			sqlgraph.From(carton.Table, carton.FieldID, id),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, carton.OrderTable, carton.OrderColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -948,15 +1042,15 @@ func (c *LocationClient) QueryOrderLines(_m *Location) *OrderLineQuery {
 	return query
 }
 
-// QueryZones queries the zones edge of a Location.
-func (c *LocationClient) QueryZones(_m *Location) *ZoneQuery {
-	query := (&ZoneClient{config: c.config}).Query()
+// QueryCartonType queries the carton_type edge of a Carton.
+func (c *CartonClient) QueryCartonType(_m *Carton) *CartonTypeQuery {
+	query := (&CartonTypeClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(location.Table, location.FieldID, id),
-			sqlgraph.To(zone.Table, zone.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, location.ZonesTable, location.ZonesColumn),
+			sqlgraph.From(carton.Table, carton.FieldID, id),
+			sqlgraph.To(cartontype.Table, cartontype.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, carton.CartonTypeTable, carton.CartonTypeColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -964,15 +1058,15 @@ func (c *LocationClient) QueryZones(_m *Location) *ZoneQuery {
 	return query
 }
 
-// QueryBins queries the bins edge of a Location.
-func (c *LocationClient) QueryBins(_m *Location) *BinQuery {
-	query := (&BinClient{config: c.config}).Query()
+// QueryLines queries the lines edge of a Carton.
+func (c *CartonClient) QueryLines(_m *Carton) *CartonLineQuery {
+	query := (&CartonLineClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(location.Table, location.FieldID, id),
-			sqlgraph.To(bin.Table, bin.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, location.BinsTable, location.BinsColumn),
+			sqlgraph.From(carton.Table, carton.FieldID, id),
+			sqlgraph.To(cartonline.Table, cartonline.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, carton.LinesTable, carton.LinesColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -980,15 +1074,15 @@ func (c *LocationClient) QueryBins(_m *Location) *BinQuery {
 	return query
 }
 
-// QueryWarehouseLink queries the warehouse_link edge of a Location.
-func (c *LocationClient) QueryWarehouseLink(_m *Location) *WarehouseLocationQuery {
-	query := (&WarehouseLocationClient{config: c.config}).Query()
+// QueryTracking queries the tracking edge of a Carton.
+func (c *CartonClient) QueryTracking(_m *Carton) *TrackingQuery {
+	query := (&TrackingClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(location.Table, location.FieldID, id),
-			sqlgraph.To(warehouselocation.Table, warehouselocation.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, location.WarehouseLinkTable, location.WarehouseLinkColumn),
+			sqlgraph.From(carton.Table, carton.FieldID, id),
+			sqlgraph.To(tracking.Table, tracking.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, carton.TrackingTable, carton.TrackingColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -997,131 +1091,131 @@ func (c *LocationClient) QueryWarehouseLink(_m *Location) *WarehouseLocationQuer
 }
 
 // Hooks returns the client hooks.
-func (c *LocationClient) Hooks() []Hook {
-	return c.hooks.Location
+func (c *CartonClient) Hooks() []Hook {
+	return c.hooks.Carton
 }
 
 // Interceptors returns the client interceptors.
-func (c *LocationClient) Interceptors() []Interceptor {
-	return c.inters.Location
+func (c *CartonClient) Interceptors() []Interceptor {
+	return c.inters.Carton
 }
 
-func (c *LocationClient) mutate(ctx context.Context, m *LocationMutation) (Value, error) {
+func (c *CartonClient) mutate(ctx context.Context, m *CartonMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LocationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CartonCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LocationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CartonUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CartonUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LocationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CartonDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Location mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Carton mutation op: %q", m.Op())
 	}
 }
 
-// OrderClient is a client for the Order schema.
-type OrderClient struct {
+// CartonLineClient is a client for the CartonLine schema.
+type CartonLineClient struct {
 	config
 }
 
-// NewOrderClient returns a client for the Order from the given config.
-func NewOrderClient(c config) *OrderClient {
-	return &OrderClient{config: c}
+// NewCartonLineClient returns a client for the CartonLine from the given config.
+func NewCartonLineClient(c config) *CartonLineClient {
+	return &CartonLineClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `order.Hooks(f(g(h())))`.
-func (c *OrderClient) Use(hooks ...Hook) {
-	c.hooks.Order = append(c.hooks.Order, hooks...)
+// A call to `Use(f, g, h)` equals to `cartonline.Hooks(f(g(h())))`.
+func (c *CartonLineClient) Use(hooks ...Hook) {
+	c.hooks.CartonLine = append(c.hooks.CartonLine, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `order.Intercept(f(g(h())))`.
-func (c *OrderClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Order = append(c.inters.Order, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `cartonline.Intercept(f(g(h())))`.
+func (c *CartonLineClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CartonLine = append(c.inters.CartonLine, interceptors...)
 }
 
-// Create returns a builder for creating a Order entity.
-func (c *OrderClient) Create() *OrderCreate {
-	mutation := newOrderMutation(c.config, OpCreate)
-	return &OrderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CartonLine entity.
+func (c *CartonLineClient) Create() *CartonLineCreate {
+	mutation := newCartonLineMutation(c.config, OpCreate)
+	return &CartonLineCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Order entities.
-func (c *OrderClient) CreateBulk(builders ...*OrderCreate) *OrderCreateBulk {
-	return &OrderCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of CartonLine entities.
+func (c *CartonLineClient) CreateBulk(builders ...*CartonLineCreate) *CartonLineCreateBulk {
+	return &CartonLineCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *OrderClient) MapCreateBulk(slice any, setFunc func(*OrderCreate, int)) *OrderCreateBulk {
+func (c *CartonLineClient) MapCreateBulk(slice any, setFunc func(*CartonLineCreate, int)) *CartonLineCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &OrderCreateBulk{err: fmt.Errorf("calling to OrderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CartonLineCreateBulk{err: fmt.Errorf("calling to CartonLineClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*OrderCreate, rv.Len())
+	builders := make([]*CartonLineCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &OrderCreateBulk{config: c.config, builders: builders}
+	return &CartonLineCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Order.
-func (c *OrderClient) Update() *OrderUpdate {
-	mutation := newOrderMutation(c.config, OpUpdate)
-	return &OrderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for CartonLine.
+func (c *CartonLineClient) Update() *CartonLineUpdate {
+	mutation := newCartonLineMutation(c.config, OpUpdate)
+	return &CartonLineUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *OrderClient) UpdateOne(_m *Order) *OrderUpdateOne {
-	mutation := newOrderMutation(c.config, OpUpdateOne, withOrder(_m))
-	return &OrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CartonLineClient) UpdateOne(_m *CartonLine) *CartonLineUpdateOne {
+	mutation := newCartonLineMutation(c.config, OpUpdateOne, withCartonLine(_m))
+	return &CartonLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *OrderClient) UpdateOneID(id int) *OrderUpdateOne {
-	mutation := newOrderMutation(c.config, OpUpdateOne, withOrderID(id))
-	return &OrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CartonLineClient) UpdateOneID(id int) *CartonLineUpdateOne {
+	mutation := newCartonLineMutation(c.config, OpUpdateOne, withCartonLineID(id))
+	return &CartonLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Order.
-func (c *OrderClient) Delete() *OrderDelete {
-	mutation := newOrderMutation(c.config, OpDelete)
-	return &OrderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for CartonLine.
+func (c *CartonLineClient) Delete() *CartonLineDelete {
+	mutation := newCartonLineMutation(c.config, OpDelete)
+	return &CartonLineDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *OrderClient) DeleteOne(_m *Order) *OrderDeleteOne {
+func (c *CartonLineClient) DeleteOne(_m *CartonLine) *CartonLineDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *OrderClient) DeleteOneID(id int) *OrderDeleteOne {
-	builder := c.Delete().Where(order.ID(id))
+func (c *CartonLineClient) DeleteOneID(id int) *CartonLineDeleteOne {
+	builder := c.Delete().Where(cartonline.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &OrderDeleteOne{builder}
+	return &CartonLineDeleteOne{builder}
 }
 
-// Query returns a query builder for Order.
-func (c *OrderClient) Query() *OrderQuery {
-	return &OrderQuery{
+// Query returns a query builder for CartonLine.
+func (c *CartonLineClient) Query() *CartonLineQuery {
+	return &CartonLineQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeOrder},
+		ctx:    &QueryContext{Type: TypeCartonLine},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Order entity by its id.
-func (c *OrderClient) Get(ctx context.Context, id int) (*Order, error) {
-	return c.Query().Where(order.ID(id)).Only(ctx)
+// Get returns a CartonLine entity by its id.
+func (c *CartonLineClient) Get(ctx context.Context, id int) (*CartonLine, error) {
+	return c.Query().Where(cartonline.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *OrderClient) GetX(ctx context.Context, id int) *Order {
+func (c *CartonLineClient) GetX(ctx context.Context, id int) *CartonLine {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1129,15 +1223,15 @@ func (c *OrderClient) GetX(ctx context.Context, id int) *Order {
 	return obj
 }
 
-// QueryLines queries the lines edge of a Order.
-func (c *OrderClient) QueryLines(_m *Order) *OrderLineQuery {
-	query := (&OrderLineClient{config: c.config}).Query()
+// QueryCarton queries the carton edge of a CartonLine.
+func (c *CartonLineClient) QueryCarton(_m *CartonLine) *CartonQuery {
+	query := (&CartonClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(order.Table, order.FieldID, id),
-			sqlgraph.To(orderline.Table, orderline.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, order.LinesTable, order.LinesColumn),
+			sqlgraph.From(cartonline.Table, cartonline.FieldID, id),
+			sqlgraph.To(carton.Table, carton.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, cartonline.CartonTable, cartonline.CartonColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1145,31 +1239,15 @@ func (c *OrderClient) QueryLines(_m *Order) *OrderLineQuery {
 	return query
 }
 
-// QueryPicklist queries the picklist edge of a Order.
-func (c *OrderClient) QueryPicklist(_m *Order) *PickListQuery {
-	query := (&PickListClient{config: c.config}).Query()
+// QueryItem queries the item edge of a CartonLine.
+func (c *CartonLineClient) QueryItem(_m *CartonLine) *ItemQuery {
+	query := (&ItemClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(order.Table, order.FieldID, id),
-			sqlgraph.To(picklist.Table, picklist.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, order.PicklistTable, order.PicklistColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryTracking queries the tracking edge of a Order.
-func (c *OrderClient) QueryTracking(_m *Order) *TrackingQuery {
-	query := (&TrackingClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(order.Table, order.FieldID, id),
-			sqlgraph.To(tracking.Table, tracking.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, order.TrackingTable, order.TrackingColumn),
+			sqlgraph.From(cartonline.Table, cartonline.FieldID, id),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, cartonline.ItemTable, cartonline.ItemColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1178,131 +1256,131 @@ func (c *OrderClient) QueryTracking(_m *Order) *TrackingQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *OrderClient) Hooks() []Hook {
-	return c.hooks.Order
+func (c *CartonLineClient) Hooks() []Hook {
+	return c.hooks.CartonLine
 }
 
 // Interceptors returns the client interceptors.
-func (c *OrderClient) Interceptors() []Interceptor {
-	return c.inters.Order
+func (c *CartonLineClient) Interceptors() []Interceptor {
+	return c.inters.CartonLine
 }
 
-func (c *OrderClient) mutate(ctx context.Context, m *OrderMutation) (Value, error) {
+func (c *CartonLineClient) mutate(ctx context.Context, m *CartonLineMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&OrderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CartonLineCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&OrderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CartonLineUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&OrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CartonLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&OrderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CartonLineDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Order mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown CartonLine mutation op: %q", m.Op())
 	}
 }
 
-// OrderLineClient is a client for the OrderLine schema.
-type OrderLineClient struct {
+// CartonTypeClient is a client for the CartonType schema.
+type CartonTypeClient struct {
 	config
 }
 
-// NewOrderLineClient returns a client for the OrderLine from the given config.
-func NewOrderLineClient(c config) *OrderLineClient {
-	return &OrderLineClient{config: c}
+// NewCartonTypeClient returns a client for the CartonType from the given config.
+func NewCartonTypeClient(c config) *CartonTypeClient {
+	return &CartonTypeClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `orderline.Hooks(f(g(h())))`.
-func (c *OrderLineClient) Use(hooks ...Hook) {
-	c.hooks.OrderLine = append(c.hooks.OrderLine, hooks...)
+// A call to `Use(f, g, h)` equals to `cartontype.Hooks(f(g(h())))`.
+func (c *CartonTypeClient) Use(hooks ...Hook) {
+	c.hooks.CartonType = append(c.hooks.CartonType, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `orderline.Intercept(f(g(h())))`.
-func (c *OrderLineClient) Intercept(interceptors ...Interceptor) {
-	c.inters.OrderLine = append(c.inters.OrderLine, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `cartontype.Intercept(f(g(h())))`.
+func (c *CartonTypeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CartonType = append(c.inters.CartonType, interceptors...)
 }
 
-// Create returns a builder for creating a OrderLine entity.
-func (c *OrderLineClient) Create() *OrderLineCreate {
-	mutation := newOrderLineMutation(c.config, OpCreate)
-	return &OrderLineCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CartonType entity.
+func (c *CartonTypeClient) Create() *CartonTypeCreate {
+	mutation := newCartonTypeMutation(c.config, OpCreate)
+	return &CartonTypeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of OrderLine entities.
-func (c *OrderLineClient) CreateBulk(builders ...*OrderLineCreate) *OrderLineCreateBulk {
-	return &OrderLineCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of CartonType entities.
+func (c *CartonTypeClient) CreateBulk(builders ...*CartonTypeCreate) *CartonTypeCreateBulk {
+	return &CartonTypeCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *OrderLineClient) MapCreateBulk(slice any, setFunc func(*OrderLineCreate, int)) *OrderLineCreateBulk {
+func (c *CartonTypeClient) MapCreateBulk(slice any, setFunc func(*CartonTypeCreate, int)) *CartonTypeCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &OrderLineCreateBulk{err: fmt.Errorf("calling to OrderLineClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CartonTypeCreateBulk{err: fmt.Errorf("calling to CartonTypeClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*OrderLineCreate, rv.Len())
+	builders := make([]*CartonTypeCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &OrderLineCreateBulk{config: c.config, builders: builders}
+	return &CartonTypeCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for OrderLine.
-func (c *OrderLineClient) Update() *OrderLineUpdate {
-	mutation := newOrderLineMutation(c.config, OpUpdate)
-	return &OrderLineUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for CartonType.
+func (c *CartonTypeClient) Update() *CartonTypeUpdate {
+	mutation := newCartonTypeMutation(c.config, OpUpdate)
+	return &CartonTypeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *OrderLineClient) UpdateOne(_m *OrderLine) *OrderLineUpdateOne {
-	mutation := newOrderLineMutation(c.config, OpUpdateOne, withOrderLine(_m))
-	return &OrderLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CartonTypeClient) UpdateOne(_m *CartonType) *CartonTypeUpdateOne {
+	mutation := newCartonTypeMutation(c.config, OpUpdateOne, withCartonType(_m))
+	return &CartonTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *OrderLineClient) UpdateOneID(id int) *OrderLineUpdateOne {
-	mutation := newOrderLineMutation(c.config, OpUpdateOne, withOrderLineID(id))
-	return &OrderLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CartonTypeClient) UpdateOneID(id int) *CartonTypeUpdateOne {
+	mutation := newCartonTypeMutation(c.config, OpUpdateOne, withCartonTypeID(id))
+	return &CartonTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for OrderLine.
-func (c *OrderLineClient) Delete() *OrderLineDelete {
-	mutation := newOrderLineMutation(c.config, OpDelete)
-	return &OrderLineDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for CartonType.
+func (c *CartonTypeClient) Delete() *CartonTypeDelete {
+	mutation := newCartonTypeMutation(c.config, OpDelete)
+	return &CartonTypeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *OrderLineClient) DeleteOne(_m *OrderLine) *OrderLineDeleteOne {
+func (c *CartonTypeClient) DeleteOne(_m *CartonType) *CartonTypeDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *OrderLineClient) DeleteOneID(id int) *OrderLineDeleteOne {
-	builder := c.Delete().Where(orderline.ID(id))
+func (c *CartonTypeClient) DeleteOneID(id int) *CartonTypeDeleteOne {
+	builder := c.Delete().Where(cartontype.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &OrderLineDeleteOne{builder}
+	return &CartonTypeDeleteOne{builder}
 }
 
-// Query returns a query builder for OrderLine.
-func (c *OrderLineClient) Query() *OrderLineQuery {
-	return &OrderLineQuery{
+// Query returns a query builder for CartonType.
+func (c *CartonTypeClient) Query() *CartonTypeQuery {
+	return &CartonTypeQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeOrderLine},
+		ctx:    &QueryContext{Type: TypeCartonType},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a OrderLine entity by its id.
-func (c *OrderLineClient) Get(ctx context.Context, id int) (*OrderLine, error) {
-	return c.Query().Where(orderline.ID(id)).Only(ctx)
+// Get returns a CartonType entity by its id.
+func (c *CartonTypeClient) Get(ctx context.Context, id int) (*CartonType, error) {
+	return c.Query().Where(cartontype.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *OrderLineClient) GetX(ctx context.Context, id int) *OrderLine {
+func (c *CartonTypeClient) GetX(ctx context.Context, id int) *CartonType {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1310,63 +1388,15 @@ func (c *OrderLineClient) GetX(ctx context.Context, id int) *OrderLine {
 	return obj
 }
 
-// QueryOrder queries the order edge of a OrderLine.
-func (c *OrderLineClient) QueryOrder(_m *OrderLine) *OrderQuery {
-	query := (&OrderClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(orderline.Table, orderline.FieldID, id),
-			sqlgraph.To(order.Table, order.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, orderline.OrderTable, orderline.OrderColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryItem queries the item edge of a OrderLine.
-func (c *OrderLineClient) QueryItem(_m *OrderLine) *ItemQuery {
-	query := (&ItemClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(orderline.Table, orderline.FieldID, id),
-			sqlgraph.To(item.Table, item.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, orderline.ItemTable, orderline.ItemColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryLocation queries the location edge of a OrderLine.
-func (c *OrderLineClient) QueryLocation(_m *OrderLine) *LocationQuery {
-	query := (&LocationClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(orderline.Table, orderline.FieldID, id),
-			sqlgraph.To(location.Table, location.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, orderline.LocationTable, orderline.LocationColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryPickTasks queries the pick_tasks edge of a OrderLine.
-func (c *OrderLineClient) QueryPickTasks(_m *OrderLine) *PickTaskQuery {
-	query := (&PickTaskClient{config: c.config}).Query()
+// QueryCartons queries the cartons edge of a CartonType.
+func (c *CartonTypeClient) QueryCartons(_m *CartonType) *CartonQuery {
+	query := (&CartonClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(orderline.Table, orderline.FieldID, id),
-			sqlgraph.To(picktask.Table, picktask.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, orderline.PickTasksTable, orderline.PickTasksColumn),
+			sqlgraph.From(cartontype.Table, cartontype.FieldID, id),
+			sqlgraph.To(carton.Table, carton.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, cartontype.CartonsTable, cartontype.CartonsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1375,131 +1405,131 @@ func (c *OrderLineClient) QueryPickTasks(_m *OrderLine) *PickTaskQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *OrderLineClient) Hooks() []Hook {
-	return c.hooks.OrderLine
+func (c *CartonTypeClient) Hooks() []Hook {
+	return c.hooks.CartonType
 }
 
 // Interceptors returns the client interceptors.
-func (c *OrderLineClient) Interceptors() []Interceptor {
-	return c.inters.OrderLine
+func (c *CartonTypeClient) Interceptors() []Interceptor {
+	return c.inters.CartonType
 }
 
-func (c *OrderLineClient) mutate(ctx context.Context, m *OrderLineMutation) (Value, error) {
+func (c *CartonTypeClient) mutate(ctx context.Context, m *CartonTypeMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&OrderLineCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CartonTypeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&OrderLineUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CartonTypeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&OrderLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&CartonTypeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&OrderLineDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&CartonTypeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown OrderLine mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown CartonType mutation op: %q", m.Op())
 	}
 }
 
-// PickListClient is a client for the PickList schema.
-type PickListClient struct {
+// EventRuleClient is a client for the EventRule schema.
+type EventRuleClient struct {
 	config
 }
 
-// NewPickListClient returns a client for the PickList from the given config.
-func NewPickListClient(c config) *PickListClient {
-	return &PickListClient{config: c}
+// NewEventRuleClient returns a client for the EventRule from the given config.
+func NewEventRuleClient(c config) *EventRuleClient {
+	return &EventRuleClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `picklist.Hooks(f(g(h())))`.
-func (c *PickListClient) Use(hooks ...Hook) {
-	c.hooks.PickList = append(c.hooks.PickList, hooks...)
+// A call to `Use(f, g, h)` equals to `eventrule.Hooks(f(g(h())))`.
+func (c *EventRuleClient) Use(hooks ...Hook) {
+	c.hooks.EventRule = append(c.hooks.EventRule, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `picklist.Intercept(f(g(h())))`.
-func (c *PickListClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PickList = append(c.inters.PickList, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `eventrule.Intercept(f(g(h())))`.
+func (c *EventRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EventRule = append(c.inters.EventRule, interceptors...)
 }
 
-// Create returns a builder for creating a PickList entity.
-func (c *PickListClient) Create() *PickListCreate {
-	mutation := newPickListMutation(c.config, OpCreate)
-	return &PickListCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a EventRule entity.
+func (c *EventRuleClient) Create() *EventRuleCreate {
+	mutation := newEventRuleMutation(c.config, OpCreate)
+	return &EventRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PickList entities.
-func (c *PickListClient) CreateBulk(builders ...*PickListCreate) *PickListCreateBulk {
-	return &PickListCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of EventRule entities.
+func (c *EventRuleClient) CreateBulk(builders ...*EventRuleCreate) *EventRuleCreateBulk {
+	return &EventRuleCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PickListClient) MapCreateBulk(slice any, setFunc func(*PickListCreate, int)) *PickListCreateBulk {
+func (c *EventRuleClient) MapCreateBulk(slice any, setFunc func(*EventRuleCreate, int)) *EventRuleCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PickListCreateBulk{err: fmt.Errorf("calling to PickListClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &EventRuleCreateBulk{err: fmt.Errorf("calling to EventRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PickListCreate, rv.Len())
+	builders := make([]*EventRuleCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PickListCreateBulk{config: c.config, builders: builders}
+	return &EventRuleCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PickList.
-func (c *PickListClient) Update() *PickListUpdate {
-	mutation := newPickListMutation(c.config, OpUpdate)
-	return &PickListUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for EventRule.
+func (c *EventRuleClient) Update() *EventRuleUpdate {
+	mutation := newEventRuleMutation(c.config, OpUpdate)
+	return &EventRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PickListClient) UpdateOne(_m *PickList) *PickListUpdateOne {
-	mutation := newPickListMutation(c.config, OpUpdateOne, withPickList(_m))
-	return &PickListUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EventRuleClient) UpdateOne(_m *EventRule) *EventRuleUpdateOne {
+	mutation := newEventRuleMutation(c.config, OpUpdateOne, withEventRule(_m))
+	return &EventRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PickListClient) UpdateOneID(id int) *PickListUpdateOne {
-	mutation := newPickListMutation(c.config, OpUpdateOne, withPickListID(id))
-	return &PickListUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EventRuleClient) UpdateOneID(id int) *EventRuleUpdateOne {
+	mutation := newEventRuleMutation(c.config, OpUpdateOne, withEventRuleID(id))
+	return &EventRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PickList.
-func (c *PickListClient) Delete() *PickListDelete {
-	mutation := newPickListMutation(c.config, OpDelete)
-	return &PickListDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for EventRule.
+func (c *EventRuleClient) Delete() *EventRuleDelete {
+	mutation := newEventRuleMutation(c.config, OpDelete)
+	return &EventRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PickListClient) DeleteOne(_m *PickList) *PickListDeleteOne {
+func (c *EventRuleClient) DeleteOne(_m *EventRule) *EventRuleDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PickListClient) DeleteOneID(id int) *PickListDeleteOne {
-	builder := c.Delete().Where(picklist.ID(id))
+func (c *EventRuleClient) DeleteOneID(id int) *EventRuleDeleteOne {
+	builder := c.Delete().Where(eventrule.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PickListDeleteOne{builder}
+	return &EventRuleDeleteOne{builder}
 }
 
-// Query returns a query builder for PickList.
-func (c *PickListClient) Query() *PickListQuery {
-	return &PickListQuery{
+// Query returns a query builder for EventRule.
+func (c *EventRuleClient) Query() *EventRuleQuery {
+	return &EventRuleQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePickList},
+		ctx:    &QueryContext{Type: TypeEventRule},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PickList entity by its id.
-func (c *PickListClient) Get(ctx context.Context, id int) (*PickList, error) {
-	return c.Query().Where(picklist.ID(id)).Only(ctx)
+// Get returns a EventRule entity by its id.
+func (c *EventRuleClient) Get(ctx context.Context, id int) (*EventRule, error) {
+	return c.Query().Where(eventrule.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PickListClient) GetX(ctx context.Context, id int) *PickList {
+func (c *EventRuleClient) GetX(ctx context.Context, id int) *EventRule {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1507,164 +1537,132 @@ func (c *PickListClient) GetX(ctx context.Context, id int) *PickList {
 	return obj
 }
 
-// QueryOrder queries the order edge of a PickList.
-func (c *PickListClient) QueryOrder(_m *PickList) *OrderQuery {
-	query := (&OrderClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(picklist.Table, picklist.FieldID, id),
-			sqlgraph.To(order.Table, order.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, picklist.OrderTable, picklist.OrderColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryTasks queries the tasks edge of a PickList.
-func (c *PickListClient) QueryTasks(_m *PickList) *PickTaskQuery {
-	query := (&PickTaskClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(picklist.Table, picklist.FieldID, id),
-			sqlgraph.To(picktask.Table, picktask.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, picklist.TasksTable, picklist.TasksColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *PickListClient) Hooks() []Hook {
-	return c.hooks.PickList
+func (c *EventRuleClient) Hooks() []Hook {
+	return c.hooks.EventRule
 }
 
 // Interceptors returns the client interceptors.
-func (c *PickListClient) Interceptors() []Interceptor {
-	return c.inters.PickList
+func (c *EventRuleClient) Interceptors() []Interceptor {
+	return c.inters.EventRule
 }
 
-func (c *PickListClient) mutate(ctx context.Context, m *PickListMutation) (Value, error) {
+func (c *EventRuleClient) mutate(ctx context.Context, m *EventRuleMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PickListCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EventRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PickListUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EventRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PickListUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EventRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PickListDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&EventRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PickList mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown EventRule mutation op: %q", m.Op())
 	}
 }
 
-// PickTaskClient is a client for the PickTask schema.
-type PickTaskClient struct {
+// ItemClient is a client for the Item schema.
+type ItemClient struct {
 	config
 }
 
-// NewPickTaskClient returns a client for the PickTask from the given config.
-func NewPickTaskClient(c config) *PickTaskClient {
-	return &PickTaskClient{config: c}
+// NewItemClient returns a client for the Item from the given config.
+func NewItemClient(c config) *ItemClient {
+	return &ItemClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `picktask.Hooks(f(g(h())))`.
-func (c *PickTaskClient) Use(hooks ...Hook) {
-	c.hooks.PickTask = append(c.hooks.PickTask, hooks...)
+// A call to `Use(f, g, h)` equals to `item.Hooks(f(g(h())))`.
+func (c *ItemClient) Use(hooks ...Hook) {
+	c.hooks.Item = append(c.hooks.Item, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `picktask.Intercept(f(g(h())))`.
-func (c *PickTaskClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PickTask = append(c.inters.PickTask, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `item.Intercept(f(g(h())))`.
+func (c *ItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Item = append(c.inters.Item, interceptors...)
 }
 
-// Create returns a builder for creating a PickTask entity.
-func (c *PickTaskClient) Create() *PickTaskCreate {
-	mutation := newPickTaskMutation(c.config, OpCreate)
-	return &PickTaskCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Item entity.
+func (c *ItemClient) Create() *ItemCreate {
+	mutation := newItemMutation(c.config, OpCreate)
+	return &ItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PickTask entities.
-func (c *PickTaskClient) CreateBulk(builders ...*PickTaskCreate) *PickTaskCreateBulk {
-	return &PickTaskCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Item entities.
+func (c *ItemClient) CreateBulk(builders ...*ItemCreate) *ItemCreateBulk {
+	return &ItemCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PickTaskClient) MapCreateBulk(slice any, setFunc func(*PickTaskCreate, int)) *PickTaskCreateBulk {
+func (c *ItemClient) MapCreateBulk(slice any, setFunc func(*ItemCreate, int)) *ItemCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PickTaskCreateBulk{err: fmt.Errorf("calling to PickTaskClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ItemCreateBulk{err: fmt.Errorf("calling to ItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PickTaskCreate, rv.Len())
+	builders := make([]*ItemCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PickTaskCreateBulk{config: c.config, builders: builders}
+	return &ItemCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PickTask.
-func (c *PickTaskClient) Update() *PickTaskUpdate {
-	mutation := newPickTaskMutation(c.config, OpUpdate)
-	return &PickTaskUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Item.
+func (c *ItemClient) Update() *ItemUpdate {
+	mutation := newItemMutation(c.config, OpUpdate)
+	return &ItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PickTaskClient) UpdateOne(_m *PickTask) *PickTaskUpdateOne {
-	mutation := newPickTaskMutation(c.config, OpUpdateOne, withPickTask(_m))
-	return &PickTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ItemClient) UpdateOne(_m *Item) *ItemUpdateOne {
+	mutation := newItemMutation(c.config, OpUpdateOne, withItem(_m))
+	return &ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PickTaskClient) UpdateOneID(id int) *PickTaskUpdateOne {
-	mutation := newPickTaskMutation(c.config, OpUpdateOne, withPickTaskID(id))
-	return &PickTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ItemClient) UpdateOneID(id int) *ItemUpdateOne {
+	mutation := newItemMutation(c.config, OpUpdateOne, withItemID(id))
+	return &ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PickTask.
-func (c *PickTaskClient) Delete() *PickTaskDelete {
-	mutation := newPickTaskMutation(c.config, OpDelete)
-	return &PickTaskDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Item.
+func (c *ItemClient) Delete() *ItemDelete {
+	mutation := newItemMutation(c.config, OpDelete)
+	return &ItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PickTaskClient) DeleteOne(_m *PickTask) *PickTaskDeleteOne {
+func (c *ItemClient) DeleteOne(_m *Item) *ItemDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PickTaskClient) DeleteOneID(id int) *PickTaskDeleteOne {
-	builder := c.Delete().Where(picktask.ID(id))
+func (c *ItemClient) DeleteOneID(id int) *ItemDeleteOne {
+	builder := c.Delete().Where(item.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PickTaskDeleteOne{builder}
+	return &ItemDeleteOne{builder}
 }
 
-// Query returns a query builder for PickTask.
-func (c *PickTaskClient) Query() *PickTaskQuery {
-	return &PickTaskQuery{
+// Query returns a query builder for Item.
+func (c *ItemClient) Query() *ItemQuery {
+	return &ItemQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePickTask},
+		ctx:    &QueryContext{Type: TypeItem},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PickTask entity by its id.
-func (c *PickTaskClient) Get(ctx context.Context, id int) (*PickTask, error) {
-	return c.Query().Where(picktask.ID(id)).Only(ctx)
+// Get returns a Item entity by its id.
+func (c *ItemClient) Get(ctx context.Context, id int) (*Item, error) {
+	return c.Query().Where(item.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PickTaskClient) GetX(ctx context.Context, id int) *PickTask {
+func (c *ItemClient) GetX(ctx context.Context, id int) *Item {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1672,15 +1670,15 @@ func (c *PickTaskClient) GetX(ctx context.Context, id int) *PickTask {
 	return obj
 }
 
-// QueryPicklist queries the picklist edge of a PickTask.
-func (c *PickTaskClient) QueryPicklist(_m *PickTask) *PickListQuery {
-	query := (&PickListClient{config: c.config}).Query()
+// QueryMovements queries the movements edge of a Item.
+func (c *ItemClient) QueryMovements(_m *Item) *StockMovementQuery {
+	query := (&StockMovementClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(picktask.Table, picktask.FieldID, id),
-			sqlgraph.To(picklist.Table, picklist.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, picktask.PicklistTable, picktask.PicklistColumn),
+			sqlgraph.From(item.Table, item.FieldID, id),
+			sqlgraph.To(stockmovement.Table, stockmovement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.MovementsTable, item.MovementsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1688,15 +1686,31 @@ func (c *PickTaskClient) QueryPicklist(_m *PickTask) *PickListQuery {
 	return query
 }
 
-// QueryOrderLine queries the order_line edge of a PickTask.
-func (c *PickTaskClient) QueryOrderLine(_m *PickTask) *OrderLineQuery {
+// QueryOrderLines queries the order_lines edge of a Item.
+func (c *ItemClient) QueryOrderLines(_m *Item) *OrderLineQuery {
 	query := (&OrderLineClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(picktask.Table, picktask.FieldID, id),
+			sqlgraph.From(item.Table, item.FieldID, id),
 			sqlgraph.To(orderline.Table, orderline.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, picktask.OrderLineTable, picktask.OrderLineColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.OrderLinesTable, item.OrderLinesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBins queries the bins edge of a Item.
+func (c *ItemClient) QueryBins(_m *Item) *BinQuery {
+	query := (&BinClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, id),
+			sqlgraph.To(bin.Table, bin.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, item.BinsTable, item.BinsPrimaryKey...),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1705,131 +1719,131 @@ func (c *PickTaskClient) QueryOrderLine(_m *PickTask) *OrderLineQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *PickTaskClient) Hooks() []Hook {
-	return c.hooks.PickTask
+func (c *ItemClient) Hooks() []Hook {
+	return c.hooks.Item
 }
 
 // Interceptors returns the client interceptors.
-func (c *PickTaskClient) Interceptors() []Interceptor {
-	return c.inters.PickTask
+func (c *ItemClient) Interceptors() []Interceptor {
+	return c.inters.Item
 }
 
-func (c *PickTaskClient) mutate(ctx context.Context, m *PickTaskMutation) (Value, error) {
+func (c *ItemClient) mutate(ctx context.Context, m *ItemMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PickTaskCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PickTaskUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PickTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PickTaskDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PickTask mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Item mutation op: %q", m.Op())
 	}
 }
 
-// StockMovementClient is a client for the StockMovement schema.
-type StockMovementClient struct {
+// LocationClient is a client for the Location schema.
+type LocationClient struct {
 	config
 }
 
-// NewStockMovementClient returns a client for the StockMovement from the given config.
-func NewStockMovementClient(c config) *StockMovementClient {
-	return &StockMovementClient{config: c}
+// NewLocationClient returns a client for the Location from the given config.
+func NewLocationClient(c config) *LocationClient {
+	return &LocationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `stockmovement.Hooks(f(g(h())))`.
-func (c *StockMovementClient) Use(hooks ...Hook) {
-	c.hooks.StockMovement = append(c.hooks.StockMovement, hooks...)
+// A call to `Use(f, g, h)` equals to `location.Hooks(f(g(h())))`.
+func (c *LocationClient) Use(hooks ...Hook) {
+	c.hooks.Location = append(c.hooks.Location, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `stockmovement.Intercept(f(g(h())))`.
-func (c *StockMovementClient) Intercept(interceptors ...Interceptor) {
-	c.inters.StockMovement = append(c.inters.StockMovement, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `location.Intercept(f(g(h())))`.
+func (c *LocationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Location = append(c.inters.Location, interceptors...)
 }
 
-// Create returns a builder for creating a StockMovement entity.
-func (c *StockMovementClient) Create() *StockMovementCreate {
-	mutation := newStockMovementMutation(c.config, OpCreate)
-	return &StockMovementCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Location entity.
+func (c *LocationClient) Create() *LocationCreate {
+	mutation := newLocationMutation(c.config, OpCreate)
+	return &LocationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of StockMovement entities.
-func (c *StockMovementClient) CreateBulk(builders ...*StockMovementCreate) *StockMovementCreateBulk {
-	return &StockMovementCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Location entities.
+func (c *LocationClient) CreateBulk(builders ...*LocationCreate) *LocationCreateBulk {
+	return &LocationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *StockMovementClient) MapCreateBulk(slice any, setFunc func(*StockMovementCreate, int)) *StockMovementCreateBulk {
+func (c *LocationClient) MapCreateBulk(slice any, setFunc func(*LocationCreate, int)) *LocationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &StockMovementCreateBulk{err: fmt.Errorf("calling to StockMovementClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &LocationCreateBulk{err: fmt.Errorf("calling to LocationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*StockMovementCreate, rv.Len())
+	builders := make([]*LocationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &StockMovementCreateBulk{config: c.config, builders: builders}
+	return &LocationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for StockMovement.
-func (c *StockMovementClient) Update() *StockMovementUpdate {
-	mutation := newStockMovementMutation(c.config, OpUpdate)
-	return &StockMovementUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Location.
+func (c *LocationClient) Update() *LocationUpdate {
+	mutation := newLocationMutation(c.config, OpUpdate)
+	return &LocationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *StockMovementClient) UpdateOne(_m *StockMovement) *StockMovementUpdateOne {
-	mutation := newStockMovementMutation(c.config, OpUpdateOne, withStockMovement(_m))
-	return &StockMovementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LocationClient) UpdateOne(_m *Location) *LocationUpdateOne {
+	mutation := newLocationMutation(c.config, OpUpdateOne, withLocation(_m))
+	return &LocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *StockMovementClient) UpdateOneID(id int) *StockMovementUpdateOne {
-	mutation := newStockMovementMutation(c.config, OpUpdateOne, withStockMovementID(id))
-	return &StockMovementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LocationClient) UpdateOneID(id int) *LocationUpdateOne {
+	mutation := newLocationMutation(c.config, OpUpdateOne, withLocationID(id))
+	return &LocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for StockMovement.
-func (c *StockMovementClient) Delete() *StockMovementDelete {
-	mutation := newStockMovementMutation(c.config, OpDelete)
-	return &StockMovementDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Location.
+func (c *LocationClient) Delete() *LocationDelete {
+	mutation := newLocationMutation(c.config, OpDelete)
+	return &LocationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *StockMovementClient) DeleteOne(_m *StockMovement) *StockMovementDeleteOne {
+func (c *LocationClient) DeleteOne(_m *Location) *LocationDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *StockMovementClient) DeleteOneID(id int) *StockMovementDeleteOne {
-	builder := c.Delete().Where(stockmovement.ID(id))
+func (c *LocationClient) DeleteOneID(id int) *LocationDeleteOne {
+	builder := c.Delete().Where(location.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &StockMovementDeleteOne{builder}
+	return &LocationDeleteOne{builder}
 }
 
-// Query returns a query builder for StockMovement.
-func (c *StockMovementClient) Query() *StockMovementQuery {
-	return &StockMovementQuery{
+// Query returns a query builder for Location.
+func (c *LocationClient) Query() *LocationQuery {
+	return &LocationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeStockMovement},
+		ctx:    &QueryContext{Type: TypeLocation},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a StockMovement entity by its id.
-func (c *StockMovementClient) Get(ctx context.Context, id int) (*StockMovement, error) {
-	return c.Query().Where(stockmovement.ID(id)).Only(ctx)
+// Get returns a Location entity by its id.
+func (c *LocationClient) Get(ctx context.Context, id int) (*Location, error) {
+	return c.Query().Where(location.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *StockMovementClient) GetX(ctx context.Context, id int) *StockMovement {
+func (c *LocationClient) GetX(ctx context.Context, id int) *Location {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1837,15 +1851,15 @@ func (c *StockMovementClient) GetX(ctx context.Context, id int) *StockMovement {
 	return obj
 }
 
-// QueryItem queries the item edge of a StockMovement.
-func (c *StockMovementClient) QueryItem(_m *StockMovement) *ItemQuery {
-	query := (&ItemClient{config: c.config}).Query()
+// QueryMovements queries the movements edge of a Location.
+func (c *LocationClient) QueryMovements(_m *Location) *StockMovementQuery {
+	query := (&StockMovementClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(stockmovement.Table, stockmovement.FieldID, id),
-			sqlgraph.To(item.Table, item.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, stockmovement.ItemTable, stockmovement.ItemColumn),
+			sqlgraph.From(location.Table, location.FieldID, id),
+			sqlgraph.To(stockmovement.Table, stockmovement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, location.MovementsTable, location.MovementsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1853,15 +1867,15 @@ func (c *StockMovementClient) QueryItem(_m *StockMovement) *ItemQuery {
 	return query
 }
 
-// QueryLocation queries the location edge of a StockMovement.
-func (c *StockMovementClient) QueryLocation(_m *StockMovement) *LocationQuery {
-	query := (&LocationClient{config: c.config}).Query()
+// QueryOrderLines queries the order_lines edge of a Location.
+func (c *LocationClient) QueryOrderLines(_m *Location) *OrderLineQuery {
+	query := (&OrderLineClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(stockmovement.Table, stockmovement.FieldID, id),
-			sqlgraph.To(location.Table, location.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, stockmovement.LocationTable, stockmovement.LocationColumn),
+			sqlgraph.From(location.Table, location.FieldID, id),
+			sqlgraph.To(orderline.Table, orderline.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, location.OrderLinesTable, location.OrderLinesColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1869,132 +1883,2751 @@ func (c *StockMovementClient) QueryLocation(_m *StockMovement) *LocationQuery {
 	return query
 }
 
-// Hooks returns the client hooks.
-func (c *StockMovementClient) Hooks() []Hook {
-	return c.hooks.StockMovement
-}
-
-// Interceptors returns the client interceptors.
-func (c *StockMovementClient) Interceptors() []Interceptor {
-	return c.inters.StockMovement
-}
-
-func (c *StockMovementClient) mutate(ctx context.Context, m *StockMovementMutation) (Value, error) {
-	switch m.Op() {
-	case OpCreate:
-		return (&StockMovementCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+// QueryZones queries the zones edge of a Location.
+func (c *LocationClient) QueryZones(_m *Location) *ZoneQuery {
+	query := (&ZoneClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, id),
+			sqlgraph.To(zone.Table, zone.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, location.ZonesTable, location.ZonesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBins queries the bins edge of a Location.
+func (c *LocationClient) QueryBins(_m *Location) *BinQuery {
+	query := (&BinClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, id),
+			sqlgraph.To(bin.Table, bin.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, location.BinsTable, location.BinsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWarehouseLink queries the warehouse_link edge of a Location.
+func (c *LocationClient) QueryWarehouseLink(_m *Location) *WarehouseLocationQuery {
+	query := (&WarehouseLocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, id),
+			sqlgraph.To(warehouselocation.Table, warehouselocation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, location.WarehouseLinkTable, location.WarehouseLinkColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LocationClient) Hooks() []Hook {
+	return c.hooks.Location
+}
+
+// Interceptors returns the client interceptors.
+func (c *LocationClient) Interceptors() []Interceptor {
+	return c.inters.Location
+}
+
+func (c *LocationClient) mutate(ctx context.Context, m *LocationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LocationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LocationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LocationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Location mutation op: %q", m.Op())
+	}
+}
+
+// OrderClient is a client for the Order schema.
+type OrderClient struct {
+	config
+}
+
+// NewOrderClient returns a client for the Order from the given config.
+func NewOrderClient(c config) *OrderClient {
+	return &OrderClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `order.Hooks(f(g(h())))`.
+func (c *OrderClient) Use(hooks ...Hook) {
+	c.hooks.Order = append(c.hooks.Order, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `order.Intercept(f(g(h())))`.
+func (c *OrderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Order = append(c.inters.Order, interceptors...)
+}
+
+// Create returns a builder for creating a Order entity.
+func (c *OrderClient) Create() *OrderCreate {
+	mutation := newOrderMutation(c.config, OpCreate)
+	return &OrderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Order entities.
+func (c *OrderClient) CreateBulk(builders ...*OrderCreate) *OrderCreateBulk {
+	return &OrderCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OrderClient) MapCreateBulk(slice any, setFunc func(*OrderCreate, int)) *OrderCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OrderCreateBulk{err: fmt.Errorf("calling to OrderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OrderCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OrderCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Order.
+func (c *OrderClient) Update() *OrderUpdate {
+	mutation := newOrderMutation(c.config, OpUpdate)
+	return &OrderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrderClient) UpdateOne(_m *Order) *OrderUpdateOne {
+	mutation := newOrderMutation(c.config, OpUpdateOne, withOrder(_m))
+	return &OrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrderClient) UpdateOneID(id int) *OrderUpdateOne {
+	mutation := newOrderMutation(c.config, OpUpdateOne, withOrderID(id))
+	return &OrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Order.
+func (c *OrderClient) Delete() *OrderDelete {
+	mutation := newOrderMutation(c.config, OpDelete)
+	return &OrderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OrderClient) DeleteOne(_m *Order) *OrderDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OrderClient) DeleteOneID(id int) *OrderDeleteOne {
+	builder := c.Delete().Where(order.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrderDeleteOne{builder}
+}
+
+// Query returns a query builder for Order.
+func (c *OrderClient) Query() *OrderQuery {
+	return &OrderQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOrder},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Order entity by its id.
+func (c *OrderClient) Get(ctx context.Context, id int) (*Order, error) {
+	return c.Query().Where(order.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrderClient) GetX(ctx context.Context, id int) *Order {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLines queries the lines edge of a Order.
+func (c *OrderClient) QueryLines(_m *Order) *OrderLineQuery {
+	query := (&OrderLineClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, id),
+			sqlgraph.To(orderline.Table, orderline.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, order.LinesTable, order.LinesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPicklist queries the picklist edge of a Order.
+func (c *OrderClient) QueryPicklist(_m *Order) *PickListQuery {
+	query := (&PickListClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, id),
+			sqlgraph.To(picklist.Table, picklist.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, order.PicklistTable, order.PicklistColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTracking queries the tracking edge of a Order.
+func (c *OrderClient) QueryTracking(_m *Order) *TrackingQuery {
+	query := (&TrackingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, id),
+			sqlgraph.To(tracking.Table, tracking.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, order.TrackingTable, order.TrackingColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCartons queries the cartons edge of a Order.
+func (c *OrderClient) QueryCartons(_m *Order) *CartonQuery {
+	query := (&CartonClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, id),
+			sqlgraph.To(carton.Table, carton.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, order.CartonsTable, order.CartonsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReturnOf queries the return_of edge of a Order.
+func (c *OrderClient) QueryReturnOf(_m *Order) *OrderQuery {
+	query := (&OrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, id),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, order.ReturnOfTable, order.ReturnOfColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReturns queries the returns edge of a Order.
+func (c *OrderClient) QueryReturns(_m *Order) *OrderQuery {
+	query := (&OrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, id),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, order.ReturnsTable, order.ReturnsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySourceWarehouse queries the source_warehouse edge of a Order.
+func (c *OrderClient) QuerySourceWarehouse(_m *Order) *WarehouseQuery {
+	query := (&WarehouseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, id),
+			sqlgraph.To(warehouse.Table, warehouse.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, order.SourceWarehouseTable, order.SourceWarehouseColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDestinationWarehouse queries the destination_warehouse edge of a Order.
+func (c *OrderClient) QueryDestinationWarehouse(_m *Order) *WarehouseQuery {
+	query := (&WarehouseClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, id),
+			sqlgraph.To(warehouse.Table, warehouse.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, order.DestinationWarehouseTable, order.DestinationWarehouseColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWave queries the wave edge of a Order.
+func (c *OrderClient) QueryWave(_m *Order) *WaveQuery {
+	query := (&WaveClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, id),
+			sqlgraph.To(wave.Table, wave.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, order.WaveTable, order.WaveColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OrderClient) Hooks() []Hook {
+	return c.hooks.Order
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrderClient) Interceptors() []Interceptor {
+	return c.inters.Order
+}
+
+func (c *OrderClient) mutate(ctx context.Context, m *OrderMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Order mutation op: %q", m.Op())
+	}
+}
+
+// OrderLineClient is a client for the OrderLine schema.
+type OrderLineClient struct {
+	config
+}
+
+// NewOrderLineClient returns a client for the OrderLine from the given config.
+func NewOrderLineClient(c config) *OrderLineClient {
+	return &OrderLineClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `orderline.Hooks(f(g(h())))`.
+func (c *OrderLineClient) Use(hooks ...Hook) {
+	c.hooks.OrderLine = append(c.hooks.OrderLine, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `orderline.Intercept(f(g(h())))`.
+func (c *OrderLineClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OrderLine = append(c.inters.OrderLine, interceptors...)
+}
+
+// Create returns a builder for creating a OrderLine entity.
+func (c *OrderLineClient) Create() *OrderLineCreate {
+	mutation := newOrderLineMutation(c.config, OpCreate)
+	return &OrderLineCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OrderLine entities.
+func (c *OrderLineClient) CreateBulk(builders ...*OrderLineCreate) *OrderLineCreateBulk {
+	return &OrderLineCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OrderLineClient) MapCreateBulk(slice any, setFunc func(*OrderLineCreate, int)) *OrderLineCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OrderLineCreateBulk{err: fmt.Errorf("calling to OrderLineClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OrderLineCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OrderLineCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OrderLine.
+func (c *OrderLineClient) Update() *OrderLineUpdate {
+	mutation := newOrderLineMutation(c.config, OpUpdate)
+	return &OrderLineUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrderLineClient) UpdateOne(_m *OrderLine) *OrderLineUpdateOne {
+	mutation := newOrderLineMutation(c.config, OpUpdateOne, withOrderLine(_m))
+	return &OrderLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrderLineClient) UpdateOneID(id int) *OrderLineUpdateOne {
+	mutation := newOrderLineMutation(c.config, OpUpdateOne, withOrderLineID(id))
+	return &OrderLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OrderLine.
+func (c *OrderLineClient) Delete() *OrderLineDelete {
+	mutation := newOrderLineMutation(c.config, OpDelete)
+	return &OrderLineDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OrderLineClient) DeleteOne(_m *OrderLine) *OrderLineDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OrderLineClient) DeleteOneID(id int) *OrderLineDeleteOne {
+	builder := c.Delete().Where(orderline.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrderLineDeleteOne{builder}
+}
+
+// Query returns a query builder for OrderLine.
+func (c *OrderLineClient) Query() *OrderLineQuery {
+	return &OrderLineQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOrderLine},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OrderLine entity by its id.
+func (c *OrderLineClient) Get(ctx context.Context, id int) (*OrderLine, error) {
+	return c.Query().Where(orderline.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrderLineClient) GetX(ctx context.Context, id int) *OrderLine {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrder queries the order edge of a OrderLine.
+func (c *OrderLineClient) QueryOrder(_m *OrderLine) *OrderQuery {
+	query := (&OrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderline.Table, orderline.FieldID, id),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, orderline.OrderTable, orderline.OrderColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryItem queries the item edge of a OrderLine.
+func (c *OrderLineClient) QueryItem(_m *OrderLine) *ItemQuery {
+	query := (&ItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderline.Table, orderline.FieldID, id),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, orderline.ItemTable, orderline.ItemColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLocation queries the location edge of a OrderLine.
+func (c *OrderLineClient) QueryLocation(_m *OrderLine) *LocationQuery {
+	query := (&LocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderline.Table, orderline.FieldID, id),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, orderline.LocationTable, orderline.LocationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPickTasks queries the pick_tasks edge of a OrderLine.
+func (c *OrderLineClient) QueryPickTasks(_m *OrderLine) *PickTaskQuery {
+	query := (&PickTaskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderline.Table, orderline.FieldID, id),
+			sqlgraph.To(picktask.Table, picktask.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, orderline.PickTasksTable, orderline.PickTasksColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReceipts queries the receipts edge of a OrderLine.
+func (c *OrderLineClient) QueryReceipts(_m *OrderLine) *ReceiptQuery {
+	query := (&ReceiptClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderline.Table, orderline.FieldID, id),
+			sqlgraph.To(receipt.Table, receipt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, orderline.ReceiptsTable, orderline.ReceiptsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OrderLineClient) Hooks() []Hook {
+	return c.hooks.OrderLine
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrderLineClient) Interceptors() []Interceptor {
+	return c.inters.OrderLine
+}
+
+func (c *OrderLineClient) mutate(ctx context.Context, m *OrderLineMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrderLineCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrderLineUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrderLineUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrderLineDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OrderLine mutation op: %q", m.Op())
+	}
+}
+
+// PickListClient is a client for the PickList schema.
+type PickListClient struct {
+	config
+}
+
+// NewPickListClient returns a client for the PickList from the given config.
+func NewPickListClient(c config) *PickListClient {
+	return &PickListClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `picklist.Hooks(f(g(h())))`.
+func (c *PickListClient) Use(hooks ...Hook) {
+	c.hooks.PickList = append(c.hooks.PickList, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `picklist.Intercept(f(g(h())))`.
+func (c *PickListClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PickList = append(c.inters.PickList, interceptors...)
+}
+
+// Create returns a builder for creating a PickList entity.
+func (c *PickListClient) Create() *PickListCreate {
+	mutation := newPickListMutation(c.config, OpCreate)
+	return &PickListCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PickList entities.
+func (c *PickListClient) CreateBulk(builders ...*PickListCreate) *PickListCreateBulk {
+	return &PickListCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PickListClient) MapCreateBulk(slice any, setFunc func(*PickListCreate, int)) *PickListCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PickListCreateBulk{err: fmt.Errorf("calling to PickListClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PickListCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PickListCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PickList.
+func (c *PickListClient) Update() *PickListUpdate {
+	mutation := newPickListMutation(c.config, OpUpdate)
+	return &PickListUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PickListClient) UpdateOne(_m *PickList) *PickListUpdateOne {
+	mutation := newPickListMutation(c.config, OpUpdateOne, withPickList(_m))
+	return &PickListUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PickListClient) UpdateOneID(id int) *PickListUpdateOne {
+	mutation := newPickListMutation(c.config, OpUpdateOne, withPickListID(id))
+	return &PickListUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PickList.
+func (c *PickListClient) Delete() *PickListDelete {
+	mutation := newPickListMutation(c.config, OpDelete)
+	return &PickListDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PickListClient) DeleteOne(_m *PickList) *PickListDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PickListClient) DeleteOneID(id int) *PickListDeleteOne {
+	builder := c.Delete().Where(picklist.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PickListDeleteOne{builder}
+}
+
+// Query returns a query builder for PickList.
+func (c *PickListClient) Query() *PickListQuery {
+	return &PickListQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePickList},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PickList entity by its id.
+func (c *PickListClient) Get(ctx context.Context, id int) (*PickList, error) {
+	return c.Query().Where(picklist.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PickListClient) GetX(ctx context.Context, id int) *PickList {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrder queries the order edge of a PickList.
+func (c *PickListClient) QueryOrder(_m *PickList) *OrderQuery {
+	query := (&OrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(picklist.Table, picklist.FieldID, id),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, picklist.OrderTable, picklist.OrderColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTasks queries the tasks edge of a PickList.
+func (c *PickListClient) QueryTasks(_m *PickList) *PickTaskQuery {
+	query := (&PickTaskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(picklist.Table, picklist.FieldID, id),
+			sqlgraph.To(picktask.Table, picktask.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, picklist.TasksTable, picklist.TasksColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PickListClient) Hooks() []Hook {
+	return c.hooks.PickList
+}
+
+// Interceptors returns the client interceptors.
+func (c *PickListClient) Interceptors() []Interceptor {
+	return c.inters.PickList
+}
+
+func (c *PickListClient) mutate(ctx context.Context, m *PickListMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PickListCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PickListUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PickListUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PickListDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PickList mutation op: %q", m.Op())
+	}
+}
+
+// PickTaskClient is a client for the PickTask schema.
+type PickTaskClient struct {
+	config
+}
+
+// NewPickTaskClient returns a client for the PickTask from the given config.
+func NewPickTaskClient(c config) *PickTaskClient {
+	return &PickTaskClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `picktask.Hooks(f(g(h())))`.
+func (c *PickTaskClient) Use(hooks ...Hook) {
+	c.hooks.PickTask = append(c.hooks.PickTask, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `picktask.Intercept(f(g(h())))`.
+func (c *PickTaskClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PickTask = append(c.inters.PickTask, interceptors...)
+}
+
+// Create returns a builder for creating a PickTask entity.
+func (c *PickTaskClient) Create() *PickTaskCreate {
+	mutation := newPickTaskMutation(c.config, OpCreate)
+	return &PickTaskCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PickTask entities.
+func (c *PickTaskClient) CreateBulk(builders ...*PickTaskCreate) *PickTaskCreateBulk {
+	return &PickTaskCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PickTaskClient) MapCreateBulk(slice any, setFunc func(*PickTaskCreate, int)) *PickTaskCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PickTaskCreateBulk{err: fmt.Errorf("calling to PickTaskClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PickTaskCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PickTaskCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PickTask.
+func (c *PickTaskClient) Update() *PickTaskUpdate {
+	mutation := newPickTaskMutation(c.config, OpUpdate)
+	return &PickTaskUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PickTaskClient) UpdateOne(_m *PickTask) *PickTaskUpdateOne {
+	mutation := newPickTaskMutation(c.config, OpUpdateOne, withPickTask(_m))
+	return &PickTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PickTaskClient) UpdateOneID(id int) *PickTaskUpdateOne {
+	mutation := newPickTaskMutation(c.config, OpUpdateOne, withPickTaskID(id))
+	return &PickTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PickTask.
+func (c *PickTaskClient) Delete() *PickTaskDelete {
+	mutation := newPickTaskMutation(c.config, OpDelete)
+	return &PickTaskDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PickTaskClient) DeleteOne(_m *PickTask) *PickTaskDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PickTaskClient) DeleteOneID(id int) *PickTaskDeleteOne {
+	builder := c.Delete().Where(picktask.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PickTaskDeleteOne{builder}
+}
+
+// Query returns a query builder for PickTask.
+func (c *PickTaskClient) Query() *PickTaskQuery {
+	return &PickTaskQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePickTask},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PickTask entity by its id.
+func (c *PickTaskClient) Get(ctx context.Context, id int) (*PickTask, error) {
+	return c.Query().Where(picktask.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PickTaskClient) GetX(ctx context.Context, id int) *PickTask {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPicklist queries the picklist edge of a PickTask.
+func (c *PickTaskClient) QueryPicklist(_m *PickTask) *PickListQuery {
+	query := (&PickListClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(picktask.Table, picktask.FieldID, id),
+			sqlgraph.To(picklist.Table, picklist.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, picktask.PicklistTable, picktask.PicklistColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrderLine queries the order_line edge of a PickTask.
+func (c *PickTaskClient) QueryOrderLine(_m *PickTask) *OrderLineQuery {
+	query := (&OrderLineClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(picktask.Table, picktask.FieldID, id),
+			sqlgraph.To(orderline.Table, orderline.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, picktask.OrderLineTable, picktask.OrderLineColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PickTaskClient) Hooks() []Hook {
+	return c.hooks.PickTask
+}
+
+// Interceptors returns the client interceptors.
+func (c *PickTaskClient) Interceptors() []Interceptor {
+	return c.inters.PickTask
+}
+
+func (c *PickTaskClient) mutate(ctx context.Context, m *PickTaskMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PickTaskCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PickTaskUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PickTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PickTaskDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PickTask mutation op: %q", m.Op())
+	}
+}
+
+// PutawayTaskClient is a client for the PutawayTask schema.
+type PutawayTaskClient struct {
+	config
+}
+
+// NewPutawayTaskClient returns a client for the PutawayTask from the given config.
+func NewPutawayTaskClient(c config) *PutawayTaskClient {
+	return &PutawayTaskClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `putawaytask.Hooks(f(g(h())))`.
+func (c *PutawayTaskClient) Use(hooks ...Hook) {
+	c.hooks.PutawayTask = append(c.hooks.PutawayTask, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `putawaytask.Intercept(f(g(h())))`.
+func (c *PutawayTaskClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PutawayTask = append(c.inters.PutawayTask, interceptors...)
+}
+
+// Create returns a builder for creating a PutawayTask entity.
+func (c *PutawayTaskClient) Create() *PutawayTaskCreate {
+	mutation := newPutawayTaskMutation(c.config, OpCreate)
+	return &PutawayTaskCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PutawayTask entities.
+func (c *PutawayTaskClient) CreateBulk(builders ...*PutawayTaskCreate) *PutawayTaskCreateBulk {
+	return &PutawayTaskCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PutawayTaskClient) MapCreateBulk(slice any, setFunc func(*PutawayTaskCreate, int)) *PutawayTaskCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PutawayTaskCreateBulk{err: fmt.Errorf("calling to PutawayTaskClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PutawayTaskCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PutawayTaskCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PutawayTask.
+func (c *PutawayTaskClient) Update() *PutawayTaskUpdate {
+	mutation := newPutawayTaskMutation(c.config, OpUpdate)
+	return &PutawayTaskUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PutawayTaskClient) UpdateOne(_m *PutawayTask) *PutawayTaskUpdateOne {
+	mutation := newPutawayTaskMutation(c.config, OpUpdateOne, withPutawayTask(_m))
+	return &PutawayTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PutawayTaskClient) UpdateOneID(id int) *PutawayTaskUpdateOne {
+	mutation := newPutawayTaskMutation(c.config, OpUpdateOne, withPutawayTaskID(id))
+	return &PutawayTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PutawayTask.
+func (c *PutawayTaskClient) Delete() *PutawayTaskDelete {
+	mutation := newPutawayTaskMutation(c.config, OpDelete)
+	return &PutawayTaskDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PutawayTaskClient) DeleteOne(_m *PutawayTask) *PutawayTaskDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PutawayTaskClient) DeleteOneID(id int) *PutawayTaskDeleteOne {
+	builder := c.Delete().Where(putawaytask.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PutawayTaskDeleteOne{builder}
+}
+
+// Query returns a query builder for PutawayTask.
+func (c *PutawayTaskClient) Query() *PutawayTaskQuery {
+	return &PutawayTaskQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePutawayTask},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PutawayTask entity by its id.
+func (c *PutawayTaskClient) Get(ctx context.Context, id int) (*PutawayTask, error) {
+	return c.Query().Where(putawaytask.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PutawayTaskClient) GetX(ctx context.Context, id int) *PutawayTask {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryItem queries the item edge of a PutawayTask.
+func (c *PutawayTaskClient) QueryItem(_m *PutawayTask) *ItemQuery {
+	query := (&ItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(putawaytask.Table, putawaytask.FieldID, id),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, putawaytask.ItemTable, putawaytask.ItemColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLocation queries the location edge of a PutawayTask.
+func (c *PutawayTaskClient) QueryLocation(_m *PutawayTask) *LocationQuery {
+	query := (&LocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(putawaytask.Table, putawaytask.FieldID, id),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, putawaytask.LocationTable, putawaytask.LocationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PutawayTaskClient) Hooks() []Hook {
+	return c.hooks.PutawayTask
+}
+
+// Interceptors returns the client interceptors.
+func (c *PutawayTaskClient) Interceptors() []Interceptor {
+	return c.inters.PutawayTask
+}
+
+func (c *PutawayTaskClient) mutate(ctx context.Context, m *PutawayTaskMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PutawayTaskCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PutawayTaskUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PutawayTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PutawayTaskDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PutawayTask mutation op: %q", m.Op())
+	}
+}
+
+// ReceiptClient is a client for the Receipt schema.
+type ReceiptClient struct {
+	config
+}
+
+// NewReceiptClient returns a client for the Receipt from the given config.
+func NewReceiptClient(c config) *ReceiptClient {
+	return &ReceiptClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `receipt.Hooks(f(g(h())))`.
+func (c *ReceiptClient) Use(hooks ...Hook) {
+	c.hooks.Receipt = append(c.hooks.Receipt, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `receipt.Intercept(f(g(h())))`.
+func (c *ReceiptClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Receipt = append(c.inters.Receipt, interceptors...)
+}
+
+// Create returns a builder for creating a Receipt entity.
+func (c *ReceiptClient) Create() *ReceiptCreate {
+	mutation := newReceiptMutation(c.config, OpCreate)
+	return &ReceiptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Receipt entities.
+func (c *ReceiptClient) CreateBulk(builders ...*ReceiptCreate) *ReceiptCreateBulk {
+	return &ReceiptCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReceiptClient) MapCreateBulk(slice any, setFunc func(*ReceiptCreate, int)) *ReceiptCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReceiptCreateBulk{err: fmt.Errorf("calling to ReceiptClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReceiptCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReceiptCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Receipt.
+func (c *ReceiptClient) Update() *ReceiptUpdate {
+	mutation := newReceiptMutation(c.config, OpUpdate)
+	return &ReceiptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReceiptClient) UpdateOne(_m *Receipt) *ReceiptUpdateOne {
+	mutation := newReceiptMutation(c.config, OpUpdateOne, withReceipt(_m))
+	return &ReceiptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReceiptClient) UpdateOneID(id int) *ReceiptUpdateOne {
+	mutation := newReceiptMutation(c.config, OpUpdateOne, withReceiptID(id))
+	return &ReceiptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Receipt.
+func (c *ReceiptClient) Delete() *ReceiptDelete {
+	mutation := newReceiptMutation(c.config, OpDelete)
+	return &ReceiptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReceiptClient) DeleteOne(_m *Receipt) *ReceiptDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReceiptClient) DeleteOneID(id int) *ReceiptDeleteOne {
+	builder := c.Delete().Where(receipt.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReceiptDeleteOne{builder}
+}
+
+// Query returns a query builder for Receipt.
+func (c *ReceiptClient) Query() *ReceiptQuery {
+	return &ReceiptQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReceipt},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Receipt entity by its id.
+func (c *ReceiptClient) Get(ctx context.Context, id int) (*Receipt, error) {
+	return c.Query().Where(receipt.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReceiptClient) GetX(ctx context.Context, id int) *Receipt {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrderLine queries the order_line edge of a Receipt.
+func (c *ReceiptClient) QueryOrderLine(_m *Receipt) *OrderLineQuery {
+	query := (&OrderLineClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(receipt.Table, receipt.FieldID, id),
+			sqlgraph.To(orderline.Table, orderline.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, receipt.OrderLineTable, receipt.OrderLineColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ReceiptClient) Hooks() []Hook {
+	return c.hooks.Receipt
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReceiptClient) Interceptors() []Interceptor {
+	return c.inters.Receipt
+}
+
+func (c *ReceiptClient) mutate(ctx context.Context, m *ReceiptMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReceiptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReceiptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReceiptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReceiptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Receipt mutation op: %q", m.Op())
+	}
+}
+
+// ReorderPolicyClient is a client for the ReorderPolicy schema.
+type ReorderPolicyClient struct {
+	config
+}
+
+// NewReorderPolicyClient returns a client for the ReorderPolicy from the given config.
+func NewReorderPolicyClient(c config) *ReorderPolicyClient {
+	return &ReorderPolicyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `reorderpolicy.Hooks(f(g(h())))`.
+func (c *ReorderPolicyClient) Use(hooks ...Hook) {
+	c.hooks.ReorderPolicy = append(c.hooks.ReorderPolicy, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `reorderpolicy.Intercept(f(g(h())))`.
+func (c *ReorderPolicyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReorderPolicy = append(c.inters.ReorderPolicy, interceptors...)
+}
+
+// Create returns a builder for creating a ReorderPolicy entity.
+func (c *ReorderPolicyClient) Create() *ReorderPolicyCreate {
+	mutation := newReorderPolicyMutation(c.config, OpCreate)
+	return &ReorderPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ReorderPolicy entities.
+func (c *ReorderPolicyClient) CreateBulk(builders ...*ReorderPolicyCreate) *ReorderPolicyCreateBulk {
+	return &ReorderPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReorderPolicyClient) MapCreateBulk(slice any, setFunc func(*ReorderPolicyCreate, int)) *ReorderPolicyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReorderPolicyCreateBulk{err: fmt.Errorf("calling to ReorderPolicyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReorderPolicyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReorderPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ReorderPolicy.
+func (c *ReorderPolicyClient) Update() *ReorderPolicyUpdate {
+	mutation := newReorderPolicyMutation(c.config, OpUpdate)
+	return &ReorderPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReorderPolicyClient) UpdateOne(_m *ReorderPolicy) *ReorderPolicyUpdateOne {
+	mutation := newReorderPolicyMutation(c.config, OpUpdateOne, withReorderPolicy(_m))
+	return &ReorderPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReorderPolicyClient) UpdateOneID(id int) *ReorderPolicyUpdateOne {
+	mutation := newReorderPolicyMutation(c.config, OpUpdateOne, withReorderPolicyID(id))
+	return &ReorderPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ReorderPolicy.
+func (c *ReorderPolicyClient) Delete() *ReorderPolicyDelete {
+	mutation := newReorderPolicyMutation(c.config, OpDelete)
+	return &ReorderPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReorderPolicyClient) DeleteOne(_m *ReorderPolicy) *ReorderPolicyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReorderPolicyClient) DeleteOneID(id int) *ReorderPolicyDeleteOne {
+	builder := c.Delete().Where(reorderpolicy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReorderPolicyDeleteOne{builder}
+}
+
+// Query returns a query builder for ReorderPolicy.
+func (c *ReorderPolicyClient) Query() *ReorderPolicyQuery {
+	return &ReorderPolicyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReorderPolicy},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ReorderPolicy entity by its id.
+func (c *ReorderPolicyClient) Get(ctx context.Context, id int) (*ReorderPolicy, error) {
+	return c.Query().Where(reorderpolicy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReorderPolicyClient) GetX(ctx context.Context, id int) *ReorderPolicy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryItem queries the item edge of a ReorderPolicy.
+func (c *ReorderPolicyClient) QueryItem(_m *ReorderPolicy) *ItemQuery {
+	query := (&ItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reorderpolicy.Table, reorderpolicy.FieldID, id),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, reorderpolicy.ItemTable, reorderpolicy.ItemColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLocation queries the location edge of a ReorderPolicy.
+func (c *ReorderPolicyClient) QueryLocation(_m *ReorderPolicy) *LocationQuery {
+	query := (&LocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(reorderpolicy.Table, reorderpolicy.FieldID, id),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, reorderpolicy.LocationTable, reorderpolicy.LocationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ReorderPolicyClient) Hooks() []Hook {
+	return c.hooks.ReorderPolicy
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReorderPolicyClient) Interceptors() []Interceptor {
+	return c.inters.ReorderPolicy
+}
+
+func (c *ReorderPolicyClient) mutate(ctx context.Context, m *ReorderPolicyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReorderPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReorderPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReorderPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReorderPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ReorderPolicy mutation op: %q", m.Op())
+	}
+}
+
+// ReplenishmentRuleClient is a client for the ReplenishmentRule schema.
+type ReplenishmentRuleClient struct {
+	config
+}
+
+// NewReplenishmentRuleClient returns a client for the ReplenishmentRule from the given config.
+func NewReplenishmentRuleClient(c config) *ReplenishmentRuleClient {
+	return &ReplenishmentRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `replenishmentrule.Hooks(f(g(h())))`.
+func (c *ReplenishmentRuleClient) Use(hooks ...Hook) {
+	c.hooks.ReplenishmentRule = append(c.hooks.ReplenishmentRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `replenishmentrule.Intercept(f(g(h())))`.
+func (c *ReplenishmentRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReplenishmentRule = append(c.inters.ReplenishmentRule, interceptors...)
+}
+
+// Create returns a builder for creating a ReplenishmentRule entity.
+func (c *ReplenishmentRuleClient) Create() *ReplenishmentRuleCreate {
+	mutation := newReplenishmentRuleMutation(c.config, OpCreate)
+	return &ReplenishmentRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ReplenishmentRule entities.
+func (c *ReplenishmentRuleClient) CreateBulk(builders ...*ReplenishmentRuleCreate) *ReplenishmentRuleCreateBulk {
+	return &ReplenishmentRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReplenishmentRuleClient) MapCreateBulk(slice any, setFunc func(*ReplenishmentRuleCreate, int)) *ReplenishmentRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReplenishmentRuleCreateBulk{err: fmt.Errorf("calling to ReplenishmentRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReplenishmentRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReplenishmentRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ReplenishmentRule.
+func (c *ReplenishmentRuleClient) Update() *ReplenishmentRuleUpdate {
+	mutation := newReplenishmentRuleMutation(c.config, OpUpdate)
+	return &ReplenishmentRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReplenishmentRuleClient) UpdateOne(_m *ReplenishmentRule) *ReplenishmentRuleUpdateOne {
+	mutation := newReplenishmentRuleMutation(c.config, OpUpdateOne, withReplenishmentRule(_m))
+	return &ReplenishmentRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReplenishmentRuleClient) UpdateOneID(id int) *ReplenishmentRuleUpdateOne {
+	mutation := newReplenishmentRuleMutation(c.config, OpUpdateOne, withReplenishmentRuleID(id))
+	return &ReplenishmentRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ReplenishmentRule.
+func (c *ReplenishmentRuleClient) Delete() *ReplenishmentRuleDelete {
+	mutation := newReplenishmentRuleMutation(c.config, OpDelete)
+	return &ReplenishmentRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReplenishmentRuleClient) DeleteOne(_m *ReplenishmentRule) *ReplenishmentRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReplenishmentRuleClient) DeleteOneID(id int) *ReplenishmentRuleDeleteOne {
+	builder := c.Delete().Where(replenishmentrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReplenishmentRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for ReplenishmentRule.
+func (c *ReplenishmentRuleClient) Query() *ReplenishmentRuleQuery {
+	return &ReplenishmentRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReplenishmentRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ReplenishmentRule entity by its id.
+func (c *ReplenishmentRuleClient) Get(ctx context.Context, id int) (*ReplenishmentRule, error) {
+	return c.Query().Where(replenishmentrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReplenishmentRuleClient) GetX(ctx context.Context, id int) *ReplenishmentRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryBin queries the bin edge of a ReplenishmentRule.
+func (c *ReplenishmentRuleClient) QueryBin(_m *ReplenishmentRule) *BinQuery {
+	query := (&BinClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(replenishmentrule.Table, replenishmentrule.FieldID, id),
+			sqlgraph.To(bin.Table, bin.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, replenishmentrule.BinTable, replenishmentrule.BinColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryItem queries the item edge of a ReplenishmentRule.
+func (c *ReplenishmentRuleClient) QueryItem(_m *ReplenishmentRule) *ItemQuery {
+	query := (&ItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(replenishmentrule.Table, replenishmentrule.FieldID, id),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, replenishmentrule.ItemTable, replenishmentrule.ItemColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ReplenishmentRuleClient) Hooks() []Hook {
+	return c.hooks.ReplenishmentRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReplenishmentRuleClient) Interceptors() []Interceptor {
+	return c.inters.ReplenishmentRule
+}
+
+func (c *ReplenishmentRuleClient) mutate(ctx context.Context, m *ReplenishmentRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReplenishmentRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReplenishmentRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReplenishmentRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReplenishmentRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ReplenishmentRule mutation op: %q", m.Op())
+	}
+}
+
+// ReplenishmentTaskClient is a client for the ReplenishmentTask schema.
+type ReplenishmentTaskClient struct {
+	config
+}
+
+// NewReplenishmentTaskClient returns a client for the ReplenishmentTask from the given config.
+func NewReplenishmentTaskClient(c config) *ReplenishmentTaskClient {
+	return &ReplenishmentTaskClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `replenishmenttask.Hooks(f(g(h())))`.
+func (c *ReplenishmentTaskClient) Use(hooks ...Hook) {
+	c.hooks.ReplenishmentTask = append(c.hooks.ReplenishmentTask, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `replenishmenttask.Intercept(f(g(h())))`.
+func (c *ReplenishmentTaskClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReplenishmentTask = append(c.inters.ReplenishmentTask, interceptors...)
+}
+
+// Create returns a builder for creating a ReplenishmentTask entity.
+func (c *ReplenishmentTaskClient) Create() *ReplenishmentTaskCreate {
+	mutation := newReplenishmentTaskMutation(c.config, OpCreate)
+	return &ReplenishmentTaskCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ReplenishmentTask entities.
+func (c *ReplenishmentTaskClient) CreateBulk(builders ...*ReplenishmentTaskCreate) *ReplenishmentTaskCreateBulk {
+	return &ReplenishmentTaskCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReplenishmentTaskClient) MapCreateBulk(slice any, setFunc func(*ReplenishmentTaskCreate, int)) *ReplenishmentTaskCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReplenishmentTaskCreateBulk{err: fmt.Errorf("calling to ReplenishmentTaskClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReplenishmentTaskCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReplenishmentTaskCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ReplenishmentTask.
+func (c *ReplenishmentTaskClient) Update() *ReplenishmentTaskUpdate {
+	mutation := newReplenishmentTaskMutation(c.config, OpUpdate)
+	return &ReplenishmentTaskUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReplenishmentTaskClient) UpdateOne(_m *ReplenishmentTask) *ReplenishmentTaskUpdateOne {
+	mutation := newReplenishmentTaskMutation(c.config, OpUpdateOne, withReplenishmentTask(_m))
+	return &ReplenishmentTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReplenishmentTaskClient) UpdateOneID(id int) *ReplenishmentTaskUpdateOne {
+	mutation := newReplenishmentTaskMutation(c.config, OpUpdateOne, withReplenishmentTaskID(id))
+	return &ReplenishmentTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ReplenishmentTask.
+func (c *ReplenishmentTaskClient) Delete() *ReplenishmentTaskDelete {
+	mutation := newReplenishmentTaskMutation(c.config, OpDelete)
+	return &ReplenishmentTaskDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReplenishmentTaskClient) DeleteOne(_m *ReplenishmentTask) *ReplenishmentTaskDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReplenishmentTaskClient) DeleteOneID(id int) *ReplenishmentTaskDeleteOne {
+	builder := c.Delete().Where(replenishmenttask.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReplenishmentTaskDeleteOne{builder}
+}
+
+// Query returns a query builder for ReplenishmentTask.
+func (c *ReplenishmentTaskClient) Query() *ReplenishmentTaskQuery {
+	return &ReplenishmentTaskQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReplenishmentTask},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ReplenishmentTask entity by its id.
+func (c *ReplenishmentTaskClient) Get(ctx context.Context, id int) (*ReplenishmentTask, error) {
+	return c.Query().Where(replenishmenttask.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReplenishmentTaskClient) GetX(ctx context.Context, id int) *ReplenishmentTask {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryItem queries the item edge of a ReplenishmentTask.
+func (c *ReplenishmentTaskClient) QueryItem(_m *ReplenishmentTask) *ItemQuery {
+	query := (&ItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(replenishmenttask.Table, replenishmenttask.FieldID, id),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, replenishmenttask.ItemTable, replenishmenttask.ItemColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFromBin queries the from_bin edge of a ReplenishmentTask.
+func (c *ReplenishmentTaskClient) QueryFromBin(_m *ReplenishmentTask) *BinQuery {
+	query := (&BinClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(replenishmenttask.Table, replenishmenttask.FieldID, id),
+			sqlgraph.To(bin.Table, bin.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, replenishmenttask.FromBinTable, replenishmenttask.FromBinColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryToBin queries the to_bin edge of a ReplenishmentTask.
+func (c *ReplenishmentTaskClient) QueryToBin(_m *ReplenishmentTask) *BinQuery {
+	query := (&BinClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(replenishmenttask.Table, replenishmenttask.FieldID, id),
+			sqlgraph.To(bin.Table, bin.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, replenishmenttask.ToBinTable, replenishmenttask.ToBinColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ReplenishmentTaskClient) Hooks() []Hook {
+	return c.hooks.ReplenishmentTask
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReplenishmentTaskClient) Interceptors() []Interceptor {
+	return c.inters.ReplenishmentTask
+}
+
+func (c *ReplenishmentTaskClient) mutate(ctx context.Context, m *ReplenishmentTaskMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReplenishmentTaskCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReplenishmentTaskUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReplenishmentTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReplenishmentTaskDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ReplenishmentTask mutation op: %q", m.Op())
+	}
+}
+
+// SequenceClient is a client for the Sequence schema.
+type SequenceClient struct {
+	config
+}
+
+// NewSequenceClient returns a client for the Sequence from the given config.
+func NewSequenceClient(c config) *SequenceClient {
+	return &SequenceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `sequence.Hooks(f(g(h())))`.
+func (c *SequenceClient) Use(hooks ...Hook) {
+	c.hooks.Sequence = append(c.hooks.Sequence, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `sequence.Intercept(f(g(h())))`.
+func (c *SequenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Sequence = append(c.inters.Sequence, interceptors...)
+}
+
+// Create returns a builder for creating a Sequence entity.
+func (c *SequenceClient) Create() *SequenceCreate {
+	mutation := newSequenceMutation(c.config, OpCreate)
+	return &SequenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Sequence entities.
+func (c *SequenceClient) CreateBulk(builders ...*SequenceCreate) *SequenceCreateBulk {
+	return &SequenceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SequenceClient) MapCreateBulk(slice any, setFunc func(*SequenceCreate, int)) *SequenceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SequenceCreateBulk{err: fmt.Errorf("calling to SequenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SequenceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SequenceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Sequence.
+func (c *SequenceClient) Update() *SequenceUpdate {
+	mutation := newSequenceMutation(c.config, OpUpdate)
+	return &SequenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SequenceClient) UpdateOne(_m *Sequence) *SequenceUpdateOne {
+	mutation := newSequenceMutation(c.config, OpUpdateOne, withSequence(_m))
+	return &SequenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SequenceClient) UpdateOneID(id int) *SequenceUpdateOne {
+	mutation := newSequenceMutation(c.config, OpUpdateOne, withSequenceID(id))
+	return &SequenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Sequence.
+func (c *SequenceClient) Delete() *SequenceDelete {
+	mutation := newSequenceMutation(c.config, OpDelete)
+	return &SequenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SequenceClient) DeleteOne(_m *Sequence) *SequenceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SequenceClient) DeleteOneID(id int) *SequenceDeleteOne {
+	builder := c.Delete().Where(sequence.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SequenceDeleteOne{builder}
+}
+
+// Query returns a query builder for Sequence.
+func (c *SequenceClient) Query() *SequenceQuery {
+	return &SequenceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSequence},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Sequence entity by its id.
+func (c *SequenceClient) Get(ctx context.Context, id int) (*Sequence, error) {
+	return c.Query().Where(sequence.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SequenceClient) GetX(ctx context.Context, id int) *Sequence {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SequenceClient) Hooks() []Hook {
+	return c.hooks.Sequence
+}
+
+// Interceptors returns the client interceptors.
+func (c *SequenceClient) Interceptors() []Interceptor {
+	return c.inters.Sequence
+}
+
+func (c *SequenceClient) mutate(ctx context.Context, m *SequenceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SequenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SequenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SequenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SequenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Sequence mutation op: %q", m.Op())
+	}
+}
+
+// StockDiscrepancyClient is a client for the StockDiscrepancy schema.
+type StockDiscrepancyClient struct {
+	config
+}
+
+// NewStockDiscrepancyClient returns a client for the StockDiscrepancy from the given config.
+func NewStockDiscrepancyClient(c config) *StockDiscrepancyClient {
+	return &StockDiscrepancyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `stockdiscrepancy.Hooks(f(g(h())))`.
+func (c *StockDiscrepancyClient) Use(hooks ...Hook) {
+	c.hooks.StockDiscrepancy = append(c.hooks.StockDiscrepancy, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `stockdiscrepancy.Intercept(f(g(h())))`.
+func (c *StockDiscrepancyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StockDiscrepancy = append(c.inters.StockDiscrepancy, interceptors...)
+}
+
+// Create returns a builder for creating a StockDiscrepancy entity.
+func (c *StockDiscrepancyClient) Create() *StockDiscrepancyCreate {
+	mutation := newStockDiscrepancyMutation(c.config, OpCreate)
+	return &StockDiscrepancyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of StockDiscrepancy entities.
+func (c *StockDiscrepancyClient) CreateBulk(builders ...*StockDiscrepancyCreate) *StockDiscrepancyCreateBulk {
+	return &StockDiscrepancyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *StockDiscrepancyClient) MapCreateBulk(slice any, setFunc func(*StockDiscrepancyCreate, int)) *StockDiscrepancyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &StockDiscrepancyCreateBulk{err: fmt.Errorf("calling to StockDiscrepancyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*StockDiscrepancyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &StockDiscrepancyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for StockDiscrepancy.
+func (c *StockDiscrepancyClient) Update() *StockDiscrepancyUpdate {
+	mutation := newStockDiscrepancyMutation(c.config, OpUpdate)
+	return &StockDiscrepancyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StockDiscrepancyClient) UpdateOne(_m *StockDiscrepancy) *StockDiscrepancyUpdateOne {
+	mutation := newStockDiscrepancyMutation(c.config, OpUpdateOne, withStockDiscrepancy(_m))
+	return &StockDiscrepancyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StockDiscrepancyClient) UpdateOneID(id int) *StockDiscrepancyUpdateOne {
+	mutation := newStockDiscrepancyMutation(c.config, OpUpdateOne, withStockDiscrepancyID(id))
+	return &StockDiscrepancyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for StockDiscrepancy.
+func (c *StockDiscrepancyClient) Delete() *StockDiscrepancyDelete {
+	mutation := newStockDiscrepancyMutation(c.config, OpDelete)
+	return &StockDiscrepancyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StockDiscrepancyClient) DeleteOne(_m *StockDiscrepancy) *StockDiscrepancyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *StockDiscrepancyClient) DeleteOneID(id int) *StockDiscrepancyDeleteOne {
+	builder := c.Delete().Where(stockdiscrepancy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StockDiscrepancyDeleteOne{builder}
+}
+
+// Query returns a query builder for StockDiscrepancy.
+func (c *StockDiscrepancyClient) Query() *StockDiscrepancyQuery {
+	return &StockDiscrepancyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeStockDiscrepancy},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a StockDiscrepancy entity by its id.
+func (c *StockDiscrepancyClient) Get(ctx context.Context, id int) (*StockDiscrepancy, error) {
+	return c.Query().Where(stockdiscrepancy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StockDiscrepancyClient) GetX(ctx context.Context, id int) *StockDiscrepancy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryItem queries the item edge of a StockDiscrepancy.
+func (c *StockDiscrepancyClient) QueryItem(_m *StockDiscrepancy) *ItemQuery {
+	query := (&ItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(stockdiscrepancy.Table, stockdiscrepancy.FieldID, id),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, stockdiscrepancy.ItemTable, stockdiscrepancy.ItemColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLocation queries the location edge of a StockDiscrepancy.
+func (c *StockDiscrepancyClient) QueryLocation(_m *StockDiscrepancy) *LocationQuery {
+	query := (&LocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(stockdiscrepancy.Table, stockdiscrepancy.FieldID, id),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, stockdiscrepancy.LocationTable, stockdiscrepancy.LocationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *StockDiscrepancyClient) Hooks() []Hook {
+	return c.hooks.StockDiscrepancy
+}
+
+// Interceptors returns the client interceptors.
+func (c *StockDiscrepancyClient) Interceptors() []Interceptor {
+	return c.inters.StockDiscrepancy
+}
+
+func (c *StockDiscrepancyClient) mutate(ctx context.Context, m *StockDiscrepancyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&StockDiscrepancyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&StockDiscrepancyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&StockDiscrepancyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&StockDiscrepancyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown StockDiscrepancy mutation op: %q", m.Op())
+	}
+}
+
+// StockMovementClient is a client for the StockMovement schema.
+type StockMovementClient struct {
+	config
+}
+
+// NewStockMovementClient returns a client for the StockMovement from the given config.
+func NewStockMovementClient(c config) *StockMovementClient {
+	return &StockMovementClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `stockmovement.Hooks(f(g(h())))`.
+func (c *StockMovementClient) Use(hooks ...Hook) {
+	c.hooks.StockMovement = append(c.hooks.StockMovement, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `stockmovement.Intercept(f(g(h())))`.
+func (c *StockMovementClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StockMovement = append(c.inters.StockMovement, interceptors...)
+}
+
+// Create returns a builder for creating a StockMovement entity.
+func (c *StockMovementClient) Create() *StockMovementCreate {
+	mutation := newStockMovementMutation(c.config, OpCreate)
+	return &StockMovementCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of StockMovement entities.
+func (c *StockMovementClient) CreateBulk(builders ...*StockMovementCreate) *StockMovementCreateBulk {
+	return &StockMovementCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *StockMovementClient) MapCreateBulk(slice any, setFunc func(*StockMovementCreate, int)) *StockMovementCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &StockMovementCreateBulk{err: fmt.Errorf("calling to StockMovementClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*StockMovementCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &StockMovementCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for StockMovement.
+func (c *StockMovementClient) Update() *StockMovementUpdate {
+	mutation := newStockMovementMutation(c.config, OpUpdate)
+	return &StockMovementUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StockMovementClient) UpdateOne(_m *StockMovement) *StockMovementUpdateOne {
+	mutation := newStockMovementMutation(c.config, OpUpdateOne, withStockMovement(_m))
+	return &StockMovementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StockMovementClient) UpdateOneID(id int) *StockMovementUpdateOne {
+	mutation := newStockMovementMutation(c.config, OpUpdateOne, withStockMovementID(id))
+	return &StockMovementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for StockMovement.
+func (c *StockMovementClient) Delete() *StockMovementDelete {
+	mutation := newStockMovementMutation(c.config, OpDelete)
+	return &StockMovementDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StockMovementClient) DeleteOne(_m *StockMovement) *StockMovementDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *StockMovementClient) DeleteOneID(id int) *StockMovementDeleteOne {
+	builder := c.Delete().Where(stockmovement.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StockMovementDeleteOne{builder}
+}
+
+// Query returns a query builder for StockMovement.
+func (c *StockMovementClient) Query() *StockMovementQuery {
+	return &StockMovementQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeStockMovement},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a StockMovement entity by its id.
+func (c *StockMovementClient) Get(ctx context.Context, id int) (*StockMovement, error) {
+	return c.Query().Where(stockmovement.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StockMovementClient) GetX(ctx context.Context, id int) *StockMovement {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryItem queries the item edge of a StockMovement.
+func (c *StockMovementClient) QueryItem(_m *StockMovement) *ItemQuery {
+	query := (&ItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(stockmovement.Table, stockmovement.FieldID, id),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, stockmovement.ItemTable, stockmovement.ItemColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLocation queries the location edge of a StockMovement.
+func (c *StockMovementClient) QueryLocation(_m *StockMovement) *LocationQuery {
+	query := (&LocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(stockmovement.Table, stockmovement.FieldID, id),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, stockmovement.LocationTable, stockmovement.LocationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *StockMovementClient) Hooks() []Hook {
+	return c.hooks.StockMovement
+}
+
+// Interceptors returns the client interceptors.
+func (c *StockMovementClient) Interceptors() []Interceptor {
+	return c.inters.StockMovement
+}
+
+func (c *StockMovementClient) mutate(ctx context.Context, m *StockMovementMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&StockMovementCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&StockMovementUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&StockMovementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&StockMovementDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown StockMovement mutation op: %q", m.Op())
+	}
+}
+
+// TrackingClient is a client for the Tracking schema.
+type TrackingClient struct {
+	config
+}
+
+// NewTrackingClient returns a client for the Tracking from the given config.
+func NewTrackingClient(c config) *TrackingClient {
+	return &TrackingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tracking.Hooks(f(g(h())))`.
+func (c *TrackingClient) Use(hooks ...Hook) {
+	c.hooks.Tracking = append(c.hooks.Tracking, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tracking.Intercept(f(g(h())))`.
+func (c *TrackingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Tracking = append(c.inters.Tracking, interceptors...)
+}
+
+// Create returns a builder for creating a Tracking entity.
+func (c *TrackingClient) Create() *TrackingCreate {
+	mutation := newTrackingMutation(c.config, OpCreate)
+	return &TrackingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Tracking entities.
+func (c *TrackingClient) CreateBulk(builders ...*TrackingCreate) *TrackingCreateBulk {
+	return &TrackingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TrackingClient) MapCreateBulk(slice any, setFunc func(*TrackingCreate, int)) *TrackingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TrackingCreateBulk{err: fmt.Errorf("calling to TrackingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TrackingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TrackingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Tracking.
+func (c *TrackingClient) Update() *TrackingUpdate {
+	mutation := newTrackingMutation(c.config, OpUpdate)
+	return &TrackingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TrackingClient) UpdateOne(_m *Tracking) *TrackingUpdateOne {
+	mutation := newTrackingMutation(c.config, OpUpdateOne, withTracking(_m))
+	return &TrackingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TrackingClient) UpdateOneID(id int) *TrackingUpdateOne {
+	mutation := newTrackingMutation(c.config, OpUpdateOne, withTrackingID(id))
+	return &TrackingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Tracking.
+func (c *TrackingClient) Delete() *TrackingDelete {
+	mutation := newTrackingMutation(c.config, OpDelete)
+	return &TrackingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TrackingClient) DeleteOne(_m *Tracking) *TrackingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TrackingClient) DeleteOneID(id int) *TrackingDeleteOne {
+	builder := c.Delete().Where(tracking.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TrackingDeleteOne{builder}
+}
+
+// Query returns a query builder for Tracking.
+func (c *TrackingClient) Query() *TrackingQuery {
+	return &TrackingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTracking},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Tracking entity by its id.
+func (c *TrackingClient) Get(ctx context.Context, id int) (*Tracking, error) {
+	return c.Query().Where(tracking.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TrackingClient) GetX(ctx context.Context, id int) *Tracking {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrder queries the order edge of a Tracking.
+func (c *TrackingClient) QueryOrder(_m *Tracking) *OrderQuery {
+	query := (&OrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tracking.Table, tracking.FieldID, id),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, tracking.OrderTable, tracking.OrderColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TrackingClient) Hooks() []Hook {
+	return c.hooks.Tracking
+}
+
+// Interceptors returns the client interceptors.
+func (c *TrackingClient) Interceptors() []Interceptor {
+	return c.inters.Tracking
+}
+
+func (c *TrackingClient) mutate(ctx context.Context, m *TrackingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TrackingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TrackingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TrackingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TrackingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Tracking mutation op: %q", m.Op())
+	}
+}
+
+// UserClient is a client for the User schema.
+type UserClient struct {
+	config
+}
+
+// NewUserClient returns a client for the User from the given config.
+func NewUserClient(c config) *UserClient {
+	return &UserClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
+func (c *UserClient) Use(hooks ...Hook) {
+	c.hooks.User = append(c.hooks.User, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
+// Create returns a builder for creating a User entity.
+func (c *UserClient) Create() *UserCreate {
+	mutation := newUserMutation(c.config, OpCreate)
+	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of User entities.
+func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for User.
+func (c *UserClient) Update() *UserUpdate {
+	mutation := newUserMutation(c.config, OpUpdate)
+	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserClient) UpdateOne(_m *User) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUser(_m))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for User.
+func (c *UserClient) Delete() *UserDelete {
+	mutation := newUserMutation(c.config, OpDelete)
+	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserClient) DeleteOne(_m *User) *UserDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserClient) DeleteOneID(id int) *UserDeleteOne {
+	builder := c.Delete().Where(user.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserDeleteOne{builder}
+}
+
+// Query returns a query builder for User.
+func (c *UserClient) Query() *UserQuery {
+	return &UserQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUser},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a User entity by its id.
+func (c *UserClient) Get(ctx context.Context, id int) (*User, error) {
+	return c.Query().Where(user.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserClient) GetX(ctx context.Context, id int) *User {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserClient) Hooks() []Hook {
+	return c.hooks.User
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserClient) Interceptors() []Interceptor {
+	return c.inters.User
+}
+
+func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown User mutation op: %q", m.Op())
+	}
+}
+
+// WarehouseClient is a client for the Warehouse schema.
+type WarehouseClient struct {
+	config
+}
+
+// NewWarehouseClient returns a client for the Warehouse from the given config.
+func NewWarehouseClient(c config) *WarehouseClient {
+	return &WarehouseClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `warehouse.Hooks(f(g(h())))`.
+func (c *WarehouseClient) Use(hooks ...Hook) {
+	c.hooks.Warehouse = append(c.hooks.Warehouse, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `warehouse.Intercept(f(g(h())))`.
+func (c *WarehouseClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Warehouse = append(c.inters.Warehouse, interceptors...)
+}
+
+// Create returns a builder for creating a Warehouse entity.
+func (c *WarehouseClient) Create() *WarehouseCreate {
+	mutation := newWarehouseMutation(c.config, OpCreate)
+	return &WarehouseCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Warehouse entities.
+func (c *WarehouseClient) CreateBulk(builders ...*WarehouseCreate) *WarehouseCreateBulk {
+	return &WarehouseCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WarehouseClient) MapCreateBulk(slice any, setFunc func(*WarehouseCreate, int)) *WarehouseCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WarehouseCreateBulk{err: fmt.Errorf("calling to WarehouseClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WarehouseCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WarehouseCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Warehouse.
+func (c *WarehouseClient) Update() *WarehouseUpdate {
+	mutation := newWarehouseMutation(c.config, OpUpdate)
+	return &WarehouseUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WarehouseClient) UpdateOne(_m *Warehouse) *WarehouseUpdateOne {
+	mutation := newWarehouseMutation(c.config, OpUpdateOne, withWarehouse(_m))
+	return &WarehouseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WarehouseClient) UpdateOneID(id int) *WarehouseUpdateOne {
+	mutation := newWarehouseMutation(c.config, OpUpdateOne, withWarehouseID(id))
+	return &WarehouseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Warehouse.
+func (c *WarehouseClient) Delete() *WarehouseDelete {
+	mutation := newWarehouseMutation(c.config, OpDelete)
+	return &WarehouseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WarehouseClient) DeleteOne(_m *Warehouse) *WarehouseDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WarehouseClient) DeleteOneID(id int) *WarehouseDeleteOne {
+	builder := c.Delete().Where(warehouse.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WarehouseDeleteOne{builder}
+}
+
+// Query returns a query builder for Warehouse.
+func (c *WarehouseClient) Query() *WarehouseQuery {
+	return &WarehouseQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWarehouse},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Warehouse entity by its id.
+func (c *WarehouseClient) Get(ctx context.Context, id int) (*Warehouse, error) {
+	return c.Query().Where(warehouse.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WarehouseClient) GetX(ctx context.Context, id int) *Warehouse {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWarehouseLocations queries the warehouse_locations edge of a Warehouse.
+func (c *WarehouseClient) QueryWarehouseLocations(_m *Warehouse) *WarehouseLocationQuery {
+	query := (&WarehouseLocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(warehouse.Table, warehouse.FieldID, id),
+			sqlgraph.To(warehouselocation.Table, warehouselocation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, warehouse.WarehouseLocationsTable, warehouse.WarehouseLocationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOutgoingTransfers queries the outgoing_transfers edge of a Warehouse.
+func (c *WarehouseClient) QueryOutgoingTransfers(_m *Warehouse) *OrderQuery {
+	query := (&OrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(warehouse.Table, warehouse.FieldID, id),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, warehouse.OutgoingTransfersTable, warehouse.OutgoingTransfersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIncomingTransfers queries the incoming_transfers edge of a Warehouse.
+func (c *WarehouseClient) QueryIncomingTransfers(_m *Warehouse) *OrderQuery {
+	query := (&OrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(warehouse.Table, warehouse.FieldID, id),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, warehouse.IncomingTransfersTable, warehouse.IncomingTransfersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WarehouseClient) Hooks() []Hook {
+	return c.hooks.Warehouse
+}
+
+// Interceptors returns the client interceptors.
+func (c *WarehouseClient) Interceptors() []Interceptor {
+	return c.inters.Warehouse
+}
+
+func (c *WarehouseClient) mutate(ctx context.Context, m *WarehouseMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WarehouseCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&StockMovementUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WarehouseUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&StockMovementUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WarehouseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&StockMovementDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&WarehouseDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown StockMovement mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Warehouse mutation op: %q", m.Op())
 	}
 }
 
-// TrackingClient is a client for the Tracking schema.
-type TrackingClient struct {
+// WarehouseLocationClient is a client for the WarehouseLocation schema.
+type WarehouseLocationClient struct {
 	config
 }
 
-// NewTrackingClient returns a client for the Tracking from the given config.
-func NewTrackingClient(c config) *TrackingClient {
-	return &TrackingClient{config: c}
+// NewWarehouseLocationClient returns a client for the WarehouseLocation from the given config.
+func NewWarehouseLocationClient(c config) *WarehouseLocationClient {
+	return &WarehouseLocationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `tracking.Hooks(f(g(h())))`.
-func (c *TrackingClient) Use(hooks ...Hook) {
-	c.hooks.Tracking = append(c.hooks.Tracking, hooks...)
+// A call to `Use(f, g, h)` equals to `warehouselocation.Hooks(f(g(h())))`.
+func (c *WarehouseLocationClient) Use(hooks ...Hook) {
+	c.hooks.WarehouseLocation = append(c.hooks.WarehouseLocation, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `tracking.Intercept(f(g(h())))`.
-func (c *TrackingClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Tracking = append(c.inters.Tracking, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `warehouselocation.Intercept(f(g(h())))`.
+func (c *WarehouseLocationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WarehouseLocation = append(c.inters.WarehouseLocation, interceptors...)
 }
 
-// Create returns a builder for creating a Tracking entity.
-func (c *TrackingClient) Create() *TrackingCreate {
-	mutation := newTrackingMutation(c.config, OpCreate)
-	return &TrackingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a WarehouseLocation entity.
+func (c *WarehouseLocationClient) Create() *WarehouseLocationCreate {
+	mutation := newWarehouseLocationMutation(c.config, OpCreate)
+	return &WarehouseLocationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Tracking entities.
-func (c *TrackingClient) CreateBulk(builders ...*TrackingCreate) *TrackingCreateBulk {
-	return &TrackingCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of WarehouseLocation entities.
+func (c *WarehouseLocationClient) CreateBulk(builders ...*WarehouseLocationCreate) *WarehouseLocationCreateBulk {
+	return &WarehouseLocationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *TrackingClient) MapCreateBulk(slice any, setFunc func(*TrackingCreate, int)) *TrackingCreateBulk {
+func (c *WarehouseLocationClient) MapCreateBulk(slice any, setFunc func(*WarehouseLocationCreate, int)) *WarehouseLocationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &TrackingCreateBulk{err: fmt.Errorf("calling to TrackingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &WarehouseLocationCreateBulk{err: fmt.Errorf("calling to WarehouseLocationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*TrackingCreate, rv.Len())
+	builders := make([]*WarehouseLocationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &TrackingCreateBulk{config: c.config, builders: builders}
+	return &WarehouseLocationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Tracking.
-func (c *TrackingClient) Update() *TrackingUpdate {
-	mutation := newTrackingMutation(c.config, OpUpdate)
-	return &TrackingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for WarehouseLocation.
+func (c *WarehouseLocationClient) Update() *WarehouseLocationUpdate {
+	mutation := newWarehouseLocationMutation(c.config, OpUpdate)
+	return &WarehouseLocationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *TrackingClient) UpdateOne(_m *Tracking) *TrackingUpdateOne {
-	mutation := newTrackingMutation(c.config, OpUpdateOne, withTracking(_m))
-	return &TrackingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WarehouseLocationClient) UpdateOne(_m *WarehouseLocation) *WarehouseLocationUpdateOne {
+	mutation := newWarehouseLocationMutation(c.config, OpUpdateOne, withWarehouseLocation(_m))
+	return &WarehouseLocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *TrackingClient) UpdateOneID(id int) *TrackingUpdateOne {
-	mutation := newTrackingMutation(c.config, OpUpdateOne, withTrackingID(id))
-	return &TrackingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WarehouseLocationClient) UpdateOneID(id int) *WarehouseLocationUpdateOne {
+	mutation := newWarehouseLocationMutation(c.config, OpUpdateOne, withWarehouseLocationID(id))
+	return &WarehouseLocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Tracking.
-func (c *TrackingClient) Delete() *TrackingDelete {
-	mutation := newTrackingMutation(c.config, OpDelete)
-	return &TrackingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for WarehouseLocation.
+func (c *WarehouseLocationClient) Delete() *WarehouseLocationDelete {
+	mutation := newWarehouseLocationMutation(c.config, OpDelete)
+	return &WarehouseLocationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *TrackingClient) DeleteOne(_m *Tracking) *TrackingDeleteOne {
+func (c *WarehouseLocationClient) DeleteOne(_m *WarehouseLocation) *WarehouseLocationDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *TrackingClient) DeleteOneID(id int) *TrackingDeleteOne {
-	builder := c.Delete().Where(tracking.ID(id))
+func (c *WarehouseLocationClient) DeleteOneID(id int) *WarehouseLocationDeleteOne {
+	builder := c.Delete().Where(warehouselocation.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &TrackingDeleteOne{builder}
+	return &WarehouseLocationDeleteOne{builder}
 }
 
-// Query returns a query builder for Tracking.
-func (c *TrackingClient) Query() *TrackingQuery {
-	return &TrackingQuery{
+// Query returns a query builder for WarehouseLocation.
+func (c *WarehouseLocationClient) Query() *WarehouseLocationQuery {
+	return &WarehouseLocationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeTracking},
+		ctx:    &QueryContext{Type: TypeWarehouseLocation},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Tracking entity by its id.
-func (c *TrackingClient) Get(ctx context.Context, id int) (*Tracking, error) {
-	return c.Query().Where(tracking.ID(id)).Only(ctx)
+// Get returns a WarehouseLocation entity by its id.
+func (c *WarehouseLocationClient) Get(ctx context.Context, id int) (*WarehouseLocation, error) {
+	return c.Query().Where(warehouselocation.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *TrackingClient) GetX(ctx context.Context, id int) *Tracking {
+func (c *WarehouseLocationClient) GetX(ctx context.Context, id int) *WarehouseLocation {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2002,15 +4635,31 @@ func (c *TrackingClient) GetX(ctx context.Context, id int) *Tracking {
 	return obj
 }
 
-// QueryOrder queries the order edge of a Tracking.
-func (c *TrackingClient) QueryOrder(_m *Tracking) *OrderQuery {
-	query := (&OrderClient{config: c.config}).Query()
+// QueryWarehouse queries the warehouse edge of a WarehouseLocation.
+func (c *WarehouseLocationClient) QueryWarehouse(_m *WarehouseLocation) *WarehouseQuery {
+	query := (&WarehouseClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(tracking.Table, tracking.FieldID, id),
-			sqlgraph.To(order.Table, order.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, tracking.OrderTable, tracking.OrderColumn),
+			sqlgraph.From(warehouselocation.Table, warehouselocation.FieldID, id),
+			sqlgraph.To(warehouse.Table, warehouse.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, warehouselocation.WarehouseTable, warehouselocation.WarehouseColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLocation queries the location edge of a WarehouseLocation.
+func (c *WarehouseLocationClient) QueryLocation(_m *WarehouseLocation) *LocationQuery {
+	query := (&LocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(warehouselocation.Table, warehouselocation.FieldID, id),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, warehouselocation.LocationTable, warehouselocation.LocationColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2019,131 +4668,131 @@ func (c *TrackingClient) QueryOrder(_m *Tracking) *OrderQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *TrackingClient) Hooks() []Hook {
-	return c.hooks.Tracking
+func (c *WarehouseLocationClient) Hooks() []Hook {
+	return c.hooks.WarehouseLocation
 }
 
 // Interceptors returns the client interceptors.
-func (c *TrackingClient) Interceptors() []Interceptor {
-	return c.inters.Tracking
+func (c *WarehouseLocationClient) Interceptors() []Interceptor {
+	return c.inters.WarehouseLocation
 }
 
-func (c *TrackingClient) mutate(ctx context.Context, m *TrackingMutation) (Value, error) {
+func (c *WarehouseLocationClient) mutate(ctx context.Context, m *WarehouseLocationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&TrackingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WarehouseLocationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&TrackingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WarehouseLocationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&TrackingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WarehouseLocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&TrackingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&WarehouseLocationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Tracking mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown WarehouseLocation mutation op: %q", m.Op())
 	}
 }
 
-// UserClient is a client for the User schema.
-type UserClient struct {
+// WaveClient is a client for the Wave schema.
+type WaveClient struct {
 	config
 }
 
-// NewUserClient returns a client for the User from the given config.
-func NewUserClient(c config) *UserClient {
-	return &UserClient{config: c}
+// NewWaveClient returns a client for the Wave from the given config.
+func NewWaveClient(c config) *WaveClient {
+	return &WaveClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
-func (c *UserClient) Use(hooks ...Hook) {
-	c.hooks.User = append(c.hooks.User, hooks...)
+// A call to `Use(f, g, h)` equals to `wave.Hooks(f(g(h())))`.
+func (c *WaveClient) Use(hooks ...Hook) {
+	c.hooks.Wave = append(c.hooks.Wave, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
-func (c *UserClient) Intercept(interceptors ...Interceptor) {
-	c.inters.User = append(c.inters.User, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `wave.Intercept(f(g(h())))`.
+func (c *WaveClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Wave = append(c.inters.Wave, interceptors...)
 }
 
-// Create returns a builder for creating a User entity.
-func (c *UserClient) Create() *UserCreate {
-	mutation := newUserMutation(c.config, OpCreate)
-	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Wave entity.
+func (c *WaveClient) Create() *WaveCreate {
+	mutation := newWaveMutation(c.config, OpCreate)
+	return &WaveCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of User entities.
-func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
-	return &UserCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Wave entities.
+func (c *WaveClient) CreateBulk(builders ...*WaveCreate) *WaveCreateBulk {
+	return &WaveCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+func (c *WaveClient) MapCreateBulk(slice any, setFunc func(*WaveCreate, int)) *WaveCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &WaveCreateBulk{err: fmt.Errorf("calling to WaveClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*UserCreate, rv.Len())
+	builders := make([]*WaveCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &UserCreateBulk{config: c.config, builders: builders}
+	return &WaveCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for User.
-func (c *UserClient) Update() *UserUpdate {
-	mutation := newUserMutation(c.config, OpUpdate)
-	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Wave.
+func (c *WaveClient) Update() *WaveUpdate {
+	mutation := newWaveMutation(c.config, OpUpdate)
+	return &WaveUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *UserClient) UpdateOne(_m *User) *UserUpdateOne {
-	mutation := newUserMutation(c.config, OpUpdateOne, withUser(_m))
-	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WaveClient) UpdateOne(_m *Wave) *WaveUpdateOne {
+	mutation := newWaveMutation(c.config, OpUpdateOne, withWave(_m))
+	return &WaveUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
-	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
-	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WaveClient) UpdateOneID(id int) *WaveUpdateOne {
+	mutation := newWaveMutation(c.config, OpUpdateOne, withWaveID(id))
+	return &WaveUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for User.
-func (c *UserClient) Delete() *UserDelete {
-	mutation := newUserMutation(c.config, OpDelete)
-	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Wave.
+func (c *WaveClient) Delete() *WaveDelete {
+	mutation := newWaveMutation(c.config, OpDelete)
+	return &WaveDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *UserClient) DeleteOne(_m *User) *UserDeleteOne {
+func (c *WaveClient) DeleteOne(_m *Wave) *WaveDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *UserClient) DeleteOneID(id int) *UserDeleteOne {
-	builder := c.Delete().Where(user.ID(id))
+func (c *WaveClient) DeleteOneID(id int) *WaveDeleteOne {
+	builder := c.Delete().Where(wave.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &UserDeleteOne{builder}
+	return &WaveDeleteOne{builder}
 }
 
-// Query returns a query builder for User.
-func (c *UserClient) Query() *UserQuery {
-	return &UserQuery{
+// Query returns a query builder for Wave.
+func (c *WaveClient) Query() *WaveQuery {
+	return &WaveQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeUser},
+		ctx:    &QueryContext{Type: TypeWave},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a User entity by its id.
-func (c *UserClient) Get(ctx context.Context, id int) (*User, error) {
-	return c.Query().Where(user.ID(id)).Only(ctx)
+// Get returns a Wave entity by its id.
+func (c *WaveClient) Get(ctx context.Context, id int) (*Wave, error) {
+	return c.Query().Where(wave.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *UserClient) GetX(ctx context.Context, id int) *User {
+func (c *WaveClient) GetX(ctx context.Context, id int) *Wave {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2151,132 +4800,164 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 	return obj
 }
 
+// QueryOrders queries the orders edge of a Wave.
+func (c *WaveClient) QueryOrders(_m *Wave) *OrderQuery {
+	query := (&OrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wave.Table, wave.FieldID, id),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, wave.OrdersTable, wave.OrdersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTasks queries the tasks edge of a Wave.
+func (c *WaveClient) QueryTasks(_m *Wave) *WaveTaskQuery {
+	query := (&WaveTaskClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wave.Table, wave.FieldID, id),
+			sqlgraph.To(wavetask.Table, wavetask.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, wave.TasksTable, wave.TasksColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
-func (c *UserClient) Hooks() []Hook {
-	return c.hooks.User
+func (c *WaveClient) Hooks() []Hook {
+	return c.hooks.Wave
 }
 
 // Interceptors returns the client interceptors.
-func (c *UserClient) Interceptors() []Interceptor {
-	return c.inters.User
+func (c *WaveClient) Interceptors() []Interceptor {
+	return c.inters.Wave
 }
 
-func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
+func (c *WaveClient) mutate(ctx context.Context, m *WaveMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WaveCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WaveUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WaveUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&WaveDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown User mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Wave mutation op: %q", m.Op())
 	}
 }
 
-// WarehouseClient is a client for the Warehouse schema.
-type WarehouseClient struct {
+// WavePickClient is a client for the WavePick schema.
+type WavePickClient struct {
 	config
 }
 
-// NewWarehouseClient returns a client for the Warehouse from the given config.
-func NewWarehouseClient(c config) *WarehouseClient {
-	return &WarehouseClient{config: c}
+// NewWavePickClient returns a client for the WavePick from the given config.
+func NewWavePickClient(c config) *WavePickClient {
+	return &WavePickClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `warehouse.Hooks(f(g(h())))`.
-func (c *WarehouseClient) Use(hooks ...Hook) {
-	c.hooks.Warehouse = append(c.hooks.Warehouse, hooks...)
+// A call to `Use(f, g, h)` equals to `wavepick.Hooks(f(g(h())))`.
+func (c *WavePickClient) Use(hooks ...Hook) {
+	c.hooks.WavePick = append(c.hooks.WavePick, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `warehouse.Intercept(f(g(h())))`.
-func (c *WarehouseClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Warehouse = append(c.inters.Warehouse, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `wavepick.Intercept(f(g(h())))`.
+func (c *WavePickClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WavePick = append(c.inters.WavePick, interceptors...)
 }
 
-// Create returns a builder for creating a Warehouse entity.
-func (c *WarehouseClient) Create() *WarehouseCreate {
-	mutation := newWarehouseMutation(c.config, OpCreate)
-	return &WarehouseCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a WavePick entity.
+func (c *WavePickClient) Create() *WavePickCreate {
+	mutation := newWavePickMutation(c.config, OpCreate)
+	return &WavePickCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Warehouse entities.
-func (c *WarehouseClient) CreateBulk(builders ...*WarehouseCreate) *WarehouseCreateBulk {
-	return &WarehouseCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of WavePick entities.
+func (c *WavePickClient) CreateBulk(builders ...*WavePickCreate) *WavePickCreateBulk {
+	return &WavePickCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *WarehouseClient) MapCreateBulk(slice any, setFunc func(*WarehouseCreate, int)) *WarehouseCreateBulk {
+func (c *WavePickClient) MapCreateBulk(slice any, setFunc func(*WavePickCreate, int)) *WavePickCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &WarehouseCreateBulk{err: fmt.Errorf("calling to WarehouseClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &WavePickCreateBulk{err: fmt.Errorf("calling to WavePickClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*WarehouseCreate, rv.Len())
+	builders := make([]*WavePickCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &WarehouseCreateBulk{config: c.config, builders: builders}
+	return &WavePickCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Warehouse.
-func (c *WarehouseClient) Update() *WarehouseUpdate {
-	mutation := newWarehouseMutation(c.config, OpUpdate)
-	return &WarehouseUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for WavePick.
+func (c *WavePickClient) Update() *WavePickUpdate {
+	mutation := newWavePickMutation(c.config, OpUpdate)
+	return &WavePickUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *WarehouseClient) UpdateOne(_m *Warehouse) *WarehouseUpdateOne {
-	mutation := newWarehouseMutation(c.config, OpUpdateOne, withWarehouse(_m))
-	return &WarehouseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WavePickClient) UpdateOne(_m *WavePick) *WavePickUpdateOne {
+	mutation := newWavePickMutation(c.config, OpUpdateOne, withWavePick(_m))
+	return &WavePickUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *WarehouseClient) UpdateOneID(id int) *WarehouseUpdateOne {
-	mutation := newWarehouseMutation(c.config, OpUpdateOne, withWarehouseID(id))
-	return &WarehouseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WavePickClient) UpdateOneID(id int) *WavePickUpdateOne {
+	mutation := newWavePickMutation(c.config, OpUpdateOne, withWavePickID(id))
+	return &WavePickUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Warehouse.
-func (c *WarehouseClient) Delete() *WarehouseDelete {
-	mutation := newWarehouseMutation(c.config, OpDelete)
-	return &WarehouseDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for WavePick.
+func (c *WavePickClient) Delete() *WavePickDelete {
+	mutation := newWavePickMutation(c.config, OpDelete)
+	return &WavePickDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *WarehouseClient) DeleteOne(_m *Warehouse) *WarehouseDeleteOne {
+func (c *WavePickClient) DeleteOne(_m *WavePick) *WavePickDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *WarehouseClient) DeleteOneID(id int) *WarehouseDeleteOne {
-	builder := c.Delete().Where(warehouse.ID(id))
+func (c *WavePickClient) DeleteOneID(id int) *WavePickDeleteOne {
+	builder := c.Delete().Where(wavepick.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &WarehouseDeleteOne{builder}
+	return &WavePickDeleteOne{builder}
 }
 
-// Query returns a query builder for Warehouse.
-func (c *WarehouseClient) Query() *WarehouseQuery {
-	return &WarehouseQuery{
+// Query returns a query builder for WavePick.
+func (c *WavePickClient) Query() *WavePickQuery {
+	return &WavePickQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeWarehouse},
+		ctx:    &QueryContext{Type: TypeWavePick},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Warehouse entity by its id.
-func (c *WarehouseClient) Get(ctx context.Context, id int) (*Warehouse, error) {
-	return c.Query().Where(warehouse.ID(id)).Only(ctx)
+// Get returns a WavePick entity by its id.
+func (c *WavePickClient) Get(ctx context.Context, id int) (*WavePick, error) {
+	return c.Query().Where(wavepick.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *WarehouseClient) GetX(ctx context.Context, id int) *Warehouse {
+func (c *WavePickClient) GetX(ctx context.Context, id int) *WavePick {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2284,15 +4965,31 @@ func (c *WarehouseClient) GetX(ctx context.Context, id int) *Warehouse {
 	return obj
 }
 
-// QueryWarehouseLocations queries the warehouse_locations edge of a Warehouse.
-func (c *WarehouseClient) QueryWarehouseLocations(_m *Warehouse) *WarehouseLocationQuery {
-	query := (&WarehouseLocationClient{config: c.config}).Query()
+// QueryTask queries the task edge of a WavePick.
+func (c *WavePickClient) QueryTask(_m *WavePick) *WaveTaskQuery {
+	query := (&WaveTaskClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(warehouse.Table, warehouse.FieldID, id),
-			sqlgraph.To(warehouselocation.Table, warehouselocation.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, warehouse.WarehouseLocationsTable, warehouse.WarehouseLocationsColumn),
+			sqlgraph.From(wavepick.Table, wavepick.FieldID, id),
+			sqlgraph.To(wavetask.Table, wavetask.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, wavepick.TaskTable, wavepick.TaskColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrderLine queries the order_line edge of a WavePick.
+func (c *WavePickClient) QueryOrderLine(_m *WavePick) *OrderLineQuery {
+	query := (&OrderLineClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wavepick.Table, wavepick.FieldID, id),
+			sqlgraph.To(orderline.Table, orderline.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, wavepick.OrderLineTable, wavepick.OrderLineColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2301,131 +4998,131 @@ func (c *WarehouseClient) QueryWarehouseLocations(_m *Warehouse) *WarehouseLocat
 }
 
 // Hooks returns the client hooks.
-func (c *WarehouseClient) Hooks() []Hook {
-	return c.hooks.Warehouse
+func (c *WavePickClient) Hooks() []Hook {
+	return c.hooks.WavePick
 }
 
 // Interceptors returns the client interceptors.
-func (c *WarehouseClient) Interceptors() []Interceptor {
-	return c.inters.Warehouse
+func (c *WavePickClient) Interceptors() []Interceptor {
+	return c.inters.WavePick
 }
 
-func (c *WarehouseClient) mutate(ctx context.Context, m *WarehouseMutation) (Value, error) {
+func (c *WavePickClient) mutate(ctx context.Context, m *WavePickMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&WarehouseCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WavePickCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&WarehouseUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WavePickUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&WarehouseUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WavePickUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&WarehouseDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&WavePickDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Warehouse mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown WavePick mutation op: %q", m.Op())
 	}
 }
 
-// WarehouseLocationClient is a client for the WarehouseLocation schema.
-type WarehouseLocationClient struct {
+// WaveTaskClient is a client for the WaveTask schema.
+type WaveTaskClient struct {
 	config
 }
 
-// NewWarehouseLocationClient returns a client for the WarehouseLocation from the given config.
-func NewWarehouseLocationClient(c config) *WarehouseLocationClient {
-	return &WarehouseLocationClient{config: c}
+// NewWaveTaskClient returns a client for the WaveTask from the given config.
+func NewWaveTaskClient(c config) *WaveTaskClient {
+	return &WaveTaskClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `warehouselocation.Hooks(f(g(h())))`.
-func (c *WarehouseLocationClient) Use(hooks ...Hook) {
-	c.hooks.WarehouseLocation = append(c.hooks.WarehouseLocation, hooks...)
+// A call to `Use(f, g, h)` equals to `wavetask.Hooks(f(g(h())))`.
+func (c *WaveTaskClient) Use(hooks ...Hook) {
+	c.hooks.WaveTask = append(c.hooks.WaveTask, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `warehouselocation.Intercept(f(g(h())))`.
-func (c *WarehouseLocationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.WarehouseLocation = append(c.inters.WarehouseLocation, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `wavetask.Intercept(f(g(h())))`.
+func (c *WaveTaskClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WaveTask = append(c.inters.WaveTask, interceptors...)
 }
 
-// Create returns a builder for creating a WarehouseLocation entity.
-func (c *WarehouseLocationClient) Create() *WarehouseLocationCreate {
-	mutation := newWarehouseLocationMutation(c.config, OpCreate)
-	return &WarehouseLocationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a WaveTask entity.
+func (c *WaveTaskClient) Create() *WaveTaskCreate {
+	mutation := newWaveTaskMutation(c.config, OpCreate)
+	return &WaveTaskCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of WarehouseLocation entities.
-func (c *WarehouseLocationClient) CreateBulk(builders ...*WarehouseLocationCreate) *WarehouseLocationCreateBulk {
-	return &WarehouseLocationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of WaveTask entities.
+func (c *WaveTaskClient) CreateBulk(builders ...*WaveTaskCreate) *WaveTaskCreateBulk {
+	return &WaveTaskCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *WarehouseLocationClient) MapCreateBulk(slice any, setFunc func(*WarehouseLocationCreate, int)) *WarehouseLocationCreateBulk {
+func (c *WaveTaskClient) MapCreateBulk(slice any, setFunc func(*WaveTaskCreate, int)) *WaveTaskCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &WarehouseLocationCreateBulk{err: fmt.Errorf("calling to WarehouseLocationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &WaveTaskCreateBulk{err: fmt.Errorf("calling to WaveTaskClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*WarehouseLocationCreate, rv.Len())
+	builders := make([]*WaveTaskCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &WarehouseLocationCreateBulk{config: c.config, builders: builders}
+	return &WaveTaskCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for WarehouseLocation.
-func (c *WarehouseLocationClient) Update() *WarehouseLocationUpdate {
-	mutation := newWarehouseLocationMutation(c.config, OpUpdate)
-	return &WarehouseLocationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for WaveTask.
+func (c *WaveTaskClient) Update() *WaveTaskUpdate {
+	mutation := newWaveTaskMutation(c.config, OpUpdate)
+	return &WaveTaskUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *WarehouseLocationClient) UpdateOne(_m *WarehouseLocation) *WarehouseLocationUpdateOne {
-	mutation := newWarehouseLocationMutation(c.config, OpUpdateOne, withWarehouseLocation(_m))
-	return &WarehouseLocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WaveTaskClient) UpdateOne(_m *WaveTask) *WaveTaskUpdateOne {
+	mutation := newWaveTaskMutation(c.config, OpUpdateOne, withWaveTask(_m))
+	return &WaveTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *WarehouseLocationClient) UpdateOneID(id int) *WarehouseLocationUpdateOne {
-	mutation := newWarehouseLocationMutation(c.config, OpUpdateOne, withWarehouseLocationID(id))
-	return &WarehouseLocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WaveTaskClient) UpdateOneID(id int) *WaveTaskUpdateOne {
+	mutation := newWaveTaskMutation(c.config, OpUpdateOne, withWaveTaskID(id))
+	return &WaveTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for WarehouseLocation.
-func (c *WarehouseLocationClient) Delete() *WarehouseLocationDelete {
-	mutation := newWarehouseLocationMutation(c.config, OpDelete)
-	return &WarehouseLocationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for WaveTask.
+func (c *WaveTaskClient) Delete() *WaveTaskDelete {
+	mutation := newWaveTaskMutation(c.config, OpDelete)
+	return &WaveTaskDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *WarehouseLocationClient) DeleteOne(_m *WarehouseLocation) *WarehouseLocationDeleteOne {
+func (c *WaveTaskClient) DeleteOne(_m *WaveTask) *WaveTaskDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *WarehouseLocationClient) DeleteOneID(id int) *WarehouseLocationDeleteOne {
-	builder := c.Delete().Where(warehouselocation.ID(id))
+func (c *WaveTaskClient) DeleteOneID(id int) *WaveTaskDeleteOne {
+	builder := c.Delete().Where(wavetask.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &WarehouseLocationDeleteOne{builder}
+	return &WaveTaskDeleteOne{builder}
 }
 
-// Query returns a query builder for WarehouseLocation.
-func (c *WarehouseLocationClient) Query() *WarehouseLocationQuery {
-	return &WarehouseLocationQuery{
+// Query returns a query builder for WaveTask.
+func (c *WaveTaskClient) Query() *WaveTaskQuery {
+	return &WaveTaskQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeWarehouseLocation},
+		ctx:    &QueryContext{Type: TypeWaveTask},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a WarehouseLocation entity by its id.
-func (c *WarehouseLocationClient) Get(ctx context.Context, id int) (*WarehouseLocation, error) {
-	return c.Query().Where(warehouselocation.ID(id)).Only(ctx)
+// Get returns a WaveTask entity by its id.
+func (c *WaveTaskClient) Get(ctx context.Context, id int) (*WaveTask, error) {
+	return c.Query().Where(wavetask.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *WarehouseLocationClient) GetX(ctx context.Context, id int) *WarehouseLocation {
+func (c *WaveTaskClient) GetX(ctx context.Context, id int) *WaveTask {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2433,15 +5130,15 @@ func (c *WarehouseLocationClient) GetX(ctx context.Context, id int) *WarehouseLo
 	return obj
 }
 
-// QueryWarehouse queries the warehouse edge of a WarehouseLocation.
-func (c *WarehouseLocationClient) QueryWarehouse(_m *WarehouseLocation) *WarehouseQuery {
-	query := (&WarehouseClient{config: c.config}).Query()
+// QueryWave queries the wave edge of a WaveTask.
+func (c *WaveTaskClient) QueryWave(_m *WaveTask) *WaveQuery {
+	query := (&WaveClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(warehouselocation.Table, warehouselocation.FieldID, id),
-			sqlgraph.To(warehouse.Table, warehouse.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, warehouselocation.WarehouseTable, warehouselocation.WarehouseColumn),
+			sqlgraph.From(wavetask.Table, wavetask.FieldID, id),
+			sqlgraph.To(wave.Table, wave.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, wavetask.WaveTable, wavetask.WaveColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2449,15 +5146,47 @@ func (c *WarehouseLocationClient) QueryWarehouse(_m *WarehouseLocation) *Warehou
 	return query
 }
 
-// QueryLocation queries the location edge of a WarehouseLocation.
-func (c *WarehouseLocationClient) QueryLocation(_m *WarehouseLocation) *LocationQuery {
+// QueryItem queries the item edge of a WaveTask.
+func (c *WaveTaskClient) QueryItem(_m *WaveTask) *ItemQuery {
+	query := (&ItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wavetask.Table, wavetask.FieldID, id),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, wavetask.ItemTable, wavetask.ItemColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLocation queries the location edge of a WaveTask.
+func (c *WaveTaskClient) QueryLocation(_m *WaveTask) *LocationQuery {
 	query := (&LocationClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(warehouselocation.Table, warehouselocation.FieldID, id),
+			sqlgraph.From(wavetask.Table, wavetask.FieldID, id),
 			sqlgraph.To(location.Table, location.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, warehouselocation.LocationTable, warehouselocation.LocationColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, wavetask.LocationTable, wavetask.LocationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPicks queries the picks edge of a WaveTask.
+func (c *WaveTaskClient) QueryPicks(_m *WaveTask) *WavePickQuery {
+	query := (&WavePickClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wavetask.Table, wavetask.FieldID, id),
+			sqlgraph.To(wavepick.Table, wavepick.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, wavetask.PicksTable, wavetask.PicksColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2466,27 +5195,27 @@ func (c *WarehouseLocationClient) QueryLocation(_m *WarehouseLocation) *Location
 }
 
 // Hooks returns the client hooks.
-func (c *WarehouseLocationClient) Hooks() []Hook {
-	return c.hooks.WarehouseLocation
+func (c *WaveTaskClient) Hooks() []Hook {
+	return c.hooks.WaveTask
 }
 
 // Interceptors returns the client interceptors.
-func (c *WarehouseLocationClient) Interceptors() []Interceptor {
-	return c.inters.WarehouseLocation
+func (c *WaveTaskClient) Interceptors() []Interceptor {
+	return c.inters.WaveTask
 }
 
-func (c *WarehouseLocationClient) mutate(ctx context.Context, m *WarehouseLocationMutation) (Value, error) {
+func (c *WaveTaskClient) mutate(ctx context.Context, m *WaveTaskMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&WarehouseLocationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WaveTaskCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&WarehouseLocationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WaveTaskUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&WarehouseLocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WaveTaskUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&WarehouseLocationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&WaveTaskDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown WarehouseLocation mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown WaveTask mutation op: %q", m.Op())
 	}
 }
 
@@ -2658,12 +5387,17 @@ func (c *ZoneClient) mutate(ctx context.Context, m *ZoneMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		AuditEvent, Bin, Item, Location, Order, OrderLine, PickList, PickTask,
-		StockMovement, Tracking, User, Warehouse, WarehouseLocation, Zone []ent.Hook
+		AuditEvent, Bin, BinStock, Carton, CartonLine, CartonType, EventRule, Item,
+		Location, Order, OrderLine, PickList, PickTask, PutawayTask, Receipt,
+		ReorderPolicy, ReplenishmentRule, ReplenishmentTask, Sequence,
+		StockDiscrepancy, StockMovement, Tracking, User, Warehouse, WarehouseLocation,
+		Wave, WavePick, WaveTask, Zone []ent.Hook
 	}
 	inters struct {
-		AuditEvent, Bin, Item, Location, Order, OrderLine, PickList, PickTask,
-		StockMovement, Tracking, User, Warehouse, WarehouseLocation,
-		Zone []ent.Interceptor
+		AuditEvent, Bin, BinStock, Carton, CartonLine, CartonType, EventRule, Item,
+		Location, Order, OrderLine, PickList, PickTask, PutawayTask, Receipt,
+		ReorderPolicy, ReplenishmentRule, ReplenishmentTask, Sequence,
+		StockDiscrepancy, StockMovement, Tracking, User, Warehouse, WarehouseLocation,
+		Wave, WavePick, WaveTask, Zone []ent.Interceptor
 	}
 )
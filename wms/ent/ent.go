@@ -14,17 +14,32 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/mxV03/wms/ent/auditevent"
 	"github.com/mxV03/wms/ent/bin"
+	"github.com/mxV03/wms/ent/binstock"
+	"github.com/mxV03/wms/ent/carton"
+	"github.com/mxV03/wms/ent/cartonline"
+	"github.com/mxV03/wms/ent/cartontype"
+	"github.com/mxV03/wms/ent/eventrule"
 	"github.com/mxV03/wms/ent/item"
 	"github.com/mxV03/wms/ent/location"
 	"github.com/mxV03/wms/ent/order"
 	"github.com/mxV03/wms/ent/orderline"
 	"github.com/mxV03/wms/ent/picklist"
 	"github.com/mxV03/wms/ent/picktask"
+	"github.com/mxV03/wms/ent/putawaytask"
+	"github.com/mxV03/wms/ent/receipt"
+	"github.com/mxV03/wms/ent/reorderpolicy"
+	"github.com/mxV03/wms/ent/replenishmentrule"
+	"github.com/mxV03/wms/ent/replenishmenttask"
+	"github.com/mxV03/wms/ent/sequence"
+	"github.com/mxV03/wms/ent/stockdiscrepancy"
 	"github.com/mxV03/wms/ent/stockmovement"
 	"github.com/mxV03/wms/ent/tracking"
 	"github.com/mxV03/wms/ent/user"
 	"github.com/mxV03/wms/ent/warehouse"
 	"github.com/mxV03/wms/ent/warehouselocation"
+	"github.com/mxV03/wms/ent/wave"
+	"github.com/mxV03/wms/ent/wavepick"
+	"github.com/mxV03/wms/ent/wavetask"
 	"github.com/mxV03/wms/ent/zone"
 )
 
@@ -88,17 +103,32 @@ func checkColumn(t, c string) error {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
 			auditevent.Table:        auditevent.ValidColumn,
 			bin.Table:               bin.ValidColumn,
+			binstock.Table:          binstock.ValidColumn,
+			carton.Table:            carton.ValidColumn,
+			cartonline.Table:        cartonline.ValidColumn,
+			cartontype.Table:        cartontype.ValidColumn,
+			eventrule.Table:         eventrule.ValidColumn,
 			item.Table:              item.ValidColumn,
 			location.Table:          location.ValidColumn,
 			order.Table:             order.ValidColumn,
 			orderline.Table:         orderline.ValidColumn,
 			picklist.Table:          picklist.ValidColumn,
 			picktask.Table:          picktask.ValidColumn,
+			putawaytask.Table:       putawaytask.ValidColumn,
+			receipt.Table:           receipt.ValidColumn,
+			reorderpolicy.Table:     reorderpolicy.ValidColumn,
+			replenishmentrule.Table: replenishmentrule.ValidColumn,
+			replenishmenttask.Table: replenishmenttask.ValidColumn,
+			sequence.Table:          sequence.ValidColumn,
+			stockdiscrepancy.Table:  stockdiscrepancy.ValidColumn,
 			stockmovement.Table:     stockmovement.ValidColumn,
 			tracking.Table:          tracking.ValidColumn,
 			user.Table:              user.ValidColumn,
 			warehouse.Table:         warehouse.ValidColumn,
 			warehouselocation.Table: warehouselocation.ValidColumn,
+			wave.Table:              wave.ValidColumn,
+			wavepick.Table:          wavepick.ValidColumn,
+			wavetask.Table:          wavetask.ValidColumn,
 			zone.Table:              zone.ValidColumn,
 		})
 	})
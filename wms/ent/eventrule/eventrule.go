@@ -0,0 +1,134 @@
+// Code generated by ent, DO NOT EDIT.
+
+package eventrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the eventrule type in the database.
+	Label = "event_rule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldEvent holds the string denoting the event field in the database.
+	FieldEvent = "event"
+	// FieldConditions holds the string denoting the conditions field in the database.
+	FieldConditions = "conditions"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldArgument holds the string denoting the argument field in the database.
+	FieldArgument = "argument"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldFired holds the string denoting the fired field in the database.
+	FieldFired = "fired"
+	// FieldLastFiredAt holds the string denoting the last_fired_at field in the database.
+	FieldLastFiredAt = "last_fired_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the eventrule in the database.
+	Table = "event_rules"
+)
+
+// Columns holds all SQL columns for eventrule fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldEvent,
+	FieldConditions,
+	FieldAction,
+	FieldArgument,
+	FieldEnabled,
+	FieldFired,
+	FieldLastFiredAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// EventValidator is a validator for the "event" field. It is called by the builders before save.
+	EventValidator func(string) error
+	// DefaultConditions holds the default value on creation for the "conditions" field.
+	DefaultConditions string
+	// ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	ActionValidator func(string) error
+	// DefaultArgument holds the default value on creation for the "argument" field.
+	DefaultArgument string
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultFired holds the default value on creation for the "fired" field.
+	DefaultFired int
+	// FiredValidator is a validator for the "fired" field. It is called by the builders before save.
+	FiredValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the EventRule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByEvent orders the results by the event field.
+func ByEvent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEvent, opts...).ToFunc()
+}
+
+// ByConditions orders the results by the conditions field.
+func ByConditions(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConditions, opts...).ToFunc()
+}
+
+// ByAction orders the results by the action field.
+func ByAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAction, opts...).ToFunc()
+}
+
+// ByArgument orders the results by the argument field.
+func ByArgument(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArgument, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByFired orders the results by the fired field.
+func ByFired(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFired, opts...).ToFunc()
+}
+
+// ByLastFiredAt orders the results by the last_fired_at field.
+func ByLastFiredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastFiredAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
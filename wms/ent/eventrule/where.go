@@ -0,0 +1,600 @@
+// Code generated by ent, DO NOT EDIT.
+
+package eventrule
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/mxV03/wms/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.EventRule {
+	return predicate.EventRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldName, v))
+}
+
+// Event applies equality check predicate on the "event" field. It's identical to EventEQ.
+func Event(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldEvent, v))
+}
+
+// Conditions applies equality check predicate on the "conditions" field. It's identical to ConditionsEQ.
+func Conditions(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldConditions, v))
+}
+
+// Action applies equality check predicate on the "action" field. It's identical to ActionEQ.
+func Action(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldAction, v))
+}
+
+// Argument applies equality check predicate on the "argument" field. It's identical to ArgumentEQ.
+func Argument(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldArgument, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// Fired applies equality check predicate on the "fired" field. It's identical to FiredEQ.
+func Fired(v int) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldFired, v))
+}
+
+// LastFiredAt applies equality check predicate on the "last_fired_at" field. It's identical to LastFiredAtEQ.
+func LastFiredAt(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldLastFiredAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldContainsFold(FieldName, v))
+}
+
+// EventEQ applies the EQ predicate on the "event" field.
+func EventEQ(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldEvent, v))
+}
+
+// EventNEQ applies the NEQ predicate on the "event" field.
+func EventNEQ(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNEQ(FieldEvent, v))
+}
+
+// EventIn applies the In predicate on the "event" field.
+func EventIn(vs ...string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldIn(FieldEvent, vs...))
+}
+
+// EventNotIn applies the NotIn predicate on the "event" field.
+func EventNotIn(vs ...string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotIn(FieldEvent, vs...))
+}
+
+// EventGT applies the GT predicate on the "event" field.
+func EventGT(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGT(FieldEvent, v))
+}
+
+// EventGTE applies the GTE predicate on the "event" field.
+func EventGTE(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGTE(FieldEvent, v))
+}
+
+// EventLT applies the LT predicate on the "event" field.
+func EventLT(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLT(FieldEvent, v))
+}
+
+// EventLTE applies the LTE predicate on the "event" field.
+func EventLTE(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLTE(FieldEvent, v))
+}
+
+// EventContains applies the Contains predicate on the "event" field.
+func EventContains(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldContains(FieldEvent, v))
+}
+
+// EventHasPrefix applies the HasPrefix predicate on the "event" field.
+func EventHasPrefix(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldHasPrefix(FieldEvent, v))
+}
+
+// EventHasSuffix applies the HasSuffix predicate on the "event" field.
+func EventHasSuffix(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldHasSuffix(FieldEvent, v))
+}
+
+// EventEqualFold applies the EqualFold predicate on the "event" field.
+func EventEqualFold(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEqualFold(FieldEvent, v))
+}
+
+// EventContainsFold applies the ContainsFold predicate on the "event" field.
+func EventContainsFold(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldContainsFold(FieldEvent, v))
+}
+
+// ConditionsEQ applies the EQ predicate on the "conditions" field.
+func ConditionsEQ(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldConditions, v))
+}
+
+// ConditionsNEQ applies the NEQ predicate on the "conditions" field.
+func ConditionsNEQ(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNEQ(FieldConditions, v))
+}
+
+// ConditionsIn applies the In predicate on the "conditions" field.
+func ConditionsIn(vs ...string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldIn(FieldConditions, vs...))
+}
+
+// ConditionsNotIn applies the NotIn predicate on the "conditions" field.
+func ConditionsNotIn(vs ...string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotIn(FieldConditions, vs...))
+}
+
+// ConditionsGT applies the GT predicate on the "conditions" field.
+func ConditionsGT(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGT(FieldConditions, v))
+}
+
+// ConditionsGTE applies the GTE predicate on the "conditions" field.
+func ConditionsGTE(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGTE(FieldConditions, v))
+}
+
+// ConditionsLT applies the LT predicate on the "conditions" field.
+func ConditionsLT(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLT(FieldConditions, v))
+}
+
+// ConditionsLTE applies the LTE predicate on the "conditions" field.
+func ConditionsLTE(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLTE(FieldConditions, v))
+}
+
+// ConditionsContains applies the Contains predicate on the "conditions" field.
+func ConditionsContains(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldContains(FieldConditions, v))
+}
+
+// ConditionsHasPrefix applies the HasPrefix predicate on the "conditions" field.
+func ConditionsHasPrefix(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldHasPrefix(FieldConditions, v))
+}
+
+// ConditionsHasSuffix applies the HasSuffix predicate on the "conditions" field.
+func ConditionsHasSuffix(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldHasSuffix(FieldConditions, v))
+}
+
+// ConditionsIsNil applies the IsNil predicate on the "conditions" field.
+func ConditionsIsNil() predicate.EventRule {
+	return predicate.EventRule(sql.FieldIsNull(FieldConditions))
+}
+
+// ConditionsNotNil applies the NotNil predicate on the "conditions" field.
+func ConditionsNotNil() predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotNull(FieldConditions))
+}
+
+// ConditionsEqualFold applies the EqualFold predicate on the "conditions" field.
+func ConditionsEqualFold(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEqualFold(FieldConditions, v))
+}
+
+// ConditionsContainsFold applies the ContainsFold predicate on the "conditions" field.
+func ConditionsContainsFold(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldContainsFold(FieldConditions, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ActionGT applies the GT predicate on the "action" field.
+func ActionGT(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGT(FieldAction, v))
+}
+
+// ActionGTE applies the GTE predicate on the "action" field.
+func ActionGTE(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGTE(FieldAction, v))
+}
+
+// ActionLT applies the LT predicate on the "action" field.
+func ActionLT(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLT(FieldAction, v))
+}
+
+// ActionLTE applies the LTE predicate on the "action" field.
+func ActionLTE(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLTE(FieldAction, v))
+}
+
+// ActionContains applies the Contains predicate on the "action" field.
+func ActionContains(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldContains(FieldAction, v))
+}
+
+// ActionHasPrefix applies the HasPrefix predicate on the "action" field.
+func ActionHasPrefix(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldHasPrefix(FieldAction, v))
+}
+
+// ActionHasSuffix applies the HasSuffix predicate on the "action" field.
+func ActionHasSuffix(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldHasSuffix(FieldAction, v))
+}
+
+// ActionEqualFold applies the EqualFold predicate on the "action" field.
+func ActionEqualFold(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEqualFold(FieldAction, v))
+}
+
+// ActionContainsFold applies the ContainsFold predicate on the "action" field.
+func ActionContainsFold(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldContainsFold(FieldAction, v))
+}
+
+// ArgumentEQ applies the EQ predicate on the "argument" field.
+func ArgumentEQ(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldArgument, v))
+}
+
+// ArgumentNEQ applies the NEQ predicate on the "argument" field.
+func ArgumentNEQ(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNEQ(FieldArgument, v))
+}
+
+// ArgumentIn applies the In predicate on the "argument" field.
+func ArgumentIn(vs ...string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldIn(FieldArgument, vs...))
+}
+
+// ArgumentNotIn applies the NotIn predicate on the "argument" field.
+func ArgumentNotIn(vs ...string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotIn(FieldArgument, vs...))
+}
+
+// ArgumentGT applies the GT predicate on the "argument" field.
+func ArgumentGT(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGT(FieldArgument, v))
+}
+
+// ArgumentGTE applies the GTE predicate on the "argument" field.
+func ArgumentGTE(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGTE(FieldArgument, v))
+}
+
+// ArgumentLT applies the LT predicate on the "argument" field.
+func ArgumentLT(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLT(FieldArgument, v))
+}
+
+// ArgumentLTE applies the LTE predicate on the "argument" field.
+func ArgumentLTE(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLTE(FieldArgument, v))
+}
+
+// ArgumentContains applies the Contains predicate on the "argument" field.
+func ArgumentContains(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldContains(FieldArgument, v))
+}
+
+// ArgumentHasPrefix applies the HasPrefix predicate on the "argument" field.
+func ArgumentHasPrefix(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldHasPrefix(FieldArgument, v))
+}
+
+// ArgumentHasSuffix applies the HasSuffix predicate on the "argument" field.
+func ArgumentHasSuffix(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldHasSuffix(FieldArgument, v))
+}
+
+// ArgumentIsNil applies the IsNil predicate on the "argument" field.
+func ArgumentIsNil() predicate.EventRule {
+	return predicate.EventRule(sql.FieldIsNull(FieldArgument))
+}
+
+// ArgumentNotNil applies the NotNil predicate on the "argument" field.
+func ArgumentNotNil() predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotNull(FieldArgument))
+}
+
+// ArgumentEqualFold applies the EqualFold predicate on the "argument" field.
+func ArgumentEqualFold(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEqualFold(FieldArgument, v))
+}
+
+// ArgumentContainsFold applies the ContainsFold predicate on the "argument" field.
+func ArgumentContainsFold(v string) predicate.EventRule {
+	return predicate.EventRule(sql.FieldContainsFold(FieldArgument, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// FiredEQ applies the EQ predicate on the "fired" field.
+func FiredEQ(v int) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldFired, v))
+}
+
+// FiredNEQ applies the NEQ predicate on the "fired" field.
+func FiredNEQ(v int) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNEQ(FieldFired, v))
+}
+
+// FiredIn applies the In predicate on the "fired" field.
+func FiredIn(vs ...int) predicate.EventRule {
+	return predicate.EventRule(sql.FieldIn(FieldFired, vs...))
+}
+
+// FiredNotIn applies the NotIn predicate on the "fired" field.
+func FiredNotIn(vs ...int) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotIn(FieldFired, vs...))
+}
+
+// FiredGT applies the GT predicate on the "fired" field.
+func FiredGT(v int) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGT(FieldFired, v))
+}
+
+// FiredGTE applies the GTE predicate on the "fired" field.
+func FiredGTE(v int) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGTE(FieldFired, v))
+}
+
+// FiredLT applies the LT predicate on the "fired" field.
+func FiredLT(v int) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLT(FieldFired, v))
+}
+
+// FiredLTE applies the LTE predicate on the "fired" field.
+func FiredLTE(v int) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLTE(FieldFired, v))
+}
+
+// LastFiredAtEQ applies the EQ predicate on the "last_fired_at" field.
+func LastFiredAtEQ(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldLastFiredAt, v))
+}
+
+// LastFiredAtNEQ applies the NEQ predicate on the "last_fired_at" field.
+func LastFiredAtNEQ(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNEQ(FieldLastFiredAt, v))
+}
+
+// LastFiredAtIn applies the In predicate on the "last_fired_at" field.
+func LastFiredAtIn(vs ...time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldIn(FieldLastFiredAt, vs...))
+}
+
+// LastFiredAtNotIn applies the NotIn predicate on the "last_fired_at" field.
+func LastFiredAtNotIn(vs ...time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotIn(FieldLastFiredAt, vs...))
+}
+
+// LastFiredAtGT applies the GT predicate on the "last_fired_at" field.
+func LastFiredAtGT(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGT(FieldLastFiredAt, v))
+}
+
+// LastFiredAtGTE applies the GTE predicate on the "last_fired_at" field.
+func LastFiredAtGTE(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGTE(FieldLastFiredAt, v))
+}
+
+// LastFiredAtLT applies the LT predicate on the "last_fired_at" field.
+func LastFiredAtLT(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLT(FieldLastFiredAt, v))
+}
+
+// LastFiredAtLTE applies the LTE predicate on the "last_fired_at" field.
+func LastFiredAtLTE(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLTE(FieldLastFiredAt, v))
+}
+
+// LastFiredAtIsNil applies the IsNil predicate on the "last_fired_at" field.
+func LastFiredAtIsNil() predicate.EventRule {
+	return predicate.EventRule(sql.FieldIsNull(FieldLastFiredAt))
+}
+
+// LastFiredAtNotNil applies the NotNil predicate on the "last_fired_at" field.
+func LastFiredAtNotNil() predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotNull(FieldLastFiredAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EventRule {
+	return predicate.EventRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EventRule) predicate.EventRule {
+	return predicate.EventRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EventRule) predicate.EventRule {
+	return predicate.EventRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EventRule) predicate.EventRule {
+	return predicate.EventRule(sql.NotPredicates(p))
+}
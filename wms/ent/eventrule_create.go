@@ -0,0 +1,373 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/eventrule"
+)
+
+// EventRuleCreate is the builder for creating a EventRule entity.
+type EventRuleCreate struct {
+	config
+	mutation *EventRuleMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (_c *EventRuleCreate) SetName(v string) *EventRuleCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetEvent sets the "event" field.
+func (_c *EventRuleCreate) SetEvent(v string) *EventRuleCreate {
+	_c.mutation.SetEvent(v)
+	return _c
+}
+
+// SetConditions sets the "conditions" field.
+func (_c *EventRuleCreate) SetConditions(v string) *EventRuleCreate {
+	_c.mutation.SetConditions(v)
+	return _c
+}
+
+// SetNillableConditions sets the "conditions" field if the given value is not nil.
+func (_c *EventRuleCreate) SetNillableConditions(v *string) *EventRuleCreate {
+	if v != nil {
+		_c.SetConditions(*v)
+	}
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *EventRuleCreate) SetAction(v string) *EventRuleCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetArgument sets the "argument" field.
+func (_c *EventRuleCreate) SetArgument(v string) *EventRuleCreate {
+	_c.mutation.SetArgument(v)
+	return _c
+}
+
+// SetNillableArgument sets the "argument" field if the given value is not nil.
+func (_c *EventRuleCreate) SetNillableArgument(v *string) *EventRuleCreate {
+	if v != nil {
+		_c.SetArgument(*v)
+	}
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *EventRuleCreate) SetEnabled(v bool) *EventRuleCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *EventRuleCreate) SetNillableEnabled(v *bool) *EventRuleCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetFired sets the "fired" field.
+func (_c *EventRuleCreate) SetFired(v int) *EventRuleCreate {
+	_c.mutation.SetFired(v)
+	return _c
+}
+
+// SetNillableFired sets the "fired" field if the given value is not nil.
+func (_c *EventRuleCreate) SetNillableFired(v *int) *EventRuleCreate {
+	if v != nil {
+		_c.SetFired(*v)
+	}
+	return _c
+}
+
+// SetLastFiredAt sets the "last_fired_at" field.
+func (_c *EventRuleCreate) SetLastFiredAt(v time.Time) *EventRuleCreate {
+	_c.mutation.SetLastFiredAt(v)
+	return _c
+}
+
+// SetNillableLastFiredAt sets the "last_fired_at" field if the given value is not nil.
+func (_c *EventRuleCreate) SetNillableLastFiredAt(v *time.Time) *EventRuleCreate {
+	if v != nil {
+		_c.SetLastFiredAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EventRuleCreate) SetCreatedAt(v time.Time) *EventRuleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EventRuleCreate) SetNillableCreatedAt(v *time.Time) *EventRuleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the EventRuleMutation object of the builder.
+func (_c *EventRuleCreate) Mutation() *EventRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the EventRule in the database.
+func (_c *EventRuleCreate) Save(ctx context.Context) (*EventRule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EventRuleCreate) SaveX(ctx context.Context) *EventRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EventRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EventRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EventRuleCreate) defaults() {
+	if _, ok := _c.mutation.Conditions(); !ok {
+		v := eventrule.DefaultConditions
+		_c.mutation.SetConditions(v)
+	}
+	if _, ok := _c.mutation.Argument(); !ok {
+		v := eventrule.DefaultArgument
+		_c.mutation.SetArgument(v)
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := eventrule.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.Fired(); !ok {
+		v := eventrule.DefaultFired
+		_c.mutation.SetFired(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := eventrule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EventRuleCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "EventRule.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := eventrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "EventRule.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Event(); !ok {
+		return &ValidationError{Name: "event", err: errors.New(`ent: missing required field "EventRule.event"`)}
+	}
+	if v, ok := _c.mutation.Event(); ok {
+		if err := eventrule.EventValidator(v); err != nil {
+			return &ValidationError{Name: "event", err: fmt.Errorf(`ent: validator failed for field "EventRule.event": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "EventRule.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := eventrule.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "EventRule.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "EventRule.enabled"`)}
+	}
+	if _, ok := _c.mutation.Fired(); !ok {
+		return &ValidationError{Name: "fired", err: errors.New(`ent: missing required field "EventRule.fired"`)}
+	}
+	if v, ok := _c.mutation.Fired(); ok {
+		if err := eventrule.FiredValidator(v); err != nil {
+			return &ValidationError{Name: "fired", err: fmt.Errorf(`ent: validator failed for field "EventRule.fired": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EventRule.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *EventRuleCreate) sqlSave(ctx context.Context) (*EventRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EventRuleCreate) createSpec() (*EventRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EventRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(eventrule.Table, sqlgraph.NewFieldSpec(eventrule.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(eventrule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Event(); ok {
+		_spec.SetField(eventrule.FieldEvent, field.TypeString, value)
+		_node.Event = value
+	}
+	if value, ok := _c.mutation.Conditions(); ok {
+		_spec.SetField(eventrule.FieldConditions, field.TypeString, value)
+		_node.Conditions = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(eventrule.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.Argument(); ok {
+		_spec.SetField(eventrule.FieldArgument, field.TypeString, value)
+		_node.Argument = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(eventrule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.Fired(); ok {
+		_spec.SetField(eventrule.FieldFired, field.TypeInt, value)
+		_node.Fired = value
+	}
+	if value, ok := _c.mutation.LastFiredAt(); ok {
+		_spec.SetField(eventrule.FieldLastFiredAt, field.TypeTime, value)
+		_node.LastFiredAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(eventrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// EventRuleCreateBulk is the builder for creating many EventRule entities in bulk.
+type EventRuleCreateBulk struct {
+	config
+	err      error
+	builders []*EventRuleCreate
+}
+
+// Save creates the EventRule entities in the database.
+func (_c *EventRuleCreateBulk) Save(ctx context.Context) ([]*EventRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EventRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EventRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EventRuleCreateBulk) SaveX(ctx context.Context) []*EventRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EventRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EventRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
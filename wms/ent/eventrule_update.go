@@ -0,0 +1,612 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/eventrule"
+	"github.com/mxV03/wms/ent/predicate"
+)
+
+// EventRuleUpdate is the builder for updating EventRule entities.
+type EventRuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EventRuleMutation
+}
+
+// Where appends a list predicates to the EventRuleUpdate builder.
+func (_u *EventRuleUpdate) Where(ps ...predicate.EventRule) *EventRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *EventRuleUpdate) SetName(v string) *EventRuleUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *EventRuleUpdate) SetNillableName(v *string) *EventRuleUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetEvent sets the "event" field.
+func (_u *EventRuleUpdate) SetEvent(v string) *EventRuleUpdate {
+	_u.mutation.SetEvent(v)
+	return _u
+}
+
+// SetNillableEvent sets the "event" field if the given value is not nil.
+func (_u *EventRuleUpdate) SetNillableEvent(v *string) *EventRuleUpdate {
+	if v != nil {
+		_u.SetEvent(*v)
+	}
+	return _u
+}
+
+// SetConditions sets the "conditions" field.
+func (_u *EventRuleUpdate) SetConditions(v string) *EventRuleUpdate {
+	_u.mutation.SetConditions(v)
+	return _u
+}
+
+// SetNillableConditions sets the "conditions" field if the given value is not nil.
+func (_u *EventRuleUpdate) SetNillableConditions(v *string) *EventRuleUpdate {
+	if v != nil {
+		_u.SetConditions(*v)
+	}
+	return _u
+}
+
+// ClearConditions clears the value of the "conditions" field.
+func (_u *EventRuleUpdate) ClearConditions() *EventRuleUpdate {
+	_u.mutation.ClearConditions()
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *EventRuleUpdate) SetAction(v string) *EventRuleUpdate {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *EventRuleUpdate) SetNillableAction(v *string) *EventRuleUpdate {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetArgument sets the "argument" field.
+func (_u *EventRuleUpdate) SetArgument(v string) *EventRuleUpdate {
+	_u.mutation.SetArgument(v)
+	return _u
+}
+
+// SetNillableArgument sets the "argument" field if the given value is not nil.
+func (_u *EventRuleUpdate) SetNillableArgument(v *string) *EventRuleUpdate {
+	if v != nil {
+		_u.SetArgument(*v)
+	}
+	return _u
+}
+
+// ClearArgument clears the value of the "argument" field.
+func (_u *EventRuleUpdate) ClearArgument() *EventRuleUpdate {
+	_u.mutation.ClearArgument()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *EventRuleUpdate) SetEnabled(v bool) *EventRuleUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *EventRuleUpdate) SetNillableEnabled(v *bool) *EventRuleUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetFired sets the "fired" field.
+func (_u *EventRuleUpdate) SetFired(v int) *EventRuleUpdate {
+	_u.mutation.ResetFired()
+	_u.mutation.SetFired(v)
+	return _u
+}
+
+// SetNillableFired sets the "fired" field if the given value is not nil.
+func (_u *EventRuleUpdate) SetNillableFired(v *int) *EventRuleUpdate {
+	if v != nil {
+		_u.SetFired(*v)
+	}
+	return _u
+}
+
+// AddFired adds value to the "fired" field.
+func (_u *EventRuleUpdate) AddFired(v int) *EventRuleUpdate {
+	_u.mutation.AddFired(v)
+	return _u
+}
+
+// SetLastFiredAt sets the "last_fired_at" field.
+func (_u *EventRuleUpdate) SetLastFiredAt(v time.Time) *EventRuleUpdate {
+	_u.mutation.SetLastFiredAt(v)
+	return _u
+}
+
+// SetNillableLastFiredAt sets the "last_fired_at" field if the given value is not nil.
+func (_u *EventRuleUpdate) SetNillableLastFiredAt(v *time.Time) *EventRuleUpdate {
+	if v != nil {
+		_u.SetLastFiredAt(*v)
+	}
+	return _u
+}
+
+// ClearLastFiredAt clears the value of the "last_fired_at" field.
+func (_u *EventRuleUpdate) ClearLastFiredAt() *EventRuleUpdate {
+	_u.mutation.ClearLastFiredAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *EventRuleUpdate) SetCreatedAt(v time.Time) *EventRuleUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *EventRuleUpdate) SetNillableCreatedAt(v *time.Time) *EventRuleUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the EventRuleMutation object of the builder.
+func (_u *EventRuleUpdate) Mutation() *EventRuleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EventRuleUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EventRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EventRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EventRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EventRuleUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := eventrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "EventRule.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Event(); ok {
+		if err := eventrule.EventValidator(v); err != nil {
+			return &ValidationError{Name: "event", err: fmt.Errorf(`ent: validator failed for field "EventRule.event": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := eventrule.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "EventRule.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Fired(); ok {
+		if err := eventrule.FiredValidator(v); err != nil {
+			return &ValidationError{Name: "fired", err: fmt.Errorf(`ent: validator failed for field "EventRule.fired": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EventRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(eventrule.Table, eventrule.Columns, sqlgraph.NewFieldSpec(eventrule.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(eventrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Event(); ok {
+		_spec.SetField(eventrule.FieldEvent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Conditions(); ok {
+		_spec.SetField(eventrule.FieldConditions, field.TypeString, value)
+	}
+	if _u.mutation.ConditionsCleared() {
+		_spec.ClearField(eventrule.FieldConditions, field.TypeString)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(eventrule.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Argument(); ok {
+		_spec.SetField(eventrule.FieldArgument, field.TypeString, value)
+	}
+	if _u.mutation.ArgumentCleared() {
+		_spec.ClearField(eventrule.FieldArgument, field.TypeString)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(eventrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Fired(); ok {
+		_spec.SetField(eventrule.FieldFired, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFired(); ok {
+		_spec.AddField(eventrule.FieldFired, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastFiredAt(); ok {
+		_spec.SetField(eventrule.FieldLastFiredAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastFiredAtCleared() {
+		_spec.ClearField(eventrule.FieldLastFiredAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(eventrule.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EventRuleUpdateOne is the builder for updating a single EventRule entity.
+type EventRuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EventRuleMutation
+}
+
+// SetName sets the "name" field.
+func (_u *EventRuleUpdateOne) SetName(v string) *EventRuleUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *EventRuleUpdateOne) SetNillableName(v *string) *EventRuleUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetEvent sets the "event" field.
+func (_u *EventRuleUpdateOne) SetEvent(v string) *EventRuleUpdateOne {
+	_u.mutation.SetEvent(v)
+	return _u
+}
+
+// SetNillableEvent sets the "event" field if the given value is not nil.
+func (_u *EventRuleUpdateOne) SetNillableEvent(v *string) *EventRuleUpdateOne {
+	if v != nil {
+		_u.SetEvent(*v)
+	}
+	return _u
+}
+
+// SetConditions sets the "conditions" field.
+func (_u *EventRuleUpdateOne) SetConditions(v string) *EventRuleUpdateOne {
+	_u.mutation.SetConditions(v)
+	return _u
+}
+
+// SetNillableConditions sets the "conditions" field if the given value is not nil.
+func (_u *EventRuleUpdateOne) SetNillableConditions(v *string) *EventRuleUpdateOne {
+	if v != nil {
+		_u.SetConditions(*v)
+	}
+	return _u
+}
+
+// ClearConditions clears the value of the "conditions" field.
+func (_u *EventRuleUpdateOne) ClearConditions() *EventRuleUpdateOne {
+	_u.mutation.ClearConditions()
+	return _u
+}
+
+// SetAction sets the "action" field.
+func (_u *EventRuleUpdateOne) SetAction(v string) *EventRuleUpdateOne {
+	_u.mutation.SetAction(v)
+	return _u
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (_u *EventRuleUpdateOne) SetNillableAction(v *string) *EventRuleUpdateOne {
+	if v != nil {
+		_u.SetAction(*v)
+	}
+	return _u
+}
+
+// SetArgument sets the "argument" field.
+func (_u *EventRuleUpdateOne) SetArgument(v string) *EventRuleUpdateOne {
+	_u.mutation.SetArgument(v)
+	return _u
+}
+
+// SetNillableArgument sets the "argument" field if the given value is not nil.
+func (_u *EventRuleUpdateOne) SetNillableArgument(v *string) *EventRuleUpdateOne {
+	if v != nil {
+		_u.SetArgument(*v)
+	}
+	return _u
+}
+
+// ClearArgument clears the value of the "argument" field.
+func (_u *EventRuleUpdateOne) ClearArgument() *EventRuleUpdateOne {
+	_u.mutation.ClearArgument()
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *EventRuleUpdateOne) SetEnabled(v bool) *EventRuleUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *EventRuleUpdateOne) SetNillableEnabled(v *bool) *EventRuleUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetFired sets the "fired" field.
+func (_u *EventRuleUpdateOne) SetFired(v int) *EventRuleUpdateOne {
+	_u.mutation.ResetFired()
+	_u.mutation.SetFired(v)
+	return _u
+}
+
+// SetNillableFired sets the "fired" field if the given value is not nil.
+func (_u *EventRuleUpdateOne) SetNillableFired(v *int) *EventRuleUpdateOne {
+	if v != nil {
+		_u.SetFired(*v)
+	}
+	return _u
+}
+
+// AddFired adds value to the "fired" field.
+func (_u *EventRuleUpdateOne) AddFired(v int) *EventRuleUpdateOne {
+	_u.mutation.AddFired(v)
+	return _u
+}
+
+// SetLastFiredAt sets the "last_fired_at" field.
+func (_u *EventRuleUpdateOne) SetLastFiredAt(v time.Time) *EventRuleUpdateOne {
+	_u.mutation.SetLastFiredAt(v)
+	return _u
+}
+
+// SetNillableLastFiredAt sets the "last_fired_at" field if the given value is not nil.
+func (_u *EventRuleUpdateOne) SetNillableLastFiredAt(v *time.Time) *EventRuleUpdateOne {
+	if v != nil {
+		_u.SetLastFiredAt(*v)
+	}
+	return _u
+}
+
+// ClearLastFiredAt clears the value of the "last_fired_at" field.
+func (_u *EventRuleUpdateOne) ClearLastFiredAt() *EventRuleUpdateOne {
+	_u.mutation.ClearLastFiredAt()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *EventRuleUpdateOne) SetCreatedAt(v time.Time) *EventRuleUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *EventRuleUpdateOne) SetNillableCreatedAt(v *time.Time) *EventRuleUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the EventRuleMutation object of the builder.
+func (_u *EventRuleUpdateOne) Mutation() *EventRuleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EventRuleUpdate builder.
+func (_u *EventRuleUpdateOne) Where(ps ...predicate.EventRule) *EventRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EventRuleUpdateOne) Select(field string, fields ...string) *EventRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EventRule entity.
+func (_u *EventRuleUpdateOne) Save(ctx context.Context) (*EventRule, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EventRuleUpdateOne) SaveX(ctx context.Context) *EventRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EventRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EventRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EventRuleUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := eventrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "EventRule.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Event(); ok {
+		if err := eventrule.EventValidator(v); err != nil {
+			return &ValidationError{Name: "event", err: fmt.Errorf(`ent: validator failed for field "EventRule.event": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Action(); ok {
+		if err := eventrule.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "EventRule.action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Fired(); ok {
+		if err := eventrule.FiredValidator(v); err != nil {
+			return &ValidationError{Name: "fired", err: fmt.Errorf(`ent: validator failed for field "EventRule.fired": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EventRuleUpdateOne) sqlSave(ctx context.Context) (_node *EventRule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(eventrule.Table, eventrule.Columns, sqlgraph.NewFieldSpec(eventrule.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EventRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, eventrule.FieldID)
+		for _, f := range fields {
+			if !eventrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != eventrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(eventrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Event(); ok {
+		_spec.SetField(eventrule.FieldEvent, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Conditions(); ok {
+		_spec.SetField(eventrule.FieldConditions, field.TypeString, value)
+	}
+	if _u.mutation.ConditionsCleared() {
+		_spec.ClearField(eventrule.FieldConditions, field.TypeString)
+	}
+	if value, ok := _u.mutation.Action(); ok {
+		_spec.SetField(eventrule.FieldAction, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Argument(); ok {
+		_spec.SetField(eventrule.FieldArgument, field.TypeString, value)
+	}
+	if _u.mutation.ArgumentCleared() {
+		_spec.ClearField(eventrule.FieldArgument, field.TypeString)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(eventrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Fired(); ok {
+		_spec.SetField(eventrule.FieldFired, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFired(); ok {
+		_spec.AddField(eventrule.FieldFired, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastFiredAt(); ok {
+		_spec.SetField(eventrule.FieldLastFiredAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastFiredAtCleared() {
+		_spec.ClearField(eventrule.FieldLastFiredAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(eventrule.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &EventRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -33,6 +33,66 @@ func (f BinFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BinMutation", m)
 }
 
+// The BinStockFunc type is an adapter to allow the use of ordinary
+// function as BinStock mutator.
+type BinStockFunc func(context.Context, *ent.BinStockMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BinStockFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BinStockMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BinStockMutation", m)
+}
+
+// The CartonFunc type is an adapter to allow the use of ordinary
+// function as Carton mutator.
+type CartonFunc func(context.Context, *ent.CartonMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CartonFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CartonMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CartonMutation", m)
+}
+
+// The CartonLineFunc type is an adapter to allow the use of ordinary
+// function as CartonLine mutator.
+type CartonLineFunc func(context.Context, *ent.CartonLineMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CartonLineFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CartonLineMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CartonLineMutation", m)
+}
+
+// The CartonTypeFunc type is an adapter to allow the use of ordinary
+// function as CartonType mutator.
+type CartonTypeFunc func(context.Context, *ent.CartonTypeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CartonTypeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CartonTypeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CartonTypeMutation", m)
+}
+
+// The EventRuleFunc type is an adapter to allow the use of ordinary
+// function as EventRule mutator.
+type EventRuleFunc func(context.Context, *ent.EventRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EventRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EventRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EventRuleMutation", m)
+}
+
 // The ItemFunc type is an adapter to allow the use of ordinary
 // function as Item mutator.
 type ItemFunc func(context.Context, *ent.ItemMutation) (ent.Value, error)
@@ -105,6 +165,90 @@ func (f PickTaskFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PickTaskMutation", m)
 }
 
+// The PutawayTaskFunc type is an adapter to allow the use of ordinary
+// function as PutawayTask mutator.
+type PutawayTaskFunc func(context.Context, *ent.PutawayTaskMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PutawayTaskFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PutawayTaskMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PutawayTaskMutation", m)
+}
+
+// The ReceiptFunc type is an adapter to allow the use of ordinary
+// function as Receipt mutator.
+type ReceiptFunc func(context.Context, *ent.ReceiptMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReceiptFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReceiptMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReceiptMutation", m)
+}
+
+// The ReorderPolicyFunc type is an adapter to allow the use of ordinary
+// function as ReorderPolicy mutator.
+type ReorderPolicyFunc func(context.Context, *ent.ReorderPolicyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReorderPolicyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReorderPolicyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReorderPolicyMutation", m)
+}
+
+// The ReplenishmentRuleFunc type is an adapter to allow the use of ordinary
+// function as ReplenishmentRule mutator.
+type ReplenishmentRuleFunc func(context.Context, *ent.ReplenishmentRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReplenishmentRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReplenishmentRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReplenishmentRuleMutation", m)
+}
+
+// The ReplenishmentTaskFunc type is an adapter to allow the use of ordinary
+// function as ReplenishmentTask mutator.
+type ReplenishmentTaskFunc func(context.Context, *ent.ReplenishmentTaskMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReplenishmentTaskFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReplenishmentTaskMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReplenishmentTaskMutation", m)
+}
+
+// The SequenceFunc type is an adapter to allow the use of ordinary
+// function as Sequence mutator.
+type SequenceFunc func(context.Context, *ent.SequenceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SequenceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SequenceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SequenceMutation", m)
+}
+
+// The StockDiscrepancyFunc type is an adapter to allow the use of ordinary
+// function as StockDiscrepancy mutator.
+type StockDiscrepancyFunc func(context.Context, *ent.StockDiscrepancyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StockDiscrepancyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.StockDiscrepancyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StockDiscrepancyMutation", m)
+}
+
 // The StockMovementFunc type is an adapter to allow the use of ordinary
 // function as StockMovement mutator.
 type StockMovementFunc func(context.Context, *ent.StockMovementMutation) (ent.Value, error)
@@ -165,6 +309,42 @@ func (f WarehouseLocationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WarehouseLocationMutation", m)
 }
 
+// The WaveFunc type is an adapter to allow the use of ordinary
+// function as Wave mutator.
+type WaveFunc func(context.Context, *ent.WaveMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WaveFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WaveMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WaveMutation", m)
+}
+
+// The WavePickFunc type is an adapter to allow the use of ordinary
+// function as WavePick mutator.
+type WavePickFunc func(context.Context, *ent.WavePickMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WavePickFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WavePickMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WavePickMutation", m)
+}
+
+// The WaveTaskFunc type is an adapter to allow the use of ordinary
+// function as WaveTask mutator.
+type WaveTaskFunc func(context.Context, *ent.WaveTaskMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WaveTaskFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WaveTaskMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WaveTaskMutation", m)
+}
+
 // The ZoneFunc type is an adapter to allow the use of ordinary
 // function as Zone mutator.
 type ZoneFunc func(context.Context, *ent.ZoneMutation) (ent.Value, error)
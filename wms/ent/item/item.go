@@ -18,6 +18,16 @@ const (
 	FieldName = "name"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
+	// FieldLength holds the string denoting the length field in the database.
+	FieldLength = "length"
+	// FieldWidth holds the string denoting the width field in the database.
+	FieldWidth = "width"
+	// FieldHeight holds the string denoting the height field in the database.
+	FieldHeight = "height"
+	// FieldWeight holds the string denoting the weight field in the database.
+	FieldWeight = "weight"
+	// FieldUnitPrice holds the string denoting the unit_price field in the database.
+	FieldUnitPrice = "unit_price"
 	// EdgeMovements holds the string denoting the movements edge name in mutations.
 	EdgeMovements = "movements"
 	// EdgeOrderLines holds the string denoting the order_lines edge name in mutations.
@@ -53,6 +63,11 @@ var Columns = []string{
 	FieldSKU,
 	FieldName,
 	FieldDescription,
+	FieldLength,
+	FieldWidth,
+	FieldHeight,
+	FieldWeight,
+	FieldUnitPrice,
 }
 
 var (
@@ -76,6 +91,16 @@ var (
 	SKUValidator func(string) error
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
+	// DefaultLength holds the default value on creation for the "length" field.
+	DefaultLength float64
+	// DefaultWidth holds the default value on creation for the "width" field.
+	DefaultWidth float64
+	// DefaultHeight holds the default value on creation for the "height" field.
+	DefaultHeight float64
+	// DefaultWeight holds the default value on creation for the "weight" field.
+	DefaultWeight float64
+	// DefaultUnitPrice holds the default value on creation for the "unit_price" field.
+	DefaultUnitPrice float64
 )
 
 // OrderOption defines the ordering options for the Item queries.
@@ -101,6 +126,31 @@ func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
 }
 
+// ByLength orders the results by the length field.
+func ByLength(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLength, opts...).ToFunc()
+}
+
+// ByWidth orders the results by the width field.
+func ByWidth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWidth, opts...).ToFunc()
+}
+
+// ByHeight orders the results by the height field.
+func ByHeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeight, opts...).ToFunc()
+}
+
+// ByWeight orders the results by the weight field.
+func ByWeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWeight, opts...).ToFunc()
+}
+
+// ByUnitPrice orders the results by the unit_price field.
+func ByUnitPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnitPrice, opts...).ToFunc()
+}
+
 // ByMovementsCount orders the results by movements count.
 func ByMovementsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
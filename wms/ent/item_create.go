@@ -48,6 +48,76 @@ func (_c *ItemCreate) SetNillableDescription(v *string) *ItemCreate {
 	return _c
 }
 
+// SetLength sets the "length" field.
+func (_c *ItemCreate) SetLength(v float64) *ItemCreate {
+	_c.mutation.SetLength(v)
+	return _c
+}
+
+// SetNillableLength sets the "length" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableLength(v *float64) *ItemCreate {
+	if v != nil {
+		_c.SetLength(*v)
+	}
+	return _c
+}
+
+// SetWidth sets the "width" field.
+func (_c *ItemCreate) SetWidth(v float64) *ItemCreate {
+	_c.mutation.SetWidth(v)
+	return _c
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableWidth(v *float64) *ItemCreate {
+	if v != nil {
+		_c.SetWidth(*v)
+	}
+	return _c
+}
+
+// SetHeight sets the "height" field.
+func (_c *ItemCreate) SetHeight(v float64) *ItemCreate {
+	_c.mutation.SetHeight(v)
+	return _c
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableHeight(v *float64) *ItemCreate {
+	if v != nil {
+		_c.SetHeight(*v)
+	}
+	return _c
+}
+
+// SetWeight sets the "weight" field.
+func (_c *ItemCreate) SetWeight(v float64) *ItemCreate {
+	_c.mutation.SetWeight(v)
+	return _c
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableWeight(v *float64) *ItemCreate {
+	if v != nil {
+		_c.SetWeight(*v)
+	}
+	return _c
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (_c *ItemCreate) SetUnitPrice(v float64) *ItemCreate {
+	_c.mutation.SetUnitPrice(v)
+	return _c
+}
+
+// SetNillableUnitPrice sets the "unit_price" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableUnitPrice(v *float64) *ItemCreate {
+	if v != nil {
+		_c.SetUnitPrice(*v)
+	}
+	return _c
+}
+
 // AddMovementIDs adds the "movements" edge to the StockMovement entity by IDs.
 func (_c *ItemCreate) AddMovementIDs(ids ...int) *ItemCreate {
 	_c.mutation.AddMovementIDs(ids...)
@@ -100,6 +170,7 @@ func (_c *ItemCreate) Mutation() *ItemMutation {
 
 // Save creates the Item in the database.
 func (_c *ItemCreate) Save(ctx context.Context) (*Item, error) {
+	_c.defaults()
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -125,6 +196,30 @@ func (_c *ItemCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_c *ItemCreate) defaults() {
+	if _, ok := _c.mutation.Length(); !ok {
+		v := item.DefaultLength
+		_c.mutation.SetLength(v)
+	}
+	if _, ok := _c.mutation.Width(); !ok {
+		v := item.DefaultWidth
+		_c.mutation.SetWidth(v)
+	}
+	if _, ok := _c.mutation.Height(); !ok {
+		v := item.DefaultHeight
+		_c.mutation.SetHeight(v)
+	}
+	if _, ok := _c.mutation.Weight(); !ok {
+		v := item.DefaultWeight
+		_c.mutation.SetWeight(v)
+	}
+	if _, ok := _c.mutation.UnitPrice(); !ok {
+		v := item.DefaultUnitPrice
+		_c.mutation.SetUnitPrice(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_c *ItemCreate) check() error {
 	if _, ok := _c.mutation.SKU(); !ok {
@@ -181,6 +276,26 @@ func (_c *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
 		_spec.SetField(item.FieldDescription, field.TypeString, value)
 		_node.Description = value
 	}
+	if value, ok := _c.mutation.Length(); ok {
+		_spec.SetField(item.FieldLength, field.TypeFloat64, value)
+		_node.Length = value
+	}
+	if value, ok := _c.mutation.Width(); ok {
+		_spec.SetField(item.FieldWidth, field.TypeFloat64, value)
+		_node.Width = value
+	}
+	if value, ok := _c.mutation.Height(); ok {
+		_spec.SetField(item.FieldHeight, field.TypeFloat64, value)
+		_node.Height = value
+	}
+	if value, ok := _c.mutation.Weight(); ok {
+		_spec.SetField(item.FieldWeight, field.TypeFloat64, value)
+		_node.Weight = value
+	}
+	if value, ok := _c.mutation.UnitPrice(); ok {
+		_spec.SetField(item.FieldUnitPrice, field.TypeFloat64, value)
+		_node.UnitPrice = value
+	}
 	if nodes := _c.mutation.MovementsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -250,6 +365,7 @@ func (_c *ItemCreateBulk) Save(ctx context.Context) ([]*Item, error) {
 	for i := range _c.builders {
 		func(i int, root context.Context) {
 			builder := _c.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*ItemMutation)
 				if !ok {
@@ -78,6 +78,141 @@ func (_u *ItemUpdate) ClearDescription() *ItemUpdate {
 	return _u
 }
 
+// SetLength sets the "length" field.
+func (_u *ItemUpdate) SetLength(v float64) *ItemUpdate {
+	_u.mutation.ResetLength()
+	_u.mutation.SetLength(v)
+	return _u
+}
+
+// SetNillableLength sets the "length" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableLength(v *float64) *ItemUpdate {
+	if v != nil {
+		_u.SetLength(*v)
+	}
+	return _u
+}
+
+// AddLength adds value to the "length" field.
+func (_u *ItemUpdate) AddLength(v float64) *ItemUpdate {
+	_u.mutation.AddLength(v)
+	return _u
+}
+
+// ClearLength clears the value of the "length" field.
+func (_u *ItemUpdate) ClearLength() *ItemUpdate {
+	_u.mutation.ClearLength()
+	return _u
+}
+
+// SetWidth sets the "width" field.
+func (_u *ItemUpdate) SetWidth(v float64) *ItemUpdate {
+	_u.mutation.ResetWidth()
+	_u.mutation.SetWidth(v)
+	return _u
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableWidth(v *float64) *ItemUpdate {
+	if v != nil {
+		_u.SetWidth(*v)
+	}
+	return _u
+}
+
+// AddWidth adds value to the "width" field.
+func (_u *ItemUpdate) AddWidth(v float64) *ItemUpdate {
+	_u.mutation.AddWidth(v)
+	return _u
+}
+
+// ClearWidth clears the value of the "width" field.
+func (_u *ItemUpdate) ClearWidth() *ItemUpdate {
+	_u.mutation.ClearWidth()
+	return _u
+}
+
+// SetHeight sets the "height" field.
+func (_u *ItemUpdate) SetHeight(v float64) *ItemUpdate {
+	_u.mutation.ResetHeight()
+	_u.mutation.SetHeight(v)
+	return _u
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableHeight(v *float64) *ItemUpdate {
+	if v != nil {
+		_u.SetHeight(*v)
+	}
+	return _u
+}
+
+// AddHeight adds value to the "height" field.
+func (_u *ItemUpdate) AddHeight(v float64) *ItemUpdate {
+	_u.mutation.AddHeight(v)
+	return _u
+}
+
+// ClearHeight clears the value of the "height" field.
+func (_u *ItemUpdate) ClearHeight() *ItemUpdate {
+	_u.mutation.ClearHeight()
+	return _u
+}
+
+// SetWeight sets the "weight" field.
+func (_u *ItemUpdate) SetWeight(v float64) *ItemUpdate {
+	_u.mutation.ResetWeight()
+	_u.mutation.SetWeight(v)
+	return _u
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableWeight(v *float64) *ItemUpdate {
+	if v != nil {
+		_u.SetWeight(*v)
+	}
+	return _u
+}
+
+// AddWeight adds value to the "weight" field.
+func (_u *ItemUpdate) AddWeight(v float64) *ItemUpdate {
+	_u.mutation.AddWeight(v)
+	return _u
+}
+
+// ClearWeight clears the value of the "weight" field.
+func (_u *ItemUpdate) ClearWeight() *ItemUpdate {
+	_u.mutation.ClearWeight()
+	return _u
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (_u *ItemUpdate) SetUnitPrice(v float64) *ItemUpdate {
+	_u.mutation.ResetUnitPrice()
+	_u.mutation.SetUnitPrice(v)
+	return _u
+}
+
+// SetNillableUnitPrice sets the "unit_price" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableUnitPrice(v *float64) *ItemUpdate {
+	if v != nil {
+		_u.SetUnitPrice(*v)
+	}
+	return _u
+}
+
+// AddUnitPrice adds value to the "unit_price" field.
+func (_u *ItemUpdate) AddUnitPrice(v float64) *ItemUpdate {
+	_u.mutation.AddUnitPrice(v)
+	return _u
+}
+
+// ClearUnitPrice clears the value of the "unit_price" field.
+func (_u *ItemUpdate) ClearUnitPrice() *ItemUpdate {
+	_u.mutation.ClearUnitPrice()
+	return _u
+}
+
 // AddMovementIDs adds the "movements" edge to the StockMovement entity by IDs.
 func (_u *ItemUpdate) AddMovementIDs(ids ...int) *ItemUpdate {
 	_u.mutation.AddMovementIDs(ids...)
@@ -257,6 +392,51 @@ func (_u *ItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.DescriptionCleared() {
 		_spec.ClearField(item.FieldDescription, field.TypeString)
 	}
+	if value, ok := _u.mutation.Length(); ok {
+		_spec.SetField(item.FieldLength, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLength(); ok {
+		_spec.AddField(item.FieldLength, field.TypeFloat64, value)
+	}
+	if _u.mutation.LengthCleared() {
+		_spec.ClearField(item.FieldLength, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Width(); ok {
+		_spec.SetField(item.FieldWidth, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedWidth(); ok {
+		_spec.AddField(item.FieldWidth, field.TypeFloat64, value)
+	}
+	if _u.mutation.WidthCleared() {
+		_spec.ClearField(item.FieldWidth, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Height(); ok {
+		_spec.SetField(item.FieldHeight, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedHeight(); ok {
+		_spec.AddField(item.FieldHeight, field.TypeFloat64, value)
+	}
+	if _u.mutation.HeightCleared() {
+		_spec.ClearField(item.FieldHeight, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Weight(); ok {
+		_spec.SetField(item.FieldWeight, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedWeight(); ok {
+		_spec.AddField(item.FieldWeight, field.TypeFloat64, value)
+	}
+	if _u.mutation.WeightCleared() {
+		_spec.ClearField(item.FieldWeight, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.UnitPrice(); ok {
+		_spec.SetField(item.FieldUnitPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedUnitPrice(); ok {
+		_spec.AddField(item.FieldUnitPrice, field.TypeFloat64, value)
+	}
+	if _u.mutation.UnitPriceCleared() {
+		_spec.ClearField(item.FieldUnitPrice, field.TypeFloat64)
+	}
 	if _u.mutation.MovementsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -460,6 +640,141 @@ func (_u *ItemUpdateOne) ClearDescription() *ItemUpdateOne {
 	return _u
 }
 
+// SetLength sets the "length" field.
+func (_u *ItemUpdateOne) SetLength(v float64) *ItemUpdateOne {
+	_u.mutation.ResetLength()
+	_u.mutation.SetLength(v)
+	return _u
+}
+
+// SetNillableLength sets the "length" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableLength(v *float64) *ItemUpdateOne {
+	if v != nil {
+		_u.SetLength(*v)
+	}
+	return _u
+}
+
+// AddLength adds value to the "length" field.
+func (_u *ItemUpdateOne) AddLength(v float64) *ItemUpdateOne {
+	_u.mutation.AddLength(v)
+	return _u
+}
+
+// ClearLength clears the value of the "length" field.
+func (_u *ItemUpdateOne) ClearLength() *ItemUpdateOne {
+	_u.mutation.ClearLength()
+	return _u
+}
+
+// SetWidth sets the "width" field.
+func (_u *ItemUpdateOne) SetWidth(v float64) *ItemUpdateOne {
+	_u.mutation.ResetWidth()
+	_u.mutation.SetWidth(v)
+	return _u
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableWidth(v *float64) *ItemUpdateOne {
+	if v != nil {
+		_u.SetWidth(*v)
+	}
+	return _u
+}
+
+// AddWidth adds value to the "width" field.
+func (_u *ItemUpdateOne) AddWidth(v float64) *ItemUpdateOne {
+	_u.mutation.AddWidth(v)
+	return _u
+}
+
+// ClearWidth clears the value of the "width" field.
+func (_u *ItemUpdateOne) ClearWidth() *ItemUpdateOne {
+	_u.mutation.ClearWidth()
+	return _u
+}
+
+// SetHeight sets the "height" field.
+func (_u *ItemUpdateOne) SetHeight(v float64) *ItemUpdateOne {
+	_u.mutation.ResetHeight()
+	_u.mutation.SetHeight(v)
+	return _u
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableHeight(v *float64) *ItemUpdateOne {
+	if v != nil {
+		_u.SetHeight(*v)
+	}
+	return _u
+}
+
+// AddHeight adds value to the "height" field.
+func (_u *ItemUpdateOne) AddHeight(v float64) *ItemUpdateOne {
+	_u.mutation.AddHeight(v)
+	return _u
+}
+
+// ClearHeight clears the value of the "height" field.
+func (_u *ItemUpdateOne) ClearHeight() *ItemUpdateOne {
+	_u.mutation.ClearHeight()
+	return _u
+}
+
+// SetWeight sets the "weight" field.
+func (_u *ItemUpdateOne) SetWeight(v float64) *ItemUpdateOne {
+	_u.mutation.ResetWeight()
+	_u.mutation.SetWeight(v)
+	return _u
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableWeight(v *float64) *ItemUpdateOne {
+	if v != nil {
+		_u.SetWeight(*v)
+	}
+	return _u
+}
+
+// AddWeight adds value to the "weight" field.
+func (_u *ItemUpdateOne) AddWeight(v float64) *ItemUpdateOne {
+	_u.mutation.AddWeight(v)
+	return _u
+}
+
+// ClearWeight clears the value of the "weight" field.
+func (_u *ItemUpdateOne) ClearWeight() *ItemUpdateOne {
+	_u.mutation.ClearWeight()
+	return _u
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (_u *ItemUpdateOne) SetUnitPrice(v float64) *ItemUpdateOne {
+	_u.mutation.ResetUnitPrice()
+	_u.mutation.SetUnitPrice(v)
+	return _u
+}
+
+// SetNillableUnitPrice sets the "unit_price" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableUnitPrice(v *float64) *ItemUpdateOne {
+	if v != nil {
+		_u.SetUnitPrice(*v)
+	}
+	return _u
+}
+
+// AddUnitPrice adds value to the "unit_price" field.
+func (_u *ItemUpdateOne) AddUnitPrice(v float64) *ItemUpdateOne {
+	_u.mutation.AddUnitPrice(v)
+	return _u
+}
+
+// ClearUnitPrice clears the value of the "unit_price" field.
+func (_u *ItemUpdateOne) ClearUnitPrice() *ItemUpdateOne {
+	_u.mutation.ClearUnitPrice()
+	return _u
+}
+
 // AddMovementIDs adds the "movements" edge to the StockMovement entity by IDs.
 func (_u *ItemUpdateOne) AddMovementIDs(ids ...int) *ItemUpdateOne {
 	_u.mutation.AddMovementIDs(ids...)
@@ -669,6 +984,51 @@ func (_u *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error) {
 	if _u.mutation.DescriptionCleared() {
 		_spec.ClearField(item.FieldDescription, field.TypeString)
 	}
+	if value, ok := _u.mutation.Length(); ok {
+		_spec.SetField(item.FieldLength, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLength(); ok {
+		_spec.AddField(item.FieldLength, field.TypeFloat64, value)
+	}
+	if _u.mutation.LengthCleared() {
+		_spec.ClearField(item.FieldLength, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Width(); ok {
+		_spec.SetField(item.FieldWidth, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedWidth(); ok {
+		_spec.AddField(item.FieldWidth, field.TypeFloat64, value)
+	}
+	if _u.mutation.WidthCleared() {
+		_spec.ClearField(item.FieldWidth, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Height(); ok {
+		_spec.SetField(item.FieldHeight, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedHeight(); ok {
+		_spec.AddField(item.FieldHeight, field.TypeFloat64, value)
+	}
+	if _u.mutation.HeightCleared() {
+		_spec.ClearField(item.FieldHeight, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Weight(); ok {
+		_spec.SetField(item.FieldWeight, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedWeight(); ok {
+		_spec.AddField(item.FieldWeight, field.TypeFloat64, value)
+	}
+	if _u.mutation.WeightCleared() {
+		_spec.ClearField(item.FieldWeight, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.UnitPrice(); ok {
+		_spec.SetField(item.FieldUnitPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedUnitPrice(); ok {
+		_spec.AddField(item.FieldUnitPrice, field.TypeFloat64, value)
+	}
+	if _u.mutation.UnitPriceCleared() {
+		_spec.ClearField(item.FieldUnitPrice, field.TypeFloat64)
+	}
 	if _u.mutation.MovementsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
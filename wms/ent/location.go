@@ -21,6 +21,10 @@ type Location struct {
 	Code string `json:"code,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// OnHold holds the value of the "on_hold" field.
+	OnHold bool `json:"on_hold,omitempty"`
+	// HoldReason holds the value of the "hold_reason" field.
+	HoldReason string `json:"hold_reason,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the LocationQuery when eager-loading is set.
 	Edges        LocationEdges `json:"edges"`
@@ -96,9 +100,11 @@ func (*Location) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case location.FieldOnHold:
+			values[i] = new(sql.NullBool)
 		case location.FieldID:
 			values[i] = new(sql.NullInt64)
-		case location.FieldCode, location.FieldName:
+		case location.FieldCode, location.FieldName, location.FieldHoldReason:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -133,6 +139,18 @@ func (_m *Location) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Name = value.String
 			}
+		case location.FieldOnHold:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field on_hold", values[i])
+			} else if value.Valid {
+				_m.OnHold = value.Bool
+			}
+		case location.FieldHoldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hold_reason", values[i])
+			} else if value.Valid {
+				_m.HoldReason = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -199,6 +217,12 @@ func (_m *Location) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("on_hold=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OnHold))
+	builder.WriteString(", ")
+	builder.WriteString("hold_reason=")
+	builder.WriteString(_m.HoldReason)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -16,6 +16,10 @@ const (
 	FieldCode = "code"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldOnHold holds the string denoting the on_hold field in the database.
+	FieldOnHold = "on_hold"
+	// FieldHoldReason holds the string denoting the hold_reason field in the database.
+	FieldHoldReason = "hold_reason"
 	// EdgeMovements holds the string denoting the movements edge name in mutations.
 	EdgeMovements = "movements"
 	// EdgeOrderLines holds the string denoting the order_lines edge name in mutations.
@@ -70,6 +74,8 @@ var Columns = []string{
 	FieldID,
 	FieldCode,
 	FieldName,
+	FieldOnHold,
+	FieldHoldReason,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -87,6 +93,10 @@ var (
 	CodeValidator func(string) error
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
+	// DefaultOnHold holds the default value on creation for the "on_hold" field.
+	DefaultOnHold bool
+	// DefaultHoldReason holds the default value on creation for the "hold_reason" field.
+	DefaultHoldReason string
 )
 
 // OrderOption defines the ordering options for the Location queries.
@@ -107,6 +117,16 @@ func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
 }
 
+// ByOnHold orders the results by the on_hold field.
+func ByOnHold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOnHold, opts...).ToFunc()
+}
+
+// ByHoldReason orders the results by the hold_reason field.
+func ByHoldReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHoldReason, opts...).ToFunc()
+}
+
 // ByMovementsCount orders the results by movements count.
 func ByMovementsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
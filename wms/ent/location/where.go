@@ -63,6 +63,16 @@ func Name(v string) predicate.Location {
 	return predicate.Location(sql.FieldEQ(FieldName, v))
 }
 
+// OnHold applies equality check predicate on the "on_hold" field. It's identical to OnHoldEQ.
+func OnHold(v bool) predicate.Location {
+	return predicate.Location(sql.FieldEQ(FieldOnHold, v))
+}
+
+// HoldReason applies equality check predicate on the "hold_reason" field. It's identical to HoldReasonEQ.
+func HoldReason(v string) predicate.Location {
+	return predicate.Location(sql.FieldEQ(FieldHoldReason, v))
+}
+
 // CodeEQ applies the EQ predicate on the "code" field.
 func CodeEQ(v string) predicate.Location {
 	return predicate.Location(sql.FieldEQ(FieldCode, v))
@@ -193,6 +203,91 @@ func NameContainsFold(v string) predicate.Location {
 	return predicate.Location(sql.FieldContainsFold(FieldName, v))
 }
 
+// OnHoldEQ applies the EQ predicate on the "on_hold" field.
+func OnHoldEQ(v bool) predicate.Location {
+	return predicate.Location(sql.FieldEQ(FieldOnHold, v))
+}
+
+// OnHoldNEQ applies the NEQ predicate on the "on_hold" field.
+func OnHoldNEQ(v bool) predicate.Location {
+	return predicate.Location(sql.FieldNEQ(FieldOnHold, v))
+}
+
+// HoldReasonEQ applies the EQ predicate on the "hold_reason" field.
+func HoldReasonEQ(v string) predicate.Location {
+	return predicate.Location(sql.FieldEQ(FieldHoldReason, v))
+}
+
+// HoldReasonNEQ applies the NEQ predicate on the "hold_reason" field.
+func HoldReasonNEQ(v string) predicate.Location {
+	return predicate.Location(sql.FieldNEQ(FieldHoldReason, v))
+}
+
+// HoldReasonIn applies the In predicate on the "hold_reason" field.
+func HoldReasonIn(vs ...string) predicate.Location {
+	return predicate.Location(sql.FieldIn(FieldHoldReason, vs...))
+}
+
+// HoldReasonNotIn applies the NotIn predicate on the "hold_reason" field.
+func HoldReasonNotIn(vs ...string) predicate.Location {
+	return predicate.Location(sql.FieldNotIn(FieldHoldReason, vs...))
+}
+
+// HoldReasonGT applies the GT predicate on the "hold_reason" field.
+func HoldReasonGT(v string) predicate.Location {
+	return predicate.Location(sql.FieldGT(FieldHoldReason, v))
+}
+
+// HoldReasonGTE applies the GTE predicate on the "hold_reason" field.
+func HoldReasonGTE(v string) predicate.Location {
+	return predicate.Location(sql.FieldGTE(FieldHoldReason, v))
+}
+
+// HoldReasonLT applies the LT predicate on the "hold_reason" field.
+func HoldReasonLT(v string) predicate.Location {
+	return predicate.Location(sql.FieldLT(FieldHoldReason, v))
+}
+
+// HoldReasonLTE applies the LTE predicate on the "hold_reason" field.
+func HoldReasonLTE(v string) predicate.Location {
+	return predicate.Location(sql.FieldLTE(FieldHoldReason, v))
+}
+
+// HoldReasonContains applies the Contains predicate on the "hold_reason" field.
+func HoldReasonContains(v string) predicate.Location {
+	return predicate.Location(sql.FieldContains(FieldHoldReason, v))
+}
+
+// HoldReasonHasPrefix applies the HasPrefix predicate on the "hold_reason" field.
+func HoldReasonHasPrefix(v string) predicate.Location {
+	return predicate.Location(sql.FieldHasPrefix(FieldHoldReason, v))
+}
+
+// HoldReasonHasSuffix applies the HasSuffix predicate on the "hold_reason" field.
+func HoldReasonHasSuffix(v string) predicate.Location {
+	return predicate.Location(sql.FieldHasSuffix(FieldHoldReason, v))
+}
+
+// HoldReasonIsNil applies the IsNil predicate on the "hold_reason" field.
+func HoldReasonIsNil() predicate.Location {
+	return predicate.Location(sql.FieldIsNull(FieldHoldReason))
+}
+
+// HoldReasonNotNil applies the NotNil predicate on the "hold_reason" field.
+func HoldReasonNotNil() predicate.Location {
+	return predicate.Location(sql.FieldNotNull(FieldHoldReason))
+}
+
+// HoldReasonEqualFold applies the EqualFold predicate on the "hold_reason" field.
+func HoldReasonEqualFold(v string) predicate.Location {
+	return predicate.Location(sql.FieldEqualFold(FieldHoldReason, v))
+}
+
+// HoldReasonContainsFold applies the ContainsFold predicate on the "hold_reason" field.
+func HoldReasonContainsFold(v string) predicate.Location {
+	return predicate.Location(sql.FieldContainsFold(FieldHoldReason, v))
+}
+
 // HasMovements applies the HasEdge predicate on the "movements" edge.
 func HasMovements() predicate.Location {
 	return predicate.Location(func(s *sql.Selector) {
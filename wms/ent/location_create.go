@@ -36,6 +36,34 @@ func (_c *LocationCreate) SetName(v string) *LocationCreate {
 	return _c
 }
 
+// SetOnHold sets the "on_hold" field.
+func (_c *LocationCreate) SetOnHold(v bool) *LocationCreate {
+	_c.mutation.SetOnHold(v)
+	return _c
+}
+
+// SetNillableOnHold sets the "on_hold" field if the given value is not nil.
+func (_c *LocationCreate) SetNillableOnHold(v *bool) *LocationCreate {
+	if v != nil {
+		_c.SetOnHold(*v)
+	}
+	return _c
+}
+
+// SetHoldReason sets the "hold_reason" field.
+func (_c *LocationCreate) SetHoldReason(v string) *LocationCreate {
+	_c.mutation.SetHoldReason(v)
+	return _c
+}
+
+// SetNillableHoldReason sets the "hold_reason" field if the given value is not nil.
+func (_c *LocationCreate) SetNillableHoldReason(v *string) *LocationCreate {
+	if v != nil {
+		_c.SetHoldReason(*v)
+	}
+	return _c
+}
+
 // AddMovementIDs adds the "movements" edge to the StockMovement entity by IDs.
 func (_c *LocationCreate) AddMovementIDs(ids ...int) *LocationCreate {
 	_c.mutation.AddMovementIDs(ids...)
@@ -122,6 +150,7 @@ func (_c *LocationCreate) Mutation() *LocationMutation {
 
 // Save creates the Location in the database.
 func (_c *LocationCreate) Save(ctx context.Context) (*Location, error) {
+	_c.defaults()
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -147,6 +176,18 @@ func (_c *LocationCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_c *LocationCreate) defaults() {
+	if _, ok := _c.mutation.OnHold(); !ok {
+		v := location.DefaultOnHold
+		_c.mutation.SetOnHold(v)
+	}
+	if _, ok := _c.mutation.HoldReason(); !ok {
+		v := location.DefaultHoldReason
+		_c.mutation.SetHoldReason(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_c *LocationCreate) check() error {
 	if _, ok := _c.mutation.Code(); !ok {
@@ -165,6 +206,9 @@ func (_c *LocationCreate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Location.name": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.OnHold(); !ok {
+		return &ValidationError{Name: "on_hold", err: errors.New(`ent: missing required field "Location.on_hold"`)}
+	}
 	return nil
 }
 
@@ -199,6 +243,14 @@ func (_c *LocationCreate) createSpec() (*Location, *sqlgraph.CreateSpec) {
 		_spec.SetField(location.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := _c.mutation.OnHold(); ok {
+		_spec.SetField(location.FieldOnHold, field.TypeBool, value)
+		_node.OnHold = value
+	}
+	if value, ok := _c.mutation.HoldReason(); ok {
+		_spec.SetField(location.FieldHoldReason, field.TypeString, value)
+		_node.HoldReason = value
+	}
 	if nodes := _c.mutation.MovementsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -300,6 +352,7 @@ func (_c *LocationCreateBulk) Save(ctx context.Context) ([]*Location, error) {
 	for i := range _c.builders {
 		func(i int, root context.Context) {
 			builder := _c.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*LocationMutation)
 				if !ok {
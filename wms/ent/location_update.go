@@ -60,6 +60,40 @@ func (_u *LocationUpdate) SetNillableName(v *string) *LocationUpdate {
 	return _u
 }
 
+// SetOnHold sets the "on_hold" field.
+func (_u *LocationUpdate) SetOnHold(v bool) *LocationUpdate {
+	_u.mutation.SetOnHold(v)
+	return _u
+}
+
+// SetNillableOnHold sets the "on_hold" field if the given value is not nil.
+func (_u *LocationUpdate) SetNillableOnHold(v *bool) *LocationUpdate {
+	if v != nil {
+		_u.SetOnHold(*v)
+	}
+	return _u
+}
+
+// SetHoldReason sets the "hold_reason" field.
+func (_u *LocationUpdate) SetHoldReason(v string) *LocationUpdate {
+	_u.mutation.SetHoldReason(v)
+	return _u
+}
+
+// SetNillableHoldReason sets the "hold_reason" field if the given value is not nil.
+func (_u *LocationUpdate) SetNillableHoldReason(v *string) *LocationUpdate {
+	if v != nil {
+		_u.SetHoldReason(*v)
+	}
+	return _u
+}
+
+// ClearHoldReason clears the value of the "hold_reason" field.
+func (_u *LocationUpdate) ClearHoldReason() *LocationUpdate {
+	_u.mutation.ClearHoldReason()
+	return _u
+}
+
 // AddMovementIDs adds the "movements" edge to the StockMovement entity by IDs.
 func (_u *LocationUpdate) AddMovementIDs(ids ...int) *LocationUpdate {
 	_u.mutation.AddMovementIDs(ids...)
@@ -294,6 +328,15 @@ func (_u *LocationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(location.FieldName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.OnHold(); ok {
+		_spec.SetField(location.FieldOnHold, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.HoldReason(); ok {
+		_spec.SetField(location.FieldHoldReason, field.TypeString, value)
+	}
+	if _u.mutation.HoldReasonCleared() {
+		_spec.ClearField(location.FieldHoldReason, field.TypeString)
+	}
 	if _u.mutation.MovementsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -551,6 +594,40 @@ func (_u *LocationUpdateOne) SetNillableName(v *string) *LocationUpdateOne {
 	return _u
 }
 
+// SetOnHold sets the "on_hold" field.
+func (_u *LocationUpdateOne) SetOnHold(v bool) *LocationUpdateOne {
+	_u.mutation.SetOnHold(v)
+	return _u
+}
+
+// SetNillableOnHold sets the "on_hold" field if the given value is not nil.
+func (_u *LocationUpdateOne) SetNillableOnHold(v *bool) *LocationUpdateOne {
+	if v != nil {
+		_u.SetOnHold(*v)
+	}
+	return _u
+}
+
+// SetHoldReason sets the "hold_reason" field.
+func (_u *LocationUpdateOne) SetHoldReason(v string) *LocationUpdateOne {
+	_u.mutation.SetHoldReason(v)
+	return _u
+}
+
+// SetNillableHoldReason sets the "hold_reason" field if the given value is not nil.
+func (_u *LocationUpdateOne) SetNillableHoldReason(v *string) *LocationUpdateOne {
+	if v != nil {
+		_u.SetHoldReason(*v)
+	}
+	return _u
+}
+
+// ClearHoldReason clears the value of the "hold_reason" field.
+func (_u *LocationUpdateOne) ClearHoldReason() *LocationUpdateOne {
+	_u.mutation.ClearHoldReason()
+	return _u
+}
+
 // AddMovementIDs adds the "movements" edge to the StockMovement entity by IDs.
 func (_u *LocationUpdateOne) AddMovementIDs(ids ...int) *LocationUpdateOne {
 	_u.mutation.AddMovementIDs(ids...)
@@ -815,6 +892,15 @@ func (_u *LocationUpdateOne) sqlSave(ctx context.Context) (_node *Location, err
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(location.FieldName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.OnHold(); ok {
+		_spec.SetField(location.FieldOnHold, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.HoldReason(); ok {
+		_spec.SetField(location.FieldHoldReason, field.TypeString, value)
+	}
+	if _u.mutation.HoldReasonCleared() {
+		_spec.ClearField(location.FieldHoldReason, field.TypeString)
+	}
 	if _u.mutation.MovementsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -51,6 +51,9 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "code", Type: field.TypeString},
 		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "aisle", Type: field.TypeInt, Default: 0},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "level", Type: field.TypeInt, Default: 0},
 		{Name: "location_bins", Type: field.TypeInt},
 		{Name: "zone_bins", Type: field.TypeInt},
 	}
@@ -62,13 +65,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "bins_locations_bins",
-				Columns:    []*schema.Column{BinsColumns[3]},
+				Columns:    []*schema.Column{BinsColumns[6]},
 				RefColumns: []*schema.Column{LocationsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "bins_zones_bins",
-				Columns:    []*schema.Column{BinsColumns[4]},
+				Columns:    []*schema.Column{BinsColumns[7]},
 				RefColumns: []*schema.Column{ZonesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -77,16 +80,166 @@ var (
 			{
 				Name:    "bin_code_location_bins",
 				Unique:  true,
-				Columns: []*schema.Column{BinsColumns[1], BinsColumns[3]},
+				Columns: []*schema.Column{BinsColumns[1], BinsColumns[6]},
 			},
 		},
 	}
+	// BinStocksColumns holds the columns for the "bin_stocks" table.
+	BinStocksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "quantity", Type: field.TypeInt},
+		{Name: "received_at", Type: field.TypeTime},
+		{Name: "bin_stock_bin", Type: field.TypeInt},
+		{Name: "bin_stock_item", Type: field.TypeInt},
+	}
+	// BinStocksTable holds the schema information for the "bin_stocks" table.
+	BinStocksTable = &schema.Table{
+		Name:       "bin_stocks",
+		Columns:    BinStocksColumns,
+		PrimaryKey: []*schema.Column{BinStocksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "bin_stocks_bins_bin",
+				Columns:    []*schema.Column{BinStocksColumns[3]},
+				RefColumns: []*schema.Column{BinsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "bin_stocks_items_item",
+				Columns:    []*schema.Column{BinStocksColumns[4]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "binstock_bin_stock_bin_bin_stock_item",
+				Unique:  true,
+				Columns: []*schema.Column{BinStocksColumns[3], BinStocksColumns[4]},
+			},
+		},
+	}
+	// CartonsColumns holds the columns for the "cartons" table.
+	CartonsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "number", Type: field.TypeString, Unique: true},
+		{Name: "status", Type: field.TypeString, Default: "OPEN"},
+		{Name: "length", Type: field.TypeFloat64, Nullable: true, Default: 0},
+		{Name: "width", Type: field.TypeFloat64, Nullable: true, Default: 0},
+		{Name: "height", Type: field.TypeFloat64, Nullable: true, Default: 0},
+		{Name: "weight", Type: field.TypeFloat64, Nullable: true, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "closed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "carton_tracking", Type: field.TypeInt, Nullable: true},
+		{Name: "carton_type_cartons", Type: field.TypeInt, Nullable: true},
+		{Name: "order_cartons", Type: field.TypeInt},
+	}
+	// CartonsTable holds the schema information for the "cartons" table.
+	CartonsTable = &schema.Table{
+		Name:       "cartons",
+		Columns:    CartonsColumns,
+		PrimaryKey: []*schema.Column{CartonsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "cartons_trackings_tracking",
+				Columns:    []*schema.Column{CartonsColumns[9]},
+				RefColumns: []*schema.Column{TrackingsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "cartons_carton_types_cartons",
+				Columns:    []*schema.Column{CartonsColumns[10]},
+				RefColumns: []*schema.Column{CartonTypesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "cartons_orders_cartons",
+				Columns:    []*schema.Column{CartonsColumns[11]},
+				RefColumns: []*schema.Column{OrdersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// CartonLinesColumns holds the columns for the "carton_lines" table.
+	CartonLinesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "quantity", Type: field.TypeInt},
+		{Name: "carton_lines", Type: field.TypeInt},
+		{Name: "carton_line_item", Type: field.TypeInt},
+	}
+	// CartonLinesTable holds the schema information for the "carton_lines" table.
+	CartonLinesTable = &schema.Table{
+		Name:       "carton_lines",
+		Columns:    CartonLinesColumns,
+		PrimaryKey: []*schema.Column{CartonLinesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "carton_lines_cartons_lines",
+				Columns:    []*schema.Column{CartonLinesColumns[2]},
+				RefColumns: []*schema.Column{CartonsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "carton_lines_items_item",
+				Columns:    []*schema.Column{CartonLinesColumns[3]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "cartonline_carton_lines_carton_line_item",
+				Unique:  true,
+				Columns: []*schema.Column{CartonLinesColumns[2], CartonLinesColumns[3]},
+			},
+		},
+	}
+	// CartonTypesColumns holds the columns for the "carton_types" table.
+	CartonTypesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "code", Type: field.TypeString, Unique: true},
+		{Name: "length", Type: field.TypeFloat64},
+		{Name: "width", Type: field.TypeFloat64},
+		{Name: "height", Type: field.TypeFloat64},
+		{Name: "max_weight", Type: field.TypeFloat64},
+		{Name: "tare_weight", Type: field.TypeFloat64, Default: 0},
+	}
+	// CartonTypesTable holds the schema information for the "carton_types" table.
+	CartonTypesTable = &schema.Table{
+		Name:       "carton_types",
+		Columns:    CartonTypesColumns,
+		PrimaryKey: []*schema.Column{CartonTypesColumns[0]},
+	}
+	// EventRulesColumns holds the columns for the "event_rules" table.
+	EventRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "event", Type: field.TypeString},
+		{Name: "conditions", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "action", Type: field.TypeString},
+		{Name: "argument", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "fired", Type: field.TypeInt, Default: 0},
+		{Name: "last_fired_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// EventRulesTable holds the schema information for the "event_rules" table.
+	EventRulesTable = &schema.Table{
+		Name:       "event_rules",
+		Columns:    EventRulesColumns,
+		PrimaryKey: []*schema.Column{EventRulesColumns[0]},
+	}
 	// ItemsColumns holds the columns for the "items" table.
 	ItemsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "sku", Type: field.TypeString, Unique: true},
 		{Name: "name", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "length", Type: field.TypeFloat64, Nullable: true, Default: 0},
+		{Name: "width", Type: field.TypeFloat64, Nullable: true, Default: 0},
+		{Name: "height", Type: field.TypeFloat64, Nullable: true, Default: 0},
+		{Name: "weight", Type: field.TypeFloat64, Nullable: true, Default: 0},
+		{Name: "unit_price", Type: field.TypeFloat64, Nullable: true, Default: 0},
 	}
 	// ItemsTable holds the schema information for the "items" table.
 	ItemsTable = &schema.Table{
@@ -99,6 +252,8 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "code", Type: field.TypeString, Unique: true},
 		{Name: "name", Type: field.TypeString},
+		{Name: "on_hold", Type: field.TypeBool, Default: false},
+		{Name: "hold_reason", Type: field.TypeString, Nullable: true, Default: ""},
 	}
 	// LocationsTable holds the schema information for the "locations" table.
 	LocationsTable = &schema.Table{
@@ -113,17 +268,56 @@ var (
 		{Name: "type", Type: field.TypeString},
 		{Name: "status", Type: field.TypeString, Default: "DRAFT"},
 		{Name: "created_at", Type: field.TypeTime},
+		{Name: "priority", Type: field.TypeString, Default: "NORMAL"},
+		{Name: "ship_by", Type: field.TypeTime, Nullable: true},
+		{Name: "posted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "reversed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "reversal_reason", Type: field.TypeString, Nullable: true},
+		{Name: "idempotency_key", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "order_returns", Type: field.TypeInt, Nullable: true},
+		{Name: "warehouse_outgoing_transfers", Type: field.TypeInt, Nullable: true},
+		{Name: "warehouse_incoming_transfers", Type: field.TypeInt, Nullable: true},
+		{Name: "wave_orders", Type: field.TypeInt, Nullable: true},
 	}
 	// OrdersTable holds the schema information for the "orders" table.
 	OrdersTable = &schema.Table{
 		Name:       "orders",
 		Columns:    OrdersColumns,
 		PrimaryKey: []*schema.Column{OrdersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "orders_orders_returns",
+				Columns:    []*schema.Column{OrdersColumns[11]},
+				RefColumns: []*schema.Column{OrdersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "orders_warehouses_outgoing_transfers",
+				Columns:    []*schema.Column{OrdersColumns[12]},
+				RefColumns: []*schema.Column{WarehousesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "orders_warehouses_incoming_transfers",
+				Columns:    []*schema.Column{OrdersColumns[13]},
+				RefColumns: []*schema.Column{WarehousesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "orders_waves_orders",
+				Columns:    []*schema.Column{OrdersColumns[14]},
+				RefColumns: []*schema.Column{WavesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
 	}
 	// OrderLinesColumns holds the columns for the "order_lines" table.
 	OrderLinesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "quantity", Type: field.TypeInt},
+		{Name: "condition", Type: field.TypeString, Nullable: true},
+		{Name: "disposition", Type: field.TypeString, Nullable: true},
+		{Name: "inspected_at", Type: field.TypeTime, Nullable: true},
 		{Name: "item_order_lines", Type: field.TypeInt},
 		{Name: "location_order_lines", Type: field.TypeInt},
 		{Name: "order_lines", Type: field.TypeInt},
@@ -136,19 +330,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "order_lines_items_order_lines",
-				Columns:    []*schema.Column{OrderLinesColumns[2]},
+				Columns:    []*schema.Column{OrderLinesColumns[5]},
 				RefColumns: []*schema.Column{ItemsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "order_lines_locations_order_lines",
-				Columns:    []*schema.Column{OrderLinesColumns[3]},
+				Columns:    []*schema.Column{OrderLinesColumns[6]},
 				RefColumns: []*schema.Column{LocationsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "order_lines_orders_lines",
-				Columns:    []*schema.Column{OrderLinesColumns[4]},
+				Columns:    []*schema.Column{OrderLinesColumns[7]},
 				RefColumns: []*schema.Column{OrdersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -157,7 +351,10 @@ var (
 	// PickListsColumns holds the columns for the "pick_lists" table.
 	PickListsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "number", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "status", Type: field.TypeString, Default: "CREATED"},
+		{Name: "assigned_to", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "assigned_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "started_at", Type: field.TypeTime, Nullable: true},
 		{Name: "done_at", Type: field.TypeTime, Nullable: true},
@@ -171,7 +368,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "pick_lists_orders_picklist",
-				Columns:    []*schema.Column{PickListsColumns[5]},
+				Columns:    []*schema.Column{PickListsColumns[8]},
 				RefColumns: []*schema.Column{OrdersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -180,7 +377,7 @@ var (
 			{
 				Name:    "picklist_order_picklist",
 				Unique:  true,
-				Columns: []*schema.Column{PickListsColumns[5]},
+				Columns: []*schema.Column{PickListsColumns[8]},
 			},
 		},
 	}
@@ -188,9 +385,12 @@ var (
 	PickTasksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "quantity", Type: field.TypeInt},
+		{Name: "picked", Type: field.TypeInt, Default: 0},
 		{Name: "status", Type: field.TypeString, Default: "OPEN"},
 		{Name: "picked_at", Type: field.TypeTime, Nullable: true},
 		{Name: "bin_id", Type: field.TypeInt, Nullable: true},
+		{Name: "assigned_to", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "picked_by", Type: field.TypeString, Nullable: true, Default: ""},
 		{Name: "order_line_pick_tasks", Type: field.TypeInt},
 		{Name: "pick_list_tasks", Type: field.TypeInt},
 	}
@@ -202,22 +402,231 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "pick_tasks_order_lines_pick_tasks",
-				Columns:    []*schema.Column{PickTasksColumns[5]},
+				Columns:    []*schema.Column{PickTasksColumns[8]},
 				RefColumns: []*schema.Column{OrderLinesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "pick_tasks_pick_lists_tasks",
-				Columns:    []*schema.Column{PickTasksColumns[6]},
+				Columns:    []*schema.Column{PickTasksColumns[9]},
 				RefColumns: []*schema.Column{PickListsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "picktask_pick_list_tasks_order_line_pick_tasks",
+				Name:    "picktask_bin_id_pick_list_tasks_order_line_pick_tasks",
 				Unique:  true,
-				Columns: []*schema.Column{PickTasksColumns[6], PickTasksColumns[5]},
+				Columns: []*schema.Column{PickTasksColumns[5], PickTasksColumns[9], PickTasksColumns[8]},
+			},
+		},
+	}
+	// PutawayTasksColumns holds the columns for the "putaway_tasks" table.
+	PutawayTasksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "quantity", Type: field.TypeInt},
+		{Name: "status", Type: field.TypeString, Default: "OPEN"},
+		{Name: "reference", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "suggested_bin_id", Type: field.TypeInt, Nullable: true},
+		{Name: "strategy", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "bin_id", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "done_at", Type: field.TypeTime, Nullable: true},
+		{Name: "putaway_task_item", Type: field.TypeInt},
+		{Name: "putaway_task_location", Type: field.TypeInt},
+	}
+	// PutawayTasksTable holds the schema information for the "putaway_tasks" table.
+	PutawayTasksTable = &schema.Table{
+		Name:       "putaway_tasks",
+		Columns:    PutawayTasksColumns,
+		PrimaryKey: []*schema.Column{PutawayTasksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "putaway_tasks_items_item",
+				Columns:    []*schema.Column{PutawayTasksColumns[9]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "putaway_tasks_locations_location",
+				Columns:    []*schema.Column{PutawayTasksColumns[10]},
+				RefColumns: []*schema.Column{LocationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// ReceiptsColumns holds the columns for the "receipts" table.
+	ReceiptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "quantity", Type: field.TypeInt},
+		{Name: "damaged", Type: field.TypeInt, Default: 0},
+		{Name: "note", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "order_line_receipts", Type: field.TypeInt},
+	}
+	// ReceiptsTable holds the schema information for the "receipts" table.
+	ReceiptsTable = &schema.Table{
+		Name:       "receipts",
+		Columns:    ReceiptsColumns,
+		PrimaryKey: []*schema.Column{ReceiptsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "receipts_order_lines_receipts",
+				Columns:    []*schema.Column{ReceiptsColumns[5]},
+				RefColumns: []*schema.Column{OrderLinesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// ReorderPoliciesColumns holds the columns for the "reorder_policies" table.
+	ReorderPoliciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "reorder_point", Type: field.TypeInt},
+		{Name: "safety_stock", Type: field.TypeInt, Default: 0},
+		{Name: "reorder_quantity", Type: field.TypeInt},
+		{Name: "lead_time_days", Type: field.TypeInt, Default: 0},
+		{Name: "supplier", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "item_id", Type: field.TypeInt},
+		{Name: "reorder_policy_location", Type: field.TypeInt},
+	}
+	// ReorderPoliciesTable holds the schema information for the "reorder_policies" table.
+	ReorderPoliciesTable = &schema.Table{
+		Name:       "reorder_policies",
+		Columns:    ReorderPoliciesColumns,
+		PrimaryKey: []*schema.Column{ReorderPoliciesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "reorder_policies_items_item",
+				Columns:    []*schema.Column{ReorderPoliciesColumns[6]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "reorder_policies_locations_location",
+				Columns:    []*schema.Column{ReorderPoliciesColumns[7]},
+				RefColumns: []*schema.Column{LocationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// ReplenishmentRulesColumns holds the columns for the "replenishment_rules" table.
+	ReplenishmentRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "min", Type: field.TypeInt},
+		{Name: "max", Type: field.TypeInt},
+		{Name: "replenishment_rule_bin", Type: field.TypeInt},
+		{Name: "replenishment_rule_item", Type: field.TypeInt},
+	}
+	// ReplenishmentRulesTable holds the schema information for the "replenishment_rules" table.
+	ReplenishmentRulesTable = &schema.Table{
+		Name:       "replenishment_rules",
+		Columns:    ReplenishmentRulesColumns,
+		PrimaryKey: []*schema.Column{ReplenishmentRulesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "replenishment_rules_bins_bin",
+				Columns:    []*schema.Column{ReplenishmentRulesColumns[3]},
+				RefColumns: []*schema.Column{BinsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "replenishment_rules_items_item",
+				Columns:    []*schema.Column{ReplenishmentRulesColumns[4]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "replenishmentrule_replenishment_rule_bin_replenishment_rule_item",
+				Unique:  true,
+				Columns: []*schema.Column{ReplenishmentRulesColumns[3], ReplenishmentRulesColumns[4]},
+			},
+		},
+	}
+	// ReplenishmentTasksColumns holds the columns for the "replenishment_tasks" table.
+	ReplenishmentTasksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "quantity", Type: field.TypeInt},
+		{Name: "kind", Type: field.TypeString, Default: "REPLENISH"},
+		{Name: "status", Type: field.TypeString, Default: "OPEN"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "done_at", Type: field.TypeTime, Nullable: true},
+		{Name: "replenishment_task_item", Type: field.TypeInt},
+		{Name: "replenishment_task_from_bin", Type: field.TypeInt},
+		{Name: "replenishment_task_to_bin", Type: field.TypeInt},
+	}
+	// ReplenishmentTasksTable holds the schema information for the "replenishment_tasks" table.
+	ReplenishmentTasksTable = &schema.Table{
+		Name:       "replenishment_tasks",
+		Columns:    ReplenishmentTasksColumns,
+		PrimaryKey: []*schema.Column{ReplenishmentTasksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "replenishment_tasks_items_item",
+				Columns:    []*schema.Column{ReplenishmentTasksColumns[6]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "replenishment_tasks_bins_from_bin",
+				Columns:    []*schema.Column{ReplenishmentTasksColumns[7]},
+				RefColumns: []*schema.Column{BinsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "replenishment_tasks_bins_to_bin",
+				Columns:    []*schema.Column{ReplenishmentTasksColumns[8]},
+				RefColumns: []*schema.Column{BinsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// SequencesColumns holds the columns for the "sequences" table.
+	SequencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "prefix", Type: field.TypeString, Default: ""},
+		{Name: "date_pattern", Type: field.TypeString, Default: ""},
+		{Name: "width", Type: field.TypeInt, Default: 6},
+		{Name: "value", Type: field.TypeInt, Default: 0},
+		{Name: "period", Type: field.TypeString, Default: ""},
+	}
+	// SequencesTable holds the schema information for the "sequences" table.
+	SequencesTable = &schema.Table{
+		Name:       "sequences",
+		Columns:    SequencesColumns,
+		PrimaryKey: []*schema.Column{SequencesColumns[0]},
+	}
+	// StockDiscrepanciesColumns holds the columns for the "stock_discrepancies" table.
+	StockDiscrepanciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "source", Type: field.TypeString},
+		{Name: "reference", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "expected", Type: field.TypeInt},
+		{Name: "found", Type: field.TypeInt},
+		{Name: "bin_id", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "stock_discrepancy_item", Type: field.TypeInt},
+		{Name: "stock_discrepancy_location", Type: field.TypeInt},
+	}
+	// StockDiscrepanciesTable holds the schema information for the "stock_discrepancies" table.
+	StockDiscrepanciesTable = &schema.Table{
+		Name:       "stock_discrepancies",
+		Columns:    StockDiscrepanciesColumns,
+		PrimaryKey: []*schema.Column{StockDiscrepanciesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "stock_discrepancies_items_item",
+				Columns:    []*schema.Column{StockDiscrepanciesColumns[7]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "stock_discrepancies_locations_location",
+				Columns:    []*schema.Column{StockDiscrepanciesColumns[8]},
+				RefColumns: []*schema.Column{LocationsColumns[0]},
+				OnDelete:   schema.NoAction,
 			},
 		},
 	}
@@ -228,6 +637,7 @@ var (
 		{Name: "quantity", Type: field.TypeInt},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "reference", Type: field.TypeString, Nullable: true},
+		{Name: "idempotency_key", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "item_movements", Type: field.TypeInt},
 		{Name: "location_movements", Type: field.TypeInt},
 	}
@@ -239,13 +649,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "stock_movements_items_movements",
-				Columns:    []*schema.Column{StockMovementsColumns[5]},
+				Columns:    []*schema.Column{StockMovementsColumns[6]},
 				RefColumns: []*schema.Column{ItemsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "stock_movements_locations_movements",
-				Columns:    []*schema.Column{StockMovementsColumns[6]},
+				Columns:    []*schema.Column{StockMovementsColumns[7]},
 				RefColumns: []*schema.Column{LocationsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -372,11 +782,98 @@ var (
 			},
 		},
 	}
+	// WavesColumns holds the columns for the "waves" table.
+	WavesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "number", Type: field.TypeString, Unique: true},
+		{Name: "status", Type: field.TypeString, Default: "CREATED"},
+		{Name: "carrier", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "zone", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "due_by", Type: field.TypeTime, Nullable: true},
+		{Name: "max_lines", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true},
+		{Name: "done_at", Type: field.TypeTime, Nullable: true},
+	}
+	// WavesTable holds the schema information for the "waves" table.
+	WavesTable = &schema.Table{
+		Name:       "waves",
+		Columns:    WavesColumns,
+		PrimaryKey: []*schema.Column{WavesColumns[0]},
+	}
+	// WavePicksColumns holds the columns for the "wave_picks" table.
+	WavePicksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "quantity", Type: field.TypeInt},
+		{Name: "picked", Type: field.TypeInt, Default: 0},
+		{Name: "wave_pick_order_line", Type: field.TypeInt},
+		{Name: "wave_task_picks", Type: field.TypeInt},
+	}
+	// WavePicksTable holds the schema information for the "wave_picks" table.
+	WavePicksTable = &schema.Table{
+		Name:       "wave_picks",
+		Columns:    WavePicksColumns,
+		PrimaryKey: []*schema.Column{WavePicksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "wave_picks_order_lines_order_line",
+				Columns:    []*schema.Column{WavePicksColumns[3]},
+				RefColumns: []*schema.Column{OrderLinesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "wave_picks_wave_tasks_picks",
+				Columns:    []*schema.Column{WavePicksColumns[4]},
+				RefColumns: []*schema.Column{WaveTasksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// WaveTasksColumns holds the columns for the "wave_tasks" table.
+	WaveTasksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "quantity", Type: field.TypeInt},
+		{Name: "picked", Type: field.TypeInt, Default: 0},
+		{Name: "status", Type: field.TypeString, Default: "OPEN"},
+		{Name: "picked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "bin_id", Type: field.TypeInt, Nullable: true},
+		{Name: "picked_by", Type: field.TypeString, Nullable: true, Default: ""},
+		{Name: "wave_tasks", Type: field.TypeInt},
+		{Name: "wave_task_item", Type: field.TypeInt},
+		{Name: "wave_task_location", Type: field.TypeInt},
+	}
+	// WaveTasksTable holds the schema information for the "wave_tasks" table.
+	WaveTasksTable = &schema.Table{
+		Name:       "wave_tasks",
+		Columns:    WaveTasksColumns,
+		PrimaryKey: []*schema.Column{WaveTasksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "wave_tasks_waves_tasks",
+				Columns:    []*schema.Column{WaveTasksColumns[7]},
+				RefColumns: []*schema.Column{WavesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "wave_tasks_items_item",
+				Columns:    []*schema.Column{WaveTasksColumns[8]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "wave_tasks_locations_location",
+				Columns:    []*schema.Column{WaveTasksColumns[9]},
+				RefColumns: []*schema.Column{LocationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// ZonesColumns holds the columns for the "zones" table.
 	ZonesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "code", Type: field.TypeString},
 		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "walk_sequence", Type: field.TypeInt, Default: 0},
 		{Name: "location_zones", Type: field.TypeInt},
 	}
 	// ZonesTable holds the schema information for the "zones" table.
@@ -387,7 +884,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "zones_locations_zones",
-				Columns:    []*schema.Column{ZonesColumns[3]},
+				Columns:    []*schema.Column{ZonesColumns[4]},
 				RefColumns: []*schema.Column{LocationsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -396,7 +893,7 @@ var (
 			{
 				Name:    "zone_code_location_zones",
 				Unique:  true,
-				Columns: []*schema.Column{ZonesColumns[1], ZonesColumns[3]},
+				Columns: []*schema.Column{ZonesColumns[1], ZonesColumns[4]},
 			},
 		},
 	}
@@ -429,17 +926,32 @@ var (
 	Tables = []*schema.Table{
 		AuditEventsTable,
 		BinsTable,
+		BinStocksTable,
+		CartonsTable,
+		CartonLinesTable,
+		CartonTypesTable,
+		EventRulesTable,
 		ItemsTable,
 		LocationsTable,
 		OrdersTable,
 		OrderLinesTable,
 		PickListsTable,
 		PickTasksTable,
+		PutawayTasksTable,
+		ReceiptsTable,
+		ReorderPoliciesTable,
+		ReplenishmentRulesTable,
+		ReplenishmentTasksTable,
+		SequencesTable,
+		StockDiscrepanciesTable,
 		StockMovementsTable,
 		TrackingsTable,
 		UsersTable,
 		WarehousesTable,
 		WarehouseLocationsTable,
+		WavesTable,
+		WavePicksTable,
+		WaveTasksTable,
 		ZonesTable,
 		BinItemsTable,
 	}
@@ -448,17 +960,45 @@ var (
 func init() {
 	BinsTable.ForeignKeys[0].RefTable = LocationsTable
 	BinsTable.ForeignKeys[1].RefTable = ZonesTable
+	BinStocksTable.ForeignKeys[0].RefTable = BinsTable
+	BinStocksTable.ForeignKeys[1].RefTable = ItemsTable
+	CartonsTable.ForeignKeys[0].RefTable = TrackingsTable
+	CartonsTable.ForeignKeys[1].RefTable = CartonTypesTable
+	CartonsTable.ForeignKeys[2].RefTable = OrdersTable
+	CartonLinesTable.ForeignKeys[0].RefTable = CartonsTable
+	CartonLinesTable.ForeignKeys[1].RefTable = ItemsTable
+	OrdersTable.ForeignKeys[0].RefTable = OrdersTable
+	OrdersTable.ForeignKeys[1].RefTable = WarehousesTable
+	OrdersTable.ForeignKeys[2].RefTable = WarehousesTable
+	OrdersTable.ForeignKeys[3].RefTable = WavesTable
 	OrderLinesTable.ForeignKeys[0].RefTable = ItemsTable
 	OrderLinesTable.ForeignKeys[1].RefTable = LocationsTable
 	OrderLinesTable.ForeignKeys[2].RefTable = OrdersTable
 	PickListsTable.ForeignKeys[0].RefTable = OrdersTable
 	PickTasksTable.ForeignKeys[0].RefTable = OrderLinesTable
 	PickTasksTable.ForeignKeys[1].RefTable = PickListsTable
+	PutawayTasksTable.ForeignKeys[0].RefTable = ItemsTable
+	PutawayTasksTable.ForeignKeys[1].RefTable = LocationsTable
+	ReceiptsTable.ForeignKeys[0].RefTable = OrderLinesTable
+	ReorderPoliciesTable.ForeignKeys[0].RefTable = ItemsTable
+	ReorderPoliciesTable.ForeignKeys[1].RefTable = LocationsTable
+	ReplenishmentRulesTable.ForeignKeys[0].RefTable = BinsTable
+	ReplenishmentRulesTable.ForeignKeys[1].RefTable = ItemsTable
+	ReplenishmentTasksTable.ForeignKeys[0].RefTable = ItemsTable
+	ReplenishmentTasksTable.ForeignKeys[1].RefTable = BinsTable
+	ReplenishmentTasksTable.ForeignKeys[2].RefTable = BinsTable
+	StockDiscrepanciesTable.ForeignKeys[0].RefTable = ItemsTable
+	StockDiscrepanciesTable.ForeignKeys[1].RefTable = LocationsTable
 	StockMovementsTable.ForeignKeys[0].RefTable = ItemsTable
 	StockMovementsTable.ForeignKeys[1].RefTable = LocationsTable
 	TrackingsTable.ForeignKeys[0].RefTable = OrdersTable
 	WarehouseLocationsTable.ForeignKeys[0].RefTable = LocationsTable
 	WarehouseLocationsTable.ForeignKeys[1].RefTable = WarehousesTable
+	WavePicksTable.ForeignKeys[0].RefTable = OrderLinesTable
+	WavePicksTable.ForeignKeys[1].RefTable = WaveTasksTable
+	WaveTasksTable.ForeignKeys[0].RefTable = WavesTable
+	WaveTasksTable.ForeignKeys[1].RefTable = ItemsTable
+	WaveTasksTable.ForeignKeys[2].RefTable = LocationsTable
 	ZonesTable.ForeignKeys[0].RefTable = LocationsTable
 	BinItemsTable.ForeignKeys[0].RefTable = BinsTable
 	BinItemsTable.ForeignKeys[1].RefTable = ItemsTable
@@ -13,6 +13,11 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/mxV03/wms/ent/auditevent"
 	"github.com/mxV03/wms/ent/bin"
+	"github.com/mxV03/wms/ent/binstock"
+	"github.com/mxV03/wms/ent/carton"
+	"github.com/mxV03/wms/ent/cartonline"
+	"github.com/mxV03/wms/ent/cartontype"
+	"github.com/mxV03/wms/ent/eventrule"
 	"github.com/mxV03/wms/ent/item"
 	"github.com/mxV03/wms/ent/location"
 	"github.com/mxV03/wms/ent/order"
@@ -20,11 +25,21 @@ import (
 	"github.com/mxV03/wms/ent/picklist"
 	"github.com/mxV03/wms/ent/picktask"
 	"github.com/mxV03/wms/ent/predicate"
+	"github.com/mxV03/wms/ent/putawaytask"
+	"github.com/mxV03/wms/ent/receipt"
+	"github.com/mxV03/wms/ent/reorderpolicy"
+	"github.com/mxV03/wms/ent/replenishmentrule"
+	"github.com/mxV03/wms/ent/replenishmenttask"
+	"github.com/mxV03/wms/ent/sequence"
+	"github.com/mxV03/wms/ent/stockdiscrepancy"
 	"github.com/mxV03/wms/ent/stockmovement"
 	"github.com/mxV03/wms/ent/tracking"
 	"github.com/mxV03/wms/ent/user"
 	"github.com/mxV03/wms/ent/warehouse"
 	"github.com/mxV03/wms/ent/warehouselocation"
+	"github.com/mxV03/wms/ent/wave"
+	"github.com/mxV03/wms/ent/wavepick"
+	"github.com/mxV03/wms/ent/wavetask"
 	"github.com/mxV03/wms/ent/zone"
 )
 
@@ -39,17 +54,32 @@ const (
 	// Node types.
 	TypeAuditEvent        = "AuditEvent"
 	TypeBin               = "Bin"
+	TypeBinStock          = "BinStock"
+	TypeCarton            = "Carton"
+	TypeCartonLine        = "CartonLine"
+	TypeCartonType        = "CartonType"
+	TypeEventRule         = "EventRule"
 	TypeItem              = "Item"
 	TypeLocation          = "Location"
 	TypeOrder             = "Order"
 	TypeOrderLine         = "OrderLine"
 	TypePickList          = "PickList"
 	TypePickTask          = "PickTask"
+	TypePutawayTask       = "PutawayTask"
+	TypeReceipt           = "Receipt"
+	TypeReorderPolicy     = "ReorderPolicy"
+	TypeReplenishmentRule = "ReplenishmentRule"
+	TypeReplenishmentTask = "ReplenishmentTask"
+	TypeSequence          = "Sequence"
+	TypeStockDiscrepancy  = "StockDiscrepancy"
 	TypeStockMovement     = "StockMovement"
 	TypeTracking          = "Tracking"
 	TypeUser              = "User"
 	TypeWarehouse         = "Warehouse"
 	TypeWarehouseLocation = "WarehouseLocation"
+	TypeWave              = "Wave"
+	TypeWavePick          = "WavePick"
+	TypeWaveTask          = "WaveTask"
 	TypeZone              = "Zone"
 )
 
@@ -717,6 +747,12 @@ type BinMutation struct {
 	id              *int
 	code            *string
 	name            *string
+	aisle           *int
+	addaisle        *int
+	position        *int
+	addposition     *int
+	level           *int
+	addlevel        *int
 	clearedFields   map[string]struct{}
 	location        *int
 	clearedlocation bool
@@ -913,6 +949,174 @@ func (m *BinMutation) ResetName() {
 	delete(m.clearedFields, bin.FieldName)
 }
 
+// SetAisle sets the "aisle" field.
+func (m *BinMutation) SetAisle(i int) {
+	m.aisle = &i
+	m.addaisle = nil
+}
+
+// Aisle returns the value of the "aisle" field in the mutation.
+func (m *BinMutation) Aisle() (r int, exists bool) {
+	v := m.aisle
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAisle returns the old "aisle" field's value of the Bin entity.
+// If the Bin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BinMutation) OldAisle(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAisle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAisle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAisle: %w", err)
+	}
+	return oldValue.Aisle, nil
+}
+
+// AddAisle adds i to the "aisle" field.
+func (m *BinMutation) AddAisle(i int) {
+	if m.addaisle != nil {
+		*m.addaisle += i
+	} else {
+		m.addaisle = &i
+	}
+}
+
+// AddedAisle returns the value that was added to the "aisle" field in this mutation.
+func (m *BinMutation) AddedAisle() (r int, exists bool) {
+	v := m.addaisle
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAisle resets all changes to the "aisle" field.
+func (m *BinMutation) ResetAisle() {
+	m.aisle = nil
+	m.addaisle = nil
+}
+
+// SetPosition sets the "position" field.
+func (m *BinMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
+}
+
+// Position returns the value of the "position" field in the mutation.
+func (m *BinMutation) Position() (r int, exists bool) {
+	v := m.position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosition returns the old "position" field's value of the Bin entity.
+// If the Bin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BinMutation) OldPosition(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+	}
+	return oldValue.Position, nil
+}
+
+// AddPosition adds i to the "position" field.
+func (m *BinMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
+}
+
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *BinMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPosition resets all changes to the "position" field.
+func (m *BinMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
+}
+
+// SetLevel sets the "level" field.
+func (m *BinMutation) SetLevel(i int) {
+	m.level = &i
+	m.addlevel = nil
+}
+
+// Level returns the value of the "level" field in the mutation.
+func (m *BinMutation) Level() (r int, exists bool) {
+	v := m.level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLevel returns the old "level" field's value of the Bin entity.
+// If the Bin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BinMutation) OldLevel(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLevel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLevel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLevel: %w", err)
+	}
+	return oldValue.Level, nil
+}
+
+// AddLevel adds i to the "level" field.
+func (m *BinMutation) AddLevel(i int) {
+	if m.addlevel != nil {
+		*m.addlevel += i
+	} else {
+		m.addlevel = &i
+	}
+}
+
+// AddedLevel returns the value that was added to the "level" field in this mutation.
+func (m *BinMutation) AddedLevel() (r int, exists bool) {
+	v := m.addlevel
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLevel resets all changes to the "level" field.
+func (m *BinMutation) ResetLevel() {
+	m.level = nil
+	m.addlevel = nil
+}
+
 // SetLocationID sets the "location" edge to the Location entity by id.
 func (m *BinMutation) SetLocationID(id int) {
 	m.location = &id
@@ -1079,13 +1283,22 @@ func (m *BinMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *BinMutation) Fields() []string {
-	fields := make([]string, 0, 2)
+	fields := make([]string, 0, 5)
 	if m.code != nil {
 		fields = append(fields, bin.FieldCode)
 	}
 	if m.name != nil {
 		fields = append(fields, bin.FieldName)
 	}
+	if m.aisle != nil {
+		fields = append(fields, bin.FieldAisle)
+	}
+	if m.position != nil {
+		fields = append(fields, bin.FieldPosition)
+	}
+	if m.level != nil {
+		fields = append(fields, bin.FieldLevel)
+	}
 	return fields
 }
 
@@ -1098,6 +1311,12 @@ func (m *BinMutation) Field(name string) (ent.Value, bool) {
 		return m.Code()
 	case bin.FieldName:
 		return m.Name()
+	case bin.FieldAisle:
+		return m.Aisle()
+	case bin.FieldPosition:
+		return m.Position()
+	case bin.FieldLevel:
+		return m.Level()
 	}
 	return nil, false
 }
@@ -1111,6 +1330,12 @@ func (m *BinMutation) OldField(ctx context.Context, name string) (ent.Value, err
 		return m.OldCode(ctx)
 	case bin.FieldName:
 		return m.OldName(ctx)
+	case bin.FieldAisle:
+		return m.OldAisle(ctx)
+	case bin.FieldPosition:
+		return m.OldPosition(ctx)
+	case bin.FieldLevel:
+		return m.OldLevel(ctx)
 	}
 	return nil, fmt.Errorf("unknown Bin field %s", name)
 }
@@ -1134,6 +1359,27 @@ func (m *BinMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetName(v)
 		return nil
+	case bin.FieldAisle:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAisle(v)
+		return nil
+	case bin.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
+	case bin.FieldLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLevel(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Bin field %s", name)
 }
@@ -1141,13 +1387,31 @@ func (m *BinMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *BinMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addaisle != nil {
+		fields = append(fields, bin.FieldAisle)
+	}
+	if m.addposition != nil {
+		fields = append(fields, bin.FieldPosition)
+	}
+	if m.addlevel != nil {
+		fields = append(fields, bin.FieldLevel)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *BinMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case bin.FieldAisle:
+		return m.AddedAisle()
+	case bin.FieldPosition:
+		return m.AddedPosition()
+	case bin.FieldLevel:
+		return m.AddedLevel()
+	}
 	return nil, false
 }
 
@@ -1156,6 +1420,27 @@ func (m *BinMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *BinMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case bin.FieldAisle:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAisle(v)
+		return nil
+	case bin.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
+	case bin.FieldLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLevel(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Bin numeric field %s", name)
 }
@@ -1198,6 +1483,15 @@ func (m *BinMutation) ResetField(name string) error {
 	case bin.FieldName:
 		m.ResetName()
 		return nil
+	case bin.FieldAisle:
+		m.ResetAisle()
+		return nil
+	case bin.FieldPosition:
+		m.ResetPosition()
+		return nil
+	case bin.FieldLevel:
+		m.ResetLevel()
+		return nil
 	}
 	return fmt.Errorf("unknown Bin field %s", name)
 }
@@ -1322,41 +1616,36 @@ func (m *BinMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Bin edge %s", name)
 }
 
-// ItemMutation represents an operation that mutates the Item nodes in the graph.
-type ItemMutation struct {
+// BinStockMutation represents an operation that mutates the BinStock nodes in the graph.
+type BinStockMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *int
-	_SKU               *string
-	name               *string
-	description        *string
-	clearedFields      map[string]struct{}
-	movements          map[int]struct{}
-	removedmovements   map[int]struct{}
-	clearedmovements   bool
-	order_lines        map[int]struct{}
-	removedorder_lines map[int]struct{}
-	clearedorder_lines bool
-	bins               map[int]struct{}
-	removedbins        map[int]struct{}
-	clearedbins        bool
-	done               bool
-	oldValue           func(context.Context) (*Item, error)
-	predicates         []predicate.Item
+	op            Op
+	typ           string
+	id            *int
+	quantity      *int
+	addquantity   *int
+	received_at   *time.Time
+	clearedFields map[string]struct{}
+	bin           *int
+	clearedbin    bool
+	item          *int
+	cleareditem   bool
+	done          bool
+	oldValue      func(context.Context) (*BinStock, error)
+	predicates    []predicate.BinStock
 }
 
-var _ ent.Mutation = (*ItemMutation)(nil)
+var _ ent.Mutation = (*BinStockMutation)(nil)
 
-// itemOption allows management of the mutation configuration using functional options.
-type itemOption func(*ItemMutation)
+// binstockOption allows management of the mutation configuration using functional options.
+type binstockOption func(*BinStockMutation)
 
-// newItemMutation creates new mutation for the Item entity.
-func newItemMutation(c config, op Op, opts ...itemOption) *ItemMutation {
-	m := &ItemMutation{
+// newBinStockMutation creates new mutation for the BinStock entity.
+func newBinStockMutation(c config, op Op, opts ...binstockOption) *BinStockMutation {
+	m := &BinStockMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeItem,
+		typ:           TypeBinStock,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1365,20 +1654,20 @@ func newItemMutation(c config, op Op, opts ...itemOption) *ItemMutation {
 	return m
 }
 
-// withItemID sets the ID field of the mutation.
-func withItemID(id int) itemOption {
-	return func(m *ItemMutation) {
+// withBinStockID sets the ID field of the mutation.
+func withBinStockID(id int) binstockOption {
+	return func(m *BinStockMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Item
+			value *BinStock
 		)
-		m.oldValue = func(ctx context.Context) (*Item, error) {
+		m.oldValue = func(ctx context.Context) (*BinStock, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Item.Get(ctx, id)
+					value, err = m.Client().BinStock.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1387,10 +1676,10 @@ func withItemID(id int) itemOption {
 	}
 }
 
-// withItem sets the old Item of the mutation.
-func withItem(node *Item) itemOption {
-	return func(m *ItemMutation) {
-		m.oldValue = func(context.Context) (*Item, error) {
+// withBinStock sets the old BinStock of the mutation.
+func withBinStock(node *BinStock) binstockOption {
+	return func(m *BinStockMutation) {
+		m.oldValue = func(context.Context) (*BinStock, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1399,7 +1688,7 @@ func withItem(node *Item) itemOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ItemMutation) Client() *Client {
+func (m BinStockMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1407,7 +1696,7 @@ func (m ItemMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ItemMutation) Tx() (*Tx, error) {
+func (m BinStockMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1418,7 +1707,7 @@ func (m ItemMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ItemMutation) ID() (id int, exists bool) {
+func (m *BinStockMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1429,7 +1718,7 @@ func (m *ItemMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ItemMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *BinStockMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1438,304 +1727,191 @@ func (m *ItemMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Item.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().BinStock.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetSKU sets the "SKU" field.
-func (m *ItemMutation) SetSKU(s string) {
-	m._SKU = &s
+// SetQuantity sets the "quantity" field.
+func (m *BinStockMutation) SetQuantity(i int) {
+	m.quantity = &i
+	m.addquantity = nil
 }
 
-// SKU returns the value of the "SKU" field in the mutation.
-func (m *ItemMutation) SKU() (r string, exists bool) {
-	v := m._SKU
+// Quantity returns the value of the "quantity" field in the mutation.
+func (m *BinStockMutation) Quantity() (r int, exists bool) {
+	v := m.quantity
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSKU returns the old "SKU" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldQuantity returns the old "quantity" field's value of the BinStock entity.
+// If the BinStock object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldSKU(ctx context.Context) (v string, err error) {
+func (m *BinStockMutation) OldQuantity(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSKU is only allowed on UpdateOne operations")
+		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSKU requires an ID field in the mutation")
+		return v, errors.New("OldQuantity requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSKU: %w", err)
+		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
 	}
-	return oldValue.SKU, nil
+	return oldValue.Quantity, nil
 }
 
-// ResetSKU resets all changes to the "SKU" field.
-func (m *ItemMutation) ResetSKU() {
-	m._SKU = nil
+// AddQuantity adds i to the "quantity" field.
+func (m *BinStockMutation) AddQuantity(i int) {
+	if m.addquantity != nil {
+		*m.addquantity += i
+	} else {
+		m.addquantity = &i
+	}
 }
 
-// SetName sets the "name" field.
-func (m *ItemMutation) SetName(s string) {
-	m.name = &s
+// AddedQuantity returns the value that was added to the "quantity" field in this mutation.
+func (m *BinStockMutation) AddedQuantity() (r int, exists bool) {
+	v := m.addquantity
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *ItemMutation) Name() (r string, exists bool) {
-	v := m.name
+// ResetQuantity resets all changes to the "quantity" field.
+func (m *BinStockMutation) ResetQuantity() {
+	m.quantity = nil
+	m.addquantity = nil
+}
+
+// SetReceivedAt sets the "received_at" field.
+func (m *BinStockMutation) SetReceivedAt(t time.Time) {
+	m.received_at = &t
+}
+
+// ReceivedAt returns the value of the "received_at" field in the mutation.
+func (m *BinStockMutation) ReceivedAt() (r time.Time, exists bool) {
+	v := m.received_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldReceivedAt returns the old "received_at" field's value of the BinStock entity.
+// If the BinStock object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *BinStockMutation) OldReceivedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldReceivedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldReceivedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldReceivedAt: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.ReceivedAt, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *ItemMutation) ResetName() {
-	m.name = nil
+// ResetReceivedAt resets all changes to the "received_at" field.
+func (m *BinStockMutation) ResetReceivedAt() {
+	m.received_at = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *ItemMutation) SetDescription(s string) {
-	m.description = &s
+// SetBinID sets the "bin" edge to the Bin entity by id.
+func (m *BinStockMutation) SetBinID(id int) {
+	m.bin = &id
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *ItemMutation) Description() (r string, exists bool) {
-	v := m.description
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldDescription returns the old "description" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldDescription(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
-	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *ItemMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[item.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *ItemMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[item.FieldDescription]
-	return ok
-}
-
-// ResetDescription resets all changes to the "description" field.
-func (m *ItemMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, item.FieldDescription)
-}
-
-// AddMovementIDs adds the "movements" edge to the StockMovement entity by ids.
-func (m *ItemMutation) AddMovementIDs(ids ...int) {
-	if m.movements == nil {
-		m.movements = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.movements[ids[i]] = struct{}{}
-	}
-}
-
-// ClearMovements clears the "movements" edge to the StockMovement entity.
-func (m *ItemMutation) ClearMovements() {
-	m.clearedmovements = true
-}
-
-// MovementsCleared reports if the "movements" edge to the StockMovement entity was cleared.
-func (m *ItemMutation) MovementsCleared() bool {
-	return m.clearedmovements
-}
-
-// RemoveMovementIDs removes the "movements" edge to the StockMovement entity by IDs.
-func (m *ItemMutation) RemoveMovementIDs(ids ...int) {
-	if m.removedmovements == nil {
-		m.removedmovements = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.movements, ids[i])
-		m.removedmovements[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedMovements returns the removed IDs of the "movements" edge to the StockMovement entity.
-func (m *ItemMutation) RemovedMovementsIDs() (ids []int) {
-	for id := range m.removedmovements {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// MovementsIDs returns the "movements" edge IDs in the mutation.
-func (m *ItemMutation) MovementsIDs() (ids []int) {
-	for id := range m.movements {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetMovements resets all changes to the "movements" edge.
-func (m *ItemMutation) ResetMovements() {
-	m.movements = nil
-	m.clearedmovements = false
-	m.removedmovements = nil
-}
-
-// AddOrderLineIDs adds the "order_lines" edge to the OrderLine entity by ids.
-func (m *ItemMutation) AddOrderLineIDs(ids ...int) {
-	if m.order_lines == nil {
-		m.order_lines = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.order_lines[ids[i]] = struct{}{}
-	}
-}
-
-// ClearOrderLines clears the "order_lines" edge to the OrderLine entity.
-func (m *ItemMutation) ClearOrderLines() {
-	m.clearedorder_lines = true
-}
-
-// OrderLinesCleared reports if the "order_lines" edge to the OrderLine entity was cleared.
-func (m *ItemMutation) OrderLinesCleared() bool {
-	return m.clearedorder_lines
+// ClearBin clears the "bin" edge to the Bin entity.
+func (m *BinStockMutation) ClearBin() {
+	m.clearedbin = true
 }
 
-// RemoveOrderLineIDs removes the "order_lines" edge to the OrderLine entity by IDs.
-func (m *ItemMutation) RemoveOrderLineIDs(ids ...int) {
-	if m.removedorder_lines == nil {
-		m.removedorder_lines = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.order_lines, ids[i])
-		m.removedorder_lines[ids[i]] = struct{}{}
-	}
+// BinCleared reports if the "bin" edge to the Bin entity was cleared.
+func (m *BinStockMutation) BinCleared() bool {
+	return m.clearedbin
 }
 
-// RemovedOrderLines returns the removed IDs of the "order_lines" edge to the OrderLine entity.
-func (m *ItemMutation) RemovedOrderLinesIDs() (ids []int) {
-	for id := range m.removedorder_lines {
-		ids = append(ids, id)
+// BinID returns the "bin" edge ID in the mutation.
+func (m *BinStockMutation) BinID() (id int, exists bool) {
+	if m.bin != nil {
+		return *m.bin, true
 	}
 	return
 }
 
-// OrderLinesIDs returns the "order_lines" edge IDs in the mutation.
-func (m *ItemMutation) OrderLinesIDs() (ids []int) {
-	for id := range m.order_lines {
-		ids = append(ids, id)
+// BinIDs returns the "bin" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BinID instead. It exists only for internal usage by the builders.
+func (m *BinStockMutation) BinIDs() (ids []int) {
+	if id := m.bin; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetOrderLines resets all changes to the "order_lines" edge.
-func (m *ItemMutation) ResetOrderLines() {
-	m.order_lines = nil
-	m.clearedorder_lines = false
-	m.removedorder_lines = nil
-}
-
-// AddBinIDs adds the "bins" edge to the Bin entity by ids.
-func (m *ItemMutation) AddBinIDs(ids ...int) {
-	if m.bins == nil {
-		m.bins = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.bins[ids[i]] = struct{}{}
-	}
+// ResetBin resets all changes to the "bin" edge.
+func (m *BinStockMutation) ResetBin() {
+	m.bin = nil
+	m.clearedbin = false
 }
 
-// ClearBins clears the "bins" edge to the Bin entity.
-func (m *ItemMutation) ClearBins() {
-	m.clearedbins = true
+// SetItemID sets the "item" edge to the Item entity by id.
+func (m *BinStockMutation) SetItemID(id int) {
+	m.item = &id
 }
 
-// BinsCleared reports if the "bins" edge to the Bin entity was cleared.
-func (m *ItemMutation) BinsCleared() bool {
-	return m.clearedbins
+// ClearItem clears the "item" edge to the Item entity.
+func (m *BinStockMutation) ClearItem() {
+	m.cleareditem = true
 }
 
-// RemoveBinIDs removes the "bins" edge to the Bin entity by IDs.
-func (m *ItemMutation) RemoveBinIDs(ids ...int) {
-	if m.removedbins == nil {
-		m.removedbins = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.bins, ids[i])
-		m.removedbins[ids[i]] = struct{}{}
-	}
+// ItemCleared reports if the "item" edge to the Item entity was cleared.
+func (m *BinStockMutation) ItemCleared() bool {
+	return m.cleareditem
 }
 
-// RemovedBins returns the removed IDs of the "bins" edge to the Bin entity.
-func (m *ItemMutation) RemovedBinsIDs() (ids []int) {
-	for id := range m.removedbins {
-		ids = append(ids, id)
+// ItemID returns the "item" edge ID in the mutation.
+func (m *BinStockMutation) ItemID() (id int, exists bool) {
+	if m.item != nil {
+		return *m.item, true
 	}
 	return
 }
 
-// BinsIDs returns the "bins" edge IDs in the mutation.
-func (m *ItemMutation) BinsIDs() (ids []int) {
-	for id := range m.bins {
-		ids = append(ids, id)
+// ItemIDs returns the "item" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ItemID instead. It exists only for internal usage by the builders.
+func (m *BinStockMutation) ItemIDs() (ids []int) {
+	if id := m.item; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetBins resets all changes to the "bins" edge.
-func (m *ItemMutation) ResetBins() {
-	m.bins = nil
-	m.clearedbins = false
-	m.removedbins = nil
+// ResetItem resets all changes to the "item" edge.
+func (m *BinStockMutation) ResetItem() {
+	m.item = nil
+	m.cleareditem = false
 }
 
-// Where appends a list predicates to the ItemMutation builder.
-func (m *ItemMutation) Where(ps ...predicate.Item) {
+// Where appends a list predicates to the BinStockMutation builder.
+func (m *BinStockMutation) Where(ps ...predicate.BinStock) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ItemMutation builder. Using this method,
+// WhereP appends storage-level predicates to the BinStockMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ItemMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Item, len(ps))
+func (m *BinStockMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BinStock, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1743,33 +1919,30 @@ func (m *ItemMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ItemMutation) Op() Op {
+func (m *BinStockMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ItemMutation) SetOp(op Op) {
+func (m *BinStockMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Item).
-func (m *ItemMutation) Type() string {
+// Type returns the node type of this mutation (BinStock).
+func (m *BinStockMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ItemMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m._SKU != nil {
-		fields = append(fields, item.FieldSKU)
-	}
-	if m.name != nil {
-		fields = append(fields, item.FieldName)
+func (m *BinStockMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.quantity != nil {
+		fields = append(fields, binstock.FieldQuantity)
 	}
-	if m.description != nil {
-		fields = append(fields, item.FieldDescription)
+	if m.received_at != nil {
+		fields = append(fields, binstock.FieldReceivedAt)
 	}
 	return fields
 }
@@ -1777,14 +1950,12 @@ func (m *ItemMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ItemMutation) Field(name string) (ent.Value, bool) {
+func (m *BinStockMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case item.FieldSKU:
-		return m.SKU()
-	case item.FieldName:
-		return m.Name()
-	case item.FieldDescription:
-		return m.Description()
+	case binstock.FieldQuantity:
+		return m.Quantity()
+	case binstock.FieldReceivedAt:
+		return m.ReceivedAt()
 	}
 	return nil, false
 }
@@ -1792,290 +1963,245 @@ func (m *ItemMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *BinStockMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case item.FieldSKU:
-		return m.OldSKU(ctx)
-	case item.FieldName:
-		return m.OldName(ctx)
-	case item.FieldDescription:
-		return m.OldDescription(ctx)
+	case binstock.FieldQuantity:
+		return m.OldQuantity(ctx)
+	case binstock.FieldReceivedAt:
+		return m.OldReceivedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Item field %s", name)
+	return nil, fmt.Errorf("unknown BinStock field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ItemMutation) SetField(name string, value ent.Value) error {
+func (m *BinStockMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case item.FieldSKU:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSKU(v)
-		return nil
-	case item.FieldName:
-		v, ok := value.(string)
+	case binstock.FieldQuantity:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetQuantity(v)
 		return nil
-	case item.FieldDescription:
-		v, ok := value.(string)
+	case binstock.FieldReceivedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetReceivedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Item field %s", name)
+	return fmt.Errorf("unknown BinStock field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ItemMutation) AddedFields() []string {
-	return nil
+func (m *BinStockMutation) AddedFields() []string {
+	var fields []string
+	if m.addquantity != nil {
+		fields = append(fields, binstock.FieldQuantity)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ItemMutation) AddedField(name string) (ent.Value, bool) {
+func (m *BinStockMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case binstock.FieldQuantity:
+		return m.AddedQuantity()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ItemMutation) AddField(name string, value ent.Value) error {
+func (m *BinStockMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	}
-	return fmt.Errorf("unknown Item numeric field %s", name)
+	case binstock.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuantity(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BinStock numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ItemMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(item.FieldDescription) {
-		fields = append(fields, item.FieldDescription)
-	}
-	return fields
+func (m *BinStockMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ItemMutation) FieldCleared(name string) bool {
+func (m *BinStockMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ItemMutation) ClearField(name string) error {
-	switch name {
-	case item.FieldDescription:
-		m.ClearDescription()
-		return nil
-	}
-	return fmt.Errorf("unknown Item nullable field %s", name)
+func (m *BinStockMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown BinStock nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ItemMutation) ResetField(name string) error {
+func (m *BinStockMutation) ResetField(name string) error {
 	switch name {
-	case item.FieldSKU:
-		m.ResetSKU()
-		return nil
-	case item.FieldName:
-		m.ResetName()
+	case binstock.FieldQuantity:
+		m.ResetQuantity()
 		return nil
-	case item.FieldDescription:
-		m.ResetDescription()
+	case binstock.FieldReceivedAt:
+		m.ResetReceivedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Item field %s", name)
+	return fmt.Errorf("unknown BinStock field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ItemMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.movements != nil {
-		edges = append(edges, item.EdgeMovements)
-	}
-	if m.order_lines != nil {
-		edges = append(edges, item.EdgeOrderLines)
+func (m *BinStockMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.bin != nil {
+		edges = append(edges, binstock.EdgeBin)
 	}
-	if m.bins != nil {
-		edges = append(edges, item.EdgeBins)
+	if m.item != nil {
+		edges = append(edges, binstock.EdgeItem)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ItemMutation) AddedIDs(name string) []ent.Value {
+func (m *BinStockMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case item.EdgeMovements:
-		ids := make([]ent.Value, 0, len(m.movements))
-		for id := range m.movements {
-			ids = append(ids, id)
-		}
-		return ids
-	case item.EdgeOrderLines:
-		ids := make([]ent.Value, 0, len(m.order_lines))
-		for id := range m.order_lines {
-			ids = append(ids, id)
+	case binstock.EdgeBin:
+		if id := m.bin; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case item.EdgeBins:
-		ids := make([]ent.Value, 0, len(m.bins))
-		for id := range m.bins {
-			ids = append(ids, id)
+	case binstock.EdgeItem:
+		if id := m.item; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ItemMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedmovements != nil {
-		edges = append(edges, item.EdgeMovements)
-	}
-	if m.removedorder_lines != nil {
-		edges = append(edges, item.EdgeOrderLines)
-	}
-	if m.removedbins != nil {
-		edges = append(edges, item.EdgeBins)
-	}
+func (m *BinStockMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ItemMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case item.EdgeMovements:
-		ids := make([]ent.Value, 0, len(m.removedmovements))
-		for id := range m.removedmovements {
-			ids = append(ids, id)
-		}
-		return ids
-	case item.EdgeOrderLines:
-		ids := make([]ent.Value, 0, len(m.removedorder_lines))
-		for id := range m.removedorder_lines {
-			ids = append(ids, id)
-		}
-		return ids
-	case item.EdgeBins:
-		ids := make([]ent.Value, 0, len(m.removedbins))
-		for id := range m.removedbins {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *BinStockMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ItemMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedmovements {
-		edges = append(edges, item.EdgeMovements)
-	}
-	if m.clearedorder_lines {
-		edges = append(edges, item.EdgeOrderLines)
+func (m *BinStockMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedbin {
+		edges = append(edges, binstock.EdgeBin)
 	}
-	if m.clearedbins {
-		edges = append(edges, item.EdgeBins)
+	if m.cleareditem {
+		edges = append(edges, binstock.EdgeItem)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ItemMutation) EdgeCleared(name string) bool {
+func (m *BinStockMutation) EdgeCleared(name string) bool {
 	switch name {
-	case item.EdgeMovements:
-		return m.clearedmovements
-	case item.EdgeOrderLines:
-		return m.clearedorder_lines
-	case item.EdgeBins:
-		return m.clearedbins
+	case binstock.EdgeBin:
+		return m.clearedbin
+	case binstock.EdgeItem:
+		return m.cleareditem
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ItemMutation) ClearEdge(name string) error {
+func (m *BinStockMutation) ClearEdge(name string) error {
 	switch name {
+	case binstock.EdgeBin:
+		m.ClearBin()
+		return nil
+	case binstock.EdgeItem:
+		m.ClearItem()
+		return nil
 	}
-	return fmt.Errorf("unknown Item unique edge %s", name)
+	return fmt.Errorf("unknown BinStock unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ItemMutation) ResetEdge(name string) error {
+func (m *BinStockMutation) ResetEdge(name string) error {
 	switch name {
-	case item.EdgeMovements:
-		m.ResetMovements()
+	case binstock.EdgeBin:
+		m.ResetBin()
 		return nil
-	case item.EdgeOrderLines:
-		m.ResetOrderLines()
-		return nil
-	case item.EdgeBins:
-		m.ResetBins()
+	case binstock.EdgeItem:
+		m.ResetItem()
 		return nil
 	}
-	return fmt.Errorf("unknown Item edge %s", name)
+	return fmt.Errorf("unknown BinStock edge %s", name)
 }
 
-// LocationMutation represents an operation that mutates the Location nodes in the graph.
-type LocationMutation struct {
+// CartonMutation represents an operation that mutates the Carton nodes in the graph.
+type CartonMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *int
-	code                  *string
-	name                  *string
-	clearedFields         map[string]struct{}
-	movements             map[int]struct{}
-	removedmovements      map[int]struct{}
-	clearedmovements      bool
-	order_lines           map[int]struct{}
-	removedorder_lines    map[int]struct{}
-	clearedorder_lines    bool
-	zones                 map[int]struct{}
-	removedzones          map[int]struct{}
-	clearedzones          bool
-	bins                  map[int]struct{}
-	removedbins           map[int]struct{}
-	clearedbins           bool
-	warehouse_link        *int
-	clearedwarehouse_link bool
-	done                  bool
-	oldValue              func(context.Context) (*Location, error)
-	predicates            []predicate.Location
+	op                 Op
+	typ                string
+	id                 *int
+	number             *string
+	status             *string
+	length             *float64
+	addlength          *float64
+	width              *float64
+	addwidth           *float64
+	height             *float64
+	addheight          *float64
+	weight             *float64
+	addweight          *float64
+	created_at         *time.Time
+	closed_at          *time.Time
+	clearedFields      map[string]struct{}
+	_order             *int
+	cleared_order      bool
+	carton_type        *int
+	clearedcarton_type bool
+	lines              map[int]struct{}
+	removedlines       map[int]struct{}
+	clearedlines       bool
+	tracking           *int
+	clearedtracking    bool
+	done               bool
+	oldValue           func(context.Context) (*Carton, error)
+	predicates         []predicate.Carton
 }
 
-var _ ent.Mutation = (*LocationMutation)(nil)
+var _ ent.Mutation = (*CartonMutation)(nil)
 
-// locationOption allows management of the mutation configuration using functional options.
-type locationOption func(*LocationMutation)
+// cartonOption allows management of the mutation configuration using functional options.
+type cartonOption func(*CartonMutation)
 
-// newLocationMutation creates new mutation for the Location entity.
-func newLocationMutation(c config, op Op, opts ...locationOption) *LocationMutation {
-	m := &LocationMutation{
+// newCartonMutation creates new mutation for the Carton entity.
+func newCartonMutation(c config, op Op, opts ...cartonOption) *CartonMutation {
+	m := &CartonMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeLocation,
+		typ:           TypeCarton,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2084,20 +2210,20 @@ func newLocationMutation(c config, op Op, opts ...locationOption) *LocationMutat
 	return m
 }
 
-// withLocationID sets the ID field of the mutation.
-func withLocationID(id int) locationOption {
-	return func(m *LocationMutation) {
+// withCartonID sets the ID field of the mutation.
+func withCartonID(id int) cartonOption {
+	return func(m *CartonMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Location
+			value *Carton
 		)
-		m.oldValue = func(ctx context.Context) (*Location, error) {
+		m.oldValue = func(ctx context.Context) (*Carton, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Location.Get(ctx, id)
+					value, err = m.Client().Carton.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2106,10 +2232,10 @@ func withLocationID(id int) locationOption {
 	}
 }
 
-// withLocation sets the old Location of the mutation.
-func withLocation(node *Location) locationOption {
-	return func(m *LocationMutation) {
-		m.oldValue = func(context.Context) (*Location, error) {
+// withCarton sets the old Carton of the mutation.
+func withCarton(node *Carton) cartonOption {
+	return func(m *CartonMutation) {
+		m.oldValue = func(context.Context) (*Carton, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2118,7 +2244,7 @@ func withLocation(node *Location) locationOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m LocationMutation) Client() *Client {
+func (m CartonMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2126,7 +2252,7 @@ func (m LocationMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m LocationMutation) Tx() (*Tx, error) {
+func (m CartonMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2137,7 +2263,7 @@ func (m LocationMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *LocationMutation) ID() (id int, exists bool) {
+func (m *CartonMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2148,7 +2274,7 @@ func (m *LocationMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *LocationMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *CartonMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2157,379 +2283,678 @@ func (m *LocationMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Location.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Carton.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCode sets the "code" field.
-func (m *LocationMutation) SetCode(s string) {
-	m.code = &s
+// SetNumber sets the "number" field.
+func (m *CartonMutation) SetNumber(s string) {
+	m.number = &s
 }
 
-// Code returns the value of the "code" field in the mutation.
-func (m *LocationMutation) Code() (r string, exists bool) {
-	v := m.code
+// Number returns the value of the "number" field in the mutation.
+func (m *CartonMutation) Number() (r string, exists bool) {
+	v := m.number
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCode returns the old "code" field's value of the Location entity.
-// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// OldNumber returns the old "number" field's value of the Carton entity.
+// If the Carton object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LocationMutation) OldCode(ctx context.Context) (v string, err error) {
+func (m *CartonMutation) OldNumber(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+		return v, errors.New("OldNumber is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCode requires an ID field in the mutation")
+		return v, errors.New("OldNumber requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+		return v, fmt.Errorf("querying old value for OldNumber: %w", err)
 	}
-	return oldValue.Code, nil
+	return oldValue.Number, nil
 }
 
-// ResetCode resets all changes to the "code" field.
-func (m *LocationMutation) ResetCode() {
-	m.code = nil
+// ResetNumber resets all changes to the "number" field.
+func (m *CartonMutation) ResetNumber() {
+	m.number = nil
 }
 
-// SetName sets the "name" field.
-func (m *LocationMutation) SetName(s string) {
-	m.name = &s
+// SetStatus sets the "status" field.
+func (m *CartonMutation) SetStatus(s string) {
+	m.status = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *LocationMutation) Name() (r string, exists bool) {
-	v := m.name
+// Status returns the value of the "status" field in the mutation.
+func (m *CartonMutation) Status() (r string, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Location entity.
-// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the Carton entity.
+// If the Carton object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LocationMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *CartonMutation) OldStatus(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Status, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *LocationMutation) ResetName() {
-	m.name = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *CartonMutation) ResetStatus() {
+	m.status = nil
 }
 
-// AddMovementIDs adds the "movements" edge to the StockMovement entity by ids.
-func (m *LocationMutation) AddMovementIDs(ids ...int) {
-	if m.movements == nil {
-		m.movements = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.movements[ids[i]] = struct{}{}
-	}
+// SetLength sets the "length" field.
+func (m *CartonMutation) SetLength(f float64) {
+	m.length = &f
+	m.addlength = nil
 }
 
-// ClearMovements clears the "movements" edge to the StockMovement entity.
-func (m *LocationMutation) ClearMovements() {
-	m.clearedmovements = true
+// Length returns the value of the "length" field in the mutation.
+func (m *CartonMutation) Length() (r float64, exists bool) {
+	v := m.length
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// MovementsCleared reports if the "movements" edge to the StockMovement entity was cleared.
-func (m *LocationMutation) MovementsCleared() bool {
-	return m.clearedmovements
+// OldLength returns the old "length" field's value of the Carton entity.
+// If the Carton object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartonMutation) OldLength(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLength is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLength requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLength: %w", err)
+	}
+	return oldValue.Length, nil
 }
 
-// RemoveMovementIDs removes the "movements" edge to the StockMovement entity by IDs.
-func (m *LocationMutation) RemoveMovementIDs(ids ...int) {
-	if m.removedmovements == nil {
-		m.removedmovements = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.movements, ids[i])
-		m.removedmovements[ids[i]] = struct{}{}
+// AddLength adds f to the "length" field.
+func (m *CartonMutation) AddLength(f float64) {
+	if m.addlength != nil {
+		*m.addlength += f
+	} else {
+		m.addlength = &f
 	}
 }
 
-// RemovedMovements returns the removed IDs of the "movements" edge to the StockMovement entity.
-func (m *LocationMutation) RemovedMovementsIDs() (ids []int) {
-	for id := range m.removedmovements {
-		ids = append(ids, id)
+// AddedLength returns the value that was added to the "length" field in this mutation.
+func (m *CartonMutation) AddedLength() (r float64, exists bool) {
+	v := m.addlength
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// MovementsIDs returns the "movements" edge IDs in the mutation.
-func (m *LocationMutation) MovementsIDs() (ids []int) {
-	for id := range m.movements {
-		ids = append(ids, id)
-	}
-	return
+// ClearLength clears the value of the "length" field.
+func (m *CartonMutation) ClearLength() {
+	m.length = nil
+	m.addlength = nil
+	m.clearedFields[carton.FieldLength] = struct{}{}
 }
 
-// ResetMovements resets all changes to the "movements" edge.
-func (m *LocationMutation) ResetMovements() {
-	m.movements = nil
-	m.clearedmovements = false
-	m.removedmovements = nil
+// LengthCleared returns if the "length" field was cleared in this mutation.
+func (m *CartonMutation) LengthCleared() bool {
+	_, ok := m.clearedFields[carton.FieldLength]
+	return ok
 }
 
-// AddOrderLineIDs adds the "order_lines" edge to the OrderLine entity by ids.
-func (m *LocationMutation) AddOrderLineIDs(ids ...int) {
-	if m.order_lines == nil {
-		m.order_lines = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.order_lines[ids[i]] = struct{}{}
-	}
+// ResetLength resets all changes to the "length" field.
+func (m *CartonMutation) ResetLength() {
+	m.length = nil
+	m.addlength = nil
+	delete(m.clearedFields, carton.FieldLength)
 }
 
-// ClearOrderLines clears the "order_lines" edge to the OrderLine entity.
-func (m *LocationMutation) ClearOrderLines() {
-	m.clearedorder_lines = true
+// SetWidth sets the "width" field.
+func (m *CartonMutation) SetWidth(f float64) {
+	m.width = &f
+	m.addwidth = nil
 }
 
-// OrderLinesCleared reports if the "order_lines" edge to the OrderLine entity was cleared.
-func (m *LocationMutation) OrderLinesCleared() bool {
-	return m.clearedorder_lines
+// Width returns the value of the "width" field in the mutation.
+func (m *CartonMutation) Width() (r float64, exists bool) {
+	v := m.width
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveOrderLineIDs removes the "order_lines" edge to the OrderLine entity by IDs.
-func (m *LocationMutation) RemoveOrderLineIDs(ids ...int) {
-	if m.removedorder_lines == nil {
-		m.removedorder_lines = make(map[int]struct{})
+// OldWidth returns the old "width" field's value of the Carton entity.
+// If the Carton object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartonMutation) OldWidth(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWidth is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.order_lines, ids[i])
-		m.removedorder_lines[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWidth requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWidth: %w", err)
+	}
+	return oldValue.Width, nil
 }
 
-// RemovedOrderLines returns the removed IDs of the "order_lines" edge to the OrderLine entity.
-func (m *LocationMutation) RemovedOrderLinesIDs() (ids []int) {
-	for id := range m.removedorder_lines {
-		ids = append(ids, id)
+// AddWidth adds f to the "width" field.
+func (m *CartonMutation) AddWidth(f float64) {
+	if m.addwidth != nil {
+		*m.addwidth += f
+	} else {
+		m.addwidth = &f
 	}
-	return
 }
 
-// OrderLinesIDs returns the "order_lines" edge IDs in the mutation.
-func (m *LocationMutation) OrderLinesIDs() (ids []int) {
-	for id := range m.order_lines {
-		ids = append(ids, id)
+// AddedWidth returns the value that was added to the "width" field in this mutation.
+func (m *CartonMutation) AddedWidth() (r float64, exists bool) {
+	v := m.addwidth
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetOrderLines resets all changes to the "order_lines" edge.
-func (m *LocationMutation) ResetOrderLines() {
-	m.order_lines = nil
-	m.clearedorder_lines = false
-	m.removedorder_lines = nil
+// ClearWidth clears the value of the "width" field.
+func (m *CartonMutation) ClearWidth() {
+	m.width = nil
+	m.addwidth = nil
+	m.clearedFields[carton.FieldWidth] = struct{}{}
 }
 
-// AddZoneIDs adds the "zones" edge to the Zone entity by ids.
-func (m *LocationMutation) AddZoneIDs(ids ...int) {
-	if m.zones == nil {
-		m.zones = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.zones[ids[i]] = struct{}{}
-	}
+// WidthCleared returns if the "width" field was cleared in this mutation.
+func (m *CartonMutation) WidthCleared() bool {
+	_, ok := m.clearedFields[carton.FieldWidth]
+	return ok
 }
 
-// ClearZones clears the "zones" edge to the Zone entity.
-func (m *LocationMutation) ClearZones() {
-	m.clearedzones = true
+// ResetWidth resets all changes to the "width" field.
+func (m *CartonMutation) ResetWidth() {
+	m.width = nil
+	m.addwidth = nil
+	delete(m.clearedFields, carton.FieldWidth)
 }
 
-// ZonesCleared reports if the "zones" edge to the Zone entity was cleared.
-func (m *LocationMutation) ZonesCleared() bool {
-	return m.clearedzones
+// SetHeight sets the "height" field.
+func (m *CartonMutation) SetHeight(f float64) {
+	m.height = &f
+	m.addheight = nil
 }
 
-// RemoveZoneIDs removes the "zones" edge to the Zone entity by IDs.
-func (m *LocationMutation) RemoveZoneIDs(ids ...int) {
-	if m.removedzones == nil {
-		m.removedzones = make(map[int]struct{})
+// Height returns the value of the "height" field in the mutation.
+func (m *CartonMutation) Height() (r float64, exists bool) {
+	v := m.height
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.zones, ids[i])
-		m.removedzones[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldHeight returns the old "height" field's value of the Carton entity.
+// If the Carton object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartonMutation) OldHeight(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeight: %w", err)
 	}
+	return oldValue.Height, nil
 }
 
-// RemovedZones returns the removed IDs of the "zones" edge to the Zone entity.
-func (m *LocationMutation) RemovedZonesIDs() (ids []int) {
-	for id := range m.removedzones {
-		ids = append(ids, id)
+// AddHeight adds f to the "height" field.
+func (m *CartonMutation) AddHeight(f float64) {
+	if m.addheight != nil {
+		*m.addheight += f
+	} else {
+		m.addheight = &f
 	}
-	return
 }
 
-// ZonesIDs returns the "zones" edge IDs in the mutation.
-func (m *LocationMutation) ZonesIDs() (ids []int) {
-	for id := range m.zones {
-		ids = append(ids, id)
+// AddedHeight returns the value that was added to the "height" field in this mutation.
+func (m *CartonMutation) AddedHeight() (r float64, exists bool) {
+	v := m.addheight
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetZones resets all changes to the "zones" edge.
-func (m *LocationMutation) ResetZones() {
-	m.zones = nil
-	m.clearedzones = false
-	m.removedzones = nil
+// ClearHeight clears the value of the "height" field.
+func (m *CartonMutation) ClearHeight() {
+	m.height = nil
+	m.addheight = nil
+	m.clearedFields[carton.FieldHeight] = struct{}{}
 }
 
-// AddBinIDs adds the "bins" edge to the Bin entity by ids.
-func (m *LocationMutation) AddBinIDs(ids ...int) {
-	if m.bins == nil {
-		m.bins = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.bins[ids[i]] = struct{}{}
-	}
+// HeightCleared returns if the "height" field was cleared in this mutation.
+func (m *CartonMutation) HeightCleared() bool {
+	_, ok := m.clearedFields[carton.FieldHeight]
+	return ok
 }
 
-// ClearBins clears the "bins" edge to the Bin entity.
-func (m *LocationMutation) ClearBins() {
-	m.clearedbins = true
+// ResetHeight resets all changes to the "height" field.
+func (m *CartonMutation) ResetHeight() {
+	m.height = nil
+	m.addheight = nil
+	delete(m.clearedFields, carton.FieldHeight)
 }
 
-// BinsCleared reports if the "bins" edge to the Bin entity was cleared.
-func (m *LocationMutation) BinsCleared() bool {
-	return m.clearedbins
+// SetWeight sets the "weight" field.
+func (m *CartonMutation) SetWeight(f float64) {
+	m.weight = &f
+	m.addweight = nil
 }
 
-// RemoveBinIDs removes the "bins" edge to the Bin entity by IDs.
-func (m *LocationMutation) RemoveBinIDs(ids ...int) {
-	if m.removedbins == nil {
-		m.removedbins = make(map[int]struct{})
+// Weight returns the value of the "weight" field in the mutation.
+func (m *CartonMutation) Weight() (r float64, exists bool) {
+	v := m.weight
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.bins, ids[i])
-		m.removedbins[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldWeight returns the old "weight" field's value of the Carton entity.
+// If the Carton object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartonMutation) OldWeight(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWeight: %w", err)
 	}
+	return oldValue.Weight, nil
 }
 
-// RemovedBins returns the removed IDs of the "bins" edge to the Bin entity.
-func (m *LocationMutation) RemovedBinsIDs() (ids []int) {
-	for id := range m.removedbins {
-		ids = append(ids, id)
+// AddWeight adds f to the "weight" field.
+func (m *CartonMutation) AddWeight(f float64) {
+	if m.addweight != nil {
+		*m.addweight += f
+	} else {
+		m.addweight = &f
 	}
-	return
 }
 
-// BinsIDs returns the "bins" edge IDs in the mutation.
-func (m *LocationMutation) BinsIDs() (ids []int) {
-	for id := range m.bins {
-		ids = append(ids, id)
+// AddedWeight returns the value that was added to the "weight" field in this mutation.
+func (m *CartonMutation) AddedWeight() (r float64, exists bool) {
+	v := m.addweight
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetBins resets all changes to the "bins" edge.
-func (m *LocationMutation) ResetBins() {
-	m.bins = nil
-	m.clearedbins = false
-	m.removedbins = nil
+// ClearWeight clears the value of the "weight" field.
+func (m *CartonMutation) ClearWeight() {
+	m.weight = nil
+	m.addweight = nil
+	m.clearedFields[carton.FieldWeight] = struct{}{}
 }
 
-// SetWarehouseLinkID sets the "warehouse_link" edge to the WarehouseLocation entity by id.
-func (m *LocationMutation) SetWarehouseLinkID(id int) {
-	m.warehouse_link = &id
+// WeightCleared returns if the "weight" field was cleared in this mutation.
+func (m *CartonMutation) WeightCleared() bool {
+	_, ok := m.clearedFields[carton.FieldWeight]
+	return ok
 }
 
-// ClearWarehouseLink clears the "warehouse_link" edge to the WarehouseLocation entity.
-func (m *LocationMutation) ClearWarehouseLink() {
-	m.clearedwarehouse_link = true
+// ResetWeight resets all changes to the "weight" field.
+func (m *CartonMutation) ResetWeight() {
+	m.weight = nil
+	m.addweight = nil
+	delete(m.clearedFields, carton.FieldWeight)
 }
 
-// WarehouseLinkCleared reports if the "warehouse_link" edge to the WarehouseLocation entity was cleared.
-func (m *LocationMutation) WarehouseLinkCleared() bool {
-	return m.clearedwarehouse_link
+// SetCreatedAt sets the "created_at" field.
+func (m *CartonMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// WarehouseLinkID returns the "warehouse_link" edge ID in the mutation.
-func (m *LocationMutation) WarehouseLinkID() (id int, exists bool) {
-	if m.warehouse_link != nil {
-		return *m.warehouse_link, true
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CartonMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// WarehouseLinkIDs returns the "warehouse_link" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// WarehouseLinkID instead. It exists only for internal usage by the builders.
-func (m *LocationMutation) WarehouseLinkIDs() (ids []int) {
-	if id := m.warehouse_link; id != nil {
-		ids = append(ids, *id)
+// OldCreatedAt returns the old "created_at" field's value of the Carton entity.
+// If the Carton object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartonMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetWarehouseLink resets all changes to the "warehouse_link" edge.
-func (m *LocationMutation) ResetWarehouseLink() {
-	m.warehouse_link = nil
-	m.clearedwarehouse_link = false
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CartonMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the LocationMutation builder.
-func (m *LocationMutation) Where(ps ...predicate.Location) {
-	m.predicates = append(m.predicates, ps...)
+// SetClosedAt sets the "closed_at" field.
+func (m *CartonMutation) SetClosedAt(t time.Time) {
+	m.closed_at = &t
 }
 
-// WhereP appends storage-level predicates to the LocationMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *LocationMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Location, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// ClosedAt returns the value of the "closed_at" field in the mutation.
+func (m *CartonMutation) ClosedAt() (r time.Time, exists bool) {
+	v := m.closed_at
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *LocationMutation) Op() Op {
-	return m.op
+// OldClosedAt returns the old "closed_at" field's value of the Carton entity.
+// If the Carton object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartonMutation) OldClosedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClosedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClosedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClosedAt: %w", err)
+	}
+	return oldValue.ClosedAt, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *LocationMutation) SetOp(op Op) {
-	m.op = op
+// ClearClosedAt clears the value of the "closed_at" field.
+func (m *CartonMutation) ClearClosedAt() {
+	m.closed_at = nil
+	m.clearedFields[carton.FieldClosedAt] = struct{}{}
 }
 
-// Type returns the node type of this mutation (Location).
-func (m *LocationMutation) Type() string {
-	return m.typ
+// ClosedAtCleared returns if the "closed_at" field was cleared in this mutation.
+func (m *CartonMutation) ClosedAtCleared() bool {
+	_, ok := m.clearedFields[carton.FieldClosedAt]
+	return ok
+}
+
+// ResetClosedAt resets all changes to the "closed_at" field.
+func (m *CartonMutation) ResetClosedAt() {
+	m.closed_at = nil
+	delete(m.clearedFields, carton.FieldClosedAt)
+}
+
+// SetOrderID sets the "order" edge to the Order entity by id.
+func (m *CartonMutation) SetOrderID(id int) {
+	m._order = &id
+}
+
+// ClearOrder clears the "order" edge to the Order entity.
+func (m *CartonMutation) ClearOrder() {
+	m.cleared_order = true
+}
+
+// OrderCleared reports if the "order" edge to the Order entity was cleared.
+func (m *CartonMutation) OrderCleared() bool {
+	return m.cleared_order
+}
+
+// OrderID returns the "order" edge ID in the mutation.
+func (m *CartonMutation) OrderID() (id int, exists bool) {
+	if m._order != nil {
+		return *m._order, true
+	}
+	return
+}
+
+// OrderIDs returns the "order" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrderID instead. It exists only for internal usage by the builders.
+func (m *CartonMutation) OrderIDs() (ids []int) {
+	if id := m._order; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrder resets all changes to the "order" edge.
+func (m *CartonMutation) ResetOrder() {
+	m._order = nil
+	m.cleared_order = false
+}
+
+// SetCartonTypeID sets the "carton_type" edge to the CartonType entity by id.
+func (m *CartonMutation) SetCartonTypeID(id int) {
+	m.carton_type = &id
+}
+
+// ClearCartonType clears the "carton_type" edge to the CartonType entity.
+func (m *CartonMutation) ClearCartonType() {
+	m.clearedcarton_type = true
+}
+
+// CartonTypeCleared reports if the "carton_type" edge to the CartonType entity was cleared.
+func (m *CartonMutation) CartonTypeCleared() bool {
+	return m.clearedcarton_type
+}
+
+// CartonTypeID returns the "carton_type" edge ID in the mutation.
+func (m *CartonMutation) CartonTypeID() (id int, exists bool) {
+	if m.carton_type != nil {
+		return *m.carton_type, true
+	}
+	return
+}
+
+// CartonTypeIDs returns the "carton_type" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CartonTypeID instead. It exists only for internal usage by the builders.
+func (m *CartonMutation) CartonTypeIDs() (ids []int) {
+	if id := m.carton_type; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCartonType resets all changes to the "carton_type" edge.
+func (m *CartonMutation) ResetCartonType() {
+	m.carton_type = nil
+	m.clearedcarton_type = false
+}
+
+// AddLineIDs adds the "lines" edge to the CartonLine entity by ids.
+func (m *CartonMutation) AddLineIDs(ids ...int) {
+	if m.lines == nil {
+		m.lines = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.lines[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLines clears the "lines" edge to the CartonLine entity.
+func (m *CartonMutation) ClearLines() {
+	m.clearedlines = true
+}
+
+// LinesCleared reports if the "lines" edge to the CartonLine entity was cleared.
+func (m *CartonMutation) LinesCleared() bool {
+	return m.clearedlines
+}
+
+// RemoveLineIDs removes the "lines" edge to the CartonLine entity by IDs.
+func (m *CartonMutation) RemoveLineIDs(ids ...int) {
+	if m.removedlines == nil {
+		m.removedlines = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.lines, ids[i])
+		m.removedlines[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLines returns the removed IDs of the "lines" edge to the CartonLine entity.
+func (m *CartonMutation) RemovedLinesIDs() (ids []int) {
+	for id := range m.removedlines {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LinesIDs returns the "lines" edge IDs in the mutation.
+func (m *CartonMutation) LinesIDs() (ids []int) {
+	for id := range m.lines {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLines resets all changes to the "lines" edge.
+func (m *CartonMutation) ResetLines() {
+	m.lines = nil
+	m.clearedlines = false
+	m.removedlines = nil
+}
+
+// SetTrackingID sets the "tracking" edge to the Tracking entity by id.
+func (m *CartonMutation) SetTrackingID(id int) {
+	m.tracking = &id
+}
+
+// ClearTracking clears the "tracking" edge to the Tracking entity.
+func (m *CartonMutation) ClearTracking() {
+	m.clearedtracking = true
+}
+
+// TrackingCleared reports if the "tracking" edge to the Tracking entity was cleared.
+func (m *CartonMutation) TrackingCleared() bool {
+	return m.clearedtracking
+}
+
+// TrackingID returns the "tracking" edge ID in the mutation.
+func (m *CartonMutation) TrackingID() (id int, exists bool) {
+	if m.tracking != nil {
+		return *m.tracking, true
+	}
+	return
+}
+
+// TrackingIDs returns the "tracking" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TrackingID instead. It exists only for internal usage by the builders.
+func (m *CartonMutation) TrackingIDs() (ids []int) {
+	if id := m.tracking; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTracking resets all changes to the "tracking" edge.
+func (m *CartonMutation) ResetTracking() {
+	m.tracking = nil
+	m.clearedtracking = false
+}
+
+// Where appends a list predicates to the CartonMutation builder.
+func (m *CartonMutation) Where(ps ...predicate.Carton) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CartonMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CartonMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Carton, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CartonMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CartonMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Carton).
+func (m *CartonMutation) Type() string {
+	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *LocationMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.code != nil {
-		fields = append(fields, location.FieldCode)
+func (m *CartonMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.number != nil {
+		fields = append(fields, carton.FieldNumber)
 	}
-	if m.name != nil {
-		fields = append(fields, location.FieldName)
+	if m.status != nil {
+		fields = append(fields, carton.FieldStatus)
+	}
+	if m.length != nil {
+		fields = append(fields, carton.FieldLength)
+	}
+	if m.width != nil {
+		fields = append(fields, carton.FieldWidth)
+	}
+	if m.height != nil {
+		fields = append(fields, carton.FieldHeight)
+	}
+	if m.weight != nil {
+		fields = append(fields, carton.FieldWeight)
+	}
+	if m.created_at != nil {
+		fields = append(fields, carton.FieldCreatedAt)
+	}
+	if m.closed_at != nil {
+		fields = append(fields, carton.FieldClosedAt)
 	}
 	return fields
 }
@@ -2537,12 +2962,24 @@ func (m *LocationMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *LocationMutation) Field(name string) (ent.Value, bool) {
+func (m *CartonMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case location.FieldCode:
-		return m.Code()
-	case location.FieldName:
-		return m.Name()
+	case carton.FieldNumber:
+		return m.Number()
+	case carton.FieldStatus:
+		return m.Status()
+	case carton.FieldLength:
+		return m.Length()
+	case carton.FieldWidth:
+		return m.Width()
+	case carton.FieldHeight:
+		return m.Height()
+	case carton.FieldWeight:
+		return m.Weight()
+	case carton.FieldCreatedAt:
+		return m.CreatedAt()
+	case carton.FieldClosedAt:
+		return m.ClosedAt()
 	}
 	return nil, false
 }
@@ -2550,145 +2987,288 @@ func (m *LocationMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *LocationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CartonMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case location.FieldCode:
-		return m.OldCode(ctx)
-	case location.FieldName:
-		return m.OldName(ctx)
+	case carton.FieldNumber:
+		return m.OldNumber(ctx)
+	case carton.FieldStatus:
+		return m.OldStatus(ctx)
+	case carton.FieldLength:
+		return m.OldLength(ctx)
+	case carton.FieldWidth:
+		return m.OldWidth(ctx)
+	case carton.FieldHeight:
+		return m.OldHeight(ctx)
+	case carton.FieldWeight:
+		return m.OldWeight(ctx)
+	case carton.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case carton.FieldClosedAt:
+		return m.OldClosedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Location field %s", name)
+	return nil, fmt.Errorf("unknown Carton field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LocationMutation) SetField(name string, value ent.Value) error {
+func (m *CartonMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case location.FieldCode:
+	case carton.FieldNumber:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCode(v)
+		m.SetNumber(v)
 		return nil
-	case location.FieldName:
+	case carton.FieldStatus:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetStatus(v)
+		return nil
+	case carton.FieldLength:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLength(v)
+		return nil
+	case carton.FieldWidth:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWidth(v)
+		return nil
+	case carton.FieldHeight:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeight(v)
+		return nil
+	case carton.FieldWeight:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWeight(v)
+		return nil
+	case carton.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case carton.FieldClosedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClosedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Location field %s", name)
+	return fmt.Errorf("unknown Carton field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *LocationMutation) AddedFields() []string {
-	return nil
+func (m *CartonMutation) AddedFields() []string {
+	var fields []string
+	if m.addlength != nil {
+		fields = append(fields, carton.FieldLength)
+	}
+	if m.addwidth != nil {
+		fields = append(fields, carton.FieldWidth)
+	}
+	if m.addheight != nil {
+		fields = append(fields, carton.FieldHeight)
+	}
+	if m.addweight != nil {
+		fields = append(fields, carton.FieldWeight)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *LocationMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CartonMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case carton.FieldLength:
+		return m.AddedLength()
+	case carton.FieldWidth:
+		return m.AddedWidth()
+	case carton.FieldHeight:
+		return m.AddedHeight()
+	case carton.FieldWeight:
+		return m.AddedWeight()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LocationMutation) AddField(name string, value ent.Value) error {
+func (m *CartonMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case carton.FieldLength:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLength(v)
+		return nil
+	case carton.FieldWidth:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWidth(v)
+		return nil
+	case carton.FieldHeight:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeight(v)
+		return nil
+	case carton.FieldWeight:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWeight(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Location numeric field %s", name)
+	return fmt.Errorf("unknown Carton numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *LocationMutation) ClearedFields() []string {
-	return nil
+func (m *CartonMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(carton.FieldLength) {
+		fields = append(fields, carton.FieldLength)
+	}
+	if m.FieldCleared(carton.FieldWidth) {
+		fields = append(fields, carton.FieldWidth)
+	}
+	if m.FieldCleared(carton.FieldHeight) {
+		fields = append(fields, carton.FieldHeight)
+	}
+	if m.FieldCleared(carton.FieldWeight) {
+		fields = append(fields, carton.FieldWeight)
+	}
+	if m.FieldCleared(carton.FieldClosedAt) {
+		fields = append(fields, carton.FieldClosedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *LocationMutation) FieldCleared(name string) bool {
+func (m *CartonMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *LocationMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Location nullable field %s", name)
-}
-
+func (m *CartonMutation) ClearField(name string) error {
+	switch name {
+	case carton.FieldLength:
+		m.ClearLength()
+		return nil
+	case carton.FieldWidth:
+		m.ClearWidth()
+		return nil
+	case carton.FieldHeight:
+		m.ClearHeight()
+		return nil
+	case carton.FieldWeight:
+		m.ClearWeight()
+		return nil
+	case carton.FieldClosedAt:
+		m.ClearClosedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Carton nullable field %s", name)
+}
+
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *LocationMutation) ResetField(name string) error {
+func (m *CartonMutation) ResetField(name string) error {
 	switch name {
-	case location.FieldCode:
-		m.ResetCode()
+	case carton.FieldNumber:
+		m.ResetNumber()
 		return nil
-	case location.FieldName:
-		m.ResetName()
+	case carton.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case carton.FieldLength:
+		m.ResetLength()
+		return nil
+	case carton.FieldWidth:
+		m.ResetWidth()
+		return nil
+	case carton.FieldHeight:
+		m.ResetHeight()
+		return nil
+	case carton.FieldWeight:
+		m.ResetWeight()
+		return nil
+	case carton.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case carton.FieldClosedAt:
+		m.ResetClosedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Location field %s", name)
+	return fmt.Errorf("unknown Carton field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *LocationMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.movements != nil {
-		edges = append(edges, location.EdgeMovements)
-	}
-	if m.order_lines != nil {
-		edges = append(edges, location.EdgeOrderLines)
+func (m *CartonMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m._order != nil {
+		edges = append(edges, carton.EdgeOrder)
 	}
-	if m.zones != nil {
-		edges = append(edges, location.EdgeZones)
+	if m.carton_type != nil {
+		edges = append(edges, carton.EdgeCartonType)
 	}
-	if m.bins != nil {
-		edges = append(edges, location.EdgeBins)
+	if m.lines != nil {
+		edges = append(edges, carton.EdgeLines)
 	}
-	if m.warehouse_link != nil {
-		edges = append(edges, location.EdgeWarehouseLink)
+	if m.tracking != nil {
+		edges = append(edges, carton.EdgeTracking)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *LocationMutation) AddedIDs(name string) []ent.Value {
+func (m *CartonMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case location.EdgeMovements:
-		ids := make([]ent.Value, 0, len(m.movements))
-		for id := range m.movements {
-			ids = append(ids, id)
-		}
-		return ids
-	case location.EdgeOrderLines:
-		ids := make([]ent.Value, 0, len(m.order_lines))
-		for id := range m.order_lines {
-			ids = append(ids, id)
+	case carton.EdgeOrder:
+		if id := m._order; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case location.EdgeZones:
-		ids := make([]ent.Value, 0, len(m.zones))
-		for id := range m.zones {
-			ids = append(ids, id)
+	case carton.EdgeCartonType:
+		if id := m.carton_type; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case location.EdgeBins:
-		ids := make([]ent.Value, 0, len(m.bins))
-		for id := range m.bins {
+	case carton.EdgeLines:
+		ids := make([]ent.Value, 0, len(m.lines))
+		for id := range m.lines {
 			ids = append(ids, id)
 		}
 		return ids
-	case location.EdgeWarehouseLink:
-		if id := m.warehouse_link; id != nil {
+	case carton.EdgeTracking:
+		if id := m.tracking; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -2696,48 +3276,21 @@ func (m *LocationMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *LocationMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.removedmovements != nil {
-		edges = append(edges, location.EdgeMovements)
-	}
-	if m.removedorder_lines != nil {
-		edges = append(edges, location.EdgeOrderLines)
-	}
-	if m.removedzones != nil {
-		edges = append(edges, location.EdgeZones)
-	}
-	if m.removedbins != nil {
-		edges = append(edges, location.EdgeBins)
+func (m *CartonMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedlines != nil {
+		edges = append(edges, carton.EdgeLines)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *LocationMutation) RemovedIDs(name string) []ent.Value {
+func (m *CartonMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case location.EdgeMovements:
-		ids := make([]ent.Value, 0, len(m.removedmovements))
-		for id := range m.removedmovements {
-			ids = append(ids, id)
-		}
-		return ids
-	case location.EdgeOrderLines:
-		ids := make([]ent.Value, 0, len(m.removedorder_lines))
-		for id := range m.removedorder_lines {
-			ids = append(ids, id)
-		}
-		return ids
-	case location.EdgeZones:
-		ids := make([]ent.Value, 0, len(m.removedzones))
-		for id := range m.removedzones {
-			ids = append(ids, id)
-		}
-		return ids
-	case location.EdgeBins:
-		ids := make([]ent.Value, 0, len(m.removedbins))
-		for id := range m.removedbins {
+	case carton.EdgeLines:
+		ids := make([]ent.Value, 0, len(m.removedlines))
+		for id := range m.removedlines {
 			ids = append(ids, id)
 		}
 		return ids
@@ -2746,112 +3299,105 @@ func (m *LocationMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *LocationMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.clearedmovements {
-		edges = append(edges, location.EdgeMovements)
-	}
-	if m.clearedorder_lines {
-		edges = append(edges, location.EdgeOrderLines)
+func (m *CartonMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.cleared_order {
+		edges = append(edges, carton.EdgeOrder)
 	}
-	if m.clearedzones {
-		edges = append(edges, location.EdgeZones)
+	if m.clearedcarton_type {
+		edges = append(edges, carton.EdgeCartonType)
 	}
-	if m.clearedbins {
-		edges = append(edges, location.EdgeBins)
+	if m.clearedlines {
+		edges = append(edges, carton.EdgeLines)
 	}
-	if m.clearedwarehouse_link {
-		edges = append(edges, location.EdgeWarehouseLink)
+	if m.clearedtracking {
+		edges = append(edges, carton.EdgeTracking)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *LocationMutation) EdgeCleared(name string) bool {
+func (m *CartonMutation) EdgeCleared(name string) bool {
 	switch name {
-	case location.EdgeMovements:
-		return m.clearedmovements
-	case location.EdgeOrderLines:
-		return m.clearedorder_lines
-	case location.EdgeZones:
-		return m.clearedzones
-	case location.EdgeBins:
-		return m.clearedbins
-	case location.EdgeWarehouseLink:
-		return m.clearedwarehouse_link
+	case carton.EdgeOrder:
+		return m.cleared_order
+	case carton.EdgeCartonType:
+		return m.clearedcarton_type
+	case carton.EdgeLines:
+		return m.clearedlines
+	case carton.EdgeTracking:
+		return m.clearedtracking
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *LocationMutation) ClearEdge(name string) error {
+func (m *CartonMutation) ClearEdge(name string) error {
 	switch name {
-	case location.EdgeWarehouseLink:
-		m.ClearWarehouseLink()
+	case carton.EdgeOrder:
+		m.ClearOrder()
+		return nil
+	case carton.EdgeCartonType:
+		m.ClearCartonType()
+		return nil
+	case carton.EdgeTracking:
+		m.ClearTracking()
 		return nil
 	}
-	return fmt.Errorf("unknown Location unique edge %s", name)
+	return fmt.Errorf("unknown Carton unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *LocationMutation) ResetEdge(name string) error {
+func (m *CartonMutation) ResetEdge(name string) error {
 	switch name {
-	case location.EdgeMovements:
-		m.ResetMovements()
-		return nil
-	case location.EdgeOrderLines:
-		m.ResetOrderLines()
+	case carton.EdgeOrder:
+		m.ResetOrder()
 		return nil
-	case location.EdgeZones:
-		m.ResetZones()
+	case carton.EdgeCartonType:
+		m.ResetCartonType()
 		return nil
-	case location.EdgeBins:
-		m.ResetBins()
+	case carton.EdgeLines:
+		m.ResetLines()
 		return nil
-	case location.EdgeWarehouseLink:
-		m.ResetWarehouseLink()
+	case carton.EdgeTracking:
+		m.ResetTracking()
 		return nil
 	}
-	return fmt.Errorf("unknown Location edge %s", name)
+	return fmt.Errorf("unknown Carton edge %s", name)
 }
 
-// OrderMutation represents an operation that mutates the Order nodes in the graph.
-type OrderMutation struct {
+// CartonLineMutation represents an operation that mutates the CartonLine nodes in the graph.
+type CartonLineMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	order_number    *string
-	_type           *string
-	status          *string
-	created_at      *time.Time
-	clearedFields   map[string]struct{}
-	lines           map[int]struct{}
-	removedlines    map[int]struct{}
-	clearedlines    bool
-	picklist        *int
-	clearedpicklist bool
-	tracking        *int
-	clearedtracking bool
-	done            bool
-	oldValue        func(context.Context) (*Order, error)
-	predicates      []predicate.Order
+	op            Op
+	typ           string
+	id            *int
+	quantity      *int
+	addquantity   *int
+	clearedFields map[string]struct{}
+	carton        *int
+	clearedcarton bool
+	item          *int
+	cleareditem   bool
+	done          bool
+	oldValue      func(context.Context) (*CartonLine, error)
+	predicates    []predicate.CartonLine
 }
 
-var _ ent.Mutation = (*OrderMutation)(nil)
+var _ ent.Mutation = (*CartonLineMutation)(nil)
 
-// orderOption allows management of the mutation configuration using functional options.
-type orderOption func(*OrderMutation)
+// cartonlineOption allows management of the mutation configuration using functional options.
+type cartonlineOption func(*CartonLineMutation)
 
-// newOrderMutation creates new mutation for the Order entity.
-func newOrderMutation(c config, op Op, opts ...orderOption) *OrderMutation {
-	m := &OrderMutation{
+// newCartonLineMutation creates new mutation for the CartonLine entity.
+func newCartonLineMutation(c config, op Op, opts ...cartonlineOption) *CartonLineMutation {
+	m := &CartonLineMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeOrder,
+		typ:           TypeCartonLine,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2860,20 +3406,20 @@ func newOrderMutation(c config, op Op, opts ...orderOption) *OrderMutation {
 	return m
 }
 
-// withOrderID sets the ID field of the mutation.
-func withOrderID(id int) orderOption {
-	return func(m *OrderMutation) {
+// withCartonLineID sets the ID field of the mutation.
+func withCartonLineID(id int) cartonlineOption {
+	return func(m *CartonLineMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Order
+			value *CartonLine
 		)
-		m.oldValue = func(ctx context.Context) (*Order, error) {
+		m.oldValue = func(ctx context.Context) (*CartonLine, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Order.Get(ctx, id)
+					value, err = m.Client().CartonLine.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2882,10 +3428,10 @@ func withOrderID(id int) orderOption {
 	}
 }
 
-// withOrder sets the old Order of the mutation.
-func withOrder(node *Order) orderOption {
-	return func(m *OrderMutation) {
-		m.oldValue = func(context.Context) (*Order, error) {
+// withCartonLine sets the old CartonLine of the mutation.
+func withCartonLine(node *CartonLine) cartonlineOption {
+	return func(m *CartonLineMutation) {
+		m.oldValue = func(context.Context) (*CartonLine, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2894,7 +3440,7 @@ func withOrder(node *Order) orderOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m OrderMutation) Client() *Client {
+func (m CartonLineMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2902,7 +3448,7 @@ func (m OrderMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m OrderMutation) Tx() (*Tx, error) {
+func (m CartonLineMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2913,7 +3459,7 @@ func (m OrderMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *OrderMutation) ID() (id int, exists bool) {
+func (m *CartonLineMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2924,7 +3470,7 @@ func (m *OrderMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *OrderMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *CartonLineMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2933,297 +3479,155 @@ func (m *OrderMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Order.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().CartonLine.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetOrderNumber sets the "order_number" field.
-func (m *OrderMutation) SetOrderNumber(s string) {
-	m.order_number = &s
+// SetQuantity sets the "quantity" field.
+func (m *CartonLineMutation) SetQuantity(i int) {
+	m.quantity = &i
+	m.addquantity = nil
 }
 
-// OrderNumber returns the value of the "order_number" field in the mutation.
-func (m *OrderMutation) OrderNumber() (r string, exists bool) {
-	v := m.order_number
+// Quantity returns the value of the "quantity" field in the mutation.
+func (m *CartonLineMutation) Quantity() (r int, exists bool) {
+	v := m.quantity
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOrderNumber returns the old "order_number" field's value of the Order entity.
-// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// OldQuantity returns the old "quantity" field's value of the CartonLine entity.
+// If the CartonLine object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderMutation) OldOrderNumber(ctx context.Context) (v string, err error) {
+func (m *CartonLineMutation) OldQuantity(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOrderNumber is only allowed on UpdateOne operations")
+		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOrderNumber requires an ID field in the mutation")
+		return v, errors.New("OldQuantity requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOrderNumber: %w", err)
+		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
 	}
-	return oldValue.OrderNumber, nil
-}
-
-// ResetOrderNumber resets all changes to the "order_number" field.
-func (m *OrderMutation) ResetOrderNumber() {
-	m.order_number = nil
+	return oldValue.Quantity, nil
 }
 
-// SetType sets the "type" field.
-func (m *OrderMutation) SetType(s string) {
-	m._type = &s
+// AddQuantity adds i to the "quantity" field.
+func (m *CartonLineMutation) AddQuantity(i int) {
+	if m.addquantity != nil {
+		*m.addquantity += i
+	} else {
+		m.addquantity = &i
+	}
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *OrderMutation) GetType() (r string, exists bool) {
-	v := m._type
+// AddedQuantity returns the value that was added to the "quantity" field in this mutation.
+func (m *CartonLineMutation) AddedQuantity() (r int, exists bool) {
+	v := m.addquantity
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Order entity.
-// If the Order object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderMutation) OldType(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
-	}
-	return oldValue.Type, nil
-}
-
-// ResetType resets all changes to the "type" field.
-func (m *OrderMutation) ResetType() {
-	m._type = nil
-}
-
-// SetStatus sets the "status" field.
-func (m *OrderMutation) SetStatus(s string) {
-	m.status = &s
-}
-
-// Status returns the value of the "status" field in the mutation.
-func (m *OrderMutation) Status() (r string, exists bool) {
-	v := m.status
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldStatus returns the old "status" field's value of the Order entity.
-// If the Order object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderMutation) OldStatus(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
-	}
-	return oldValue.Status, nil
-}
-
-// ResetStatus resets all changes to the "status" field.
-func (m *OrderMutation) ResetStatus() {
-	m.status = nil
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (m *OrderMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
-}
-
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *OrderMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCreatedAt returns the old "created_at" field's value of the Order entity.
-// If the Order object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *OrderMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// AddLineIDs adds the "lines" edge to the OrderLine entity by ids.
-func (m *OrderMutation) AddLineIDs(ids ...int) {
-	if m.lines == nil {
-		m.lines = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.lines[ids[i]] = struct{}{}
-	}
-}
-
-// ClearLines clears the "lines" edge to the OrderLine entity.
-func (m *OrderMutation) ClearLines() {
-	m.clearedlines = true
-}
-
-// LinesCleared reports if the "lines" edge to the OrderLine entity was cleared.
-func (m *OrderMutation) LinesCleared() bool {
-	return m.clearedlines
-}
-
-// RemoveLineIDs removes the "lines" edge to the OrderLine entity by IDs.
-func (m *OrderMutation) RemoveLineIDs(ids ...int) {
-	if m.removedlines == nil {
-		m.removedlines = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.lines, ids[i])
-		m.removedlines[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedLines returns the removed IDs of the "lines" edge to the OrderLine entity.
-func (m *OrderMutation) RemovedLinesIDs() (ids []int) {
-	for id := range m.removedlines {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// LinesIDs returns the "lines" edge IDs in the mutation.
-func (m *OrderMutation) LinesIDs() (ids []int) {
-	for id := range m.lines {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetLines resets all changes to the "lines" edge.
-func (m *OrderMutation) ResetLines() {
-	m.lines = nil
-	m.clearedlines = false
-	m.removedlines = nil
+// ResetQuantity resets all changes to the "quantity" field.
+func (m *CartonLineMutation) ResetQuantity() {
+	m.quantity = nil
+	m.addquantity = nil
 }
 
-// SetPicklistID sets the "picklist" edge to the PickList entity by id.
-func (m *OrderMutation) SetPicklistID(id int) {
-	m.picklist = &id
+// SetCartonID sets the "carton" edge to the Carton entity by id.
+func (m *CartonLineMutation) SetCartonID(id int) {
+	m.carton = &id
 }
 
-// ClearPicklist clears the "picklist" edge to the PickList entity.
-func (m *OrderMutation) ClearPicklist() {
-	m.clearedpicklist = true
+// ClearCarton clears the "carton" edge to the Carton entity.
+func (m *CartonLineMutation) ClearCarton() {
+	m.clearedcarton = true
 }
 
-// PicklistCleared reports if the "picklist" edge to the PickList entity was cleared.
-func (m *OrderMutation) PicklistCleared() bool {
-	return m.clearedpicklist
+// CartonCleared reports if the "carton" edge to the Carton entity was cleared.
+func (m *CartonLineMutation) CartonCleared() bool {
+	return m.clearedcarton
 }
 
-// PicklistID returns the "picklist" edge ID in the mutation.
-func (m *OrderMutation) PicklistID() (id int, exists bool) {
-	if m.picklist != nil {
-		return *m.picklist, true
+// CartonID returns the "carton" edge ID in the mutation.
+func (m *CartonLineMutation) CartonID() (id int, exists bool) {
+	if m.carton != nil {
+		return *m.carton, true
 	}
 	return
 }
 
-// PicklistIDs returns the "picklist" edge IDs in the mutation.
+// CartonIDs returns the "carton" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PicklistID instead. It exists only for internal usage by the builders.
-func (m *OrderMutation) PicklistIDs() (ids []int) {
-	if id := m.picklist; id != nil {
+// CartonID instead. It exists only for internal usage by the builders.
+func (m *CartonLineMutation) CartonIDs() (ids []int) {
+	if id := m.carton; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetPicklist resets all changes to the "picklist" edge.
-func (m *OrderMutation) ResetPicklist() {
-	m.picklist = nil
-	m.clearedpicklist = false
+// ResetCarton resets all changes to the "carton" edge.
+func (m *CartonLineMutation) ResetCarton() {
+	m.carton = nil
+	m.clearedcarton = false
 }
 
-// SetTrackingID sets the "tracking" edge to the Tracking entity by id.
-func (m *OrderMutation) SetTrackingID(id int) {
-	m.tracking = &id
+// SetItemID sets the "item" edge to the Item entity by id.
+func (m *CartonLineMutation) SetItemID(id int) {
+	m.item = &id
 }
 
-// ClearTracking clears the "tracking" edge to the Tracking entity.
-func (m *OrderMutation) ClearTracking() {
-	m.clearedtracking = true
+// ClearItem clears the "item" edge to the Item entity.
+func (m *CartonLineMutation) ClearItem() {
+	m.cleareditem = true
 }
 
-// TrackingCleared reports if the "tracking" edge to the Tracking entity was cleared.
-func (m *OrderMutation) TrackingCleared() bool {
-	return m.clearedtracking
+// ItemCleared reports if the "item" edge to the Item entity was cleared.
+func (m *CartonLineMutation) ItemCleared() bool {
+	return m.cleareditem
 }
 
-// TrackingID returns the "tracking" edge ID in the mutation.
-func (m *OrderMutation) TrackingID() (id int, exists bool) {
-	if m.tracking != nil {
-		return *m.tracking, true
+// ItemID returns the "item" edge ID in the mutation.
+func (m *CartonLineMutation) ItemID() (id int, exists bool) {
+	if m.item != nil {
+		return *m.item, true
 	}
 	return
 }
 
-// TrackingIDs returns the "tracking" edge IDs in the mutation.
+// ItemIDs returns the "item" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TrackingID instead. It exists only for internal usage by the builders.
-func (m *OrderMutation) TrackingIDs() (ids []int) {
-	if id := m.tracking; id != nil {
+// ItemID instead. It exists only for internal usage by the builders.
+func (m *CartonLineMutation) ItemIDs() (ids []int) {
+	if id := m.item; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetTracking resets all changes to the "tracking" edge.
-func (m *OrderMutation) ResetTracking() {
-	m.tracking = nil
-	m.clearedtracking = false
+// ResetItem resets all changes to the "item" edge.
+func (m *CartonLineMutation) ResetItem() {
+	m.item = nil
+	m.cleareditem = false
 }
 
-// Where appends a list predicates to the OrderMutation builder.
-func (m *OrderMutation) Where(ps ...predicate.Order) {
+// Where appends a list predicates to the CartonLineMutation builder.
+func (m *CartonLineMutation) Where(ps ...predicate.CartonLine) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the OrderMutation builder. Using this method,
+// WhereP appends storage-level predicates to the CartonLineMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *OrderMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Order, len(ps))
+func (m *CartonLineMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CartonLine, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3231,36 +3635,27 @@ func (m *OrderMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *OrderMutation) Op() Op {
+func (m *CartonLineMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *OrderMutation) SetOp(op Op) {
+func (m *CartonLineMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Order).
-func (m *OrderMutation) Type() string {
+// Type returns the node type of this mutation (CartonLine).
+func (m *CartonLineMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *OrderMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.order_number != nil {
-		fields = append(fields, order.FieldOrderNumber)
-	}
-	if m._type != nil {
-		fields = append(fields, order.FieldType)
-	}
-	if m.status != nil {
-		fields = append(fields, order.FieldStatus)
-	}
-	if m.created_at != nil {
-		fields = append(fields, order.FieldCreatedAt)
+func (m *CartonLineMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.quantity != nil {
+		fields = append(fields, cartonline.FieldQuantity)
 	}
 	return fields
 }
@@ -3268,16 +3663,10 @@ func (m *OrderMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *OrderMutation) Field(name string) (ent.Value, bool) {
+func (m *CartonLineMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case order.FieldOrderNumber:
-		return m.OrderNumber()
-	case order.FieldType:
-		return m.GetType()
-	case order.FieldStatus:
-		return m.Status()
-	case order.FieldCreatedAt:
-		return m.CreatedAt()
+	case cartonline.FieldQuantity:
+		return m.Quantity()
 	}
 	return nil, false
 }
@@ -3285,149 +3674,119 @@ func (m *OrderMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *OrderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CartonLineMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case order.FieldOrderNumber:
-		return m.OldOrderNumber(ctx)
-	case order.FieldType:
-		return m.OldType(ctx)
-	case order.FieldStatus:
-		return m.OldStatus(ctx)
-	case order.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
+	case cartonline.FieldQuantity:
+		return m.OldQuantity(ctx)
 	}
-	return nil, fmt.Errorf("unknown Order field %s", name)
+	return nil, fmt.Errorf("unknown CartonLine field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OrderMutation) SetField(name string, value ent.Value) error {
+func (m *CartonLineMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case order.FieldOrderNumber:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOrderNumber(v)
-		return nil
-	case order.FieldType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
-		return nil
-	case order.FieldStatus:
-		v, ok := value.(string)
+	case cartonline.FieldQuantity:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
-		return nil
-	case order.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
+		m.SetQuantity(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Order field %s", name)
+	return fmt.Errorf("unknown CartonLine field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *OrderMutation) AddedFields() []string {
-	return nil
+func (m *CartonLineMutation) AddedFields() []string {
+	var fields []string
+	if m.addquantity != nil {
+		fields = append(fields, cartonline.FieldQuantity)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *OrderMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CartonLineMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case cartonline.FieldQuantity:
+		return m.AddedQuantity()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OrderMutation) AddField(name string, value ent.Value) error {
+func (m *CartonLineMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case cartonline.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuantity(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Order numeric field %s", name)
+	return fmt.Errorf("unknown CartonLine numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *OrderMutation) ClearedFields() []string {
+func (m *CartonLineMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *OrderMutation) FieldCleared(name string) bool {
+func (m *CartonLineMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *OrderMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Order nullable field %s", name)
+func (m *CartonLineMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown CartonLine nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *OrderMutation) ResetField(name string) error {
+func (m *CartonLineMutation) ResetField(name string) error {
 	switch name {
-	case order.FieldOrderNumber:
-		m.ResetOrderNumber()
-		return nil
-	case order.FieldType:
-		m.ResetType()
-		return nil
-	case order.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case order.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case cartonline.FieldQuantity:
+		m.ResetQuantity()
 		return nil
 	}
-	return fmt.Errorf("unknown Order field %s", name)
+	return fmt.Errorf("unknown CartonLine field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *OrderMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.lines != nil {
-		edges = append(edges, order.EdgeLines)
-	}
-	if m.picklist != nil {
-		edges = append(edges, order.EdgePicklist)
+func (m *CartonLineMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.carton != nil {
+		edges = append(edges, cartonline.EdgeCarton)
 	}
-	if m.tracking != nil {
-		edges = append(edges, order.EdgeTracking)
+	if m.item != nil {
+		edges = append(edges, cartonline.EdgeItem)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *OrderMutation) AddedIDs(name string) []ent.Value {
+func (m *CartonLineMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case order.EdgeLines:
-		ids := make([]ent.Value, 0, len(m.lines))
-		for id := range m.lines {
-			ids = append(ids, id)
-		}
-		return ids
-	case order.EdgePicklist:
-		if id := m.picklist; id != nil {
+	case cartonline.EdgeCarton:
+		if id := m.carton; id != nil {
 			return []ent.Value{*id}
 		}
-	case order.EdgeTracking:
-		if id := m.tracking; id != nil {
+	case cartonline.EdgeItem:
+		if id := m.item; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -3435,122 +3794,106 @@ func (m *OrderMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *OrderMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedlines != nil {
-		edges = append(edges, order.EdgeLines)
-	}
+func (m *CartonLineMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *OrderMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case order.EdgeLines:
-		ids := make([]ent.Value, 0, len(m.removedlines))
-		for id := range m.removedlines {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *CartonLineMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *OrderMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedlines {
-		edges = append(edges, order.EdgeLines)
-	}
-	if m.clearedpicklist {
-		edges = append(edges, order.EdgePicklist)
+func (m *CartonLineMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedcarton {
+		edges = append(edges, cartonline.EdgeCarton)
 	}
-	if m.clearedtracking {
-		edges = append(edges, order.EdgeTracking)
+	if m.cleareditem {
+		edges = append(edges, cartonline.EdgeItem)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *OrderMutation) EdgeCleared(name string) bool {
+func (m *CartonLineMutation) EdgeCleared(name string) bool {
 	switch name {
-	case order.EdgeLines:
-		return m.clearedlines
-	case order.EdgePicklist:
-		return m.clearedpicklist
-	case order.EdgeTracking:
-		return m.clearedtracking
+	case cartonline.EdgeCarton:
+		return m.clearedcarton
+	case cartonline.EdgeItem:
+		return m.cleareditem
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *OrderMutation) ClearEdge(name string) error {
+func (m *CartonLineMutation) ClearEdge(name string) error {
 	switch name {
-	case order.EdgePicklist:
-		m.ClearPicklist()
+	case cartonline.EdgeCarton:
+		m.ClearCarton()
 		return nil
-	case order.EdgeTracking:
-		m.ClearTracking()
+	case cartonline.EdgeItem:
+		m.ClearItem()
 		return nil
 	}
-	return fmt.Errorf("unknown Order unique edge %s", name)
+	return fmt.Errorf("unknown CartonLine unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *OrderMutation) ResetEdge(name string) error {
+func (m *CartonLineMutation) ResetEdge(name string) error {
 	switch name {
-	case order.EdgeLines:
-		m.ResetLines()
-		return nil
-	case order.EdgePicklist:
-		m.ResetPicklist()
+	case cartonline.EdgeCarton:
+		m.ResetCarton()
 		return nil
-	case order.EdgeTracking:
-		m.ResetTracking()
+	case cartonline.EdgeItem:
+		m.ResetItem()
 		return nil
 	}
-	return fmt.Errorf("unknown Order edge %s", name)
+	return fmt.Errorf("unknown CartonLine edge %s", name)
 }
 
-// OrderLineMutation represents an operation that mutates the OrderLine nodes in the graph.
-type OrderLineMutation struct {
+// CartonTypeMutation represents an operation that mutates the CartonType nodes in the graph.
+type CartonTypeMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *int
-	quantity          *int
-	addquantity       *int
-	clearedFields     map[string]struct{}
-	_order            *int
-	cleared_order     bool
-	item              *int
-	cleareditem       bool
-	location          *int
-	clearedlocation   bool
-	pick_tasks        map[int]struct{}
-	removedpick_tasks map[int]struct{}
-	clearedpick_tasks bool
-	done              bool
-	oldValue          func(context.Context) (*OrderLine, error)
-	predicates        []predicate.OrderLine
-}
-
-var _ ent.Mutation = (*OrderLineMutation)(nil)
-
-// orderlineOption allows management of the mutation configuration using functional options.
-type orderlineOption func(*OrderLineMutation)
-
-// newOrderLineMutation creates new mutation for the OrderLine entity.
-func newOrderLineMutation(c config, op Op, opts ...orderlineOption) *OrderLineMutation {
-	m := &OrderLineMutation{
+	op             Op
+	typ            string
+	id             *int
+	code           *string
+	length         *float64
+	addlength      *float64
+	width          *float64
+	addwidth       *float64
+	height         *float64
+	addheight      *float64
+	max_weight     *float64
+	addmax_weight  *float64
+	tare_weight    *float64
+	addtare_weight *float64
+	clearedFields  map[string]struct{}
+	cartons        map[int]struct{}
+	removedcartons map[int]struct{}
+	clearedcartons bool
+	done           bool
+	oldValue       func(context.Context) (*CartonType, error)
+	predicates     []predicate.CartonType
+}
+
+var _ ent.Mutation = (*CartonTypeMutation)(nil)
+
+// cartontypeOption allows management of the mutation configuration using functional options.
+type cartontypeOption func(*CartonTypeMutation)
+
+// newCartonTypeMutation creates new mutation for the CartonType entity.
+func newCartonTypeMutation(c config, op Op, opts ...cartontypeOption) *CartonTypeMutation {
+	m := &CartonTypeMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeOrderLine,
+		typ:           TypeCartonType,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3559,20 +3902,20 @@ func newOrderLineMutation(c config, op Op, opts ...orderlineOption) *OrderLineMu
 	return m
 }
 
-// withOrderLineID sets the ID field of the mutation.
-func withOrderLineID(id int) orderlineOption {
-	return func(m *OrderLineMutation) {
+// withCartonTypeID sets the ID field of the mutation.
+func withCartonTypeID(id int) cartontypeOption {
+	return func(m *CartonTypeMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *OrderLine
+			value *CartonType
 		)
-		m.oldValue = func(ctx context.Context) (*OrderLine, error) {
+		m.oldValue = func(ctx context.Context) (*CartonType, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().OrderLine.Get(ctx, id)
+					value, err = m.Client().CartonType.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3581,10 +3924,10 @@ func withOrderLineID(id int) orderlineOption {
 	}
 }
 
-// withOrderLine sets the old OrderLine of the mutation.
-func withOrderLine(node *OrderLine) orderlineOption {
-	return func(m *OrderLineMutation) {
-		m.oldValue = func(context.Context) (*OrderLine, error) {
+// withCartonType sets the old CartonType of the mutation.
+func withCartonType(node *CartonType) cartontypeOption {
+	return func(m *CartonTypeMutation) {
+		m.oldValue = func(context.Context) (*CartonType, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3593,7 +3936,7 @@ func withOrderLine(node *OrderLine) orderlineOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m OrderLineMutation) Client() *Client {
+func (m CartonTypeMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3601,7 +3944,7 @@ func (m OrderLineMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m OrderLineMutation) Tx() (*Tx, error) {
+func (m CartonTypeMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3612,7 +3955,7 @@ func (m OrderLineMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *OrderLineMutation) ID() (id int, exists bool) {
+func (m *CartonTypeMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3623,7 +3966,7 @@ func (m *OrderLineMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *OrderLineMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *CartonTypeMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3632,248 +3975,391 @@ func (m *OrderLineMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().OrderLine.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().CartonType.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetQuantity sets the "quantity" field.
-func (m *OrderLineMutation) SetQuantity(i int) {
-	m.quantity = &i
-	m.addquantity = nil
+// SetCode sets the "code" field.
+func (m *CartonTypeMutation) SetCode(s string) {
+	m.code = &s
 }
 
-// Quantity returns the value of the "quantity" field in the mutation.
-func (m *OrderLineMutation) Quantity() (r int, exists bool) {
-	v := m.quantity
+// Code returns the value of the "code" field in the mutation.
+func (m *CartonTypeMutation) Code() (r string, exists bool) {
+	v := m.code
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQuantity returns the old "quantity" field's value of the OrderLine entity.
-// If the OrderLine object wasn't provided to the builder, the object is fetched from the database.
+// OldCode returns the old "code" field's value of the CartonType entity.
+// If the CartonType object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderLineMutation) OldQuantity(ctx context.Context) (v int, err error) {
+func (m *CartonTypeMutation) OldCode(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuantity requires an ID field in the mutation")
+		return v, errors.New("OldCode requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
 	}
-	return oldValue.Quantity, nil
+	return oldValue.Code, nil
 }
 
-// AddQuantity adds i to the "quantity" field.
-func (m *OrderLineMutation) AddQuantity(i int) {
-	if m.addquantity != nil {
-		*m.addquantity += i
-	} else {
-		m.addquantity = &i
-	}
+// ResetCode resets all changes to the "code" field.
+func (m *CartonTypeMutation) ResetCode() {
+	m.code = nil
 }
 
-// AddedQuantity returns the value that was added to the "quantity" field in this mutation.
-func (m *OrderLineMutation) AddedQuantity() (r int, exists bool) {
-	v := m.addquantity
+// SetLength sets the "length" field.
+func (m *CartonTypeMutation) SetLength(f float64) {
+	m.length = &f
+	m.addlength = nil
+}
+
+// Length returns the value of the "length" field in the mutation.
+func (m *CartonTypeMutation) Length() (r float64, exists bool) {
+	v := m.length
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetQuantity resets all changes to the "quantity" field.
-func (m *OrderLineMutation) ResetQuantity() {
-	m.quantity = nil
-	m.addquantity = nil
+// OldLength returns the old "length" field's value of the CartonType entity.
+// If the CartonType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartonTypeMutation) OldLength(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLength is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLength requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLength: %w", err)
+	}
+	return oldValue.Length, nil
 }
 
-// SetOrderID sets the "order" edge to the Order entity by id.
-func (m *OrderLineMutation) SetOrderID(id int) {
-	m._order = &id
+// AddLength adds f to the "length" field.
+func (m *CartonTypeMutation) AddLength(f float64) {
+	if m.addlength != nil {
+		*m.addlength += f
+	} else {
+		m.addlength = &f
+	}
 }
 
-// ClearOrder clears the "order" edge to the Order entity.
-func (m *OrderLineMutation) ClearOrder() {
-	m.cleared_order = true
+// AddedLength returns the value that was added to the "length" field in this mutation.
+func (m *CartonTypeMutation) AddedLength() (r float64, exists bool) {
+	v := m.addlength
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// OrderCleared reports if the "order" edge to the Order entity was cleared.
-func (m *OrderLineMutation) OrderCleared() bool {
-	return m.cleared_order
+// ResetLength resets all changes to the "length" field.
+func (m *CartonTypeMutation) ResetLength() {
+	m.length = nil
+	m.addlength = nil
 }
 
-// OrderID returns the "order" edge ID in the mutation.
-func (m *OrderLineMutation) OrderID() (id int, exists bool) {
-	if m._order != nil {
-		return *m._order, true
+// SetWidth sets the "width" field.
+func (m *CartonTypeMutation) SetWidth(f float64) {
+	m.width = &f
+	m.addwidth = nil
+}
+
+// Width returns the value of the "width" field in the mutation.
+func (m *CartonTypeMutation) Width() (r float64, exists bool) {
+	v := m.width
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// OrderIDs returns the "order" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OrderID instead. It exists only for internal usage by the builders.
-func (m *OrderLineMutation) OrderIDs() (ids []int) {
-	if id := m._order; id != nil {
-		ids = append(ids, *id)
+// OldWidth returns the old "width" field's value of the CartonType entity.
+// If the CartonType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartonTypeMutation) OldWidth(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWidth is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWidth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWidth: %w", err)
+	}
+	return oldValue.Width, nil
 }
 
-// ResetOrder resets all changes to the "order" edge.
-func (m *OrderLineMutation) ResetOrder() {
-	m._order = nil
-	m.cleared_order = false
+// AddWidth adds f to the "width" field.
+func (m *CartonTypeMutation) AddWidth(f float64) {
+	if m.addwidth != nil {
+		*m.addwidth += f
+	} else {
+		m.addwidth = &f
+	}
 }
 
-// SetItemID sets the "item" edge to the Item entity by id.
-func (m *OrderLineMutation) SetItemID(id int) {
-	m.item = &id
+// AddedWidth returns the value that was added to the "width" field in this mutation.
+func (m *CartonTypeMutation) AddedWidth() (r float64, exists bool) {
+	v := m.addwidth
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearItem clears the "item" edge to the Item entity.
-func (m *OrderLineMutation) ClearItem() {
-	m.cleareditem = true
+// ResetWidth resets all changes to the "width" field.
+func (m *CartonTypeMutation) ResetWidth() {
+	m.width = nil
+	m.addwidth = nil
 }
 
-// ItemCleared reports if the "item" edge to the Item entity was cleared.
-func (m *OrderLineMutation) ItemCleared() bool {
-	return m.cleareditem
+// SetHeight sets the "height" field.
+func (m *CartonTypeMutation) SetHeight(f float64) {
+	m.height = &f
+	m.addheight = nil
 }
 
-// ItemID returns the "item" edge ID in the mutation.
-func (m *OrderLineMutation) ItemID() (id int, exists bool) {
-	if m.item != nil {
-		return *m.item, true
+// Height returns the value of the "height" field in the mutation.
+func (m *CartonTypeMutation) Height() (r float64, exists bool) {
+	v := m.height
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ItemIDs returns the "item" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ItemID instead. It exists only for internal usage by the builders.
-func (m *OrderLineMutation) ItemIDs() (ids []int) {
-	if id := m.item; id != nil {
-		ids = append(ids, *id)
+// OldHeight returns the old "height" field's value of the CartonType entity.
+// If the CartonType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartonTypeMutation) OldHeight(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeight is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeight: %w", err)
+	}
+	return oldValue.Height, nil
 }
 
-// ResetItem resets all changes to the "item" edge.
-func (m *OrderLineMutation) ResetItem() {
-	m.item = nil
-	m.cleareditem = false
+// AddHeight adds f to the "height" field.
+func (m *CartonTypeMutation) AddHeight(f float64) {
+	if m.addheight != nil {
+		*m.addheight += f
+	} else {
+		m.addheight = &f
+	}
 }
 
-// SetLocationID sets the "location" edge to the Location entity by id.
-func (m *OrderLineMutation) SetLocationID(id int) {
-	m.location = &id
+// AddedHeight returns the value that was added to the "height" field in this mutation.
+func (m *CartonTypeMutation) AddedHeight() (r float64, exists bool) {
+	v := m.addheight
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearLocation clears the "location" edge to the Location entity.
-func (m *OrderLineMutation) ClearLocation() {
-	m.clearedlocation = true
+// ResetHeight resets all changes to the "height" field.
+func (m *CartonTypeMutation) ResetHeight() {
+	m.height = nil
+	m.addheight = nil
 }
 
-// LocationCleared reports if the "location" edge to the Location entity was cleared.
-func (m *OrderLineMutation) LocationCleared() bool {
-	return m.clearedlocation
+// SetMaxWeight sets the "max_weight" field.
+func (m *CartonTypeMutation) SetMaxWeight(f float64) {
+	m.max_weight = &f
+	m.addmax_weight = nil
 }
 
-// LocationID returns the "location" edge ID in the mutation.
-func (m *OrderLineMutation) LocationID() (id int, exists bool) {
-	if m.location != nil {
-		return *m.location, true
+// MaxWeight returns the value of the "max_weight" field in the mutation.
+func (m *CartonTypeMutation) MaxWeight() (r float64, exists bool) {
+	v := m.max_weight
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// LocationIDs returns the "location" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// LocationID instead. It exists only for internal usage by the builders.
-func (m *OrderLineMutation) LocationIDs() (ids []int) {
-	if id := m.location; id != nil {
-		ids = append(ids, *id)
+// OldMaxWeight returns the old "max_weight" field's value of the CartonType entity.
+// If the CartonType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartonTypeMutation) OldMaxWeight(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxWeight is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxWeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxWeight: %w", err)
+	}
+	return oldValue.MaxWeight, nil
 }
 
-// ResetLocation resets all changes to the "location" edge.
-func (m *OrderLineMutation) ResetLocation() {
-	m.location = nil
-	m.clearedlocation = false
+// AddMaxWeight adds f to the "max_weight" field.
+func (m *CartonTypeMutation) AddMaxWeight(f float64) {
+	if m.addmax_weight != nil {
+		*m.addmax_weight += f
+	} else {
+		m.addmax_weight = &f
+	}
 }
 
-// AddPickTaskIDs adds the "pick_tasks" edge to the PickTask entity by ids.
-func (m *OrderLineMutation) AddPickTaskIDs(ids ...int) {
-	if m.pick_tasks == nil {
-		m.pick_tasks = make(map[int]struct{})
+// AddedMaxWeight returns the value that was added to the "max_weight" field in this mutation.
+func (m *CartonTypeMutation) AddedMaxWeight() (r float64, exists bool) {
+	v := m.addmax_weight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxWeight resets all changes to the "max_weight" field.
+func (m *CartonTypeMutation) ResetMaxWeight() {
+	m.max_weight = nil
+	m.addmax_weight = nil
+}
+
+// SetTareWeight sets the "tare_weight" field.
+func (m *CartonTypeMutation) SetTareWeight(f float64) {
+	m.tare_weight = &f
+	m.addtare_weight = nil
+}
+
+// TareWeight returns the value of the "tare_weight" field in the mutation.
+func (m *CartonTypeMutation) TareWeight() (r float64, exists bool) {
+	v := m.tare_weight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTareWeight returns the old "tare_weight" field's value of the CartonType entity.
+// If the CartonType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartonTypeMutation) OldTareWeight(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTareWeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTareWeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTareWeight: %w", err)
+	}
+	return oldValue.TareWeight, nil
+}
+
+// AddTareWeight adds f to the "tare_weight" field.
+func (m *CartonTypeMutation) AddTareWeight(f float64) {
+	if m.addtare_weight != nil {
+		*m.addtare_weight += f
+	} else {
+		m.addtare_weight = &f
+	}
+}
+
+// AddedTareWeight returns the value that was added to the "tare_weight" field in this mutation.
+func (m *CartonTypeMutation) AddedTareWeight() (r float64, exists bool) {
+	v := m.addtare_weight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTareWeight resets all changes to the "tare_weight" field.
+func (m *CartonTypeMutation) ResetTareWeight() {
+	m.tare_weight = nil
+	m.addtare_weight = nil
+}
+
+// AddCartonIDs adds the "cartons" edge to the Carton entity by ids.
+func (m *CartonTypeMutation) AddCartonIDs(ids ...int) {
+	if m.cartons == nil {
+		m.cartons = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.pick_tasks[ids[i]] = struct{}{}
+		m.cartons[ids[i]] = struct{}{}
 	}
 }
 
-// ClearPickTasks clears the "pick_tasks" edge to the PickTask entity.
-func (m *OrderLineMutation) ClearPickTasks() {
-	m.clearedpick_tasks = true
+// ClearCartons clears the "cartons" edge to the Carton entity.
+func (m *CartonTypeMutation) ClearCartons() {
+	m.clearedcartons = true
 }
 
-// PickTasksCleared reports if the "pick_tasks" edge to the PickTask entity was cleared.
-func (m *OrderLineMutation) PickTasksCleared() bool {
-	return m.clearedpick_tasks
+// CartonsCleared reports if the "cartons" edge to the Carton entity was cleared.
+func (m *CartonTypeMutation) CartonsCleared() bool {
+	return m.clearedcartons
 }
 
-// RemovePickTaskIDs removes the "pick_tasks" edge to the PickTask entity by IDs.
-func (m *OrderLineMutation) RemovePickTaskIDs(ids ...int) {
-	if m.removedpick_tasks == nil {
-		m.removedpick_tasks = make(map[int]struct{})
+// RemoveCartonIDs removes the "cartons" edge to the Carton entity by IDs.
+func (m *CartonTypeMutation) RemoveCartonIDs(ids ...int) {
+	if m.removedcartons == nil {
+		m.removedcartons = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.pick_tasks, ids[i])
-		m.removedpick_tasks[ids[i]] = struct{}{}
+		delete(m.cartons, ids[i])
+		m.removedcartons[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedPickTasks returns the removed IDs of the "pick_tasks" edge to the PickTask entity.
-func (m *OrderLineMutation) RemovedPickTasksIDs() (ids []int) {
-	for id := range m.removedpick_tasks {
+// RemovedCartons returns the removed IDs of the "cartons" edge to the Carton entity.
+func (m *CartonTypeMutation) RemovedCartonsIDs() (ids []int) {
+	for id := range m.removedcartons {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// PickTasksIDs returns the "pick_tasks" edge IDs in the mutation.
-func (m *OrderLineMutation) PickTasksIDs() (ids []int) {
-	for id := range m.pick_tasks {
+// CartonsIDs returns the "cartons" edge IDs in the mutation.
+func (m *CartonTypeMutation) CartonsIDs() (ids []int) {
+	for id := range m.cartons {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetPickTasks resets all changes to the "pick_tasks" edge.
-func (m *OrderLineMutation) ResetPickTasks() {
-	m.pick_tasks = nil
-	m.clearedpick_tasks = false
-	m.removedpick_tasks = nil
+// ResetCartons resets all changes to the "cartons" edge.
+func (m *CartonTypeMutation) ResetCartons() {
+	m.cartons = nil
+	m.clearedcartons = false
+	m.removedcartons = nil
 }
 
-// Where appends a list predicates to the OrderLineMutation builder.
-func (m *OrderLineMutation) Where(ps ...predicate.OrderLine) {
+// Where appends a list predicates to the CartonTypeMutation builder.
+func (m *CartonTypeMutation) Where(ps ...predicate.CartonType) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the OrderLineMutation builder. Using this method,
+// WhereP appends storage-level predicates to the CartonTypeMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *OrderLineMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.OrderLine, len(ps))
+func (m *CartonTypeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CartonType, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3881,27 +4367,42 @@ func (m *OrderLineMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *OrderLineMutation) Op() Op {
+func (m *CartonTypeMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *OrderLineMutation) SetOp(op Op) {
+func (m *CartonTypeMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (OrderLine).
-func (m *OrderLineMutation) Type() string {
+// Type returns the node type of this mutation (CartonType).
+func (m *CartonTypeMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *OrderLineMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.quantity != nil {
-		fields = append(fields, orderline.FieldQuantity)
+func (m *CartonTypeMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.code != nil {
+		fields = append(fields, cartontype.FieldCode)
+	}
+	if m.length != nil {
+		fields = append(fields, cartontype.FieldLength)
+	}
+	if m.width != nil {
+		fields = append(fields, cartontype.FieldWidth)
+	}
+	if m.height != nil {
+		fields = append(fields, cartontype.FieldHeight)
+	}
+	if m.max_weight != nil {
+		fields = append(fields, cartontype.FieldMaxWeight)
+	}
+	if m.tare_weight != nil {
+		fields = append(fields, cartontype.FieldTareWeight)
 	}
 	return fields
 }
@@ -3909,10 +4410,20 @@ func (m *OrderLineMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *OrderLineMutation) Field(name string) (ent.Value, bool) {
+func (m *CartonTypeMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case orderline.FieldQuantity:
-		return m.Quantity()
+	case cartontype.FieldCode:
+		return m.Code()
+	case cartontype.FieldLength:
+		return m.Length()
+	case cartontype.FieldWidth:
+		return m.Width()
+	case cartontype.FieldHeight:
+		return m.Height()
+	case cartontype.FieldMaxWeight:
+		return m.MaxWeight()
+	case cartontype.FieldTareWeight:
+		return m.TareWeight()
 	}
 	return nil, false
 }
@@ -3920,36 +4431,93 @@ func (m *OrderLineMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *OrderLineMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *CartonTypeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case orderline.FieldQuantity:
-		return m.OldQuantity(ctx)
+	case cartontype.FieldCode:
+		return m.OldCode(ctx)
+	case cartontype.FieldLength:
+		return m.OldLength(ctx)
+	case cartontype.FieldWidth:
+		return m.OldWidth(ctx)
+	case cartontype.FieldHeight:
+		return m.OldHeight(ctx)
+	case cartontype.FieldMaxWeight:
+		return m.OldMaxWeight(ctx)
+	case cartontype.FieldTareWeight:
+		return m.OldTareWeight(ctx)
 	}
-	return nil, fmt.Errorf("unknown OrderLine field %s", name)
+	return nil, fmt.Errorf("unknown CartonType field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OrderLineMutation) SetField(name string, value ent.Value) error {
+func (m *CartonTypeMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case orderline.FieldQuantity:
-		v, ok := value.(int)
+	case cartontype.FieldCode:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetQuantity(v)
+		m.SetCode(v)
+		return nil
+	case cartontype.FieldLength:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLength(v)
+		return nil
+	case cartontype.FieldWidth:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWidth(v)
+		return nil
+	case cartontype.FieldHeight:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeight(v)
+		return nil
+	case cartontype.FieldMaxWeight:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxWeight(v)
+		return nil
+	case cartontype.FieldTareWeight:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTareWeight(v)
 		return nil
 	}
-	return fmt.Errorf("unknown OrderLine field %s", name)
+	return fmt.Errorf("unknown CartonType field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *OrderLineMutation) AddedFields() []string {
+func (m *CartonTypeMutation) AddedFields() []string {
 	var fields []string
-	if m.addquantity != nil {
-		fields = append(fields, orderline.FieldQuantity)
+	if m.addlength != nil {
+		fields = append(fields, cartontype.FieldLength)
+	}
+	if m.addwidth != nil {
+		fields = append(fields, cartontype.FieldWidth)
+	}
+	if m.addheight != nil {
+		fields = append(fields, cartontype.FieldHeight)
+	}
+	if m.addmax_weight != nil {
+		fields = append(fields, cartontype.FieldMaxWeight)
+	}
+	if m.addtare_weight != nil {
+		fields = append(fields, cartontype.FieldTareWeight)
 	}
 	return fields
 }
@@ -3957,10 +4525,18 @@ func (m *OrderLineMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *OrderLineMutation) AddedField(name string) (ent.Value, bool) {
+func (m *CartonTypeMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case orderline.FieldQuantity:
-		return m.AddedQuantity()
+	case cartontype.FieldLength:
+		return m.AddedLength()
+	case cartontype.FieldWidth:
+		return m.AddedWidth()
+	case cartontype.FieldHeight:
+		return m.AddedHeight()
+	case cartontype.FieldMaxWeight:
+		return m.AddedMaxWeight()
+	case cartontype.FieldTareWeight:
+		return m.AddedTareWeight()
 	}
 	return nil, false
 }
@@ -3968,86 +4544,108 @@ func (m *OrderLineMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OrderLineMutation) AddField(name string, value ent.Value) error {
+func (m *CartonTypeMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case orderline.FieldQuantity:
-		v, ok := value.(int)
+	case cartontype.FieldLength:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddQuantity(v)
+		m.AddLength(v)
+		return nil
+	case cartontype.FieldWidth:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWidth(v)
+		return nil
+	case cartontype.FieldHeight:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeight(v)
+		return nil
+	case cartontype.FieldMaxWeight:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxWeight(v)
+		return nil
+	case cartontype.FieldTareWeight:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTareWeight(v)
 		return nil
 	}
-	return fmt.Errorf("unknown OrderLine numeric field %s", name)
+	return fmt.Errorf("unknown CartonType numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *OrderLineMutation) ClearedFields() []string {
+func (m *CartonTypeMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *OrderLineMutation) FieldCleared(name string) bool {
+func (m *CartonTypeMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *OrderLineMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown OrderLine nullable field %s", name)
+func (m *CartonTypeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown CartonType nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *OrderLineMutation) ResetField(name string) error {
+func (m *CartonTypeMutation) ResetField(name string) error {
 	switch name {
-	case orderline.FieldQuantity:
-		m.ResetQuantity()
+	case cartontype.FieldCode:
+		m.ResetCode()
+		return nil
+	case cartontype.FieldLength:
+		m.ResetLength()
+		return nil
+	case cartontype.FieldWidth:
+		m.ResetWidth()
+		return nil
+	case cartontype.FieldHeight:
+		m.ResetHeight()
+		return nil
+	case cartontype.FieldMaxWeight:
+		m.ResetMaxWeight()
+		return nil
+	case cartontype.FieldTareWeight:
+		m.ResetTareWeight()
 		return nil
 	}
-	return fmt.Errorf("unknown OrderLine field %s", name)
+	return fmt.Errorf("unknown CartonType field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *OrderLineMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m._order != nil {
-		edges = append(edges, orderline.EdgeOrder)
-	}
-	if m.item != nil {
-		edges = append(edges, orderline.EdgeItem)
-	}
-	if m.location != nil {
-		edges = append(edges, orderline.EdgeLocation)
-	}
-	if m.pick_tasks != nil {
-		edges = append(edges, orderline.EdgePickTasks)
+func (m *CartonTypeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cartons != nil {
+		edges = append(edges, cartontype.EdgeCartons)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *OrderLineMutation) AddedIDs(name string) []ent.Value {
+func (m *CartonTypeMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case orderline.EdgeOrder:
-		if id := m._order; id != nil {
-			return []ent.Value{*id}
-		}
-	case orderline.EdgeItem:
-		if id := m.item; id != nil {
-			return []ent.Value{*id}
-		}
-	case orderline.EdgeLocation:
-		if id := m.location; id != nil {
-			return []ent.Value{*id}
-		}
-	case orderline.EdgePickTasks:
-		ids := make([]ent.Value, 0, len(m.pick_tasks))
-		for id := range m.pick_tasks {
+	case cartontype.EdgeCartons:
+		ids := make([]ent.Value, 0, len(m.cartons))
+		for id := range m.cartons {
 			ids = append(ids, id)
 		}
 		return ids
@@ -4056,21 +4654,21 @@ func (m *OrderLineMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *OrderLineMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedpick_tasks != nil {
-		edges = append(edges, orderline.EdgePickTasks)
+func (m *CartonTypeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedcartons != nil {
+		edges = append(edges, cartontype.EdgeCartons)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *OrderLineMutation) RemovedIDs(name string) []ent.Value {
+func (m *CartonTypeMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case orderline.EdgePickTasks:
-		ids := make([]ent.Value, 0, len(m.removedpick_tasks))
-		for id := range m.removedpick_tasks {
+	case cartontype.EdgeCartons:
+		ids := make([]ent.Value, 0, len(m.removedcartons))
+		for id := range m.removedcartons {
 			ids = append(ids, id)
 		}
 		return ids
@@ -4079,108 +4677,76 @@ func (m *OrderLineMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *OrderLineMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.cleared_order {
-		edges = append(edges, orderline.EdgeOrder)
-	}
-	if m.cleareditem {
-		edges = append(edges, orderline.EdgeItem)
-	}
-	if m.clearedlocation {
-		edges = append(edges, orderline.EdgeLocation)
-	}
-	if m.clearedpick_tasks {
-		edges = append(edges, orderline.EdgePickTasks)
+func (m *CartonTypeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcartons {
+		edges = append(edges, cartontype.EdgeCartons)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *OrderLineMutation) EdgeCleared(name string) bool {
+func (m *CartonTypeMutation) EdgeCleared(name string) bool {
 	switch name {
-	case orderline.EdgeOrder:
-		return m.cleared_order
-	case orderline.EdgeItem:
-		return m.cleareditem
-	case orderline.EdgeLocation:
-		return m.clearedlocation
-	case orderline.EdgePickTasks:
-		return m.clearedpick_tasks
+	case cartontype.EdgeCartons:
+		return m.clearedcartons
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *OrderLineMutation) ClearEdge(name string) error {
+func (m *CartonTypeMutation) ClearEdge(name string) error {
 	switch name {
-	case orderline.EdgeOrder:
-		m.ClearOrder()
-		return nil
-	case orderline.EdgeItem:
-		m.ClearItem()
-		return nil
-	case orderline.EdgeLocation:
-		m.ClearLocation()
-		return nil
 	}
-	return fmt.Errorf("unknown OrderLine unique edge %s", name)
+	return fmt.Errorf("unknown CartonType unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *OrderLineMutation) ResetEdge(name string) error {
+func (m *CartonTypeMutation) ResetEdge(name string) error {
 	switch name {
-	case orderline.EdgeOrder:
-		m.ResetOrder()
-		return nil
-	case orderline.EdgeItem:
-		m.ResetItem()
-		return nil
-	case orderline.EdgeLocation:
-		m.ResetLocation()
-		return nil
-	case orderline.EdgePickTasks:
-		m.ResetPickTasks()
+	case cartontype.EdgeCartons:
+		m.ResetCartons()
 		return nil
 	}
-	return fmt.Errorf("unknown OrderLine edge %s", name)
+	return fmt.Errorf("unknown CartonType edge %s", name)
 }
 
-// PickListMutation represents an operation that mutates the PickList nodes in the graph.
-type PickListMutation struct {
+// EventRuleMutation represents an operation that mutates the EventRule nodes in the graph.
+type EventRuleMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	status        *string
+	name          *string
+	event         *string
+	conditions    *string
+	action        *string
+	argument      *string
+	enabled       *bool
+	fired         *int
+	addfired      *int
+	last_fired_at *time.Time
 	created_at    *time.Time
-	started_at    *time.Time
-	done_at       *time.Time
 	clearedFields map[string]struct{}
-	_order        *int
-	cleared_order bool
-	tasks         map[int]struct{}
-	removedtasks  map[int]struct{}
-	clearedtasks  bool
 	done          bool
-	oldValue      func(context.Context) (*PickList, error)
-	predicates    []predicate.PickList
+	oldValue      func(context.Context) (*EventRule, error)
+	predicates    []predicate.EventRule
 }
 
-var _ ent.Mutation = (*PickListMutation)(nil)
+var _ ent.Mutation = (*EventRuleMutation)(nil)
 
-// picklistOption allows management of the mutation configuration using functional options.
-type picklistOption func(*PickListMutation)
+// eventruleOption allows management of the mutation configuration using functional options.
+type eventruleOption func(*EventRuleMutation)
 
-// newPickListMutation creates new mutation for the PickList entity.
-func newPickListMutation(c config, op Op, opts ...picklistOption) *PickListMutation {
-	m := &PickListMutation{
+// newEventRuleMutation creates new mutation for the EventRule entity.
+func newEventRuleMutation(c config, op Op, opts ...eventruleOption) *EventRuleMutation {
+	m := &EventRuleMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePickList,
+		typ:           TypeEventRule,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4189,20 +4755,20 @@ func newPickListMutation(c config, op Op, opts ...picklistOption) *PickListMutat
 	return m
 }
 
-// withPickListID sets the ID field of the mutation.
-func withPickListID(id int) picklistOption {
-	return func(m *PickListMutation) {
+// withEventRuleID sets the ID field of the mutation.
+func withEventRuleID(id int) eventruleOption {
+	return func(m *EventRuleMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *PickList
+			value *EventRule
 		)
-		m.oldValue = func(ctx context.Context) (*PickList, error) {
+		m.oldValue = func(ctx context.Context) (*EventRule, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().PickList.Get(ctx, id)
+					value, err = m.Client().EventRule.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4211,10 +4777,10 @@ func withPickListID(id int) picklistOption {
 	}
 }
 
-// withPickList sets the old PickList of the mutation.
-func withPickList(node *PickList) picklistOption {
-	return func(m *PickListMutation) {
-		m.oldValue = func(context.Context) (*PickList, error) {
+// withEventRule sets the old EventRule of the mutation.
+func withEventRule(node *EventRule) eventruleOption {
+	return func(m *EventRuleMutation) {
+		m.oldValue = func(context.Context) (*EventRule, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4223,7 +4789,7 @@ func withPickList(node *PickList) picklistOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PickListMutation) Client() *Client {
+func (m EventRuleMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4231,7 +4797,7 @@ func (m PickListMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PickListMutation) Tx() (*Tx, error) {
+func (m EventRuleMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4242,7 +4808,7 @@ func (m PickListMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PickListMutation) ID() (id int, exists bool) {
+func (m *EventRuleMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4253,7 +4819,7 @@ func (m *PickListMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PickListMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *EventRuleMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4262,284 +4828,404 @@ func (m *PickListMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PickList.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().EventRule.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetStatus sets the "status" field.
-func (m *PickListMutation) SetStatus(s string) {
-	m.status = &s
+// SetName sets the "name" field.
+func (m *EventRuleMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *PickListMutation) Status() (r string, exists bool) {
-	v := m.status
+// Name returns the value of the "name" field in the mutation.
+func (m *EventRuleMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the PickList entity.
-// If the PickList object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the EventRule entity.
+// If the EventRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PickListMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *EventRuleMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Name, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *PickListMutation) ResetStatus() {
-	m.status = nil
+// ResetName resets all changes to the "name" field.
+func (m *EventRuleMutation) ResetName() {
+	m.name = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *PickListMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetEvent sets the "event" field.
+func (m *EventRuleMutation) SetEvent(s string) {
+	m.event = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PickListMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Event returns the value of the "event" field in the mutation.
+func (m *EventRuleMutation) Event() (r string, exists bool) {
+	v := m.event
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the PickList entity.
-// If the PickList object wasn't provided to the builder, the object is fetched from the database.
+// OldEvent returns the old "event" field's value of the EventRule entity.
+// If the EventRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PickListMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *EventRuleMutation) OldEvent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldEvent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldEvent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldEvent: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Event, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PickListMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetEvent resets all changes to the "event" field.
+func (m *EventRuleMutation) ResetEvent() {
+	m.event = nil
 }
 
-// SetStartedAt sets the "started_at" field.
-func (m *PickListMutation) SetStartedAt(t time.Time) {
-	m.started_at = &t
+// SetConditions sets the "conditions" field.
+func (m *EventRuleMutation) SetConditions(s string) {
+	m.conditions = &s
 }
 
-// StartedAt returns the value of the "started_at" field in the mutation.
-func (m *PickListMutation) StartedAt() (r time.Time, exists bool) {
-	v := m.started_at
+// Conditions returns the value of the "conditions" field in the mutation.
+func (m *EventRuleMutation) Conditions() (r string, exists bool) {
+	v := m.conditions
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStartedAt returns the old "started_at" field's value of the PickList entity.
-// If the PickList object wasn't provided to the builder, the object is fetched from the database.
+// OldConditions returns the old "conditions" field's value of the EventRule entity.
+// If the EventRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PickListMutation) OldStartedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *EventRuleMutation) OldConditions(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldConditions is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+		return v, errors.New("OldConditions requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldConditions: %w", err)
 	}
-	return oldValue.StartedAt, nil
+	return oldValue.Conditions, nil
 }
 
-// ClearStartedAt clears the value of the "started_at" field.
-func (m *PickListMutation) ClearStartedAt() {
-	m.started_at = nil
-	m.clearedFields[picklist.FieldStartedAt] = struct{}{}
+// ClearConditions clears the value of the "conditions" field.
+func (m *EventRuleMutation) ClearConditions() {
+	m.conditions = nil
+	m.clearedFields[eventrule.FieldConditions] = struct{}{}
 }
 
-// StartedAtCleared returns if the "started_at" field was cleared in this mutation.
-func (m *PickListMutation) StartedAtCleared() bool {
-	_, ok := m.clearedFields[picklist.FieldStartedAt]
+// ConditionsCleared returns if the "conditions" field was cleared in this mutation.
+func (m *EventRuleMutation) ConditionsCleared() bool {
+	_, ok := m.clearedFields[eventrule.FieldConditions]
 	return ok
 }
 
-// ResetStartedAt resets all changes to the "started_at" field.
-func (m *PickListMutation) ResetStartedAt() {
-	m.started_at = nil
-	delete(m.clearedFields, picklist.FieldStartedAt)
+// ResetConditions resets all changes to the "conditions" field.
+func (m *EventRuleMutation) ResetConditions() {
+	m.conditions = nil
+	delete(m.clearedFields, eventrule.FieldConditions)
 }
 
-// SetDoneAt sets the "done_at" field.
-func (m *PickListMutation) SetDoneAt(t time.Time) {
-	m.done_at = &t
+// SetAction sets the "action" field.
+func (m *EventRuleMutation) SetAction(s string) {
+	m.action = &s
 }
 
-// DoneAt returns the value of the "done_at" field in the mutation.
-func (m *PickListMutation) DoneAt() (r time.Time, exists bool) {
-	v := m.done_at
+// Action returns the value of the "action" field in the mutation.
+func (m *EventRuleMutation) Action() (r string, exists bool) {
+	v := m.action
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDoneAt returns the old "done_at" field's value of the PickList entity.
-// If the PickList object wasn't provided to the builder, the object is fetched from the database.
+// OldAction returns the old "action" field's value of the EventRule entity.
+// If the EventRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PickListMutation) OldDoneAt(ctx context.Context) (v *time.Time, err error) {
+func (m *EventRuleMutation) OldAction(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDoneAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDoneAt requires an ID field in the mutation")
+		return v, errors.New("OldAction requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDoneAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
 	}
-	return oldValue.DoneAt, nil
+	return oldValue.Action, nil
 }
 
-// ClearDoneAt clears the value of the "done_at" field.
-func (m *PickListMutation) ClearDoneAt() {
-	m.done_at = nil
-	m.clearedFields[picklist.FieldDoneAt] = struct{}{}
+// ResetAction resets all changes to the "action" field.
+func (m *EventRuleMutation) ResetAction() {
+	m.action = nil
 }
 
-// DoneAtCleared returns if the "done_at" field was cleared in this mutation.
-func (m *PickListMutation) DoneAtCleared() bool {
-	_, ok := m.clearedFields[picklist.FieldDoneAt]
-	return ok
+// SetArgument sets the "argument" field.
+func (m *EventRuleMutation) SetArgument(s string) {
+	m.argument = &s
 }
 
-// ResetDoneAt resets all changes to the "done_at" field.
-func (m *PickListMutation) ResetDoneAt() {
-	m.done_at = nil
-	delete(m.clearedFields, picklist.FieldDoneAt)
+// Argument returns the value of the "argument" field in the mutation.
+func (m *EventRuleMutation) Argument() (r string, exists bool) {
+	v := m.argument
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetOrderID sets the "order" edge to the Order entity by id.
-func (m *PickListMutation) SetOrderID(id int) {
-	m._order = &id
+// OldArgument returns the old "argument" field's value of the EventRule entity.
+// If the EventRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventRuleMutation) OldArgument(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArgument is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArgument requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArgument: %w", err)
+	}
+	return oldValue.Argument, nil
 }
 
-// ClearOrder clears the "order" edge to the Order entity.
-func (m *PickListMutation) ClearOrder() {
-	m.cleared_order = true
+// ClearArgument clears the value of the "argument" field.
+func (m *EventRuleMutation) ClearArgument() {
+	m.argument = nil
+	m.clearedFields[eventrule.FieldArgument] = struct{}{}
 }
 
-// OrderCleared reports if the "order" edge to the Order entity was cleared.
-func (m *PickListMutation) OrderCleared() bool {
-	return m.cleared_order
+// ArgumentCleared returns if the "argument" field was cleared in this mutation.
+func (m *EventRuleMutation) ArgumentCleared() bool {
+	_, ok := m.clearedFields[eventrule.FieldArgument]
+	return ok
 }
 
-// OrderID returns the "order" edge ID in the mutation.
-func (m *PickListMutation) OrderID() (id int, exists bool) {
-	if m._order != nil {
-		return *m._order, true
-	}
-	return
+// ResetArgument resets all changes to the "argument" field.
+func (m *EventRuleMutation) ResetArgument() {
+	m.argument = nil
+	delete(m.clearedFields, eventrule.FieldArgument)
 }
 
-// OrderIDs returns the "order" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OrderID instead. It exists only for internal usage by the builders.
-func (m *PickListMutation) OrderIDs() (ids []int) {
-	if id := m._order; id != nil {
-		ids = append(ids, *id)
+// SetEnabled sets the "enabled" field.
+func (m *EventRuleMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *EventRuleMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetOrder resets all changes to the "order" edge.
-func (m *PickListMutation) ResetOrder() {
-	m._order = nil
-	m.cleared_order = false
+// OldEnabled returns the old "enabled" field's value of the EventRule entity.
+// If the EventRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventRuleMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
 }
 
-// AddTaskIDs adds the "tasks" edge to the PickTask entity by ids.
-func (m *PickListMutation) AddTaskIDs(ids ...int) {
-	if m.tasks == nil {
-		m.tasks = make(map[int]struct{})
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *EventRuleMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetFired sets the "fired" field.
+func (m *EventRuleMutation) SetFired(i int) {
+	m.fired = &i
+	m.addfired = nil
+}
+
+// Fired returns the value of the "fired" field in the mutation.
+func (m *EventRuleMutation) Fired() (r int, exists bool) {
+	v := m.fired
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.tasks[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldFired returns the old "fired" field's value of the EventRule entity.
+// If the EventRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventRuleMutation) OldFired(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFired is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFired requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFired: %w", err)
 	}
+	return oldValue.Fired, nil
 }
 
-// ClearTasks clears the "tasks" edge to the PickTask entity.
-func (m *PickListMutation) ClearTasks() {
-	m.clearedtasks = true
+// AddFired adds i to the "fired" field.
+func (m *EventRuleMutation) AddFired(i int) {
+	if m.addfired != nil {
+		*m.addfired += i
+	} else {
+		m.addfired = &i
+	}
 }
 
-// TasksCleared reports if the "tasks" edge to the PickTask entity was cleared.
-func (m *PickListMutation) TasksCleared() bool {
-	return m.clearedtasks
+// AddedFired returns the value that was added to the "fired" field in this mutation.
+func (m *EventRuleMutation) AddedFired() (r int, exists bool) {
+	v := m.addfired
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveTaskIDs removes the "tasks" edge to the PickTask entity by IDs.
-func (m *PickListMutation) RemoveTaskIDs(ids ...int) {
-	if m.removedtasks == nil {
-		m.removedtasks = make(map[int]struct{})
+// ResetFired resets all changes to the "fired" field.
+func (m *EventRuleMutation) ResetFired() {
+	m.fired = nil
+	m.addfired = nil
+}
+
+// SetLastFiredAt sets the "last_fired_at" field.
+func (m *EventRuleMutation) SetLastFiredAt(t time.Time) {
+	m.last_fired_at = &t
+}
+
+// LastFiredAt returns the value of the "last_fired_at" field in the mutation.
+func (m *EventRuleMutation) LastFiredAt() (r time.Time, exists bool) {
+	v := m.last_fired_at
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.tasks, ids[i])
-		m.removedtasks[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldLastFiredAt returns the old "last_fired_at" field's value of the EventRule entity.
+// If the EventRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventRuleMutation) OldLastFiredAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastFiredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastFiredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastFiredAt: %w", err)
 	}
+	return oldValue.LastFiredAt, nil
 }
 
-// RemovedTasks returns the removed IDs of the "tasks" edge to the PickTask entity.
-func (m *PickListMutation) RemovedTasksIDs() (ids []int) {
-	for id := range m.removedtasks {
-		ids = append(ids, id)
+// ClearLastFiredAt clears the value of the "last_fired_at" field.
+func (m *EventRuleMutation) ClearLastFiredAt() {
+	m.last_fired_at = nil
+	m.clearedFields[eventrule.FieldLastFiredAt] = struct{}{}
+}
+
+// LastFiredAtCleared returns if the "last_fired_at" field was cleared in this mutation.
+func (m *EventRuleMutation) LastFiredAtCleared() bool {
+	_, ok := m.clearedFields[eventrule.FieldLastFiredAt]
+	return ok
+}
+
+// ResetLastFiredAt resets all changes to the "last_fired_at" field.
+func (m *EventRuleMutation) ResetLastFiredAt() {
+	m.last_fired_at = nil
+	delete(m.clearedFields, eventrule.FieldLastFiredAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EventRuleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EventRuleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// TasksIDs returns the "tasks" edge IDs in the mutation.
-func (m *PickListMutation) TasksIDs() (ids []int) {
-	for id := range m.tasks {
-		ids = append(ids, id)
+// OldCreatedAt returns the old "created_at" field's value of the EventRule entity.
+// If the EventRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventRuleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetTasks resets all changes to the "tasks" edge.
-func (m *PickListMutation) ResetTasks() {
-	m.tasks = nil
-	m.clearedtasks = false
-	m.removedtasks = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EventRuleMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the PickListMutation builder.
-func (m *PickListMutation) Where(ps ...predicate.PickList) {
+// Where appends a list predicates to the EventRuleMutation builder.
+func (m *EventRuleMutation) Where(ps ...predicate.EventRule) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PickListMutation builder. Using this method,
+// WhereP appends storage-level predicates to the EventRuleMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PickListMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PickList, len(ps))
+func (m *EventRuleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EventRule, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4547,36 +5233,51 @@ func (m *PickListMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PickListMutation) Op() Op {
+func (m *EventRuleMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PickListMutation) SetOp(op Op) {
+func (m *EventRuleMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (PickList).
-func (m *PickListMutation) Type() string {
+// Type returns the node type of this mutation (EventRule).
+func (m *EventRuleMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PickListMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.status != nil {
-		fields = append(fields, picklist.FieldStatus)
+func (m *EventRuleMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.name != nil {
+		fields = append(fields, eventrule.FieldName)
 	}
-	if m.created_at != nil {
-		fields = append(fields, picklist.FieldCreatedAt)
+	if m.event != nil {
+		fields = append(fields, eventrule.FieldEvent)
 	}
-	if m.started_at != nil {
-		fields = append(fields, picklist.FieldStartedAt)
+	if m.conditions != nil {
+		fields = append(fields, eventrule.FieldConditions)
 	}
-	if m.done_at != nil {
-		fields = append(fields, picklist.FieldDoneAt)
+	if m.action != nil {
+		fields = append(fields, eventrule.FieldAction)
+	}
+	if m.argument != nil {
+		fields = append(fields, eventrule.FieldArgument)
+	}
+	if m.enabled != nil {
+		fields = append(fields, eventrule.FieldEnabled)
+	}
+	if m.fired != nil {
+		fields = append(fields, eventrule.FieldFired)
+	}
+	if m.last_fired_at != nil {
+		fields = append(fields, eventrule.FieldLastFiredAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, eventrule.FieldCreatedAt)
 	}
 	return fields
 }
@@ -4584,16 +5285,26 @@ func (m *PickListMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PickListMutation) Field(name string) (ent.Value, bool) {
+func (m *EventRuleMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case picklist.FieldStatus:
-		return m.Status()
-	case picklist.FieldCreatedAt:
+	case eventrule.FieldName:
+		return m.Name()
+	case eventrule.FieldEvent:
+		return m.Event()
+	case eventrule.FieldConditions:
+		return m.Conditions()
+	case eventrule.FieldAction:
+		return m.Action()
+	case eventrule.FieldArgument:
+		return m.Argument()
+	case eventrule.FieldEnabled:
+		return m.Enabled()
+	case eventrule.FieldFired:
+		return m.Fired()
+	case eventrule.FieldLastFiredAt:
+		return m.LastFiredAt()
+	case eventrule.FieldCreatedAt:
 		return m.CreatedAt()
-	case picklist.FieldStartedAt:
-		return m.StartedAt()
-	case picklist.FieldDoneAt:
-		return m.DoneAt()
 	}
 	return nil, false
 }
@@ -4601,268 +5312,307 @@ func (m *PickListMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PickListMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *EventRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case picklist.FieldStatus:
-		return m.OldStatus(ctx)
-	case picklist.FieldCreatedAt:
+	case eventrule.FieldName:
+		return m.OldName(ctx)
+	case eventrule.FieldEvent:
+		return m.OldEvent(ctx)
+	case eventrule.FieldConditions:
+		return m.OldConditions(ctx)
+	case eventrule.FieldAction:
+		return m.OldAction(ctx)
+	case eventrule.FieldArgument:
+		return m.OldArgument(ctx)
+	case eventrule.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case eventrule.FieldFired:
+		return m.OldFired(ctx)
+	case eventrule.FieldLastFiredAt:
+		return m.OldLastFiredAt(ctx)
+	case eventrule.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case picklist.FieldStartedAt:
-		return m.OldStartedAt(ctx)
-	case picklist.FieldDoneAt:
-		return m.OldDoneAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown PickList field %s", name)
+	return nil, fmt.Errorf("unknown EventRule field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PickListMutation) SetField(name string, value ent.Value) error {
+func (m *EventRuleMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case picklist.FieldStatus:
+	case eventrule.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetName(v)
 		return nil
-	case picklist.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case eventrule.FieldEvent:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetEvent(v)
 		return nil
-	case picklist.FieldStartedAt:
+	case eventrule.FieldConditions:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConditions(v)
+		return nil
+	case eventrule.FieldAction:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case eventrule.FieldArgument:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArgument(v)
+		return nil
+	case eventrule.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case eventrule.FieldFired:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFired(v)
+		return nil
+	case eventrule.FieldLastFiredAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStartedAt(v)
+		m.SetLastFiredAt(v)
 		return nil
-	case picklist.FieldDoneAt:
+	case eventrule.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDoneAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PickList field %s", name)
+	return fmt.Errorf("unknown EventRule field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PickListMutation) AddedFields() []string {
-	return nil
+func (m *EventRuleMutation) AddedFields() []string {
+	var fields []string
+	if m.addfired != nil {
+		fields = append(fields, eventrule.FieldFired)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PickListMutation) AddedField(name string) (ent.Value, bool) {
+func (m *EventRuleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case eventrule.FieldFired:
+		return m.AddedFired()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PickListMutation) AddField(name string, value ent.Value) error {
+func (m *EventRuleMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case eventrule.FieldFired:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFired(v)
+		return nil
 	}
-	return fmt.Errorf("unknown PickList numeric field %s", name)
+	return fmt.Errorf("unknown EventRule numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PickListMutation) ClearedFields() []string {
+func (m *EventRuleMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(picklist.FieldStartedAt) {
-		fields = append(fields, picklist.FieldStartedAt)
+	if m.FieldCleared(eventrule.FieldConditions) {
+		fields = append(fields, eventrule.FieldConditions)
 	}
-	if m.FieldCleared(picklist.FieldDoneAt) {
-		fields = append(fields, picklist.FieldDoneAt)
+	if m.FieldCleared(eventrule.FieldArgument) {
+		fields = append(fields, eventrule.FieldArgument)
+	}
+	if m.FieldCleared(eventrule.FieldLastFiredAt) {
+		fields = append(fields, eventrule.FieldLastFiredAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PickListMutation) FieldCleared(name string) bool {
+func (m *EventRuleMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PickListMutation) ClearField(name string) error {
+func (m *EventRuleMutation) ClearField(name string) error {
 	switch name {
-	case picklist.FieldStartedAt:
-		m.ClearStartedAt()
+	case eventrule.FieldConditions:
+		m.ClearConditions()
 		return nil
-	case picklist.FieldDoneAt:
-		m.ClearDoneAt()
+	case eventrule.FieldArgument:
+		m.ClearArgument()
+		return nil
+	case eventrule.FieldLastFiredAt:
+		m.ClearLastFiredAt()
 		return nil
 	}
-	return fmt.Errorf("unknown PickList nullable field %s", name)
+	return fmt.Errorf("unknown EventRule nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PickListMutation) ResetField(name string) error {
+func (m *EventRuleMutation) ResetField(name string) error {
 	switch name {
-	case picklist.FieldStatus:
-		m.ResetStatus()
+	case eventrule.FieldName:
+		m.ResetName()
 		return nil
-	case picklist.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case eventrule.FieldEvent:
+		m.ResetEvent()
 		return nil
-	case picklist.FieldStartedAt:
-		m.ResetStartedAt()
+	case eventrule.FieldConditions:
+		m.ResetConditions()
 		return nil
-	case picklist.FieldDoneAt:
-		m.ResetDoneAt()
+	case eventrule.FieldAction:
+		m.ResetAction()
+		return nil
+	case eventrule.FieldArgument:
+		m.ResetArgument()
+		return nil
+	case eventrule.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case eventrule.FieldFired:
+		m.ResetFired()
+		return nil
+	case eventrule.FieldLastFiredAt:
+		m.ResetLastFiredAt()
+		return nil
+	case eventrule.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown PickList field %s", name)
+	return fmt.Errorf("unknown EventRule field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PickListMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m._order != nil {
-		edges = append(edges, picklist.EdgeOrder)
-	}
-	if m.tasks != nil {
-		edges = append(edges, picklist.EdgeTasks)
-	}
+func (m *EventRuleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PickListMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case picklist.EdgeOrder:
-		if id := m._order; id != nil {
-			return []ent.Value{*id}
-		}
-	case picklist.EdgeTasks:
-		ids := make([]ent.Value, 0, len(m.tasks))
-		for id := range m.tasks {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *EventRuleMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PickListMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedtasks != nil {
-		edges = append(edges, picklist.EdgeTasks)
-	}
+func (m *EventRuleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PickListMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case picklist.EdgeTasks:
-		ids := make([]ent.Value, 0, len(m.removedtasks))
-		for id := range m.removedtasks {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *EventRuleMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PickListMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleared_order {
-		edges = append(edges, picklist.EdgeOrder)
-	}
-	if m.clearedtasks {
-		edges = append(edges, picklist.EdgeTasks)
-	}
+func (m *EventRuleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PickListMutation) EdgeCleared(name string) bool {
-	switch name {
-	case picklist.EdgeOrder:
-		return m.cleared_order
-	case picklist.EdgeTasks:
-		return m.clearedtasks
-	}
+func (m *EventRuleMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PickListMutation) ClearEdge(name string) error {
-	switch name {
-	case picklist.EdgeOrder:
-		m.ClearOrder()
-		return nil
-	}
-	return fmt.Errorf("unknown PickList unique edge %s", name)
+func (m *EventRuleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EventRule unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PickListMutation) ResetEdge(name string) error {
-	switch name {
-	case picklist.EdgeOrder:
-		m.ResetOrder()
-		return nil
-	case picklist.EdgeTasks:
-		m.ResetTasks()
-		return nil
-	}
-	return fmt.Errorf("unknown PickList edge %s", name)
+func (m *EventRuleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EventRule edge %s", name)
 }
 
-// PickTaskMutation represents an operation that mutates the PickTask nodes in the graph.
-type PickTaskMutation struct {
+// ItemMutation represents an operation that mutates the Item nodes in the graph.
+type ItemMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *int
-	quantity          *int
-	addquantity       *int
-	status            *string
-	picked_at         *time.Time
-	bin_id            *int
-	addbin_id         *int
-	clearedFields     map[string]struct{}
-	picklist          *int
-	clearedpicklist   bool
-	order_line        *int
-	clearedorder_line bool
-	done              bool
-	oldValue          func(context.Context) (*PickTask, error)
-	predicates        []predicate.PickTask
+	op                 Op
+	typ                string
+	id                 *int
+	_SKU               *string
+	name               *string
+	description        *string
+	length             *float64
+	addlength          *float64
+	width              *float64
+	addwidth           *float64
+	height             *float64
+	addheight          *float64
+	weight             *float64
+	addweight          *float64
+	unit_price         *float64
+	addunit_price      *float64
+	clearedFields      map[string]struct{}
+	movements          map[int]struct{}
+	removedmovements   map[int]struct{}
+	clearedmovements   bool
+	order_lines        map[int]struct{}
+	removedorder_lines map[int]struct{}
+	clearedorder_lines bool
+	bins               map[int]struct{}
+	removedbins        map[int]struct{}
+	clearedbins        bool
+	done               bool
+	oldValue           func(context.Context) (*Item, error)
+	predicates         []predicate.Item
 }
 
-var _ ent.Mutation = (*PickTaskMutation)(nil)
+var _ ent.Mutation = (*ItemMutation)(nil)
 
-// picktaskOption allows management of the mutation configuration using functional options.
-type picktaskOption func(*PickTaskMutation)
+// itemOption allows management of the mutation configuration using functional options.
+type itemOption func(*ItemMutation)
 
-// newPickTaskMutation creates new mutation for the PickTask entity.
-func newPickTaskMutation(c config, op Op, opts ...picktaskOption) *PickTaskMutation {
-	m := &PickTaskMutation{
+// newItemMutation creates new mutation for the Item entity.
+func newItemMutation(c config, op Op, opts ...itemOption) *ItemMutation {
+	m := &ItemMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePickTask,
+		typ:           TypeItem,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4871,20 +5621,20 @@ func newPickTaskMutation(c config, op Op, opts ...picktaskOption) *PickTaskMutat
 	return m
 }
 
-// withPickTaskID sets the ID field of the mutation.
-func withPickTaskID(id int) picktaskOption {
-	return func(m *PickTaskMutation) {
+// withItemID sets the ID field of the mutation.
+func withItemID(id int) itemOption {
+	return func(m *ItemMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *PickTask
+			value *Item
 		)
-		m.oldValue = func(ctx context.Context) (*PickTask, error) {
+		m.oldValue = func(ctx context.Context) (*Item, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().PickTask.Get(ctx, id)
+					value, err = m.Client().Item.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4893,10 +5643,10 @@ func withPickTaskID(id int) picktaskOption {
 	}
 }
 
-// withPickTask sets the old PickTask of the mutation.
-func withPickTask(node *PickTask) picktaskOption {
-	return func(m *PickTaskMutation) {
-		m.oldValue = func(context.Context) (*PickTask, error) {
+// withItem sets the old Item of the mutation.
+func withItem(node *Item) itemOption {
+	return func(m *ItemMutation) {
+		m.oldValue = func(context.Context) (*Item, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4905,7 +5655,7 @@ func withPickTask(node *PickTask) picktaskOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PickTaskMutation) Client() *Client {
+func (m ItemMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4913,7 +5663,7 @@ func (m PickTaskMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PickTaskMutation) Tx() (*Tx, error) {
+func (m ItemMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4924,7 +5674,7 @@ func (m PickTaskMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PickTaskMutation) ID() (id int, exists bool) {
+func (m *ItemMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4935,7 +5685,7 @@ func (m *PickTaskMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PickTaskMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ItemMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4944,649 +5694,14476 @@ func (m *PickTaskMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PickTask.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Item.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetQuantity sets the "quantity" field.
-func (m *PickTaskMutation) SetQuantity(i int) {
-	m.quantity = &i
-	m.addquantity = nil
+// SetSKU sets the "SKU" field.
+func (m *ItemMutation) SetSKU(s string) {
+	m._SKU = &s
 }
 
-// Quantity returns the value of the "quantity" field in the mutation.
-func (m *PickTaskMutation) Quantity() (r int, exists bool) {
-	v := m.quantity
+// SKU returns the value of the "SKU" field in the mutation.
+func (m *ItemMutation) SKU() (r string, exists bool) {
+	v := m._SKU
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQuantity returns the old "quantity" field's value of the PickTask entity.
-// If the PickTask object wasn't provided to the builder, the object is fetched from the database.
+// OldSKU returns the old "SKU" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PickTaskMutation) OldQuantity(ctx context.Context) (v int, err error) {
+func (m *ItemMutation) OldSKU(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
+		return v, errors.New("OldSKU is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuantity requires an ID field in the mutation")
+		return v, errors.New("OldSKU requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
-	}
-	return oldValue.Quantity, nil
-}
-
-// AddQuantity adds i to the "quantity" field.
-func (m *PickTaskMutation) AddQuantity(i int) {
-	if m.addquantity != nil {
-		*m.addquantity += i
-	} else {
-		m.addquantity = &i
-	}
-}
-
-// AddedQuantity returns the value that was added to the "quantity" field in this mutation.
-func (m *PickTaskMutation) AddedQuantity() (r int, exists bool) {
-	v := m.addquantity
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldSKU: %w", err)
 	}
-	return *v, true
+	return oldValue.SKU, nil
 }
 
-// ResetQuantity resets all changes to the "quantity" field.
-func (m *PickTaskMutation) ResetQuantity() {
-	m.quantity = nil
-	m.addquantity = nil
+// ResetSKU resets all changes to the "SKU" field.
+func (m *ItemMutation) ResetSKU() {
+	m._SKU = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *PickTaskMutation) SetStatus(s string) {
-	m.status = &s
+// SetName sets the "name" field.
+func (m *ItemMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *PickTaskMutation) Status() (r string, exists bool) {
-	v := m.status
+// Name returns the value of the "name" field in the mutation.
+func (m *ItemMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the PickTask entity.
-// If the PickTask object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PickTaskMutation) OldStatus(ctx context.Context) (v string, err error) {
+func (m *ItemMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Name, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *PickTaskMutation) ResetStatus() {
-	m.status = nil
+// ResetName resets all changes to the "name" field.
+func (m *ItemMutation) ResetName() {
+	m.name = nil
 }
 
-// SetPickedAt sets the "picked_at" field.
-func (m *PickTaskMutation) SetPickedAt(t time.Time) {
-	m.picked_at = &t
+// SetDescription sets the "description" field.
+func (m *ItemMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// PickedAt returns the value of the "picked_at" field in the mutation.
-func (m *PickTaskMutation) PickedAt() (r time.Time, exists bool) {
-	v := m.picked_at
+// Description returns the value of the "description" field in the mutation.
+func (m *ItemMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPickedAt returns the old "picked_at" field's value of the PickTask entity.
-// If the PickTask object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PickTaskMutation) OldPickedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *ItemMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPickedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPickedAt requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPickedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.PickedAt, nil
+	return oldValue.Description, nil
 }
 
-// ClearPickedAt clears the value of the "picked_at" field.
-func (m *PickTaskMutation) ClearPickedAt() {
-	m.picked_at = nil
-	m.clearedFields[picktask.FieldPickedAt] = struct{}{}
+// ClearDescription clears the value of the "description" field.
+func (m *ItemMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[item.FieldDescription] = struct{}{}
 }
 
-// PickedAtCleared returns if the "picked_at" field was cleared in this mutation.
-func (m *PickTaskMutation) PickedAtCleared() bool {
-	_, ok := m.clearedFields[picktask.FieldPickedAt]
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ItemMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[item.FieldDescription]
 	return ok
 }
 
-// ResetPickedAt resets all changes to the "picked_at" field.
-func (m *PickTaskMutation) ResetPickedAt() {
-	m.picked_at = nil
-	delete(m.clearedFields, picktask.FieldPickedAt)
+// ResetDescription resets all changes to the "description" field.
+func (m *ItemMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, item.FieldDescription)
 }
 
-// SetBinID sets the "bin_id" field.
-func (m *PickTaskMutation) SetBinID(i int) {
-	m.bin_id = &i
-	m.addbin_id = nil
+// SetLength sets the "length" field.
+func (m *ItemMutation) SetLength(f float64) {
+	m.length = &f
+	m.addlength = nil
 }
 
-// BinID returns the value of the "bin_id" field in the mutation.
-func (m *PickTaskMutation) BinID() (r int, exists bool) {
-	v := m.bin_id
+// Length returns the value of the "length" field in the mutation.
+func (m *ItemMutation) Length() (r float64, exists bool) {
+	v := m.length
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBinID returns the old "bin_id" field's value of the PickTask entity.
-// If the PickTask object wasn't provided to the builder, the object is fetched from the database.
+// OldLength returns the old "length" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PickTaskMutation) OldBinID(ctx context.Context) (v *int, err error) {
+func (m *ItemMutation) OldLength(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBinID is only allowed on UpdateOne operations")
+		return v, errors.New("OldLength is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBinID requires an ID field in the mutation")
+		return v, errors.New("OldLength requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBinID: %w", err)
+		return v, fmt.Errorf("querying old value for OldLength: %w", err)
 	}
-	return oldValue.BinID, nil
+	return oldValue.Length, nil
 }
 
-// AddBinID adds i to the "bin_id" field.
-func (m *PickTaskMutation) AddBinID(i int) {
-	if m.addbin_id != nil {
-		*m.addbin_id += i
+// AddLength adds f to the "length" field.
+func (m *ItemMutation) AddLength(f float64) {
+	if m.addlength != nil {
+		*m.addlength += f
 	} else {
-		m.addbin_id = &i
+		m.addlength = &f
 	}
 }
 
-// AddedBinID returns the value that was added to the "bin_id" field in this mutation.
-func (m *PickTaskMutation) AddedBinID() (r int, exists bool) {
-	v := m.addbin_id
+// AddedLength returns the value that was added to the "length" field in this mutation.
+func (m *ItemMutation) AddedLength() (r float64, exists bool) {
+	v := m.addlength
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearBinID clears the value of the "bin_id" field.
-func (m *PickTaskMutation) ClearBinID() {
-	m.bin_id = nil
-	m.addbin_id = nil
-	m.clearedFields[picktask.FieldBinID] = struct{}{}
+// ClearLength clears the value of the "length" field.
+func (m *ItemMutation) ClearLength() {
+	m.length = nil
+	m.addlength = nil
+	m.clearedFields[item.FieldLength] = struct{}{}
 }
 
-// BinIDCleared returns if the "bin_id" field was cleared in this mutation.
-func (m *PickTaskMutation) BinIDCleared() bool {
-	_, ok := m.clearedFields[picktask.FieldBinID]
+// LengthCleared returns if the "length" field was cleared in this mutation.
+func (m *ItemMutation) LengthCleared() bool {
+	_, ok := m.clearedFields[item.FieldLength]
 	return ok
 }
 
-// ResetBinID resets all changes to the "bin_id" field.
-func (m *PickTaskMutation) ResetBinID() {
-	m.bin_id = nil
-	m.addbin_id = nil
-	delete(m.clearedFields, picktask.FieldBinID)
+// ResetLength resets all changes to the "length" field.
+func (m *ItemMutation) ResetLength() {
+	m.length = nil
+	m.addlength = nil
+	delete(m.clearedFields, item.FieldLength)
 }
 
-// SetPicklistID sets the "picklist" edge to the PickList entity by id.
-func (m *PickTaskMutation) SetPicklistID(id int) {
-	m.picklist = &id
+// SetWidth sets the "width" field.
+func (m *ItemMutation) SetWidth(f float64) {
+	m.width = &f
+	m.addwidth = nil
 }
 
-// ClearPicklist clears the "picklist" edge to the PickList entity.
-func (m *PickTaskMutation) ClearPicklist() {
-	m.clearedpicklist = true
+// Width returns the value of the "width" field in the mutation.
+func (m *ItemMutation) Width() (r float64, exists bool) {
+	v := m.width
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// PicklistCleared reports if the "picklist" edge to the PickList entity was cleared.
-func (m *PickTaskMutation) PicklistCleared() bool {
-	return m.clearedpicklist
+// OldWidth returns the old "width" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldWidth(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWidth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWidth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWidth: %w", err)
+	}
+	return oldValue.Width, nil
 }
 
-// PicklistID returns the "picklist" edge ID in the mutation.
-func (m *PickTaskMutation) PicklistID() (id int, exists bool) {
-	if m.picklist != nil {
-		return *m.picklist, true
+// AddWidth adds f to the "width" field.
+func (m *ItemMutation) AddWidth(f float64) {
+	if m.addwidth != nil {
+		*m.addwidth += f
+	} else {
+		m.addwidth = &f
 	}
-	return
 }
 
-// PicklistIDs returns the "picklist" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PicklistID instead. It exists only for internal usage by the builders.
-func (m *PickTaskMutation) PicklistIDs() (ids []int) {
-	if id := m.picklist; id != nil {
-		ids = append(ids, *id)
+// AddedWidth returns the value that was added to the "width" field in this mutation.
+func (m *ItemMutation) AddedWidth() (r float64, exists bool) {
+	v := m.addwidth
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetPicklist resets all changes to the "picklist" edge.
-func (m *PickTaskMutation) ResetPicklist() {
-	m.picklist = nil
-	m.clearedpicklist = false
+// ClearWidth clears the value of the "width" field.
+func (m *ItemMutation) ClearWidth() {
+	m.width = nil
+	m.addwidth = nil
+	m.clearedFields[item.FieldWidth] = struct{}{}
 }
 
-// SetOrderLineID sets the "order_line" edge to the OrderLine entity by id.
-func (m *PickTaskMutation) SetOrderLineID(id int) {
-	m.order_line = &id
+// WidthCleared returns if the "width" field was cleared in this mutation.
+func (m *ItemMutation) WidthCleared() bool {
+	_, ok := m.clearedFields[item.FieldWidth]
+	return ok
 }
 
-// ClearOrderLine clears the "order_line" edge to the OrderLine entity.
-func (m *PickTaskMutation) ClearOrderLine() {
-	m.clearedorder_line = true
+// ResetWidth resets all changes to the "width" field.
+func (m *ItemMutation) ResetWidth() {
+	m.width = nil
+	m.addwidth = nil
+	delete(m.clearedFields, item.FieldWidth)
 }
 
-// OrderLineCleared reports if the "order_line" edge to the OrderLine entity was cleared.
-func (m *PickTaskMutation) OrderLineCleared() bool {
-	return m.clearedorder_line
+// SetHeight sets the "height" field.
+func (m *ItemMutation) SetHeight(f float64) {
+	m.height = &f
+	m.addheight = nil
 }
 
-// OrderLineID returns the "order_line" edge ID in the mutation.
-func (m *PickTaskMutation) OrderLineID() (id int, exists bool) {
-	if m.order_line != nil {
-		return *m.order_line, true
+// Height returns the value of the "height" field in the mutation.
+func (m *ItemMutation) Height() (r float64, exists bool) {
+	v := m.height
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// OrderLineIDs returns the "order_line" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OrderLineID instead. It exists only for internal usage by the builders.
-func (m *PickTaskMutation) OrderLineIDs() (ids []int) {
-	if id := m.order_line; id != nil {
-		ids = append(ids, *id)
+// OldHeight returns the old "height" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldHeight(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeight is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeight: %w", err)
+	}
+	return oldValue.Height, nil
 }
 
-// ResetOrderLine resets all changes to the "order_line" edge.
-func (m *PickTaskMutation) ResetOrderLine() {
-	m.order_line = nil
-	m.clearedorder_line = false
+// AddHeight adds f to the "height" field.
+func (m *ItemMutation) AddHeight(f float64) {
+	if m.addheight != nil {
+		*m.addheight += f
+	} else {
+		m.addheight = &f
+	}
 }
 
-// Where appends a list predicates to the PickTaskMutation builder.
-func (m *PickTaskMutation) Where(ps ...predicate.PickTask) {
-	m.predicates = append(m.predicates, ps...)
+// AddedHeight returns the value that was added to the "height" field in this mutation.
+func (m *ItemMutation) AddedHeight() (r float64, exists bool) {
+	v := m.addheight
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// WhereP appends storage-level predicates to the PickTaskMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PickTaskMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PickTask, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// ClearHeight clears the value of the "height" field.
+func (m *ItemMutation) ClearHeight() {
+	m.height = nil
+	m.addheight = nil
+	m.clearedFields[item.FieldHeight] = struct{}{}
 }
 
-// Op returns the operation name.
-func (m *PickTaskMutation) Op() Op {
-	return m.op
+// HeightCleared returns if the "height" field was cleared in this mutation.
+func (m *ItemMutation) HeightCleared() bool {
+	_, ok := m.clearedFields[item.FieldHeight]
+	return ok
 }
 
-// SetOp allows setting the mutation operation.
-func (m *PickTaskMutation) SetOp(op Op) {
-	m.op = op
+// ResetHeight resets all changes to the "height" field.
+func (m *ItemMutation) ResetHeight() {
+	m.height = nil
+	m.addheight = nil
+	delete(m.clearedFields, item.FieldHeight)
 }
 
-// Type returns the node type of this mutation (PickTask).
-func (m *PickTaskMutation) Type() string {
-	return m.typ
+// SetWeight sets the "weight" field.
+func (m *ItemMutation) SetWeight(f float64) {
+	m.weight = &f
+	m.addweight = nil
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *PickTaskMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.quantity != nil {
-		fields = append(fields, picktask.FieldQuantity)
+// Weight returns the value of the "weight" field in the mutation.
+func (m *ItemMutation) Weight() (r float64, exists bool) {
+	v := m.weight
+	if v == nil {
+		return
 	}
-	if m.status != nil {
-		fields = append(fields, picktask.FieldStatus)
+	return *v, true
+}
+
+// OldWeight returns the old "weight" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldWeight(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWeight is only allowed on UpdateOne operations")
 	}
-	if m.picked_at != nil {
-		fields = append(fields, picktask.FieldPickedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWeight requires an ID field in the mutation")
 	}
-	if m.bin_id != nil {
-		fields = append(fields, picktask.FieldBinID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWeight: %w", err)
 	}
-	return fields
+	return oldValue.Weight, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *PickTaskMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case picktask.FieldQuantity:
-		return m.Quantity()
-	case picktask.FieldStatus:
-		return m.Status()
-	case picktask.FieldPickedAt:
-		return m.PickedAt()
-	case picktask.FieldBinID:
-		return m.BinID()
+// AddWeight adds f to the "weight" field.
+func (m *ItemMutation) AddWeight(f float64) {
+	if m.addweight != nil {
+		*m.addweight += f
+	} else {
+		m.addweight = &f
 	}
-	return nil, false
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *PickTaskMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case picktask.FieldQuantity:
-		return m.OldQuantity(ctx)
-	case picktask.FieldStatus:
-		return m.OldStatus(ctx)
-	case picktask.FieldPickedAt:
-		return m.OldPickedAt(ctx)
-	case picktask.FieldBinID:
-		return m.OldBinID(ctx)
+// AddedWeight returns the value that was added to the "weight" field in this mutation.
+func (m *ItemMutation) AddedWeight() (r float64, exists bool) {
+	v := m.addweight
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown PickTask field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *PickTaskMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case picktask.FieldQuantity:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetQuantity(v)
-		return nil
-	case picktask.FieldStatus:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case picktask.FieldPickedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPickedAt(v)
-		return nil
-	case picktask.FieldBinID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBinID(v)
-		return nil
-	}
-	return fmt.Errorf("unknown PickTask field %s", name)
+// ClearWeight clears the value of the "weight" field.
+func (m *ItemMutation) ClearWeight() {
+	m.weight = nil
+	m.addweight = nil
+	m.clearedFields[item.FieldWeight] = struct{}{}
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
+// WeightCleared returns if the "weight" field was cleared in this mutation.
+func (m *ItemMutation) WeightCleared() bool {
+	_, ok := m.clearedFields[item.FieldWeight]
+	return ok
+}
+
+// ResetWeight resets all changes to the "weight" field.
+func (m *ItemMutation) ResetWeight() {
+	m.weight = nil
+	m.addweight = nil
+	delete(m.clearedFields, item.FieldWeight)
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (m *ItemMutation) SetUnitPrice(f float64) {
+	m.unit_price = &f
+	m.addunit_price = nil
+}
+
+// UnitPrice returns the value of the "unit_price" field in the mutation.
+func (m *ItemMutation) UnitPrice() (r float64, exists bool) {
+	v := m.unit_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUnitPrice returns the old "unit_price" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldUnitPrice(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUnitPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUnitPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUnitPrice: %w", err)
+	}
+	return oldValue.UnitPrice, nil
+}
+
+// AddUnitPrice adds f to the "unit_price" field.
+func (m *ItemMutation) AddUnitPrice(f float64) {
+	if m.addunit_price != nil {
+		*m.addunit_price += f
+	} else {
+		m.addunit_price = &f
+	}
+}
+
+// AddedUnitPrice returns the value that was added to the "unit_price" field in this mutation.
+func (m *ItemMutation) AddedUnitPrice() (r float64, exists bool) {
+	v := m.addunit_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUnitPrice clears the value of the "unit_price" field.
+func (m *ItemMutation) ClearUnitPrice() {
+	m.unit_price = nil
+	m.addunit_price = nil
+	m.clearedFields[item.FieldUnitPrice] = struct{}{}
+}
+
+// UnitPriceCleared returns if the "unit_price" field was cleared in this mutation.
+func (m *ItemMutation) UnitPriceCleared() bool {
+	_, ok := m.clearedFields[item.FieldUnitPrice]
+	return ok
+}
+
+// ResetUnitPrice resets all changes to the "unit_price" field.
+func (m *ItemMutation) ResetUnitPrice() {
+	m.unit_price = nil
+	m.addunit_price = nil
+	delete(m.clearedFields, item.FieldUnitPrice)
+}
+
+// AddMovementIDs adds the "movements" edge to the StockMovement entity by ids.
+func (m *ItemMutation) AddMovementIDs(ids ...int) {
+	if m.movements == nil {
+		m.movements = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.movements[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMovements clears the "movements" edge to the StockMovement entity.
+func (m *ItemMutation) ClearMovements() {
+	m.clearedmovements = true
+}
+
+// MovementsCleared reports if the "movements" edge to the StockMovement entity was cleared.
+func (m *ItemMutation) MovementsCleared() bool {
+	return m.clearedmovements
+}
+
+// RemoveMovementIDs removes the "movements" edge to the StockMovement entity by IDs.
+func (m *ItemMutation) RemoveMovementIDs(ids ...int) {
+	if m.removedmovements == nil {
+		m.removedmovements = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.movements, ids[i])
+		m.removedmovements[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMovements returns the removed IDs of the "movements" edge to the StockMovement entity.
+func (m *ItemMutation) RemovedMovementsIDs() (ids []int) {
+	for id := range m.removedmovements {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MovementsIDs returns the "movements" edge IDs in the mutation.
+func (m *ItemMutation) MovementsIDs() (ids []int) {
+	for id := range m.movements {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMovements resets all changes to the "movements" edge.
+func (m *ItemMutation) ResetMovements() {
+	m.movements = nil
+	m.clearedmovements = false
+	m.removedmovements = nil
+}
+
+// AddOrderLineIDs adds the "order_lines" edge to the OrderLine entity by ids.
+func (m *ItemMutation) AddOrderLineIDs(ids ...int) {
+	if m.order_lines == nil {
+		m.order_lines = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.order_lines[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOrderLines clears the "order_lines" edge to the OrderLine entity.
+func (m *ItemMutation) ClearOrderLines() {
+	m.clearedorder_lines = true
+}
+
+// OrderLinesCleared reports if the "order_lines" edge to the OrderLine entity was cleared.
+func (m *ItemMutation) OrderLinesCleared() bool {
+	return m.clearedorder_lines
+}
+
+// RemoveOrderLineIDs removes the "order_lines" edge to the OrderLine entity by IDs.
+func (m *ItemMutation) RemoveOrderLineIDs(ids ...int) {
+	if m.removedorder_lines == nil {
+		m.removedorder_lines = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.order_lines, ids[i])
+		m.removedorder_lines[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOrderLines returns the removed IDs of the "order_lines" edge to the OrderLine entity.
+func (m *ItemMutation) RemovedOrderLinesIDs() (ids []int) {
+	for id := range m.removedorder_lines {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OrderLinesIDs returns the "order_lines" edge IDs in the mutation.
+func (m *ItemMutation) OrderLinesIDs() (ids []int) {
+	for id := range m.order_lines {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOrderLines resets all changes to the "order_lines" edge.
+func (m *ItemMutation) ResetOrderLines() {
+	m.order_lines = nil
+	m.clearedorder_lines = false
+	m.removedorder_lines = nil
+}
+
+// AddBinIDs adds the "bins" edge to the Bin entity by ids.
+func (m *ItemMutation) AddBinIDs(ids ...int) {
+	if m.bins == nil {
+		m.bins = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.bins[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBins clears the "bins" edge to the Bin entity.
+func (m *ItemMutation) ClearBins() {
+	m.clearedbins = true
+}
+
+// BinsCleared reports if the "bins" edge to the Bin entity was cleared.
+func (m *ItemMutation) BinsCleared() bool {
+	return m.clearedbins
+}
+
+// RemoveBinIDs removes the "bins" edge to the Bin entity by IDs.
+func (m *ItemMutation) RemoveBinIDs(ids ...int) {
+	if m.removedbins == nil {
+		m.removedbins = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.bins, ids[i])
+		m.removedbins[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBins returns the removed IDs of the "bins" edge to the Bin entity.
+func (m *ItemMutation) RemovedBinsIDs() (ids []int) {
+	for id := range m.removedbins {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BinsIDs returns the "bins" edge IDs in the mutation.
+func (m *ItemMutation) BinsIDs() (ids []int) {
+	for id := range m.bins {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBins resets all changes to the "bins" edge.
+func (m *ItemMutation) ResetBins() {
+	m.bins = nil
+	m.clearedbins = false
+	m.removedbins = nil
+}
+
+// Where appends a list predicates to the ItemMutation builder.
+func (m *ItemMutation) Where(ps ...predicate.Item) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ItemMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ItemMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Item, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ItemMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ItemMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Item).
+func (m *ItemMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ItemMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m._SKU != nil {
+		fields = append(fields, item.FieldSKU)
+	}
+	if m.name != nil {
+		fields = append(fields, item.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, item.FieldDescription)
+	}
+	if m.length != nil {
+		fields = append(fields, item.FieldLength)
+	}
+	if m.width != nil {
+		fields = append(fields, item.FieldWidth)
+	}
+	if m.height != nil {
+		fields = append(fields, item.FieldHeight)
+	}
+	if m.weight != nil {
+		fields = append(fields, item.FieldWeight)
+	}
+	if m.unit_price != nil {
+		fields = append(fields, item.FieldUnitPrice)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ItemMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case item.FieldSKU:
+		return m.SKU()
+	case item.FieldName:
+		return m.Name()
+	case item.FieldDescription:
+		return m.Description()
+	case item.FieldLength:
+		return m.Length()
+	case item.FieldWidth:
+		return m.Width()
+	case item.FieldHeight:
+		return m.Height()
+	case item.FieldWeight:
+		return m.Weight()
+	case item.FieldUnitPrice:
+		return m.UnitPrice()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case item.FieldSKU:
+		return m.OldSKU(ctx)
+	case item.FieldName:
+		return m.OldName(ctx)
+	case item.FieldDescription:
+		return m.OldDescription(ctx)
+	case item.FieldLength:
+		return m.OldLength(ctx)
+	case item.FieldWidth:
+		return m.OldWidth(ctx)
+	case item.FieldHeight:
+		return m.OldHeight(ctx)
+	case item.FieldWeight:
+		return m.OldWeight(ctx)
+	case item.FieldUnitPrice:
+		return m.OldUnitPrice(ctx)
+	}
+	return nil, fmt.Errorf("unknown Item field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case item.FieldSKU:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSKU(v)
+		return nil
+	case item.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case item.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case item.FieldLength:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLength(v)
+		return nil
+	case item.FieldWidth:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWidth(v)
+		return nil
+	case item.FieldHeight:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeight(v)
+		return nil
+	case item.FieldWeight:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWeight(v)
+		return nil
+	case item.FieldUnitPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUnitPrice(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Item field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ItemMutation) AddedFields() []string {
+	var fields []string
+	if m.addlength != nil {
+		fields = append(fields, item.FieldLength)
+	}
+	if m.addwidth != nil {
+		fields = append(fields, item.FieldWidth)
+	}
+	if m.addheight != nil {
+		fields = append(fields, item.FieldHeight)
+	}
+	if m.addweight != nil {
+		fields = append(fields, item.FieldWeight)
+	}
+	if m.addunit_price != nil {
+		fields = append(fields, item.FieldUnitPrice)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ItemMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case item.FieldLength:
+		return m.AddedLength()
+	case item.FieldWidth:
+		return m.AddedWidth()
+	case item.FieldHeight:
+		return m.AddedHeight()
+	case item.FieldWeight:
+		return m.AddedWeight()
+	case item.FieldUnitPrice:
+		return m.AddedUnitPrice()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case item.FieldLength:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLength(v)
+		return nil
+	case item.FieldWidth:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWidth(v)
+		return nil
+	case item.FieldHeight:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeight(v)
+		return nil
+	case item.FieldWeight:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWeight(v)
+		return nil
+	case item.FieldUnitPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUnitPrice(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Item numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ItemMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(item.FieldDescription) {
+		fields = append(fields, item.FieldDescription)
+	}
+	if m.FieldCleared(item.FieldLength) {
+		fields = append(fields, item.FieldLength)
+	}
+	if m.FieldCleared(item.FieldWidth) {
+		fields = append(fields, item.FieldWidth)
+	}
+	if m.FieldCleared(item.FieldHeight) {
+		fields = append(fields, item.FieldHeight)
+	}
+	if m.FieldCleared(item.FieldWeight) {
+		fields = append(fields, item.FieldWeight)
+	}
+	if m.FieldCleared(item.FieldUnitPrice) {
+		fields = append(fields, item.FieldUnitPrice)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ItemMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ItemMutation) ClearField(name string) error {
+	switch name {
+	case item.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case item.FieldLength:
+		m.ClearLength()
+		return nil
+	case item.FieldWidth:
+		m.ClearWidth()
+		return nil
+	case item.FieldHeight:
+		m.ClearHeight()
+		return nil
+	case item.FieldWeight:
+		m.ClearWeight()
+		return nil
+	case item.FieldUnitPrice:
+		m.ClearUnitPrice()
+		return nil
+	}
+	return fmt.Errorf("unknown Item nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ItemMutation) ResetField(name string) error {
+	switch name {
+	case item.FieldSKU:
+		m.ResetSKU()
+		return nil
+	case item.FieldName:
+		m.ResetName()
+		return nil
+	case item.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case item.FieldLength:
+		m.ResetLength()
+		return nil
+	case item.FieldWidth:
+		m.ResetWidth()
+		return nil
+	case item.FieldHeight:
+		m.ResetHeight()
+		return nil
+	case item.FieldWeight:
+		m.ResetWeight()
+		return nil
+	case item.FieldUnitPrice:
+		m.ResetUnitPrice()
+		return nil
+	}
+	return fmt.Errorf("unknown Item field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ItemMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.movements != nil {
+		edges = append(edges, item.EdgeMovements)
+	}
+	if m.order_lines != nil {
+		edges = append(edges, item.EdgeOrderLines)
+	}
+	if m.bins != nil {
+		edges = append(edges, item.EdgeBins)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ItemMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case item.EdgeMovements:
+		ids := make([]ent.Value, 0, len(m.movements))
+		for id := range m.movements {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeOrderLines:
+		ids := make([]ent.Value, 0, len(m.order_lines))
+		for id := range m.order_lines {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeBins:
+		ids := make([]ent.Value, 0, len(m.bins))
+		for id := range m.bins {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ItemMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedmovements != nil {
+		edges = append(edges, item.EdgeMovements)
+	}
+	if m.removedorder_lines != nil {
+		edges = append(edges, item.EdgeOrderLines)
+	}
+	if m.removedbins != nil {
+		edges = append(edges, item.EdgeBins)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ItemMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case item.EdgeMovements:
+		ids := make([]ent.Value, 0, len(m.removedmovements))
+		for id := range m.removedmovements {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeOrderLines:
+		ids := make([]ent.Value, 0, len(m.removedorder_lines))
+		for id := range m.removedorder_lines {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeBins:
+		ids := make([]ent.Value, 0, len(m.removedbins))
+		for id := range m.removedbins {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ItemMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedmovements {
+		edges = append(edges, item.EdgeMovements)
+	}
+	if m.clearedorder_lines {
+		edges = append(edges, item.EdgeOrderLines)
+	}
+	if m.clearedbins {
+		edges = append(edges, item.EdgeBins)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ItemMutation) EdgeCleared(name string) bool {
+	switch name {
+	case item.EdgeMovements:
+		return m.clearedmovements
+	case item.EdgeOrderLines:
+		return m.clearedorder_lines
+	case item.EdgeBins:
+		return m.clearedbins
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ItemMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Item unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ItemMutation) ResetEdge(name string) error {
+	switch name {
+	case item.EdgeMovements:
+		m.ResetMovements()
+		return nil
+	case item.EdgeOrderLines:
+		m.ResetOrderLines()
+		return nil
+	case item.EdgeBins:
+		m.ResetBins()
+		return nil
+	}
+	return fmt.Errorf("unknown Item edge %s", name)
+}
+
+// LocationMutation represents an operation that mutates the Location nodes in the graph.
+type LocationMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	code                  *string
+	name                  *string
+	on_hold               *bool
+	hold_reason           *string
+	clearedFields         map[string]struct{}
+	movements             map[int]struct{}
+	removedmovements      map[int]struct{}
+	clearedmovements      bool
+	order_lines           map[int]struct{}
+	removedorder_lines    map[int]struct{}
+	clearedorder_lines    bool
+	zones                 map[int]struct{}
+	removedzones          map[int]struct{}
+	clearedzones          bool
+	bins                  map[int]struct{}
+	removedbins           map[int]struct{}
+	clearedbins           bool
+	warehouse_link        *int
+	clearedwarehouse_link bool
+	done                  bool
+	oldValue              func(context.Context) (*Location, error)
+	predicates            []predicate.Location
+}
+
+var _ ent.Mutation = (*LocationMutation)(nil)
+
+// locationOption allows management of the mutation configuration using functional options.
+type locationOption func(*LocationMutation)
+
+// newLocationMutation creates new mutation for the Location entity.
+func newLocationMutation(c config, op Op, opts ...locationOption) *LocationMutation {
+	m := &LocationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLocation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLocationID sets the ID field of the mutation.
+func withLocationID(id int) locationOption {
+	return func(m *LocationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Location
+		)
+		m.oldValue = func(ctx context.Context) (*Location, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Location.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLocation sets the old Location of the mutation.
+func withLocation(node *Location) locationOption {
+	return func(m *LocationMutation) {
+		m.oldValue = func(context.Context) (*Location, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LocationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LocationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LocationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LocationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Location.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCode sets the "code" field.
+func (m *LocationMutation) SetCode(s string) {
+	m.code = &s
+}
+
+// Code returns the value of the "code" field in the mutation.
+func (m *LocationMutation) Code() (r string, exists bool) {
+	v := m.code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCode returns the old "code" field's value of the Location entity.
+// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocationMutation) OldCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+	}
+	return oldValue.Code, nil
+}
+
+// ResetCode resets all changes to the "code" field.
+func (m *LocationMutation) ResetCode() {
+	m.code = nil
+}
+
+// SetName sets the "name" field.
+func (m *LocationMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *LocationMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Location entity.
+// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocationMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *LocationMutation) ResetName() {
+	m.name = nil
+}
+
+// SetOnHold sets the "on_hold" field.
+func (m *LocationMutation) SetOnHold(b bool) {
+	m.on_hold = &b
+}
+
+// OnHold returns the value of the "on_hold" field in the mutation.
+func (m *LocationMutation) OnHold() (r bool, exists bool) {
+	v := m.on_hold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOnHold returns the old "on_hold" field's value of the Location entity.
+// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocationMutation) OldOnHold(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOnHold is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOnHold requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOnHold: %w", err)
+	}
+	return oldValue.OnHold, nil
+}
+
+// ResetOnHold resets all changes to the "on_hold" field.
+func (m *LocationMutation) ResetOnHold() {
+	m.on_hold = nil
+}
+
+// SetHoldReason sets the "hold_reason" field.
+func (m *LocationMutation) SetHoldReason(s string) {
+	m.hold_reason = &s
+}
+
+// HoldReason returns the value of the "hold_reason" field in the mutation.
+func (m *LocationMutation) HoldReason() (r string, exists bool) {
+	v := m.hold_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHoldReason returns the old "hold_reason" field's value of the Location entity.
+// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocationMutation) OldHoldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHoldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHoldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHoldReason: %w", err)
+	}
+	return oldValue.HoldReason, nil
+}
+
+// ClearHoldReason clears the value of the "hold_reason" field.
+func (m *LocationMutation) ClearHoldReason() {
+	m.hold_reason = nil
+	m.clearedFields[location.FieldHoldReason] = struct{}{}
+}
+
+// HoldReasonCleared returns if the "hold_reason" field was cleared in this mutation.
+func (m *LocationMutation) HoldReasonCleared() bool {
+	_, ok := m.clearedFields[location.FieldHoldReason]
+	return ok
+}
+
+// ResetHoldReason resets all changes to the "hold_reason" field.
+func (m *LocationMutation) ResetHoldReason() {
+	m.hold_reason = nil
+	delete(m.clearedFields, location.FieldHoldReason)
+}
+
+// AddMovementIDs adds the "movements" edge to the StockMovement entity by ids.
+func (m *LocationMutation) AddMovementIDs(ids ...int) {
+	if m.movements == nil {
+		m.movements = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.movements[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMovements clears the "movements" edge to the StockMovement entity.
+func (m *LocationMutation) ClearMovements() {
+	m.clearedmovements = true
+}
+
+// MovementsCleared reports if the "movements" edge to the StockMovement entity was cleared.
+func (m *LocationMutation) MovementsCleared() bool {
+	return m.clearedmovements
+}
+
+// RemoveMovementIDs removes the "movements" edge to the StockMovement entity by IDs.
+func (m *LocationMutation) RemoveMovementIDs(ids ...int) {
+	if m.removedmovements == nil {
+		m.removedmovements = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.movements, ids[i])
+		m.removedmovements[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMovements returns the removed IDs of the "movements" edge to the StockMovement entity.
+func (m *LocationMutation) RemovedMovementsIDs() (ids []int) {
+	for id := range m.removedmovements {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MovementsIDs returns the "movements" edge IDs in the mutation.
+func (m *LocationMutation) MovementsIDs() (ids []int) {
+	for id := range m.movements {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMovements resets all changes to the "movements" edge.
+func (m *LocationMutation) ResetMovements() {
+	m.movements = nil
+	m.clearedmovements = false
+	m.removedmovements = nil
+}
+
+// AddOrderLineIDs adds the "order_lines" edge to the OrderLine entity by ids.
+func (m *LocationMutation) AddOrderLineIDs(ids ...int) {
+	if m.order_lines == nil {
+		m.order_lines = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.order_lines[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOrderLines clears the "order_lines" edge to the OrderLine entity.
+func (m *LocationMutation) ClearOrderLines() {
+	m.clearedorder_lines = true
+}
+
+// OrderLinesCleared reports if the "order_lines" edge to the OrderLine entity was cleared.
+func (m *LocationMutation) OrderLinesCleared() bool {
+	return m.clearedorder_lines
+}
+
+// RemoveOrderLineIDs removes the "order_lines" edge to the OrderLine entity by IDs.
+func (m *LocationMutation) RemoveOrderLineIDs(ids ...int) {
+	if m.removedorder_lines == nil {
+		m.removedorder_lines = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.order_lines, ids[i])
+		m.removedorder_lines[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOrderLines returns the removed IDs of the "order_lines" edge to the OrderLine entity.
+func (m *LocationMutation) RemovedOrderLinesIDs() (ids []int) {
+	for id := range m.removedorder_lines {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OrderLinesIDs returns the "order_lines" edge IDs in the mutation.
+func (m *LocationMutation) OrderLinesIDs() (ids []int) {
+	for id := range m.order_lines {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOrderLines resets all changes to the "order_lines" edge.
+func (m *LocationMutation) ResetOrderLines() {
+	m.order_lines = nil
+	m.clearedorder_lines = false
+	m.removedorder_lines = nil
+}
+
+// AddZoneIDs adds the "zones" edge to the Zone entity by ids.
+func (m *LocationMutation) AddZoneIDs(ids ...int) {
+	if m.zones == nil {
+		m.zones = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.zones[ids[i]] = struct{}{}
+	}
+}
+
+// ClearZones clears the "zones" edge to the Zone entity.
+func (m *LocationMutation) ClearZones() {
+	m.clearedzones = true
+}
+
+// ZonesCleared reports if the "zones" edge to the Zone entity was cleared.
+func (m *LocationMutation) ZonesCleared() bool {
+	return m.clearedzones
+}
+
+// RemoveZoneIDs removes the "zones" edge to the Zone entity by IDs.
+func (m *LocationMutation) RemoveZoneIDs(ids ...int) {
+	if m.removedzones == nil {
+		m.removedzones = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.zones, ids[i])
+		m.removedzones[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedZones returns the removed IDs of the "zones" edge to the Zone entity.
+func (m *LocationMutation) RemovedZonesIDs() (ids []int) {
+	for id := range m.removedzones {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ZonesIDs returns the "zones" edge IDs in the mutation.
+func (m *LocationMutation) ZonesIDs() (ids []int) {
+	for id := range m.zones {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetZones resets all changes to the "zones" edge.
+func (m *LocationMutation) ResetZones() {
+	m.zones = nil
+	m.clearedzones = false
+	m.removedzones = nil
+}
+
+// AddBinIDs adds the "bins" edge to the Bin entity by ids.
+func (m *LocationMutation) AddBinIDs(ids ...int) {
+	if m.bins == nil {
+		m.bins = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.bins[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBins clears the "bins" edge to the Bin entity.
+func (m *LocationMutation) ClearBins() {
+	m.clearedbins = true
+}
+
+// BinsCleared reports if the "bins" edge to the Bin entity was cleared.
+func (m *LocationMutation) BinsCleared() bool {
+	return m.clearedbins
+}
+
+// RemoveBinIDs removes the "bins" edge to the Bin entity by IDs.
+func (m *LocationMutation) RemoveBinIDs(ids ...int) {
+	if m.removedbins == nil {
+		m.removedbins = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.bins, ids[i])
+		m.removedbins[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBins returns the removed IDs of the "bins" edge to the Bin entity.
+func (m *LocationMutation) RemovedBinsIDs() (ids []int) {
+	for id := range m.removedbins {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BinsIDs returns the "bins" edge IDs in the mutation.
+func (m *LocationMutation) BinsIDs() (ids []int) {
+	for id := range m.bins {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBins resets all changes to the "bins" edge.
+func (m *LocationMutation) ResetBins() {
+	m.bins = nil
+	m.clearedbins = false
+	m.removedbins = nil
+}
+
+// SetWarehouseLinkID sets the "warehouse_link" edge to the WarehouseLocation entity by id.
+func (m *LocationMutation) SetWarehouseLinkID(id int) {
+	m.warehouse_link = &id
+}
+
+// ClearWarehouseLink clears the "warehouse_link" edge to the WarehouseLocation entity.
+func (m *LocationMutation) ClearWarehouseLink() {
+	m.clearedwarehouse_link = true
+}
+
+// WarehouseLinkCleared reports if the "warehouse_link" edge to the WarehouseLocation entity was cleared.
+func (m *LocationMutation) WarehouseLinkCleared() bool {
+	return m.clearedwarehouse_link
+}
+
+// WarehouseLinkID returns the "warehouse_link" edge ID in the mutation.
+func (m *LocationMutation) WarehouseLinkID() (id int, exists bool) {
+	if m.warehouse_link != nil {
+		return *m.warehouse_link, true
+	}
+	return
+}
+
+// WarehouseLinkIDs returns the "warehouse_link" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WarehouseLinkID instead. It exists only for internal usage by the builders.
+func (m *LocationMutation) WarehouseLinkIDs() (ids []int) {
+	if id := m.warehouse_link; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWarehouseLink resets all changes to the "warehouse_link" edge.
+func (m *LocationMutation) ResetWarehouseLink() {
+	m.warehouse_link = nil
+	m.clearedwarehouse_link = false
+}
+
+// Where appends a list predicates to the LocationMutation builder.
+func (m *LocationMutation) Where(ps ...predicate.Location) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LocationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LocationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Location, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LocationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LocationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Location).
+func (m *LocationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LocationMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.code != nil {
+		fields = append(fields, location.FieldCode)
+	}
+	if m.name != nil {
+		fields = append(fields, location.FieldName)
+	}
+	if m.on_hold != nil {
+		fields = append(fields, location.FieldOnHold)
+	}
+	if m.hold_reason != nil {
+		fields = append(fields, location.FieldHoldReason)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LocationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case location.FieldCode:
+		return m.Code()
+	case location.FieldName:
+		return m.Name()
+	case location.FieldOnHold:
+		return m.OnHold()
+	case location.FieldHoldReason:
+		return m.HoldReason()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LocationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case location.FieldCode:
+		return m.OldCode(ctx)
+	case location.FieldName:
+		return m.OldName(ctx)
+	case location.FieldOnHold:
+		return m.OldOnHold(ctx)
+	case location.FieldHoldReason:
+		return m.OldHoldReason(ctx)
+	}
+	return nil, fmt.Errorf("unknown Location field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LocationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case location.FieldCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCode(v)
+		return nil
+	case location.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case location.FieldOnHold:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOnHold(v)
+		return nil
+	case location.FieldHoldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHoldReason(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Location field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LocationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LocationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LocationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Location numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LocationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(location.FieldHoldReason) {
+		fields = append(fields, location.FieldHoldReason)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LocationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LocationMutation) ClearField(name string) error {
+	switch name {
+	case location.FieldHoldReason:
+		m.ClearHoldReason()
+		return nil
+	}
+	return fmt.Errorf("unknown Location nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LocationMutation) ResetField(name string) error {
+	switch name {
+	case location.FieldCode:
+		m.ResetCode()
+		return nil
+	case location.FieldName:
+		m.ResetName()
+		return nil
+	case location.FieldOnHold:
+		m.ResetOnHold()
+		return nil
+	case location.FieldHoldReason:
+		m.ResetHoldReason()
+		return nil
+	}
+	return fmt.Errorf("unknown Location field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LocationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.movements != nil {
+		edges = append(edges, location.EdgeMovements)
+	}
+	if m.order_lines != nil {
+		edges = append(edges, location.EdgeOrderLines)
+	}
+	if m.zones != nil {
+		edges = append(edges, location.EdgeZones)
+	}
+	if m.bins != nil {
+		edges = append(edges, location.EdgeBins)
+	}
+	if m.warehouse_link != nil {
+		edges = append(edges, location.EdgeWarehouseLink)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LocationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case location.EdgeMovements:
+		ids := make([]ent.Value, 0, len(m.movements))
+		for id := range m.movements {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeOrderLines:
+		ids := make([]ent.Value, 0, len(m.order_lines))
+		for id := range m.order_lines {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeZones:
+		ids := make([]ent.Value, 0, len(m.zones))
+		for id := range m.zones {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeBins:
+		ids := make([]ent.Value, 0, len(m.bins))
+		for id := range m.bins {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeWarehouseLink:
+		if id := m.warehouse_link; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LocationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedmovements != nil {
+		edges = append(edges, location.EdgeMovements)
+	}
+	if m.removedorder_lines != nil {
+		edges = append(edges, location.EdgeOrderLines)
+	}
+	if m.removedzones != nil {
+		edges = append(edges, location.EdgeZones)
+	}
+	if m.removedbins != nil {
+		edges = append(edges, location.EdgeBins)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LocationMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case location.EdgeMovements:
+		ids := make([]ent.Value, 0, len(m.removedmovements))
+		for id := range m.removedmovements {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeOrderLines:
+		ids := make([]ent.Value, 0, len(m.removedorder_lines))
+		for id := range m.removedorder_lines {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeZones:
+		ids := make([]ent.Value, 0, len(m.removedzones))
+		for id := range m.removedzones {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeBins:
+		ids := make([]ent.Value, 0, len(m.removedbins))
+		for id := range m.removedbins {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LocationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedmovements {
+		edges = append(edges, location.EdgeMovements)
+	}
+	if m.clearedorder_lines {
+		edges = append(edges, location.EdgeOrderLines)
+	}
+	if m.clearedzones {
+		edges = append(edges, location.EdgeZones)
+	}
+	if m.clearedbins {
+		edges = append(edges, location.EdgeBins)
+	}
+	if m.clearedwarehouse_link {
+		edges = append(edges, location.EdgeWarehouseLink)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LocationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case location.EdgeMovements:
+		return m.clearedmovements
+	case location.EdgeOrderLines:
+		return m.clearedorder_lines
+	case location.EdgeZones:
+		return m.clearedzones
+	case location.EdgeBins:
+		return m.clearedbins
+	case location.EdgeWarehouseLink:
+		return m.clearedwarehouse_link
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LocationMutation) ClearEdge(name string) error {
+	switch name {
+	case location.EdgeWarehouseLink:
+		m.ClearWarehouseLink()
+		return nil
+	}
+	return fmt.Errorf("unknown Location unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LocationMutation) ResetEdge(name string) error {
+	switch name {
+	case location.EdgeMovements:
+		m.ResetMovements()
+		return nil
+	case location.EdgeOrderLines:
+		m.ResetOrderLines()
+		return nil
+	case location.EdgeZones:
+		m.ResetZones()
+		return nil
+	case location.EdgeBins:
+		m.ResetBins()
+		return nil
+	case location.EdgeWarehouseLink:
+		m.ResetWarehouseLink()
+		return nil
+	}
+	return fmt.Errorf("unknown Location edge %s", name)
+}
+
+// OrderMutation represents an operation that mutates the Order nodes in the graph.
+type OrderMutation struct {
+	config
+	op                           Op
+	typ                          string
+	id                           *int
+	order_number                 *string
+	_type                        *string
+	status                       *string
+	created_at                   *time.Time
+	priority                     *string
+	ship_by                      *time.Time
+	posted_at                    *time.Time
+	reversed_at                  *time.Time
+	reversal_reason              *string
+	idempotency_key              *string
+	clearedFields                map[string]struct{}
+	lines                        map[int]struct{}
+	removedlines                 map[int]struct{}
+	clearedlines                 bool
+	picklist                     *int
+	clearedpicklist              bool
+	tracking                     *int
+	clearedtracking              bool
+	cartons                      map[int]struct{}
+	removedcartons               map[int]struct{}
+	clearedcartons               bool
+	return_of                    *int
+	clearedreturn_of             bool
+	returns                      map[int]struct{}
+	removedreturns               map[int]struct{}
+	clearedreturns               bool
+	source_warehouse             *int
+	clearedsource_warehouse      bool
+	destination_warehouse        *int
+	cleareddestination_warehouse bool
+	wave                         *int
+	clearedwave                  bool
+	done                         bool
+	oldValue                     func(context.Context) (*Order, error)
+	predicates                   []predicate.Order
+}
+
+var _ ent.Mutation = (*OrderMutation)(nil)
+
+// orderOption allows management of the mutation configuration using functional options.
+type orderOption func(*OrderMutation)
+
+// newOrderMutation creates new mutation for the Order entity.
+func newOrderMutation(c config, op Op, opts ...orderOption) *OrderMutation {
+	m := &OrderMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOrder,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOrderID sets the ID field of the mutation.
+func withOrderID(id int) orderOption {
+	return func(m *OrderMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Order
+		)
+		m.oldValue = func(ctx context.Context) (*Order, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Order.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOrder sets the old Order of the mutation.
+func withOrder(node *Order) orderOption {
+	return func(m *OrderMutation) {
+		m.oldValue = func(context.Context) (*Order, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OrderMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OrderMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OrderMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OrderMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Order.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetOrderNumber sets the "order_number" field.
+func (m *OrderMutation) SetOrderNumber(s string) {
+	m.order_number = &s
+}
+
+// OrderNumber returns the value of the "order_number" field in the mutation.
+func (m *OrderMutation) OrderNumber() (r string, exists bool) {
+	v := m.order_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrderNumber returns the old "order_number" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldOrderNumber(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrderNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrderNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrderNumber: %w", err)
+	}
+	return oldValue.OrderNumber, nil
+}
+
+// ResetOrderNumber resets all changes to the "order_number" field.
+func (m *OrderMutation) ResetOrderNumber() {
+	m.order_number = nil
+}
+
+// SetType sets the "type" field.
+func (m *OrderMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *OrderMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *OrderMutation) ResetType() {
+	m._type = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *OrderMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *OrderMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *OrderMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *OrderMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *OrderMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *OrderMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetPriority sets the "priority" field.
+func (m *OrderMutation) SetPriority(s string) {
+	m.priority = &s
+}
+
+// Priority returns the value of the "priority" field in the mutation.
+func (m *OrderMutation) Priority() (r string, exists bool) {
+	v := m.priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriority returns the old "priority" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldPriority(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	}
+	return oldValue.Priority, nil
+}
+
+// ResetPriority resets all changes to the "priority" field.
+func (m *OrderMutation) ResetPriority() {
+	m.priority = nil
+}
+
+// SetShipBy sets the "ship_by" field.
+func (m *OrderMutation) SetShipBy(t time.Time) {
+	m.ship_by = &t
+}
+
+// ShipBy returns the value of the "ship_by" field in the mutation.
+func (m *OrderMutation) ShipBy() (r time.Time, exists bool) {
+	v := m.ship_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShipBy returns the old "ship_by" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldShipBy(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShipBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShipBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShipBy: %w", err)
+	}
+	return oldValue.ShipBy, nil
+}
+
+// ClearShipBy clears the value of the "ship_by" field.
+func (m *OrderMutation) ClearShipBy() {
+	m.ship_by = nil
+	m.clearedFields[order.FieldShipBy] = struct{}{}
+}
+
+// ShipByCleared returns if the "ship_by" field was cleared in this mutation.
+func (m *OrderMutation) ShipByCleared() bool {
+	_, ok := m.clearedFields[order.FieldShipBy]
+	return ok
+}
+
+// ResetShipBy resets all changes to the "ship_by" field.
+func (m *OrderMutation) ResetShipBy() {
+	m.ship_by = nil
+	delete(m.clearedFields, order.FieldShipBy)
+}
+
+// SetPostedAt sets the "posted_at" field.
+func (m *OrderMutation) SetPostedAt(t time.Time) {
+	m.posted_at = &t
+}
+
+// PostedAt returns the value of the "posted_at" field in the mutation.
+func (m *OrderMutation) PostedAt() (r time.Time, exists bool) {
+	v := m.posted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPostedAt returns the old "posted_at" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldPostedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPostedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPostedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPostedAt: %w", err)
+	}
+	return oldValue.PostedAt, nil
+}
+
+// ClearPostedAt clears the value of the "posted_at" field.
+func (m *OrderMutation) ClearPostedAt() {
+	m.posted_at = nil
+	m.clearedFields[order.FieldPostedAt] = struct{}{}
+}
+
+// PostedAtCleared returns if the "posted_at" field was cleared in this mutation.
+func (m *OrderMutation) PostedAtCleared() bool {
+	_, ok := m.clearedFields[order.FieldPostedAt]
+	return ok
+}
+
+// ResetPostedAt resets all changes to the "posted_at" field.
+func (m *OrderMutation) ResetPostedAt() {
+	m.posted_at = nil
+	delete(m.clearedFields, order.FieldPostedAt)
+}
+
+// SetReversedAt sets the "reversed_at" field.
+func (m *OrderMutation) SetReversedAt(t time.Time) {
+	m.reversed_at = &t
+}
+
+// ReversedAt returns the value of the "reversed_at" field in the mutation.
+func (m *OrderMutation) ReversedAt() (r time.Time, exists bool) {
+	v := m.reversed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReversedAt returns the old "reversed_at" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldReversedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReversedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReversedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReversedAt: %w", err)
+	}
+	return oldValue.ReversedAt, nil
+}
+
+// ClearReversedAt clears the value of the "reversed_at" field.
+func (m *OrderMutation) ClearReversedAt() {
+	m.reversed_at = nil
+	m.clearedFields[order.FieldReversedAt] = struct{}{}
+}
+
+// ReversedAtCleared returns if the "reversed_at" field was cleared in this mutation.
+func (m *OrderMutation) ReversedAtCleared() bool {
+	_, ok := m.clearedFields[order.FieldReversedAt]
+	return ok
+}
+
+// ResetReversedAt resets all changes to the "reversed_at" field.
+func (m *OrderMutation) ResetReversedAt() {
+	m.reversed_at = nil
+	delete(m.clearedFields, order.FieldReversedAt)
+}
+
+// SetReversalReason sets the "reversal_reason" field.
+func (m *OrderMutation) SetReversalReason(s string) {
+	m.reversal_reason = &s
+}
+
+// ReversalReason returns the value of the "reversal_reason" field in the mutation.
+func (m *OrderMutation) ReversalReason() (r string, exists bool) {
+	v := m.reversal_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReversalReason returns the old "reversal_reason" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldReversalReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReversalReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReversalReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReversalReason: %w", err)
+	}
+	return oldValue.ReversalReason, nil
+}
+
+// ClearReversalReason clears the value of the "reversal_reason" field.
+func (m *OrderMutation) ClearReversalReason() {
+	m.reversal_reason = nil
+	m.clearedFields[order.FieldReversalReason] = struct{}{}
+}
+
+// ReversalReasonCleared returns if the "reversal_reason" field was cleared in this mutation.
+func (m *OrderMutation) ReversalReasonCleared() bool {
+	_, ok := m.clearedFields[order.FieldReversalReason]
+	return ok
+}
+
+// ResetReversalReason resets all changes to the "reversal_reason" field.
+func (m *OrderMutation) ResetReversalReason() {
+	m.reversal_reason = nil
+	delete(m.clearedFields, order.FieldReversalReason)
+}
+
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (m *OrderMutation) SetIdempotencyKey(s string) {
+	m.idempotency_key = &s
+}
+
+// IdempotencyKey returns the value of the "idempotency_key" field in the mutation.
+func (m *OrderMutation) IdempotencyKey() (r string, exists bool) {
+	v := m.idempotency_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIdempotencyKey returns the old "idempotency_key" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldIdempotencyKey(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIdempotencyKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIdempotencyKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIdempotencyKey: %w", err)
+	}
+	return oldValue.IdempotencyKey, nil
+}
+
+// ClearIdempotencyKey clears the value of the "idempotency_key" field.
+func (m *OrderMutation) ClearIdempotencyKey() {
+	m.idempotency_key = nil
+	m.clearedFields[order.FieldIdempotencyKey] = struct{}{}
+}
+
+// IdempotencyKeyCleared returns if the "idempotency_key" field was cleared in this mutation.
+func (m *OrderMutation) IdempotencyKeyCleared() bool {
+	_, ok := m.clearedFields[order.FieldIdempotencyKey]
+	return ok
+}
+
+// ResetIdempotencyKey resets all changes to the "idempotency_key" field.
+func (m *OrderMutation) ResetIdempotencyKey() {
+	m.idempotency_key = nil
+	delete(m.clearedFields, order.FieldIdempotencyKey)
+}
+
+// AddLineIDs adds the "lines" edge to the OrderLine entity by ids.
+func (m *OrderMutation) AddLineIDs(ids ...int) {
+	if m.lines == nil {
+		m.lines = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.lines[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLines clears the "lines" edge to the OrderLine entity.
+func (m *OrderMutation) ClearLines() {
+	m.clearedlines = true
+}
+
+// LinesCleared reports if the "lines" edge to the OrderLine entity was cleared.
+func (m *OrderMutation) LinesCleared() bool {
+	return m.clearedlines
+}
+
+// RemoveLineIDs removes the "lines" edge to the OrderLine entity by IDs.
+func (m *OrderMutation) RemoveLineIDs(ids ...int) {
+	if m.removedlines == nil {
+		m.removedlines = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.lines, ids[i])
+		m.removedlines[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLines returns the removed IDs of the "lines" edge to the OrderLine entity.
+func (m *OrderMutation) RemovedLinesIDs() (ids []int) {
+	for id := range m.removedlines {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LinesIDs returns the "lines" edge IDs in the mutation.
+func (m *OrderMutation) LinesIDs() (ids []int) {
+	for id := range m.lines {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLines resets all changes to the "lines" edge.
+func (m *OrderMutation) ResetLines() {
+	m.lines = nil
+	m.clearedlines = false
+	m.removedlines = nil
+}
+
+// SetPicklistID sets the "picklist" edge to the PickList entity by id.
+func (m *OrderMutation) SetPicklistID(id int) {
+	m.picklist = &id
+}
+
+// ClearPicklist clears the "picklist" edge to the PickList entity.
+func (m *OrderMutation) ClearPicklist() {
+	m.clearedpicklist = true
+}
+
+// PicklistCleared reports if the "picklist" edge to the PickList entity was cleared.
+func (m *OrderMutation) PicklistCleared() bool {
+	return m.clearedpicklist
+}
+
+// PicklistID returns the "picklist" edge ID in the mutation.
+func (m *OrderMutation) PicklistID() (id int, exists bool) {
+	if m.picklist != nil {
+		return *m.picklist, true
+	}
+	return
+}
+
+// PicklistIDs returns the "picklist" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PicklistID instead. It exists only for internal usage by the builders.
+func (m *OrderMutation) PicklistIDs() (ids []int) {
+	if id := m.picklist; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPicklist resets all changes to the "picklist" edge.
+func (m *OrderMutation) ResetPicklist() {
+	m.picklist = nil
+	m.clearedpicklist = false
+}
+
+// SetTrackingID sets the "tracking" edge to the Tracking entity by id.
+func (m *OrderMutation) SetTrackingID(id int) {
+	m.tracking = &id
+}
+
+// ClearTracking clears the "tracking" edge to the Tracking entity.
+func (m *OrderMutation) ClearTracking() {
+	m.clearedtracking = true
+}
+
+// TrackingCleared reports if the "tracking" edge to the Tracking entity was cleared.
+func (m *OrderMutation) TrackingCleared() bool {
+	return m.clearedtracking
+}
+
+// TrackingID returns the "tracking" edge ID in the mutation.
+func (m *OrderMutation) TrackingID() (id int, exists bool) {
+	if m.tracking != nil {
+		return *m.tracking, true
+	}
+	return
+}
+
+// TrackingIDs returns the "tracking" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TrackingID instead. It exists only for internal usage by the builders.
+func (m *OrderMutation) TrackingIDs() (ids []int) {
+	if id := m.tracking; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTracking resets all changes to the "tracking" edge.
+func (m *OrderMutation) ResetTracking() {
+	m.tracking = nil
+	m.clearedtracking = false
+}
+
+// AddCartonIDs adds the "cartons" edge to the Carton entity by ids.
+func (m *OrderMutation) AddCartonIDs(ids ...int) {
+	if m.cartons == nil {
+		m.cartons = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.cartons[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCartons clears the "cartons" edge to the Carton entity.
+func (m *OrderMutation) ClearCartons() {
+	m.clearedcartons = true
+}
+
+// CartonsCleared reports if the "cartons" edge to the Carton entity was cleared.
+func (m *OrderMutation) CartonsCleared() bool {
+	return m.clearedcartons
+}
+
+// RemoveCartonIDs removes the "cartons" edge to the Carton entity by IDs.
+func (m *OrderMutation) RemoveCartonIDs(ids ...int) {
+	if m.removedcartons == nil {
+		m.removedcartons = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.cartons, ids[i])
+		m.removedcartons[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCartons returns the removed IDs of the "cartons" edge to the Carton entity.
+func (m *OrderMutation) RemovedCartonsIDs() (ids []int) {
+	for id := range m.removedcartons {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CartonsIDs returns the "cartons" edge IDs in the mutation.
+func (m *OrderMutation) CartonsIDs() (ids []int) {
+	for id := range m.cartons {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCartons resets all changes to the "cartons" edge.
+func (m *OrderMutation) ResetCartons() {
+	m.cartons = nil
+	m.clearedcartons = false
+	m.removedcartons = nil
+}
+
+// SetReturnOfID sets the "return_of" edge to the Order entity by id.
+func (m *OrderMutation) SetReturnOfID(id int) {
+	m.return_of = &id
+}
+
+// ClearReturnOf clears the "return_of" edge to the Order entity.
+func (m *OrderMutation) ClearReturnOf() {
+	m.clearedreturn_of = true
+}
+
+// ReturnOfCleared reports if the "return_of" edge to the Order entity was cleared.
+func (m *OrderMutation) ReturnOfCleared() bool {
+	return m.clearedreturn_of
+}
+
+// ReturnOfID returns the "return_of" edge ID in the mutation.
+func (m *OrderMutation) ReturnOfID() (id int, exists bool) {
+	if m.return_of != nil {
+		return *m.return_of, true
+	}
+	return
+}
+
+// ReturnOfIDs returns the "return_of" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ReturnOfID instead. It exists only for internal usage by the builders.
+func (m *OrderMutation) ReturnOfIDs() (ids []int) {
+	if id := m.return_of; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetReturnOf resets all changes to the "return_of" edge.
+func (m *OrderMutation) ResetReturnOf() {
+	m.return_of = nil
+	m.clearedreturn_of = false
+}
+
+// AddReturnIDs adds the "returns" edge to the Order entity by ids.
+func (m *OrderMutation) AddReturnIDs(ids ...int) {
+	if m.returns == nil {
+		m.returns = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.returns[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReturns clears the "returns" edge to the Order entity.
+func (m *OrderMutation) ClearReturns() {
+	m.clearedreturns = true
+}
+
+// ReturnsCleared reports if the "returns" edge to the Order entity was cleared.
+func (m *OrderMutation) ReturnsCleared() bool {
+	return m.clearedreturns
+}
+
+// RemoveReturnIDs removes the "returns" edge to the Order entity by IDs.
+func (m *OrderMutation) RemoveReturnIDs(ids ...int) {
+	if m.removedreturns == nil {
+		m.removedreturns = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.returns, ids[i])
+		m.removedreturns[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReturns returns the removed IDs of the "returns" edge to the Order entity.
+func (m *OrderMutation) RemovedReturnsIDs() (ids []int) {
+	for id := range m.removedreturns {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReturnsIDs returns the "returns" edge IDs in the mutation.
+func (m *OrderMutation) ReturnsIDs() (ids []int) {
+	for id := range m.returns {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReturns resets all changes to the "returns" edge.
+func (m *OrderMutation) ResetReturns() {
+	m.returns = nil
+	m.clearedreturns = false
+	m.removedreturns = nil
+}
+
+// SetSourceWarehouseID sets the "source_warehouse" edge to the Warehouse entity by id.
+func (m *OrderMutation) SetSourceWarehouseID(id int) {
+	m.source_warehouse = &id
+}
+
+// ClearSourceWarehouse clears the "source_warehouse" edge to the Warehouse entity.
+func (m *OrderMutation) ClearSourceWarehouse() {
+	m.clearedsource_warehouse = true
+}
+
+// SourceWarehouseCleared reports if the "source_warehouse" edge to the Warehouse entity was cleared.
+func (m *OrderMutation) SourceWarehouseCleared() bool {
+	return m.clearedsource_warehouse
+}
+
+// SourceWarehouseID returns the "source_warehouse" edge ID in the mutation.
+func (m *OrderMutation) SourceWarehouseID() (id int, exists bool) {
+	if m.source_warehouse != nil {
+		return *m.source_warehouse, true
+	}
+	return
+}
+
+// SourceWarehouseIDs returns the "source_warehouse" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SourceWarehouseID instead. It exists only for internal usage by the builders.
+func (m *OrderMutation) SourceWarehouseIDs() (ids []int) {
+	if id := m.source_warehouse; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSourceWarehouse resets all changes to the "source_warehouse" edge.
+func (m *OrderMutation) ResetSourceWarehouse() {
+	m.source_warehouse = nil
+	m.clearedsource_warehouse = false
+}
+
+// SetDestinationWarehouseID sets the "destination_warehouse" edge to the Warehouse entity by id.
+func (m *OrderMutation) SetDestinationWarehouseID(id int) {
+	m.destination_warehouse = &id
+}
+
+// ClearDestinationWarehouse clears the "destination_warehouse" edge to the Warehouse entity.
+func (m *OrderMutation) ClearDestinationWarehouse() {
+	m.cleareddestination_warehouse = true
+}
+
+// DestinationWarehouseCleared reports if the "destination_warehouse" edge to the Warehouse entity was cleared.
+func (m *OrderMutation) DestinationWarehouseCleared() bool {
+	return m.cleareddestination_warehouse
+}
+
+// DestinationWarehouseID returns the "destination_warehouse" edge ID in the mutation.
+func (m *OrderMutation) DestinationWarehouseID() (id int, exists bool) {
+	if m.destination_warehouse != nil {
+		return *m.destination_warehouse, true
+	}
+	return
+}
+
+// DestinationWarehouseIDs returns the "destination_warehouse" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DestinationWarehouseID instead. It exists only for internal usage by the builders.
+func (m *OrderMutation) DestinationWarehouseIDs() (ids []int) {
+	if id := m.destination_warehouse; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDestinationWarehouse resets all changes to the "destination_warehouse" edge.
+func (m *OrderMutation) ResetDestinationWarehouse() {
+	m.destination_warehouse = nil
+	m.cleareddestination_warehouse = false
+}
+
+// SetWaveID sets the "wave" edge to the Wave entity by id.
+func (m *OrderMutation) SetWaveID(id int) {
+	m.wave = &id
+}
+
+// ClearWave clears the "wave" edge to the Wave entity.
+func (m *OrderMutation) ClearWave() {
+	m.clearedwave = true
+}
+
+// WaveCleared reports if the "wave" edge to the Wave entity was cleared.
+func (m *OrderMutation) WaveCleared() bool {
+	return m.clearedwave
+}
+
+// WaveID returns the "wave" edge ID in the mutation.
+func (m *OrderMutation) WaveID() (id int, exists bool) {
+	if m.wave != nil {
+		return *m.wave, true
+	}
+	return
+}
+
+// WaveIDs returns the "wave" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WaveID instead. It exists only for internal usage by the builders.
+func (m *OrderMutation) WaveIDs() (ids []int) {
+	if id := m.wave; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWave resets all changes to the "wave" edge.
+func (m *OrderMutation) ResetWave() {
+	m.wave = nil
+	m.clearedwave = false
+}
+
+// Where appends a list predicates to the OrderMutation builder.
+func (m *OrderMutation) Where(ps ...predicate.Order) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OrderMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OrderMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Order, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OrderMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OrderMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Order).
+func (m *OrderMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OrderMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.order_number != nil {
+		fields = append(fields, order.FieldOrderNumber)
+	}
+	if m._type != nil {
+		fields = append(fields, order.FieldType)
+	}
+	if m.status != nil {
+		fields = append(fields, order.FieldStatus)
+	}
+	if m.created_at != nil {
+		fields = append(fields, order.FieldCreatedAt)
+	}
+	if m.priority != nil {
+		fields = append(fields, order.FieldPriority)
+	}
+	if m.ship_by != nil {
+		fields = append(fields, order.FieldShipBy)
+	}
+	if m.posted_at != nil {
+		fields = append(fields, order.FieldPostedAt)
+	}
+	if m.reversed_at != nil {
+		fields = append(fields, order.FieldReversedAt)
+	}
+	if m.reversal_reason != nil {
+		fields = append(fields, order.FieldReversalReason)
+	}
+	if m.idempotency_key != nil {
+		fields = append(fields, order.FieldIdempotencyKey)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OrderMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case order.FieldOrderNumber:
+		return m.OrderNumber()
+	case order.FieldType:
+		return m.GetType()
+	case order.FieldStatus:
+		return m.Status()
+	case order.FieldCreatedAt:
+		return m.CreatedAt()
+	case order.FieldPriority:
+		return m.Priority()
+	case order.FieldShipBy:
+		return m.ShipBy()
+	case order.FieldPostedAt:
+		return m.PostedAt()
+	case order.FieldReversedAt:
+		return m.ReversedAt()
+	case order.FieldReversalReason:
+		return m.ReversalReason()
+	case order.FieldIdempotencyKey:
+		return m.IdempotencyKey()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OrderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case order.FieldOrderNumber:
+		return m.OldOrderNumber(ctx)
+	case order.FieldType:
+		return m.OldType(ctx)
+	case order.FieldStatus:
+		return m.OldStatus(ctx)
+	case order.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case order.FieldPriority:
+		return m.OldPriority(ctx)
+	case order.FieldShipBy:
+		return m.OldShipBy(ctx)
+	case order.FieldPostedAt:
+		return m.OldPostedAt(ctx)
+	case order.FieldReversedAt:
+		return m.OldReversedAt(ctx)
+	case order.FieldReversalReason:
+		return m.OldReversalReason(ctx)
+	case order.FieldIdempotencyKey:
+		return m.OldIdempotencyKey(ctx)
+	}
+	return nil, fmt.Errorf("unknown Order field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrderMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case order.FieldOrderNumber:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrderNumber(v)
+		return nil
+	case order.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case order.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case order.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case order.FieldPriority:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
+	case order.FieldShipBy:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShipBy(v)
+		return nil
+	case order.FieldPostedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPostedAt(v)
+		return nil
+	case order.FieldReversedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReversedAt(v)
+		return nil
+	case order.FieldReversalReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReversalReason(v)
+		return nil
+	case order.FieldIdempotencyKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIdempotencyKey(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Order field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OrderMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OrderMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrderMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Order numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OrderMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(order.FieldShipBy) {
+		fields = append(fields, order.FieldShipBy)
+	}
+	if m.FieldCleared(order.FieldPostedAt) {
+		fields = append(fields, order.FieldPostedAt)
+	}
+	if m.FieldCleared(order.FieldReversedAt) {
+		fields = append(fields, order.FieldReversedAt)
+	}
+	if m.FieldCleared(order.FieldReversalReason) {
+		fields = append(fields, order.FieldReversalReason)
+	}
+	if m.FieldCleared(order.FieldIdempotencyKey) {
+		fields = append(fields, order.FieldIdempotencyKey)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OrderMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OrderMutation) ClearField(name string) error {
+	switch name {
+	case order.FieldShipBy:
+		m.ClearShipBy()
+		return nil
+	case order.FieldPostedAt:
+		m.ClearPostedAt()
+		return nil
+	case order.FieldReversedAt:
+		m.ClearReversedAt()
+		return nil
+	case order.FieldReversalReason:
+		m.ClearReversalReason()
+		return nil
+	case order.FieldIdempotencyKey:
+		m.ClearIdempotencyKey()
+		return nil
+	}
+	return fmt.Errorf("unknown Order nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OrderMutation) ResetField(name string) error {
+	switch name {
+	case order.FieldOrderNumber:
+		m.ResetOrderNumber()
+		return nil
+	case order.FieldType:
+		m.ResetType()
+		return nil
+	case order.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case order.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case order.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case order.FieldShipBy:
+		m.ResetShipBy()
+		return nil
+	case order.FieldPostedAt:
+		m.ResetPostedAt()
+		return nil
+	case order.FieldReversedAt:
+		m.ResetReversedAt()
+		return nil
+	case order.FieldReversalReason:
+		m.ResetReversalReason()
+		return nil
+	case order.FieldIdempotencyKey:
+		m.ResetIdempotencyKey()
+		return nil
+	}
+	return fmt.Errorf("unknown Order field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OrderMutation) AddedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.lines != nil {
+		edges = append(edges, order.EdgeLines)
+	}
+	if m.picklist != nil {
+		edges = append(edges, order.EdgePicklist)
+	}
+	if m.tracking != nil {
+		edges = append(edges, order.EdgeTracking)
+	}
+	if m.cartons != nil {
+		edges = append(edges, order.EdgeCartons)
+	}
+	if m.return_of != nil {
+		edges = append(edges, order.EdgeReturnOf)
+	}
+	if m.returns != nil {
+		edges = append(edges, order.EdgeReturns)
+	}
+	if m.source_warehouse != nil {
+		edges = append(edges, order.EdgeSourceWarehouse)
+	}
+	if m.destination_warehouse != nil {
+		edges = append(edges, order.EdgeDestinationWarehouse)
+	}
+	if m.wave != nil {
+		edges = append(edges, order.EdgeWave)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OrderMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case order.EdgeLines:
+		ids := make([]ent.Value, 0, len(m.lines))
+		for id := range m.lines {
+			ids = append(ids, id)
+		}
+		return ids
+	case order.EdgePicklist:
+		if id := m.picklist; id != nil {
+			return []ent.Value{*id}
+		}
+	case order.EdgeTracking:
+		if id := m.tracking; id != nil {
+			return []ent.Value{*id}
+		}
+	case order.EdgeCartons:
+		ids := make([]ent.Value, 0, len(m.cartons))
+		for id := range m.cartons {
+			ids = append(ids, id)
+		}
+		return ids
+	case order.EdgeReturnOf:
+		if id := m.return_of; id != nil {
+			return []ent.Value{*id}
+		}
+	case order.EdgeReturns:
+		ids := make([]ent.Value, 0, len(m.returns))
+		for id := range m.returns {
+			ids = append(ids, id)
+		}
+		return ids
+	case order.EdgeSourceWarehouse:
+		if id := m.source_warehouse; id != nil {
+			return []ent.Value{*id}
+		}
+	case order.EdgeDestinationWarehouse:
+		if id := m.destination_warehouse; id != nil {
+			return []ent.Value{*id}
+		}
+	case order.EdgeWave:
+		if id := m.wave; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OrderMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.removedlines != nil {
+		edges = append(edges, order.EdgeLines)
+	}
+	if m.removedcartons != nil {
+		edges = append(edges, order.EdgeCartons)
+	}
+	if m.removedreturns != nil {
+		edges = append(edges, order.EdgeReturns)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OrderMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case order.EdgeLines:
+		ids := make([]ent.Value, 0, len(m.removedlines))
+		for id := range m.removedlines {
+			ids = append(ids, id)
+		}
+		return ids
+	case order.EdgeCartons:
+		ids := make([]ent.Value, 0, len(m.removedcartons))
+		for id := range m.removedcartons {
+			ids = append(ids, id)
+		}
+		return ids
+	case order.EdgeReturns:
+		ids := make([]ent.Value, 0, len(m.removedreturns))
+		for id := range m.removedreturns {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OrderMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.clearedlines {
+		edges = append(edges, order.EdgeLines)
+	}
+	if m.clearedpicklist {
+		edges = append(edges, order.EdgePicklist)
+	}
+	if m.clearedtracking {
+		edges = append(edges, order.EdgeTracking)
+	}
+	if m.clearedcartons {
+		edges = append(edges, order.EdgeCartons)
+	}
+	if m.clearedreturn_of {
+		edges = append(edges, order.EdgeReturnOf)
+	}
+	if m.clearedreturns {
+		edges = append(edges, order.EdgeReturns)
+	}
+	if m.clearedsource_warehouse {
+		edges = append(edges, order.EdgeSourceWarehouse)
+	}
+	if m.cleareddestination_warehouse {
+		edges = append(edges, order.EdgeDestinationWarehouse)
+	}
+	if m.clearedwave {
+		edges = append(edges, order.EdgeWave)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OrderMutation) EdgeCleared(name string) bool {
+	switch name {
+	case order.EdgeLines:
+		return m.clearedlines
+	case order.EdgePicklist:
+		return m.clearedpicklist
+	case order.EdgeTracking:
+		return m.clearedtracking
+	case order.EdgeCartons:
+		return m.clearedcartons
+	case order.EdgeReturnOf:
+		return m.clearedreturn_of
+	case order.EdgeReturns:
+		return m.clearedreturns
+	case order.EdgeSourceWarehouse:
+		return m.clearedsource_warehouse
+	case order.EdgeDestinationWarehouse:
+		return m.cleareddestination_warehouse
+	case order.EdgeWave:
+		return m.clearedwave
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OrderMutation) ClearEdge(name string) error {
+	switch name {
+	case order.EdgePicklist:
+		m.ClearPicklist()
+		return nil
+	case order.EdgeTracking:
+		m.ClearTracking()
+		return nil
+	case order.EdgeReturnOf:
+		m.ClearReturnOf()
+		return nil
+	case order.EdgeSourceWarehouse:
+		m.ClearSourceWarehouse()
+		return nil
+	case order.EdgeDestinationWarehouse:
+		m.ClearDestinationWarehouse()
+		return nil
+	case order.EdgeWave:
+		m.ClearWave()
+		return nil
+	}
+	return fmt.Errorf("unknown Order unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OrderMutation) ResetEdge(name string) error {
+	switch name {
+	case order.EdgeLines:
+		m.ResetLines()
+		return nil
+	case order.EdgePicklist:
+		m.ResetPicklist()
+		return nil
+	case order.EdgeTracking:
+		m.ResetTracking()
+		return nil
+	case order.EdgeCartons:
+		m.ResetCartons()
+		return nil
+	case order.EdgeReturnOf:
+		m.ResetReturnOf()
+		return nil
+	case order.EdgeReturns:
+		m.ResetReturns()
+		return nil
+	case order.EdgeSourceWarehouse:
+		m.ResetSourceWarehouse()
+		return nil
+	case order.EdgeDestinationWarehouse:
+		m.ResetDestinationWarehouse()
+		return nil
+	case order.EdgeWave:
+		m.ResetWave()
+		return nil
+	}
+	return fmt.Errorf("unknown Order edge %s", name)
+}
+
+// OrderLineMutation represents an operation that mutates the OrderLine nodes in the graph.
+type OrderLineMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	quantity          *int
+	addquantity       *int
+	condition         *string
+	disposition       *string
+	inspected_at      *time.Time
+	clearedFields     map[string]struct{}
+	_order            *int
+	cleared_order     bool
+	item              *int
+	cleareditem       bool
+	location          *int
+	clearedlocation   bool
+	pick_tasks        map[int]struct{}
+	removedpick_tasks map[int]struct{}
+	clearedpick_tasks bool
+	receipts          map[int]struct{}
+	removedreceipts   map[int]struct{}
+	clearedreceipts   bool
+	done              bool
+	oldValue          func(context.Context) (*OrderLine, error)
+	predicates        []predicate.OrderLine
+}
+
+var _ ent.Mutation = (*OrderLineMutation)(nil)
+
+// orderlineOption allows management of the mutation configuration using functional options.
+type orderlineOption func(*OrderLineMutation)
+
+// newOrderLineMutation creates new mutation for the OrderLine entity.
+func newOrderLineMutation(c config, op Op, opts ...orderlineOption) *OrderLineMutation {
+	m := &OrderLineMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOrderLine,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOrderLineID sets the ID field of the mutation.
+func withOrderLineID(id int) orderlineOption {
+	return func(m *OrderLineMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OrderLine
+		)
+		m.oldValue = func(ctx context.Context) (*OrderLine, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OrderLine.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOrderLine sets the old OrderLine of the mutation.
+func withOrderLine(node *OrderLine) orderlineOption {
+	return func(m *OrderLineMutation) {
+		m.oldValue = func(context.Context) (*OrderLine, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OrderLineMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OrderLineMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OrderLineMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OrderLineMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OrderLine.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetQuantity sets the "quantity" field.
+func (m *OrderLineMutation) SetQuantity(i int) {
+	m.quantity = &i
+	m.addquantity = nil
+}
+
+// Quantity returns the value of the "quantity" field in the mutation.
+func (m *OrderLineMutation) Quantity() (r int, exists bool) {
+	v := m.quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuantity returns the old "quantity" field's value of the OrderLine entity.
+// If the OrderLine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderLineMutation) OldQuantity(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
+	}
+	return oldValue.Quantity, nil
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (m *OrderLineMutation) AddQuantity(i int) {
+	if m.addquantity != nil {
+		*m.addquantity += i
+	} else {
+		m.addquantity = &i
+	}
+}
+
+// AddedQuantity returns the value that was added to the "quantity" field in this mutation.
+func (m *OrderLineMutation) AddedQuantity() (r int, exists bool) {
+	v := m.addquantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetQuantity resets all changes to the "quantity" field.
+func (m *OrderLineMutation) ResetQuantity() {
+	m.quantity = nil
+	m.addquantity = nil
+}
+
+// SetCondition sets the "condition" field.
+func (m *OrderLineMutation) SetCondition(s string) {
+	m.condition = &s
+}
+
+// Condition returns the value of the "condition" field in the mutation.
+func (m *OrderLineMutation) Condition() (r string, exists bool) {
+	v := m.condition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCondition returns the old "condition" field's value of the OrderLine entity.
+// If the OrderLine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderLineMutation) OldCondition(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCondition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCondition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCondition: %w", err)
+	}
+	return oldValue.Condition, nil
+}
+
+// ClearCondition clears the value of the "condition" field.
+func (m *OrderLineMutation) ClearCondition() {
+	m.condition = nil
+	m.clearedFields[orderline.FieldCondition] = struct{}{}
+}
+
+// ConditionCleared returns if the "condition" field was cleared in this mutation.
+func (m *OrderLineMutation) ConditionCleared() bool {
+	_, ok := m.clearedFields[orderline.FieldCondition]
+	return ok
+}
+
+// ResetCondition resets all changes to the "condition" field.
+func (m *OrderLineMutation) ResetCondition() {
+	m.condition = nil
+	delete(m.clearedFields, orderline.FieldCondition)
+}
+
+// SetDisposition sets the "disposition" field.
+func (m *OrderLineMutation) SetDisposition(s string) {
+	m.disposition = &s
+}
+
+// Disposition returns the value of the "disposition" field in the mutation.
+func (m *OrderLineMutation) Disposition() (r string, exists bool) {
+	v := m.disposition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisposition returns the old "disposition" field's value of the OrderLine entity.
+// If the OrderLine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderLineMutation) OldDisposition(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisposition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisposition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisposition: %w", err)
+	}
+	return oldValue.Disposition, nil
+}
+
+// ClearDisposition clears the value of the "disposition" field.
+func (m *OrderLineMutation) ClearDisposition() {
+	m.disposition = nil
+	m.clearedFields[orderline.FieldDisposition] = struct{}{}
+}
+
+// DispositionCleared returns if the "disposition" field was cleared in this mutation.
+func (m *OrderLineMutation) DispositionCleared() bool {
+	_, ok := m.clearedFields[orderline.FieldDisposition]
+	return ok
+}
+
+// ResetDisposition resets all changes to the "disposition" field.
+func (m *OrderLineMutation) ResetDisposition() {
+	m.disposition = nil
+	delete(m.clearedFields, orderline.FieldDisposition)
+}
+
+// SetInspectedAt sets the "inspected_at" field.
+func (m *OrderLineMutation) SetInspectedAt(t time.Time) {
+	m.inspected_at = &t
+}
+
+// InspectedAt returns the value of the "inspected_at" field in the mutation.
+func (m *OrderLineMutation) InspectedAt() (r time.Time, exists bool) {
+	v := m.inspected_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInspectedAt returns the old "inspected_at" field's value of the OrderLine entity.
+// If the OrderLine object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderLineMutation) OldInspectedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInspectedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInspectedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInspectedAt: %w", err)
+	}
+	return oldValue.InspectedAt, nil
+}
+
+// ClearInspectedAt clears the value of the "inspected_at" field.
+func (m *OrderLineMutation) ClearInspectedAt() {
+	m.inspected_at = nil
+	m.clearedFields[orderline.FieldInspectedAt] = struct{}{}
+}
+
+// InspectedAtCleared returns if the "inspected_at" field was cleared in this mutation.
+func (m *OrderLineMutation) InspectedAtCleared() bool {
+	_, ok := m.clearedFields[orderline.FieldInspectedAt]
+	return ok
+}
+
+// ResetInspectedAt resets all changes to the "inspected_at" field.
+func (m *OrderLineMutation) ResetInspectedAt() {
+	m.inspected_at = nil
+	delete(m.clearedFields, orderline.FieldInspectedAt)
+}
+
+// SetOrderID sets the "order" edge to the Order entity by id.
+func (m *OrderLineMutation) SetOrderID(id int) {
+	m._order = &id
+}
+
+// ClearOrder clears the "order" edge to the Order entity.
+func (m *OrderLineMutation) ClearOrder() {
+	m.cleared_order = true
+}
+
+// OrderCleared reports if the "order" edge to the Order entity was cleared.
+func (m *OrderLineMutation) OrderCleared() bool {
+	return m.cleared_order
+}
+
+// OrderID returns the "order" edge ID in the mutation.
+func (m *OrderLineMutation) OrderID() (id int, exists bool) {
+	if m._order != nil {
+		return *m._order, true
+	}
+	return
+}
+
+// OrderIDs returns the "order" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrderID instead. It exists only for internal usage by the builders.
+func (m *OrderLineMutation) OrderIDs() (ids []int) {
+	if id := m._order; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrder resets all changes to the "order" edge.
+func (m *OrderLineMutation) ResetOrder() {
+	m._order = nil
+	m.cleared_order = false
+}
+
+// SetItemID sets the "item" edge to the Item entity by id.
+func (m *OrderLineMutation) SetItemID(id int) {
+	m.item = &id
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (m *OrderLineMutation) ClearItem() {
+	m.cleareditem = true
+}
+
+// ItemCleared reports if the "item" edge to the Item entity was cleared.
+func (m *OrderLineMutation) ItemCleared() bool {
+	return m.cleareditem
+}
+
+// ItemID returns the "item" edge ID in the mutation.
+func (m *OrderLineMutation) ItemID() (id int, exists bool) {
+	if m.item != nil {
+		return *m.item, true
+	}
+	return
+}
+
+// ItemIDs returns the "item" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ItemID instead. It exists only for internal usage by the builders.
+func (m *OrderLineMutation) ItemIDs() (ids []int) {
+	if id := m.item; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetItem resets all changes to the "item" edge.
+func (m *OrderLineMutation) ResetItem() {
+	m.item = nil
+	m.cleareditem = false
+}
+
+// SetLocationID sets the "location" edge to the Location entity by id.
+func (m *OrderLineMutation) SetLocationID(id int) {
+	m.location = &id
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (m *OrderLineMutation) ClearLocation() {
+	m.clearedlocation = true
+}
+
+// LocationCleared reports if the "location" edge to the Location entity was cleared.
+func (m *OrderLineMutation) LocationCleared() bool {
+	return m.clearedlocation
+}
+
+// LocationID returns the "location" edge ID in the mutation.
+func (m *OrderLineMutation) LocationID() (id int, exists bool) {
+	if m.location != nil {
+		return *m.location, true
+	}
+	return
+}
+
+// LocationIDs returns the "location" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LocationID instead. It exists only for internal usage by the builders.
+func (m *OrderLineMutation) LocationIDs() (ids []int) {
+	if id := m.location; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLocation resets all changes to the "location" edge.
+func (m *OrderLineMutation) ResetLocation() {
+	m.location = nil
+	m.clearedlocation = false
+}
+
+// AddPickTaskIDs adds the "pick_tasks" edge to the PickTask entity by ids.
+func (m *OrderLineMutation) AddPickTaskIDs(ids ...int) {
+	if m.pick_tasks == nil {
+		m.pick_tasks = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.pick_tasks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPickTasks clears the "pick_tasks" edge to the PickTask entity.
+func (m *OrderLineMutation) ClearPickTasks() {
+	m.clearedpick_tasks = true
+}
+
+// PickTasksCleared reports if the "pick_tasks" edge to the PickTask entity was cleared.
+func (m *OrderLineMutation) PickTasksCleared() bool {
+	return m.clearedpick_tasks
+}
+
+// RemovePickTaskIDs removes the "pick_tasks" edge to the PickTask entity by IDs.
+func (m *OrderLineMutation) RemovePickTaskIDs(ids ...int) {
+	if m.removedpick_tasks == nil {
+		m.removedpick_tasks = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.pick_tasks, ids[i])
+		m.removedpick_tasks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPickTasks returns the removed IDs of the "pick_tasks" edge to the PickTask entity.
+func (m *OrderLineMutation) RemovedPickTasksIDs() (ids []int) {
+	for id := range m.removedpick_tasks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PickTasksIDs returns the "pick_tasks" edge IDs in the mutation.
+func (m *OrderLineMutation) PickTasksIDs() (ids []int) {
+	for id := range m.pick_tasks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPickTasks resets all changes to the "pick_tasks" edge.
+func (m *OrderLineMutation) ResetPickTasks() {
+	m.pick_tasks = nil
+	m.clearedpick_tasks = false
+	m.removedpick_tasks = nil
+}
+
+// AddReceiptIDs adds the "receipts" edge to the Receipt entity by ids.
+func (m *OrderLineMutation) AddReceiptIDs(ids ...int) {
+	if m.receipts == nil {
+		m.receipts = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.receipts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReceipts clears the "receipts" edge to the Receipt entity.
+func (m *OrderLineMutation) ClearReceipts() {
+	m.clearedreceipts = true
+}
+
+// ReceiptsCleared reports if the "receipts" edge to the Receipt entity was cleared.
+func (m *OrderLineMutation) ReceiptsCleared() bool {
+	return m.clearedreceipts
+}
+
+// RemoveReceiptIDs removes the "receipts" edge to the Receipt entity by IDs.
+func (m *OrderLineMutation) RemoveReceiptIDs(ids ...int) {
+	if m.removedreceipts == nil {
+		m.removedreceipts = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.receipts, ids[i])
+		m.removedreceipts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReceipts returns the removed IDs of the "receipts" edge to the Receipt entity.
+func (m *OrderLineMutation) RemovedReceiptsIDs() (ids []int) {
+	for id := range m.removedreceipts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReceiptsIDs returns the "receipts" edge IDs in the mutation.
+func (m *OrderLineMutation) ReceiptsIDs() (ids []int) {
+	for id := range m.receipts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReceipts resets all changes to the "receipts" edge.
+func (m *OrderLineMutation) ResetReceipts() {
+	m.receipts = nil
+	m.clearedreceipts = false
+	m.removedreceipts = nil
+}
+
+// Where appends a list predicates to the OrderLineMutation builder.
+func (m *OrderLineMutation) Where(ps ...predicate.OrderLine) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OrderLineMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OrderLineMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OrderLine, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OrderLineMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OrderLineMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OrderLine).
+func (m *OrderLineMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OrderLineMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.quantity != nil {
+		fields = append(fields, orderline.FieldQuantity)
+	}
+	if m.condition != nil {
+		fields = append(fields, orderline.FieldCondition)
+	}
+	if m.disposition != nil {
+		fields = append(fields, orderline.FieldDisposition)
+	}
+	if m.inspected_at != nil {
+		fields = append(fields, orderline.FieldInspectedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OrderLineMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case orderline.FieldQuantity:
+		return m.Quantity()
+	case orderline.FieldCondition:
+		return m.Condition()
+	case orderline.FieldDisposition:
+		return m.Disposition()
+	case orderline.FieldInspectedAt:
+		return m.InspectedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OrderLineMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case orderline.FieldQuantity:
+		return m.OldQuantity(ctx)
+	case orderline.FieldCondition:
+		return m.OldCondition(ctx)
+	case orderline.FieldDisposition:
+		return m.OldDisposition(ctx)
+	case orderline.FieldInspectedAt:
+		return m.OldInspectedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown OrderLine field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrderLineMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case orderline.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuantity(v)
+		return nil
+	case orderline.FieldCondition:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCondition(v)
+		return nil
+	case orderline.FieldDisposition:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisposition(v)
+		return nil
+	case orderline.FieldInspectedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInspectedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OrderLine field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OrderLineMutation) AddedFields() []string {
+	var fields []string
+	if m.addquantity != nil {
+		fields = append(fields, orderline.FieldQuantity)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OrderLineMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case orderline.FieldQuantity:
+		return m.AddedQuantity()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrderLineMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case orderline.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuantity(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OrderLine numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OrderLineMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(orderline.FieldCondition) {
+		fields = append(fields, orderline.FieldCondition)
+	}
+	if m.FieldCleared(orderline.FieldDisposition) {
+		fields = append(fields, orderline.FieldDisposition)
+	}
+	if m.FieldCleared(orderline.FieldInspectedAt) {
+		fields = append(fields, orderline.FieldInspectedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OrderLineMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OrderLineMutation) ClearField(name string) error {
+	switch name {
+	case orderline.FieldCondition:
+		m.ClearCondition()
+		return nil
+	case orderline.FieldDisposition:
+		m.ClearDisposition()
+		return nil
+	case orderline.FieldInspectedAt:
+		m.ClearInspectedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown OrderLine nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OrderLineMutation) ResetField(name string) error {
+	switch name {
+	case orderline.FieldQuantity:
+		m.ResetQuantity()
+		return nil
+	case orderline.FieldCondition:
+		m.ResetCondition()
+		return nil
+	case orderline.FieldDisposition:
+		m.ResetDisposition()
+		return nil
+	case orderline.FieldInspectedAt:
+		m.ResetInspectedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown OrderLine field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OrderLineMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m._order != nil {
+		edges = append(edges, orderline.EdgeOrder)
+	}
+	if m.item != nil {
+		edges = append(edges, orderline.EdgeItem)
+	}
+	if m.location != nil {
+		edges = append(edges, orderline.EdgeLocation)
+	}
+	if m.pick_tasks != nil {
+		edges = append(edges, orderline.EdgePickTasks)
+	}
+	if m.receipts != nil {
+		edges = append(edges, orderline.EdgeReceipts)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OrderLineMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case orderline.EdgeOrder:
+		if id := m._order; id != nil {
+			return []ent.Value{*id}
+		}
+	case orderline.EdgeItem:
+		if id := m.item; id != nil {
+			return []ent.Value{*id}
+		}
+	case orderline.EdgeLocation:
+		if id := m.location; id != nil {
+			return []ent.Value{*id}
+		}
+	case orderline.EdgePickTasks:
+		ids := make([]ent.Value, 0, len(m.pick_tasks))
+		for id := range m.pick_tasks {
+			ids = append(ids, id)
+		}
+		return ids
+	case orderline.EdgeReceipts:
+		ids := make([]ent.Value, 0, len(m.receipts))
+		for id := range m.receipts {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OrderLineMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedpick_tasks != nil {
+		edges = append(edges, orderline.EdgePickTasks)
+	}
+	if m.removedreceipts != nil {
+		edges = append(edges, orderline.EdgeReceipts)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OrderLineMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case orderline.EdgePickTasks:
+		ids := make([]ent.Value, 0, len(m.removedpick_tasks))
+		for id := range m.removedpick_tasks {
+			ids = append(ids, id)
+		}
+		return ids
+	case orderline.EdgeReceipts:
+		ids := make([]ent.Value, 0, len(m.removedreceipts))
+		for id := range m.removedreceipts {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OrderLineMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.cleared_order {
+		edges = append(edges, orderline.EdgeOrder)
+	}
+	if m.cleareditem {
+		edges = append(edges, orderline.EdgeItem)
+	}
+	if m.clearedlocation {
+		edges = append(edges, orderline.EdgeLocation)
+	}
+	if m.clearedpick_tasks {
+		edges = append(edges, orderline.EdgePickTasks)
+	}
+	if m.clearedreceipts {
+		edges = append(edges, orderline.EdgeReceipts)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OrderLineMutation) EdgeCleared(name string) bool {
+	switch name {
+	case orderline.EdgeOrder:
+		return m.cleared_order
+	case orderline.EdgeItem:
+		return m.cleareditem
+	case orderline.EdgeLocation:
+		return m.clearedlocation
+	case orderline.EdgePickTasks:
+		return m.clearedpick_tasks
+	case orderline.EdgeReceipts:
+		return m.clearedreceipts
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OrderLineMutation) ClearEdge(name string) error {
+	switch name {
+	case orderline.EdgeOrder:
+		m.ClearOrder()
+		return nil
+	case orderline.EdgeItem:
+		m.ClearItem()
+		return nil
+	case orderline.EdgeLocation:
+		m.ClearLocation()
+		return nil
+	}
+	return fmt.Errorf("unknown OrderLine unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OrderLineMutation) ResetEdge(name string) error {
+	switch name {
+	case orderline.EdgeOrder:
+		m.ResetOrder()
+		return nil
+	case orderline.EdgeItem:
+		m.ResetItem()
+		return nil
+	case orderline.EdgeLocation:
+		m.ResetLocation()
+		return nil
+	case orderline.EdgePickTasks:
+		m.ResetPickTasks()
+		return nil
+	case orderline.EdgeReceipts:
+		m.ResetReceipts()
+		return nil
+	}
+	return fmt.Errorf("unknown OrderLine edge %s", name)
+}
+
+// PickListMutation represents an operation that mutates the PickList nodes in the graph.
+type PickListMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	number        *string
+	status        *string
+	assigned_to   *string
+	assigned_at   *time.Time
+	created_at    *time.Time
+	started_at    *time.Time
+	done_at       *time.Time
+	clearedFields map[string]struct{}
+	_order        *int
+	cleared_order bool
+	tasks         map[int]struct{}
+	removedtasks  map[int]struct{}
+	clearedtasks  bool
+	done          bool
+	oldValue      func(context.Context) (*PickList, error)
+	predicates    []predicate.PickList
+}
+
+var _ ent.Mutation = (*PickListMutation)(nil)
+
+// picklistOption allows management of the mutation configuration using functional options.
+type picklistOption func(*PickListMutation)
+
+// newPickListMutation creates new mutation for the PickList entity.
+func newPickListMutation(c config, op Op, opts ...picklistOption) *PickListMutation {
+	m := &PickListMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePickList,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPickListID sets the ID field of the mutation.
+func withPickListID(id int) picklistOption {
+	return func(m *PickListMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PickList
+		)
+		m.oldValue = func(ctx context.Context) (*PickList, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PickList.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPickList sets the old PickList of the mutation.
+func withPickList(node *PickList) picklistOption {
+	return func(m *PickListMutation) {
+		m.oldValue = func(context.Context) (*PickList, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PickListMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PickListMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PickListMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PickListMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PickList.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetNumber sets the "number" field.
+func (m *PickListMutation) SetNumber(s string) {
+	m.number = &s
+}
+
+// Number returns the value of the "number" field in the mutation.
+func (m *PickListMutation) Number() (r string, exists bool) {
+	v := m.number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNumber returns the old "number" field's value of the PickList entity.
+// If the PickList object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PickListMutation) OldNumber(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNumber: %w", err)
+	}
+	return oldValue.Number, nil
+}
+
+// ClearNumber clears the value of the "number" field.
+func (m *PickListMutation) ClearNumber() {
+	m.number = nil
+	m.clearedFields[picklist.FieldNumber] = struct{}{}
+}
+
+// NumberCleared returns if the "number" field was cleared in this mutation.
+func (m *PickListMutation) NumberCleared() bool {
+	_, ok := m.clearedFields[picklist.FieldNumber]
+	return ok
+}
+
+// ResetNumber resets all changes to the "number" field.
+func (m *PickListMutation) ResetNumber() {
+	m.number = nil
+	delete(m.clearedFields, picklist.FieldNumber)
+}
+
+// SetStatus sets the "status" field.
+func (m *PickListMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *PickListMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the PickList entity.
+// If the PickList object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PickListMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *PickListMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetAssignedTo sets the "assigned_to" field.
+func (m *PickListMutation) SetAssignedTo(s string) {
+	m.assigned_to = &s
+}
+
+// AssignedTo returns the value of the "assigned_to" field in the mutation.
+func (m *PickListMutation) AssignedTo() (r string, exists bool) {
+	v := m.assigned_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAssignedTo returns the old "assigned_to" field's value of the PickList entity.
+// If the PickList object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PickListMutation) OldAssignedTo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAssignedTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAssignedTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssignedTo: %w", err)
+	}
+	return oldValue.AssignedTo, nil
+}
+
+// ClearAssignedTo clears the value of the "assigned_to" field.
+func (m *PickListMutation) ClearAssignedTo() {
+	m.assigned_to = nil
+	m.clearedFields[picklist.FieldAssignedTo] = struct{}{}
+}
+
+// AssignedToCleared returns if the "assigned_to" field was cleared in this mutation.
+func (m *PickListMutation) AssignedToCleared() bool {
+	_, ok := m.clearedFields[picklist.FieldAssignedTo]
+	return ok
+}
+
+// ResetAssignedTo resets all changes to the "assigned_to" field.
+func (m *PickListMutation) ResetAssignedTo() {
+	m.assigned_to = nil
+	delete(m.clearedFields, picklist.FieldAssignedTo)
+}
+
+// SetAssignedAt sets the "assigned_at" field.
+func (m *PickListMutation) SetAssignedAt(t time.Time) {
+	m.assigned_at = &t
+}
+
+// AssignedAt returns the value of the "assigned_at" field in the mutation.
+func (m *PickListMutation) AssignedAt() (r time.Time, exists bool) {
+	v := m.assigned_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAssignedAt returns the old "assigned_at" field's value of the PickList entity.
+// If the PickList object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PickListMutation) OldAssignedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAssignedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAssignedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssignedAt: %w", err)
+	}
+	return oldValue.AssignedAt, nil
+}
+
+// ClearAssignedAt clears the value of the "assigned_at" field.
+func (m *PickListMutation) ClearAssignedAt() {
+	m.assigned_at = nil
+	m.clearedFields[picklist.FieldAssignedAt] = struct{}{}
+}
+
+// AssignedAtCleared returns if the "assigned_at" field was cleared in this mutation.
+func (m *PickListMutation) AssignedAtCleared() bool {
+	_, ok := m.clearedFields[picklist.FieldAssignedAt]
+	return ok
+}
+
+// ResetAssignedAt resets all changes to the "assigned_at" field.
+func (m *PickListMutation) ResetAssignedAt() {
+	m.assigned_at = nil
+	delete(m.clearedFields, picklist.FieldAssignedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PickListMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PickListMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PickList entity.
+// If the PickList object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PickListMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PickListMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *PickListMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *PickListMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the PickList entity.
+// If the PickList object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PickListMutation) OldStartedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (m *PickListMutation) ClearStartedAt() {
+	m.started_at = nil
+	m.clearedFields[picklist.FieldStartedAt] = struct{}{}
+}
+
+// StartedAtCleared returns if the "started_at" field was cleared in this mutation.
+func (m *PickListMutation) StartedAtCleared() bool {
+	_, ok := m.clearedFields[picklist.FieldStartedAt]
+	return ok
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *PickListMutation) ResetStartedAt() {
+	m.started_at = nil
+	delete(m.clearedFields, picklist.FieldStartedAt)
+}
+
+// SetDoneAt sets the "done_at" field.
+func (m *PickListMutation) SetDoneAt(t time.Time) {
+	m.done_at = &t
+}
+
+// DoneAt returns the value of the "done_at" field in the mutation.
+func (m *PickListMutation) DoneAt() (r time.Time, exists bool) {
+	v := m.done_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDoneAt returns the old "done_at" field's value of the PickList entity.
+// If the PickList object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PickListMutation) OldDoneAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDoneAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDoneAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDoneAt: %w", err)
+	}
+	return oldValue.DoneAt, nil
+}
+
+// ClearDoneAt clears the value of the "done_at" field.
+func (m *PickListMutation) ClearDoneAt() {
+	m.done_at = nil
+	m.clearedFields[picklist.FieldDoneAt] = struct{}{}
+}
+
+// DoneAtCleared returns if the "done_at" field was cleared in this mutation.
+func (m *PickListMutation) DoneAtCleared() bool {
+	_, ok := m.clearedFields[picklist.FieldDoneAt]
+	return ok
+}
+
+// ResetDoneAt resets all changes to the "done_at" field.
+func (m *PickListMutation) ResetDoneAt() {
+	m.done_at = nil
+	delete(m.clearedFields, picklist.FieldDoneAt)
+}
+
+// SetOrderID sets the "order" edge to the Order entity by id.
+func (m *PickListMutation) SetOrderID(id int) {
+	m._order = &id
+}
+
+// ClearOrder clears the "order" edge to the Order entity.
+func (m *PickListMutation) ClearOrder() {
+	m.cleared_order = true
+}
+
+// OrderCleared reports if the "order" edge to the Order entity was cleared.
+func (m *PickListMutation) OrderCleared() bool {
+	return m.cleared_order
+}
+
+// OrderID returns the "order" edge ID in the mutation.
+func (m *PickListMutation) OrderID() (id int, exists bool) {
+	if m._order != nil {
+		return *m._order, true
+	}
+	return
+}
+
+// OrderIDs returns the "order" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrderID instead. It exists only for internal usage by the builders.
+func (m *PickListMutation) OrderIDs() (ids []int) {
+	if id := m._order; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrder resets all changes to the "order" edge.
+func (m *PickListMutation) ResetOrder() {
+	m._order = nil
+	m.cleared_order = false
+}
+
+// AddTaskIDs adds the "tasks" edge to the PickTask entity by ids.
+func (m *PickListMutation) AddTaskIDs(ids ...int) {
+	if m.tasks == nil {
+		m.tasks = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.tasks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTasks clears the "tasks" edge to the PickTask entity.
+func (m *PickListMutation) ClearTasks() {
+	m.clearedtasks = true
+}
+
+// TasksCleared reports if the "tasks" edge to the PickTask entity was cleared.
+func (m *PickListMutation) TasksCleared() bool {
+	return m.clearedtasks
+}
+
+// RemoveTaskIDs removes the "tasks" edge to the PickTask entity by IDs.
+func (m *PickListMutation) RemoveTaskIDs(ids ...int) {
+	if m.removedtasks == nil {
+		m.removedtasks = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.tasks, ids[i])
+		m.removedtasks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTasks returns the removed IDs of the "tasks" edge to the PickTask entity.
+func (m *PickListMutation) RemovedTasksIDs() (ids []int) {
+	for id := range m.removedtasks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TasksIDs returns the "tasks" edge IDs in the mutation.
+func (m *PickListMutation) TasksIDs() (ids []int) {
+	for id := range m.tasks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTasks resets all changes to the "tasks" edge.
+func (m *PickListMutation) ResetTasks() {
+	m.tasks = nil
+	m.clearedtasks = false
+	m.removedtasks = nil
+}
+
+// Where appends a list predicates to the PickListMutation builder.
+func (m *PickListMutation) Where(ps ...predicate.PickList) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PickListMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PickListMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PickList, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PickListMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PickListMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PickList).
+func (m *PickListMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PickListMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.number != nil {
+		fields = append(fields, picklist.FieldNumber)
+	}
+	if m.status != nil {
+		fields = append(fields, picklist.FieldStatus)
+	}
+	if m.assigned_to != nil {
+		fields = append(fields, picklist.FieldAssignedTo)
+	}
+	if m.assigned_at != nil {
+		fields = append(fields, picklist.FieldAssignedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, picklist.FieldCreatedAt)
+	}
+	if m.started_at != nil {
+		fields = append(fields, picklist.FieldStartedAt)
+	}
+	if m.done_at != nil {
+		fields = append(fields, picklist.FieldDoneAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PickListMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case picklist.FieldNumber:
+		return m.Number()
+	case picklist.FieldStatus:
+		return m.Status()
+	case picklist.FieldAssignedTo:
+		return m.AssignedTo()
+	case picklist.FieldAssignedAt:
+		return m.AssignedAt()
+	case picklist.FieldCreatedAt:
+		return m.CreatedAt()
+	case picklist.FieldStartedAt:
+		return m.StartedAt()
+	case picklist.FieldDoneAt:
+		return m.DoneAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PickListMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case picklist.FieldNumber:
+		return m.OldNumber(ctx)
+	case picklist.FieldStatus:
+		return m.OldStatus(ctx)
+	case picklist.FieldAssignedTo:
+		return m.OldAssignedTo(ctx)
+	case picklist.FieldAssignedAt:
+		return m.OldAssignedAt(ctx)
+	case picklist.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case picklist.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case picklist.FieldDoneAt:
+		return m.OldDoneAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PickList field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PickListMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case picklist.FieldNumber:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNumber(v)
+		return nil
+	case picklist.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case picklist.FieldAssignedTo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssignedTo(v)
+		return nil
+	case picklist.FieldAssignedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssignedAt(v)
+		return nil
+	case picklist.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case picklist.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case picklist.FieldDoneAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDoneAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PickList field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PickListMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PickListMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PickListMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PickList numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PickListMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(picklist.FieldNumber) {
+		fields = append(fields, picklist.FieldNumber)
+	}
+	if m.FieldCleared(picklist.FieldAssignedTo) {
+		fields = append(fields, picklist.FieldAssignedTo)
+	}
+	if m.FieldCleared(picklist.FieldAssignedAt) {
+		fields = append(fields, picklist.FieldAssignedAt)
+	}
+	if m.FieldCleared(picklist.FieldStartedAt) {
+		fields = append(fields, picklist.FieldStartedAt)
+	}
+	if m.FieldCleared(picklist.FieldDoneAt) {
+		fields = append(fields, picklist.FieldDoneAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PickListMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PickListMutation) ClearField(name string) error {
+	switch name {
+	case picklist.FieldNumber:
+		m.ClearNumber()
+		return nil
+	case picklist.FieldAssignedTo:
+		m.ClearAssignedTo()
+		return nil
+	case picklist.FieldAssignedAt:
+		m.ClearAssignedAt()
+		return nil
+	case picklist.FieldStartedAt:
+		m.ClearStartedAt()
+		return nil
+	case picklist.FieldDoneAt:
+		m.ClearDoneAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PickList nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PickListMutation) ResetField(name string) error {
+	switch name {
+	case picklist.FieldNumber:
+		m.ResetNumber()
+		return nil
+	case picklist.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case picklist.FieldAssignedTo:
+		m.ResetAssignedTo()
+		return nil
+	case picklist.FieldAssignedAt:
+		m.ResetAssignedAt()
+		return nil
+	case picklist.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case picklist.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case picklist.FieldDoneAt:
+		m.ResetDoneAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PickList field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PickListMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m._order != nil {
+		edges = append(edges, picklist.EdgeOrder)
+	}
+	if m.tasks != nil {
+		edges = append(edges, picklist.EdgeTasks)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PickListMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case picklist.EdgeOrder:
+		if id := m._order; id != nil {
+			return []ent.Value{*id}
+		}
+	case picklist.EdgeTasks:
+		ids := make([]ent.Value, 0, len(m.tasks))
+		for id := range m.tasks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PickListMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedtasks != nil {
+		edges = append(edges, picklist.EdgeTasks)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PickListMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case picklist.EdgeTasks:
+		ids := make([]ent.Value, 0, len(m.removedtasks))
+		for id := range m.removedtasks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PickListMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleared_order {
+		edges = append(edges, picklist.EdgeOrder)
+	}
+	if m.clearedtasks {
+		edges = append(edges, picklist.EdgeTasks)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PickListMutation) EdgeCleared(name string) bool {
+	switch name {
+	case picklist.EdgeOrder:
+		return m.cleared_order
+	case picklist.EdgeTasks:
+		return m.clearedtasks
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PickListMutation) ClearEdge(name string) error {
+	switch name {
+	case picklist.EdgeOrder:
+		m.ClearOrder()
+		return nil
+	}
+	return fmt.Errorf("unknown PickList unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PickListMutation) ResetEdge(name string) error {
+	switch name {
+	case picklist.EdgeOrder:
+		m.ResetOrder()
+		return nil
+	case picklist.EdgeTasks:
+		m.ResetTasks()
+		return nil
+	}
+	return fmt.Errorf("unknown PickList edge %s", name)
+}
+
+// PickTaskMutation represents an operation that mutates the PickTask nodes in the graph.
+type PickTaskMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	quantity          *int
+	addquantity       *int
+	picked            *int
+	addpicked         *int
+	status            *string
+	picked_at         *time.Time
+	bin_id            *int
+	addbin_id         *int
+	assigned_to       *string
+	picked_by         *string
+	clearedFields     map[string]struct{}
+	picklist          *int
+	clearedpicklist   bool
+	order_line        *int
+	clearedorder_line bool
+	done              bool
+	oldValue          func(context.Context) (*PickTask, error)
+	predicates        []predicate.PickTask
+}
+
+var _ ent.Mutation = (*PickTaskMutation)(nil)
+
+// picktaskOption allows management of the mutation configuration using functional options.
+type picktaskOption func(*PickTaskMutation)
+
+// newPickTaskMutation creates new mutation for the PickTask entity.
+func newPickTaskMutation(c config, op Op, opts ...picktaskOption) *PickTaskMutation {
+	m := &PickTaskMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePickTask,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPickTaskID sets the ID field of the mutation.
+func withPickTaskID(id int) picktaskOption {
+	return func(m *PickTaskMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PickTask
+		)
+		m.oldValue = func(ctx context.Context) (*PickTask, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PickTask.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPickTask sets the old PickTask of the mutation.
+func withPickTask(node *PickTask) picktaskOption {
+	return func(m *PickTaskMutation) {
+		m.oldValue = func(context.Context) (*PickTask, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PickTaskMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PickTaskMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PickTaskMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PickTaskMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PickTask.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetQuantity sets the "quantity" field.
+func (m *PickTaskMutation) SetQuantity(i int) {
+	m.quantity = &i
+	m.addquantity = nil
+}
+
+// Quantity returns the value of the "quantity" field in the mutation.
+func (m *PickTaskMutation) Quantity() (r int, exists bool) {
+	v := m.quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuantity returns the old "quantity" field's value of the PickTask entity.
+// If the PickTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PickTaskMutation) OldQuantity(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
+	}
+	return oldValue.Quantity, nil
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (m *PickTaskMutation) AddQuantity(i int) {
+	if m.addquantity != nil {
+		*m.addquantity += i
+	} else {
+		m.addquantity = &i
+	}
+}
+
+// AddedQuantity returns the value that was added to the "quantity" field in this mutation.
+func (m *PickTaskMutation) AddedQuantity() (r int, exists bool) {
+	v := m.addquantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetQuantity resets all changes to the "quantity" field.
+func (m *PickTaskMutation) ResetQuantity() {
+	m.quantity = nil
+	m.addquantity = nil
+}
+
+// SetPicked sets the "picked" field.
+func (m *PickTaskMutation) SetPicked(i int) {
+	m.picked = &i
+	m.addpicked = nil
+}
+
+// Picked returns the value of the "picked" field in the mutation.
+func (m *PickTaskMutation) Picked() (r int, exists bool) {
+	v := m.picked
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPicked returns the old "picked" field's value of the PickTask entity.
+// If the PickTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PickTaskMutation) OldPicked(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPicked is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPicked requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPicked: %w", err)
+	}
+	return oldValue.Picked, nil
+}
+
+// AddPicked adds i to the "picked" field.
+func (m *PickTaskMutation) AddPicked(i int) {
+	if m.addpicked != nil {
+		*m.addpicked += i
+	} else {
+		m.addpicked = &i
+	}
+}
+
+// AddedPicked returns the value that was added to the "picked" field in this mutation.
+func (m *PickTaskMutation) AddedPicked() (r int, exists bool) {
+	v := m.addpicked
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPicked resets all changes to the "picked" field.
+func (m *PickTaskMutation) ResetPicked() {
+	m.picked = nil
+	m.addpicked = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *PickTaskMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *PickTaskMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the PickTask entity.
+// If the PickTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PickTaskMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *PickTaskMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetPickedAt sets the "picked_at" field.
+func (m *PickTaskMutation) SetPickedAt(t time.Time) {
+	m.picked_at = &t
+}
+
+// PickedAt returns the value of the "picked_at" field in the mutation.
+func (m *PickTaskMutation) PickedAt() (r time.Time, exists bool) {
+	v := m.picked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPickedAt returns the old "picked_at" field's value of the PickTask entity.
+// If the PickTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PickTaskMutation) OldPickedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPickedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPickedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPickedAt: %w", err)
+	}
+	return oldValue.PickedAt, nil
+}
+
+// ClearPickedAt clears the value of the "picked_at" field.
+func (m *PickTaskMutation) ClearPickedAt() {
+	m.picked_at = nil
+	m.clearedFields[picktask.FieldPickedAt] = struct{}{}
+}
+
+// PickedAtCleared returns if the "picked_at" field was cleared in this mutation.
+func (m *PickTaskMutation) PickedAtCleared() bool {
+	_, ok := m.clearedFields[picktask.FieldPickedAt]
+	return ok
+}
+
+// ResetPickedAt resets all changes to the "picked_at" field.
+func (m *PickTaskMutation) ResetPickedAt() {
+	m.picked_at = nil
+	delete(m.clearedFields, picktask.FieldPickedAt)
+}
+
+// SetBinID sets the "bin_id" field.
+func (m *PickTaskMutation) SetBinID(i int) {
+	m.bin_id = &i
+	m.addbin_id = nil
+}
+
+// BinID returns the value of the "bin_id" field in the mutation.
+func (m *PickTaskMutation) BinID() (r int, exists bool) {
+	v := m.bin_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBinID returns the old "bin_id" field's value of the PickTask entity.
+// If the PickTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PickTaskMutation) OldBinID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBinID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBinID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBinID: %w", err)
+	}
+	return oldValue.BinID, nil
+}
+
+// AddBinID adds i to the "bin_id" field.
+func (m *PickTaskMutation) AddBinID(i int) {
+	if m.addbin_id != nil {
+		*m.addbin_id += i
+	} else {
+		m.addbin_id = &i
+	}
+}
+
+// AddedBinID returns the value that was added to the "bin_id" field in this mutation.
+func (m *PickTaskMutation) AddedBinID() (r int, exists bool) {
+	v := m.addbin_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearBinID clears the value of the "bin_id" field.
+func (m *PickTaskMutation) ClearBinID() {
+	m.bin_id = nil
+	m.addbin_id = nil
+	m.clearedFields[picktask.FieldBinID] = struct{}{}
+}
+
+// BinIDCleared returns if the "bin_id" field was cleared in this mutation.
+func (m *PickTaskMutation) BinIDCleared() bool {
+	_, ok := m.clearedFields[picktask.FieldBinID]
+	return ok
+}
+
+// ResetBinID resets all changes to the "bin_id" field.
+func (m *PickTaskMutation) ResetBinID() {
+	m.bin_id = nil
+	m.addbin_id = nil
+	delete(m.clearedFields, picktask.FieldBinID)
+}
+
+// SetAssignedTo sets the "assigned_to" field.
+func (m *PickTaskMutation) SetAssignedTo(s string) {
+	m.assigned_to = &s
+}
+
+// AssignedTo returns the value of the "assigned_to" field in the mutation.
+func (m *PickTaskMutation) AssignedTo() (r string, exists bool) {
+	v := m.assigned_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAssignedTo returns the old "assigned_to" field's value of the PickTask entity.
+// If the PickTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PickTaskMutation) OldAssignedTo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAssignedTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAssignedTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAssignedTo: %w", err)
+	}
+	return oldValue.AssignedTo, nil
+}
+
+// ClearAssignedTo clears the value of the "assigned_to" field.
+func (m *PickTaskMutation) ClearAssignedTo() {
+	m.assigned_to = nil
+	m.clearedFields[picktask.FieldAssignedTo] = struct{}{}
+}
+
+// AssignedToCleared returns if the "assigned_to" field was cleared in this mutation.
+func (m *PickTaskMutation) AssignedToCleared() bool {
+	_, ok := m.clearedFields[picktask.FieldAssignedTo]
+	return ok
+}
+
+// ResetAssignedTo resets all changes to the "assigned_to" field.
+func (m *PickTaskMutation) ResetAssignedTo() {
+	m.assigned_to = nil
+	delete(m.clearedFields, picktask.FieldAssignedTo)
+}
+
+// SetPickedBy sets the "picked_by" field.
+func (m *PickTaskMutation) SetPickedBy(s string) {
+	m.picked_by = &s
+}
+
+// PickedBy returns the value of the "picked_by" field in the mutation.
+func (m *PickTaskMutation) PickedBy() (r string, exists bool) {
+	v := m.picked_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPickedBy returns the old "picked_by" field's value of the PickTask entity.
+// If the PickTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PickTaskMutation) OldPickedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPickedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPickedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPickedBy: %w", err)
+	}
+	return oldValue.PickedBy, nil
+}
+
+// ClearPickedBy clears the value of the "picked_by" field.
+func (m *PickTaskMutation) ClearPickedBy() {
+	m.picked_by = nil
+	m.clearedFields[picktask.FieldPickedBy] = struct{}{}
+}
+
+// PickedByCleared returns if the "picked_by" field was cleared in this mutation.
+func (m *PickTaskMutation) PickedByCleared() bool {
+	_, ok := m.clearedFields[picktask.FieldPickedBy]
+	return ok
+}
+
+// ResetPickedBy resets all changes to the "picked_by" field.
+func (m *PickTaskMutation) ResetPickedBy() {
+	m.picked_by = nil
+	delete(m.clearedFields, picktask.FieldPickedBy)
+}
+
+// SetPicklistID sets the "picklist" edge to the PickList entity by id.
+func (m *PickTaskMutation) SetPicklistID(id int) {
+	m.picklist = &id
+}
+
+// ClearPicklist clears the "picklist" edge to the PickList entity.
+func (m *PickTaskMutation) ClearPicklist() {
+	m.clearedpicklist = true
+}
+
+// PicklistCleared reports if the "picklist" edge to the PickList entity was cleared.
+func (m *PickTaskMutation) PicklistCleared() bool {
+	return m.clearedpicklist
+}
+
+// PicklistID returns the "picklist" edge ID in the mutation.
+func (m *PickTaskMutation) PicklistID() (id int, exists bool) {
+	if m.picklist != nil {
+		return *m.picklist, true
+	}
+	return
+}
+
+// PicklistIDs returns the "picklist" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PicklistID instead. It exists only for internal usage by the builders.
+func (m *PickTaskMutation) PicklistIDs() (ids []int) {
+	if id := m.picklist; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPicklist resets all changes to the "picklist" edge.
+func (m *PickTaskMutation) ResetPicklist() {
+	m.picklist = nil
+	m.clearedpicklist = false
+}
+
+// SetOrderLineID sets the "order_line" edge to the OrderLine entity by id.
+func (m *PickTaskMutation) SetOrderLineID(id int) {
+	m.order_line = &id
+}
+
+// ClearOrderLine clears the "order_line" edge to the OrderLine entity.
+func (m *PickTaskMutation) ClearOrderLine() {
+	m.clearedorder_line = true
+}
+
+// OrderLineCleared reports if the "order_line" edge to the OrderLine entity was cleared.
+func (m *PickTaskMutation) OrderLineCleared() bool {
+	return m.clearedorder_line
+}
+
+// OrderLineID returns the "order_line" edge ID in the mutation.
+func (m *PickTaskMutation) OrderLineID() (id int, exists bool) {
+	if m.order_line != nil {
+		return *m.order_line, true
+	}
+	return
+}
+
+// OrderLineIDs returns the "order_line" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrderLineID instead. It exists only for internal usage by the builders.
+func (m *PickTaskMutation) OrderLineIDs() (ids []int) {
+	if id := m.order_line; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrderLine resets all changes to the "order_line" edge.
+func (m *PickTaskMutation) ResetOrderLine() {
+	m.order_line = nil
+	m.clearedorder_line = false
+}
+
+// Where appends a list predicates to the PickTaskMutation builder.
+func (m *PickTaskMutation) Where(ps ...predicate.PickTask) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PickTaskMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PickTaskMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PickTask, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PickTaskMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PickTaskMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PickTask).
+func (m *PickTaskMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PickTaskMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.quantity != nil {
+		fields = append(fields, picktask.FieldQuantity)
+	}
+	if m.picked != nil {
+		fields = append(fields, picktask.FieldPicked)
+	}
+	if m.status != nil {
+		fields = append(fields, picktask.FieldStatus)
+	}
+	if m.picked_at != nil {
+		fields = append(fields, picktask.FieldPickedAt)
+	}
+	if m.bin_id != nil {
+		fields = append(fields, picktask.FieldBinID)
+	}
+	if m.assigned_to != nil {
+		fields = append(fields, picktask.FieldAssignedTo)
+	}
+	if m.picked_by != nil {
+		fields = append(fields, picktask.FieldPickedBy)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PickTaskMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case picktask.FieldQuantity:
+		return m.Quantity()
+	case picktask.FieldPicked:
+		return m.Picked()
+	case picktask.FieldStatus:
+		return m.Status()
+	case picktask.FieldPickedAt:
+		return m.PickedAt()
+	case picktask.FieldBinID:
+		return m.BinID()
+	case picktask.FieldAssignedTo:
+		return m.AssignedTo()
+	case picktask.FieldPickedBy:
+		return m.PickedBy()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PickTaskMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case picktask.FieldQuantity:
+		return m.OldQuantity(ctx)
+	case picktask.FieldPicked:
+		return m.OldPicked(ctx)
+	case picktask.FieldStatus:
+		return m.OldStatus(ctx)
+	case picktask.FieldPickedAt:
+		return m.OldPickedAt(ctx)
+	case picktask.FieldBinID:
+		return m.OldBinID(ctx)
+	case picktask.FieldAssignedTo:
+		return m.OldAssignedTo(ctx)
+	case picktask.FieldPickedBy:
+		return m.OldPickedBy(ctx)
+	}
+	return nil, fmt.Errorf("unknown PickTask field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PickTaskMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case picktask.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuantity(v)
+		return nil
+	case picktask.FieldPicked:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPicked(v)
+		return nil
+	case picktask.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case picktask.FieldPickedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPickedAt(v)
+		return nil
+	case picktask.FieldBinID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBinID(v)
+		return nil
+	case picktask.FieldAssignedTo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAssignedTo(v)
+		return nil
+	case picktask.FieldPickedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPickedBy(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PickTask field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PickTaskMutation) AddedFields() []string {
+	var fields []string
+	if m.addquantity != nil {
+		fields = append(fields, picktask.FieldQuantity)
+	}
+	if m.addpicked != nil {
+		fields = append(fields, picktask.FieldPicked)
+	}
+	if m.addbin_id != nil {
+		fields = append(fields, picktask.FieldBinID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PickTaskMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case picktask.FieldQuantity:
+		return m.AddedQuantity()
+	case picktask.FieldPicked:
+		return m.AddedPicked()
+	case picktask.FieldBinID:
+		return m.AddedBinID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PickTaskMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case picktask.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuantity(v)
+		return nil
+	case picktask.FieldPicked:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPicked(v)
+		return nil
+	case picktask.FieldBinID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBinID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PickTask numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PickTaskMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(picktask.FieldPickedAt) {
+		fields = append(fields, picktask.FieldPickedAt)
+	}
+	if m.FieldCleared(picktask.FieldBinID) {
+		fields = append(fields, picktask.FieldBinID)
+	}
+	if m.FieldCleared(picktask.FieldAssignedTo) {
+		fields = append(fields, picktask.FieldAssignedTo)
+	}
+	if m.FieldCleared(picktask.FieldPickedBy) {
+		fields = append(fields, picktask.FieldPickedBy)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PickTaskMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PickTaskMutation) ClearField(name string) error {
+	switch name {
+	case picktask.FieldPickedAt:
+		m.ClearPickedAt()
+		return nil
+	case picktask.FieldBinID:
+		m.ClearBinID()
+		return nil
+	case picktask.FieldAssignedTo:
+		m.ClearAssignedTo()
+		return nil
+	case picktask.FieldPickedBy:
+		m.ClearPickedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown PickTask nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PickTaskMutation) ResetField(name string) error {
+	switch name {
+	case picktask.FieldQuantity:
+		m.ResetQuantity()
+		return nil
+	case picktask.FieldPicked:
+		m.ResetPicked()
+		return nil
+	case picktask.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case picktask.FieldPickedAt:
+		m.ResetPickedAt()
+		return nil
+	case picktask.FieldBinID:
+		m.ResetBinID()
+		return nil
+	case picktask.FieldAssignedTo:
+		m.ResetAssignedTo()
+		return nil
+	case picktask.FieldPickedBy:
+		m.ResetPickedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown PickTask field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PickTaskMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.picklist != nil {
+		edges = append(edges, picktask.EdgePicklist)
+	}
+	if m.order_line != nil {
+		edges = append(edges, picktask.EdgeOrderLine)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PickTaskMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case picktask.EdgePicklist:
+		if id := m.picklist; id != nil {
+			return []ent.Value{*id}
+		}
+	case picktask.EdgeOrderLine:
+		if id := m.order_line; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PickTaskMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PickTaskMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PickTaskMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedpicklist {
+		edges = append(edges, picktask.EdgePicklist)
+	}
+	if m.clearedorder_line {
+		edges = append(edges, picktask.EdgeOrderLine)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PickTaskMutation) EdgeCleared(name string) bool {
+	switch name {
+	case picktask.EdgePicklist:
+		return m.clearedpicklist
+	case picktask.EdgeOrderLine:
+		return m.clearedorder_line
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PickTaskMutation) ClearEdge(name string) error {
+	switch name {
+	case picktask.EdgePicklist:
+		m.ClearPicklist()
+		return nil
+	case picktask.EdgeOrderLine:
+		m.ClearOrderLine()
+		return nil
+	}
+	return fmt.Errorf("unknown PickTask unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PickTaskMutation) ResetEdge(name string) error {
+	switch name {
+	case picktask.EdgePicklist:
+		m.ResetPicklist()
+		return nil
+	case picktask.EdgeOrderLine:
+		m.ResetOrderLine()
+		return nil
+	}
+	return fmt.Errorf("unknown PickTask edge %s", name)
+}
+
+// PutawayTaskMutation represents an operation that mutates the PutawayTask nodes in the graph.
+type PutawayTaskMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	quantity            *int
+	addquantity         *int
+	status              *string
+	reference           *string
+	suggested_bin_id    *int
+	addsuggested_bin_id *int
+	strategy            *string
+	bin_id              *int
+	addbin_id           *int
+	created_at          *time.Time
+	done_at             *time.Time
+	clearedFields       map[string]struct{}
+	item                *int
+	cleareditem         bool
+	location            *int
+	clearedlocation     bool
+	done                bool
+	oldValue            func(context.Context) (*PutawayTask, error)
+	predicates          []predicate.PutawayTask
+}
+
+var _ ent.Mutation = (*PutawayTaskMutation)(nil)
+
+// putawaytaskOption allows management of the mutation configuration using functional options.
+type putawaytaskOption func(*PutawayTaskMutation)
+
+// newPutawayTaskMutation creates new mutation for the PutawayTask entity.
+func newPutawayTaskMutation(c config, op Op, opts ...putawaytaskOption) *PutawayTaskMutation {
+	m := &PutawayTaskMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePutawayTask,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPutawayTaskID sets the ID field of the mutation.
+func withPutawayTaskID(id int) putawaytaskOption {
+	return func(m *PutawayTaskMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PutawayTask
+		)
+		m.oldValue = func(ctx context.Context) (*PutawayTask, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PutawayTask.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPutawayTask sets the old PutawayTask of the mutation.
+func withPutawayTask(node *PutawayTask) putawaytaskOption {
+	return func(m *PutawayTaskMutation) {
+		m.oldValue = func(context.Context) (*PutawayTask, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PutawayTaskMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PutawayTaskMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PutawayTaskMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PutawayTaskMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PutawayTask.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetQuantity sets the "quantity" field.
+func (m *PutawayTaskMutation) SetQuantity(i int) {
+	m.quantity = &i
+	m.addquantity = nil
+}
+
+// Quantity returns the value of the "quantity" field in the mutation.
+func (m *PutawayTaskMutation) Quantity() (r int, exists bool) {
+	v := m.quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuantity returns the old "quantity" field's value of the PutawayTask entity.
+// If the PutawayTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PutawayTaskMutation) OldQuantity(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
+	}
+	return oldValue.Quantity, nil
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (m *PutawayTaskMutation) AddQuantity(i int) {
+	if m.addquantity != nil {
+		*m.addquantity += i
+	} else {
+		m.addquantity = &i
+	}
+}
+
+// AddedQuantity returns the value that was added to the "quantity" field in this mutation.
+func (m *PutawayTaskMutation) AddedQuantity() (r int, exists bool) {
+	v := m.addquantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetQuantity resets all changes to the "quantity" field.
+func (m *PutawayTaskMutation) ResetQuantity() {
+	m.quantity = nil
+	m.addquantity = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *PutawayTaskMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *PutawayTaskMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the PutawayTask entity.
+// If the PutawayTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PutawayTaskMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *PutawayTaskMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetReference sets the "reference" field.
+func (m *PutawayTaskMutation) SetReference(s string) {
+	m.reference = &s
+}
+
+// Reference returns the value of the "reference" field in the mutation.
+func (m *PutawayTaskMutation) Reference() (r string, exists bool) {
+	v := m.reference
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReference returns the old "reference" field's value of the PutawayTask entity.
+// If the PutawayTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PutawayTaskMutation) OldReference(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReference is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReference requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReference: %w", err)
+	}
+	return oldValue.Reference, nil
+}
+
+// ClearReference clears the value of the "reference" field.
+func (m *PutawayTaskMutation) ClearReference() {
+	m.reference = nil
+	m.clearedFields[putawaytask.FieldReference] = struct{}{}
+}
+
+// ReferenceCleared returns if the "reference" field was cleared in this mutation.
+func (m *PutawayTaskMutation) ReferenceCleared() bool {
+	_, ok := m.clearedFields[putawaytask.FieldReference]
+	return ok
+}
+
+// ResetReference resets all changes to the "reference" field.
+func (m *PutawayTaskMutation) ResetReference() {
+	m.reference = nil
+	delete(m.clearedFields, putawaytask.FieldReference)
+}
+
+// SetSuggestedBinID sets the "suggested_bin_id" field.
+func (m *PutawayTaskMutation) SetSuggestedBinID(i int) {
+	m.suggested_bin_id = &i
+	m.addsuggested_bin_id = nil
+}
+
+// SuggestedBinID returns the value of the "suggested_bin_id" field in the mutation.
+func (m *PutawayTaskMutation) SuggestedBinID() (r int, exists bool) {
+	v := m.suggested_bin_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuggestedBinID returns the old "suggested_bin_id" field's value of the PutawayTask entity.
+// If the PutawayTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PutawayTaskMutation) OldSuggestedBinID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuggestedBinID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuggestedBinID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuggestedBinID: %w", err)
+	}
+	return oldValue.SuggestedBinID, nil
+}
+
+// AddSuggestedBinID adds i to the "suggested_bin_id" field.
+func (m *PutawayTaskMutation) AddSuggestedBinID(i int) {
+	if m.addsuggested_bin_id != nil {
+		*m.addsuggested_bin_id += i
+	} else {
+		m.addsuggested_bin_id = &i
+	}
+}
+
+// AddedSuggestedBinID returns the value that was added to the "suggested_bin_id" field in this mutation.
+func (m *PutawayTaskMutation) AddedSuggestedBinID() (r int, exists bool) {
+	v := m.addsuggested_bin_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSuggestedBinID clears the value of the "suggested_bin_id" field.
+func (m *PutawayTaskMutation) ClearSuggestedBinID() {
+	m.suggested_bin_id = nil
+	m.addsuggested_bin_id = nil
+	m.clearedFields[putawaytask.FieldSuggestedBinID] = struct{}{}
+}
+
+// SuggestedBinIDCleared returns if the "suggested_bin_id" field was cleared in this mutation.
+func (m *PutawayTaskMutation) SuggestedBinIDCleared() bool {
+	_, ok := m.clearedFields[putawaytask.FieldSuggestedBinID]
+	return ok
+}
+
+// ResetSuggestedBinID resets all changes to the "suggested_bin_id" field.
+func (m *PutawayTaskMutation) ResetSuggestedBinID() {
+	m.suggested_bin_id = nil
+	m.addsuggested_bin_id = nil
+	delete(m.clearedFields, putawaytask.FieldSuggestedBinID)
+}
+
+// SetStrategy sets the "strategy" field.
+func (m *PutawayTaskMutation) SetStrategy(s string) {
+	m.strategy = &s
+}
+
+// Strategy returns the value of the "strategy" field in the mutation.
+func (m *PutawayTaskMutation) Strategy() (r string, exists bool) {
+	v := m.strategy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStrategy returns the old "strategy" field's value of the PutawayTask entity.
+// If the PutawayTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PutawayTaskMutation) OldStrategy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStrategy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStrategy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStrategy: %w", err)
+	}
+	return oldValue.Strategy, nil
+}
+
+// ClearStrategy clears the value of the "strategy" field.
+func (m *PutawayTaskMutation) ClearStrategy() {
+	m.strategy = nil
+	m.clearedFields[putawaytask.FieldStrategy] = struct{}{}
+}
+
+// StrategyCleared returns if the "strategy" field was cleared in this mutation.
+func (m *PutawayTaskMutation) StrategyCleared() bool {
+	_, ok := m.clearedFields[putawaytask.FieldStrategy]
+	return ok
+}
+
+// ResetStrategy resets all changes to the "strategy" field.
+func (m *PutawayTaskMutation) ResetStrategy() {
+	m.strategy = nil
+	delete(m.clearedFields, putawaytask.FieldStrategy)
+}
+
+// SetBinID sets the "bin_id" field.
+func (m *PutawayTaskMutation) SetBinID(i int) {
+	m.bin_id = &i
+	m.addbin_id = nil
+}
+
+// BinID returns the value of the "bin_id" field in the mutation.
+func (m *PutawayTaskMutation) BinID() (r int, exists bool) {
+	v := m.bin_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBinID returns the old "bin_id" field's value of the PutawayTask entity.
+// If the PutawayTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PutawayTaskMutation) OldBinID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBinID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBinID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBinID: %w", err)
+	}
+	return oldValue.BinID, nil
+}
+
+// AddBinID adds i to the "bin_id" field.
+func (m *PutawayTaskMutation) AddBinID(i int) {
+	if m.addbin_id != nil {
+		*m.addbin_id += i
+	} else {
+		m.addbin_id = &i
+	}
+}
+
+// AddedBinID returns the value that was added to the "bin_id" field in this mutation.
+func (m *PutawayTaskMutation) AddedBinID() (r int, exists bool) {
+	v := m.addbin_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearBinID clears the value of the "bin_id" field.
+func (m *PutawayTaskMutation) ClearBinID() {
+	m.bin_id = nil
+	m.addbin_id = nil
+	m.clearedFields[putawaytask.FieldBinID] = struct{}{}
+}
+
+// BinIDCleared returns if the "bin_id" field was cleared in this mutation.
+func (m *PutawayTaskMutation) BinIDCleared() bool {
+	_, ok := m.clearedFields[putawaytask.FieldBinID]
+	return ok
+}
+
+// ResetBinID resets all changes to the "bin_id" field.
+func (m *PutawayTaskMutation) ResetBinID() {
+	m.bin_id = nil
+	m.addbin_id = nil
+	delete(m.clearedFields, putawaytask.FieldBinID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PutawayTaskMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PutawayTaskMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PutawayTask entity.
+// If the PutawayTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PutawayTaskMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PutawayTaskMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetDoneAt sets the "done_at" field.
+func (m *PutawayTaskMutation) SetDoneAt(t time.Time) {
+	m.done_at = &t
+}
+
+// DoneAt returns the value of the "done_at" field in the mutation.
+func (m *PutawayTaskMutation) DoneAt() (r time.Time, exists bool) {
+	v := m.done_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDoneAt returns the old "done_at" field's value of the PutawayTask entity.
+// If the PutawayTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PutawayTaskMutation) OldDoneAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDoneAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDoneAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDoneAt: %w", err)
+	}
+	return oldValue.DoneAt, nil
+}
+
+// ClearDoneAt clears the value of the "done_at" field.
+func (m *PutawayTaskMutation) ClearDoneAt() {
+	m.done_at = nil
+	m.clearedFields[putawaytask.FieldDoneAt] = struct{}{}
+}
+
+// DoneAtCleared returns if the "done_at" field was cleared in this mutation.
+func (m *PutawayTaskMutation) DoneAtCleared() bool {
+	_, ok := m.clearedFields[putawaytask.FieldDoneAt]
+	return ok
+}
+
+// ResetDoneAt resets all changes to the "done_at" field.
+func (m *PutawayTaskMutation) ResetDoneAt() {
+	m.done_at = nil
+	delete(m.clearedFields, putawaytask.FieldDoneAt)
+}
+
+// SetItemID sets the "item" edge to the Item entity by id.
+func (m *PutawayTaskMutation) SetItemID(id int) {
+	m.item = &id
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (m *PutawayTaskMutation) ClearItem() {
+	m.cleareditem = true
+}
+
+// ItemCleared reports if the "item" edge to the Item entity was cleared.
+func (m *PutawayTaskMutation) ItemCleared() bool {
+	return m.cleareditem
+}
+
+// ItemID returns the "item" edge ID in the mutation.
+func (m *PutawayTaskMutation) ItemID() (id int, exists bool) {
+	if m.item != nil {
+		return *m.item, true
+	}
+	return
+}
+
+// ItemIDs returns the "item" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ItemID instead. It exists only for internal usage by the builders.
+func (m *PutawayTaskMutation) ItemIDs() (ids []int) {
+	if id := m.item; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetItem resets all changes to the "item" edge.
+func (m *PutawayTaskMutation) ResetItem() {
+	m.item = nil
+	m.cleareditem = false
+}
+
+// SetLocationID sets the "location" edge to the Location entity by id.
+func (m *PutawayTaskMutation) SetLocationID(id int) {
+	m.location = &id
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (m *PutawayTaskMutation) ClearLocation() {
+	m.clearedlocation = true
+}
+
+// LocationCleared reports if the "location" edge to the Location entity was cleared.
+func (m *PutawayTaskMutation) LocationCleared() bool {
+	return m.clearedlocation
+}
+
+// LocationID returns the "location" edge ID in the mutation.
+func (m *PutawayTaskMutation) LocationID() (id int, exists bool) {
+	if m.location != nil {
+		return *m.location, true
+	}
+	return
+}
+
+// LocationIDs returns the "location" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LocationID instead. It exists only for internal usage by the builders.
+func (m *PutawayTaskMutation) LocationIDs() (ids []int) {
+	if id := m.location; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLocation resets all changes to the "location" edge.
+func (m *PutawayTaskMutation) ResetLocation() {
+	m.location = nil
+	m.clearedlocation = false
+}
+
+// Where appends a list predicates to the PutawayTaskMutation builder.
+func (m *PutawayTaskMutation) Where(ps ...predicate.PutawayTask) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PutawayTaskMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PutawayTaskMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PutawayTask, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PutawayTaskMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PutawayTaskMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PutawayTask).
+func (m *PutawayTaskMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PutawayTaskMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.quantity != nil {
+		fields = append(fields, putawaytask.FieldQuantity)
+	}
+	if m.status != nil {
+		fields = append(fields, putawaytask.FieldStatus)
+	}
+	if m.reference != nil {
+		fields = append(fields, putawaytask.FieldReference)
+	}
+	if m.suggested_bin_id != nil {
+		fields = append(fields, putawaytask.FieldSuggestedBinID)
+	}
+	if m.strategy != nil {
+		fields = append(fields, putawaytask.FieldStrategy)
+	}
+	if m.bin_id != nil {
+		fields = append(fields, putawaytask.FieldBinID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, putawaytask.FieldCreatedAt)
+	}
+	if m.done_at != nil {
+		fields = append(fields, putawaytask.FieldDoneAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PutawayTaskMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case putawaytask.FieldQuantity:
+		return m.Quantity()
+	case putawaytask.FieldStatus:
+		return m.Status()
+	case putawaytask.FieldReference:
+		return m.Reference()
+	case putawaytask.FieldSuggestedBinID:
+		return m.SuggestedBinID()
+	case putawaytask.FieldStrategy:
+		return m.Strategy()
+	case putawaytask.FieldBinID:
+		return m.BinID()
+	case putawaytask.FieldCreatedAt:
+		return m.CreatedAt()
+	case putawaytask.FieldDoneAt:
+		return m.DoneAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PutawayTaskMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case putawaytask.FieldQuantity:
+		return m.OldQuantity(ctx)
+	case putawaytask.FieldStatus:
+		return m.OldStatus(ctx)
+	case putawaytask.FieldReference:
+		return m.OldReference(ctx)
+	case putawaytask.FieldSuggestedBinID:
+		return m.OldSuggestedBinID(ctx)
+	case putawaytask.FieldStrategy:
+		return m.OldStrategy(ctx)
+	case putawaytask.FieldBinID:
+		return m.OldBinID(ctx)
+	case putawaytask.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case putawaytask.FieldDoneAt:
+		return m.OldDoneAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PutawayTask field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PutawayTaskMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case putawaytask.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuantity(v)
+		return nil
+	case putawaytask.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case putawaytask.FieldReference:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReference(v)
+		return nil
+	case putawaytask.FieldSuggestedBinID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuggestedBinID(v)
+		return nil
+	case putawaytask.FieldStrategy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStrategy(v)
+		return nil
+	case putawaytask.FieldBinID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBinID(v)
+		return nil
+	case putawaytask.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case putawaytask.FieldDoneAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDoneAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PutawayTask field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PutawayTaskMutation) AddedFields() []string {
+	var fields []string
+	if m.addquantity != nil {
+		fields = append(fields, putawaytask.FieldQuantity)
+	}
+	if m.addsuggested_bin_id != nil {
+		fields = append(fields, putawaytask.FieldSuggestedBinID)
+	}
+	if m.addbin_id != nil {
+		fields = append(fields, putawaytask.FieldBinID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PutawayTaskMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case putawaytask.FieldQuantity:
+		return m.AddedQuantity()
+	case putawaytask.FieldSuggestedBinID:
+		return m.AddedSuggestedBinID()
+	case putawaytask.FieldBinID:
+		return m.AddedBinID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PutawayTaskMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case putawaytask.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuantity(v)
+		return nil
+	case putawaytask.FieldSuggestedBinID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSuggestedBinID(v)
+		return nil
+	case putawaytask.FieldBinID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBinID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PutawayTask numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PutawayTaskMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(putawaytask.FieldReference) {
+		fields = append(fields, putawaytask.FieldReference)
+	}
+	if m.FieldCleared(putawaytask.FieldSuggestedBinID) {
+		fields = append(fields, putawaytask.FieldSuggestedBinID)
+	}
+	if m.FieldCleared(putawaytask.FieldStrategy) {
+		fields = append(fields, putawaytask.FieldStrategy)
+	}
+	if m.FieldCleared(putawaytask.FieldBinID) {
+		fields = append(fields, putawaytask.FieldBinID)
+	}
+	if m.FieldCleared(putawaytask.FieldDoneAt) {
+		fields = append(fields, putawaytask.FieldDoneAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PutawayTaskMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PutawayTaskMutation) ClearField(name string) error {
+	switch name {
+	case putawaytask.FieldReference:
+		m.ClearReference()
+		return nil
+	case putawaytask.FieldSuggestedBinID:
+		m.ClearSuggestedBinID()
+		return nil
+	case putawaytask.FieldStrategy:
+		m.ClearStrategy()
+		return nil
+	case putawaytask.FieldBinID:
+		m.ClearBinID()
+		return nil
+	case putawaytask.FieldDoneAt:
+		m.ClearDoneAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PutawayTask nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PutawayTaskMutation) ResetField(name string) error {
+	switch name {
+	case putawaytask.FieldQuantity:
+		m.ResetQuantity()
+		return nil
+	case putawaytask.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case putawaytask.FieldReference:
+		m.ResetReference()
+		return nil
+	case putawaytask.FieldSuggestedBinID:
+		m.ResetSuggestedBinID()
+		return nil
+	case putawaytask.FieldStrategy:
+		m.ResetStrategy()
+		return nil
+	case putawaytask.FieldBinID:
+		m.ResetBinID()
+		return nil
+	case putawaytask.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case putawaytask.FieldDoneAt:
+		m.ResetDoneAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PutawayTask field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PutawayTaskMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.item != nil {
+		edges = append(edges, putawaytask.EdgeItem)
+	}
+	if m.location != nil {
+		edges = append(edges, putawaytask.EdgeLocation)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PutawayTaskMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case putawaytask.EdgeItem:
+		if id := m.item; id != nil {
+			return []ent.Value{*id}
+		}
+	case putawaytask.EdgeLocation:
+		if id := m.location; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PutawayTaskMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PutawayTaskMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PutawayTaskMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareditem {
+		edges = append(edges, putawaytask.EdgeItem)
+	}
+	if m.clearedlocation {
+		edges = append(edges, putawaytask.EdgeLocation)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PutawayTaskMutation) EdgeCleared(name string) bool {
+	switch name {
+	case putawaytask.EdgeItem:
+		return m.cleareditem
+	case putawaytask.EdgeLocation:
+		return m.clearedlocation
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PutawayTaskMutation) ClearEdge(name string) error {
+	switch name {
+	case putawaytask.EdgeItem:
+		m.ClearItem()
+		return nil
+	case putawaytask.EdgeLocation:
+		m.ClearLocation()
+		return nil
+	}
+	return fmt.Errorf("unknown PutawayTask unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PutawayTaskMutation) ResetEdge(name string) error {
+	switch name {
+	case putawaytask.EdgeItem:
+		m.ResetItem()
+		return nil
+	case putawaytask.EdgeLocation:
+		m.ResetLocation()
+		return nil
+	}
+	return fmt.Errorf("unknown PutawayTask edge %s", name)
+}
+
+// ReceiptMutation represents an operation that mutates the Receipt nodes in the graph.
+type ReceiptMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	quantity          *int
+	addquantity       *int
+	damaged           *int
+	adddamaged        *int
+	note              *string
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	order_line        *int
+	clearedorder_line bool
+	done              bool
+	oldValue          func(context.Context) (*Receipt, error)
+	predicates        []predicate.Receipt
+}
+
+var _ ent.Mutation = (*ReceiptMutation)(nil)
+
+// receiptOption allows management of the mutation configuration using functional options.
+type receiptOption func(*ReceiptMutation)
+
+// newReceiptMutation creates new mutation for the Receipt entity.
+func newReceiptMutation(c config, op Op, opts ...receiptOption) *ReceiptMutation {
+	m := &ReceiptMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReceipt,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReceiptID sets the ID field of the mutation.
+func withReceiptID(id int) receiptOption {
+	return func(m *ReceiptMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Receipt
+		)
+		m.oldValue = func(ctx context.Context) (*Receipt, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Receipt.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReceipt sets the old Receipt of the mutation.
+func withReceipt(node *Receipt) receiptOption {
+	return func(m *ReceiptMutation) {
+		m.oldValue = func(context.Context) (*Receipt, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReceiptMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReceiptMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReceiptMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReceiptMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Receipt.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetQuantity sets the "quantity" field.
+func (m *ReceiptMutation) SetQuantity(i int) {
+	m.quantity = &i
+	m.addquantity = nil
+}
+
+// Quantity returns the value of the "quantity" field in the mutation.
+func (m *ReceiptMutation) Quantity() (r int, exists bool) {
+	v := m.quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuantity returns the old "quantity" field's value of the Receipt entity.
+// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceiptMutation) OldQuantity(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
+	}
+	return oldValue.Quantity, nil
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (m *ReceiptMutation) AddQuantity(i int) {
+	if m.addquantity != nil {
+		*m.addquantity += i
+	} else {
+		m.addquantity = &i
+	}
+}
+
+// AddedQuantity returns the value that was added to the "quantity" field in this mutation.
+func (m *ReceiptMutation) AddedQuantity() (r int, exists bool) {
+	v := m.addquantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetQuantity resets all changes to the "quantity" field.
+func (m *ReceiptMutation) ResetQuantity() {
+	m.quantity = nil
+	m.addquantity = nil
+}
+
+// SetDamaged sets the "damaged" field.
+func (m *ReceiptMutation) SetDamaged(i int) {
+	m.damaged = &i
+	m.adddamaged = nil
+}
+
+// Damaged returns the value of the "damaged" field in the mutation.
+func (m *ReceiptMutation) Damaged() (r int, exists bool) {
+	v := m.damaged
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDamaged returns the old "damaged" field's value of the Receipt entity.
+// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceiptMutation) OldDamaged(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDamaged is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDamaged requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDamaged: %w", err)
+	}
+	return oldValue.Damaged, nil
+}
+
+// AddDamaged adds i to the "damaged" field.
+func (m *ReceiptMutation) AddDamaged(i int) {
+	if m.adddamaged != nil {
+		*m.adddamaged += i
+	} else {
+		m.adddamaged = &i
+	}
+}
+
+// AddedDamaged returns the value that was added to the "damaged" field in this mutation.
+func (m *ReceiptMutation) AddedDamaged() (r int, exists bool) {
+	v := m.adddamaged
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDamaged resets all changes to the "damaged" field.
+func (m *ReceiptMutation) ResetDamaged() {
+	m.damaged = nil
+	m.adddamaged = nil
+}
+
+// SetNote sets the "note" field.
+func (m *ReceiptMutation) SetNote(s string) {
+	m.note = &s
+}
+
+// Note returns the value of the "note" field in the mutation.
+func (m *ReceiptMutation) Note() (r string, exists bool) {
+	v := m.note
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNote returns the old "note" field's value of the Receipt entity.
+// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceiptMutation) OldNote(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNote is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNote requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNote: %w", err)
+	}
+	return oldValue.Note, nil
+}
+
+// ClearNote clears the value of the "note" field.
+func (m *ReceiptMutation) ClearNote() {
+	m.note = nil
+	m.clearedFields[receipt.FieldNote] = struct{}{}
+}
+
+// NoteCleared returns if the "note" field was cleared in this mutation.
+func (m *ReceiptMutation) NoteCleared() bool {
+	_, ok := m.clearedFields[receipt.FieldNote]
+	return ok
+}
+
+// ResetNote resets all changes to the "note" field.
+func (m *ReceiptMutation) ResetNote() {
+	m.note = nil
+	delete(m.clearedFields, receipt.FieldNote)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ReceiptMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ReceiptMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Receipt entity.
+// If the Receipt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReceiptMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ReceiptMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetOrderLineID sets the "order_line" edge to the OrderLine entity by id.
+func (m *ReceiptMutation) SetOrderLineID(id int) {
+	m.order_line = &id
+}
+
+// ClearOrderLine clears the "order_line" edge to the OrderLine entity.
+func (m *ReceiptMutation) ClearOrderLine() {
+	m.clearedorder_line = true
+}
+
+// OrderLineCleared reports if the "order_line" edge to the OrderLine entity was cleared.
+func (m *ReceiptMutation) OrderLineCleared() bool {
+	return m.clearedorder_line
+}
+
+// OrderLineID returns the "order_line" edge ID in the mutation.
+func (m *ReceiptMutation) OrderLineID() (id int, exists bool) {
+	if m.order_line != nil {
+		return *m.order_line, true
+	}
+	return
+}
+
+// OrderLineIDs returns the "order_line" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrderLineID instead. It exists only for internal usage by the builders.
+func (m *ReceiptMutation) OrderLineIDs() (ids []int) {
+	if id := m.order_line; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrderLine resets all changes to the "order_line" edge.
+func (m *ReceiptMutation) ResetOrderLine() {
+	m.order_line = nil
+	m.clearedorder_line = false
+}
+
+// Where appends a list predicates to the ReceiptMutation builder.
+func (m *ReceiptMutation) Where(ps ...predicate.Receipt) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ReceiptMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ReceiptMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Receipt, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ReceiptMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ReceiptMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Receipt).
+func (m *ReceiptMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReceiptMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.quantity != nil {
+		fields = append(fields, receipt.FieldQuantity)
+	}
+	if m.damaged != nil {
+		fields = append(fields, receipt.FieldDamaged)
+	}
+	if m.note != nil {
+		fields = append(fields, receipt.FieldNote)
+	}
+	if m.created_at != nil {
+		fields = append(fields, receipt.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReceiptMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case receipt.FieldQuantity:
+		return m.Quantity()
+	case receipt.FieldDamaged:
+		return m.Damaged()
+	case receipt.FieldNote:
+		return m.Note()
+	case receipt.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReceiptMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case receipt.FieldQuantity:
+		return m.OldQuantity(ctx)
+	case receipt.FieldDamaged:
+		return m.OldDamaged(ctx)
+	case receipt.FieldNote:
+		return m.OldNote(ctx)
+	case receipt.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Receipt field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReceiptMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case receipt.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuantity(v)
+		return nil
+	case receipt.FieldDamaged:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDamaged(v)
+		return nil
+	case receipt.FieldNote:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNote(v)
+		return nil
+	case receipt.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Receipt field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReceiptMutation) AddedFields() []string {
+	var fields []string
+	if m.addquantity != nil {
+		fields = append(fields, receipt.FieldQuantity)
+	}
+	if m.adddamaged != nil {
+		fields = append(fields, receipt.FieldDamaged)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReceiptMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case receipt.FieldQuantity:
+		return m.AddedQuantity()
+	case receipt.FieldDamaged:
+		return m.AddedDamaged()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReceiptMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case receipt.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuantity(v)
+		return nil
+	case receipt.FieldDamaged:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDamaged(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Receipt numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReceiptMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(receipt.FieldNote) {
+		fields = append(fields, receipt.FieldNote)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReceiptMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReceiptMutation) ClearField(name string) error {
+	switch name {
+	case receipt.FieldNote:
+		m.ClearNote()
+		return nil
+	}
+	return fmt.Errorf("unknown Receipt nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReceiptMutation) ResetField(name string) error {
+	switch name {
+	case receipt.FieldQuantity:
+		m.ResetQuantity()
+		return nil
+	case receipt.FieldDamaged:
+		m.ResetDamaged()
+		return nil
+	case receipt.FieldNote:
+		m.ResetNote()
+		return nil
+	case receipt.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Receipt field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReceiptMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.order_line != nil {
+		edges = append(edges, receipt.EdgeOrderLine)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReceiptMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case receipt.EdgeOrderLine:
+		if id := m.order_line; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReceiptMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReceiptMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReceiptMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedorder_line {
+		edges = append(edges, receipt.EdgeOrderLine)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReceiptMutation) EdgeCleared(name string) bool {
+	switch name {
+	case receipt.EdgeOrderLine:
+		return m.clearedorder_line
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReceiptMutation) ClearEdge(name string) error {
+	switch name {
+	case receipt.EdgeOrderLine:
+		m.ClearOrderLine()
+		return nil
+	}
+	return fmt.Errorf("unknown Receipt unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReceiptMutation) ResetEdge(name string) error {
+	switch name {
+	case receipt.EdgeOrderLine:
+		m.ResetOrderLine()
+		return nil
+	}
+	return fmt.Errorf("unknown Receipt edge %s", name)
+}
+
+// ReorderPolicyMutation represents an operation that mutates the ReorderPolicy nodes in the graph.
+type ReorderPolicyMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	reorder_point       *int
+	addreorder_point    *int
+	safety_stock        *int
+	addsafety_stock     *int
+	reorder_quantity    *int
+	addreorder_quantity *int
+	lead_time_days      *int
+	addlead_time_days   *int
+	supplier            *string
+	clearedFields       map[string]struct{}
+	item                *int
+	cleareditem         bool
+	location            *int
+	clearedlocation     bool
+	done                bool
+	oldValue            func(context.Context) (*ReorderPolicy, error)
+	predicates          []predicate.ReorderPolicy
+}
+
+var _ ent.Mutation = (*ReorderPolicyMutation)(nil)
+
+// reorderpolicyOption allows management of the mutation configuration using functional options.
+type reorderpolicyOption func(*ReorderPolicyMutation)
+
+// newReorderPolicyMutation creates new mutation for the ReorderPolicy entity.
+func newReorderPolicyMutation(c config, op Op, opts ...reorderpolicyOption) *ReorderPolicyMutation {
+	m := &ReorderPolicyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReorderPolicy,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReorderPolicyID sets the ID field of the mutation.
+func withReorderPolicyID(id int) reorderpolicyOption {
+	return func(m *ReorderPolicyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ReorderPolicy
+		)
+		m.oldValue = func(ctx context.Context) (*ReorderPolicy, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ReorderPolicy.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReorderPolicy sets the old ReorderPolicy of the mutation.
+func withReorderPolicy(node *ReorderPolicy) reorderpolicyOption {
+	return func(m *ReorderPolicyMutation) {
+		m.oldValue = func(context.Context) (*ReorderPolicy, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReorderPolicyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReorderPolicyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReorderPolicyMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReorderPolicyMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ReorderPolicy.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetReorderPoint sets the "reorder_point" field.
+func (m *ReorderPolicyMutation) SetReorderPoint(i int) {
+	m.reorder_point = &i
+	m.addreorder_point = nil
+}
+
+// ReorderPoint returns the value of the "reorder_point" field in the mutation.
+func (m *ReorderPolicyMutation) ReorderPoint() (r int, exists bool) {
+	v := m.reorder_point
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReorderPoint returns the old "reorder_point" field's value of the ReorderPolicy entity.
+// If the ReorderPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReorderPolicyMutation) OldReorderPoint(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReorderPoint is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReorderPoint requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReorderPoint: %w", err)
+	}
+	return oldValue.ReorderPoint, nil
+}
+
+// AddReorderPoint adds i to the "reorder_point" field.
+func (m *ReorderPolicyMutation) AddReorderPoint(i int) {
+	if m.addreorder_point != nil {
+		*m.addreorder_point += i
+	} else {
+		m.addreorder_point = &i
+	}
+}
+
+// AddedReorderPoint returns the value that was added to the "reorder_point" field in this mutation.
+func (m *ReorderPolicyMutation) AddedReorderPoint() (r int, exists bool) {
+	v := m.addreorder_point
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetReorderPoint resets all changes to the "reorder_point" field.
+func (m *ReorderPolicyMutation) ResetReorderPoint() {
+	m.reorder_point = nil
+	m.addreorder_point = nil
+}
+
+// SetSafetyStock sets the "safety_stock" field.
+func (m *ReorderPolicyMutation) SetSafetyStock(i int) {
+	m.safety_stock = &i
+	m.addsafety_stock = nil
+}
+
+// SafetyStock returns the value of the "safety_stock" field in the mutation.
+func (m *ReorderPolicyMutation) SafetyStock() (r int, exists bool) {
+	v := m.safety_stock
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSafetyStock returns the old "safety_stock" field's value of the ReorderPolicy entity.
+// If the ReorderPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReorderPolicyMutation) OldSafetyStock(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSafetyStock is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSafetyStock requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSafetyStock: %w", err)
+	}
+	return oldValue.SafetyStock, nil
+}
+
+// AddSafetyStock adds i to the "safety_stock" field.
+func (m *ReorderPolicyMutation) AddSafetyStock(i int) {
+	if m.addsafety_stock != nil {
+		*m.addsafety_stock += i
+	} else {
+		m.addsafety_stock = &i
+	}
+}
+
+// AddedSafetyStock returns the value that was added to the "safety_stock" field in this mutation.
+func (m *ReorderPolicyMutation) AddedSafetyStock() (r int, exists bool) {
+	v := m.addsafety_stock
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSafetyStock resets all changes to the "safety_stock" field.
+func (m *ReorderPolicyMutation) ResetSafetyStock() {
+	m.safety_stock = nil
+	m.addsafety_stock = nil
+}
+
+// SetReorderQuantity sets the "reorder_quantity" field.
+func (m *ReorderPolicyMutation) SetReorderQuantity(i int) {
+	m.reorder_quantity = &i
+	m.addreorder_quantity = nil
+}
+
+// ReorderQuantity returns the value of the "reorder_quantity" field in the mutation.
+func (m *ReorderPolicyMutation) ReorderQuantity() (r int, exists bool) {
+	v := m.reorder_quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReorderQuantity returns the old "reorder_quantity" field's value of the ReorderPolicy entity.
+// If the ReorderPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReorderPolicyMutation) OldReorderQuantity(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReorderQuantity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReorderQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReorderQuantity: %w", err)
+	}
+	return oldValue.ReorderQuantity, nil
+}
+
+// AddReorderQuantity adds i to the "reorder_quantity" field.
+func (m *ReorderPolicyMutation) AddReorderQuantity(i int) {
+	if m.addreorder_quantity != nil {
+		*m.addreorder_quantity += i
+	} else {
+		m.addreorder_quantity = &i
+	}
+}
+
+// AddedReorderQuantity returns the value that was added to the "reorder_quantity" field in this mutation.
+func (m *ReorderPolicyMutation) AddedReorderQuantity() (r int, exists bool) {
+	v := m.addreorder_quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetReorderQuantity resets all changes to the "reorder_quantity" field.
+func (m *ReorderPolicyMutation) ResetReorderQuantity() {
+	m.reorder_quantity = nil
+	m.addreorder_quantity = nil
+}
+
+// SetLeadTimeDays sets the "lead_time_days" field.
+func (m *ReorderPolicyMutation) SetLeadTimeDays(i int) {
+	m.lead_time_days = &i
+	m.addlead_time_days = nil
+}
+
+// LeadTimeDays returns the value of the "lead_time_days" field in the mutation.
+func (m *ReorderPolicyMutation) LeadTimeDays() (r int, exists bool) {
+	v := m.lead_time_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLeadTimeDays returns the old "lead_time_days" field's value of the ReorderPolicy entity.
+// If the ReorderPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReorderPolicyMutation) OldLeadTimeDays(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeadTimeDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeadTimeDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeadTimeDays: %w", err)
+	}
+	return oldValue.LeadTimeDays, nil
+}
+
+// AddLeadTimeDays adds i to the "lead_time_days" field.
+func (m *ReorderPolicyMutation) AddLeadTimeDays(i int) {
+	if m.addlead_time_days != nil {
+		*m.addlead_time_days += i
+	} else {
+		m.addlead_time_days = &i
+	}
+}
+
+// AddedLeadTimeDays returns the value that was added to the "lead_time_days" field in this mutation.
+func (m *ReorderPolicyMutation) AddedLeadTimeDays() (r int, exists bool) {
+	v := m.addlead_time_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLeadTimeDays resets all changes to the "lead_time_days" field.
+func (m *ReorderPolicyMutation) ResetLeadTimeDays() {
+	m.lead_time_days = nil
+	m.addlead_time_days = nil
+}
+
+// SetItemID sets the "item_id" field.
+func (m *ReorderPolicyMutation) SetItemID(i int) {
+	m.item = &i
+}
+
+// ItemID returns the value of the "item_id" field in the mutation.
+func (m *ReorderPolicyMutation) ItemID() (r int, exists bool) {
+	v := m.item
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldItemID returns the old "item_id" field's value of the ReorderPolicy entity.
+// If the ReorderPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReorderPolicyMutation) OldItemID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldItemID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldItemID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldItemID: %w", err)
+	}
+	return oldValue.ItemID, nil
+}
+
+// ResetItemID resets all changes to the "item_id" field.
+func (m *ReorderPolicyMutation) ResetItemID() {
+	m.item = nil
+}
+
+// SetSupplier sets the "supplier" field.
+func (m *ReorderPolicyMutation) SetSupplier(s string) {
+	m.supplier = &s
+}
+
+// Supplier returns the value of the "supplier" field in the mutation.
+func (m *ReorderPolicyMutation) Supplier() (r string, exists bool) {
+	v := m.supplier
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSupplier returns the old "supplier" field's value of the ReorderPolicy entity.
+// If the ReorderPolicy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReorderPolicyMutation) OldSupplier(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSupplier is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSupplier requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSupplier: %w", err)
+	}
+	return oldValue.Supplier, nil
+}
+
+// ClearSupplier clears the value of the "supplier" field.
+func (m *ReorderPolicyMutation) ClearSupplier() {
+	m.supplier = nil
+	m.clearedFields[reorderpolicy.FieldSupplier] = struct{}{}
+}
+
+// SupplierCleared returns if the "supplier" field was cleared in this mutation.
+func (m *ReorderPolicyMutation) SupplierCleared() bool {
+	_, ok := m.clearedFields[reorderpolicy.FieldSupplier]
+	return ok
+}
+
+// ResetSupplier resets all changes to the "supplier" field.
+func (m *ReorderPolicyMutation) ResetSupplier() {
+	m.supplier = nil
+	delete(m.clearedFields, reorderpolicy.FieldSupplier)
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (m *ReorderPolicyMutation) ClearItem() {
+	m.cleareditem = true
+	m.clearedFields[reorderpolicy.FieldItemID] = struct{}{}
+}
+
+// ItemCleared reports if the "item" edge to the Item entity was cleared.
+func (m *ReorderPolicyMutation) ItemCleared() bool {
+	return m.cleareditem
+}
+
+// ItemIDs returns the "item" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ItemID instead. It exists only for internal usage by the builders.
+func (m *ReorderPolicyMutation) ItemIDs() (ids []int) {
+	if id := m.item; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetItem resets all changes to the "item" edge.
+func (m *ReorderPolicyMutation) ResetItem() {
+	m.item = nil
+	m.cleareditem = false
+}
+
+// SetLocationID sets the "location" edge to the Location entity by id.
+func (m *ReorderPolicyMutation) SetLocationID(id int) {
+	m.location = &id
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (m *ReorderPolicyMutation) ClearLocation() {
+	m.clearedlocation = true
+}
+
+// LocationCleared reports if the "location" edge to the Location entity was cleared.
+func (m *ReorderPolicyMutation) LocationCleared() bool {
+	return m.clearedlocation
+}
+
+// LocationID returns the "location" edge ID in the mutation.
+func (m *ReorderPolicyMutation) LocationID() (id int, exists bool) {
+	if m.location != nil {
+		return *m.location, true
+	}
+	return
+}
+
+// LocationIDs returns the "location" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LocationID instead. It exists only for internal usage by the builders.
+func (m *ReorderPolicyMutation) LocationIDs() (ids []int) {
+	if id := m.location; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLocation resets all changes to the "location" edge.
+func (m *ReorderPolicyMutation) ResetLocation() {
+	m.location = nil
+	m.clearedlocation = false
+}
+
+// Where appends a list predicates to the ReorderPolicyMutation builder.
+func (m *ReorderPolicyMutation) Where(ps ...predicate.ReorderPolicy) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ReorderPolicyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ReorderPolicyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ReorderPolicy, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ReorderPolicyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ReorderPolicyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ReorderPolicy).
+func (m *ReorderPolicyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReorderPolicyMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.reorder_point != nil {
+		fields = append(fields, reorderpolicy.FieldReorderPoint)
+	}
+	if m.safety_stock != nil {
+		fields = append(fields, reorderpolicy.FieldSafetyStock)
+	}
+	if m.reorder_quantity != nil {
+		fields = append(fields, reorderpolicy.FieldReorderQuantity)
+	}
+	if m.lead_time_days != nil {
+		fields = append(fields, reorderpolicy.FieldLeadTimeDays)
+	}
+	if m.item != nil {
+		fields = append(fields, reorderpolicy.FieldItemID)
+	}
+	if m.supplier != nil {
+		fields = append(fields, reorderpolicy.FieldSupplier)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReorderPolicyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case reorderpolicy.FieldReorderPoint:
+		return m.ReorderPoint()
+	case reorderpolicy.FieldSafetyStock:
+		return m.SafetyStock()
+	case reorderpolicy.FieldReorderQuantity:
+		return m.ReorderQuantity()
+	case reorderpolicy.FieldLeadTimeDays:
+		return m.LeadTimeDays()
+	case reorderpolicy.FieldItemID:
+		return m.ItemID()
+	case reorderpolicy.FieldSupplier:
+		return m.Supplier()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReorderPolicyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case reorderpolicy.FieldReorderPoint:
+		return m.OldReorderPoint(ctx)
+	case reorderpolicy.FieldSafetyStock:
+		return m.OldSafetyStock(ctx)
+	case reorderpolicy.FieldReorderQuantity:
+		return m.OldReorderQuantity(ctx)
+	case reorderpolicy.FieldLeadTimeDays:
+		return m.OldLeadTimeDays(ctx)
+	case reorderpolicy.FieldItemID:
+		return m.OldItemID(ctx)
+	case reorderpolicy.FieldSupplier:
+		return m.OldSupplier(ctx)
+	}
+	return nil, fmt.Errorf("unknown ReorderPolicy field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReorderPolicyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case reorderpolicy.FieldReorderPoint:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReorderPoint(v)
+		return nil
+	case reorderpolicy.FieldSafetyStock:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSafetyStock(v)
+		return nil
+	case reorderpolicy.FieldReorderQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReorderQuantity(v)
+		return nil
+	case reorderpolicy.FieldLeadTimeDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeadTimeDays(v)
+		return nil
+	case reorderpolicy.FieldItemID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetItemID(v)
+		return nil
+	case reorderpolicy.FieldSupplier:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSupplier(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ReorderPolicy field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReorderPolicyMutation) AddedFields() []string {
+	var fields []string
+	if m.addreorder_point != nil {
+		fields = append(fields, reorderpolicy.FieldReorderPoint)
+	}
+	if m.addsafety_stock != nil {
+		fields = append(fields, reorderpolicy.FieldSafetyStock)
+	}
+	if m.addreorder_quantity != nil {
+		fields = append(fields, reorderpolicy.FieldReorderQuantity)
+	}
+	if m.addlead_time_days != nil {
+		fields = append(fields, reorderpolicy.FieldLeadTimeDays)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReorderPolicyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case reorderpolicy.FieldReorderPoint:
+		return m.AddedReorderPoint()
+	case reorderpolicy.FieldSafetyStock:
+		return m.AddedSafetyStock()
+	case reorderpolicy.FieldReorderQuantity:
+		return m.AddedReorderQuantity()
+	case reorderpolicy.FieldLeadTimeDays:
+		return m.AddedLeadTimeDays()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReorderPolicyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case reorderpolicy.FieldReorderPoint:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReorderPoint(v)
+		return nil
+	case reorderpolicy.FieldSafetyStock:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSafetyStock(v)
+		return nil
+	case reorderpolicy.FieldReorderQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReorderQuantity(v)
+		return nil
+	case reorderpolicy.FieldLeadTimeDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLeadTimeDays(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ReorderPolicy numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReorderPolicyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(reorderpolicy.FieldSupplier) {
+		fields = append(fields, reorderpolicy.FieldSupplier)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReorderPolicyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReorderPolicyMutation) ClearField(name string) error {
+	switch name {
+	case reorderpolicy.FieldSupplier:
+		m.ClearSupplier()
+		return nil
+	}
+	return fmt.Errorf("unknown ReorderPolicy nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReorderPolicyMutation) ResetField(name string) error {
+	switch name {
+	case reorderpolicy.FieldReorderPoint:
+		m.ResetReorderPoint()
+		return nil
+	case reorderpolicy.FieldSafetyStock:
+		m.ResetSafetyStock()
+		return nil
+	case reorderpolicy.FieldReorderQuantity:
+		m.ResetReorderQuantity()
+		return nil
+	case reorderpolicy.FieldLeadTimeDays:
+		m.ResetLeadTimeDays()
+		return nil
+	case reorderpolicy.FieldItemID:
+		m.ResetItemID()
+		return nil
+	case reorderpolicy.FieldSupplier:
+		m.ResetSupplier()
+		return nil
+	}
+	return fmt.Errorf("unknown ReorderPolicy field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReorderPolicyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.item != nil {
+		edges = append(edges, reorderpolicy.EdgeItem)
+	}
+	if m.location != nil {
+		edges = append(edges, reorderpolicy.EdgeLocation)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReorderPolicyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case reorderpolicy.EdgeItem:
+		if id := m.item; id != nil {
+			return []ent.Value{*id}
+		}
+	case reorderpolicy.EdgeLocation:
+		if id := m.location; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReorderPolicyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReorderPolicyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReorderPolicyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareditem {
+		edges = append(edges, reorderpolicy.EdgeItem)
+	}
+	if m.clearedlocation {
+		edges = append(edges, reorderpolicy.EdgeLocation)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReorderPolicyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case reorderpolicy.EdgeItem:
+		return m.cleareditem
+	case reorderpolicy.EdgeLocation:
+		return m.clearedlocation
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReorderPolicyMutation) ClearEdge(name string) error {
+	switch name {
+	case reorderpolicy.EdgeItem:
+		m.ClearItem()
+		return nil
+	case reorderpolicy.EdgeLocation:
+		m.ClearLocation()
+		return nil
+	}
+	return fmt.Errorf("unknown ReorderPolicy unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReorderPolicyMutation) ResetEdge(name string) error {
+	switch name {
+	case reorderpolicy.EdgeItem:
+		m.ResetItem()
+		return nil
+	case reorderpolicy.EdgeLocation:
+		m.ResetLocation()
+		return nil
+	}
+	return fmt.Errorf("unknown ReorderPolicy edge %s", name)
+}
+
+// ReplenishmentRuleMutation represents an operation that mutates the ReplenishmentRule nodes in the graph.
+type ReplenishmentRuleMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	min           *int
+	addmin        *int
+	max           *int
+	addmax        *int
+	clearedFields map[string]struct{}
+	bin           *int
+	clearedbin    bool
+	item          *int
+	cleareditem   bool
+	done          bool
+	oldValue      func(context.Context) (*ReplenishmentRule, error)
+	predicates    []predicate.ReplenishmentRule
+}
+
+var _ ent.Mutation = (*ReplenishmentRuleMutation)(nil)
+
+// replenishmentruleOption allows management of the mutation configuration using functional options.
+type replenishmentruleOption func(*ReplenishmentRuleMutation)
+
+// newReplenishmentRuleMutation creates new mutation for the ReplenishmentRule entity.
+func newReplenishmentRuleMutation(c config, op Op, opts ...replenishmentruleOption) *ReplenishmentRuleMutation {
+	m := &ReplenishmentRuleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReplenishmentRule,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReplenishmentRuleID sets the ID field of the mutation.
+func withReplenishmentRuleID(id int) replenishmentruleOption {
+	return func(m *ReplenishmentRuleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ReplenishmentRule
+		)
+		m.oldValue = func(ctx context.Context) (*ReplenishmentRule, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ReplenishmentRule.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReplenishmentRule sets the old ReplenishmentRule of the mutation.
+func withReplenishmentRule(node *ReplenishmentRule) replenishmentruleOption {
+	return func(m *ReplenishmentRuleMutation) {
+		m.oldValue = func(context.Context) (*ReplenishmentRule, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReplenishmentRuleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReplenishmentRuleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReplenishmentRuleMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReplenishmentRuleMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ReplenishmentRule.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMin sets the "min" field.
+func (m *ReplenishmentRuleMutation) SetMin(i int) {
+	m.min = &i
+	m.addmin = nil
+}
+
+// Min returns the value of the "min" field in the mutation.
+func (m *ReplenishmentRuleMutation) Min() (r int, exists bool) {
+	v := m.min
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMin returns the old "min" field's value of the ReplenishmentRule entity.
+// If the ReplenishmentRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReplenishmentRuleMutation) OldMin(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMin: %w", err)
+	}
+	return oldValue.Min, nil
+}
+
+// AddMin adds i to the "min" field.
+func (m *ReplenishmentRuleMutation) AddMin(i int) {
+	if m.addmin != nil {
+		*m.addmin += i
+	} else {
+		m.addmin = &i
+	}
+}
+
+// AddedMin returns the value that was added to the "min" field in this mutation.
+func (m *ReplenishmentRuleMutation) AddedMin() (r int, exists bool) {
+	v := m.addmin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMin resets all changes to the "min" field.
+func (m *ReplenishmentRuleMutation) ResetMin() {
+	m.min = nil
+	m.addmin = nil
+}
+
+// SetMax sets the "max" field.
+func (m *ReplenishmentRuleMutation) SetMax(i int) {
+	m.max = &i
+	m.addmax = nil
+}
+
+// Max returns the value of the "max" field in the mutation.
+func (m *ReplenishmentRuleMutation) Max() (r int, exists bool) {
+	v := m.max
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMax returns the old "max" field's value of the ReplenishmentRule entity.
+// If the ReplenishmentRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReplenishmentRuleMutation) OldMax(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMax is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMax requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMax: %w", err)
+	}
+	return oldValue.Max, nil
+}
+
+// AddMax adds i to the "max" field.
+func (m *ReplenishmentRuleMutation) AddMax(i int) {
+	if m.addmax != nil {
+		*m.addmax += i
+	} else {
+		m.addmax = &i
+	}
+}
+
+// AddedMax returns the value that was added to the "max" field in this mutation.
+func (m *ReplenishmentRuleMutation) AddedMax() (r int, exists bool) {
+	v := m.addmax
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMax resets all changes to the "max" field.
+func (m *ReplenishmentRuleMutation) ResetMax() {
+	m.max = nil
+	m.addmax = nil
+}
+
+// SetBinID sets the "bin" edge to the Bin entity by id.
+func (m *ReplenishmentRuleMutation) SetBinID(id int) {
+	m.bin = &id
+}
+
+// ClearBin clears the "bin" edge to the Bin entity.
+func (m *ReplenishmentRuleMutation) ClearBin() {
+	m.clearedbin = true
+}
+
+// BinCleared reports if the "bin" edge to the Bin entity was cleared.
+func (m *ReplenishmentRuleMutation) BinCleared() bool {
+	return m.clearedbin
+}
+
+// BinID returns the "bin" edge ID in the mutation.
+func (m *ReplenishmentRuleMutation) BinID() (id int, exists bool) {
+	if m.bin != nil {
+		return *m.bin, true
+	}
+	return
+}
+
+// BinIDs returns the "bin" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BinID instead. It exists only for internal usage by the builders.
+func (m *ReplenishmentRuleMutation) BinIDs() (ids []int) {
+	if id := m.bin; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBin resets all changes to the "bin" edge.
+func (m *ReplenishmentRuleMutation) ResetBin() {
+	m.bin = nil
+	m.clearedbin = false
+}
+
+// SetItemID sets the "item" edge to the Item entity by id.
+func (m *ReplenishmentRuleMutation) SetItemID(id int) {
+	m.item = &id
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (m *ReplenishmentRuleMutation) ClearItem() {
+	m.cleareditem = true
+}
+
+// ItemCleared reports if the "item" edge to the Item entity was cleared.
+func (m *ReplenishmentRuleMutation) ItemCleared() bool {
+	return m.cleareditem
+}
+
+// ItemID returns the "item" edge ID in the mutation.
+func (m *ReplenishmentRuleMutation) ItemID() (id int, exists bool) {
+	if m.item != nil {
+		return *m.item, true
+	}
+	return
+}
+
+// ItemIDs returns the "item" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ItemID instead. It exists only for internal usage by the builders.
+func (m *ReplenishmentRuleMutation) ItemIDs() (ids []int) {
+	if id := m.item; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetItem resets all changes to the "item" edge.
+func (m *ReplenishmentRuleMutation) ResetItem() {
+	m.item = nil
+	m.cleareditem = false
+}
+
+// Where appends a list predicates to the ReplenishmentRuleMutation builder.
+func (m *ReplenishmentRuleMutation) Where(ps ...predicate.ReplenishmentRule) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ReplenishmentRuleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ReplenishmentRuleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ReplenishmentRule, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ReplenishmentRuleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ReplenishmentRuleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ReplenishmentRule).
+func (m *ReplenishmentRuleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReplenishmentRuleMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.min != nil {
+		fields = append(fields, replenishmentrule.FieldMin)
+	}
+	if m.max != nil {
+		fields = append(fields, replenishmentrule.FieldMax)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReplenishmentRuleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case replenishmentrule.FieldMin:
+		return m.Min()
+	case replenishmentrule.FieldMax:
+		return m.Max()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReplenishmentRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case replenishmentrule.FieldMin:
+		return m.OldMin(ctx)
+	case replenishmentrule.FieldMax:
+		return m.OldMax(ctx)
+	}
+	return nil, fmt.Errorf("unknown ReplenishmentRule field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReplenishmentRuleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case replenishmentrule.FieldMin:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMin(v)
+		return nil
+	case replenishmentrule.FieldMax:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMax(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ReplenishmentRule field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReplenishmentRuleMutation) AddedFields() []string {
+	var fields []string
+	if m.addmin != nil {
+		fields = append(fields, replenishmentrule.FieldMin)
+	}
+	if m.addmax != nil {
+		fields = append(fields, replenishmentrule.FieldMax)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReplenishmentRuleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case replenishmentrule.FieldMin:
+		return m.AddedMin()
+	case replenishmentrule.FieldMax:
+		return m.AddedMax()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReplenishmentRuleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case replenishmentrule.FieldMin:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMin(v)
+		return nil
+	case replenishmentrule.FieldMax:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMax(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ReplenishmentRule numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReplenishmentRuleMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReplenishmentRuleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReplenishmentRuleMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ReplenishmentRule nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReplenishmentRuleMutation) ResetField(name string) error {
+	switch name {
+	case replenishmentrule.FieldMin:
+		m.ResetMin()
+		return nil
+	case replenishmentrule.FieldMax:
+		m.ResetMax()
+		return nil
+	}
+	return fmt.Errorf("unknown ReplenishmentRule field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReplenishmentRuleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.bin != nil {
+		edges = append(edges, replenishmentrule.EdgeBin)
+	}
+	if m.item != nil {
+		edges = append(edges, replenishmentrule.EdgeItem)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReplenishmentRuleMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case replenishmentrule.EdgeBin:
+		if id := m.bin; id != nil {
+			return []ent.Value{*id}
+		}
+	case replenishmentrule.EdgeItem:
+		if id := m.item; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReplenishmentRuleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReplenishmentRuleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReplenishmentRuleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedbin {
+		edges = append(edges, replenishmentrule.EdgeBin)
+	}
+	if m.cleareditem {
+		edges = append(edges, replenishmentrule.EdgeItem)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReplenishmentRuleMutation) EdgeCleared(name string) bool {
+	switch name {
+	case replenishmentrule.EdgeBin:
+		return m.clearedbin
+	case replenishmentrule.EdgeItem:
+		return m.cleareditem
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReplenishmentRuleMutation) ClearEdge(name string) error {
+	switch name {
+	case replenishmentrule.EdgeBin:
+		m.ClearBin()
+		return nil
+	case replenishmentrule.EdgeItem:
+		m.ClearItem()
+		return nil
+	}
+	return fmt.Errorf("unknown ReplenishmentRule unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReplenishmentRuleMutation) ResetEdge(name string) error {
+	switch name {
+	case replenishmentrule.EdgeBin:
+		m.ResetBin()
+		return nil
+	case replenishmentrule.EdgeItem:
+		m.ResetItem()
+		return nil
+	}
+	return fmt.Errorf("unknown ReplenishmentRule edge %s", name)
+}
+
+// ReplenishmentTaskMutation represents an operation that mutates the ReplenishmentTask nodes in the graph.
+type ReplenishmentTaskMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	quantity        *int
+	addquantity     *int
+	kind            *string
+	status          *string
+	created_at      *time.Time
+	done_at         *time.Time
+	clearedFields   map[string]struct{}
+	item            *int
+	cleareditem     bool
+	from_bin        *int
+	clearedfrom_bin bool
+	to_bin          *int
+	clearedto_bin   bool
+	done            bool
+	oldValue        func(context.Context) (*ReplenishmentTask, error)
+	predicates      []predicate.ReplenishmentTask
+}
+
+var _ ent.Mutation = (*ReplenishmentTaskMutation)(nil)
+
+// replenishmenttaskOption allows management of the mutation configuration using functional options.
+type replenishmenttaskOption func(*ReplenishmentTaskMutation)
+
+// newReplenishmentTaskMutation creates new mutation for the ReplenishmentTask entity.
+func newReplenishmentTaskMutation(c config, op Op, opts ...replenishmenttaskOption) *ReplenishmentTaskMutation {
+	m := &ReplenishmentTaskMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReplenishmentTask,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReplenishmentTaskID sets the ID field of the mutation.
+func withReplenishmentTaskID(id int) replenishmenttaskOption {
+	return func(m *ReplenishmentTaskMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ReplenishmentTask
+		)
+		m.oldValue = func(ctx context.Context) (*ReplenishmentTask, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ReplenishmentTask.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReplenishmentTask sets the old ReplenishmentTask of the mutation.
+func withReplenishmentTask(node *ReplenishmentTask) replenishmenttaskOption {
+	return func(m *ReplenishmentTaskMutation) {
+		m.oldValue = func(context.Context) (*ReplenishmentTask, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReplenishmentTaskMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReplenishmentTaskMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReplenishmentTaskMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReplenishmentTaskMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ReplenishmentTask.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetQuantity sets the "quantity" field.
+func (m *ReplenishmentTaskMutation) SetQuantity(i int) {
+	m.quantity = &i
+	m.addquantity = nil
+}
+
+// Quantity returns the value of the "quantity" field in the mutation.
+func (m *ReplenishmentTaskMutation) Quantity() (r int, exists bool) {
+	v := m.quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuantity returns the old "quantity" field's value of the ReplenishmentTask entity.
+// If the ReplenishmentTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReplenishmentTaskMutation) OldQuantity(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
+	}
+	return oldValue.Quantity, nil
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (m *ReplenishmentTaskMutation) AddQuantity(i int) {
+	if m.addquantity != nil {
+		*m.addquantity += i
+	} else {
+		m.addquantity = &i
+	}
+}
+
+// AddedQuantity returns the value that was added to the "quantity" field in this mutation.
+func (m *ReplenishmentTaskMutation) AddedQuantity() (r int, exists bool) {
+	v := m.addquantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetQuantity resets all changes to the "quantity" field.
+func (m *ReplenishmentTaskMutation) ResetQuantity() {
+	m.quantity = nil
+	m.addquantity = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *ReplenishmentTaskMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *ReplenishmentTaskMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the ReplenishmentTask entity.
+// If the ReplenishmentTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReplenishmentTaskMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *ReplenishmentTaskMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ReplenishmentTaskMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ReplenishmentTaskMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ReplenishmentTask entity.
+// If the ReplenishmentTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReplenishmentTaskMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ReplenishmentTaskMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ReplenishmentTaskMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ReplenishmentTaskMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ReplenishmentTask entity.
+// If the ReplenishmentTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReplenishmentTaskMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ReplenishmentTaskMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetDoneAt sets the "done_at" field.
+func (m *ReplenishmentTaskMutation) SetDoneAt(t time.Time) {
+	m.done_at = &t
+}
+
+// DoneAt returns the value of the "done_at" field in the mutation.
+func (m *ReplenishmentTaskMutation) DoneAt() (r time.Time, exists bool) {
+	v := m.done_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDoneAt returns the old "done_at" field's value of the ReplenishmentTask entity.
+// If the ReplenishmentTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReplenishmentTaskMutation) OldDoneAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDoneAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDoneAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDoneAt: %w", err)
+	}
+	return oldValue.DoneAt, nil
+}
+
+// ClearDoneAt clears the value of the "done_at" field.
+func (m *ReplenishmentTaskMutation) ClearDoneAt() {
+	m.done_at = nil
+	m.clearedFields[replenishmenttask.FieldDoneAt] = struct{}{}
+}
+
+// DoneAtCleared returns if the "done_at" field was cleared in this mutation.
+func (m *ReplenishmentTaskMutation) DoneAtCleared() bool {
+	_, ok := m.clearedFields[replenishmenttask.FieldDoneAt]
+	return ok
+}
+
+// ResetDoneAt resets all changes to the "done_at" field.
+func (m *ReplenishmentTaskMutation) ResetDoneAt() {
+	m.done_at = nil
+	delete(m.clearedFields, replenishmenttask.FieldDoneAt)
+}
+
+// SetItemID sets the "item" edge to the Item entity by id.
+func (m *ReplenishmentTaskMutation) SetItemID(id int) {
+	m.item = &id
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (m *ReplenishmentTaskMutation) ClearItem() {
+	m.cleareditem = true
+}
+
+// ItemCleared reports if the "item" edge to the Item entity was cleared.
+func (m *ReplenishmentTaskMutation) ItemCleared() bool {
+	return m.cleareditem
+}
+
+// ItemID returns the "item" edge ID in the mutation.
+func (m *ReplenishmentTaskMutation) ItemID() (id int, exists bool) {
+	if m.item != nil {
+		return *m.item, true
+	}
+	return
+}
+
+// ItemIDs returns the "item" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ItemID instead. It exists only for internal usage by the builders.
+func (m *ReplenishmentTaskMutation) ItemIDs() (ids []int) {
+	if id := m.item; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetItem resets all changes to the "item" edge.
+func (m *ReplenishmentTaskMutation) ResetItem() {
+	m.item = nil
+	m.cleareditem = false
+}
+
+// SetFromBinID sets the "from_bin" edge to the Bin entity by id.
+func (m *ReplenishmentTaskMutation) SetFromBinID(id int) {
+	m.from_bin = &id
+}
+
+// ClearFromBin clears the "from_bin" edge to the Bin entity.
+func (m *ReplenishmentTaskMutation) ClearFromBin() {
+	m.clearedfrom_bin = true
+}
+
+// FromBinCleared reports if the "from_bin" edge to the Bin entity was cleared.
+func (m *ReplenishmentTaskMutation) FromBinCleared() bool {
+	return m.clearedfrom_bin
+}
+
+// FromBinID returns the "from_bin" edge ID in the mutation.
+func (m *ReplenishmentTaskMutation) FromBinID() (id int, exists bool) {
+	if m.from_bin != nil {
+		return *m.from_bin, true
+	}
+	return
+}
+
+// FromBinIDs returns the "from_bin" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FromBinID instead. It exists only for internal usage by the builders.
+func (m *ReplenishmentTaskMutation) FromBinIDs() (ids []int) {
+	if id := m.from_bin; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFromBin resets all changes to the "from_bin" edge.
+func (m *ReplenishmentTaskMutation) ResetFromBin() {
+	m.from_bin = nil
+	m.clearedfrom_bin = false
+}
+
+// SetToBinID sets the "to_bin" edge to the Bin entity by id.
+func (m *ReplenishmentTaskMutation) SetToBinID(id int) {
+	m.to_bin = &id
+}
+
+// ClearToBin clears the "to_bin" edge to the Bin entity.
+func (m *ReplenishmentTaskMutation) ClearToBin() {
+	m.clearedto_bin = true
+}
+
+// ToBinCleared reports if the "to_bin" edge to the Bin entity was cleared.
+func (m *ReplenishmentTaskMutation) ToBinCleared() bool {
+	return m.clearedto_bin
+}
+
+// ToBinID returns the "to_bin" edge ID in the mutation.
+func (m *ReplenishmentTaskMutation) ToBinID() (id int, exists bool) {
+	if m.to_bin != nil {
+		return *m.to_bin, true
+	}
+	return
+}
+
+// ToBinIDs returns the "to_bin" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ToBinID instead. It exists only for internal usage by the builders.
+func (m *ReplenishmentTaskMutation) ToBinIDs() (ids []int) {
+	if id := m.to_bin; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetToBin resets all changes to the "to_bin" edge.
+func (m *ReplenishmentTaskMutation) ResetToBin() {
+	m.to_bin = nil
+	m.clearedto_bin = false
+}
+
+// Where appends a list predicates to the ReplenishmentTaskMutation builder.
+func (m *ReplenishmentTaskMutation) Where(ps ...predicate.ReplenishmentTask) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ReplenishmentTaskMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ReplenishmentTaskMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ReplenishmentTask, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ReplenishmentTaskMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ReplenishmentTaskMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ReplenishmentTask).
+func (m *ReplenishmentTaskMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReplenishmentTaskMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.quantity != nil {
+		fields = append(fields, replenishmenttask.FieldQuantity)
+	}
+	if m.kind != nil {
+		fields = append(fields, replenishmenttask.FieldKind)
+	}
+	if m.status != nil {
+		fields = append(fields, replenishmenttask.FieldStatus)
+	}
+	if m.created_at != nil {
+		fields = append(fields, replenishmenttask.FieldCreatedAt)
+	}
+	if m.done_at != nil {
+		fields = append(fields, replenishmenttask.FieldDoneAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReplenishmentTaskMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case replenishmenttask.FieldQuantity:
+		return m.Quantity()
+	case replenishmenttask.FieldKind:
+		return m.Kind()
+	case replenishmenttask.FieldStatus:
+		return m.Status()
+	case replenishmenttask.FieldCreatedAt:
+		return m.CreatedAt()
+	case replenishmenttask.FieldDoneAt:
+		return m.DoneAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReplenishmentTaskMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case replenishmenttask.FieldQuantity:
+		return m.OldQuantity(ctx)
+	case replenishmenttask.FieldKind:
+		return m.OldKind(ctx)
+	case replenishmenttask.FieldStatus:
+		return m.OldStatus(ctx)
+	case replenishmenttask.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case replenishmenttask.FieldDoneAt:
+		return m.OldDoneAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ReplenishmentTask field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReplenishmentTaskMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case replenishmenttask.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuantity(v)
+		return nil
+	case replenishmenttask.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case replenishmenttask.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case replenishmenttask.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case replenishmenttask.FieldDoneAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDoneAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ReplenishmentTask field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReplenishmentTaskMutation) AddedFields() []string {
+	var fields []string
+	if m.addquantity != nil {
+		fields = append(fields, replenishmenttask.FieldQuantity)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReplenishmentTaskMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case replenishmenttask.FieldQuantity:
+		return m.AddedQuantity()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReplenishmentTaskMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case replenishmenttask.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuantity(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ReplenishmentTask numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReplenishmentTaskMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(replenishmenttask.FieldDoneAt) {
+		fields = append(fields, replenishmenttask.FieldDoneAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReplenishmentTaskMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReplenishmentTaskMutation) ClearField(name string) error {
+	switch name {
+	case replenishmenttask.FieldDoneAt:
+		m.ClearDoneAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ReplenishmentTask nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReplenishmentTaskMutation) ResetField(name string) error {
+	switch name {
+	case replenishmenttask.FieldQuantity:
+		m.ResetQuantity()
+		return nil
+	case replenishmenttask.FieldKind:
+		m.ResetKind()
+		return nil
+	case replenishmenttask.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case replenishmenttask.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case replenishmenttask.FieldDoneAt:
+		m.ResetDoneAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ReplenishmentTask field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReplenishmentTaskMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.item != nil {
+		edges = append(edges, replenishmenttask.EdgeItem)
+	}
+	if m.from_bin != nil {
+		edges = append(edges, replenishmenttask.EdgeFromBin)
+	}
+	if m.to_bin != nil {
+		edges = append(edges, replenishmenttask.EdgeToBin)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReplenishmentTaskMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case replenishmenttask.EdgeItem:
+		if id := m.item; id != nil {
+			return []ent.Value{*id}
+		}
+	case replenishmenttask.EdgeFromBin:
+		if id := m.from_bin; id != nil {
+			return []ent.Value{*id}
+		}
+	case replenishmenttask.EdgeToBin:
+		if id := m.to_bin; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReplenishmentTaskMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReplenishmentTaskMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReplenishmentTaskMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.cleareditem {
+		edges = append(edges, replenishmenttask.EdgeItem)
+	}
+	if m.clearedfrom_bin {
+		edges = append(edges, replenishmenttask.EdgeFromBin)
+	}
+	if m.clearedto_bin {
+		edges = append(edges, replenishmenttask.EdgeToBin)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReplenishmentTaskMutation) EdgeCleared(name string) bool {
+	switch name {
+	case replenishmenttask.EdgeItem:
+		return m.cleareditem
+	case replenishmenttask.EdgeFromBin:
+		return m.clearedfrom_bin
+	case replenishmenttask.EdgeToBin:
+		return m.clearedto_bin
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReplenishmentTaskMutation) ClearEdge(name string) error {
+	switch name {
+	case replenishmenttask.EdgeItem:
+		m.ClearItem()
+		return nil
+	case replenishmenttask.EdgeFromBin:
+		m.ClearFromBin()
+		return nil
+	case replenishmenttask.EdgeToBin:
+		m.ClearToBin()
+		return nil
+	}
+	return fmt.Errorf("unknown ReplenishmentTask unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReplenishmentTaskMutation) ResetEdge(name string) error {
+	switch name {
+	case replenishmenttask.EdgeItem:
+		m.ResetItem()
+		return nil
+	case replenishmenttask.EdgeFromBin:
+		m.ResetFromBin()
+		return nil
+	case replenishmenttask.EdgeToBin:
+		m.ResetToBin()
+		return nil
+	}
+	return fmt.Errorf("unknown ReplenishmentTask edge %s", name)
+}
+
+// SequenceMutation represents an operation that mutates the Sequence nodes in the graph.
+type SequenceMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	prefix        *string
+	date_pattern  *string
+	width         *int
+	addwidth      *int
+	value         *int
+	addvalue      *int
+	period        *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Sequence, error)
+	predicates    []predicate.Sequence
+}
+
+var _ ent.Mutation = (*SequenceMutation)(nil)
+
+// sequenceOption allows management of the mutation configuration using functional options.
+type sequenceOption func(*SequenceMutation)
+
+// newSequenceMutation creates new mutation for the Sequence entity.
+func newSequenceMutation(c config, op Op, opts ...sequenceOption) *SequenceMutation {
+	m := &SequenceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSequence,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSequenceID sets the ID field of the mutation.
+func withSequenceID(id int) sequenceOption {
+	return func(m *SequenceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Sequence
+		)
+		m.oldValue = func(ctx context.Context) (*Sequence, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Sequence.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSequence sets the old Sequence of the mutation.
+func withSequence(node *Sequence) sequenceOption {
+	return func(m *SequenceMutation) {
+		m.oldValue = func(context.Context) (*Sequence, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SequenceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SequenceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SequenceMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SequenceMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Sequence.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *SequenceMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *SequenceMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Sequence entity.
+// If the Sequence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SequenceMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *SequenceMutation) ResetName() {
+	m.name = nil
+}
+
+// SetPrefix sets the "prefix" field.
+func (m *SequenceMutation) SetPrefix(s string) {
+	m.prefix = &s
+}
+
+// Prefix returns the value of the "prefix" field in the mutation.
+func (m *SequenceMutation) Prefix() (r string, exists bool) {
+	v := m.prefix
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrefix returns the old "prefix" field's value of the Sequence entity.
+// If the Sequence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SequenceMutation) OldPrefix(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrefix is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrefix requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrefix: %w", err)
+	}
+	return oldValue.Prefix, nil
+}
+
+// ResetPrefix resets all changes to the "prefix" field.
+func (m *SequenceMutation) ResetPrefix() {
+	m.prefix = nil
+}
+
+// SetDatePattern sets the "date_pattern" field.
+func (m *SequenceMutation) SetDatePattern(s string) {
+	m.date_pattern = &s
+}
+
+// DatePattern returns the value of the "date_pattern" field in the mutation.
+func (m *SequenceMutation) DatePattern() (r string, exists bool) {
+	v := m.date_pattern
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDatePattern returns the old "date_pattern" field's value of the Sequence entity.
+// If the Sequence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SequenceMutation) OldDatePattern(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDatePattern is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDatePattern requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDatePattern: %w", err)
+	}
+	return oldValue.DatePattern, nil
+}
+
+// ResetDatePattern resets all changes to the "date_pattern" field.
+func (m *SequenceMutation) ResetDatePattern() {
+	m.date_pattern = nil
+}
+
+// SetWidth sets the "width" field.
+func (m *SequenceMutation) SetWidth(i int) {
+	m.width = &i
+	m.addwidth = nil
+}
+
+// Width returns the value of the "width" field in the mutation.
+func (m *SequenceMutation) Width() (r int, exists bool) {
+	v := m.width
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWidth returns the old "width" field's value of the Sequence entity.
+// If the Sequence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SequenceMutation) OldWidth(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWidth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWidth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWidth: %w", err)
+	}
+	return oldValue.Width, nil
+}
+
+// AddWidth adds i to the "width" field.
+func (m *SequenceMutation) AddWidth(i int) {
+	if m.addwidth != nil {
+		*m.addwidth += i
+	} else {
+		m.addwidth = &i
+	}
+}
+
+// AddedWidth returns the value that was added to the "width" field in this mutation.
+func (m *SequenceMutation) AddedWidth() (r int, exists bool) {
+	v := m.addwidth
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWidth resets all changes to the "width" field.
+func (m *SequenceMutation) ResetWidth() {
+	m.width = nil
+	m.addwidth = nil
+}
+
+// SetValue sets the "value" field.
+func (m *SequenceMutation) SetValue(i int) {
+	m.value = &i
+	m.addvalue = nil
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *SequenceMutation) Value() (r int, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the Sequence entity.
+// If the Sequence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SequenceMutation) OldValue(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// AddValue adds i to the "value" field.
+func (m *SequenceMutation) AddValue(i int) {
+	if m.addvalue != nil {
+		*m.addvalue += i
+	} else {
+		m.addvalue = &i
+	}
+}
+
+// AddedValue returns the value that was added to the "value" field in this mutation.
+func (m *SequenceMutation) AddedValue() (r int, exists bool) {
+	v := m.addvalue
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *SequenceMutation) ResetValue() {
+	m.value = nil
+	m.addvalue = nil
+}
+
+// SetPeriod sets the "period" field.
+func (m *SequenceMutation) SetPeriod(s string) {
+	m.period = &s
+}
+
+// Period returns the value of the "period" field in the mutation.
+func (m *SequenceMutation) Period() (r string, exists bool) {
+	v := m.period
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPeriod returns the old "period" field's value of the Sequence entity.
+// If the Sequence object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SequenceMutation) OldPeriod(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPeriod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPeriod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPeriod: %w", err)
+	}
+	return oldValue.Period, nil
+}
+
+// ResetPeriod resets all changes to the "period" field.
+func (m *SequenceMutation) ResetPeriod() {
+	m.period = nil
+}
+
+// Where appends a list predicates to the SequenceMutation builder.
+func (m *SequenceMutation) Where(ps ...predicate.Sequence) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SequenceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SequenceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Sequence, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SequenceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SequenceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Sequence).
+func (m *SequenceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SequenceMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.name != nil {
+		fields = append(fields, sequence.FieldName)
+	}
+	if m.prefix != nil {
+		fields = append(fields, sequence.FieldPrefix)
+	}
+	if m.date_pattern != nil {
+		fields = append(fields, sequence.FieldDatePattern)
+	}
+	if m.width != nil {
+		fields = append(fields, sequence.FieldWidth)
+	}
+	if m.value != nil {
+		fields = append(fields, sequence.FieldValue)
+	}
+	if m.period != nil {
+		fields = append(fields, sequence.FieldPeriod)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SequenceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case sequence.FieldName:
+		return m.Name()
+	case sequence.FieldPrefix:
+		return m.Prefix()
+	case sequence.FieldDatePattern:
+		return m.DatePattern()
+	case sequence.FieldWidth:
+		return m.Width()
+	case sequence.FieldValue:
+		return m.Value()
+	case sequence.FieldPeriod:
+		return m.Period()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SequenceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case sequence.FieldName:
+		return m.OldName(ctx)
+	case sequence.FieldPrefix:
+		return m.OldPrefix(ctx)
+	case sequence.FieldDatePattern:
+		return m.OldDatePattern(ctx)
+	case sequence.FieldWidth:
+		return m.OldWidth(ctx)
+	case sequence.FieldValue:
+		return m.OldValue(ctx)
+	case sequence.FieldPeriod:
+		return m.OldPeriod(ctx)
+	}
+	return nil, fmt.Errorf("unknown Sequence field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SequenceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case sequence.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case sequence.FieldPrefix:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrefix(v)
+		return nil
+	case sequence.FieldDatePattern:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDatePattern(v)
+		return nil
+	case sequence.FieldWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWidth(v)
+		return nil
+	case sequence.FieldValue:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case sequence.FieldPeriod:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPeriod(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Sequence field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SequenceMutation) AddedFields() []string {
+	var fields []string
+	if m.addwidth != nil {
+		fields = append(fields, sequence.FieldWidth)
+	}
+	if m.addvalue != nil {
+		fields = append(fields, sequence.FieldValue)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SequenceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case sequence.FieldWidth:
+		return m.AddedWidth()
+	case sequence.FieldValue:
+		return m.AddedValue()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SequenceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case sequence.FieldWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWidth(v)
+		return nil
+	case sequence.FieldValue:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddValue(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Sequence numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SequenceMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SequenceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SequenceMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Sequence nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SequenceMutation) ResetField(name string) error {
+	switch name {
+	case sequence.FieldName:
+		m.ResetName()
+		return nil
+	case sequence.FieldPrefix:
+		m.ResetPrefix()
+		return nil
+	case sequence.FieldDatePattern:
+		m.ResetDatePattern()
+		return nil
+	case sequence.FieldWidth:
+		m.ResetWidth()
+		return nil
+	case sequence.FieldValue:
+		m.ResetValue()
+		return nil
+	case sequence.FieldPeriod:
+		m.ResetPeriod()
+		return nil
+	}
+	return fmt.Errorf("unknown Sequence field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SequenceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SequenceMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SequenceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SequenceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SequenceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SequenceMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SequenceMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Sequence unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SequenceMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Sequence edge %s", name)
+}
+
+// StockDiscrepancyMutation represents an operation that mutates the StockDiscrepancy nodes in the graph.
+type StockDiscrepancyMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	source          *string
+	reference       *string
+	expected        *int
+	addexpected     *int
+	found           *int
+	addfound        *int
+	bin_id          *int
+	addbin_id       *int
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	item            *int
+	cleareditem     bool
+	location        *int
+	clearedlocation bool
+	done            bool
+	oldValue        func(context.Context) (*StockDiscrepancy, error)
+	predicates      []predicate.StockDiscrepancy
+}
+
+var _ ent.Mutation = (*StockDiscrepancyMutation)(nil)
+
+// stockdiscrepancyOption allows management of the mutation configuration using functional options.
+type stockdiscrepancyOption func(*StockDiscrepancyMutation)
+
+// newStockDiscrepancyMutation creates new mutation for the StockDiscrepancy entity.
+func newStockDiscrepancyMutation(c config, op Op, opts ...stockdiscrepancyOption) *StockDiscrepancyMutation {
+	m := &StockDiscrepancyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeStockDiscrepancy,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withStockDiscrepancyID sets the ID field of the mutation.
+func withStockDiscrepancyID(id int) stockdiscrepancyOption {
+	return func(m *StockDiscrepancyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *StockDiscrepancy
+		)
+		m.oldValue = func(ctx context.Context) (*StockDiscrepancy, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().StockDiscrepancy.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withStockDiscrepancy sets the old StockDiscrepancy of the mutation.
+func withStockDiscrepancy(node *StockDiscrepancy) stockdiscrepancyOption {
+	return func(m *StockDiscrepancyMutation) {
+		m.oldValue = func(context.Context) (*StockDiscrepancy, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StockDiscrepancyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StockDiscrepancyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StockDiscrepancyMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StockDiscrepancyMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().StockDiscrepancy.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSource sets the "source" field.
+func (m *StockDiscrepancyMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *StockDiscrepancyMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the StockDiscrepancy entity.
+// If the StockDiscrepancy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StockDiscrepancyMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *StockDiscrepancyMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetReference sets the "reference" field.
+func (m *StockDiscrepancyMutation) SetReference(s string) {
+	m.reference = &s
+}
+
+// Reference returns the value of the "reference" field in the mutation.
+func (m *StockDiscrepancyMutation) Reference() (r string, exists bool) {
+	v := m.reference
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReference returns the old "reference" field's value of the StockDiscrepancy entity.
+// If the StockDiscrepancy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StockDiscrepancyMutation) OldReference(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReference is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReference requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReference: %w", err)
+	}
+	return oldValue.Reference, nil
+}
+
+// ClearReference clears the value of the "reference" field.
+func (m *StockDiscrepancyMutation) ClearReference() {
+	m.reference = nil
+	m.clearedFields[stockdiscrepancy.FieldReference] = struct{}{}
+}
+
+// ReferenceCleared returns if the "reference" field was cleared in this mutation.
+func (m *StockDiscrepancyMutation) ReferenceCleared() bool {
+	_, ok := m.clearedFields[stockdiscrepancy.FieldReference]
+	return ok
+}
+
+// ResetReference resets all changes to the "reference" field.
+func (m *StockDiscrepancyMutation) ResetReference() {
+	m.reference = nil
+	delete(m.clearedFields, stockdiscrepancy.FieldReference)
+}
+
+// SetExpected sets the "expected" field.
+func (m *StockDiscrepancyMutation) SetExpected(i int) {
+	m.expected = &i
+	m.addexpected = nil
+}
+
+// Expected returns the value of the "expected" field in the mutation.
+func (m *StockDiscrepancyMutation) Expected() (r int, exists bool) {
+	v := m.expected
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpected returns the old "expected" field's value of the StockDiscrepancy entity.
+// If the StockDiscrepancy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StockDiscrepancyMutation) OldExpected(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpected is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpected requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpected: %w", err)
+	}
+	return oldValue.Expected, nil
+}
+
+// AddExpected adds i to the "expected" field.
+func (m *StockDiscrepancyMutation) AddExpected(i int) {
+	if m.addexpected != nil {
+		*m.addexpected += i
+	} else {
+		m.addexpected = &i
+	}
+}
+
+// AddedExpected returns the value that was added to the "expected" field in this mutation.
+func (m *StockDiscrepancyMutation) AddedExpected() (r int, exists bool) {
+	v := m.addexpected
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetExpected resets all changes to the "expected" field.
+func (m *StockDiscrepancyMutation) ResetExpected() {
+	m.expected = nil
+	m.addexpected = nil
+}
+
+// SetFound sets the "found" field.
+func (m *StockDiscrepancyMutation) SetFound(i int) {
+	m.found = &i
+	m.addfound = nil
+}
+
+// Found returns the value of the "found" field in the mutation.
+func (m *StockDiscrepancyMutation) Found() (r int, exists bool) {
+	v := m.found
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFound returns the old "found" field's value of the StockDiscrepancy entity.
+// If the StockDiscrepancy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StockDiscrepancyMutation) OldFound(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFound is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFound requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFound: %w", err)
+	}
+	return oldValue.Found, nil
+}
+
+// AddFound adds i to the "found" field.
+func (m *StockDiscrepancyMutation) AddFound(i int) {
+	if m.addfound != nil {
+		*m.addfound += i
+	} else {
+		m.addfound = &i
+	}
+}
+
+// AddedFound returns the value that was added to the "found" field in this mutation.
+func (m *StockDiscrepancyMutation) AddedFound() (r int, exists bool) {
+	v := m.addfound
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFound resets all changes to the "found" field.
+func (m *StockDiscrepancyMutation) ResetFound() {
+	m.found = nil
+	m.addfound = nil
+}
+
+// SetBinID sets the "bin_id" field.
+func (m *StockDiscrepancyMutation) SetBinID(i int) {
+	m.bin_id = &i
+	m.addbin_id = nil
+}
+
+// BinID returns the value of the "bin_id" field in the mutation.
+func (m *StockDiscrepancyMutation) BinID() (r int, exists bool) {
+	v := m.bin_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBinID returns the old "bin_id" field's value of the StockDiscrepancy entity.
+// If the StockDiscrepancy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StockDiscrepancyMutation) OldBinID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBinID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBinID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBinID: %w", err)
+	}
+	return oldValue.BinID, nil
+}
+
+// AddBinID adds i to the "bin_id" field.
+func (m *StockDiscrepancyMutation) AddBinID(i int) {
+	if m.addbin_id != nil {
+		*m.addbin_id += i
+	} else {
+		m.addbin_id = &i
+	}
+}
+
+// AddedBinID returns the value that was added to the "bin_id" field in this mutation.
+func (m *StockDiscrepancyMutation) AddedBinID() (r int, exists bool) {
+	v := m.addbin_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearBinID clears the value of the "bin_id" field.
+func (m *StockDiscrepancyMutation) ClearBinID() {
+	m.bin_id = nil
+	m.addbin_id = nil
+	m.clearedFields[stockdiscrepancy.FieldBinID] = struct{}{}
+}
+
+// BinIDCleared returns if the "bin_id" field was cleared in this mutation.
+func (m *StockDiscrepancyMutation) BinIDCleared() bool {
+	_, ok := m.clearedFields[stockdiscrepancy.FieldBinID]
+	return ok
+}
+
+// ResetBinID resets all changes to the "bin_id" field.
+func (m *StockDiscrepancyMutation) ResetBinID() {
+	m.bin_id = nil
+	m.addbin_id = nil
+	delete(m.clearedFields, stockdiscrepancy.FieldBinID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *StockDiscrepancyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *StockDiscrepancyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the StockDiscrepancy entity.
+// If the StockDiscrepancy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StockDiscrepancyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *StockDiscrepancyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetItemID sets the "item" edge to the Item entity by id.
+func (m *StockDiscrepancyMutation) SetItemID(id int) {
+	m.item = &id
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (m *StockDiscrepancyMutation) ClearItem() {
+	m.cleareditem = true
+}
+
+// ItemCleared reports if the "item" edge to the Item entity was cleared.
+func (m *StockDiscrepancyMutation) ItemCleared() bool {
+	return m.cleareditem
+}
+
+// ItemID returns the "item" edge ID in the mutation.
+func (m *StockDiscrepancyMutation) ItemID() (id int, exists bool) {
+	if m.item != nil {
+		return *m.item, true
+	}
+	return
+}
+
+// ItemIDs returns the "item" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ItemID instead. It exists only for internal usage by the builders.
+func (m *StockDiscrepancyMutation) ItemIDs() (ids []int) {
+	if id := m.item; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetItem resets all changes to the "item" edge.
+func (m *StockDiscrepancyMutation) ResetItem() {
+	m.item = nil
+	m.cleareditem = false
+}
+
+// SetLocationID sets the "location" edge to the Location entity by id.
+func (m *StockDiscrepancyMutation) SetLocationID(id int) {
+	m.location = &id
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (m *StockDiscrepancyMutation) ClearLocation() {
+	m.clearedlocation = true
+}
+
+// LocationCleared reports if the "location" edge to the Location entity was cleared.
+func (m *StockDiscrepancyMutation) LocationCleared() bool {
+	return m.clearedlocation
+}
+
+// LocationID returns the "location" edge ID in the mutation.
+func (m *StockDiscrepancyMutation) LocationID() (id int, exists bool) {
+	if m.location != nil {
+		return *m.location, true
+	}
+	return
+}
+
+// LocationIDs returns the "location" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LocationID instead. It exists only for internal usage by the builders.
+func (m *StockDiscrepancyMutation) LocationIDs() (ids []int) {
+	if id := m.location; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLocation resets all changes to the "location" edge.
+func (m *StockDiscrepancyMutation) ResetLocation() {
+	m.location = nil
+	m.clearedlocation = false
+}
+
+// Where appends a list predicates to the StockDiscrepancyMutation builder.
+func (m *StockDiscrepancyMutation) Where(ps ...predicate.StockDiscrepancy) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the StockDiscrepancyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *StockDiscrepancyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.StockDiscrepancy, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *StockDiscrepancyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *StockDiscrepancyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (StockDiscrepancy).
+func (m *StockDiscrepancyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *StockDiscrepancyMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.source != nil {
+		fields = append(fields, stockdiscrepancy.FieldSource)
+	}
+	if m.reference != nil {
+		fields = append(fields, stockdiscrepancy.FieldReference)
+	}
+	if m.expected != nil {
+		fields = append(fields, stockdiscrepancy.FieldExpected)
+	}
+	if m.found != nil {
+		fields = append(fields, stockdiscrepancy.FieldFound)
+	}
+	if m.bin_id != nil {
+		fields = append(fields, stockdiscrepancy.FieldBinID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, stockdiscrepancy.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *StockDiscrepancyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case stockdiscrepancy.FieldSource:
+		return m.Source()
+	case stockdiscrepancy.FieldReference:
+		return m.Reference()
+	case stockdiscrepancy.FieldExpected:
+		return m.Expected()
+	case stockdiscrepancy.FieldFound:
+		return m.Found()
+	case stockdiscrepancy.FieldBinID:
+		return m.BinID()
+	case stockdiscrepancy.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *StockDiscrepancyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case stockdiscrepancy.FieldSource:
+		return m.OldSource(ctx)
+	case stockdiscrepancy.FieldReference:
+		return m.OldReference(ctx)
+	case stockdiscrepancy.FieldExpected:
+		return m.OldExpected(ctx)
+	case stockdiscrepancy.FieldFound:
+		return m.OldFound(ctx)
+	case stockdiscrepancy.FieldBinID:
+		return m.OldBinID(ctx)
+	case stockdiscrepancy.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown StockDiscrepancy field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StockDiscrepancyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case stockdiscrepancy.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case stockdiscrepancy.FieldReference:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReference(v)
+		return nil
+	case stockdiscrepancy.FieldExpected:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpected(v)
+		return nil
+	case stockdiscrepancy.FieldFound:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFound(v)
+		return nil
+	case stockdiscrepancy.FieldBinID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBinID(v)
+		return nil
+	case stockdiscrepancy.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown StockDiscrepancy field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *StockDiscrepancyMutation) AddedFields() []string {
+	var fields []string
+	if m.addexpected != nil {
+		fields = append(fields, stockdiscrepancy.FieldExpected)
+	}
+	if m.addfound != nil {
+		fields = append(fields, stockdiscrepancy.FieldFound)
+	}
+	if m.addbin_id != nil {
+		fields = append(fields, stockdiscrepancy.FieldBinID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *StockDiscrepancyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case stockdiscrepancy.FieldExpected:
+		return m.AddedExpected()
+	case stockdiscrepancy.FieldFound:
+		return m.AddedFound()
+	case stockdiscrepancy.FieldBinID:
+		return m.AddedBinID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StockDiscrepancyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case stockdiscrepancy.FieldExpected:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExpected(v)
+		return nil
+	case stockdiscrepancy.FieldFound:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFound(v)
+		return nil
+	case stockdiscrepancy.FieldBinID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBinID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown StockDiscrepancy numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *StockDiscrepancyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(stockdiscrepancy.FieldReference) {
+		fields = append(fields, stockdiscrepancy.FieldReference)
+	}
+	if m.FieldCleared(stockdiscrepancy.FieldBinID) {
+		fields = append(fields, stockdiscrepancy.FieldBinID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *StockDiscrepancyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *StockDiscrepancyMutation) ClearField(name string) error {
+	switch name {
+	case stockdiscrepancy.FieldReference:
+		m.ClearReference()
+		return nil
+	case stockdiscrepancy.FieldBinID:
+		m.ClearBinID()
+		return nil
+	}
+	return fmt.Errorf("unknown StockDiscrepancy nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *StockDiscrepancyMutation) ResetField(name string) error {
+	switch name {
+	case stockdiscrepancy.FieldSource:
+		m.ResetSource()
+		return nil
+	case stockdiscrepancy.FieldReference:
+		m.ResetReference()
+		return nil
+	case stockdiscrepancy.FieldExpected:
+		m.ResetExpected()
+		return nil
+	case stockdiscrepancy.FieldFound:
+		m.ResetFound()
+		return nil
+	case stockdiscrepancy.FieldBinID:
+		m.ResetBinID()
+		return nil
+	case stockdiscrepancy.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown StockDiscrepancy field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *StockDiscrepancyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.item != nil {
+		edges = append(edges, stockdiscrepancy.EdgeItem)
+	}
+	if m.location != nil {
+		edges = append(edges, stockdiscrepancy.EdgeLocation)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *StockDiscrepancyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case stockdiscrepancy.EdgeItem:
+		if id := m.item; id != nil {
+			return []ent.Value{*id}
+		}
+	case stockdiscrepancy.EdgeLocation:
+		if id := m.location; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *StockDiscrepancyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *StockDiscrepancyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *StockDiscrepancyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareditem {
+		edges = append(edges, stockdiscrepancy.EdgeItem)
+	}
+	if m.clearedlocation {
+		edges = append(edges, stockdiscrepancy.EdgeLocation)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *StockDiscrepancyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case stockdiscrepancy.EdgeItem:
+		return m.cleareditem
+	case stockdiscrepancy.EdgeLocation:
+		return m.clearedlocation
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *StockDiscrepancyMutation) ClearEdge(name string) error {
+	switch name {
+	case stockdiscrepancy.EdgeItem:
+		m.ClearItem()
+		return nil
+	case stockdiscrepancy.EdgeLocation:
+		m.ClearLocation()
+		return nil
+	}
+	return fmt.Errorf("unknown StockDiscrepancy unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *StockDiscrepancyMutation) ResetEdge(name string) error {
+	switch name {
+	case stockdiscrepancy.EdgeItem:
+		m.ResetItem()
+		return nil
+	case stockdiscrepancy.EdgeLocation:
+		m.ResetLocation()
+		return nil
+	}
+	return fmt.Errorf("unknown StockDiscrepancy edge %s", name)
+}
+
+// StockMovementMutation represents an operation that mutates the StockMovement nodes in the graph.
+type StockMovementMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	_type           *string
+	quantity        *int
+	addquantity     *int
+	created_at      *time.Time
+	reference       *string
+	idempotency_key *string
+	clearedFields   map[string]struct{}
+	item            *int
+	cleareditem     bool
+	location        *int
+	clearedlocation bool
+	done            bool
+	oldValue        func(context.Context) (*StockMovement, error)
+	predicates      []predicate.StockMovement
+}
+
+var _ ent.Mutation = (*StockMovementMutation)(nil)
+
+// stockmovementOption allows management of the mutation configuration using functional options.
+type stockmovementOption func(*StockMovementMutation)
+
+// newStockMovementMutation creates new mutation for the StockMovement entity.
+func newStockMovementMutation(c config, op Op, opts ...stockmovementOption) *StockMovementMutation {
+	m := &StockMovementMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeStockMovement,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withStockMovementID sets the ID field of the mutation.
+func withStockMovementID(id int) stockmovementOption {
+	return func(m *StockMovementMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *StockMovement
+		)
+		m.oldValue = func(ctx context.Context) (*StockMovement, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().StockMovement.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withStockMovement sets the old StockMovement of the mutation.
+func withStockMovement(node *StockMovement) stockmovementOption {
+	return func(m *StockMovementMutation) {
+		m.oldValue = func(context.Context) (*StockMovement, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StockMovementMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StockMovementMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StockMovementMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StockMovementMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().StockMovement.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetType sets the "type" field.
+func (m *StockMovementMutation) SetType(s string) {
+	m._type = &s
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *StockMovementMutation) GetType() (r string, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the StockMovement entity.
+// If the StockMovement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StockMovementMutation) OldType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *StockMovementMutation) ResetType() {
+	m._type = nil
+}
+
+// SetQuantity sets the "quantity" field.
+func (m *StockMovementMutation) SetQuantity(i int) {
+	m.quantity = &i
+	m.addquantity = nil
+}
+
+// Quantity returns the value of the "quantity" field in the mutation.
+func (m *StockMovementMutation) Quantity() (r int, exists bool) {
+	v := m.quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuantity returns the old "quantity" field's value of the StockMovement entity.
+// If the StockMovement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StockMovementMutation) OldQuantity(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
+	}
+	return oldValue.Quantity, nil
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (m *StockMovementMutation) AddQuantity(i int) {
+	if m.addquantity != nil {
+		*m.addquantity += i
+	} else {
+		m.addquantity = &i
+	}
+}
+
+// AddedQuantity returns the value that was added to the "quantity" field in this mutation.
+func (m *StockMovementMutation) AddedQuantity() (r int, exists bool) {
+	v := m.addquantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetQuantity resets all changes to the "quantity" field.
+func (m *StockMovementMutation) ResetQuantity() {
+	m.quantity = nil
+	m.addquantity = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *StockMovementMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *StockMovementMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the StockMovement entity.
+// If the StockMovement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StockMovementMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *StockMovementMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetReference sets the "reference" field.
+func (m *StockMovementMutation) SetReference(s string) {
+	m.reference = &s
+}
+
+// Reference returns the value of the "reference" field in the mutation.
+func (m *StockMovementMutation) Reference() (r string, exists bool) {
+	v := m.reference
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReference returns the old "reference" field's value of the StockMovement entity.
+// If the StockMovement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StockMovementMutation) OldReference(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReference is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReference requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReference: %w", err)
+	}
+	return oldValue.Reference, nil
+}
+
+// ClearReference clears the value of the "reference" field.
+func (m *StockMovementMutation) ClearReference() {
+	m.reference = nil
+	m.clearedFields[stockmovement.FieldReference] = struct{}{}
+}
+
+// ReferenceCleared returns if the "reference" field was cleared in this mutation.
+func (m *StockMovementMutation) ReferenceCleared() bool {
+	_, ok := m.clearedFields[stockmovement.FieldReference]
+	return ok
+}
+
+// ResetReference resets all changes to the "reference" field.
+func (m *StockMovementMutation) ResetReference() {
+	m.reference = nil
+	delete(m.clearedFields, stockmovement.FieldReference)
+}
+
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (m *StockMovementMutation) SetIdempotencyKey(s string) {
+	m.idempotency_key = &s
+}
+
+// IdempotencyKey returns the value of the "idempotency_key" field in the mutation.
+func (m *StockMovementMutation) IdempotencyKey() (r string, exists bool) {
+	v := m.idempotency_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIdempotencyKey returns the old "idempotency_key" field's value of the StockMovement entity.
+// If the StockMovement object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StockMovementMutation) OldIdempotencyKey(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIdempotencyKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIdempotencyKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIdempotencyKey: %w", err)
+	}
+	return oldValue.IdempotencyKey, nil
+}
+
+// ClearIdempotencyKey clears the value of the "idempotency_key" field.
+func (m *StockMovementMutation) ClearIdempotencyKey() {
+	m.idempotency_key = nil
+	m.clearedFields[stockmovement.FieldIdempotencyKey] = struct{}{}
+}
+
+// IdempotencyKeyCleared returns if the "idempotency_key" field was cleared in this mutation.
+func (m *StockMovementMutation) IdempotencyKeyCleared() bool {
+	_, ok := m.clearedFields[stockmovement.FieldIdempotencyKey]
+	return ok
+}
+
+// ResetIdempotencyKey resets all changes to the "idempotency_key" field.
+func (m *StockMovementMutation) ResetIdempotencyKey() {
+	m.idempotency_key = nil
+	delete(m.clearedFields, stockmovement.FieldIdempotencyKey)
+}
+
+// SetItemID sets the "item" edge to the Item entity by id.
+func (m *StockMovementMutation) SetItemID(id int) {
+	m.item = &id
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (m *StockMovementMutation) ClearItem() {
+	m.cleareditem = true
+}
+
+// ItemCleared reports if the "item" edge to the Item entity was cleared.
+func (m *StockMovementMutation) ItemCleared() bool {
+	return m.cleareditem
+}
+
+// ItemID returns the "item" edge ID in the mutation.
+func (m *StockMovementMutation) ItemID() (id int, exists bool) {
+	if m.item != nil {
+		return *m.item, true
+	}
+	return
+}
+
+// ItemIDs returns the "item" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ItemID instead. It exists only for internal usage by the builders.
+func (m *StockMovementMutation) ItemIDs() (ids []int) {
+	if id := m.item; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetItem resets all changes to the "item" edge.
+func (m *StockMovementMutation) ResetItem() {
+	m.item = nil
+	m.cleareditem = false
+}
+
+// SetLocationID sets the "location" edge to the Location entity by id.
+func (m *StockMovementMutation) SetLocationID(id int) {
+	m.location = &id
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (m *StockMovementMutation) ClearLocation() {
+	m.clearedlocation = true
+}
+
+// LocationCleared reports if the "location" edge to the Location entity was cleared.
+func (m *StockMovementMutation) LocationCleared() bool {
+	return m.clearedlocation
+}
+
+// LocationID returns the "location" edge ID in the mutation.
+func (m *StockMovementMutation) LocationID() (id int, exists bool) {
+	if m.location != nil {
+		return *m.location, true
+	}
+	return
+}
+
+// LocationIDs returns the "location" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LocationID instead. It exists only for internal usage by the builders.
+func (m *StockMovementMutation) LocationIDs() (ids []int) {
+	if id := m.location; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLocation resets all changes to the "location" edge.
+func (m *StockMovementMutation) ResetLocation() {
+	m.location = nil
+	m.clearedlocation = false
+}
+
+// Where appends a list predicates to the StockMovementMutation builder.
+func (m *StockMovementMutation) Where(ps ...predicate.StockMovement) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the StockMovementMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *StockMovementMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.StockMovement, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *StockMovementMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *StockMovementMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (StockMovement).
+func (m *StockMovementMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *StockMovementMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m._type != nil {
+		fields = append(fields, stockmovement.FieldType)
+	}
+	if m.quantity != nil {
+		fields = append(fields, stockmovement.FieldQuantity)
+	}
+	if m.created_at != nil {
+		fields = append(fields, stockmovement.FieldCreatedAt)
+	}
+	if m.reference != nil {
+		fields = append(fields, stockmovement.FieldReference)
+	}
+	if m.idempotency_key != nil {
+		fields = append(fields, stockmovement.FieldIdempotencyKey)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *StockMovementMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case stockmovement.FieldType:
+		return m.GetType()
+	case stockmovement.FieldQuantity:
+		return m.Quantity()
+	case stockmovement.FieldCreatedAt:
+		return m.CreatedAt()
+	case stockmovement.FieldReference:
+		return m.Reference()
+	case stockmovement.FieldIdempotencyKey:
+		return m.IdempotencyKey()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *StockMovementMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case stockmovement.FieldType:
+		return m.OldType(ctx)
+	case stockmovement.FieldQuantity:
+		return m.OldQuantity(ctx)
+	case stockmovement.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case stockmovement.FieldReference:
+		return m.OldReference(ctx)
+	case stockmovement.FieldIdempotencyKey:
+		return m.OldIdempotencyKey(ctx)
+	}
+	return nil, fmt.Errorf("unknown StockMovement field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StockMovementMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case stockmovement.FieldType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case stockmovement.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuantity(v)
+		return nil
+	case stockmovement.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case stockmovement.FieldReference:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReference(v)
+		return nil
+	case stockmovement.FieldIdempotencyKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIdempotencyKey(v)
+		return nil
+	}
+	return fmt.Errorf("unknown StockMovement field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *StockMovementMutation) AddedFields() []string {
+	var fields []string
+	if m.addquantity != nil {
+		fields = append(fields, stockmovement.FieldQuantity)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *StockMovementMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case stockmovement.FieldQuantity:
+		return m.AddedQuantity()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StockMovementMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case stockmovement.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuantity(v)
+		return nil
+	}
+	return fmt.Errorf("unknown StockMovement numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *StockMovementMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(stockmovement.FieldReference) {
+		fields = append(fields, stockmovement.FieldReference)
+	}
+	if m.FieldCleared(stockmovement.FieldIdempotencyKey) {
+		fields = append(fields, stockmovement.FieldIdempotencyKey)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *StockMovementMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *StockMovementMutation) ClearField(name string) error {
+	switch name {
+	case stockmovement.FieldReference:
+		m.ClearReference()
+		return nil
+	case stockmovement.FieldIdempotencyKey:
+		m.ClearIdempotencyKey()
+		return nil
+	}
+	return fmt.Errorf("unknown StockMovement nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *StockMovementMutation) ResetField(name string) error {
+	switch name {
+	case stockmovement.FieldType:
+		m.ResetType()
+		return nil
+	case stockmovement.FieldQuantity:
+		m.ResetQuantity()
+		return nil
+	case stockmovement.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case stockmovement.FieldReference:
+		m.ResetReference()
+		return nil
+	case stockmovement.FieldIdempotencyKey:
+		m.ResetIdempotencyKey()
+		return nil
+	}
+	return fmt.Errorf("unknown StockMovement field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *StockMovementMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.item != nil {
+		edges = append(edges, stockmovement.EdgeItem)
+	}
+	if m.location != nil {
+		edges = append(edges, stockmovement.EdgeLocation)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *StockMovementMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case stockmovement.EdgeItem:
+		if id := m.item; id != nil {
+			return []ent.Value{*id}
+		}
+	case stockmovement.EdgeLocation:
+		if id := m.location; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *StockMovementMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *StockMovementMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *StockMovementMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareditem {
+		edges = append(edges, stockmovement.EdgeItem)
+	}
+	if m.clearedlocation {
+		edges = append(edges, stockmovement.EdgeLocation)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *StockMovementMutation) EdgeCleared(name string) bool {
+	switch name {
+	case stockmovement.EdgeItem:
+		return m.cleareditem
+	case stockmovement.EdgeLocation:
+		return m.clearedlocation
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *StockMovementMutation) ClearEdge(name string) error {
+	switch name {
+	case stockmovement.EdgeItem:
+		m.ClearItem()
+		return nil
+	case stockmovement.EdgeLocation:
+		m.ClearLocation()
+		return nil
+	}
+	return fmt.Errorf("unknown StockMovement unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *StockMovementMutation) ResetEdge(name string) error {
+	switch name {
+	case stockmovement.EdgeItem:
+		m.ResetItem()
+		return nil
+	case stockmovement.EdgeLocation:
+		m.ResetLocation()
+		return nil
+	}
+	return fmt.Errorf("unknown StockMovement edge %s", name)
+}
+
+// TrackingMutation represents an operation that mutates the Tracking nodes in the graph.
+type TrackingMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	tracking_id   *string
+	tracking_url  *string
+	carrier       *string
+	created_at    *time.Time
+	update_at     *time.Time
+	clearedFields map[string]struct{}
+	_order        *int
+	cleared_order bool
+	done          bool
+	oldValue      func(context.Context) (*Tracking, error)
+	predicates    []predicate.Tracking
+}
+
+var _ ent.Mutation = (*TrackingMutation)(nil)
+
+// trackingOption allows management of the mutation configuration using functional options.
+type trackingOption func(*TrackingMutation)
+
+// newTrackingMutation creates new mutation for the Tracking entity.
+func newTrackingMutation(c config, op Op, opts ...trackingOption) *TrackingMutation {
+	m := &TrackingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTracking,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTrackingID sets the ID field of the mutation.
+func withTrackingID(id int) trackingOption {
+	return func(m *TrackingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Tracking
+		)
+		m.oldValue = func(ctx context.Context) (*Tracking, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Tracking.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTracking sets the old Tracking of the mutation.
+func withTracking(node *Tracking) trackingOption {
+	return func(m *TrackingMutation) {
+		m.oldValue = func(context.Context) (*Tracking, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TrackingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TrackingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TrackingMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TrackingMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Tracking.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetTrackingID sets the "tracking_id" field.
+func (m *TrackingMutation) SetTrackingID(s string) {
+	m.tracking_id = &s
+}
+
+// TrackingID returns the value of the "tracking_id" field in the mutation.
+func (m *TrackingMutation) TrackingID() (r string, exists bool) {
+	v := m.tracking_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrackingID returns the old "tracking_id" field's value of the Tracking entity.
+// If the Tracking object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TrackingMutation) OldTrackingID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrackingID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrackingID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrackingID: %w", err)
+	}
+	return oldValue.TrackingID, nil
+}
+
+// ResetTrackingID resets all changes to the "tracking_id" field.
+func (m *TrackingMutation) ResetTrackingID() {
+	m.tracking_id = nil
+}
+
+// SetTrackingURL sets the "tracking_url" field.
+func (m *TrackingMutation) SetTrackingURL(s string) {
+	m.tracking_url = &s
+}
+
+// TrackingURL returns the value of the "tracking_url" field in the mutation.
+func (m *TrackingMutation) TrackingURL() (r string, exists bool) {
+	v := m.tracking_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrackingURL returns the old "tracking_url" field's value of the Tracking entity.
+// If the Tracking object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TrackingMutation) OldTrackingURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrackingURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrackingURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrackingURL: %w", err)
+	}
+	return oldValue.TrackingURL, nil
+}
+
+// ClearTrackingURL clears the value of the "tracking_url" field.
+func (m *TrackingMutation) ClearTrackingURL() {
+	m.tracking_url = nil
+	m.clearedFields[tracking.FieldTrackingURL] = struct{}{}
+}
+
+// TrackingURLCleared returns if the "tracking_url" field was cleared in this mutation.
+func (m *TrackingMutation) TrackingURLCleared() bool {
+	_, ok := m.clearedFields[tracking.FieldTrackingURL]
+	return ok
+}
+
+// ResetTrackingURL resets all changes to the "tracking_url" field.
+func (m *TrackingMutation) ResetTrackingURL() {
+	m.tracking_url = nil
+	delete(m.clearedFields, tracking.FieldTrackingURL)
+}
+
+// SetCarrier sets the "carrier" field.
+func (m *TrackingMutation) SetCarrier(s string) {
+	m.carrier = &s
+}
+
+// Carrier returns the value of the "carrier" field in the mutation.
+func (m *TrackingMutation) Carrier() (r string, exists bool) {
+	v := m.carrier
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCarrier returns the old "carrier" field's value of the Tracking entity.
+// If the Tracking object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TrackingMutation) OldCarrier(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCarrier is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCarrier requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCarrier: %w", err)
+	}
+	return oldValue.Carrier, nil
+}
+
+// ClearCarrier clears the value of the "carrier" field.
+func (m *TrackingMutation) ClearCarrier() {
+	m.carrier = nil
+	m.clearedFields[tracking.FieldCarrier] = struct{}{}
+}
+
+// CarrierCleared returns if the "carrier" field was cleared in this mutation.
+func (m *TrackingMutation) CarrierCleared() bool {
+	_, ok := m.clearedFields[tracking.FieldCarrier]
+	return ok
+}
+
+// ResetCarrier resets all changes to the "carrier" field.
+func (m *TrackingMutation) ResetCarrier() {
+	m.carrier = nil
+	delete(m.clearedFields, tracking.FieldCarrier)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TrackingMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TrackingMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Tracking entity.
+// If the Tracking object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TrackingMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TrackingMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdateAt sets the "update_at" field.
+func (m *TrackingMutation) SetUpdateAt(t time.Time) {
+	m.update_at = &t
+}
+
+// UpdateAt returns the value of the "update_at" field in the mutation.
+func (m *TrackingMutation) UpdateAt() (r time.Time, exists bool) {
+	v := m.update_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateAt returns the old "update_at" field's value of the Tracking entity.
+// If the Tracking object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TrackingMutation) OldUpdateAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateAt: %w", err)
+	}
+	return oldValue.UpdateAt, nil
+}
+
+// ResetUpdateAt resets all changes to the "update_at" field.
+func (m *TrackingMutation) ResetUpdateAt() {
+	m.update_at = nil
+}
+
+// SetOrderID sets the "order" edge to the Order entity by id.
+func (m *TrackingMutation) SetOrderID(id int) {
+	m._order = &id
+}
+
+// ClearOrder clears the "order" edge to the Order entity.
+func (m *TrackingMutation) ClearOrder() {
+	m.cleared_order = true
+}
+
+// OrderCleared reports if the "order" edge to the Order entity was cleared.
+func (m *TrackingMutation) OrderCleared() bool {
+	return m.cleared_order
+}
+
+// OrderID returns the "order" edge ID in the mutation.
+func (m *TrackingMutation) OrderID() (id int, exists bool) {
+	if m._order != nil {
+		return *m._order, true
+	}
+	return
+}
+
+// OrderIDs returns the "order" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrderID instead. It exists only for internal usage by the builders.
+func (m *TrackingMutation) OrderIDs() (ids []int) {
+	if id := m._order; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrder resets all changes to the "order" edge.
+func (m *TrackingMutation) ResetOrder() {
+	m._order = nil
+	m.cleared_order = false
+}
+
+// Where appends a list predicates to the TrackingMutation builder.
+func (m *TrackingMutation) Where(ps ...predicate.Tracking) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TrackingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TrackingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tracking, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TrackingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TrackingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Tracking).
+func (m *TrackingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TrackingMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.tracking_id != nil {
+		fields = append(fields, tracking.FieldTrackingID)
+	}
+	if m.tracking_url != nil {
+		fields = append(fields, tracking.FieldTrackingURL)
+	}
+	if m.carrier != nil {
+		fields = append(fields, tracking.FieldCarrier)
+	}
+	if m.created_at != nil {
+		fields = append(fields, tracking.FieldCreatedAt)
+	}
+	if m.update_at != nil {
+		fields = append(fields, tracking.FieldUpdateAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TrackingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tracking.FieldTrackingID:
+		return m.TrackingID()
+	case tracking.FieldTrackingURL:
+		return m.TrackingURL()
+	case tracking.FieldCarrier:
+		return m.Carrier()
+	case tracking.FieldCreatedAt:
+		return m.CreatedAt()
+	case tracking.FieldUpdateAt:
+		return m.UpdateAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TrackingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tracking.FieldTrackingID:
+		return m.OldTrackingID(ctx)
+	case tracking.FieldTrackingURL:
+		return m.OldTrackingURL(ctx)
+	case tracking.FieldCarrier:
+		return m.OldCarrier(ctx)
+	case tracking.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case tracking.FieldUpdateAt:
+		return m.OldUpdateAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Tracking field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TrackingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tracking.FieldTrackingID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrackingID(v)
+		return nil
+	case tracking.FieldTrackingURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrackingURL(v)
+		return nil
+	case tracking.FieldCarrier:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCarrier(v)
+		return nil
+	case tracking.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case tracking.FieldUpdateAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tracking field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TrackingMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TrackingMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TrackingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Tracking numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TrackingMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(tracking.FieldTrackingURL) {
+		fields = append(fields, tracking.FieldTrackingURL)
+	}
+	if m.FieldCleared(tracking.FieldCarrier) {
+		fields = append(fields, tracking.FieldCarrier)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TrackingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TrackingMutation) ClearField(name string) error {
+	switch name {
+	case tracking.FieldTrackingURL:
+		m.ClearTrackingURL()
+		return nil
+	case tracking.FieldCarrier:
+		m.ClearCarrier()
+		return nil
+	}
+	return fmt.Errorf("unknown Tracking nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TrackingMutation) ResetField(name string) error {
+	switch name {
+	case tracking.FieldTrackingID:
+		m.ResetTrackingID()
+		return nil
+	case tracking.FieldTrackingURL:
+		m.ResetTrackingURL()
+		return nil
+	case tracking.FieldCarrier:
+		m.ResetCarrier()
+		return nil
+	case tracking.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case tracking.FieldUpdateAt:
+		m.ResetUpdateAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Tracking field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TrackingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m._order != nil {
+		edges = append(edges, tracking.EdgeOrder)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TrackingMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case tracking.EdgeOrder:
+		if id := m._order; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TrackingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TrackingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TrackingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleared_order {
+		edges = append(edges, tracking.EdgeOrder)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TrackingMutation) EdgeCleared(name string) bool {
+	switch name {
+	case tracking.EdgeOrder:
+		return m.cleared_order
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TrackingMutation) ClearEdge(name string) error {
+	switch name {
+	case tracking.EdgeOrder:
+		m.ClearOrder()
+		return nil
+	}
+	return fmt.Errorf("unknown Tracking unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TrackingMutation) ResetEdge(name string) error {
+	switch name {
+	case tracking.EdgeOrder:
+		m.ResetOrder()
+		return nil
+	}
+	return fmt.Errorf("unknown Tracking edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	username      *string
+	password_hash *string
+	role          *string
+	active        *bool
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*User, error)
+	predicates    []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id int) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUsername sets the "username" field.
+func (m *UserMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *UserMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *UserMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (m *UserMutation) SetPasswordHash(s string) {
+	m.password_hash = &s
+}
+
+// PasswordHash returns the value of the "password_hash" field in the mutation.
+func (m *UserMutation) PasswordHash() (r string, exists bool) {
+	v := m.password_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPasswordHash returns the old "password_hash" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPasswordHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
+	}
+	return oldValue.PasswordHash, nil
+}
+
+// ResetPasswordHash resets all changes to the "password_hash" field.
+func (m *UserMutation) ResetPasswordHash() {
+	m.password_hash = nil
+}
+
+// SetRole sets the "role" field.
+func (m *UserMutation) SetRole(s string) {
+	m.role = &s
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *UserMutation) Role() (r string, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldRole(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *UserMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetActive sets the "active" field.
+func (m *UserMutation) SetActive(b bool) {
+	m.active = &b
+}
+
+// Active returns the value of the "active" field in the mutation.
+func (m *UserMutation) Active() (r bool, exists bool) {
+	v := m.active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActive returns the old "active" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+	}
+	return oldValue.Active, nil
+}
+
+// ResetActive resets all changes to the "active" field.
+func (m *UserMutation) ResetActive() {
+	m.active = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.username != nil {
+		fields = append(fields, user.FieldUsername)
+	}
+	if m.password_hash != nil {
+		fields = append(fields, user.FieldPasswordHash)
+	}
+	if m.role != nil {
+		fields = append(fields, user.FieldRole)
+	}
+	if m.active != nil {
+		fields = append(fields, user.FieldActive)
+	}
+	if m.created_at != nil {
+		fields = append(fields, user.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, user.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldUsername:
+		return m.Username()
+	case user.FieldPasswordHash:
+		return m.PasswordHash()
+	case user.FieldRole:
+		return m.Role()
+	case user.FieldActive:
+		return m.Active()
+	case user.FieldCreatedAt:
+		return m.CreatedAt()
+	case user.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case user.FieldUsername:
+		return m.OldUsername(ctx)
+	case user.FieldPasswordHash:
+		return m.OldPasswordHash(ctx)
+	case user.FieldRole:
+		return m.OldRole(ctx)
+	case user.FieldActive:
+		return m.OldActive(ctx)
+	case user.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case user.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown User field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case user.FieldPasswordHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordHash(v)
+		return nil
+	case user.FieldRole:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case user.FieldActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActive(v)
+		return nil
+	case user.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case user.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PickTaskMutation) AddedFields() []string {
-	var fields []string
-	if m.addquantity != nil {
-		fields = append(fields, picktask.FieldQuantity)
+func (m *UserMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown User numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown User nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserMutation) ResetField(name string) error {
+	switch name {
+	case user.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case user.FieldPasswordHash:
+		m.ResetPasswordHash()
+		return nil
+	case user.FieldRole:
+		m.ResetRole()
+		return nil
+	case user.FieldActive:
+		m.ResetActive()
+		return nil
+	case user.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case user.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown User unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown User edge %s", name)
+}
+
+// WarehouseMutation represents an operation that mutates the Warehouse nodes in the graph.
+type WarehouseMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *int
+	code                       *string
+	name                       *string
+	clearedFields              map[string]struct{}
+	warehouse_locations        map[int]struct{}
+	removedwarehouse_locations map[int]struct{}
+	clearedwarehouse_locations bool
+	outgoing_transfers         map[int]struct{}
+	removedoutgoing_transfers  map[int]struct{}
+	clearedoutgoing_transfers  bool
+	incoming_transfers         map[int]struct{}
+	removedincoming_transfers  map[int]struct{}
+	clearedincoming_transfers  bool
+	done                       bool
+	oldValue                   func(context.Context) (*Warehouse, error)
+	predicates                 []predicate.Warehouse
+}
+
+var _ ent.Mutation = (*WarehouseMutation)(nil)
+
+// warehouseOption allows management of the mutation configuration using functional options.
+type warehouseOption func(*WarehouseMutation)
+
+// newWarehouseMutation creates new mutation for the Warehouse entity.
+func newWarehouseMutation(c config, op Op, opts ...warehouseOption) *WarehouseMutation {
+	m := &WarehouseMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWarehouse,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWarehouseID sets the ID field of the mutation.
+func withWarehouseID(id int) warehouseOption {
+	return func(m *WarehouseMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Warehouse
+		)
+		m.oldValue = func(ctx context.Context) (*Warehouse, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Warehouse.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWarehouse sets the old Warehouse of the mutation.
+func withWarehouse(node *Warehouse) warehouseOption {
+	return func(m *WarehouseMutation) {
+		m.oldValue = func(context.Context) (*Warehouse, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WarehouseMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WarehouseMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WarehouseMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WarehouseMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Warehouse.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCode sets the "code" field.
+func (m *WarehouseMutation) SetCode(s string) {
+	m.code = &s
+}
+
+// Code returns the value of the "code" field in the mutation.
+func (m *WarehouseMutation) Code() (r string, exists bool) {
+	v := m.code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCode returns the old "code" field's value of the Warehouse entity.
+// If the Warehouse object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WarehouseMutation) OldCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+	}
+	return oldValue.Code, nil
+}
+
+// ResetCode resets all changes to the "code" field.
+func (m *WarehouseMutation) ResetCode() {
+	m.code = nil
+}
+
+// SetName sets the "name" field.
+func (m *WarehouseMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *WarehouseMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Warehouse entity.
+// If the Warehouse object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WarehouseMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ClearName clears the value of the "name" field.
+func (m *WarehouseMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[warehouse.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *WarehouseMutation) NameCleared() bool {
+	_, ok := m.clearedFields[warehouse.FieldName]
+	return ok
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *WarehouseMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, warehouse.FieldName)
+}
+
+// AddWarehouseLocationIDs adds the "warehouse_locations" edge to the WarehouseLocation entity by ids.
+func (m *WarehouseMutation) AddWarehouseLocationIDs(ids ...int) {
+	if m.warehouse_locations == nil {
+		m.warehouse_locations = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.warehouse_locations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWarehouseLocations clears the "warehouse_locations" edge to the WarehouseLocation entity.
+func (m *WarehouseMutation) ClearWarehouseLocations() {
+	m.clearedwarehouse_locations = true
+}
+
+// WarehouseLocationsCleared reports if the "warehouse_locations" edge to the WarehouseLocation entity was cleared.
+func (m *WarehouseMutation) WarehouseLocationsCleared() bool {
+	return m.clearedwarehouse_locations
+}
+
+// RemoveWarehouseLocationIDs removes the "warehouse_locations" edge to the WarehouseLocation entity by IDs.
+func (m *WarehouseMutation) RemoveWarehouseLocationIDs(ids ...int) {
+	if m.removedwarehouse_locations == nil {
+		m.removedwarehouse_locations = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.warehouse_locations, ids[i])
+		m.removedwarehouse_locations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWarehouseLocations returns the removed IDs of the "warehouse_locations" edge to the WarehouseLocation entity.
+func (m *WarehouseMutation) RemovedWarehouseLocationsIDs() (ids []int) {
+	for id := range m.removedwarehouse_locations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WarehouseLocationsIDs returns the "warehouse_locations" edge IDs in the mutation.
+func (m *WarehouseMutation) WarehouseLocationsIDs() (ids []int) {
+	for id := range m.warehouse_locations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWarehouseLocations resets all changes to the "warehouse_locations" edge.
+func (m *WarehouseMutation) ResetWarehouseLocations() {
+	m.warehouse_locations = nil
+	m.clearedwarehouse_locations = false
+	m.removedwarehouse_locations = nil
+}
+
+// AddOutgoingTransferIDs adds the "outgoing_transfers" edge to the Order entity by ids.
+func (m *WarehouseMutation) AddOutgoingTransferIDs(ids ...int) {
+	if m.outgoing_transfers == nil {
+		m.outgoing_transfers = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.outgoing_transfers[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOutgoingTransfers clears the "outgoing_transfers" edge to the Order entity.
+func (m *WarehouseMutation) ClearOutgoingTransfers() {
+	m.clearedoutgoing_transfers = true
+}
+
+// OutgoingTransfersCleared reports if the "outgoing_transfers" edge to the Order entity was cleared.
+func (m *WarehouseMutation) OutgoingTransfersCleared() bool {
+	return m.clearedoutgoing_transfers
+}
+
+// RemoveOutgoingTransferIDs removes the "outgoing_transfers" edge to the Order entity by IDs.
+func (m *WarehouseMutation) RemoveOutgoingTransferIDs(ids ...int) {
+	if m.removedoutgoing_transfers == nil {
+		m.removedoutgoing_transfers = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.outgoing_transfers, ids[i])
+		m.removedoutgoing_transfers[ids[i]] = struct{}{}
 	}
-	if m.addbin_id != nil {
-		fields = append(fields, picktask.FieldBinID)
+}
+
+// RemovedOutgoingTransfers returns the removed IDs of the "outgoing_transfers" edge to the Order entity.
+func (m *WarehouseMutation) RemovedOutgoingTransfersIDs() (ids []int) {
+	for id := range m.removedoutgoing_transfers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OutgoingTransfersIDs returns the "outgoing_transfers" edge IDs in the mutation.
+func (m *WarehouseMutation) OutgoingTransfersIDs() (ids []int) {
+	for id := range m.outgoing_transfers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOutgoingTransfers resets all changes to the "outgoing_transfers" edge.
+func (m *WarehouseMutation) ResetOutgoingTransfers() {
+	m.outgoing_transfers = nil
+	m.clearedoutgoing_transfers = false
+	m.removedoutgoing_transfers = nil
+}
+
+// AddIncomingTransferIDs adds the "incoming_transfers" edge to the Order entity by ids.
+func (m *WarehouseMutation) AddIncomingTransferIDs(ids ...int) {
+	if m.incoming_transfers == nil {
+		m.incoming_transfers = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.incoming_transfers[ids[i]] = struct{}{}
+	}
+}
+
+// ClearIncomingTransfers clears the "incoming_transfers" edge to the Order entity.
+func (m *WarehouseMutation) ClearIncomingTransfers() {
+	m.clearedincoming_transfers = true
+}
+
+// IncomingTransfersCleared reports if the "incoming_transfers" edge to the Order entity was cleared.
+func (m *WarehouseMutation) IncomingTransfersCleared() bool {
+	return m.clearedincoming_transfers
+}
+
+// RemoveIncomingTransferIDs removes the "incoming_transfers" edge to the Order entity by IDs.
+func (m *WarehouseMutation) RemoveIncomingTransferIDs(ids ...int) {
+	if m.removedincoming_transfers == nil {
+		m.removedincoming_transfers = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.incoming_transfers, ids[i])
+		m.removedincoming_transfers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedIncomingTransfers returns the removed IDs of the "incoming_transfers" edge to the Order entity.
+func (m *WarehouseMutation) RemovedIncomingTransfersIDs() (ids []int) {
+	for id := range m.removedincoming_transfers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// IncomingTransfersIDs returns the "incoming_transfers" edge IDs in the mutation.
+func (m *WarehouseMutation) IncomingTransfersIDs() (ids []int) {
+	for id := range m.incoming_transfers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetIncomingTransfers resets all changes to the "incoming_transfers" edge.
+func (m *WarehouseMutation) ResetIncomingTransfers() {
+	m.incoming_transfers = nil
+	m.clearedincoming_transfers = false
+	m.removedincoming_transfers = nil
+}
+
+// Where appends a list predicates to the WarehouseMutation builder.
+func (m *WarehouseMutation) Where(ps ...predicate.Warehouse) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the WarehouseMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *WarehouseMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Warehouse, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *WarehouseMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *WarehouseMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Warehouse).
+func (m *WarehouseMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WarehouseMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.code != nil {
+		fields = append(fields, warehouse.FieldCode)
+	}
+	if m.name != nil {
+		fields = append(fields, warehouse.FieldName)
 	}
 	return fields
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *PickTaskMutation) AddedField(name string) (ent.Value, bool) {
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WarehouseMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case picktask.FieldQuantity:
-		return m.AddedQuantity()
-	case picktask.FieldBinID:
-		return m.AddedBinID()
+	case warehouse.FieldCode:
+		return m.Code()
+	case warehouse.FieldName:
+		return m.Name()
 	}
 	return nil, false
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WarehouseMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case warehouse.FieldCode:
+		return m.OldCode(ctx)
+	case warehouse.FieldName:
+		return m.OldName(ctx)
+	}
+	return nil, fmt.Errorf("unknown Warehouse field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PickTaskMutation) AddField(name string, value ent.Value) error {
+func (m *WarehouseMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case picktask.FieldQuantity:
-		v, ok := value.(int)
+	case warehouse.FieldCode:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddQuantity(v)
+		m.SetCode(v)
 		return nil
-	case picktask.FieldBinID:
-		v, ok := value.(int)
+	case warehouse.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddBinID(v)
+		m.SetName(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PickTask numeric field %s", name)
+	return fmt.Errorf("unknown Warehouse field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WarehouseMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WarehouseMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WarehouseMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Warehouse numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PickTaskMutation) ClearedFields() []string {
+func (m *WarehouseMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(picktask.FieldPickedAt) {
-		fields = append(fields, picktask.FieldPickedAt)
-	}
-	if m.FieldCleared(picktask.FieldBinID) {
-		fields = append(fields, picktask.FieldBinID)
+	if m.FieldCleared(warehouse.FieldName) {
+		fields = append(fields, warehouse.FieldName)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PickTaskMutation) FieldCleared(name string) bool {
+func (m *WarehouseMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PickTaskMutation) ClearField(name string) error {
+func (m *WarehouseMutation) ClearField(name string) error {
 	switch name {
-	case picktask.FieldPickedAt:
-		m.ClearPickedAt()
-		return nil
-	case picktask.FieldBinID:
-		m.ClearBinID()
+	case warehouse.FieldName:
+		m.ClearName()
 		return nil
 	}
-	return fmt.Errorf("unknown PickTask nullable field %s", name)
+	return fmt.Errorf("unknown Warehouse nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PickTaskMutation) ResetField(name string) error {
+func (m *WarehouseMutation) ResetField(name string) error {
 	switch name {
-	case picktask.FieldQuantity:
-		m.ResetQuantity()
-		return nil
-	case picktask.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case picktask.FieldPickedAt:
-		m.ResetPickedAt()
+	case warehouse.FieldCode:
+		m.ResetCode()
 		return nil
-	case picktask.FieldBinID:
-		m.ResetBinID()
+	case warehouse.FieldName:
+		m.ResetName()
 		return nil
 	}
-	return fmt.Errorf("unknown PickTask field %s", name)
+	return fmt.Errorf("unknown Warehouse field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PickTaskMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.picklist != nil {
-		edges = append(edges, picktask.EdgePicklist)
+func (m *WarehouseMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.warehouse_locations != nil {
+		edges = append(edges, warehouse.EdgeWarehouseLocations)
 	}
-	if m.order_line != nil {
-		edges = append(edges, picktask.EdgeOrderLine)
+	if m.outgoing_transfers != nil {
+		edges = append(edges, warehouse.EdgeOutgoingTransfers)
+	}
+	if m.incoming_transfers != nil {
+		edges = append(edges, warehouse.EdgeIncomingTransfers)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PickTaskMutation) AddedIDs(name string) []ent.Value {
+func (m *WarehouseMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case picktask.EdgePicklist:
-		if id := m.picklist; id != nil {
-			return []ent.Value{*id}
+	case warehouse.EdgeWarehouseLocations:
+		ids := make([]ent.Value, 0, len(m.warehouse_locations))
+		for id := range m.warehouse_locations {
+			ids = append(ids, id)
 		}
-	case picktask.EdgeOrderLine:
-		if id := m.order_line; id != nil {
-			return []ent.Value{*id}
+		return ids
+	case warehouse.EdgeOutgoingTransfers:
+		ids := make([]ent.Value, 0, len(m.outgoing_transfers))
+		for id := range m.outgoing_transfers {
+			ids = append(ids, id)
+		}
+		return ids
+	case warehouse.EdgeIncomingTransfers:
+		ids := make([]ent.Value, 0, len(m.incoming_transfers))
+		for id := range m.incoming_transfers {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PickTaskMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *WarehouseMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedwarehouse_locations != nil {
+		edges = append(edges, warehouse.EdgeWarehouseLocations)
+	}
+	if m.removedoutgoing_transfers != nil {
+		edges = append(edges, warehouse.EdgeOutgoingTransfers)
+	}
+	if m.removedincoming_transfers != nil {
+		edges = append(edges, warehouse.EdgeIncomingTransfers)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PickTaskMutation) RemovedIDs(name string) []ent.Value {
+func (m *WarehouseMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case warehouse.EdgeWarehouseLocations:
+		ids := make([]ent.Value, 0, len(m.removedwarehouse_locations))
+		for id := range m.removedwarehouse_locations {
+			ids = append(ids, id)
+		}
+		return ids
+	case warehouse.EdgeOutgoingTransfers:
+		ids := make([]ent.Value, 0, len(m.removedoutgoing_transfers))
+		for id := range m.removedoutgoing_transfers {
+			ids = append(ids, id)
+		}
+		return ids
+	case warehouse.EdgeIncomingTransfers:
+		ids := make([]ent.Value, 0, len(m.removedincoming_transfers))
+		for id := range m.removedincoming_transfers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PickTaskMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedpicklist {
-		edges = append(edges, picktask.EdgePicklist)
+func (m *WarehouseMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedwarehouse_locations {
+		edges = append(edges, warehouse.EdgeWarehouseLocations)
 	}
-	if m.clearedorder_line {
-		edges = append(edges, picktask.EdgeOrderLine)
+	if m.clearedoutgoing_transfers {
+		edges = append(edges, warehouse.EdgeOutgoingTransfers)
+	}
+	if m.clearedincoming_transfers {
+		edges = append(edges, warehouse.EdgeIncomingTransfers)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PickTaskMutation) EdgeCleared(name string) bool {
+func (m *WarehouseMutation) EdgeCleared(name string) bool {
 	switch name {
-	case picktask.EdgePicklist:
-		return m.clearedpicklist
-	case picktask.EdgeOrderLine:
-		return m.clearedorder_line
+	case warehouse.EdgeWarehouseLocations:
+		return m.clearedwarehouse_locations
+	case warehouse.EdgeOutgoingTransfers:
+		return m.clearedoutgoing_transfers
+	case warehouse.EdgeIncomingTransfers:
+		return m.clearedincoming_transfers
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PickTaskMutation) ClearEdge(name string) error {
+func (m *WarehouseMutation) ClearEdge(name string) error {
 	switch name {
-	case picktask.EdgePicklist:
-		m.ClearPicklist()
-		return nil
-	case picktask.EdgeOrderLine:
-		m.ClearOrderLine()
-		return nil
 	}
-	return fmt.Errorf("unknown PickTask unique edge %s", name)
+	return fmt.Errorf("unknown Warehouse unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PickTaskMutation) ResetEdge(name string) error {
+func (m *WarehouseMutation) ResetEdge(name string) error {
 	switch name {
-	case picktask.EdgePicklist:
-		m.ResetPicklist()
+	case warehouse.EdgeWarehouseLocations:
+		m.ResetWarehouseLocations()
 		return nil
-	case picktask.EdgeOrderLine:
-		m.ResetOrderLine()
+	case warehouse.EdgeOutgoingTransfers:
+		m.ResetOutgoingTransfers()
+		return nil
+	case warehouse.EdgeIncomingTransfers:
+		m.ResetIncomingTransfers()
 		return nil
 	}
-	return fmt.Errorf("unknown PickTask edge %s", name)
+	return fmt.Errorf("unknown Warehouse edge %s", name)
 }
 
-// StockMovementMutation represents an operation that mutates the StockMovement nodes in the graph.
-type StockMovementMutation struct {
+// WarehouseLocationMutation represents an operation that mutates the WarehouseLocation nodes in the graph.
+type WarehouseLocationMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	_type           *string
-	quantity        *int
-	addquantity     *int
-	created_at      *time.Time
-	reference       *string
-	clearedFields   map[string]struct{}
-	item            *int
-	cleareditem     bool
-	location        *int
-	clearedlocation bool
-	done            bool
-	oldValue        func(context.Context) (*StockMovement, error)
-	predicates      []predicate.StockMovement
+	op               Op
+	typ              string
+	id               *int
+	clearedFields    map[string]struct{}
+	warehouse        *int
+	clearedwarehouse bool
+	location         *int
+	clearedlocation  bool
+	done             bool
+	oldValue         func(context.Context) (*WarehouseLocation, error)
+	predicates       []predicate.WarehouseLocation
 }
 
-var _ ent.Mutation = (*StockMovementMutation)(nil)
+var _ ent.Mutation = (*WarehouseLocationMutation)(nil)
 
-// stockmovementOption allows management of the mutation configuration using functional options.
-type stockmovementOption func(*StockMovementMutation)
+// warehouselocationOption allows management of the mutation configuration using functional options.
+type warehouselocationOption func(*WarehouseLocationMutation)
 
-// newStockMovementMutation creates new mutation for the StockMovement entity.
-func newStockMovementMutation(c config, op Op, opts ...stockmovementOption) *StockMovementMutation {
-	m := &StockMovementMutation{
+// newWarehouseLocationMutation creates new mutation for the WarehouseLocation entity.
+func newWarehouseLocationMutation(c config, op Op, opts ...warehouselocationOption) *WarehouseLocationMutation {
+	m := &WarehouseLocationMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeStockMovement,
+		typ:           TypeWarehouseLocation,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5595,318 +20172,141 @@ func newStockMovementMutation(c config, op Op, opts ...stockmovementOption) *Sto
 	return m
 }
 
-// withStockMovementID sets the ID field of the mutation.
-func withStockMovementID(id int) stockmovementOption {
-	return func(m *StockMovementMutation) {
+// withWarehouseLocationID sets the ID field of the mutation.
+func withWarehouseLocationID(id int) warehouselocationOption {
+	return func(m *WarehouseLocationMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *StockMovement
+			value *WarehouseLocation
 		)
-		m.oldValue = func(ctx context.Context) (*StockMovement, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().StockMovement.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
-}
-
-// withStockMovement sets the old StockMovement of the mutation.
-func withStockMovement(node *StockMovement) stockmovementOption {
-	return func(m *StockMovementMutation) {
-		m.oldValue = func(context.Context) (*StockMovement, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
-}
-
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m StockMovementMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m StockMovementMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
-}
-
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *StockMovementMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
-}
-
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *StockMovementMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().StockMovement.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetType sets the "type" field.
-func (m *StockMovementMutation) SetType(s string) {
-	m._type = &s
-}
-
-// GetType returns the value of the "type" field in the mutation.
-func (m *StockMovementMutation) GetType() (r string, exists bool) {
-	v := m._type
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldType returns the old "type" field's value of the StockMovement entity.
-// If the StockMovement object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StockMovementMutation) OldType(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
-	}
-	return oldValue.Type, nil
-}
-
-// ResetType resets all changes to the "type" field.
-func (m *StockMovementMutation) ResetType() {
-	m._type = nil
-}
-
-// SetQuantity sets the "quantity" field.
-func (m *StockMovementMutation) SetQuantity(i int) {
-	m.quantity = &i
-	m.addquantity = nil
-}
-
-// Quantity returns the value of the "quantity" field in the mutation.
-func (m *StockMovementMutation) Quantity() (r int, exists bool) {
-	v := m.quantity
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldQuantity returns the old "quantity" field's value of the StockMovement entity.
-// If the StockMovement object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StockMovementMutation) OldQuantity(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuantity requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
-	}
-	return oldValue.Quantity, nil
-}
-
-// AddQuantity adds i to the "quantity" field.
-func (m *StockMovementMutation) AddQuantity(i int) {
-	if m.addquantity != nil {
-		*m.addquantity += i
-	} else {
-		m.addquantity = &i
-	}
-}
-
-// AddedQuantity returns the value that was added to the "quantity" field in this mutation.
-func (m *StockMovementMutation) AddedQuantity() (r int, exists bool) {
-	v := m.addquantity
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetQuantity resets all changes to the "quantity" field.
-func (m *StockMovementMutation) ResetQuantity() {
-	m.quantity = nil
-	m.addquantity = nil
-}
-
-// SetCreatedAt sets the "created_at" field.
-func (m *StockMovementMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
-}
-
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *StockMovementMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+		m.oldValue = func(ctx context.Context) (*WarehouseLocation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WarehouseLocation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the StockMovement entity.
-// If the StockMovement object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StockMovementMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+// withWarehouseLocation sets the old WarehouseLocation of the mutation.
+func withWarehouseLocation(node *WarehouseLocation) warehouselocationOption {
+	return func(m *WarehouseLocationMutation) {
+		m.oldValue = func(context.Context) (*WarehouseLocation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return oldValue.CreatedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *StockMovementMutation) ResetCreatedAt() {
-	m.created_at = nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WarehouseLocationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// SetReference sets the "reference" field.
-func (m *StockMovementMutation) SetReference(s string) {
-	m.reference = &s
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WarehouseLocationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// Reference returns the value of the "reference" field in the mutation.
-func (m *StockMovementMutation) Reference() (r string, exists bool) {
-	v := m.reference
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WarehouseLocationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// OldReference returns the old "reference" field's value of the StockMovement entity.
-// If the StockMovement object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StockMovementMutation) OldReference(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReference is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReference requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReference: %w", err)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WarehouseLocationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WarehouseLocation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return oldValue.Reference, nil
-}
-
-// ClearReference clears the value of the "reference" field.
-func (m *StockMovementMutation) ClearReference() {
-	m.reference = nil
-	m.clearedFields[stockmovement.FieldReference] = struct{}{}
-}
-
-// ReferenceCleared returns if the "reference" field was cleared in this mutation.
-func (m *StockMovementMutation) ReferenceCleared() bool {
-	_, ok := m.clearedFields[stockmovement.FieldReference]
-	return ok
-}
-
-// ResetReference resets all changes to the "reference" field.
-func (m *StockMovementMutation) ResetReference() {
-	m.reference = nil
-	delete(m.clearedFields, stockmovement.FieldReference)
 }
 
-// SetItemID sets the "item" edge to the Item entity by id.
-func (m *StockMovementMutation) SetItemID(id int) {
-	m.item = &id
+// SetWarehouseID sets the "warehouse" edge to the Warehouse entity by id.
+func (m *WarehouseLocationMutation) SetWarehouseID(id int) {
+	m.warehouse = &id
 }
 
-// ClearItem clears the "item" edge to the Item entity.
-func (m *StockMovementMutation) ClearItem() {
-	m.cleareditem = true
+// ClearWarehouse clears the "warehouse" edge to the Warehouse entity.
+func (m *WarehouseLocationMutation) ClearWarehouse() {
+	m.clearedwarehouse = true
 }
 
-// ItemCleared reports if the "item" edge to the Item entity was cleared.
-func (m *StockMovementMutation) ItemCleared() bool {
-	return m.cleareditem
+// WarehouseCleared reports if the "warehouse" edge to the Warehouse entity was cleared.
+func (m *WarehouseLocationMutation) WarehouseCleared() bool {
+	return m.clearedwarehouse
 }
 
-// ItemID returns the "item" edge ID in the mutation.
-func (m *StockMovementMutation) ItemID() (id int, exists bool) {
-	if m.item != nil {
-		return *m.item, true
+// WarehouseID returns the "warehouse" edge ID in the mutation.
+func (m *WarehouseLocationMutation) WarehouseID() (id int, exists bool) {
+	if m.warehouse != nil {
+		return *m.warehouse, true
 	}
 	return
 }
 
-// ItemIDs returns the "item" edge IDs in the mutation.
+// WarehouseIDs returns the "warehouse" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ItemID instead. It exists only for internal usage by the builders.
-func (m *StockMovementMutation) ItemIDs() (ids []int) {
-	if id := m.item; id != nil {
+// WarehouseID instead. It exists only for internal usage by the builders.
+func (m *WarehouseLocationMutation) WarehouseIDs() (ids []int) {
+	if id := m.warehouse; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetItem resets all changes to the "item" edge.
-func (m *StockMovementMutation) ResetItem() {
-	m.item = nil
-	m.cleareditem = false
+// ResetWarehouse resets all changes to the "warehouse" edge.
+func (m *WarehouseLocationMutation) ResetWarehouse() {
+	m.warehouse = nil
+	m.clearedwarehouse = false
 }
 
 // SetLocationID sets the "location" edge to the Location entity by id.
-func (m *StockMovementMutation) SetLocationID(id int) {
+func (m *WarehouseLocationMutation) SetLocationID(id int) {
 	m.location = &id
 }
 
 // ClearLocation clears the "location" edge to the Location entity.
-func (m *StockMovementMutation) ClearLocation() {
+func (m *WarehouseLocationMutation) ClearLocation() {
 	m.clearedlocation = true
 }
 
 // LocationCleared reports if the "location" edge to the Location entity was cleared.
-func (m *StockMovementMutation) LocationCleared() bool {
+func (m *WarehouseLocationMutation) LocationCleared() bool {
 	return m.clearedlocation
 }
 
 // LocationID returns the "location" edge ID in the mutation.
-func (m *StockMovementMutation) LocationID() (id int, exists bool) {
+func (m *WarehouseLocationMutation) LocationID() (id int, exists bool) {
 	if m.location != nil {
 		return *m.location, true
 	}
@@ -5916,7 +20316,7 @@ func (m *StockMovementMutation) LocationID() (id int, exists bool) {
 // LocationIDs returns the "location" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // LocationID instead. It exists only for internal usage by the builders.
-func (m *StockMovementMutation) LocationIDs() (ids []int) {
+func (m *WarehouseLocationMutation) LocationIDs() (ids []int) {
 	if id := m.location; id != nil {
 		ids = append(ids, *id)
 	}
@@ -5924,20 +20324,20 @@ func (m *StockMovementMutation) LocationIDs() (ids []int) {
 }
 
 // ResetLocation resets all changes to the "location" edge.
-func (m *StockMovementMutation) ResetLocation() {
+func (m *WarehouseLocationMutation) ResetLocation() {
 	m.location = nil
 	m.clearedlocation = false
 }
 
-// Where appends a list predicates to the StockMovementMutation builder.
-func (m *StockMovementMutation) Where(ps ...predicate.StockMovement) {
+// Where appends a list predicates to the WarehouseLocationMutation builder.
+func (m *WarehouseLocationMutation) Where(ps ...predicate.WarehouseLocation) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the StockMovementMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WarehouseLocationMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *StockMovementMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.StockMovement, len(ps))
+func (m *WarehouseLocationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WarehouseLocation, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5945,217 +20345,117 @@ func (m *StockMovementMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *StockMovementMutation) Op() Op {
+func (m *WarehouseLocationMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *StockMovementMutation) SetOp(op Op) {
+func (m *WarehouseLocationMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (StockMovement).
-func (m *StockMovementMutation) Type() string {
+// Type returns the node type of this mutation (WarehouseLocation).
+func (m *WarehouseLocationMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *StockMovementMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m._type != nil {
-		fields = append(fields, stockmovement.FieldType)
-	}
-	if m.quantity != nil {
-		fields = append(fields, stockmovement.FieldQuantity)
-	}
-	if m.created_at != nil {
-		fields = append(fields, stockmovement.FieldCreatedAt)
-	}
-	if m.reference != nil {
-		fields = append(fields, stockmovement.FieldReference)
-	}
+func (m *WarehouseLocationMutation) Fields() []string {
+	fields := make([]string, 0, 0)
 	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *StockMovementMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case stockmovement.FieldType:
-		return m.GetType()
-	case stockmovement.FieldQuantity:
-		return m.Quantity()
-	case stockmovement.FieldCreatedAt:
-		return m.CreatedAt()
-	case stockmovement.FieldReference:
-		return m.Reference()
-	}
+func (m *WarehouseLocationMutation) Field(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *StockMovementMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case stockmovement.FieldType:
-		return m.OldType(ctx)
-	case stockmovement.FieldQuantity:
-		return m.OldQuantity(ctx)
-	case stockmovement.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case stockmovement.FieldReference:
-		return m.OldReference(ctx)
-	}
-	return nil, fmt.Errorf("unknown StockMovement field %s", name)
+func (m *WarehouseLocationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	return nil, fmt.Errorf("unknown WarehouseLocation field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *StockMovementMutation) SetField(name string, value ent.Value) error {
+func (m *WarehouseLocationMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case stockmovement.FieldType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
-		return nil
-	case stockmovement.FieldQuantity:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetQuantity(v)
-		return nil
-	case stockmovement.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case stockmovement.FieldReference:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetReference(v)
-		return nil
 	}
-	return fmt.Errorf("unknown StockMovement field %s", name)
+	return fmt.Errorf("unknown WarehouseLocation field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *StockMovementMutation) AddedFields() []string {
-	var fields []string
-	if m.addquantity != nil {
-		fields = append(fields, stockmovement.FieldQuantity)
-	}
-	return fields
+func (m *WarehouseLocationMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *StockMovementMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case stockmovement.FieldQuantity:
-		return m.AddedQuantity()
-	}
+func (m *WarehouseLocationMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *StockMovementMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case stockmovement.FieldQuantity:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddQuantity(v)
-		return nil
-	}
-	return fmt.Errorf("unknown StockMovement numeric field %s", name)
+func (m *WarehouseLocationMutation) AddField(name string, value ent.Value) error {
+	return fmt.Errorf("unknown WarehouseLocation numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *StockMovementMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(stockmovement.FieldReference) {
-		fields = append(fields, stockmovement.FieldReference)
-	}
-	return fields
+func (m *WarehouseLocationMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *StockMovementMutation) FieldCleared(name string) bool {
+func (m *WarehouseLocationMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *StockMovementMutation) ClearField(name string) error {
-	switch name {
-	case stockmovement.FieldReference:
-		m.ClearReference()
-		return nil
-	}
-	return fmt.Errorf("unknown StockMovement nullable field %s", name)
+func (m *WarehouseLocationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown WarehouseLocation nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *StockMovementMutation) ResetField(name string) error {
-	switch name {
-	case stockmovement.FieldType:
-		m.ResetType()
-		return nil
-	case stockmovement.FieldQuantity:
-		m.ResetQuantity()
-		return nil
-	case stockmovement.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case stockmovement.FieldReference:
-		m.ResetReference()
-		return nil
-	}
-	return fmt.Errorf("unknown StockMovement field %s", name)
+func (m *WarehouseLocationMutation) ResetField(name string) error {
+	return fmt.Errorf("unknown WarehouseLocation field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *StockMovementMutation) AddedEdges() []string {
+func (m *WarehouseLocationMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.item != nil {
-		edges = append(edges, stockmovement.EdgeItem)
+	if m.warehouse != nil {
+		edges = append(edges, warehouselocation.EdgeWarehouse)
 	}
 	if m.location != nil {
-		edges = append(edges, stockmovement.EdgeLocation)
+		edges = append(edges, warehouselocation.EdgeLocation)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *StockMovementMutation) AddedIDs(name string) []ent.Value {
+func (m *WarehouseLocationMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case stockmovement.EdgeItem:
-		if id := m.item; id != nil {
+	case warehouselocation.EdgeWarehouse:
+		if id := m.warehouse; id != nil {
 			return []ent.Value{*id}
 		}
-	case stockmovement.EdgeLocation:
+	case warehouselocation.EdgeLocation:
 		if id := m.location; id != nil {
 			return []ent.Value{*id}
 		}
@@ -6164,36 +20464,36 @@ func (m *StockMovementMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *StockMovementMutation) RemovedEdges() []string {
+func (m *WarehouseLocationMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *StockMovementMutation) RemovedIDs(name string) []ent.Value {
+func (m *WarehouseLocationMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *StockMovementMutation) ClearedEdges() []string {
+func (m *WarehouseLocationMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.cleareditem {
-		edges = append(edges, stockmovement.EdgeItem)
+	if m.clearedwarehouse {
+		edges = append(edges, warehouselocation.EdgeWarehouse)
 	}
 	if m.clearedlocation {
-		edges = append(edges, stockmovement.EdgeLocation)
+		edges = append(edges, warehouselocation.EdgeLocation)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *StockMovementMutation) EdgeCleared(name string) bool {
+func (m *WarehouseLocationMutation) EdgeCleared(name string) bool {
 	switch name {
-	case stockmovement.EdgeItem:
-		return m.cleareditem
-	case stockmovement.EdgeLocation:
+	case warehouselocation.EdgeWarehouse:
+		return m.clearedwarehouse
+	case warehouselocation.EdgeLocation:
 		return m.clearedlocation
 	}
 	return false
@@ -6201,62 +20501,71 @@ func (m *StockMovementMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *StockMovementMutation) ClearEdge(name string) error {
+func (m *WarehouseLocationMutation) ClearEdge(name string) error {
 	switch name {
-	case stockmovement.EdgeItem:
-		m.ClearItem()
+	case warehouselocation.EdgeWarehouse:
+		m.ClearWarehouse()
 		return nil
-	case stockmovement.EdgeLocation:
+	case warehouselocation.EdgeLocation:
 		m.ClearLocation()
 		return nil
 	}
-	return fmt.Errorf("unknown StockMovement unique edge %s", name)
+	return fmt.Errorf("unknown WarehouseLocation unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *StockMovementMutation) ResetEdge(name string) error {
+func (m *WarehouseLocationMutation) ResetEdge(name string) error {
 	switch name {
-	case stockmovement.EdgeItem:
-		m.ResetItem()
+	case warehouselocation.EdgeWarehouse:
+		m.ResetWarehouse()
 		return nil
-	case stockmovement.EdgeLocation:
+	case warehouselocation.EdgeLocation:
 		m.ResetLocation()
 		return nil
 	}
-	return fmt.Errorf("unknown StockMovement edge %s", name)
+	return fmt.Errorf("unknown WarehouseLocation edge %s", name)
 }
 
-// TrackingMutation represents an operation that mutates the Tracking nodes in the graph.
-type TrackingMutation struct {
+// WaveMutation represents an operation that mutates the Wave nodes in the graph.
+type WaveMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
-	tracking_id   *string
-	tracking_url  *string
+	number        *string
+	status        *string
 	carrier       *string
+	zone          *string
+	due_by        *time.Time
+	max_lines     *int
+	addmax_lines  *int
 	created_at    *time.Time
-	update_at     *time.Time
+	started_at    *time.Time
+	done_at       *time.Time
 	clearedFields map[string]struct{}
-	_order        *int
-	cleared_order bool
+	orders        map[int]struct{}
+	removedorders map[int]struct{}
+	clearedorders bool
+	tasks         map[int]struct{}
+	removedtasks  map[int]struct{}
+	clearedtasks  bool
 	done          bool
-	oldValue      func(context.Context) (*Tracking, error)
-	predicates    []predicate.Tracking
+	oldValue      func(context.Context) (*Wave, error)
+	predicates    []predicate.Wave
 }
 
-var _ ent.Mutation = (*TrackingMutation)(nil)
+var _ ent.Mutation = (*WaveMutation)(nil)
 
-// trackingOption allows management of the mutation configuration using functional options.
-type trackingOption func(*TrackingMutation)
+// waveOption allows management of the mutation configuration using functional options.
+type waveOption func(*WaveMutation)
 
-// newTrackingMutation creates new mutation for the Tracking entity.
-func newTrackingMutation(c config, op Op, opts ...trackingOption) *TrackingMutation {
-	m := &TrackingMutation{
+// newWaveMutation creates new mutation for the Wave entity.
+func newWaveMutation(c config, op Op, opts ...waveOption) *WaveMutation {
+	m := &WaveMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeTracking,
+		typ:           TypeWave,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6265,20 +20574,20 @@ func newTrackingMutation(c config, op Op, opts ...trackingOption) *TrackingMutat
 	return m
 }
 
-// withTrackingID sets the ID field of the mutation.
-func withTrackingID(id int) trackingOption {
-	return func(m *TrackingMutation) {
+// withWaveID sets the ID field of the mutation.
+func withWaveID(id int) waveOption {
+	return func(m *WaveMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Tracking
+			value *Wave
 		)
-		m.oldValue = func(ctx context.Context) (*Tracking, error) {
+		m.oldValue = func(ctx context.Context) (*Wave, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Tracking.Get(ctx, id)
+					value, err = m.Client().Wave.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6287,10 +20596,10 @@ func withTrackingID(id int) trackingOption {
 	}
 }
 
-// withTracking sets the old Tracking of the mutation.
-func withTracking(node *Tracking) trackingOption {
-	return func(m *TrackingMutation) {
-		m.oldValue = func(context.Context) (*Tracking, error) {
+// withWave sets the old Wave of the mutation.
+func withWave(node *Wave) waveOption {
+	return func(m *WaveMutation) {
+		m.oldValue = func(context.Context) (*Wave, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6299,7 +20608,7 @@ func withTracking(node *Tracking) trackingOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TrackingMutation) Client() *Client {
+func (m WaveMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6307,7 +20616,7 @@ func (m TrackingMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TrackingMutation) Tx() (*Tx, error) {
+func (m WaveMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -6318,7 +20627,7 @@ func (m TrackingMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *TrackingMutation) ID() (id int, exists bool) {
+func (m *WaveMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6329,7 +20638,7 @@ func (m *TrackingMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *TrackingMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *WaveMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -6338,153 +20647,294 @@ func (m *TrackingMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Tracking.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Wave.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetTrackingID sets the "tracking_id" field.
-func (m *TrackingMutation) SetTrackingID(s string) {
-	m.tracking_id = &s
+// SetNumber sets the "number" field.
+func (m *WaveMutation) SetNumber(s string) {
+	m.number = &s
 }
 
-// TrackingID returns the value of the "tracking_id" field in the mutation.
-func (m *TrackingMutation) TrackingID() (r string, exists bool) {
-	v := m.tracking_id
+// Number returns the value of the "number" field in the mutation.
+func (m *WaveMutation) Number() (r string, exists bool) {
+	v := m.number
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTrackingID returns the old "tracking_id" field's value of the Tracking entity.
-// If the Tracking object wasn't provided to the builder, the object is fetched from the database.
+// OldNumber returns the old "number" field's value of the Wave entity.
+// If the Wave object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TrackingMutation) OldTrackingID(ctx context.Context) (v string, err error) {
+func (m *WaveMutation) OldNumber(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNumber: %w", err)
+	}
+	return oldValue.Number, nil
+}
+
+// ResetNumber resets all changes to the "number" field.
+func (m *WaveMutation) ResetNumber() {
+	m.number = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *WaveMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *WaveMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Wave entity.
+// If the Wave object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WaveMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *WaveMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCarrier sets the "carrier" field.
+func (m *WaveMutation) SetCarrier(s string) {
+	m.carrier = &s
+}
+
+// Carrier returns the value of the "carrier" field in the mutation.
+func (m *WaveMutation) Carrier() (r string, exists bool) {
+	v := m.carrier
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCarrier returns the old "carrier" field's value of the Wave entity.
+// If the Wave object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WaveMutation) OldCarrier(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCarrier is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCarrier requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCarrier: %w", err)
+	}
+	return oldValue.Carrier, nil
+}
+
+// ClearCarrier clears the value of the "carrier" field.
+func (m *WaveMutation) ClearCarrier() {
+	m.carrier = nil
+	m.clearedFields[wave.FieldCarrier] = struct{}{}
+}
+
+// CarrierCleared returns if the "carrier" field was cleared in this mutation.
+func (m *WaveMutation) CarrierCleared() bool {
+	_, ok := m.clearedFields[wave.FieldCarrier]
+	return ok
+}
+
+// ResetCarrier resets all changes to the "carrier" field.
+func (m *WaveMutation) ResetCarrier() {
+	m.carrier = nil
+	delete(m.clearedFields, wave.FieldCarrier)
+}
+
+// SetZone sets the "zone" field.
+func (m *WaveMutation) SetZone(s string) {
+	m.zone = &s
+}
+
+// Zone returns the value of the "zone" field in the mutation.
+func (m *WaveMutation) Zone() (r string, exists bool) {
+	v := m.zone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldZone returns the old "zone" field's value of the Wave entity.
+// If the Wave object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WaveMutation) OldZone(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTrackingID is only allowed on UpdateOne operations")
+		return v, errors.New("OldZone is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTrackingID requires an ID field in the mutation")
+		return v, errors.New("OldZone requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTrackingID: %w", err)
+		return v, fmt.Errorf("querying old value for OldZone: %w", err)
 	}
-	return oldValue.TrackingID, nil
+	return oldValue.Zone, nil
 }
 
-// ResetTrackingID resets all changes to the "tracking_id" field.
-func (m *TrackingMutation) ResetTrackingID() {
-	m.tracking_id = nil
+// ClearZone clears the value of the "zone" field.
+func (m *WaveMutation) ClearZone() {
+	m.zone = nil
+	m.clearedFields[wave.FieldZone] = struct{}{}
 }
 
-// SetTrackingURL sets the "tracking_url" field.
-func (m *TrackingMutation) SetTrackingURL(s string) {
-	m.tracking_url = &s
+// ZoneCleared returns if the "zone" field was cleared in this mutation.
+func (m *WaveMutation) ZoneCleared() bool {
+	_, ok := m.clearedFields[wave.FieldZone]
+	return ok
 }
 
-// TrackingURL returns the value of the "tracking_url" field in the mutation.
-func (m *TrackingMutation) TrackingURL() (r string, exists bool) {
-	v := m.tracking_url
+// ResetZone resets all changes to the "zone" field.
+func (m *WaveMutation) ResetZone() {
+	m.zone = nil
+	delete(m.clearedFields, wave.FieldZone)
+}
+
+// SetDueBy sets the "due_by" field.
+func (m *WaveMutation) SetDueBy(t time.Time) {
+	m.due_by = &t
+}
+
+// DueBy returns the value of the "due_by" field in the mutation.
+func (m *WaveMutation) DueBy() (r time.Time, exists bool) {
+	v := m.due_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTrackingURL returns the old "tracking_url" field's value of the Tracking entity.
-// If the Tracking object wasn't provided to the builder, the object is fetched from the database.
+// OldDueBy returns the old "due_by" field's value of the Wave entity.
+// If the Wave object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TrackingMutation) OldTrackingURL(ctx context.Context) (v string, err error) {
+func (m *WaveMutation) OldDueBy(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTrackingURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldDueBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTrackingURL requires an ID field in the mutation")
+		return v, errors.New("OldDueBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTrackingURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldDueBy: %w", err)
 	}
-	return oldValue.TrackingURL, nil
+	return oldValue.DueBy, nil
 }
 
-// ClearTrackingURL clears the value of the "tracking_url" field.
-func (m *TrackingMutation) ClearTrackingURL() {
-	m.tracking_url = nil
-	m.clearedFields[tracking.FieldTrackingURL] = struct{}{}
+// ClearDueBy clears the value of the "due_by" field.
+func (m *WaveMutation) ClearDueBy() {
+	m.due_by = nil
+	m.clearedFields[wave.FieldDueBy] = struct{}{}
 }
 
-// TrackingURLCleared returns if the "tracking_url" field was cleared in this mutation.
-func (m *TrackingMutation) TrackingURLCleared() bool {
-	_, ok := m.clearedFields[tracking.FieldTrackingURL]
+// DueByCleared returns if the "due_by" field was cleared in this mutation.
+func (m *WaveMutation) DueByCleared() bool {
+	_, ok := m.clearedFields[wave.FieldDueBy]
 	return ok
 }
 
-// ResetTrackingURL resets all changes to the "tracking_url" field.
-func (m *TrackingMutation) ResetTrackingURL() {
-	m.tracking_url = nil
-	delete(m.clearedFields, tracking.FieldTrackingURL)
+// ResetDueBy resets all changes to the "due_by" field.
+func (m *WaveMutation) ResetDueBy() {
+	m.due_by = nil
+	delete(m.clearedFields, wave.FieldDueBy)
 }
 
-// SetCarrier sets the "carrier" field.
-func (m *TrackingMutation) SetCarrier(s string) {
-	m.carrier = &s
+// SetMaxLines sets the "max_lines" field.
+func (m *WaveMutation) SetMaxLines(i int) {
+	m.max_lines = &i
+	m.addmax_lines = nil
 }
 
-// Carrier returns the value of the "carrier" field in the mutation.
-func (m *TrackingMutation) Carrier() (r string, exists bool) {
-	v := m.carrier
+// MaxLines returns the value of the "max_lines" field in the mutation.
+func (m *WaveMutation) MaxLines() (r int, exists bool) {
+	v := m.max_lines
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCarrier returns the old "carrier" field's value of the Tracking entity.
-// If the Tracking object wasn't provided to the builder, the object is fetched from the database.
+// OldMaxLines returns the old "max_lines" field's value of the Wave entity.
+// If the Wave object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TrackingMutation) OldCarrier(ctx context.Context) (v string, err error) {
+func (m *WaveMutation) OldMaxLines(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCarrier is only allowed on UpdateOne operations")
+		return v, errors.New("OldMaxLines is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCarrier requires an ID field in the mutation")
+		return v, errors.New("OldMaxLines requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCarrier: %w", err)
+		return v, fmt.Errorf("querying old value for OldMaxLines: %w", err)
 	}
-	return oldValue.Carrier, nil
+	return oldValue.MaxLines, nil
 }
 
-// ClearCarrier clears the value of the "carrier" field.
-func (m *TrackingMutation) ClearCarrier() {
-	m.carrier = nil
-	m.clearedFields[tracking.FieldCarrier] = struct{}{}
+// AddMaxLines adds i to the "max_lines" field.
+func (m *WaveMutation) AddMaxLines(i int) {
+	if m.addmax_lines != nil {
+		*m.addmax_lines += i
+	} else {
+		m.addmax_lines = &i
+	}
 }
 
-// CarrierCleared returns if the "carrier" field was cleared in this mutation.
-func (m *TrackingMutation) CarrierCleared() bool {
-	_, ok := m.clearedFields[tracking.FieldCarrier]
-	return ok
+// AddedMaxLines returns the value that was added to the "max_lines" field in this mutation.
+func (m *WaveMutation) AddedMaxLines() (r int, exists bool) {
+	v := m.addmax_lines
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetCarrier resets all changes to the "carrier" field.
-func (m *TrackingMutation) ResetCarrier() {
-	m.carrier = nil
-	delete(m.clearedFields, tracking.FieldCarrier)
+// ResetMaxLines resets all changes to the "max_lines" field.
+func (m *WaveMutation) ResetMaxLines() {
+	m.max_lines = nil
+	m.addmax_lines = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *TrackingMutation) SetCreatedAt(t time.Time) {
+func (m *WaveMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *TrackingMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *WaveMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -6492,10 +20942,10 @@ func (m *TrackingMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Tracking entity.
-// If the Tracking object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Wave entity.
+// If the Wave object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TrackingMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WaveMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -6510,94 +20960,225 @@ func (m *TrackingMutation) OldCreatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *TrackingMutation) ResetCreatedAt() {
+func (m *WaveMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetUpdateAt sets the "update_at" field.
-func (m *TrackingMutation) SetUpdateAt(t time.Time) {
-	m.update_at = &t
+// SetStartedAt sets the "started_at" field.
+func (m *WaveMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
 }
 
-// UpdateAt returns the value of the "update_at" field in the mutation.
-func (m *TrackingMutation) UpdateAt() (r time.Time, exists bool) {
-	v := m.update_at
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *WaveMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdateAt returns the old "update_at" field's value of the Tracking entity.
-// If the Tracking object wasn't provided to the builder, the object is fetched from the database.
+// OldStartedAt returns the old "started_at" field's value of the Wave entity.
+// If the Wave object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TrackingMutation) OldUpdateAt(ctx context.Context) (v time.Time, err error) {
+func (m *WaveMutation) OldStartedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdateAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdateAt requires an ID field in the mutation")
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdateAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
 	}
-	return oldValue.UpdateAt, nil
+	return oldValue.StartedAt, nil
 }
 
-// ResetUpdateAt resets all changes to the "update_at" field.
-func (m *TrackingMutation) ResetUpdateAt() {
-	m.update_at = nil
+// ClearStartedAt clears the value of the "started_at" field.
+func (m *WaveMutation) ClearStartedAt() {
+	m.started_at = nil
+	m.clearedFields[wave.FieldStartedAt] = struct{}{}
 }
 
-// SetOrderID sets the "order" edge to the Order entity by id.
-func (m *TrackingMutation) SetOrderID(id int) {
-	m._order = &id
+// StartedAtCleared returns if the "started_at" field was cleared in this mutation.
+func (m *WaveMutation) StartedAtCleared() bool {
+	_, ok := m.clearedFields[wave.FieldStartedAt]
+	return ok
 }
 
-// ClearOrder clears the "order" edge to the Order entity.
-func (m *TrackingMutation) ClearOrder() {
-	m.cleared_order = true
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *WaveMutation) ResetStartedAt() {
+	m.started_at = nil
+	delete(m.clearedFields, wave.FieldStartedAt)
 }
 
-// OrderCleared reports if the "order" edge to the Order entity was cleared.
-func (m *TrackingMutation) OrderCleared() bool {
-	return m.cleared_order
+// SetDoneAt sets the "done_at" field.
+func (m *WaveMutation) SetDoneAt(t time.Time) {
+	m.done_at = &t
 }
 
-// OrderID returns the "order" edge ID in the mutation.
-func (m *TrackingMutation) OrderID() (id int, exists bool) {
-	if m._order != nil {
-		return *m._order, true
+// DoneAt returns the value of the "done_at" field in the mutation.
+func (m *WaveMutation) DoneAt() (r time.Time, exists bool) {
+	v := m.done_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDoneAt returns the old "done_at" field's value of the Wave entity.
+// If the Wave object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WaveMutation) OldDoneAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDoneAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDoneAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDoneAt: %w", err)
+	}
+	return oldValue.DoneAt, nil
+}
+
+// ClearDoneAt clears the value of the "done_at" field.
+func (m *WaveMutation) ClearDoneAt() {
+	m.done_at = nil
+	m.clearedFields[wave.FieldDoneAt] = struct{}{}
+}
+
+// DoneAtCleared returns if the "done_at" field was cleared in this mutation.
+func (m *WaveMutation) DoneAtCleared() bool {
+	_, ok := m.clearedFields[wave.FieldDoneAt]
+	return ok
+}
+
+// ResetDoneAt resets all changes to the "done_at" field.
+func (m *WaveMutation) ResetDoneAt() {
+	m.done_at = nil
+	delete(m.clearedFields, wave.FieldDoneAt)
+}
+
+// AddOrderIDs adds the "orders" edge to the Order entity by ids.
+func (m *WaveMutation) AddOrderIDs(ids ...int) {
+	if m.orders == nil {
+		m.orders = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.orders[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOrders clears the "orders" edge to the Order entity.
+func (m *WaveMutation) ClearOrders() {
+	m.clearedorders = true
+}
+
+// OrdersCleared reports if the "orders" edge to the Order entity was cleared.
+func (m *WaveMutation) OrdersCleared() bool {
+	return m.clearedorders
+}
+
+// RemoveOrderIDs removes the "orders" edge to the Order entity by IDs.
+func (m *WaveMutation) RemoveOrderIDs(ids ...int) {
+	if m.removedorders == nil {
+		m.removedorders = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.orders, ids[i])
+		m.removedorders[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOrders returns the removed IDs of the "orders" edge to the Order entity.
+func (m *WaveMutation) RemovedOrdersIDs() (ids []int) {
+	for id := range m.removedorders {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// OrderIDs returns the "order" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OrderID instead. It exists only for internal usage by the builders.
-func (m *TrackingMutation) OrderIDs() (ids []int) {
-	if id := m._order; id != nil {
-		ids = append(ids, *id)
+// OrdersIDs returns the "orders" edge IDs in the mutation.
+func (m *WaveMutation) OrdersIDs() (ids []int) {
+	for id := range m.orders {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetOrder resets all changes to the "order" edge.
-func (m *TrackingMutation) ResetOrder() {
-	m._order = nil
-	m.cleared_order = false
+// ResetOrders resets all changes to the "orders" edge.
+func (m *WaveMutation) ResetOrders() {
+	m.orders = nil
+	m.clearedorders = false
+	m.removedorders = nil
 }
 
-// Where appends a list predicates to the TrackingMutation builder.
-func (m *TrackingMutation) Where(ps ...predicate.Tracking) {
+// AddTaskIDs adds the "tasks" edge to the WaveTask entity by ids.
+func (m *WaveMutation) AddTaskIDs(ids ...int) {
+	if m.tasks == nil {
+		m.tasks = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.tasks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTasks clears the "tasks" edge to the WaveTask entity.
+func (m *WaveMutation) ClearTasks() {
+	m.clearedtasks = true
+}
+
+// TasksCleared reports if the "tasks" edge to the WaveTask entity was cleared.
+func (m *WaveMutation) TasksCleared() bool {
+	return m.clearedtasks
+}
+
+// RemoveTaskIDs removes the "tasks" edge to the WaveTask entity by IDs.
+func (m *WaveMutation) RemoveTaskIDs(ids ...int) {
+	if m.removedtasks == nil {
+		m.removedtasks = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.tasks, ids[i])
+		m.removedtasks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTasks returns the removed IDs of the "tasks" edge to the WaveTask entity.
+func (m *WaveMutation) RemovedTasksIDs() (ids []int) {
+	for id := range m.removedtasks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TasksIDs returns the "tasks" edge IDs in the mutation.
+func (m *WaveMutation) TasksIDs() (ids []int) {
+	for id := range m.tasks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTasks resets all changes to the "tasks" edge.
+func (m *WaveMutation) ResetTasks() {
+	m.tasks = nil
+	m.clearedtasks = false
+	m.removedtasks = nil
+}
+
+// Where appends a list predicates to the WaveMutation builder.
+func (m *WaveMutation) Where(ps ...predicate.Wave) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the TrackingMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WaveMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *TrackingMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Tracking, len(ps))
+func (m *WaveMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Wave, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -6605,39 +21186,51 @@ func (m *TrackingMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *TrackingMutation) Op() Op {
+func (m *WaveMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *TrackingMutation) SetOp(op Op) {
+func (m *WaveMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Tracking).
-func (m *TrackingMutation) Type() string {
+// Type returns the node type of this mutation (Wave).
+func (m *WaveMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *TrackingMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.tracking_id != nil {
-		fields = append(fields, tracking.FieldTrackingID)
+func (m *WaveMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.number != nil {
+		fields = append(fields, wave.FieldNumber)
 	}
-	if m.tracking_url != nil {
-		fields = append(fields, tracking.FieldTrackingURL)
+	if m.status != nil {
+		fields = append(fields, wave.FieldStatus)
 	}
 	if m.carrier != nil {
-		fields = append(fields, tracking.FieldCarrier)
+		fields = append(fields, wave.FieldCarrier)
+	}
+	if m.zone != nil {
+		fields = append(fields, wave.FieldZone)
+	}
+	if m.due_by != nil {
+		fields = append(fields, wave.FieldDueBy)
+	}
+	if m.max_lines != nil {
+		fields = append(fields, wave.FieldMaxLines)
 	}
 	if m.created_at != nil {
-		fields = append(fields, tracking.FieldCreatedAt)
+		fields = append(fields, wave.FieldCreatedAt)
 	}
-	if m.update_at != nil {
-		fields = append(fields, tracking.FieldUpdateAt)
+	if m.started_at != nil {
+		fields = append(fields, wave.FieldStartedAt)
+	}
+	if m.done_at != nil {
+		fields = append(fields, wave.FieldDoneAt)
 	}
 	return fields
 }
@@ -6645,18 +21238,26 @@ func (m *TrackingMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *TrackingMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case tracking.FieldTrackingID:
-		return m.TrackingID()
-	case tracking.FieldTrackingURL:
-		return m.TrackingURL()
-	case tracking.FieldCarrier:
+func (m *WaveMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case wave.FieldNumber:
+		return m.Number()
+	case wave.FieldStatus:
+		return m.Status()
+	case wave.FieldCarrier:
 		return m.Carrier()
-	case tracking.FieldCreatedAt:
+	case wave.FieldZone:
+		return m.Zone()
+	case wave.FieldDueBy:
+		return m.DueBy()
+	case wave.FieldMaxLines:
+		return m.MaxLines()
+	case wave.FieldCreatedAt:
 		return m.CreatedAt()
-	case tracking.FieldUpdateAt:
-		return m.UpdateAt()
+	case wave.FieldStartedAt:
+		return m.StartedAt()
+	case wave.FieldDoneAt:
+		return m.DoneAt()
 	}
 	return nil, false
 }
@@ -6664,248 +21265,367 @@ func (m *TrackingMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *TrackingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WaveMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case tracking.FieldTrackingID:
-		return m.OldTrackingID(ctx)
-	case tracking.FieldTrackingURL:
-		return m.OldTrackingURL(ctx)
-	case tracking.FieldCarrier:
+	case wave.FieldNumber:
+		return m.OldNumber(ctx)
+	case wave.FieldStatus:
+		return m.OldStatus(ctx)
+	case wave.FieldCarrier:
 		return m.OldCarrier(ctx)
-	case tracking.FieldCreatedAt:
+	case wave.FieldZone:
+		return m.OldZone(ctx)
+	case wave.FieldDueBy:
+		return m.OldDueBy(ctx)
+	case wave.FieldMaxLines:
+		return m.OldMaxLines(ctx)
+	case wave.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case tracking.FieldUpdateAt:
-		return m.OldUpdateAt(ctx)
+	case wave.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case wave.FieldDoneAt:
+		return m.OldDoneAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Tracking field %s", name)
+	return nil, fmt.Errorf("unknown Wave field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TrackingMutation) SetField(name string, value ent.Value) error {
+func (m *WaveMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case tracking.FieldTrackingID:
+	case wave.FieldNumber:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTrackingID(v)
+		m.SetNumber(v)
 		return nil
-	case tracking.FieldTrackingURL:
+	case wave.FieldStatus:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTrackingURL(v)
+		m.SetStatus(v)
 		return nil
-	case tracking.FieldCarrier:
+	case wave.FieldCarrier:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCarrier(v)
 		return nil
-	case tracking.FieldCreatedAt:
+	case wave.FieldZone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetZone(v)
+		return nil
+	case wave.FieldDueBy:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDueBy(v)
+		return nil
+	case wave.FieldMaxLines:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxLines(v)
+		return nil
+	case wave.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case tracking.FieldUpdateAt:
+	case wave.FieldStartedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdateAt(v)
+		m.SetStartedAt(v)
+		return nil
+	case wave.FieldDoneAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDoneAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Tracking field %s", name)
+	return fmt.Errorf("unknown Wave field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *TrackingMutation) AddedFields() []string {
-	return nil
+func (m *WaveMutation) AddedFields() []string {
+	var fields []string
+	if m.addmax_lines != nil {
+		fields = append(fields, wave.FieldMaxLines)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *TrackingMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WaveMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case wave.FieldMaxLines:
+		return m.AddedMaxLines()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *TrackingMutation) AddField(name string, value ent.Value) error {
+func (m *WaveMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case wave.FieldMaxLines:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxLines(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Tracking numeric field %s", name)
+	return fmt.Errorf("unknown Wave numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *TrackingMutation) ClearedFields() []string {
+func (m *WaveMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(tracking.FieldTrackingURL) {
-		fields = append(fields, tracking.FieldTrackingURL)
+	if m.FieldCleared(wave.FieldCarrier) {
+		fields = append(fields, wave.FieldCarrier)
 	}
-	if m.FieldCleared(tracking.FieldCarrier) {
-		fields = append(fields, tracking.FieldCarrier)
+	if m.FieldCleared(wave.FieldZone) {
+		fields = append(fields, wave.FieldZone)
+	}
+	if m.FieldCleared(wave.FieldDueBy) {
+		fields = append(fields, wave.FieldDueBy)
+	}
+	if m.FieldCleared(wave.FieldStartedAt) {
+		fields = append(fields, wave.FieldStartedAt)
+	}
+	if m.FieldCleared(wave.FieldDoneAt) {
+		fields = append(fields, wave.FieldDoneAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *TrackingMutation) FieldCleared(name string) bool {
+func (m *WaveMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TrackingMutation) ClearField(name string) error {
+func (m *WaveMutation) ClearField(name string) error {
 	switch name {
-	case tracking.FieldTrackingURL:
-		m.ClearTrackingURL()
-		return nil
-	case tracking.FieldCarrier:
+	case wave.FieldCarrier:
 		m.ClearCarrier()
 		return nil
+	case wave.FieldZone:
+		m.ClearZone()
+		return nil
+	case wave.FieldDueBy:
+		m.ClearDueBy()
+		return nil
+	case wave.FieldStartedAt:
+		m.ClearStartedAt()
+		return nil
+	case wave.FieldDoneAt:
+		m.ClearDoneAt()
+		return nil
 	}
-	return fmt.Errorf("unknown Tracking nullable field %s", name)
+	return fmt.Errorf("unknown Wave nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *TrackingMutation) ResetField(name string) error {
+func (m *WaveMutation) ResetField(name string) error {
 	switch name {
-	case tracking.FieldTrackingID:
-		m.ResetTrackingID()
+	case wave.FieldNumber:
+		m.ResetNumber()
 		return nil
-	case tracking.FieldTrackingURL:
-		m.ResetTrackingURL()
+	case wave.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case tracking.FieldCarrier:
+	case wave.FieldCarrier:
 		m.ResetCarrier()
 		return nil
-	case tracking.FieldCreatedAt:
+	case wave.FieldZone:
+		m.ResetZone()
+		return nil
+	case wave.FieldDueBy:
+		m.ResetDueBy()
+		return nil
+	case wave.FieldMaxLines:
+		m.ResetMaxLines()
+		return nil
+	case wave.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case tracking.FieldUpdateAt:
-		m.ResetUpdateAt()
+	case wave.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case wave.FieldDoneAt:
+		m.ResetDoneAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Tracking field %s", name)
+	return fmt.Errorf("unknown Wave field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *TrackingMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m._order != nil {
-		edges = append(edges, tracking.EdgeOrder)
+func (m *WaveMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.orders != nil {
+		edges = append(edges, wave.EdgeOrders)
+	}
+	if m.tasks != nil {
+		edges = append(edges, wave.EdgeTasks)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *TrackingMutation) AddedIDs(name string) []ent.Value {
+func (m *WaveMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case tracking.EdgeOrder:
-		if id := m._order; id != nil {
-			return []ent.Value{*id}
+	case wave.EdgeOrders:
+		ids := make([]ent.Value, 0, len(m.orders))
+		for id := range m.orders {
+			ids = append(ids, id)
 		}
+		return ids
+	case wave.EdgeTasks:
+		ids := make([]ent.Value, 0, len(m.tasks))
+		for id := range m.tasks {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *TrackingMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *WaveMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedorders != nil {
+		edges = append(edges, wave.EdgeOrders)
+	}
+	if m.removedtasks != nil {
+		edges = append(edges, wave.EdgeTasks)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *TrackingMutation) RemovedIDs(name string) []ent.Value {
+func (m *WaveMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case wave.EdgeOrders:
+		ids := make([]ent.Value, 0, len(m.removedorders))
+		for id := range m.removedorders {
+			ids = append(ids, id)
+		}
+		return ids
+	case wave.EdgeTasks:
+		ids := make([]ent.Value, 0, len(m.removedtasks))
+		for id := range m.removedtasks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *TrackingMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleared_order {
-		edges = append(edges, tracking.EdgeOrder)
+func (m *WaveMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedorders {
+		edges = append(edges, wave.EdgeOrders)
+	}
+	if m.clearedtasks {
+		edges = append(edges, wave.EdgeTasks)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *TrackingMutation) EdgeCleared(name string) bool {
+func (m *WaveMutation) EdgeCleared(name string) bool {
 	switch name {
-	case tracking.EdgeOrder:
-		return m.cleared_order
+	case wave.EdgeOrders:
+		return m.clearedorders
+	case wave.EdgeTasks:
+		return m.clearedtasks
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *TrackingMutation) ClearEdge(name string) error {
+func (m *WaveMutation) ClearEdge(name string) error {
 	switch name {
-	case tracking.EdgeOrder:
-		m.ClearOrder()
-		return nil
 	}
-	return fmt.Errorf("unknown Tracking unique edge %s", name)
+	return fmt.Errorf("unknown Wave unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *TrackingMutation) ResetEdge(name string) error {
+func (m *WaveMutation) ResetEdge(name string) error {
 	switch name {
-	case tracking.EdgeOrder:
-		m.ResetOrder()
+	case wave.EdgeOrders:
+		m.ResetOrders()
+		return nil
+	case wave.EdgeTasks:
+		m.ResetTasks()
 		return nil
 	}
-	return fmt.Errorf("unknown Tracking edge %s", name)
+	return fmt.Errorf("unknown Wave edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// WavePickMutation represents an operation that mutates the WavePick nodes in the graph.
+type WavePickMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	username      *string
-	password_hash *string
-	role          *string
-	active        *bool
-	created_at    *time.Time
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*User, error)
-	predicates    []predicate.User
+	op                Op
+	typ               string
+	id                *int
+	quantity          *int
+	addquantity       *int
+	picked            *int
+	addpicked         *int
+	clearedFields     map[string]struct{}
+	task              *int
+	clearedtask       bool
+	order_line        *int
+	clearedorder_line bool
+	done              bool
+	oldValue          func(context.Context) (*WavePick, error)
+	predicates        []predicate.WavePick
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
+var _ ent.Mutation = (*WavePickMutation)(nil)
 
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// wavepickOption allows management of the mutation configuration using functional options.
+type wavepickOption func(*WavePickMutation)
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+// newWavePickMutation creates new mutation for the WavePick entity.
+func newWavePickMutation(c config, op Op, opts ...wavepickOption) *WavePickMutation {
+	m := &WavePickMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeWavePick,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6914,20 +21634,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id int) userOption {
-	return func(m *UserMutation) {
+// withWavePickID sets the ID field of the mutation.
+func withWavePickID(id int) wavepickOption {
+	return func(m *WavePickMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *WavePick
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*WavePick, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().WavePick.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6936,10 +21656,10 @@ func withUserID(id int) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withWavePick sets the old WavePick of the mutation.
+func withWavePick(node *WavePick) wavepickOption {
+	return func(m *WavePickMutation) {
+		m.oldValue = func(context.Context) (*WavePick, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6948,7 +21668,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m WavePickMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6956,7 +21676,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m WavePickMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -6967,7 +21687,7 @@ func (m UserMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id int, exists bool) {
+func (m *WavePickMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6978,7 +21698,7 @@ func (m *UserMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *WavePickMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -6987,237 +21707,211 @@ func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WavePick.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUsername sets the "username" field.
-func (m *UserMutation) SetUsername(s string) {
-	m.username = &s
-}
-
-// Username returns the value of the "username" field in the mutation.
-func (m *UserMutation) Username() (r string, exists bool) {
-	v := m.username
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUsername returns the old "username" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUsername(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsername requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
-	}
-	return oldValue.Username, nil
-}
-
-// ResetUsername resets all changes to the "username" field.
-func (m *UserMutation) ResetUsername() {
-	m.username = nil
-}
-
-// SetPasswordHash sets the "password_hash" field.
-func (m *UserMutation) SetPasswordHash(s string) {
-	m.password_hash = &s
+// SetQuantity sets the "quantity" field.
+func (m *WavePickMutation) SetQuantity(i int) {
+	m.quantity = &i
+	m.addquantity = nil
 }
 
-// PasswordHash returns the value of the "password_hash" field in the mutation.
-func (m *UserMutation) PasswordHash() (r string, exists bool) {
-	v := m.password_hash
+// Quantity returns the value of the "quantity" field in the mutation.
+func (m *WavePickMutation) Quantity() (r int, exists bool) {
+	v := m.quantity
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPasswordHash returns the old "password_hash" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldQuantity returns the old "quantity" field's value of the WavePick entity.
+// If the WavePick object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPasswordHash(ctx context.Context) (v string, err error) {
+func (m *WavePickMutation) OldQuantity(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
+		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
+		return v, errors.New("OldQuantity requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
+		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
 	}
-	return oldValue.PasswordHash, nil
-}
-
-// ResetPasswordHash resets all changes to the "password_hash" field.
-func (m *UserMutation) ResetPasswordHash() {
-	m.password_hash = nil
+	return oldValue.Quantity, nil
 }
 
-// SetRole sets the "role" field.
-func (m *UserMutation) SetRole(s string) {
-	m.role = &s
+// AddQuantity adds i to the "quantity" field.
+func (m *WavePickMutation) AddQuantity(i int) {
+	if m.addquantity != nil {
+		*m.addquantity += i
+	} else {
+		m.addquantity = &i
+	}
 }
 
-// Role returns the value of the "role" field in the mutation.
-func (m *UserMutation) Role() (r string, exists bool) {
-	v := m.role
+// AddedQuantity returns the value that was added to the "quantity" field in this mutation.
+func (m *WavePickMutation) AddedQuantity() (r int, exists bool) {
+	v := m.addquantity
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRole returns the old "role" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldRole(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRole is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRole requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRole: %w", err)
-	}
-	return oldValue.Role, nil
-}
-
-// ResetRole resets all changes to the "role" field.
-func (m *UserMutation) ResetRole() {
-	m.role = nil
+// ResetQuantity resets all changes to the "quantity" field.
+func (m *WavePickMutation) ResetQuantity() {
+	m.quantity = nil
+	m.addquantity = nil
 }
 
-// SetActive sets the "active" field.
-func (m *UserMutation) SetActive(b bool) {
-	m.active = &b
+// SetPicked sets the "picked" field.
+func (m *WavePickMutation) SetPicked(i int) {
+	m.picked = &i
+	m.addpicked = nil
 }
 
-// Active returns the value of the "active" field in the mutation.
-func (m *UserMutation) Active() (r bool, exists bool) {
-	v := m.active
+// Picked returns the value of the "picked" field in the mutation.
+func (m *WavePickMutation) Picked() (r int, exists bool) {
+	v := m.picked
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldActive returns the old "active" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldPicked returns the old "picked" field's value of the WavePick entity.
+// If the WavePick object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldActive(ctx context.Context) (v bool, err error) {
+func (m *WavePickMutation) OldPicked(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldActive is only allowed on UpdateOne operations")
+		return v, errors.New("OldPicked is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldActive requires an ID field in the mutation")
+		return v, errors.New("OldPicked requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+		return v, fmt.Errorf("querying old value for OldPicked: %w", err)
 	}
-	return oldValue.Active, nil
-}
-
-// ResetActive resets all changes to the "active" field.
-func (m *UserMutation) ResetActive() {
-	m.active = nil
+	return oldValue.Picked, nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// AddPicked adds i to the "picked" field.
+func (m *WavePickMutation) AddPicked(i int) {
+	if m.addpicked != nil {
+		*m.addpicked += i
+	} else {
+		m.addpicked = &i
+	}
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// AddedPicked returns the value that was added to the "picked" field in this mutation.
+func (m *WavePickMutation) AddedPicked() (r int, exists bool) {
+	v := m.addpicked
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
+// ResetPicked resets all changes to the "picked" field.
+func (m *WavePickMutation) ResetPicked() {
+	m.picked = nil
+	m.addpicked = nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserMutation) ResetCreatedAt() {
-	m.created_at = nil
+// SetTaskID sets the "task" edge to the WaveTask entity by id.
+func (m *WavePickMutation) SetTaskID(id int) {
+	m.task = &id
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *UserMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// ClearTask clears the "task" edge to the WaveTask entity.
+func (m *WavePickMutation) ClearTask() {
+	m.clearedtask = true
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// TaskCleared reports if the "task" edge to the WaveTask entity was cleared.
+func (m *WavePickMutation) TaskCleared() bool {
+	return m.clearedtask
+}
+
+// TaskID returns the "task" edge ID in the mutation.
+func (m *WavePickMutation) TaskID() (id int, exists bool) {
+	if m.task != nil {
+		return *m.task, true
 	}
-	return *v, true
+	return
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+// TaskIDs returns the "task" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TaskID instead. It exists only for internal usage by the builders.
+func (m *WavePickMutation) TaskIDs() (ids []int) {
+	if id := m.task; id != nil {
+		ids = append(ids, *id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	return
+}
+
+// ResetTask resets all changes to the "task" edge.
+func (m *WavePickMutation) ResetTask() {
+	m.task = nil
+	m.clearedtask = false
+}
+
+// SetOrderLineID sets the "order_line" edge to the OrderLine entity by id.
+func (m *WavePickMutation) SetOrderLineID(id int) {
+	m.order_line = &id
+}
+
+// ClearOrderLine clears the "order_line" edge to the OrderLine entity.
+func (m *WavePickMutation) ClearOrderLine() {
+	m.clearedorder_line = true
+}
+
+// OrderLineCleared reports if the "order_line" edge to the OrderLine entity was cleared.
+func (m *WavePickMutation) OrderLineCleared() bool {
+	return m.clearedorder_line
+}
+
+// OrderLineID returns the "order_line" edge ID in the mutation.
+func (m *WavePickMutation) OrderLineID() (id int, exists bool) {
+	if m.order_line != nil {
+		return *m.order_line, true
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	return
+}
+
+// OrderLineIDs returns the "order_line" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrderLineID instead. It exists only for internal usage by the builders.
+func (m *WavePickMutation) OrderLineIDs() (ids []int) {
+	if id := m.order_line; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.UpdatedAt, nil
+	return
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetOrderLine resets all changes to the "order_line" edge.
+func (m *WavePickMutation) ResetOrderLine() {
+	m.order_line = nil
+	m.clearedorder_line = false
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// Where appends a list predicates to the WavePickMutation builder.
+func (m *WavePickMutation) Where(ps ...predicate.WavePick) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WavePickMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
+func (m *WavePickMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WavePick, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7225,42 +21919,30 @@ func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *WavePickMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
+func (m *WavePickMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (WavePick).
+func (m *WavePickMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.username != nil {
-		fields = append(fields, user.FieldUsername)
-	}
-	if m.password_hash != nil {
-		fields = append(fields, user.FieldPasswordHash)
-	}
-	if m.role != nil {
-		fields = append(fields, user.FieldRole)
-	}
-	if m.active != nil {
-		fields = append(fields, user.FieldActive)
-	}
-	if m.created_at != nil {
-		fields = append(fields, user.FieldCreatedAt)
+func (m *WavePickMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.quantity != nil {
+		fields = append(fields, wavepick.FieldQuantity)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, user.FieldUpdatedAt)
+	if m.picked != nil {
+		fields = append(fields, wavepick.FieldPicked)
 	}
 	return fields
 }
@@ -7268,20 +21950,12 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *WavePickMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldUsername:
-		return m.Username()
-	case user.FieldPasswordHash:
-		return m.PasswordHash()
-	case user.FieldRole:
-		return m.Role()
-	case user.FieldActive:
-		return m.Active()
-	case user.FieldCreatedAt:
-		return m.CreatedAt()
-	case user.FieldUpdatedAt:
-		return m.UpdatedAt()
+	case wavepick.FieldQuantity:
+		return m.Quantity()
+	case wavepick.FieldPicked:
+		return m.Picked()
 	}
 	return nil, false
 }
@@ -7289,218 +21963,254 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case user.FieldUsername:
-		return m.OldUsername(ctx)
-	case user.FieldPasswordHash:
-		return m.OldPasswordHash(ctx)
-	case user.FieldRole:
-		return m.OldRole(ctx)
-	case user.FieldActive:
-		return m.OldActive(ctx)
-	case user.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case user.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown User field %s", name)
-}
-
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *WavePickMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.FieldUsername:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUsername(v)
-		return nil
-	case user.FieldPasswordHash:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPasswordHash(v)
-		return nil
-	case user.FieldRole:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRole(v)
-		return nil
-	case user.FieldActive:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetActive(v)
-		return nil
-	case user.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case wavepick.FieldQuantity:
+		return m.OldQuantity(ctx)
+	case wavepick.FieldPicked:
+		return m.OldPicked(ctx)
+	}
+	return nil, fmt.Errorf("unknown WavePick field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WavePickMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case wavepick.FieldQuantity:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetQuantity(v)
 		return nil
-	case user.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case wavepick.FieldPicked:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetPicked(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown WavePick field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
-	return nil
+func (m *WavePickMutation) AddedFields() []string {
+	var fields []string
+	if m.addquantity != nil {
+		fields = append(fields, wavepick.FieldQuantity)
+	}
+	if m.addpicked != nil {
+		fields = append(fields, wavepick.FieldPicked)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WavePickMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case wavepick.FieldQuantity:
+		return m.AddedQuantity()
+	case wavepick.FieldPicked:
+		return m.AddedPicked()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *WavePickMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case wavepick.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuantity(v)
+		return nil
+	case wavepick.FieldPicked:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPicked(v)
+		return nil
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown WavePick numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
+func (m *WavePickMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *WavePickMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown User nullable field %s", name)
+func (m *WavePickMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown WavePick nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *WavePickMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldUsername:
-		m.ResetUsername()
-		return nil
-	case user.FieldPasswordHash:
-		m.ResetPasswordHash()
-		return nil
-	case user.FieldRole:
-		m.ResetRole()
-		return nil
-	case user.FieldActive:
-		m.ResetActive()
-		return nil
-	case user.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case wavepick.FieldQuantity:
+		m.ResetQuantity()
 		return nil
-	case user.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case wavepick.FieldPicked:
+		m.ResetPicked()
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown WavePick field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *WavePickMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.task != nil {
+		edges = append(edges, wavepick.EdgeTask)
+	}
+	if m.order_line != nil {
+		edges = append(edges, wavepick.EdgeOrderLine)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+func (m *WavePickMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case wavepick.EdgeTask:
+		if id := m.task; id != nil {
+			return []ent.Value{*id}
+		}
+	case wavepick.EdgeOrderLine:
+		if id := m.order_line; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *WavePickMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+func (m *WavePickMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *WavePickMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedtask {
+		edges = append(edges, wavepick.EdgeTask)
+	}
+	if m.clearedorder_line {
+		edges = append(edges, wavepick.EdgeOrderLine)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
+func (m *WavePickMutation) EdgeCleared(name string) bool {
+	switch name {
+	case wavepick.EdgeTask:
+		return m.clearedtask
+	case wavepick.EdgeOrderLine:
+		return m.clearedorder_line
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown User unique edge %s", name)
+func (m *WavePickMutation) ClearEdge(name string) error {
+	switch name {
+	case wavepick.EdgeTask:
+		m.ClearTask()
+		return nil
+	case wavepick.EdgeOrderLine:
+		m.ClearOrderLine()
+		return nil
+	}
+	return fmt.Errorf("unknown WavePick unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown User edge %s", name)
+func (m *WavePickMutation) ResetEdge(name string) error {
+	switch name {
+	case wavepick.EdgeTask:
+		m.ResetTask()
+		return nil
+	case wavepick.EdgeOrderLine:
+		m.ResetOrderLine()
+		return nil
+	}
+	return fmt.Errorf("unknown WavePick edge %s", name)
 }
 
-// WarehouseMutation represents an operation that mutates the Warehouse nodes in the graph.
-type WarehouseMutation struct {
+// WaveTaskMutation represents an operation that mutates the WaveTask nodes in the graph.
+type WaveTaskMutation struct {
 	config
-	op                         Op
-	typ                        string
-	id                         *int
-	code                       *string
-	name                       *string
-	clearedFields              map[string]struct{}
-	warehouse_locations        map[int]struct{}
-	removedwarehouse_locations map[int]struct{}
-	clearedwarehouse_locations bool
-	done                       bool
-	oldValue                   func(context.Context) (*Warehouse, error)
-	predicates                 []predicate.Warehouse
+	op              Op
+	typ             string
+	id              *int
+	quantity        *int
+	addquantity     *int
+	picked          *int
+	addpicked       *int
+	status          *string
+	picked_at       *time.Time
+	bin_id          *int
+	addbin_id       *int
+	picked_by       *string
+	clearedFields   map[string]struct{}
+	wave            *int
+	clearedwave     bool
+	item            *int
+	cleareditem     bool
+	location        *int
+	clearedlocation bool
+	picks           map[int]struct{}
+	removedpicks    map[int]struct{}
+	clearedpicks    bool
+	done            bool
+	oldValue        func(context.Context) (*WaveTask, error)
+	predicates      []predicate.WaveTask
 }
 
-var _ ent.Mutation = (*WarehouseMutation)(nil)
+var _ ent.Mutation = (*WaveTaskMutation)(nil)
 
-// warehouseOption allows management of the mutation configuration using functional options.
-type warehouseOption func(*WarehouseMutation)
+// wavetaskOption allows management of the mutation configuration using functional options.
+type wavetaskOption func(*WaveTaskMutation)
 
-// newWarehouseMutation creates new mutation for the Warehouse entity.
-func newWarehouseMutation(c config, op Op, opts ...warehouseOption) *WarehouseMutation {
-	m := &WarehouseMutation{
+// newWaveTaskMutation creates new mutation for the WaveTask entity.
+func newWaveTaskMutation(c config, op Op, opts ...wavetaskOption) *WaveTaskMutation {
+	m := &WaveTaskMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeWarehouse,
+		typ:           TypeWaveTask,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -7509,20 +22219,20 @@ func newWarehouseMutation(c config, op Op, opts ...warehouseOption) *WarehouseMu
 	return m
 }
 
-// withWarehouseID sets the ID field of the mutation.
-func withWarehouseID(id int) warehouseOption {
-	return func(m *WarehouseMutation) {
+// withWaveTaskID sets the ID field of the mutation.
+func withWaveTaskID(id int) wavetaskOption {
+	return func(m *WaveTaskMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Warehouse
+			value *WaveTask
 		)
-		m.oldValue = func(ctx context.Context) (*Warehouse, error) {
+		m.oldValue = func(ctx context.Context) (*WaveTask, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Warehouse.Get(ctx, id)
+					value, err = m.Client().WaveTask.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -7531,10 +22241,10 @@ func withWarehouseID(id int) warehouseOption {
 	}
 }
 
-// withWarehouse sets the old Warehouse of the mutation.
-func withWarehouse(node *Warehouse) warehouseOption {
-	return func(m *WarehouseMutation) {
-		m.oldValue = func(context.Context) (*Warehouse, error) {
+// withWaveTask sets the old WaveTask of the mutation.
+func withWaveTask(node *WaveTask) wavetaskOption {
+	return func(m *WaveTaskMutation) {
+		m.oldValue = func(context.Context) (*WaveTask, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -7543,7 +22253,7 @@ func withWarehouse(node *Warehouse) warehouseOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m WarehouseMutation) Client() *Client {
+func (m WaveTaskMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -7551,7 +22261,7 @@ func (m WarehouseMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m WarehouseMutation) Tx() (*Tx, error) {
+func (m WaveTaskMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -7562,7 +22272,7 @@ func (m WarehouseMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *WarehouseMutation) ID() (id int, exists bool) {
+func (m *WaveTaskMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -7573,7 +22283,7 @@ func (m *WarehouseMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *WarehouseMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *WaveTaskMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -7582,562 +22292,423 @@ func (m *WarehouseMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Warehouse.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WaveTask.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCode sets the "code" field.
-func (m *WarehouseMutation) SetCode(s string) {
-	m.code = &s
-}
-
-// Code returns the value of the "code" field in the mutation.
-func (m *WarehouseMutation) Code() (r string, exists bool) {
-	v := m.code
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCode returns the old "code" field's value of the Warehouse entity.
-// If the Warehouse object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WarehouseMutation) OldCode(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCode is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCode requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCode: %w", err)
-	}
-	return oldValue.Code, nil
-}
-
-// ResetCode resets all changes to the "code" field.
-func (m *WarehouseMutation) ResetCode() {
-	m.code = nil
-}
-
-// SetName sets the "name" field.
-func (m *WarehouseMutation) SetName(s string) {
-	m.name = &s
+// SetQuantity sets the "quantity" field.
+func (m *WaveTaskMutation) SetQuantity(i int) {
+	m.quantity = &i
+	m.addquantity = nil
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *WarehouseMutation) Name() (r string, exists bool) {
-	v := m.name
+// Quantity returns the value of the "quantity" field in the mutation.
+func (m *WaveTaskMutation) Quantity() (r int, exists bool) {
+	v := m.quantity
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Warehouse entity.
-// If the Warehouse object wasn't provided to the builder, the object is fetched from the database.
+// OldQuantity returns the old "quantity" field's value of the WaveTask entity.
+// If the WaveTask object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WarehouseMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *WaveTaskMutation) OldQuantity(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
-}
-
-// ClearName clears the value of the "name" field.
-func (m *WarehouseMutation) ClearName() {
-	m.name = nil
-	m.clearedFields[warehouse.FieldName] = struct{}{}
-}
-
-// NameCleared returns if the "name" field was cleared in this mutation.
-func (m *WarehouseMutation) NameCleared() bool {
-	_, ok := m.clearedFields[warehouse.FieldName]
-	return ok
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *WarehouseMutation) ResetName() {
-	m.name = nil
-	delete(m.clearedFields, warehouse.FieldName)
-}
-
-// AddWarehouseLocationIDs adds the "warehouse_locations" edge to the WarehouseLocation entity by ids.
-func (m *WarehouseMutation) AddWarehouseLocationIDs(ids ...int) {
-	if m.warehouse_locations == nil {
-		m.warehouse_locations = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.warehouse_locations[ids[i]] = struct{}{}
-	}
-}
-
-// ClearWarehouseLocations clears the "warehouse_locations" edge to the WarehouseLocation entity.
-func (m *WarehouseMutation) ClearWarehouseLocations() {
-	m.clearedwarehouse_locations = true
-}
-
-// WarehouseLocationsCleared reports if the "warehouse_locations" edge to the WarehouseLocation entity was cleared.
-func (m *WarehouseMutation) WarehouseLocationsCleared() bool {
-	return m.clearedwarehouse_locations
-}
-
-// RemoveWarehouseLocationIDs removes the "warehouse_locations" edge to the WarehouseLocation entity by IDs.
-func (m *WarehouseMutation) RemoveWarehouseLocationIDs(ids ...int) {
-	if m.removedwarehouse_locations == nil {
-		m.removedwarehouse_locations = make(map[int]struct{})
+		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.warehouse_locations, ids[i])
-		m.removedwarehouse_locations[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
 	}
+	return oldValue.Quantity, nil
 }
 
-// RemovedWarehouseLocations returns the removed IDs of the "warehouse_locations" edge to the WarehouseLocation entity.
-func (m *WarehouseMutation) RemovedWarehouseLocationsIDs() (ids []int) {
-	for id := range m.removedwarehouse_locations {
-		ids = append(ids, id)
+// AddQuantity adds i to the "quantity" field.
+func (m *WaveTaskMutation) AddQuantity(i int) {
+	if m.addquantity != nil {
+		*m.addquantity += i
+	} else {
+		m.addquantity = &i
 	}
-	return
 }
 
-// WarehouseLocationsIDs returns the "warehouse_locations" edge IDs in the mutation.
-func (m *WarehouseMutation) WarehouseLocationsIDs() (ids []int) {
-	for id := range m.warehouse_locations {
-		ids = append(ids, id)
+// AddedQuantity returns the value that was added to the "quantity" field in this mutation.
+func (m *WaveTaskMutation) AddedQuantity() (r int, exists bool) {
+	v := m.addquantity
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetWarehouseLocations resets all changes to the "warehouse_locations" edge.
-func (m *WarehouseMutation) ResetWarehouseLocations() {
-	m.warehouse_locations = nil
-	m.clearedwarehouse_locations = false
-	m.removedwarehouse_locations = nil
+// ResetQuantity resets all changes to the "quantity" field.
+func (m *WaveTaskMutation) ResetQuantity() {
+	m.quantity = nil
+	m.addquantity = nil
 }
 
-// Where appends a list predicates to the WarehouseMutation builder.
-func (m *WarehouseMutation) Where(ps ...predicate.Warehouse) {
-	m.predicates = append(m.predicates, ps...)
+// SetPicked sets the "picked" field.
+func (m *WaveTaskMutation) SetPicked(i int) {
+	m.picked = &i
+	m.addpicked = nil
 }
 
-// WhereP appends storage-level predicates to the WarehouseMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *WarehouseMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Warehouse, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// Picked returns the value of the "picked" field in the mutation.
+func (m *WaveTaskMutation) Picked() (r int, exists bool) {
+	v := m.picked
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *WarehouseMutation) Op() Op {
-	return m.op
+// OldPicked returns the old "picked" field's value of the WaveTask entity.
+// If the WaveTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WaveTaskMutation) OldPicked(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPicked is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPicked requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPicked: %w", err)
+	}
+	return oldValue.Picked, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *WarehouseMutation) SetOp(op Op) {
-	m.op = op
+// AddPicked adds i to the "picked" field.
+func (m *WaveTaskMutation) AddPicked(i int) {
+	if m.addpicked != nil {
+		*m.addpicked += i
+	} else {
+		m.addpicked = &i
+	}
 }
 
-// Type returns the node type of this mutation (Warehouse).
-func (m *WarehouseMutation) Type() string {
-	return m.typ
+// AddedPicked returns the value that was added to the "picked" field in this mutation.
+func (m *WaveTaskMutation) AddedPicked() (r int, exists bool) {
+	v := m.addpicked
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *WarehouseMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.code != nil {
-		fields = append(fields, warehouse.FieldCode)
-	}
-	if m.name != nil {
-		fields = append(fields, warehouse.FieldName)
-	}
-	return fields
+// ResetPicked resets all changes to the "picked" field.
+func (m *WaveTaskMutation) ResetPicked() {
+	m.picked = nil
+	m.addpicked = nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *WarehouseMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case warehouse.FieldCode:
-		return m.Code()
-	case warehouse.FieldName:
-		return m.Name()
-	}
-	return nil, false
+// SetStatus sets the "status" field.
+func (m *WaveTaskMutation) SetStatus(s string) {
+	m.status = &s
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *WarehouseMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case warehouse.FieldCode:
-		return m.OldCode(ctx)
-	case warehouse.FieldName:
-		return m.OldName(ctx)
+// Status returns the value of the "status" field in the mutation.
+func (m *WaveTaskMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown Warehouse field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *WarehouseMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case warehouse.FieldCode:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCode(v)
-		return nil
-	case warehouse.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
+// OldStatus returns the old "status" field's value of the WaveTask entity.
+// If the WaveTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WaveTaskMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Warehouse field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *WarehouseMutation) AddedFields() []string {
-	return nil
+// ResetStatus resets all changes to the "status" field.
+func (m *WaveTaskMutation) ResetStatus() {
+	m.status = nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *WarehouseMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// SetPickedAt sets the "picked_at" field.
+func (m *WaveTaskMutation) SetPickedAt(t time.Time) {
+	m.picked_at = &t
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *WarehouseMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// PickedAt returns the value of the "picked_at" field in the mutation.
+func (m *WaveTaskMutation) PickedAt() (r time.Time, exists bool) {
+	v := m.picked_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Warehouse numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *WarehouseMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(warehouse.FieldName) {
-		fields = append(fields, warehouse.FieldName)
+// OldPickedAt returns the old "picked_at" field's value of the WaveTask entity.
+// If the WaveTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WaveTaskMutation) OldPickedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPickedAt is only allowed on UpdateOne operations")
 	}
-	return fields
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPickedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPickedAt: %w", err)
+	}
+	return oldValue.PickedAt, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *WarehouseMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// ClearPickedAt clears the value of the "picked_at" field.
+func (m *WaveTaskMutation) ClearPickedAt() {
+	m.picked_at = nil
+	m.clearedFields[wavetask.FieldPickedAt] = struct{}{}
+}
+
+// PickedAtCleared returns if the "picked_at" field was cleared in this mutation.
+func (m *WaveTaskMutation) PickedAtCleared() bool {
+	_, ok := m.clearedFields[wavetask.FieldPickedAt]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *WarehouseMutation) ClearField(name string) error {
-	switch name {
-	case warehouse.FieldName:
-		m.ClearName()
-		return nil
-	}
-	return fmt.Errorf("unknown Warehouse nullable field %s", name)
+// ResetPickedAt resets all changes to the "picked_at" field.
+func (m *WaveTaskMutation) ResetPickedAt() {
+	m.picked_at = nil
+	delete(m.clearedFields, wavetask.FieldPickedAt)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *WarehouseMutation) ResetField(name string) error {
-	switch name {
-	case warehouse.FieldCode:
-		m.ResetCode()
-		return nil
-	case warehouse.FieldName:
-		m.ResetName()
-		return nil
-	}
-	return fmt.Errorf("unknown Warehouse field %s", name)
+// SetBinID sets the "bin_id" field.
+func (m *WaveTaskMutation) SetBinID(i int) {
+	m.bin_id = &i
+	m.addbin_id = nil
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *WarehouseMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.warehouse_locations != nil {
-		edges = append(edges, warehouse.EdgeWarehouseLocations)
+// BinID returns the value of the "bin_id" field in the mutation.
+func (m *WaveTaskMutation) BinID() (r int, exists bool) {
+	v := m.bin_id
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *WarehouseMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case warehouse.EdgeWarehouseLocations:
-		ids := make([]ent.Value, 0, len(m.warehouse_locations))
-		for id := range m.warehouse_locations {
-			ids = append(ids, id)
-		}
-		return ids
+// OldBinID returns the old "bin_id" field's value of the WaveTask entity.
+// If the WaveTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WaveTaskMutation) OldBinID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBinID is only allowed on UpdateOne operations")
 	}
-	return nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBinID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBinID: %w", err)
+	}
+	return oldValue.BinID, nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *WarehouseMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedwarehouse_locations != nil {
-		edges = append(edges, warehouse.EdgeWarehouseLocations)
+// AddBinID adds i to the "bin_id" field.
+func (m *WaveTaskMutation) AddBinID(i int) {
+	if m.addbin_id != nil {
+		*m.addbin_id += i
+	} else {
+		m.addbin_id = &i
 	}
-	return edges
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *WarehouseMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case warehouse.EdgeWarehouseLocations:
-		ids := make([]ent.Value, 0, len(m.removedwarehouse_locations))
-		for id := range m.removedwarehouse_locations {
-			ids = append(ids, id)
-		}
-		return ids
+// AddedBinID returns the value that was added to the "bin_id" field in this mutation.
+func (m *WaveTaskMutation) AddedBinID() (r int, exists bool) {
+	v := m.addbin_id
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *WarehouseMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedwarehouse_locations {
-		edges = append(edges, warehouse.EdgeWarehouseLocations)
-	}
-	return edges
+// ClearBinID clears the value of the "bin_id" field.
+func (m *WaveTaskMutation) ClearBinID() {
+	m.bin_id = nil
+	m.addbin_id = nil
+	m.clearedFields[wavetask.FieldBinID] = struct{}{}
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *WarehouseMutation) EdgeCleared(name string) bool {
-	switch name {
-	case warehouse.EdgeWarehouseLocations:
-		return m.clearedwarehouse_locations
-	}
-	return false
+// BinIDCleared returns if the "bin_id" field was cleared in this mutation.
+func (m *WaveTaskMutation) BinIDCleared() bool {
+	_, ok := m.clearedFields[wavetask.FieldBinID]
+	return ok
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *WarehouseMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Warehouse unique edge %s", name)
+// ResetBinID resets all changes to the "bin_id" field.
+func (m *WaveTaskMutation) ResetBinID() {
+	m.bin_id = nil
+	m.addbin_id = nil
+	delete(m.clearedFields, wavetask.FieldBinID)
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *WarehouseMutation) ResetEdge(name string) error {
-	switch name {
-	case warehouse.EdgeWarehouseLocations:
-		m.ResetWarehouseLocations()
-		return nil
+// SetPickedBy sets the "picked_by" field.
+func (m *WaveTaskMutation) SetPickedBy(s string) {
+	m.picked_by = &s
+}
+
+// PickedBy returns the value of the "picked_by" field in the mutation.
+func (m *WaveTaskMutation) PickedBy() (r string, exists bool) {
+	v := m.picked_by
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Warehouse edge %s", name)
+	return *v, true
 }
 
-// WarehouseLocationMutation represents an operation that mutates the WarehouseLocation nodes in the graph.
-type WarehouseLocationMutation struct {
-	config
-	op               Op
-	typ              string
-	id               *int
-	clearedFields    map[string]struct{}
-	warehouse        *int
-	clearedwarehouse bool
-	location         *int
-	clearedlocation  bool
-	done             bool
-	oldValue         func(context.Context) (*WarehouseLocation, error)
-	predicates       []predicate.WarehouseLocation
+// OldPickedBy returns the old "picked_by" field's value of the WaveTask entity.
+// If the WaveTask object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WaveTaskMutation) OldPickedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPickedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPickedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPickedBy: %w", err)
+	}
+	return oldValue.PickedBy, nil
 }
 
-var _ ent.Mutation = (*WarehouseLocationMutation)(nil)
+// ClearPickedBy clears the value of the "picked_by" field.
+func (m *WaveTaskMutation) ClearPickedBy() {
+	m.picked_by = nil
+	m.clearedFields[wavetask.FieldPickedBy] = struct{}{}
+}
 
-// warehouselocationOption allows management of the mutation configuration using functional options.
-type warehouselocationOption func(*WarehouseLocationMutation)
+// PickedByCleared returns if the "picked_by" field was cleared in this mutation.
+func (m *WaveTaskMutation) PickedByCleared() bool {
+	_, ok := m.clearedFields[wavetask.FieldPickedBy]
+	return ok
+}
 
-// newWarehouseLocationMutation creates new mutation for the WarehouseLocation entity.
-func newWarehouseLocationMutation(c config, op Op, opts ...warehouselocationOption) *WarehouseLocationMutation {
-	m := &WarehouseLocationMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeWarehouseLocation,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
+// ResetPickedBy resets all changes to the "picked_by" field.
+func (m *WaveTaskMutation) ResetPickedBy() {
+	m.picked_by = nil
+	delete(m.clearedFields, wavetask.FieldPickedBy)
 }
 
-// withWarehouseLocationID sets the ID field of the mutation.
-func withWarehouseLocationID(id int) warehouselocationOption {
-	return func(m *WarehouseLocationMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *WarehouseLocation
-		)
-		m.oldValue = func(ctx context.Context) (*WarehouseLocation, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().WarehouseLocation.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// SetWaveID sets the "wave" edge to the Wave entity by id.
+func (m *WaveTaskMutation) SetWaveID(id int) {
+	m.wave = &id
 }
 
-// withWarehouseLocation sets the old WarehouseLocation of the mutation.
-func withWarehouseLocation(node *WarehouseLocation) warehouselocationOption {
-	return func(m *WarehouseLocationMutation) {
-		m.oldValue = func(context.Context) (*WarehouseLocation, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// ClearWave clears the "wave" edge to the Wave entity.
+func (m *WaveTaskMutation) ClearWave() {
+	m.clearedwave = true
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m WarehouseLocationMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// WaveCleared reports if the "wave" edge to the Wave entity was cleared.
+func (m *WaveTaskMutation) WaveCleared() bool {
+	return m.clearedwave
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m WarehouseLocationMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// WaveID returns the "wave" edge ID in the mutation.
+func (m *WaveTaskMutation) WaveID() (id int, exists bool) {
+	if m.wave != nil {
+		return *m.wave, true
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *WarehouseLocationMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
+// WaveIDs returns the "wave" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WaveID instead. It exists only for internal usage by the builders.
+func (m *WaveTaskMutation) WaveIDs() (ids []int) {
+	if id := m.wave; id != nil {
+		ids = append(ids, *id)
 	}
-	return *m.id, true
+	return
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *WarehouseLocationMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().WarehouseLocation.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetWave resets all changes to the "wave" edge.
+func (m *WaveTaskMutation) ResetWave() {
+	m.wave = nil
+	m.clearedwave = false
 }
 
-// SetWarehouseID sets the "warehouse" edge to the Warehouse entity by id.
-func (m *WarehouseLocationMutation) SetWarehouseID(id int) {
-	m.warehouse = &id
+// SetItemID sets the "item" edge to the Item entity by id.
+func (m *WaveTaskMutation) SetItemID(id int) {
+	m.item = &id
 }
 
-// ClearWarehouse clears the "warehouse" edge to the Warehouse entity.
-func (m *WarehouseLocationMutation) ClearWarehouse() {
-	m.clearedwarehouse = true
+// ClearItem clears the "item" edge to the Item entity.
+func (m *WaveTaskMutation) ClearItem() {
+	m.cleareditem = true
 }
 
-// WarehouseCleared reports if the "warehouse" edge to the Warehouse entity was cleared.
-func (m *WarehouseLocationMutation) WarehouseCleared() bool {
-	return m.clearedwarehouse
+// ItemCleared reports if the "item" edge to the Item entity was cleared.
+func (m *WaveTaskMutation) ItemCleared() bool {
+	return m.cleareditem
 }
 
-// WarehouseID returns the "warehouse" edge ID in the mutation.
-func (m *WarehouseLocationMutation) WarehouseID() (id int, exists bool) {
-	if m.warehouse != nil {
-		return *m.warehouse, true
+// ItemID returns the "item" edge ID in the mutation.
+func (m *WaveTaskMutation) ItemID() (id int, exists bool) {
+	if m.item != nil {
+		return *m.item, true
 	}
 	return
 }
 
-// WarehouseIDs returns the "warehouse" edge IDs in the mutation.
+// ItemIDs returns the "item" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// WarehouseID instead. It exists only for internal usage by the builders.
-func (m *WarehouseLocationMutation) WarehouseIDs() (ids []int) {
-	if id := m.warehouse; id != nil {
+// ItemID instead. It exists only for internal usage by the builders.
+func (m *WaveTaskMutation) ItemIDs() (ids []int) {
+	if id := m.item; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetWarehouse resets all changes to the "warehouse" edge.
-func (m *WarehouseLocationMutation) ResetWarehouse() {
-	m.warehouse = nil
-	m.clearedwarehouse = false
+// ResetItem resets all changes to the "item" edge.
+func (m *WaveTaskMutation) ResetItem() {
+	m.item = nil
+	m.cleareditem = false
 }
 
 // SetLocationID sets the "location" edge to the Location entity by id.
-func (m *WarehouseLocationMutation) SetLocationID(id int) {
+func (m *WaveTaskMutation) SetLocationID(id int) {
 	m.location = &id
 }
 
 // ClearLocation clears the "location" edge to the Location entity.
-func (m *WarehouseLocationMutation) ClearLocation() {
+func (m *WaveTaskMutation) ClearLocation() {
 	m.clearedlocation = true
 }
 
 // LocationCleared reports if the "location" edge to the Location entity was cleared.
-func (m *WarehouseLocationMutation) LocationCleared() bool {
+func (m *WaveTaskMutation) LocationCleared() bool {
 	return m.clearedlocation
 }
 
 // LocationID returns the "location" edge ID in the mutation.
-func (m *WarehouseLocationMutation) LocationID() (id int, exists bool) {
+func (m *WaveTaskMutation) LocationID() (id int, exists bool) {
 	if m.location != nil {
 		return *m.location, true
 	}
@@ -8147,7 +22718,7 @@ func (m *WarehouseLocationMutation) LocationID() (id int, exists bool) {
 // LocationIDs returns the "location" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // LocationID instead. It exists only for internal usage by the builders.
-func (m *WarehouseLocationMutation) LocationIDs() (ids []int) {
+func (m *WaveTaskMutation) LocationIDs() (ids []int) {
 	if id := m.location; id != nil {
 		ids = append(ids, *id)
 	}
@@ -8155,20 +22726,74 @@ func (m *WarehouseLocationMutation) LocationIDs() (ids []int) {
 }
 
 // ResetLocation resets all changes to the "location" edge.
-func (m *WarehouseLocationMutation) ResetLocation() {
+func (m *WaveTaskMutation) ResetLocation() {
 	m.location = nil
 	m.clearedlocation = false
 }
 
-// Where appends a list predicates to the WarehouseLocationMutation builder.
-func (m *WarehouseLocationMutation) Where(ps ...predicate.WarehouseLocation) {
+// AddPickIDs adds the "picks" edge to the WavePick entity by ids.
+func (m *WaveTaskMutation) AddPickIDs(ids ...int) {
+	if m.picks == nil {
+		m.picks = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.picks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPicks clears the "picks" edge to the WavePick entity.
+func (m *WaveTaskMutation) ClearPicks() {
+	m.clearedpicks = true
+}
+
+// PicksCleared reports if the "picks" edge to the WavePick entity was cleared.
+func (m *WaveTaskMutation) PicksCleared() bool {
+	return m.clearedpicks
+}
+
+// RemovePickIDs removes the "picks" edge to the WavePick entity by IDs.
+func (m *WaveTaskMutation) RemovePickIDs(ids ...int) {
+	if m.removedpicks == nil {
+		m.removedpicks = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.picks, ids[i])
+		m.removedpicks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPicks returns the removed IDs of the "picks" edge to the WavePick entity.
+func (m *WaveTaskMutation) RemovedPicksIDs() (ids []int) {
+	for id := range m.removedpicks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PicksIDs returns the "picks" edge IDs in the mutation.
+func (m *WaveTaskMutation) PicksIDs() (ids []int) {
+	for id := range m.picks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPicks resets all changes to the "picks" edge.
+func (m *WaveTaskMutation) ResetPicks() {
+	m.picks = nil
+	m.clearedpicks = false
+	m.removedpicks = nil
+}
+
+// Where appends a list predicates to the WaveTaskMutation builder.
+func (m *WaveTaskMutation) Where(ps ...predicate.WaveTask) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the WarehouseLocationMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WaveTaskMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *WarehouseLocationMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.WarehouseLocation, len(ps))
+func (m *WaveTaskMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WaveTask, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -8176,205 +22801,423 @@ func (m *WarehouseLocationMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *WarehouseLocationMutation) Op() Op {
+func (m *WaveTaskMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *WarehouseLocationMutation) SetOp(op Op) {
+func (m *WaveTaskMutation) SetOp(op Op) {
 	m.op = op
 }
-
-// Type returns the node type of this mutation (WarehouseLocation).
-func (m *WarehouseLocationMutation) Type() string {
+
+// Type returns the node type of this mutation (WaveTask).
+func (m *WaveTaskMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *WarehouseLocationMutation) Fields() []string {
-	fields := make([]string, 0, 0)
+func (m *WaveTaskMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.quantity != nil {
+		fields = append(fields, wavetask.FieldQuantity)
+	}
+	if m.picked != nil {
+		fields = append(fields, wavetask.FieldPicked)
+	}
+	if m.status != nil {
+		fields = append(fields, wavetask.FieldStatus)
+	}
+	if m.picked_at != nil {
+		fields = append(fields, wavetask.FieldPickedAt)
+	}
+	if m.bin_id != nil {
+		fields = append(fields, wavetask.FieldBinID)
+	}
+	if m.picked_by != nil {
+		fields = append(fields, wavetask.FieldPickedBy)
+	}
 	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *WarehouseLocationMutation) Field(name string) (ent.Value, bool) {
+func (m *WaveTaskMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case wavetask.FieldQuantity:
+		return m.Quantity()
+	case wavetask.FieldPicked:
+		return m.Picked()
+	case wavetask.FieldStatus:
+		return m.Status()
+	case wavetask.FieldPickedAt:
+		return m.PickedAt()
+	case wavetask.FieldBinID:
+		return m.BinID()
+	case wavetask.FieldPickedBy:
+		return m.PickedBy()
+	}
 	return nil, false
 }
 
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *WarehouseLocationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	return nil, fmt.Errorf("unknown WarehouseLocation field %s", name)
+func (m *WaveTaskMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case wavetask.FieldQuantity:
+		return m.OldQuantity(ctx)
+	case wavetask.FieldPicked:
+		return m.OldPicked(ctx)
+	case wavetask.FieldStatus:
+		return m.OldStatus(ctx)
+	case wavetask.FieldPickedAt:
+		return m.OldPickedAt(ctx)
+	case wavetask.FieldBinID:
+		return m.OldBinID(ctx)
+	case wavetask.FieldPickedBy:
+		return m.OldPickedBy(ctx)
+	}
+	return nil, fmt.Errorf("unknown WaveTask field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *WarehouseLocationMutation) SetField(name string, value ent.Value) error {
+func (m *WaveTaskMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case wavetask.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuantity(v)
+		return nil
+	case wavetask.FieldPicked:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPicked(v)
+		return nil
+	case wavetask.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case wavetask.FieldPickedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPickedAt(v)
+		return nil
+	case wavetask.FieldBinID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBinID(v)
+		return nil
+	case wavetask.FieldPickedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPickedBy(v)
+		return nil
 	}
-	return fmt.Errorf("unknown WarehouseLocation field %s", name)
+	return fmt.Errorf("unknown WaveTask field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *WarehouseLocationMutation) AddedFields() []string {
-	return nil
+func (m *WaveTaskMutation) AddedFields() []string {
+	var fields []string
+	if m.addquantity != nil {
+		fields = append(fields, wavetask.FieldQuantity)
+	}
+	if m.addpicked != nil {
+		fields = append(fields, wavetask.FieldPicked)
+	}
+	if m.addbin_id != nil {
+		fields = append(fields, wavetask.FieldBinID)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *WarehouseLocationMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WaveTaskMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case wavetask.FieldQuantity:
+		return m.AddedQuantity()
+	case wavetask.FieldPicked:
+		return m.AddedPicked()
+	case wavetask.FieldBinID:
+		return m.AddedBinID()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *WarehouseLocationMutation) AddField(name string, value ent.Value) error {
-	return fmt.Errorf("unknown WarehouseLocation numeric field %s", name)
+func (m *WaveTaskMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case wavetask.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuantity(v)
+		return nil
+	case wavetask.FieldPicked:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPicked(v)
+		return nil
+	case wavetask.FieldBinID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBinID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WaveTask numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *WarehouseLocationMutation) ClearedFields() []string {
-	return nil
+func (m *WaveTaskMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(wavetask.FieldPickedAt) {
+		fields = append(fields, wavetask.FieldPickedAt)
+	}
+	if m.FieldCleared(wavetask.FieldBinID) {
+		fields = append(fields, wavetask.FieldBinID)
+	}
+	if m.FieldCleared(wavetask.FieldPickedBy) {
+		fields = append(fields, wavetask.FieldPickedBy)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *WarehouseLocationMutation) FieldCleared(name string) bool {
+func (m *WaveTaskMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *WarehouseLocationMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown WarehouseLocation nullable field %s", name)
+func (m *WaveTaskMutation) ClearField(name string) error {
+	switch name {
+	case wavetask.FieldPickedAt:
+		m.ClearPickedAt()
+		return nil
+	case wavetask.FieldBinID:
+		m.ClearBinID()
+		return nil
+	case wavetask.FieldPickedBy:
+		m.ClearPickedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown WaveTask nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *WarehouseLocationMutation) ResetField(name string) error {
-	return fmt.Errorf("unknown WarehouseLocation field %s", name)
+func (m *WaveTaskMutation) ResetField(name string) error {
+	switch name {
+	case wavetask.FieldQuantity:
+		m.ResetQuantity()
+		return nil
+	case wavetask.FieldPicked:
+		m.ResetPicked()
+		return nil
+	case wavetask.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case wavetask.FieldPickedAt:
+		m.ResetPickedAt()
+		return nil
+	case wavetask.FieldBinID:
+		m.ResetBinID()
+		return nil
+	case wavetask.FieldPickedBy:
+		m.ResetPickedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown WaveTask field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *WarehouseLocationMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.warehouse != nil {
-		edges = append(edges, warehouselocation.EdgeWarehouse)
+func (m *WaveTaskMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.wave != nil {
+		edges = append(edges, wavetask.EdgeWave)
+	}
+	if m.item != nil {
+		edges = append(edges, wavetask.EdgeItem)
 	}
 	if m.location != nil {
-		edges = append(edges, warehouselocation.EdgeLocation)
+		edges = append(edges, wavetask.EdgeLocation)
+	}
+	if m.picks != nil {
+		edges = append(edges, wavetask.EdgePicks)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *WarehouseLocationMutation) AddedIDs(name string) []ent.Value {
+func (m *WaveTaskMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case warehouselocation.EdgeWarehouse:
-		if id := m.warehouse; id != nil {
+	case wavetask.EdgeWave:
+		if id := m.wave; id != nil {
 			return []ent.Value{*id}
 		}
-	case warehouselocation.EdgeLocation:
+	case wavetask.EdgeItem:
+		if id := m.item; id != nil {
+			return []ent.Value{*id}
+		}
+	case wavetask.EdgeLocation:
 		if id := m.location; id != nil {
 			return []ent.Value{*id}
 		}
+	case wavetask.EdgePicks:
+		ids := make([]ent.Value, 0, len(m.picks))
+		for id := range m.picks {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *WarehouseLocationMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *WaveTaskMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedpicks != nil {
+		edges = append(edges, wavetask.EdgePicks)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *WarehouseLocationMutation) RemovedIDs(name string) []ent.Value {
+func (m *WaveTaskMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case wavetask.EdgePicks:
+		ids := make([]ent.Value, 0, len(m.removedpicks))
+		for id := range m.removedpicks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *WarehouseLocationMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedwarehouse {
-		edges = append(edges, warehouselocation.EdgeWarehouse)
+func (m *WaveTaskMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedwave {
+		edges = append(edges, wavetask.EdgeWave)
+	}
+	if m.cleareditem {
+		edges = append(edges, wavetask.EdgeItem)
 	}
 	if m.clearedlocation {
-		edges = append(edges, warehouselocation.EdgeLocation)
+		edges = append(edges, wavetask.EdgeLocation)
+	}
+	if m.clearedpicks {
+		edges = append(edges, wavetask.EdgePicks)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *WarehouseLocationMutation) EdgeCleared(name string) bool {
+func (m *WaveTaskMutation) EdgeCleared(name string) bool {
 	switch name {
-	case warehouselocation.EdgeWarehouse:
-		return m.clearedwarehouse
-	case warehouselocation.EdgeLocation:
+	case wavetask.EdgeWave:
+		return m.clearedwave
+	case wavetask.EdgeItem:
+		return m.cleareditem
+	case wavetask.EdgeLocation:
 		return m.clearedlocation
+	case wavetask.EdgePicks:
+		return m.clearedpicks
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *WarehouseLocationMutation) ClearEdge(name string) error {
+func (m *WaveTaskMutation) ClearEdge(name string) error {
 	switch name {
-	case warehouselocation.EdgeWarehouse:
-		m.ClearWarehouse()
+	case wavetask.EdgeWave:
+		m.ClearWave()
 		return nil
-	case warehouselocation.EdgeLocation:
+	case wavetask.EdgeItem:
+		m.ClearItem()
+		return nil
+	case wavetask.EdgeLocation:
 		m.ClearLocation()
 		return nil
 	}
-	return fmt.Errorf("unknown WarehouseLocation unique edge %s", name)
+	return fmt.Errorf("unknown WaveTask unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *WarehouseLocationMutation) ResetEdge(name string) error {
+func (m *WaveTaskMutation) ResetEdge(name string) error {
 	switch name {
-	case warehouselocation.EdgeWarehouse:
-		m.ResetWarehouse()
+	case wavetask.EdgeWave:
+		m.ResetWave()
 		return nil
-	case warehouselocation.EdgeLocation:
+	case wavetask.EdgeItem:
+		m.ResetItem()
+		return nil
+	case wavetask.EdgeLocation:
 		m.ResetLocation()
 		return nil
+	case wavetask.EdgePicks:
+		m.ResetPicks()
+		return nil
 	}
-	return fmt.Errorf("unknown WarehouseLocation edge %s", name)
+	return fmt.Errorf("unknown WaveTask edge %s", name)
 }
 
 // ZoneMutation represents an operation that mutates the Zone nodes in the graph.
 type ZoneMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	code            *string
-	name            *string
-	clearedFields   map[string]struct{}
-	location        *int
-	clearedlocation bool
-	bins            map[int]struct{}
-	removedbins     map[int]struct{}
-	clearedbins     bool
-	done            bool
-	oldValue        func(context.Context) (*Zone, error)
-	predicates      []predicate.Zone
+	op               Op
+	typ              string
+	id               *int
+	code             *string
+	name             *string
+	walk_sequence    *int
+	addwalk_sequence *int
+	clearedFields    map[string]struct{}
+	location         *int
+	clearedlocation  bool
+	bins             map[int]struct{}
+	removedbins      map[int]struct{}
+	clearedbins      bool
+	done             bool
+	oldValue         func(context.Context) (*Zone, error)
+	predicates       []predicate.Zone
 }
 
 var _ ent.Mutation = (*ZoneMutation)(nil)
@@ -8560,6 +23403,62 @@ func (m *ZoneMutation) ResetName() {
 	delete(m.clearedFields, zone.FieldName)
 }
 
+// SetWalkSequence sets the "walk_sequence" field.
+func (m *ZoneMutation) SetWalkSequence(i int) {
+	m.walk_sequence = &i
+	m.addwalk_sequence = nil
+}
+
+// WalkSequence returns the value of the "walk_sequence" field in the mutation.
+func (m *ZoneMutation) WalkSequence() (r int, exists bool) {
+	v := m.walk_sequence
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWalkSequence returns the old "walk_sequence" field's value of the Zone entity.
+// If the Zone object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ZoneMutation) OldWalkSequence(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWalkSequence is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWalkSequence requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWalkSequence: %w", err)
+	}
+	return oldValue.WalkSequence, nil
+}
+
+// AddWalkSequence adds i to the "walk_sequence" field.
+func (m *ZoneMutation) AddWalkSequence(i int) {
+	if m.addwalk_sequence != nil {
+		*m.addwalk_sequence += i
+	} else {
+		m.addwalk_sequence = &i
+	}
+}
+
+// AddedWalkSequence returns the value that was added to the "walk_sequence" field in this mutation.
+func (m *ZoneMutation) AddedWalkSequence() (r int, exists bool) {
+	v := m.addwalk_sequence
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWalkSequence resets all changes to the "walk_sequence" field.
+func (m *ZoneMutation) ResetWalkSequence() {
+	m.walk_sequence = nil
+	m.addwalk_sequence = nil
+}
+
 // SetLocationID sets the "location" edge to the Location entity by id.
 func (m *ZoneMutation) SetLocationID(id int) {
 	m.location = &id
@@ -8687,13 +23586,16 @@ func (m *ZoneMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ZoneMutation) Fields() []string {
-	fields := make([]string, 0, 2)
+	fields := make([]string, 0, 3)
 	if m.code != nil {
 		fields = append(fields, zone.FieldCode)
 	}
 	if m.name != nil {
 		fields = append(fields, zone.FieldName)
 	}
+	if m.walk_sequence != nil {
+		fields = append(fields, zone.FieldWalkSequence)
+	}
 	return fields
 }
 
@@ -8706,6 +23608,8 @@ func (m *ZoneMutation) Field(name string) (ent.Value, bool) {
 		return m.Code()
 	case zone.FieldName:
 		return m.Name()
+	case zone.FieldWalkSequence:
+		return m.WalkSequence()
 	}
 	return nil, false
 }
@@ -8719,6 +23623,8 @@ func (m *ZoneMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldCode(ctx)
 	case zone.FieldName:
 		return m.OldName(ctx)
+	case zone.FieldWalkSequence:
+		return m.OldWalkSequence(ctx)
 	}
 	return nil, fmt.Errorf("unknown Zone field %s", name)
 }
@@ -8742,6 +23648,13 @@ func (m *ZoneMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetName(v)
 		return nil
+	case zone.FieldWalkSequence:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWalkSequence(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Zone field %s", name)
 }
@@ -8749,13 +23662,21 @@ func (m *ZoneMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *ZoneMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addwalk_sequence != nil {
+		fields = append(fields, zone.FieldWalkSequence)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *ZoneMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case zone.FieldWalkSequence:
+		return m.AddedWalkSequence()
+	}
 	return nil, false
 }
 
@@ -8764,6 +23685,13 @@ func (m *ZoneMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *ZoneMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case zone.FieldWalkSequence:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWalkSequence(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Zone numeric field %s", name)
 }
@@ -8806,6 +23734,9 @@ func (m *ZoneMutation) ResetField(name string) error {
 	case zone.FieldName:
 		m.ResetName()
 		return nil
+	case zone.FieldWalkSequence:
+		m.ResetWalkSequence()
+		return nil
 	}
 	return fmt.Errorf("unknown Zone field %s", name)
 }
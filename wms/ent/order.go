@@ -12,6 +12,8 @@ import (
 	"github.com/mxV03/wms/ent/order"
 	"github.com/mxV03/wms/ent/picklist"
 	"github.com/mxV03/wms/ent/tracking"
+	"github.com/mxV03/wms/ent/warehouse"
+	"github.com/mxV03/wms/ent/wave"
 )
 
 // Order is the model entity for the Order schema.
@@ -27,10 +29,26 @@ type Order struct {
 	Status string `json:"status,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority string `json:"priority,omitempty"`
+	// ShipBy holds the value of the "ship_by" field.
+	ShipBy *time.Time `json:"ship_by,omitempty"`
+	// PostedAt holds the value of the "posted_at" field.
+	PostedAt *time.Time `json:"posted_at,omitempty"`
+	// ReversedAt holds the value of the "reversed_at" field.
+	ReversedAt *time.Time `json:"reversed_at,omitempty"`
+	// ReversalReason holds the value of the "reversal_reason" field.
+	ReversalReason string `json:"reversal_reason,omitempty"`
+	// IdempotencyKey holds the value of the "idempotency_key" field.
+	IdempotencyKey *string `json:"idempotency_key,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the OrderQuery when eager-loading is set.
-	Edges        OrderEdges `json:"edges"`
-	selectValues sql.SelectValues
+	Edges                        OrderEdges `json:"edges"`
+	order_returns                *int
+	warehouse_outgoing_transfers *int
+	warehouse_incoming_transfers *int
+	wave_orders                  *int
+	selectValues                 sql.SelectValues
 }
 
 // OrderEdges holds the relations/edges for other nodes in the graph.
@@ -41,9 +59,21 @@ type OrderEdges struct {
 	Picklist *PickList `json:"picklist,omitempty"`
 	// Tracking holds the value of the tracking edge.
 	Tracking *Tracking `json:"tracking,omitempty"`
+	// Cartons holds the value of the cartons edge.
+	Cartons []*Carton `json:"cartons,omitempty"`
+	// ReturnOf holds the value of the return_of edge.
+	ReturnOf *Order `json:"return_of,omitempty"`
+	// Returns holds the value of the returns edge.
+	Returns []*Order `json:"returns,omitempty"`
+	// SourceWarehouse holds the value of the source_warehouse edge.
+	SourceWarehouse *Warehouse `json:"source_warehouse,omitempty"`
+	// DestinationWarehouse holds the value of the destination_warehouse edge.
+	DestinationWarehouse *Warehouse `json:"destination_warehouse,omitempty"`
+	// Wave holds the value of the wave edge.
+	Wave *Wave `json:"wave,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [9]bool
 }
 
 // LinesOrErr returns the Lines value or an error if the edge
@@ -77,6 +107,68 @@ func (e OrderEdges) TrackingOrErr() (*Tracking, error) {
 	return nil, &NotLoadedError{edge: "tracking"}
 }
 
+// CartonsOrErr returns the Cartons value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrderEdges) CartonsOrErr() ([]*Carton, error) {
+	if e.loadedTypes[3] {
+		return e.Cartons, nil
+	}
+	return nil, &NotLoadedError{edge: "cartons"}
+}
+
+// ReturnOfOrErr returns the ReturnOf value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrderEdges) ReturnOfOrErr() (*Order, error) {
+	if e.ReturnOf != nil {
+		return e.ReturnOf, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: order.Label}
+	}
+	return nil, &NotLoadedError{edge: "return_of"}
+}
+
+// ReturnsOrErr returns the Returns value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrderEdges) ReturnsOrErr() ([]*Order, error) {
+	if e.loadedTypes[5] {
+		return e.Returns, nil
+	}
+	return nil, &NotLoadedError{edge: "returns"}
+}
+
+// SourceWarehouseOrErr returns the SourceWarehouse value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrderEdges) SourceWarehouseOrErr() (*Warehouse, error) {
+	if e.SourceWarehouse != nil {
+		return e.SourceWarehouse, nil
+	} else if e.loadedTypes[6] {
+		return nil, &NotFoundError{label: warehouse.Label}
+	}
+	return nil, &NotLoadedError{edge: "source_warehouse"}
+}
+
+// DestinationWarehouseOrErr returns the DestinationWarehouse value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrderEdges) DestinationWarehouseOrErr() (*Warehouse, error) {
+	if e.DestinationWarehouse != nil {
+		return e.DestinationWarehouse, nil
+	} else if e.loadedTypes[7] {
+		return nil, &NotFoundError{label: warehouse.Label}
+	}
+	return nil, &NotLoadedError{edge: "destination_warehouse"}
+}
+
+// WaveOrErr returns the Wave value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrderEdges) WaveOrErr() (*Wave, error) {
+	if e.Wave != nil {
+		return e.Wave, nil
+	} else if e.loadedTypes[8] {
+		return nil, &NotFoundError{label: wave.Label}
+	}
+	return nil, &NotLoadedError{edge: "wave"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Order) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -84,10 +176,18 @@ func (*Order) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case order.FieldID:
 			values[i] = new(sql.NullInt64)
-		case order.FieldOrderNumber, order.FieldType, order.FieldStatus:
+		case order.FieldOrderNumber, order.FieldType, order.FieldStatus, order.FieldPriority, order.FieldReversalReason, order.FieldIdempotencyKey:
 			values[i] = new(sql.NullString)
-		case order.FieldCreatedAt:
+		case order.FieldCreatedAt, order.FieldShipBy, order.FieldPostedAt, order.FieldReversedAt:
 			values[i] = new(sql.NullTime)
+		case order.ForeignKeys[0]: // order_returns
+			values[i] = new(sql.NullInt64)
+		case order.ForeignKeys[1]: // warehouse_outgoing_transfers
+			values[i] = new(sql.NullInt64)
+		case order.ForeignKeys[2]: // warehouse_incoming_transfers
+			values[i] = new(sql.NullInt64)
+		case order.ForeignKeys[3]: // wave_orders
+			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -133,6 +233,74 @@ func (_m *Order) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.CreatedAt = value.Time
 			}
+		case order.FieldPriority:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				_m.Priority = value.String
+			}
+		case order.FieldShipBy:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ship_by", values[i])
+			} else if value.Valid {
+				_m.ShipBy = new(time.Time)
+				*_m.ShipBy = value.Time
+			}
+		case order.FieldPostedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field posted_at", values[i])
+			} else if value.Valid {
+				_m.PostedAt = new(time.Time)
+				*_m.PostedAt = value.Time
+			}
+		case order.FieldReversedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reversed_at", values[i])
+			} else if value.Valid {
+				_m.ReversedAt = new(time.Time)
+				*_m.ReversedAt = value.Time
+			}
+		case order.FieldReversalReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reversal_reason", values[i])
+			} else if value.Valid {
+				_m.ReversalReason = value.String
+			}
+		case order.FieldIdempotencyKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field idempotency_key", values[i])
+			} else if value.Valid {
+				_m.IdempotencyKey = new(string)
+				*_m.IdempotencyKey = value.String
+			}
+		case order.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field order_returns", value)
+			} else if value.Valid {
+				_m.order_returns = new(int)
+				*_m.order_returns = int(value.Int64)
+			}
+		case order.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field warehouse_outgoing_transfers", value)
+			} else if value.Valid {
+				_m.warehouse_outgoing_transfers = new(int)
+				*_m.warehouse_outgoing_transfers = int(value.Int64)
+			}
+		case order.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field warehouse_incoming_transfers", value)
+			} else if value.Valid {
+				_m.warehouse_incoming_transfers = new(int)
+				*_m.warehouse_incoming_transfers = int(value.Int64)
+			}
+		case order.ForeignKeys[3]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field wave_orders", value)
+			} else if value.Valid {
+				_m.wave_orders = new(int)
+				*_m.wave_orders = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -161,6 +329,36 @@ func (_m *Order) QueryTracking() *TrackingQuery {
 	return NewOrderClient(_m.config).QueryTracking(_m)
 }
 
+// QueryCartons queries the "cartons" edge of the Order entity.
+func (_m *Order) QueryCartons() *CartonQuery {
+	return NewOrderClient(_m.config).QueryCartons(_m)
+}
+
+// QueryReturnOf queries the "return_of" edge of the Order entity.
+func (_m *Order) QueryReturnOf() *OrderQuery {
+	return NewOrderClient(_m.config).QueryReturnOf(_m)
+}
+
+// QueryReturns queries the "returns" edge of the Order entity.
+func (_m *Order) QueryReturns() *OrderQuery {
+	return NewOrderClient(_m.config).QueryReturns(_m)
+}
+
+// QuerySourceWarehouse queries the "source_warehouse" edge of the Order entity.
+func (_m *Order) QuerySourceWarehouse() *WarehouseQuery {
+	return NewOrderClient(_m.config).QuerySourceWarehouse(_m)
+}
+
+// QueryDestinationWarehouse queries the "destination_warehouse" edge of the Order entity.
+func (_m *Order) QueryDestinationWarehouse() *WarehouseQuery {
+	return NewOrderClient(_m.config).QueryDestinationWarehouse(_m)
+}
+
+// QueryWave queries the "wave" edge of the Order entity.
+func (_m *Order) QueryWave() *WaveQuery {
+	return NewOrderClient(_m.config).QueryWave(_m)
+}
+
 // Update returns a builder for updating this Order.
 // Note that you need to call Order.Unwrap() before calling this method if this Order
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -195,6 +393,32 @@ func (_m *Order) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(_m.Priority)
+	builder.WriteString(", ")
+	if v := _m.ShipBy; v != nil {
+		builder.WriteString("ship_by=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.PostedAt; v != nil {
+		builder.WriteString("posted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ReversedAt; v != nil {
+		builder.WriteString("reversed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("reversal_reason=")
+	builder.WriteString(_m.ReversalReason)
+	builder.WriteString(", ")
+	if v := _m.IdempotencyKey; v != nil {
+		builder.WriteString("idempotency_key=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -22,12 +22,36 @@ const (
 	FieldStatus = "status"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
+	// FieldPriority holds the string denoting the priority field in the database.
+	FieldPriority = "priority"
+	// FieldShipBy holds the string denoting the ship_by field in the database.
+	FieldShipBy = "ship_by"
+	// FieldPostedAt holds the string denoting the posted_at field in the database.
+	FieldPostedAt = "posted_at"
+	// FieldReversedAt holds the string denoting the reversed_at field in the database.
+	FieldReversedAt = "reversed_at"
+	// FieldReversalReason holds the string denoting the reversal_reason field in the database.
+	FieldReversalReason = "reversal_reason"
+	// FieldIdempotencyKey holds the string denoting the idempotency_key field in the database.
+	FieldIdempotencyKey = "idempotency_key"
 	// EdgeLines holds the string denoting the lines edge name in mutations.
 	EdgeLines = "lines"
 	// EdgePicklist holds the string denoting the picklist edge name in mutations.
 	EdgePicklist = "picklist"
 	// EdgeTracking holds the string denoting the tracking edge name in mutations.
 	EdgeTracking = "tracking"
+	// EdgeCartons holds the string denoting the cartons edge name in mutations.
+	EdgeCartons = "cartons"
+	// EdgeReturnOf holds the string denoting the return_of edge name in mutations.
+	EdgeReturnOf = "return_of"
+	// EdgeReturns holds the string denoting the returns edge name in mutations.
+	EdgeReturns = "returns"
+	// EdgeSourceWarehouse holds the string denoting the source_warehouse edge name in mutations.
+	EdgeSourceWarehouse = "source_warehouse"
+	// EdgeDestinationWarehouse holds the string denoting the destination_warehouse edge name in mutations.
+	EdgeDestinationWarehouse = "destination_warehouse"
+	// EdgeWave holds the string denoting the wave edge name in mutations.
+	EdgeWave = "wave"
 	// Table holds the table name of the order in the database.
 	Table = "orders"
 	// LinesTable is the table that holds the lines relation/edge.
@@ -51,6 +75,42 @@ const (
 	TrackingInverseTable = "trackings"
 	// TrackingColumn is the table column denoting the tracking relation/edge.
 	TrackingColumn = "order_tracking"
+	// CartonsTable is the table that holds the cartons relation/edge.
+	CartonsTable = "cartons"
+	// CartonsInverseTable is the table name for the Carton entity.
+	// It exists in this package in order to avoid circular dependency with the "carton" package.
+	CartonsInverseTable = "cartons"
+	// CartonsColumn is the table column denoting the cartons relation/edge.
+	CartonsColumn = "order_cartons"
+	// ReturnOfTable is the table that holds the return_of relation/edge.
+	ReturnOfTable = "orders"
+	// ReturnOfColumn is the table column denoting the return_of relation/edge.
+	ReturnOfColumn = "order_returns"
+	// ReturnsTable is the table that holds the returns relation/edge.
+	ReturnsTable = "orders"
+	// ReturnsColumn is the table column denoting the returns relation/edge.
+	ReturnsColumn = "order_returns"
+	// SourceWarehouseTable is the table that holds the source_warehouse relation/edge.
+	SourceWarehouseTable = "orders"
+	// SourceWarehouseInverseTable is the table name for the Warehouse entity.
+	// It exists in this package in order to avoid circular dependency with the "warehouse" package.
+	SourceWarehouseInverseTable = "warehouses"
+	// SourceWarehouseColumn is the table column denoting the source_warehouse relation/edge.
+	SourceWarehouseColumn = "warehouse_outgoing_transfers"
+	// DestinationWarehouseTable is the table that holds the destination_warehouse relation/edge.
+	DestinationWarehouseTable = "orders"
+	// DestinationWarehouseInverseTable is the table name for the Warehouse entity.
+	// It exists in this package in order to avoid circular dependency with the "warehouse" package.
+	DestinationWarehouseInverseTable = "warehouses"
+	// DestinationWarehouseColumn is the table column denoting the destination_warehouse relation/edge.
+	DestinationWarehouseColumn = "warehouse_incoming_transfers"
+	// WaveTable is the table that holds the wave relation/edge.
+	WaveTable = "orders"
+	// WaveInverseTable is the table name for the Wave entity.
+	// It exists in this package in order to avoid circular dependency with the "wave" package.
+	WaveInverseTable = "waves"
+	// WaveColumn is the table column denoting the wave relation/edge.
+	WaveColumn = "wave_orders"
 )
 
 // Columns holds all SQL columns for order fields.
@@ -60,6 +120,21 @@ var Columns = []string{
 	FieldType,
 	FieldStatus,
 	FieldCreatedAt,
+	FieldPriority,
+	FieldShipBy,
+	FieldPostedAt,
+	FieldReversedAt,
+	FieldReversalReason,
+	FieldIdempotencyKey,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "orders"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"order_returns",
+	"warehouse_outgoing_transfers",
+	"warehouse_incoming_transfers",
+	"wave_orders",
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -69,6 +144,11 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
 	return false
 }
 
@@ -83,6 +163,10 @@ var (
 	StatusValidator func(string) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
+	// DefaultPriority holds the default value on creation for the "priority" field.
+	DefaultPriority string
+	// PriorityValidator is a validator for the "priority" field. It is called by the builders before save.
+	PriorityValidator func(string) error
 )
 
 // OrderOption defines the ordering options for the Order queries.
@@ -113,6 +197,36 @@ func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
 }
 
+// ByPriority orders the results by the priority field.
+func ByPriority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriority, opts...).ToFunc()
+}
+
+// ByShipBy orders the results by the ship_by field.
+func ByShipBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShipBy, opts...).ToFunc()
+}
+
+// ByPostedAt orders the results by the posted_at field.
+func ByPostedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPostedAt, opts...).ToFunc()
+}
+
+// ByReversedAt orders the results by the reversed_at field.
+func ByReversedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReversedAt, opts...).ToFunc()
+}
+
+// ByReversalReason orders the results by the reversal_reason field.
+func ByReversalReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReversalReason, opts...).ToFunc()
+}
+
+// ByIdempotencyKey orders the results by the idempotency_key field.
+func ByIdempotencyKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdempotencyKey, opts...).ToFunc()
+}
+
 // ByLinesCount orders the results by lines count.
 func ByLinesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -140,6 +254,62 @@ func ByTrackingField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newTrackingStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByCartonsCount orders the results by cartons count.
+func ByCartonsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCartonsStep(), opts...)
+	}
+}
+
+// ByCartons orders the results by cartons terms.
+func ByCartons(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCartonsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReturnOfField orders the results by return_of field.
+func ByReturnOfField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReturnOfStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByReturnsCount orders the results by returns count.
+func ByReturnsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReturnsStep(), opts...)
+	}
+}
+
+// ByReturns orders the results by returns terms.
+func ByReturns(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReturnsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySourceWarehouseField orders the results by source_warehouse field.
+func BySourceWarehouseField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSourceWarehouseStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByDestinationWarehouseField orders the results by destination_warehouse field.
+func ByDestinationWarehouseField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDestinationWarehouseStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByWaveField orders the results by wave field.
+func ByWaveField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWaveStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newLinesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -161,3 +331,45 @@ func newTrackingStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2O, false, TrackingTable, TrackingColumn),
 	)
 }
+func newCartonsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CartonsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CartonsTable, CartonsColumn),
+	)
+}
+func newReturnOfStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ReturnOfTable, ReturnOfColumn),
+	)
+}
+func newReturnsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReturnsTable, ReturnsColumn),
+	)
+}
+func newSourceWarehouseStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SourceWarehouseInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SourceWarehouseTable, SourceWarehouseColumn),
+	)
+}
+func newDestinationWarehouseStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DestinationWarehouseInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, DestinationWarehouseTable, DestinationWarehouseColumn),
+	)
+}
+func newWaveStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WaveInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, WaveTable, WaveColumn),
+	)
+}
@@ -75,6 +75,36 @@ func CreatedAt(v time.Time) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldCreatedAt, v))
 }
 
+// Priority applies equality check predicate on the "priority" field. It's identical to PriorityEQ.
+func Priority(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldPriority, v))
+}
+
+// ShipBy applies equality check predicate on the "ship_by" field. It's identical to ShipByEQ.
+func ShipBy(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldShipBy, v))
+}
+
+// PostedAt applies equality check predicate on the "posted_at" field. It's identical to PostedAtEQ.
+func PostedAt(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldPostedAt, v))
+}
+
+// ReversedAt applies equality check predicate on the "reversed_at" field. It's identical to ReversedAtEQ.
+func ReversedAt(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldReversedAt, v))
+}
+
+// ReversalReason applies equality check predicate on the "reversal_reason" field. It's identical to ReversalReasonEQ.
+func ReversalReason(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldReversalReason, v))
+}
+
+// IdempotencyKey applies equality check predicate on the "idempotency_key" field. It's identical to IdempotencyKeyEQ.
+func IdempotencyKey(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldIdempotencyKey, v))
+}
+
 // OrderNumberEQ applies the EQ predicate on the "order_number" field.
 func OrderNumberEQ(v string) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldOrderNumber, v))
@@ -310,6 +340,371 @@ func CreatedAtLTE(v time.Time) predicate.Order {
 	return predicate.Order(sql.FieldLTE(FieldCreatedAt, v))
 }
 
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityGT applies the GT predicate on the "priority" field.
+func PriorityGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldPriority, v))
+}
+
+// PriorityGTE applies the GTE predicate on the "priority" field.
+func PriorityGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldPriority, v))
+}
+
+// PriorityLT applies the LT predicate on the "priority" field.
+func PriorityLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldPriority, v))
+}
+
+// PriorityLTE applies the LTE predicate on the "priority" field.
+func PriorityLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldPriority, v))
+}
+
+// PriorityContains applies the Contains predicate on the "priority" field.
+func PriorityContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldPriority, v))
+}
+
+// PriorityHasPrefix applies the HasPrefix predicate on the "priority" field.
+func PriorityHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldPriority, v))
+}
+
+// PriorityHasSuffix applies the HasSuffix predicate on the "priority" field.
+func PriorityHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldPriority, v))
+}
+
+// PriorityEqualFold applies the EqualFold predicate on the "priority" field.
+func PriorityEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldPriority, v))
+}
+
+// PriorityContainsFold applies the ContainsFold predicate on the "priority" field.
+func PriorityContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldPriority, v))
+}
+
+// ShipByEQ applies the EQ predicate on the "ship_by" field.
+func ShipByEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldShipBy, v))
+}
+
+// ShipByNEQ applies the NEQ predicate on the "ship_by" field.
+func ShipByNEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldShipBy, v))
+}
+
+// ShipByIn applies the In predicate on the "ship_by" field.
+func ShipByIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldShipBy, vs...))
+}
+
+// ShipByNotIn applies the NotIn predicate on the "ship_by" field.
+func ShipByNotIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldShipBy, vs...))
+}
+
+// ShipByGT applies the GT predicate on the "ship_by" field.
+func ShipByGT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldShipBy, v))
+}
+
+// ShipByGTE applies the GTE predicate on the "ship_by" field.
+func ShipByGTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldShipBy, v))
+}
+
+// ShipByLT applies the LT predicate on the "ship_by" field.
+func ShipByLT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldShipBy, v))
+}
+
+// ShipByLTE applies the LTE predicate on the "ship_by" field.
+func ShipByLTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldShipBy, v))
+}
+
+// ShipByIsNil applies the IsNil predicate on the "ship_by" field.
+func ShipByIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldShipBy))
+}
+
+// ShipByNotNil applies the NotNil predicate on the "ship_by" field.
+func ShipByNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldShipBy))
+}
+
+// PostedAtEQ applies the EQ predicate on the "posted_at" field.
+func PostedAtEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldPostedAt, v))
+}
+
+// PostedAtNEQ applies the NEQ predicate on the "posted_at" field.
+func PostedAtNEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldPostedAt, v))
+}
+
+// PostedAtIn applies the In predicate on the "posted_at" field.
+func PostedAtIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldPostedAt, vs...))
+}
+
+// PostedAtNotIn applies the NotIn predicate on the "posted_at" field.
+func PostedAtNotIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldPostedAt, vs...))
+}
+
+// PostedAtGT applies the GT predicate on the "posted_at" field.
+func PostedAtGT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldPostedAt, v))
+}
+
+// PostedAtGTE applies the GTE predicate on the "posted_at" field.
+func PostedAtGTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldPostedAt, v))
+}
+
+// PostedAtLT applies the LT predicate on the "posted_at" field.
+func PostedAtLT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldPostedAt, v))
+}
+
+// PostedAtLTE applies the LTE predicate on the "posted_at" field.
+func PostedAtLTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldPostedAt, v))
+}
+
+// PostedAtIsNil applies the IsNil predicate on the "posted_at" field.
+func PostedAtIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldPostedAt))
+}
+
+// PostedAtNotNil applies the NotNil predicate on the "posted_at" field.
+func PostedAtNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldPostedAt))
+}
+
+// ReversedAtEQ applies the EQ predicate on the "reversed_at" field.
+func ReversedAtEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldReversedAt, v))
+}
+
+// ReversedAtNEQ applies the NEQ predicate on the "reversed_at" field.
+func ReversedAtNEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldReversedAt, v))
+}
+
+// ReversedAtIn applies the In predicate on the "reversed_at" field.
+func ReversedAtIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldReversedAt, vs...))
+}
+
+// ReversedAtNotIn applies the NotIn predicate on the "reversed_at" field.
+func ReversedAtNotIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldReversedAt, vs...))
+}
+
+// ReversedAtGT applies the GT predicate on the "reversed_at" field.
+func ReversedAtGT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldReversedAt, v))
+}
+
+// ReversedAtGTE applies the GTE predicate on the "reversed_at" field.
+func ReversedAtGTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldReversedAt, v))
+}
+
+// ReversedAtLT applies the LT predicate on the "reversed_at" field.
+func ReversedAtLT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldReversedAt, v))
+}
+
+// ReversedAtLTE applies the LTE predicate on the "reversed_at" field.
+func ReversedAtLTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldReversedAt, v))
+}
+
+// ReversedAtIsNil applies the IsNil predicate on the "reversed_at" field.
+func ReversedAtIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldReversedAt))
+}
+
+// ReversedAtNotNil applies the NotNil predicate on the "reversed_at" field.
+func ReversedAtNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldReversedAt))
+}
+
+// ReversalReasonEQ applies the EQ predicate on the "reversal_reason" field.
+func ReversalReasonEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldReversalReason, v))
+}
+
+// ReversalReasonNEQ applies the NEQ predicate on the "reversal_reason" field.
+func ReversalReasonNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldReversalReason, v))
+}
+
+// ReversalReasonIn applies the In predicate on the "reversal_reason" field.
+func ReversalReasonIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldReversalReason, vs...))
+}
+
+// ReversalReasonNotIn applies the NotIn predicate on the "reversal_reason" field.
+func ReversalReasonNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldReversalReason, vs...))
+}
+
+// ReversalReasonGT applies the GT predicate on the "reversal_reason" field.
+func ReversalReasonGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldReversalReason, v))
+}
+
+// ReversalReasonGTE applies the GTE predicate on the "reversal_reason" field.
+func ReversalReasonGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldReversalReason, v))
+}
+
+// ReversalReasonLT applies the LT predicate on the "reversal_reason" field.
+func ReversalReasonLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldReversalReason, v))
+}
+
+// ReversalReasonLTE applies the LTE predicate on the "reversal_reason" field.
+func ReversalReasonLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldReversalReason, v))
+}
+
+// ReversalReasonContains applies the Contains predicate on the "reversal_reason" field.
+func ReversalReasonContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldReversalReason, v))
+}
+
+// ReversalReasonHasPrefix applies the HasPrefix predicate on the "reversal_reason" field.
+func ReversalReasonHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldReversalReason, v))
+}
+
+// ReversalReasonHasSuffix applies the HasSuffix predicate on the "reversal_reason" field.
+func ReversalReasonHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldReversalReason, v))
+}
+
+// ReversalReasonIsNil applies the IsNil predicate on the "reversal_reason" field.
+func ReversalReasonIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldReversalReason))
+}
+
+// ReversalReasonNotNil applies the NotNil predicate on the "reversal_reason" field.
+func ReversalReasonNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldReversalReason))
+}
+
+// ReversalReasonEqualFold applies the EqualFold predicate on the "reversal_reason" field.
+func ReversalReasonEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldReversalReason, v))
+}
+
+// ReversalReasonContainsFold applies the ContainsFold predicate on the "reversal_reason" field.
+func ReversalReasonContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldReversalReason, v))
+}
+
+// IdempotencyKeyEQ applies the EQ predicate on the "idempotency_key" field.
+func IdempotencyKeyEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyNEQ applies the NEQ predicate on the "idempotency_key" field.
+func IdempotencyKeyNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyIn applies the In predicate on the "idempotency_key" field.
+func IdempotencyKeyIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldIdempotencyKey, vs...))
+}
+
+// IdempotencyKeyNotIn applies the NotIn predicate on the "idempotency_key" field.
+func IdempotencyKeyNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldIdempotencyKey, vs...))
+}
+
+// IdempotencyKeyGT applies the GT predicate on the "idempotency_key" field.
+func IdempotencyKeyGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyGTE applies the GTE predicate on the "idempotency_key" field.
+func IdempotencyKeyGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyLT applies the LT predicate on the "idempotency_key" field.
+func IdempotencyKeyLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyLTE applies the LTE predicate on the "idempotency_key" field.
+func IdempotencyKeyLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyContains applies the Contains predicate on the "idempotency_key" field.
+func IdempotencyKeyContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyHasPrefix applies the HasPrefix predicate on the "idempotency_key" field.
+func IdempotencyKeyHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyHasSuffix applies the HasSuffix predicate on the "idempotency_key" field.
+func IdempotencyKeyHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyIsNil applies the IsNil predicate on the "idempotency_key" field.
+func IdempotencyKeyIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldIdempotencyKey))
+}
+
+// IdempotencyKeyNotNil applies the NotNil predicate on the "idempotency_key" field.
+func IdempotencyKeyNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldIdempotencyKey))
+}
+
+// IdempotencyKeyEqualFold applies the EqualFold predicate on the "idempotency_key" field.
+func IdempotencyKeyEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyContainsFold applies the ContainsFold predicate on the "idempotency_key" field.
+func IdempotencyKeyContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldIdempotencyKey, v))
+}
+
 // HasLines applies the HasEdge predicate on the "lines" edge.
 func HasLines() predicate.Order {
 	return predicate.Order(func(s *sql.Selector) {
@@ -379,6 +774,144 @@ func HasTrackingWith(preds ...predicate.Tracking) predicate.Order {
 	})
 }
 
+// HasCartons applies the HasEdge predicate on the "cartons" edge.
+func HasCartons() predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CartonsTable, CartonsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCartonsWith applies the HasEdge predicate on the "cartons" edge with a given conditions (other predicates).
+func HasCartonsWith(preds ...predicate.Carton) predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := newCartonsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReturnOf applies the HasEdge predicate on the "return_of" edge.
+func HasReturnOf() predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ReturnOfTable, ReturnOfColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReturnOfWith applies the HasEdge predicate on the "return_of" edge with a given conditions (other predicates).
+func HasReturnOfWith(preds ...predicate.Order) predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := newReturnOfStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReturns applies the HasEdge predicate on the "returns" edge.
+func HasReturns() predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReturnsTable, ReturnsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReturnsWith applies the HasEdge predicate on the "returns" edge with a given conditions (other predicates).
+func HasReturnsWith(preds ...predicate.Order) predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := newReturnsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSourceWarehouse applies the HasEdge predicate on the "source_warehouse" edge.
+func HasSourceWarehouse() predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SourceWarehouseTable, SourceWarehouseColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSourceWarehouseWith applies the HasEdge predicate on the "source_warehouse" edge with a given conditions (other predicates).
+func HasSourceWarehouseWith(preds ...predicate.Warehouse) predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := newSourceWarehouseStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDestinationWarehouse applies the HasEdge predicate on the "destination_warehouse" edge.
+func HasDestinationWarehouse() predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DestinationWarehouseTable, DestinationWarehouseColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDestinationWarehouseWith applies the HasEdge predicate on the "destination_warehouse" edge with a given conditions (other predicates).
+func HasDestinationWarehouseWith(preds ...predicate.Warehouse) predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := newDestinationWarehouseStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWave applies the HasEdge predicate on the "wave" edge.
+func HasWave() predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WaveTable, WaveColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWaveWith applies the HasEdge predicate on the "wave" edge with a given conditions (other predicates).
+func HasWaveWith(preds ...predicate.Wave) predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := newWaveStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Order) predicate.Order {
 	return predicate.Order(sql.AndPredicates(predicates...))
@@ -10,10 +10,13 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/carton"
 	"github.com/mxV03/wms/ent/order"
 	"github.com/mxV03/wms/ent/orderline"
 	"github.com/mxV03/wms/ent/picklist"
 	"github.com/mxV03/wms/ent/tracking"
+	"github.com/mxV03/wms/ent/warehouse"
+	"github.com/mxV03/wms/ent/wave"
 )
 
 // OrderCreate is the builder for creating a Order entity.
@@ -63,6 +66,90 @@ func (_c *OrderCreate) SetNillableCreatedAt(v *time.Time) *OrderCreate {
 	return _c
 }
 
+// SetPriority sets the "priority" field.
+func (_c *OrderCreate) SetPriority(v string) *OrderCreate {
+	_c.mutation.SetPriority(v)
+	return _c
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_c *OrderCreate) SetNillablePriority(v *string) *OrderCreate {
+	if v != nil {
+		_c.SetPriority(*v)
+	}
+	return _c
+}
+
+// SetShipBy sets the "ship_by" field.
+func (_c *OrderCreate) SetShipBy(v time.Time) *OrderCreate {
+	_c.mutation.SetShipBy(v)
+	return _c
+}
+
+// SetNillableShipBy sets the "ship_by" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableShipBy(v *time.Time) *OrderCreate {
+	if v != nil {
+		_c.SetShipBy(*v)
+	}
+	return _c
+}
+
+// SetPostedAt sets the "posted_at" field.
+func (_c *OrderCreate) SetPostedAt(v time.Time) *OrderCreate {
+	_c.mutation.SetPostedAt(v)
+	return _c
+}
+
+// SetNillablePostedAt sets the "posted_at" field if the given value is not nil.
+func (_c *OrderCreate) SetNillablePostedAt(v *time.Time) *OrderCreate {
+	if v != nil {
+		_c.SetPostedAt(*v)
+	}
+	return _c
+}
+
+// SetReversedAt sets the "reversed_at" field.
+func (_c *OrderCreate) SetReversedAt(v time.Time) *OrderCreate {
+	_c.mutation.SetReversedAt(v)
+	return _c
+}
+
+// SetNillableReversedAt sets the "reversed_at" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableReversedAt(v *time.Time) *OrderCreate {
+	if v != nil {
+		_c.SetReversedAt(*v)
+	}
+	return _c
+}
+
+// SetReversalReason sets the "reversal_reason" field.
+func (_c *OrderCreate) SetReversalReason(v string) *OrderCreate {
+	_c.mutation.SetReversalReason(v)
+	return _c
+}
+
+// SetNillableReversalReason sets the "reversal_reason" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableReversalReason(v *string) *OrderCreate {
+	if v != nil {
+		_c.SetReversalReason(*v)
+	}
+	return _c
+}
+
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (_c *OrderCreate) SetIdempotencyKey(v string) *OrderCreate {
+	_c.mutation.SetIdempotencyKey(v)
+	return _c
+}
+
+// SetNillableIdempotencyKey sets the "idempotency_key" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableIdempotencyKey(v *string) *OrderCreate {
+	if v != nil {
+		_c.SetIdempotencyKey(*v)
+	}
+	return _c
+}
+
 // AddLineIDs adds the "lines" edge to the OrderLine entity by IDs.
 func (_c *OrderCreate) AddLineIDs(ids ...int) *OrderCreate {
 	_c.mutation.AddLineIDs(ids...)
@@ -116,6 +203,112 @@ func (_c *OrderCreate) SetTracking(v *Tracking) *OrderCreate {
 	return _c.SetTrackingID(v.ID)
 }
 
+// AddCartonIDs adds the "cartons" edge to the Carton entity by IDs.
+func (_c *OrderCreate) AddCartonIDs(ids ...int) *OrderCreate {
+	_c.mutation.AddCartonIDs(ids...)
+	return _c
+}
+
+// AddCartons adds the "cartons" edges to the Carton entity.
+func (_c *OrderCreate) AddCartons(v ...*Carton) *OrderCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCartonIDs(ids...)
+}
+
+// SetReturnOfID sets the "return_of" edge to the Order entity by ID.
+func (_c *OrderCreate) SetReturnOfID(id int) *OrderCreate {
+	_c.mutation.SetReturnOfID(id)
+	return _c
+}
+
+// SetNillableReturnOfID sets the "return_of" edge to the Order entity by ID if the given value is not nil.
+func (_c *OrderCreate) SetNillableReturnOfID(id *int) *OrderCreate {
+	if id != nil {
+		_c = _c.SetReturnOfID(*id)
+	}
+	return _c
+}
+
+// SetReturnOf sets the "return_of" edge to the Order entity.
+func (_c *OrderCreate) SetReturnOf(v *Order) *OrderCreate {
+	return _c.SetReturnOfID(v.ID)
+}
+
+// AddReturnIDs adds the "returns" edge to the Order entity by IDs.
+func (_c *OrderCreate) AddReturnIDs(ids ...int) *OrderCreate {
+	_c.mutation.AddReturnIDs(ids...)
+	return _c
+}
+
+// AddReturns adds the "returns" edges to the Order entity.
+func (_c *OrderCreate) AddReturns(v ...*Order) *OrderCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReturnIDs(ids...)
+}
+
+// SetSourceWarehouseID sets the "source_warehouse" edge to the Warehouse entity by ID.
+func (_c *OrderCreate) SetSourceWarehouseID(id int) *OrderCreate {
+	_c.mutation.SetSourceWarehouseID(id)
+	return _c
+}
+
+// SetNillableSourceWarehouseID sets the "source_warehouse" edge to the Warehouse entity by ID if the given value is not nil.
+func (_c *OrderCreate) SetNillableSourceWarehouseID(id *int) *OrderCreate {
+	if id != nil {
+		_c = _c.SetSourceWarehouseID(*id)
+	}
+	return _c
+}
+
+// SetSourceWarehouse sets the "source_warehouse" edge to the Warehouse entity.
+func (_c *OrderCreate) SetSourceWarehouse(v *Warehouse) *OrderCreate {
+	return _c.SetSourceWarehouseID(v.ID)
+}
+
+// SetDestinationWarehouseID sets the "destination_warehouse" edge to the Warehouse entity by ID.
+func (_c *OrderCreate) SetDestinationWarehouseID(id int) *OrderCreate {
+	_c.mutation.SetDestinationWarehouseID(id)
+	return _c
+}
+
+// SetNillableDestinationWarehouseID sets the "destination_warehouse" edge to the Warehouse entity by ID if the given value is not nil.
+func (_c *OrderCreate) SetNillableDestinationWarehouseID(id *int) *OrderCreate {
+	if id != nil {
+		_c = _c.SetDestinationWarehouseID(*id)
+	}
+	return _c
+}
+
+// SetDestinationWarehouse sets the "destination_warehouse" edge to the Warehouse entity.
+func (_c *OrderCreate) SetDestinationWarehouse(v *Warehouse) *OrderCreate {
+	return _c.SetDestinationWarehouseID(v.ID)
+}
+
+// SetWaveID sets the "wave" edge to the Wave entity by ID.
+func (_c *OrderCreate) SetWaveID(id int) *OrderCreate {
+	_c.mutation.SetWaveID(id)
+	return _c
+}
+
+// SetNillableWaveID sets the "wave" edge to the Wave entity by ID if the given value is not nil.
+func (_c *OrderCreate) SetNillableWaveID(id *int) *OrderCreate {
+	if id != nil {
+		_c = _c.SetWaveID(*id)
+	}
+	return _c
+}
+
+// SetWave sets the "wave" edge to the Wave entity.
+func (_c *OrderCreate) SetWave(v *Wave) *OrderCreate {
+	return _c.SetWaveID(v.ID)
+}
+
 // Mutation returns the OrderMutation object of the builder.
 func (_c *OrderCreate) Mutation() *OrderMutation {
 	return _c.mutation
@@ -159,6 +352,10 @@ func (_c *OrderCreate) defaults() {
 		v := order.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		v := order.DefaultPriority
+		_c.mutation.SetPriority(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -190,6 +387,14 @@ func (_c *OrderCreate) check() error {
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Order.created_at"`)}
 	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "Order.priority"`)}
+	}
+	if v, ok := _c.mutation.Priority(); ok {
+		if err := order.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "Order.priority": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -232,6 +437,30 @@ func (_c *OrderCreate) createSpec() (*Order, *sqlgraph.CreateSpec) {
 		_spec.SetField(order.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
 	}
+	if value, ok := _c.mutation.Priority(); ok {
+		_spec.SetField(order.FieldPriority, field.TypeString, value)
+		_node.Priority = value
+	}
+	if value, ok := _c.mutation.ShipBy(); ok {
+		_spec.SetField(order.FieldShipBy, field.TypeTime, value)
+		_node.ShipBy = &value
+	}
+	if value, ok := _c.mutation.PostedAt(); ok {
+		_spec.SetField(order.FieldPostedAt, field.TypeTime, value)
+		_node.PostedAt = &value
+	}
+	if value, ok := _c.mutation.ReversedAt(); ok {
+		_spec.SetField(order.FieldReversedAt, field.TypeTime, value)
+		_node.ReversedAt = &value
+	}
+	if value, ok := _c.mutation.ReversalReason(); ok {
+		_spec.SetField(order.FieldReversalReason, field.TypeString, value)
+		_node.ReversalReason = value
+	}
+	if value, ok := _c.mutation.IdempotencyKey(); ok {
+		_spec.SetField(order.FieldIdempotencyKey, field.TypeString, value)
+		_node.IdempotencyKey = &value
+	}
 	if nodes := _c.mutation.LinesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -280,6 +509,106 @@ func (_c *OrderCreate) createSpec() (*Order, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.CartonsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.CartonsTable,
+			Columns: []string{order.CartonsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(carton.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReturnOfIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.ReturnOfTable,
+			Columns: []string{order.ReturnOfColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.order_returns = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReturnsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ReturnsTable,
+			Columns: []string{order.ReturnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SourceWarehouseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.SourceWarehouseTable,
+			Columns: []string{order.SourceWarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.warehouse_outgoing_transfers = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DestinationWarehouseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.DestinationWarehouseTable,
+			Columns: []string{order.DestinationWarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.warehouse_incoming_transfers = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.WaveIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.WaveTable,
+			Columns: []string{order.WaveColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wave.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.wave_orders = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
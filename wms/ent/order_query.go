@@ -12,23 +12,33 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/carton"
 	"github.com/mxV03/wms/ent/order"
 	"github.com/mxV03/wms/ent/orderline"
 	"github.com/mxV03/wms/ent/picklist"
 	"github.com/mxV03/wms/ent/predicate"
 	"github.com/mxV03/wms/ent/tracking"
+	"github.com/mxV03/wms/ent/warehouse"
+	"github.com/mxV03/wms/ent/wave"
 )
 
 // OrderQuery is the builder for querying Order entities.
 type OrderQuery struct {
 	config
-	ctx          *QueryContext
-	order        []order.OrderOption
-	inters       []Interceptor
-	predicates   []predicate.Order
-	withLines    *OrderLineQuery
-	withPicklist *PickListQuery
-	withTracking *TrackingQuery
+	ctx                      *QueryContext
+	order                    []order.OrderOption
+	inters                   []Interceptor
+	predicates               []predicate.Order
+	withLines                *OrderLineQuery
+	withPicklist             *PickListQuery
+	withTracking             *TrackingQuery
+	withCartons              *CartonQuery
+	withReturnOf             *OrderQuery
+	withReturns              *OrderQuery
+	withSourceWarehouse      *WarehouseQuery
+	withDestinationWarehouse *WarehouseQuery
+	withWave                 *WaveQuery
+	withFKs                  bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -131,6 +141,138 @@ func (_q *OrderQuery) QueryTracking() *TrackingQuery {
 	return query
 }
 
+// QueryCartons chains the current query on the "cartons" edge.
+func (_q *OrderQuery) QueryCartons() *CartonQuery {
+	query := (&CartonClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, selector),
+			sqlgraph.To(carton.Table, carton.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, order.CartonsTable, order.CartonsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReturnOf chains the current query on the "return_of" edge.
+func (_q *OrderQuery) QueryReturnOf() *OrderQuery {
+	query := (&OrderClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, selector),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, order.ReturnOfTable, order.ReturnOfColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReturns chains the current query on the "returns" edge.
+func (_q *OrderQuery) QueryReturns() *OrderQuery {
+	query := (&OrderClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, selector),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, order.ReturnsTable, order.ReturnsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySourceWarehouse chains the current query on the "source_warehouse" edge.
+func (_q *OrderQuery) QuerySourceWarehouse() *WarehouseQuery {
+	query := (&WarehouseClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, selector),
+			sqlgraph.To(warehouse.Table, warehouse.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, order.SourceWarehouseTable, order.SourceWarehouseColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDestinationWarehouse chains the current query on the "destination_warehouse" edge.
+func (_q *OrderQuery) QueryDestinationWarehouse() *WarehouseQuery {
+	query := (&WarehouseClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, selector),
+			sqlgraph.To(warehouse.Table, warehouse.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, order.DestinationWarehouseTable, order.DestinationWarehouseColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWave chains the current query on the "wave" edge.
+func (_q *OrderQuery) QueryWave() *WaveQuery {
+	query := (&WaveClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, selector),
+			sqlgraph.To(wave.Table, wave.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, order.WaveTable, order.WaveColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Order entity from the query.
 // Returns a *NotFoundError when no Order was found.
 func (_q *OrderQuery) First(ctx context.Context) (*Order, error) {
@@ -318,14 +460,20 @@ func (_q *OrderQuery) Clone() *OrderQuery {
 		return nil
 	}
 	return &OrderQuery{
-		config:       _q.config,
-		ctx:          _q.ctx.Clone(),
-		order:        append([]order.OrderOption{}, _q.order...),
-		inters:       append([]Interceptor{}, _q.inters...),
-		predicates:   append([]predicate.Order{}, _q.predicates...),
-		withLines:    _q.withLines.Clone(),
-		withPicklist: _q.withPicklist.Clone(),
-		withTracking: _q.withTracking.Clone(),
+		config:                   _q.config,
+		ctx:                      _q.ctx.Clone(),
+		order:                    append([]order.OrderOption{}, _q.order...),
+		inters:                   append([]Interceptor{}, _q.inters...),
+		predicates:               append([]predicate.Order{}, _q.predicates...),
+		withLines:                _q.withLines.Clone(),
+		withPicklist:             _q.withPicklist.Clone(),
+		withTracking:             _q.withTracking.Clone(),
+		withCartons:              _q.withCartons.Clone(),
+		withReturnOf:             _q.withReturnOf.Clone(),
+		withReturns:              _q.withReturns.Clone(),
+		withSourceWarehouse:      _q.withSourceWarehouse.Clone(),
+		withDestinationWarehouse: _q.withDestinationWarehouse.Clone(),
+		withWave:                 _q.withWave.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -365,6 +513,72 @@ func (_q *OrderQuery) WithTracking(opts ...func(*TrackingQuery)) *OrderQuery {
 	return _q
 }
 
+// WithCartons tells the query-builder to eager-load the nodes that are connected to
+// the "cartons" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OrderQuery) WithCartons(opts ...func(*CartonQuery)) *OrderQuery {
+	query := (&CartonClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCartons = query
+	return _q
+}
+
+// WithReturnOf tells the query-builder to eager-load the nodes that are connected to
+// the "return_of" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OrderQuery) WithReturnOf(opts ...func(*OrderQuery)) *OrderQuery {
+	query := (&OrderClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReturnOf = query
+	return _q
+}
+
+// WithReturns tells the query-builder to eager-load the nodes that are connected to
+// the "returns" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OrderQuery) WithReturns(opts ...func(*OrderQuery)) *OrderQuery {
+	query := (&OrderClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReturns = query
+	return _q
+}
+
+// WithSourceWarehouse tells the query-builder to eager-load the nodes that are connected to
+// the "source_warehouse" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OrderQuery) WithSourceWarehouse(opts ...func(*WarehouseQuery)) *OrderQuery {
+	query := (&WarehouseClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSourceWarehouse = query
+	return _q
+}
+
+// WithDestinationWarehouse tells the query-builder to eager-load the nodes that are connected to
+// the "destination_warehouse" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OrderQuery) WithDestinationWarehouse(opts ...func(*WarehouseQuery)) *OrderQuery {
+	query := (&WarehouseClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDestinationWarehouse = query
+	return _q
+}
+
+// WithWave tells the query-builder to eager-load the nodes that are connected to
+// the "wave" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OrderQuery) WithWave(opts ...func(*WaveQuery)) *OrderQuery {
+	query := (&WaveClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withWave = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -442,13 +656,26 @@ func (_q *OrderQuery) prepareQuery(ctx context.Context) error {
 func (_q *OrderQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Order, error) {
 	var (
 		nodes       = []*Order{}
+		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [9]bool{
 			_q.withLines != nil,
 			_q.withPicklist != nil,
 			_q.withTracking != nil,
+			_q.withCartons != nil,
+			_q.withReturnOf != nil,
+			_q.withReturns != nil,
+			_q.withSourceWarehouse != nil,
+			_q.withDestinationWarehouse != nil,
+			_q.withWave != nil,
 		}
 	)
+	if _q.withReturnOf != nil || _q.withSourceWarehouse != nil || _q.withDestinationWarehouse != nil || _q.withWave != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, order.ForeignKeys...)
+	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Order).scanValues(nil, columns)
 	}
@@ -486,6 +713,44 @@ func (_q *OrderQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Order,
 			return nil, err
 		}
 	}
+	if query := _q.withCartons; query != nil {
+		if err := _q.loadCartons(ctx, query, nodes,
+			func(n *Order) { n.Edges.Cartons = []*Carton{} },
+			func(n *Order, e *Carton) { n.Edges.Cartons = append(n.Edges.Cartons, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withReturnOf; query != nil {
+		if err := _q.loadReturnOf(ctx, query, nodes, nil,
+			func(n *Order, e *Order) { n.Edges.ReturnOf = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withReturns; query != nil {
+		if err := _q.loadReturns(ctx, query, nodes,
+			func(n *Order) { n.Edges.Returns = []*Order{} },
+			func(n *Order, e *Order) { n.Edges.Returns = append(n.Edges.Returns, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSourceWarehouse; query != nil {
+		if err := _q.loadSourceWarehouse(ctx, query, nodes, nil,
+			func(n *Order, e *Warehouse) { n.Edges.SourceWarehouse = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withDestinationWarehouse; query != nil {
+		if err := _q.loadDestinationWarehouse(ctx, query, nodes, nil,
+			func(n *Order, e *Warehouse) { n.Edges.DestinationWarehouse = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withWave; query != nil {
+		if err := _q.loadWave(ctx, query, nodes, nil,
+			func(n *Order, e *Wave) { n.Edges.Wave = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -576,6 +841,196 @@ func (_q *OrderQuery) loadTracking(ctx context.Context, query *TrackingQuery, no
 	}
 	return nil
 }
+func (_q *OrderQuery) loadCartons(ctx context.Context, query *CartonQuery, nodes []*Order, init func(*Order), assign func(*Order, *Carton)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Order)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Carton(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(order.CartonsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.order_cartons
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "order_cartons" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "order_cartons" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *OrderQuery) loadReturnOf(ctx context.Context, query *OrderQuery, nodes []*Order, init func(*Order), assign func(*Order, *Order)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Order)
+	for i := range nodes {
+		if nodes[i].order_returns == nil {
+			continue
+		}
+		fk := *nodes[i].order_returns
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(order.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "order_returns" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *OrderQuery) loadReturns(ctx context.Context, query *OrderQuery, nodes []*Order, init func(*Order), assign func(*Order, *Order)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Order)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Order(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(order.ReturnsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.order_returns
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "order_returns" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "order_returns" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *OrderQuery) loadSourceWarehouse(ctx context.Context, query *WarehouseQuery, nodes []*Order, init func(*Order), assign func(*Order, *Warehouse)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Order)
+	for i := range nodes {
+		if nodes[i].warehouse_outgoing_transfers == nil {
+			continue
+		}
+		fk := *nodes[i].warehouse_outgoing_transfers
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(warehouse.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "warehouse_outgoing_transfers" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *OrderQuery) loadDestinationWarehouse(ctx context.Context, query *WarehouseQuery, nodes []*Order, init func(*Order), assign func(*Order, *Warehouse)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Order)
+	for i := range nodes {
+		if nodes[i].warehouse_incoming_transfers == nil {
+			continue
+		}
+		fk := *nodes[i].warehouse_incoming_transfers
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(warehouse.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "warehouse_incoming_transfers" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *OrderQuery) loadWave(ctx context.Context, query *WaveQuery, nodes []*Order, init func(*Order), assign func(*Order, *Wave)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Order)
+	for i := range nodes {
+		if nodes[i].wave_orders == nil {
+			continue
+		}
+		fk := *nodes[i].wave_orders
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(wave.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "wave_orders" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *OrderQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -11,11 +11,14 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/carton"
 	"github.com/mxV03/wms/ent/order"
 	"github.com/mxV03/wms/ent/orderline"
 	"github.com/mxV03/wms/ent/picklist"
 	"github.com/mxV03/wms/ent/predicate"
 	"github.com/mxV03/wms/ent/tracking"
+	"github.com/mxV03/wms/ent/warehouse"
+	"github.com/mxV03/wms/ent/wave"
 )
 
 // OrderUpdate is the builder for updating Order entities.
@@ -87,6 +90,120 @@ func (_u *OrderUpdate) SetNillableCreatedAt(v *time.Time) *OrderUpdate {
 	return _u
 }
 
+// SetPriority sets the "priority" field.
+func (_u *OrderUpdate) SetPriority(v string) *OrderUpdate {
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillablePriority(v *string) *OrderUpdate {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// SetShipBy sets the "ship_by" field.
+func (_u *OrderUpdate) SetShipBy(v time.Time) *OrderUpdate {
+	_u.mutation.SetShipBy(v)
+	return _u
+}
+
+// SetNillableShipBy sets the "ship_by" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableShipBy(v *time.Time) *OrderUpdate {
+	if v != nil {
+		_u.SetShipBy(*v)
+	}
+	return _u
+}
+
+// ClearShipBy clears the value of the "ship_by" field.
+func (_u *OrderUpdate) ClearShipBy() *OrderUpdate {
+	_u.mutation.ClearShipBy()
+	return _u
+}
+
+// SetPostedAt sets the "posted_at" field.
+func (_u *OrderUpdate) SetPostedAt(v time.Time) *OrderUpdate {
+	_u.mutation.SetPostedAt(v)
+	return _u
+}
+
+// SetNillablePostedAt sets the "posted_at" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillablePostedAt(v *time.Time) *OrderUpdate {
+	if v != nil {
+		_u.SetPostedAt(*v)
+	}
+	return _u
+}
+
+// ClearPostedAt clears the value of the "posted_at" field.
+func (_u *OrderUpdate) ClearPostedAt() *OrderUpdate {
+	_u.mutation.ClearPostedAt()
+	return _u
+}
+
+// SetReversedAt sets the "reversed_at" field.
+func (_u *OrderUpdate) SetReversedAt(v time.Time) *OrderUpdate {
+	_u.mutation.SetReversedAt(v)
+	return _u
+}
+
+// SetNillableReversedAt sets the "reversed_at" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableReversedAt(v *time.Time) *OrderUpdate {
+	if v != nil {
+		_u.SetReversedAt(*v)
+	}
+	return _u
+}
+
+// ClearReversedAt clears the value of the "reversed_at" field.
+func (_u *OrderUpdate) ClearReversedAt() *OrderUpdate {
+	_u.mutation.ClearReversedAt()
+	return _u
+}
+
+// SetReversalReason sets the "reversal_reason" field.
+func (_u *OrderUpdate) SetReversalReason(v string) *OrderUpdate {
+	_u.mutation.SetReversalReason(v)
+	return _u
+}
+
+// SetNillableReversalReason sets the "reversal_reason" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableReversalReason(v *string) *OrderUpdate {
+	if v != nil {
+		_u.SetReversalReason(*v)
+	}
+	return _u
+}
+
+// ClearReversalReason clears the value of the "reversal_reason" field.
+func (_u *OrderUpdate) ClearReversalReason() *OrderUpdate {
+	_u.mutation.ClearReversalReason()
+	return _u
+}
+
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (_u *OrderUpdate) SetIdempotencyKey(v string) *OrderUpdate {
+	_u.mutation.SetIdempotencyKey(v)
+	return _u
+}
+
+// SetNillableIdempotencyKey sets the "idempotency_key" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableIdempotencyKey(v *string) *OrderUpdate {
+	if v != nil {
+		_u.SetIdempotencyKey(*v)
+	}
+	return _u
+}
+
+// ClearIdempotencyKey clears the value of the "idempotency_key" field.
+func (_u *OrderUpdate) ClearIdempotencyKey() *OrderUpdate {
+	_u.mutation.ClearIdempotencyKey()
+	return _u
+}
+
 // AddLineIDs adds the "lines" edge to the OrderLine entity by IDs.
 func (_u *OrderUpdate) AddLineIDs(ids ...int) *OrderUpdate {
 	_u.mutation.AddLineIDs(ids...)
@@ -140,6 +257,112 @@ func (_u *OrderUpdate) SetTracking(v *Tracking) *OrderUpdate {
 	return _u.SetTrackingID(v.ID)
 }
 
+// AddCartonIDs adds the "cartons" edge to the Carton entity by IDs.
+func (_u *OrderUpdate) AddCartonIDs(ids ...int) *OrderUpdate {
+	_u.mutation.AddCartonIDs(ids...)
+	return _u
+}
+
+// AddCartons adds the "cartons" edges to the Carton entity.
+func (_u *OrderUpdate) AddCartons(v ...*Carton) *OrderUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCartonIDs(ids...)
+}
+
+// SetReturnOfID sets the "return_of" edge to the Order entity by ID.
+func (_u *OrderUpdate) SetReturnOfID(id int) *OrderUpdate {
+	_u.mutation.SetReturnOfID(id)
+	return _u
+}
+
+// SetNillableReturnOfID sets the "return_of" edge to the Order entity by ID if the given value is not nil.
+func (_u *OrderUpdate) SetNillableReturnOfID(id *int) *OrderUpdate {
+	if id != nil {
+		_u = _u.SetReturnOfID(*id)
+	}
+	return _u
+}
+
+// SetReturnOf sets the "return_of" edge to the Order entity.
+func (_u *OrderUpdate) SetReturnOf(v *Order) *OrderUpdate {
+	return _u.SetReturnOfID(v.ID)
+}
+
+// AddReturnIDs adds the "returns" edge to the Order entity by IDs.
+func (_u *OrderUpdate) AddReturnIDs(ids ...int) *OrderUpdate {
+	_u.mutation.AddReturnIDs(ids...)
+	return _u
+}
+
+// AddReturns adds the "returns" edges to the Order entity.
+func (_u *OrderUpdate) AddReturns(v ...*Order) *OrderUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReturnIDs(ids...)
+}
+
+// SetSourceWarehouseID sets the "source_warehouse" edge to the Warehouse entity by ID.
+func (_u *OrderUpdate) SetSourceWarehouseID(id int) *OrderUpdate {
+	_u.mutation.SetSourceWarehouseID(id)
+	return _u
+}
+
+// SetNillableSourceWarehouseID sets the "source_warehouse" edge to the Warehouse entity by ID if the given value is not nil.
+func (_u *OrderUpdate) SetNillableSourceWarehouseID(id *int) *OrderUpdate {
+	if id != nil {
+		_u = _u.SetSourceWarehouseID(*id)
+	}
+	return _u
+}
+
+// SetSourceWarehouse sets the "source_warehouse" edge to the Warehouse entity.
+func (_u *OrderUpdate) SetSourceWarehouse(v *Warehouse) *OrderUpdate {
+	return _u.SetSourceWarehouseID(v.ID)
+}
+
+// SetDestinationWarehouseID sets the "destination_warehouse" edge to the Warehouse entity by ID.
+func (_u *OrderUpdate) SetDestinationWarehouseID(id int) *OrderUpdate {
+	_u.mutation.SetDestinationWarehouseID(id)
+	return _u
+}
+
+// SetNillableDestinationWarehouseID sets the "destination_warehouse" edge to the Warehouse entity by ID if the given value is not nil.
+func (_u *OrderUpdate) SetNillableDestinationWarehouseID(id *int) *OrderUpdate {
+	if id != nil {
+		_u = _u.SetDestinationWarehouseID(*id)
+	}
+	return _u
+}
+
+// SetDestinationWarehouse sets the "destination_warehouse" edge to the Warehouse entity.
+func (_u *OrderUpdate) SetDestinationWarehouse(v *Warehouse) *OrderUpdate {
+	return _u.SetDestinationWarehouseID(v.ID)
+}
+
+// SetWaveID sets the "wave" edge to the Wave entity by ID.
+func (_u *OrderUpdate) SetWaveID(id int) *OrderUpdate {
+	_u.mutation.SetWaveID(id)
+	return _u
+}
+
+// SetNillableWaveID sets the "wave" edge to the Wave entity by ID if the given value is not nil.
+func (_u *OrderUpdate) SetNillableWaveID(id *int) *OrderUpdate {
+	if id != nil {
+		_u = _u.SetWaveID(*id)
+	}
+	return _u
+}
+
+// SetWave sets the "wave" edge to the Wave entity.
+func (_u *OrderUpdate) SetWave(v *Wave) *OrderUpdate {
+	return _u.SetWaveID(v.ID)
+}
+
 // Mutation returns the OrderMutation object of the builder.
 func (_u *OrderUpdate) Mutation() *OrderMutation {
 	return _u.mutation
@@ -178,6 +401,72 @@ func (_u *OrderUpdate) ClearTracking() *OrderUpdate {
 	return _u
 }
 
+// ClearCartons clears all "cartons" edges to the Carton entity.
+func (_u *OrderUpdate) ClearCartons() *OrderUpdate {
+	_u.mutation.ClearCartons()
+	return _u
+}
+
+// RemoveCartonIDs removes the "cartons" edge to Carton entities by IDs.
+func (_u *OrderUpdate) RemoveCartonIDs(ids ...int) *OrderUpdate {
+	_u.mutation.RemoveCartonIDs(ids...)
+	return _u
+}
+
+// RemoveCartons removes "cartons" edges to Carton entities.
+func (_u *OrderUpdate) RemoveCartons(v ...*Carton) *OrderUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCartonIDs(ids...)
+}
+
+// ClearReturnOf clears the "return_of" edge to the Order entity.
+func (_u *OrderUpdate) ClearReturnOf() *OrderUpdate {
+	_u.mutation.ClearReturnOf()
+	return _u
+}
+
+// ClearReturns clears all "returns" edges to the Order entity.
+func (_u *OrderUpdate) ClearReturns() *OrderUpdate {
+	_u.mutation.ClearReturns()
+	return _u
+}
+
+// RemoveReturnIDs removes the "returns" edge to Order entities by IDs.
+func (_u *OrderUpdate) RemoveReturnIDs(ids ...int) *OrderUpdate {
+	_u.mutation.RemoveReturnIDs(ids...)
+	return _u
+}
+
+// RemoveReturns removes "returns" edges to Order entities.
+func (_u *OrderUpdate) RemoveReturns(v ...*Order) *OrderUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReturnIDs(ids...)
+}
+
+// ClearSourceWarehouse clears the "source_warehouse" edge to the Warehouse entity.
+func (_u *OrderUpdate) ClearSourceWarehouse() *OrderUpdate {
+	_u.mutation.ClearSourceWarehouse()
+	return _u
+}
+
+// ClearDestinationWarehouse clears the "destination_warehouse" edge to the Warehouse entity.
+func (_u *OrderUpdate) ClearDestinationWarehouse() *OrderUpdate {
+	_u.mutation.ClearDestinationWarehouse()
+	return _u
+}
+
+// ClearWave clears the "wave" edge to the Wave entity.
+func (_u *OrderUpdate) ClearWave() *OrderUpdate {
+	_u.mutation.ClearWave()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *OrderUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -222,6 +511,11 @@ func (_u *OrderUpdate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Order.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Priority(); ok {
+		if err := order.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "Order.priority": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -249,6 +543,39 @@ func (_u *OrderUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(order.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(order.FieldPriority, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ShipBy(); ok {
+		_spec.SetField(order.FieldShipBy, field.TypeTime, value)
+	}
+	if _u.mutation.ShipByCleared() {
+		_spec.ClearField(order.FieldShipBy, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PostedAt(); ok {
+		_spec.SetField(order.FieldPostedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PostedAtCleared() {
+		_spec.ClearField(order.FieldPostedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReversedAt(); ok {
+		_spec.SetField(order.FieldReversedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReversedAtCleared() {
+		_spec.ClearField(order.FieldReversedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReversalReason(); ok {
+		_spec.SetField(order.FieldReversalReason, field.TypeString, value)
+	}
+	if _u.mutation.ReversalReasonCleared() {
+		_spec.ClearField(order.FieldReversalReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.IdempotencyKey(); ok {
+		_spec.SetField(order.FieldIdempotencyKey, field.TypeString, value)
+	}
+	if _u.mutation.IdempotencyKeyCleared() {
+		_spec.ClearField(order.FieldIdempotencyKey, field.TypeString)
+	}
 	if _u.mutation.LinesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -352,6 +679,212 @@ func (_u *OrderUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.CartonsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.CartonsTable,
+			Columns: []string{order.CartonsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(carton.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCartonsIDs(); len(nodes) > 0 && !_u.mutation.CartonsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.CartonsTable,
+			Columns: []string{order.CartonsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(carton.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CartonsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.CartonsTable,
+			Columns: []string{order.CartonsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(carton.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReturnOfCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.ReturnOfTable,
+			Columns: []string{order.ReturnOfColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReturnOfIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.ReturnOfTable,
+			Columns: []string{order.ReturnOfColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReturnsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ReturnsTable,
+			Columns: []string{order.ReturnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReturnsIDs(); len(nodes) > 0 && !_u.mutation.ReturnsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ReturnsTable,
+			Columns: []string{order.ReturnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReturnsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ReturnsTable,
+			Columns: []string{order.ReturnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SourceWarehouseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.SourceWarehouseTable,
+			Columns: []string{order.SourceWarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SourceWarehouseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.SourceWarehouseTable,
+			Columns: []string{order.SourceWarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DestinationWarehouseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.DestinationWarehouseTable,
+			Columns: []string{order.DestinationWarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DestinationWarehouseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.DestinationWarehouseTable,
+			Columns: []string{order.DestinationWarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WaveCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.WaveTable,
+			Columns: []string{order.WaveColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wave.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WaveIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.WaveTable,
+			Columns: []string{order.WaveColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wave.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{order.Label}
@@ -406,28 +939,142 @@ func (_u *OrderUpdateOne) SetStatus(v string) *OrderUpdateOne {
 	return _u
 }
 
-// SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *OrderUpdateOne) SetNillableStatus(v *string) *OrderUpdateOne {
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableStatus(v *string) *OrderUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *OrderUpdateOne) SetCreatedAt(v time.Time) *OrderUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableCreatedAt(v *time.Time) *OrderUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *OrderUpdateOne) SetPriority(v string) *OrderUpdateOne {
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillablePriority(v *string) *OrderUpdateOne {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// SetShipBy sets the "ship_by" field.
+func (_u *OrderUpdateOne) SetShipBy(v time.Time) *OrderUpdateOne {
+	_u.mutation.SetShipBy(v)
+	return _u
+}
+
+// SetNillableShipBy sets the "ship_by" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableShipBy(v *time.Time) *OrderUpdateOne {
+	if v != nil {
+		_u.SetShipBy(*v)
+	}
+	return _u
+}
+
+// ClearShipBy clears the value of the "ship_by" field.
+func (_u *OrderUpdateOne) ClearShipBy() *OrderUpdateOne {
+	_u.mutation.ClearShipBy()
+	return _u
+}
+
+// SetPostedAt sets the "posted_at" field.
+func (_u *OrderUpdateOne) SetPostedAt(v time.Time) *OrderUpdateOne {
+	_u.mutation.SetPostedAt(v)
+	return _u
+}
+
+// SetNillablePostedAt sets the "posted_at" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillablePostedAt(v *time.Time) *OrderUpdateOne {
+	if v != nil {
+		_u.SetPostedAt(*v)
+	}
+	return _u
+}
+
+// ClearPostedAt clears the value of the "posted_at" field.
+func (_u *OrderUpdateOne) ClearPostedAt() *OrderUpdateOne {
+	_u.mutation.ClearPostedAt()
+	return _u
+}
+
+// SetReversedAt sets the "reversed_at" field.
+func (_u *OrderUpdateOne) SetReversedAt(v time.Time) *OrderUpdateOne {
+	_u.mutation.SetReversedAt(v)
+	return _u
+}
+
+// SetNillableReversedAt sets the "reversed_at" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableReversedAt(v *time.Time) *OrderUpdateOne {
+	if v != nil {
+		_u.SetReversedAt(*v)
+	}
+	return _u
+}
+
+// ClearReversedAt clears the value of the "reversed_at" field.
+func (_u *OrderUpdateOne) ClearReversedAt() *OrderUpdateOne {
+	_u.mutation.ClearReversedAt()
+	return _u
+}
+
+// SetReversalReason sets the "reversal_reason" field.
+func (_u *OrderUpdateOne) SetReversalReason(v string) *OrderUpdateOne {
+	_u.mutation.SetReversalReason(v)
+	return _u
+}
+
+// SetNillableReversalReason sets the "reversal_reason" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableReversalReason(v *string) *OrderUpdateOne {
 	if v != nil {
-		_u.SetStatus(*v)
+		_u.SetReversalReason(*v)
 	}
 	return _u
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (_u *OrderUpdateOne) SetCreatedAt(v time.Time) *OrderUpdateOne {
-	_u.mutation.SetCreatedAt(v)
+// ClearReversalReason clears the value of the "reversal_reason" field.
+func (_u *OrderUpdateOne) ClearReversalReason() *OrderUpdateOne {
+	_u.mutation.ClearReversalReason()
 	return _u
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (_u *OrderUpdateOne) SetNillableCreatedAt(v *time.Time) *OrderUpdateOne {
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (_u *OrderUpdateOne) SetIdempotencyKey(v string) *OrderUpdateOne {
+	_u.mutation.SetIdempotencyKey(v)
+	return _u
+}
+
+// SetNillableIdempotencyKey sets the "idempotency_key" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableIdempotencyKey(v *string) *OrderUpdateOne {
 	if v != nil {
-		_u.SetCreatedAt(*v)
+		_u.SetIdempotencyKey(*v)
 	}
 	return _u
 }
 
+// ClearIdempotencyKey clears the value of the "idempotency_key" field.
+func (_u *OrderUpdateOne) ClearIdempotencyKey() *OrderUpdateOne {
+	_u.mutation.ClearIdempotencyKey()
+	return _u
+}
+
 // AddLineIDs adds the "lines" edge to the OrderLine entity by IDs.
 func (_u *OrderUpdateOne) AddLineIDs(ids ...int) *OrderUpdateOne {
 	_u.mutation.AddLineIDs(ids...)
@@ -481,6 +1128,112 @@ func (_u *OrderUpdateOne) SetTracking(v *Tracking) *OrderUpdateOne {
 	return _u.SetTrackingID(v.ID)
 }
 
+// AddCartonIDs adds the "cartons" edge to the Carton entity by IDs.
+func (_u *OrderUpdateOne) AddCartonIDs(ids ...int) *OrderUpdateOne {
+	_u.mutation.AddCartonIDs(ids...)
+	return _u
+}
+
+// AddCartons adds the "cartons" edges to the Carton entity.
+func (_u *OrderUpdateOne) AddCartons(v ...*Carton) *OrderUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCartonIDs(ids...)
+}
+
+// SetReturnOfID sets the "return_of" edge to the Order entity by ID.
+func (_u *OrderUpdateOne) SetReturnOfID(id int) *OrderUpdateOne {
+	_u.mutation.SetReturnOfID(id)
+	return _u
+}
+
+// SetNillableReturnOfID sets the "return_of" edge to the Order entity by ID if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableReturnOfID(id *int) *OrderUpdateOne {
+	if id != nil {
+		_u = _u.SetReturnOfID(*id)
+	}
+	return _u
+}
+
+// SetReturnOf sets the "return_of" edge to the Order entity.
+func (_u *OrderUpdateOne) SetReturnOf(v *Order) *OrderUpdateOne {
+	return _u.SetReturnOfID(v.ID)
+}
+
+// AddReturnIDs adds the "returns" edge to the Order entity by IDs.
+func (_u *OrderUpdateOne) AddReturnIDs(ids ...int) *OrderUpdateOne {
+	_u.mutation.AddReturnIDs(ids...)
+	return _u
+}
+
+// AddReturns adds the "returns" edges to the Order entity.
+func (_u *OrderUpdateOne) AddReturns(v ...*Order) *OrderUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReturnIDs(ids...)
+}
+
+// SetSourceWarehouseID sets the "source_warehouse" edge to the Warehouse entity by ID.
+func (_u *OrderUpdateOne) SetSourceWarehouseID(id int) *OrderUpdateOne {
+	_u.mutation.SetSourceWarehouseID(id)
+	return _u
+}
+
+// SetNillableSourceWarehouseID sets the "source_warehouse" edge to the Warehouse entity by ID if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableSourceWarehouseID(id *int) *OrderUpdateOne {
+	if id != nil {
+		_u = _u.SetSourceWarehouseID(*id)
+	}
+	return _u
+}
+
+// SetSourceWarehouse sets the "source_warehouse" edge to the Warehouse entity.
+func (_u *OrderUpdateOne) SetSourceWarehouse(v *Warehouse) *OrderUpdateOne {
+	return _u.SetSourceWarehouseID(v.ID)
+}
+
+// SetDestinationWarehouseID sets the "destination_warehouse" edge to the Warehouse entity by ID.
+func (_u *OrderUpdateOne) SetDestinationWarehouseID(id int) *OrderUpdateOne {
+	_u.mutation.SetDestinationWarehouseID(id)
+	return _u
+}
+
+// SetNillableDestinationWarehouseID sets the "destination_warehouse" edge to the Warehouse entity by ID if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableDestinationWarehouseID(id *int) *OrderUpdateOne {
+	if id != nil {
+		_u = _u.SetDestinationWarehouseID(*id)
+	}
+	return _u
+}
+
+// SetDestinationWarehouse sets the "destination_warehouse" edge to the Warehouse entity.
+func (_u *OrderUpdateOne) SetDestinationWarehouse(v *Warehouse) *OrderUpdateOne {
+	return _u.SetDestinationWarehouseID(v.ID)
+}
+
+// SetWaveID sets the "wave" edge to the Wave entity by ID.
+func (_u *OrderUpdateOne) SetWaveID(id int) *OrderUpdateOne {
+	_u.mutation.SetWaveID(id)
+	return _u
+}
+
+// SetNillableWaveID sets the "wave" edge to the Wave entity by ID if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableWaveID(id *int) *OrderUpdateOne {
+	if id != nil {
+		_u = _u.SetWaveID(*id)
+	}
+	return _u
+}
+
+// SetWave sets the "wave" edge to the Wave entity.
+func (_u *OrderUpdateOne) SetWave(v *Wave) *OrderUpdateOne {
+	return _u.SetWaveID(v.ID)
+}
+
 // Mutation returns the OrderMutation object of the builder.
 func (_u *OrderUpdateOne) Mutation() *OrderMutation {
 	return _u.mutation
@@ -519,6 +1272,72 @@ func (_u *OrderUpdateOne) ClearTracking() *OrderUpdateOne {
 	return _u
 }
 
+// ClearCartons clears all "cartons" edges to the Carton entity.
+func (_u *OrderUpdateOne) ClearCartons() *OrderUpdateOne {
+	_u.mutation.ClearCartons()
+	return _u
+}
+
+// RemoveCartonIDs removes the "cartons" edge to Carton entities by IDs.
+func (_u *OrderUpdateOne) RemoveCartonIDs(ids ...int) *OrderUpdateOne {
+	_u.mutation.RemoveCartonIDs(ids...)
+	return _u
+}
+
+// RemoveCartons removes "cartons" edges to Carton entities.
+func (_u *OrderUpdateOne) RemoveCartons(v ...*Carton) *OrderUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCartonIDs(ids...)
+}
+
+// ClearReturnOf clears the "return_of" edge to the Order entity.
+func (_u *OrderUpdateOne) ClearReturnOf() *OrderUpdateOne {
+	_u.mutation.ClearReturnOf()
+	return _u
+}
+
+// ClearReturns clears all "returns" edges to the Order entity.
+func (_u *OrderUpdateOne) ClearReturns() *OrderUpdateOne {
+	_u.mutation.ClearReturns()
+	return _u
+}
+
+// RemoveReturnIDs removes the "returns" edge to Order entities by IDs.
+func (_u *OrderUpdateOne) RemoveReturnIDs(ids ...int) *OrderUpdateOne {
+	_u.mutation.RemoveReturnIDs(ids...)
+	return _u
+}
+
+// RemoveReturns removes "returns" edges to Order entities.
+func (_u *OrderUpdateOne) RemoveReturns(v ...*Order) *OrderUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReturnIDs(ids...)
+}
+
+// ClearSourceWarehouse clears the "source_warehouse" edge to the Warehouse entity.
+func (_u *OrderUpdateOne) ClearSourceWarehouse() *OrderUpdateOne {
+	_u.mutation.ClearSourceWarehouse()
+	return _u
+}
+
+// ClearDestinationWarehouse clears the "destination_warehouse" edge to the Warehouse entity.
+func (_u *OrderUpdateOne) ClearDestinationWarehouse() *OrderUpdateOne {
+	_u.mutation.ClearDestinationWarehouse()
+	return _u
+}
+
+// ClearWave clears the "wave" edge to the Wave entity.
+func (_u *OrderUpdateOne) ClearWave() *OrderUpdateOne {
+	_u.mutation.ClearWave()
+	return _u
+}
+
 // Where appends a list predicates to the OrderUpdate builder.
 func (_u *OrderUpdateOne) Where(ps ...predicate.Order) *OrderUpdateOne {
 	_u.mutation.Where(ps...)
@@ -576,6 +1395,11 @@ func (_u *OrderUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Order.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Priority(); ok {
+		if err := order.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "Order.priority": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -620,6 +1444,39 @@ func (_u *OrderUpdateOne) sqlSave(ctx context.Context) (_node *Order, err error)
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(order.FieldCreatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(order.FieldPriority, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ShipBy(); ok {
+		_spec.SetField(order.FieldShipBy, field.TypeTime, value)
+	}
+	if _u.mutation.ShipByCleared() {
+		_spec.ClearField(order.FieldShipBy, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PostedAt(); ok {
+		_spec.SetField(order.FieldPostedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PostedAtCleared() {
+		_spec.ClearField(order.FieldPostedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReversedAt(); ok {
+		_spec.SetField(order.FieldReversedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReversedAtCleared() {
+		_spec.ClearField(order.FieldReversedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReversalReason(); ok {
+		_spec.SetField(order.FieldReversalReason, field.TypeString, value)
+	}
+	if _u.mutation.ReversalReasonCleared() {
+		_spec.ClearField(order.FieldReversalReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.IdempotencyKey(); ok {
+		_spec.SetField(order.FieldIdempotencyKey, field.TypeString, value)
+	}
+	if _u.mutation.IdempotencyKeyCleared() {
+		_spec.ClearField(order.FieldIdempotencyKey, field.TypeString)
+	}
 	if _u.mutation.LinesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -723,6 +1580,212 @@ func (_u *OrderUpdateOne) sqlSave(ctx context.Context) (_node *Order, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.CartonsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.CartonsTable,
+			Columns: []string{order.CartonsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(carton.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCartonsIDs(); len(nodes) > 0 && !_u.mutation.CartonsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.CartonsTable,
+			Columns: []string{order.CartonsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(carton.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CartonsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.CartonsTable,
+			Columns: []string{order.CartonsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(carton.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReturnOfCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.ReturnOfTable,
+			Columns: []string{order.ReturnOfColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReturnOfIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.ReturnOfTable,
+			Columns: []string{order.ReturnOfColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReturnsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ReturnsTable,
+			Columns: []string{order.ReturnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReturnsIDs(); len(nodes) > 0 && !_u.mutation.ReturnsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ReturnsTable,
+			Columns: []string{order.ReturnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReturnsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ReturnsTable,
+			Columns: []string{order.ReturnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SourceWarehouseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.SourceWarehouseTable,
+			Columns: []string{order.SourceWarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SourceWarehouseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.SourceWarehouseTable,
+			Columns: []string{order.SourceWarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DestinationWarehouseCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.DestinationWarehouseTable,
+			Columns: []string{order.DestinationWarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DestinationWarehouseIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.DestinationWarehouseTable,
+			Columns: []string{order.DestinationWarehouseColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(warehouse.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WaveCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.WaveTable,
+			Columns: []string{order.WaveColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wave.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WaveIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.WaveTable,
+			Columns: []string{order.WaveColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wave.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Order{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -5,6 +5,7 @@ package ent
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -21,6 +22,12 @@ type OrderLine struct {
 	ID int `json:"id,omitempty"`
 	// Quantity holds the value of the "quantity" field.
 	Quantity int `json:"quantity,omitempty"`
+	// Condition holds the value of the "condition" field.
+	Condition string `json:"condition,omitempty"`
+	// Disposition holds the value of the "disposition" field.
+	Disposition string `json:"disposition,omitempty"`
+	// InspectedAt holds the value of the "inspected_at" field.
+	InspectedAt *time.Time `json:"inspected_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the OrderLineQuery when eager-loading is set.
 	Edges                OrderLineEdges `json:"edges"`
@@ -40,9 +47,11 @@ type OrderLineEdges struct {
 	Location *Location `json:"location,omitempty"`
 	// PickTasks holds the value of the pick_tasks edge.
 	PickTasks []*PickTask `json:"pick_tasks,omitempty"`
+	// Receipts holds the value of the receipts edge.
+	Receipts []*Receipt `json:"receipts,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [5]bool
 }
 
 // OrderOrErr returns the Order value or an error if the edge
@@ -87,6 +96,15 @@ func (e OrderLineEdges) PickTasksOrErr() ([]*PickTask, error) {
 	return nil, &NotLoadedError{edge: "pick_tasks"}
 }
 
+// ReceiptsOrErr returns the Receipts value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrderLineEdges) ReceiptsOrErr() ([]*Receipt, error) {
+	if e.loadedTypes[4] {
+		return e.Receipts, nil
+	}
+	return nil, &NotLoadedError{edge: "receipts"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*OrderLine) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -94,6 +112,10 @@ func (*OrderLine) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case orderline.FieldID, orderline.FieldQuantity:
 			values[i] = new(sql.NullInt64)
+		case orderline.FieldCondition, orderline.FieldDisposition:
+			values[i] = new(sql.NullString)
+		case orderline.FieldInspectedAt:
+			values[i] = new(sql.NullTime)
 		case orderline.ForeignKeys[0]: // item_order_lines
 			values[i] = new(sql.NullInt64)
 		case orderline.ForeignKeys[1]: // location_order_lines
@@ -127,6 +149,25 @@ func (_m *OrderLine) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Quantity = int(value.Int64)
 			}
+		case orderline.FieldCondition:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field condition", values[i])
+			} else if value.Valid {
+				_m.Condition = value.String
+			}
+		case orderline.FieldDisposition:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field disposition", values[i])
+			} else if value.Valid {
+				_m.Disposition = value.String
+			}
+		case orderline.FieldInspectedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field inspected_at", values[i])
+			} else if value.Valid {
+				_m.InspectedAt = new(time.Time)
+				*_m.InspectedAt = value.Time
+			}
 		case orderline.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field item_order_lines", value)
@@ -181,6 +222,11 @@ func (_m *OrderLine) QueryPickTasks() *PickTaskQuery {
 	return NewOrderLineClient(_m.config).QueryPickTasks(_m)
 }
 
+// QueryReceipts queries the "receipts" edge of the OrderLine entity.
+func (_m *OrderLine) QueryReceipts() *ReceiptQuery {
+	return NewOrderLineClient(_m.config).QueryReceipts(_m)
+}
+
 // Update returns a builder for updating this OrderLine.
 // Note that you need to call OrderLine.Unwrap() before calling this method if this OrderLine
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -206,6 +252,17 @@ func (_m *OrderLine) String() string {
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
 	builder.WriteString("quantity=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("condition=")
+	builder.WriteString(_m.Condition)
+	builder.WriteString(", ")
+	builder.WriteString("disposition=")
+	builder.WriteString(_m.Disposition)
+	builder.WriteString(", ")
+	if v := _m.InspectedAt; v != nil {
+		builder.WriteString("inspected_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
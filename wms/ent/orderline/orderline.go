@@ -14,6 +14,12 @@ const (
 	FieldID = "id"
 	// FieldQuantity holds the string denoting the quantity field in the database.
 	FieldQuantity = "quantity"
+	// FieldCondition holds the string denoting the condition field in the database.
+	FieldCondition = "condition"
+	// FieldDisposition holds the string denoting the disposition field in the database.
+	FieldDisposition = "disposition"
+	// FieldInspectedAt holds the string denoting the inspected_at field in the database.
+	FieldInspectedAt = "inspected_at"
 	// EdgeOrder holds the string denoting the order edge name in mutations.
 	EdgeOrder = "order"
 	// EdgeItem holds the string denoting the item edge name in mutations.
@@ -22,6 +28,8 @@ const (
 	EdgeLocation = "location"
 	// EdgePickTasks holds the string denoting the pick_tasks edge name in mutations.
 	EdgePickTasks = "pick_tasks"
+	// EdgeReceipts holds the string denoting the receipts edge name in mutations.
+	EdgeReceipts = "receipts"
 	// Table holds the table name of the orderline in the database.
 	Table = "order_lines"
 	// OrderTable is the table that holds the order relation/edge.
@@ -52,12 +60,22 @@ const (
 	PickTasksInverseTable = "pick_tasks"
 	// PickTasksColumn is the table column denoting the pick_tasks relation/edge.
 	PickTasksColumn = "order_line_pick_tasks"
+	// ReceiptsTable is the table that holds the receipts relation/edge.
+	ReceiptsTable = "receipts"
+	// ReceiptsInverseTable is the table name for the Receipt entity.
+	// It exists in this package in order to avoid circular dependency with the "receipt" package.
+	ReceiptsInverseTable = "receipts"
+	// ReceiptsColumn is the table column denoting the receipts relation/edge.
+	ReceiptsColumn = "order_line_receipts"
 )
 
 // Columns holds all SQL columns for orderline fields.
 var Columns = []string{
 	FieldID,
 	FieldQuantity,
+	FieldCondition,
+	FieldDisposition,
+	FieldInspectedAt,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "order_lines"
@@ -101,6 +119,21 @@ func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
 }
 
+// ByCondition orders the results by the condition field.
+func ByCondition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCondition, opts...).ToFunc()
+}
+
+// ByDisposition orders the results by the disposition field.
+func ByDisposition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisposition, opts...).ToFunc()
+}
+
+// ByInspectedAt orders the results by the inspected_at field.
+func ByInspectedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInspectedAt, opts...).ToFunc()
+}
+
 // ByOrderField orders the results by order field.
 func ByOrderField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -135,6 +168,20 @@ func ByPickTasks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newPickTasksStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByReceiptsCount orders the results by receipts count.
+func ByReceiptsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReceiptsStep(), opts...)
+	}
+}
+
+// ByReceipts orders the results by receipts terms.
+func ByReceipts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReceiptsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newOrderStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -163,3 +210,10 @@ func newPickTasksStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, PickTasksTable, PickTasksColumn),
 	)
 }
+func newReceiptsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReceiptsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReceiptsTable, ReceiptsColumn),
+	)
+}
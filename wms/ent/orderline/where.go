@@ -3,6 +3,8 @@
 package orderline
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/mxV03/wms/ent/predicate"
@@ -58,6 +60,21 @@ func Quantity(v int) predicate.OrderLine {
 	return predicate.OrderLine(sql.FieldEQ(FieldQuantity, v))
 }
 
+// Condition applies equality check predicate on the "condition" field. It's identical to ConditionEQ.
+func Condition(v string) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldEQ(FieldCondition, v))
+}
+
+// Disposition applies equality check predicate on the "disposition" field. It's identical to DispositionEQ.
+func Disposition(v string) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldEQ(FieldDisposition, v))
+}
+
+// InspectedAt applies equality check predicate on the "inspected_at" field. It's identical to InspectedAtEQ.
+func InspectedAt(v time.Time) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldEQ(FieldInspectedAt, v))
+}
+
 // QuantityEQ applies the EQ predicate on the "quantity" field.
 func QuantityEQ(v int) predicate.OrderLine {
 	return predicate.OrderLine(sql.FieldEQ(FieldQuantity, v))
@@ -98,6 +115,206 @@ func QuantityLTE(v int) predicate.OrderLine {
 	return predicate.OrderLine(sql.FieldLTE(FieldQuantity, v))
 }
 
+// ConditionEQ applies the EQ predicate on the "condition" field.
+func ConditionEQ(v string) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldEQ(FieldCondition, v))
+}
+
+// ConditionNEQ applies the NEQ predicate on the "condition" field.
+func ConditionNEQ(v string) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldNEQ(FieldCondition, v))
+}
+
+// ConditionIn applies the In predicate on the "condition" field.
+func ConditionIn(vs ...string) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldIn(FieldCondition, vs...))
+}
+
+// ConditionNotIn applies the NotIn predicate on the "condition" field.
+func ConditionNotIn(vs ...string) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldNotIn(FieldCondition, vs...))
+}
+
+// ConditionGT applies the GT predicate on the "condition" field.
+func ConditionGT(v string) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldGT(FieldCondition, v))
+}
+
+// ConditionGTE applies the GTE predicate on the "condition" field.
+func ConditionGTE(v string) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldGTE(FieldCondition, v))
+}
+
+// ConditionLT applies the LT predicate on the "condition" field.
+func ConditionLT(v string) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldLT(FieldCondition, v))
+}
+
+// ConditionLTE applies the LTE predicate on the "condition" field.
+func ConditionLTE(v string) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldLTE(FieldCondition, v))
+}
+
+// ConditionContains applies the Contains predicate on the "condition" field.
+func ConditionContains(v string) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldContains(FieldCondition, v))
+}
+
+// ConditionHasPrefix applies the HasPrefix predicate on the "condition" field.
+func ConditionHasPrefix(v string) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldHasPrefix(FieldCondition, v))
+}
+
+// ConditionHasSuffix applies the HasSuffix predicate on the "condition" field.
+func ConditionHasSuffix(v string) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldHasSuffix(FieldCondition, v))
+}
+
+// ConditionIsNil applies the IsNil predicate on the "condition" field.
+func ConditionIsNil() predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldIsNull(FieldCondition))
+}
+
+// ConditionNotNil applies the NotNil predicate on the "condition" field.
+func ConditionNotNil() predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldNotNull(FieldCondition))
+}
+
+// ConditionEqualFold applies the EqualFold predicate on the "condition" field.
+func ConditionEqualFold(v string) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldEqualFold(FieldCondition, v))
+}
+
+// ConditionContainsFold applies the ContainsFold predicate on the "condition" field.
+func ConditionContainsFold(v string) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldContainsFold(FieldCondition, v))
+}
+
+// DispositionEQ applies the EQ predicate on the "disposition" field.
+func DispositionEQ(v string) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldEQ(FieldDisposition, v))
+}
+
+// DispositionNEQ applies the NEQ predicate on the "disposition" field.
+func DispositionNEQ(v string) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldNEQ(FieldDisposition, v))
+}
+
+// DispositionIn applies the In predicate on the "disposition" field.
+func DispositionIn(vs ...string) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldIn(FieldDisposition, vs...))
+}
+
+// DispositionNotIn applies the NotIn predicate on the "disposition" field.
+func DispositionNotIn(vs ...string) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldNotIn(FieldDisposition, vs...))
+}
+
+// DispositionGT applies the GT predicate on the "disposition" field.
+func DispositionGT(v string) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldGT(FieldDisposition, v))
+}
+
+// DispositionGTE applies the GTE predicate on the "disposition" field.
+func DispositionGTE(v string) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldGTE(FieldDisposition, v))
+}
+
+// DispositionLT applies the LT predicate on the "disposition" field.
+func DispositionLT(v string) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldLT(FieldDisposition, v))
+}
+
+// DispositionLTE applies the LTE predicate on the "disposition" field.
+func DispositionLTE(v string) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldLTE(FieldDisposition, v))
+}
+
+// DispositionContains applies the Contains predicate on the "disposition" field.
+func DispositionContains(v string) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldContains(FieldDisposition, v))
+}
+
+// DispositionHasPrefix applies the HasPrefix predicate on the "disposition" field.
+func DispositionHasPrefix(v string) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldHasPrefix(FieldDisposition, v))
+}
+
+// DispositionHasSuffix applies the HasSuffix predicate on the "disposition" field.
+func DispositionHasSuffix(v string) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldHasSuffix(FieldDisposition, v))
+}
+
+// DispositionIsNil applies the IsNil predicate on the "disposition" field.
+func DispositionIsNil() predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldIsNull(FieldDisposition))
+}
+
+// DispositionNotNil applies the NotNil predicate on the "disposition" field.
+func DispositionNotNil() predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldNotNull(FieldDisposition))
+}
+
+// DispositionEqualFold applies the EqualFold predicate on the "disposition" field.
+func DispositionEqualFold(v string) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldEqualFold(FieldDisposition, v))
+}
+
+// DispositionContainsFold applies the ContainsFold predicate on the "disposition" field.
+func DispositionContainsFold(v string) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldContainsFold(FieldDisposition, v))
+}
+
+// InspectedAtEQ applies the EQ predicate on the "inspected_at" field.
+func InspectedAtEQ(v time.Time) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldEQ(FieldInspectedAt, v))
+}
+
+// InspectedAtNEQ applies the NEQ predicate on the "inspected_at" field.
+func InspectedAtNEQ(v time.Time) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldNEQ(FieldInspectedAt, v))
+}
+
+// InspectedAtIn applies the In predicate on the "inspected_at" field.
+func InspectedAtIn(vs ...time.Time) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldIn(FieldInspectedAt, vs...))
+}
+
+// InspectedAtNotIn applies the NotIn predicate on the "inspected_at" field.
+func InspectedAtNotIn(vs ...time.Time) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldNotIn(FieldInspectedAt, vs...))
+}
+
+// InspectedAtGT applies the GT predicate on the "inspected_at" field.
+func InspectedAtGT(v time.Time) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldGT(FieldInspectedAt, v))
+}
+
+// InspectedAtGTE applies the GTE predicate on the "inspected_at" field.
+func InspectedAtGTE(v time.Time) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldGTE(FieldInspectedAt, v))
+}
+
+// InspectedAtLT applies the LT predicate on the "inspected_at" field.
+func InspectedAtLT(v time.Time) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldLT(FieldInspectedAt, v))
+}
+
+// InspectedAtLTE applies the LTE predicate on the "inspected_at" field.
+func InspectedAtLTE(v time.Time) predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldLTE(FieldInspectedAt, v))
+}
+
+// InspectedAtIsNil applies the IsNil predicate on the "inspected_at" field.
+func InspectedAtIsNil() predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldIsNull(FieldInspectedAt))
+}
+
+// InspectedAtNotNil applies the NotNil predicate on the "inspected_at" field.
+func InspectedAtNotNil() predicate.OrderLine {
+	return predicate.OrderLine(sql.FieldNotNull(FieldInspectedAt))
+}
+
 // HasOrder applies the HasEdge predicate on the "order" edge.
 func HasOrder() predicate.OrderLine {
 	return predicate.OrderLine(func(s *sql.Selector) {
@@ -190,6 +407,29 @@ func HasPickTasksWith(preds ...predicate.PickTask) predicate.OrderLine {
 	})
 }
 
+// HasReceipts applies the HasEdge predicate on the "receipts" edge.
+func HasReceipts() predicate.OrderLine {
+	return predicate.OrderLine(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReceiptsTable, ReceiptsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReceiptsWith applies the HasEdge predicate on the "receipts" edge with a given conditions (other predicates).
+func HasReceiptsWith(preds ...predicate.Receipt) predicate.OrderLine {
+	return predicate.OrderLine(func(s *sql.Selector) {
+		step := newReceiptsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.OrderLine) predicate.OrderLine {
 	return predicate.OrderLine(sql.AndPredicates(predicates...))
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -14,6 +15,7 @@ import (
 	"github.com/mxV03/wms/ent/order"
 	"github.com/mxV03/wms/ent/orderline"
 	"github.com/mxV03/wms/ent/picktask"
+	"github.com/mxV03/wms/ent/receipt"
 )
 
 // OrderLineCreate is the builder for creating a OrderLine entity.
@@ -29,6 +31,48 @@ func (_c *OrderLineCreate) SetQuantity(v int) *OrderLineCreate {
 	return _c
 }
 
+// SetCondition sets the "condition" field.
+func (_c *OrderLineCreate) SetCondition(v string) *OrderLineCreate {
+	_c.mutation.SetCondition(v)
+	return _c
+}
+
+// SetNillableCondition sets the "condition" field if the given value is not nil.
+func (_c *OrderLineCreate) SetNillableCondition(v *string) *OrderLineCreate {
+	if v != nil {
+		_c.SetCondition(*v)
+	}
+	return _c
+}
+
+// SetDisposition sets the "disposition" field.
+func (_c *OrderLineCreate) SetDisposition(v string) *OrderLineCreate {
+	_c.mutation.SetDisposition(v)
+	return _c
+}
+
+// SetNillableDisposition sets the "disposition" field if the given value is not nil.
+func (_c *OrderLineCreate) SetNillableDisposition(v *string) *OrderLineCreate {
+	if v != nil {
+		_c.SetDisposition(*v)
+	}
+	return _c
+}
+
+// SetInspectedAt sets the "inspected_at" field.
+func (_c *OrderLineCreate) SetInspectedAt(v time.Time) *OrderLineCreate {
+	_c.mutation.SetInspectedAt(v)
+	return _c
+}
+
+// SetNillableInspectedAt sets the "inspected_at" field if the given value is not nil.
+func (_c *OrderLineCreate) SetNillableInspectedAt(v *time.Time) *OrderLineCreate {
+	if v != nil {
+		_c.SetInspectedAt(*v)
+	}
+	return _c
+}
+
 // SetOrderID sets the "order" edge to the Order entity by ID.
 func (_c *OrderLineCreate) SetOrderID(id int) *OrderLineCreate {
 	_c.mutation.SetOrderID(id)
@@ -77,6 +121,21 @@ func (_c *OrderLineCreate) AddPickTasks(v ...*PickTask) *OrderLineCreate {
 	return _c.AddPickTaskIDs(ids...)
 }
 
+// AddReceiptIDs adds the "receipts" edge to the Receipt entity by IDs.
+func (_c *OrderLineCreate) AddReceiptIDs(ids ...int) *OrderLineCreate {
+	_c.mutation.AddReceiptIDs(ids...)
+	return _c
+}
+
+// AddReceipts adds the "receipts" edges to the Receipt entity.
+func (_c *OrderLineCreate) AddReceipts(v ...*Receipt) *OrderLineCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReceiptIDs(ids...)
+}
+
 // Mutation returns the OrderLineMutation object of the builder.
 func (_c *OrderLineCreate) Mutation() *OrderLineMutation {
 	return _c.mutation
@@ -158,6 +217,18 @@ func (_c *OrderLineCreate) createSpec() (*OrderLine, *sqlgraph.CreateSpec) {
 		_spec.SetField(orderline.FieldQuantity, field.TypeInt, value)
 		_node.Quantity = value
 	}
+	if value, ok := _c.mutation.Condition(); ok {
+		_spec.SetField(orderline.FieldCondition, field.TypeString, value)
+		_node.Condition = value
+	}
+	if value, ok := _c.mutation.Disposition(); ok {
+		_spec.SetField(orderline.FieldDisposition, field.TypeString, value)
+		_node.Disposition = value
+	}
+	if value, ok := _c.mutation.InspectedAt(); ok {
+		_spec.SetField(orderline.FieldInspectedAt, field.TypeTime, value)
+		_node.InspectedAt = &value
+	}
 	if nodes := _c.mutation.OrderIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -225,6 +296,22 @@ func (_c *OrderLineCreate) createSpec() (*OrderLine, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ReceiptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   orderline.ReceiptsTable,
+			Columns: []string{orderline.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
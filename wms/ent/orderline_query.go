@@ -18,6 +18,7 @@ import (
 	"github.com/mxV03/wms/ent/orderline"
 	"github.com/mxV03/wms/ent/picktask"
 	"github.com/mxV03/wms/ent/predicate"
+	"github.com/mxV03/wms/ent/receipt"
 )
 
 // OrderLineQuery is the builder for querying OrderLine entities.
@@ -31,6 +32,7 @@ type OrderLineQuery struct {
 	withItem      *ItemQuery
 	withLocation  *LocationQuery
 	withPickTasks *PickTaskQuery
+	withReceipts  *ReceiptQuery
 	withFKs       bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -156,6 +158,28 @@ func (_q *OrderLineQuery) QueryPickTasks() *PickTaskQuery {
 	return query
 }
 
+// QueryReceipts chains the current query on the "receipts" edge.
+func (_q *OrderLineQuery) QueryReceipts() *ReceiptQuery {
+	query := (&ReceiptClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderline.Table, orderline.FieldID, selector),
+			sqlgraph.To(receipt.Table, receipt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, orderline.ReceiptsTable, orderline.ReceiptsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first OrderLine entity from the query.
 // Returns a *NotFoundError when no OrderLine was found.
 func (_q *OrderLineQuery) First(ctx context.Context) (*OrderLine, error) {
@@ -352,6 +376,7 @@ func (_q *OrderLineQuery) Clone() *OrderLineQuery {
 		withItem:      _q.withItem.Clone(),
 		withLocation:  _q.withLocation.Clone(),
 		withPickTasks: _q.withPickTasks.Clone(),
+		withReceipts:  _q.withReceipts.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -402,6 +427,17 @@ func (_q *OrderLineQuery) WithPickTasks(opts ...func(*PickTaskQuery)) *OrderLine
 	return _q
 }
 
+// WithReceipts tells the query-builder to eager-load the nodes that are connected to
+// the "receipts" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OrderLineQuery) WithReceipts(opts ...func(*ReceiptQuery)) *OrderLineQuery {
+	query := (&ReceiptClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReceipts = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -481,11 +517,12 @@ func (_q *OrderLineQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Or
 		nodes       = []*OrderLine{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [5]bool{
 			_q.withOrder != nil,
 			_q.withItem != nil,
 			_q.withLocation != nil,
 			_q.withPickTasks != nil,
+			_q.withReceipts != nil,
 		}
 	)
 	if _q.withOrder != nil || _q.withItem != nil || _q.withLocation != nil {
@@ -537,6 +574,13 @@ func (_q *OrderLineQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Or
 			return nil, err
 		}
 	}
+	if query := _q.withReceipts; query != nil {
+		if err := _q.loadReceipts(ctx, query, nodes,
+			func(n *OrderLine) { n.Edges.Receipts = []*Receipt{} },
+			func(n *OrderLine, e *Receipt) { n.Edges.Receipts = append(n.Edges.Receipts, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -667,6 +711,37 @@ func (_q *OrderLineQuery) loadPickTasks(ctx context.Context, query *PickTaskQuer
 	}
 	return nil
 }
+func (_q *OrderLineQuery) loadReceipts(ctx context.Context, query *ReceiptQuery, nodes []*OrderLine, init func(*OrderLine), assign func(*OrderLine, *Receipt)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*OrderLine)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Receipt(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(orderline.ReceiptsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.order_line_receipts
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "order_line_receipts" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "order_line_receipts" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *OrderLineQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -16,6 +17,7 @@ import (
 	"github.com/mxV03/wms/ent/orderline"
 	"github.com/mxV03/wms/ent/picktask"
 	"github.com/mxV03/wms/ent/predicate"
+	"github.com/mxV03/wms/ent/receipt"
 )
 
 // OrderLineUpdate is the builder for updating OrderLine entities.
@@ -52,6 +54,66 @@ func (_u *OrderLineUpdate) AddQuantity(v int) *OrderLineUpdate {
 	return _u
 }
 
+// SetCondition sets the "condition" field.
+func (_u *OrderLineUpdate) SetCondition(v string) *OrderLineUpdate {
+	_u.mutation.SetCondition(v)
+	return _u
+}
+
+// SetNillableCondition sets the "condition" field if the given value is not nil.
+func (_u *OrderLineUpdate) SetNillableCondition(v *string) *OrderLineUpdate {
+	if v != nil {
+		_u.SetCondition(*v)
+	}
+	return _u
+}
+
+// ClearCondition clears the value of the "condition" field.
+func (_u *OrderLineUpdate) ClearCondition() *OrderLineUpdate {
+	_u.mutation.ClearCondition()
+	return _u
+}
+
+// SetDisposition sets the "disposition" field.
+func (_u *OrderLineUpdate) SetDisposition(v string) *OrderLineUpdate {
+	_u.mutation.SetDisposition(v)
+	return _u
+}
+
+// SetNillableDisposition sets the "disposition" field if the given value is not nil.
+func (_u *OrderLineUpdate) SetNillableDisposition(v *string) *OrderLineUpdate {
+	if v != nil {
+		_u.SetDisposition(*v)
+	}
+	return _u
+}
+
+// ClearDisposition clears the value of the "disposition" field.
+func (_u *OrderLineUpdate) ClearDisposition() *OrderLineUpdate {
+	_u.mutation.ClearDisposition()
+	return _u
+}
+
+// SetInspectedAt sets the "inspected_at" field.
+func (_u *OrderLineUpdate) SetInspectedAt(v time.Time) *OrderLineUpdate {
+	_u.mutation.SetInspectedAt(v)
+	return _u
+}
+
+// SetNillableInspectedAt sets the "inspected_at" field if the given value is not nil.
+func (_u *OrderLineUpdate) SetNillableInspectedAt(v *time.Time) *OrderLineUpdate {
+	if v != nil {
+		_u.SetInspectedAt(*v)
+	}
+	return _u
+}
+
+// ClearInspectedAt clears the value of the "inspected_at" field.
+func (_u *OrderLineUpdate) ClearInspectedAt() *OrderLineUpdate {
+	_u.mutation.ClearInspectedAt()
+	return _u
+}
+
 // SetOrderID sets the "order" edge to the Order entity by ID.
 func (_u *OrderLineUpdate) SetOrderID(id int) *OrderLineUpdate {
 	_u.mutation.SetOrderID(id)
@@ -100,6 +162,21 @@ func (_u *OrderLineUpdate) AddPickTasks(v ...*PickTask) *OrderLineUpdate {
 	return _u.AddPickTaskIDs(ids...)
 }
 
+// AddReceiptIDs adds the "receipts" edge to the Receipt entity by IDs.
+func (_u *OrderLineUpdate) AddReceiptIDs(ids ...int) *OrderLineUpdate {
+	_u.mutation.AddReceiptIDs(ids...)
+	return _u
+}
+
+// AddReceipts adds the "receipts" edges to the Receipt entity.
+func (_u *OrderLineUpdate) AddReceipts(v ...*Receipt) *OrderLineUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReceiptIDs(ids...)
+}
+
 // Mutation returns the OrderLineMutation object of the builder.
 func (_u *OrderLineUpdate) Mutation() *OrderLineMutation {
 	return _u.mutation
@@ -144,6 +221,27 @@ func (_u *OrderLineUpdate) RemovePickTasks(v ...*PickTask) *OrderLineUpdate {
 	return _u.RemovePickTaskIDs(ids...)
 }
 
+// ClearReceipts clears all "receipts" edges to the Receipt entity.
+func (_u *OrderLineUpdate) ClearReceipts() *OrderLineUpdate {
+	_u.mutation.ClearReceipts()
+	return _u
+}
+
+// RemoveReceiptIDs removes the "receipts" edge to Receipt entities by IDs.
+func (_u *OrderLineUpdate) RemoveReceiptIDs(ids ...int) *OrderLineUpdate {
+	_u.mutation.RemoveReceiptIDs(ids...)
+	return _u
+}
+
+// RemoveReceipts removes "receipts" edges to Receipt entities.
+func (_u *OrderLineUpdate) RemoveReceipts(v ...*Receipt) *OrderLineUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReceiptIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *OrderLineUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -208,6 +306,24 @@ func (_u *OrderLineUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedQuantity(); ok {
 		_spec.AddField(orderline.FieldQuantity, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Condition(); ok {
+		_spec.SetField(orderline.FieldCondition, field.TypeString, value)
+	}
+	if _u.mutation.ConditionCleared() {
+		_spec.ClearField(orderline.FieldCondition, field.TypeString)
+	}
+	if value, ok := _u.mutation.Disposition(); ok {
+		_spec.SetField(orderline.FieldDisposition, field.TypeString, value)
+	}
+	if _u.mutation.DispositionCleared() {
+		_spec.ClearField(orderline.FieldDisposition, field.TypeString)
+	}
+	if value, ok := _u.mutation.InspectedAt(); ok {
+		_spec.SetField(orderline.FieldInspectedAt, field.TypeTime, value)
+	}
+	if _u.mutation.InspectedAtCleared() {
+		_spec.ClearField(orderline.FieldInspectedAt, field.TypeTime)
+	}
 	if _u.mutation.OrderCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -340,6 +456,51 @@ func (_u *OrderLineUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ReceiptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   orderline.ReceiptsTable,
+			Columns: []string{orderline.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receipt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReceiptsIDs(); len(nodes) > 0 && !_u.mutation.ReceiptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   orderline.ReceiptsTable,
+			Columns: []string{orderline.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReceiptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   orderline.ReceiptsTable,
+			Columns: []string{orderline.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{orderline.Label}
@@ -381,6 +542,66 @@ func (_u *OrderLineUpdateOne) AddQuantity(v int) *OrderLineUpdateOne {
 	return _u
 }
 
+// SetCondition sets the "condition" field.
+func (_u *OrderLineUpdateOne) SetCondition(v string) *OrderLineUpdateOne {
+	_u.mutation.SetCondition(v)
+	return _u
+}
+
+// SetNillableCondition sets the "condition" field if the given value is not nil.
+func (_u *OrderLineUpdateOne) SetNillableCondition(v *string) *OrderLineUpdateOne {
+	if v != nil {
+		_u.SetCondition(*v)
+	}
+	return _u
+}
+
+// ClearCondition clears the value of the "condition" field.
+func (_u *OrderLineUpdateOne) ClearCondition() *OrderLineUpdateOne {
+	_u.mutation.ClearCondition()
+	return _u
+}
+
+// SetDisposition sets the "disposition" field.
+func (_u *OrderLineUpdateOne) SetDisposition(v string) *OrderLineUpdateOne {
+	_u.mutation.SetDisposition(v)
+	return _u
+}
+
+// SetNillableDisposition sets the "disposition" field if the given value is not nil.
+func (_u *OrderLineUpdateOne) SetNillableDisposition(v *string) *OrderLineUpdateOne {
+	if v != nil {
+		_u.SetDisposition(*v)
+	}
+	return _u
+}
+
+// ClearDisposition clears the value of the "disposition" field.
+func (_u *OrderLineUpdateOne) ClearDisposition() *OrderLineUpdateOne {
+	_u.mutation.ClearDisposition()
+	return _u
+}
+
+// SetInspectedAt sets the "inspected_at" field.
+func (_u *OrderLineUpdateOne) SetInspectedAt(v time.Time) *OrderLineUpdateOne {
+	_u.mutation.SetInspectedAt(v)
+	return _u
+}
+
+// SetNillableInspectedAt sets the "inspected_at" field if the given value is not nil.
+func (_u *OrderLineUpdateOne) SetNillableInspectedAt(v *time.Time) *OrderLineUpdateOne {
+	if v != nil {
+		_u.SetInspectedAt(*v)
+	}
+	return _u
+}
+
+// ClearInspectedAt clears the value of the "inspected_at" field.
+func (_u *OrderLineUpdateOne) ClearInspectedAt() *OrderLineUpdateOne {
+	_u.mutation.ClearInspectedAt()
+	return _u
+}
+
 // SetOrderID sets the "order" edge to the Order entity by ID.
 func (_u *OrderLineUpdateOne) SetOrderID(id int) *OrderLineUpdateOne {
 	_u.mutation.SetOrderID(id)
@@ -429,6 +650,21 @@ func (_u *OrderLineUpdateOne) AddPickTasks(v ...*PickTask) *OrderLineUpdateOne {
 	return _u.AddPickTaskIDs(ids...)
 }
 
+// AddReceiptIDs adds the "receipts" edge to the Receipt entity by IDs.
+func (_u *OrderLineUpdateOne) AddReceiptIDs(ids ...int) *OrderLineUpdateOne {
+	_u.mutation.AddReceiptIDs(ids...)
+	return _u
+}
+
+// AddReceipts adds the "receipts" edges to the Receipt entity.
+func (_u *OrderLineUpdateOne) AddReceipts(v ...*Receipt) *OrderLineUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReceiptIDs(ids...)
+}
+
 // Mutation returns the OrderLineMutation object of the builder.
 func (_u *OrderLineUpdateOne) Mutation() *OrderLineMutation {
 	return _u.mutation
@@ -473,6 +709,27 @@ func (_u *OrderLineUpdateOne) RemovePickTasks(v ...*PickTask) *OrderLineUpdateOn
 	return _u.RemovePickTaskIDs(ids...)
 }
 
+// ClearReceipts clears all "receipts" edges to the Receipt entity.
+func (_u *OrderLineUpdateOne) ClearReceipts() *OrderLineUpdateOne {
+	_u.mutation.ClearReceipts()
+	return _u
+}
+
+// RemoveReceiptIDs removes the "receipts" edge to Receipt entities by IDs.
+func (_u *OrderLineUpdateOne) RemoveReceiptIDs(ids ...int) *OrderLineUpdateOne {
+	_u.mutation.RemoveReceiptIDs(ids...)
+	return _u
+}
+
+// RemoveReceipts removes "receipts" edges to Receipt entities.
+func (_u *OrderLineUpdateOne) RemoveReceipts(v ...*Receipt) *OrderLineUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReceiptIDs(ids...)
+}
+
 // Where appends a list predicates to the OrderLineUpdate builder.
 func (_u *OrderLineUpdateOne) Where(ps ...predicate.OrderLine) *OrderLineUpdateOne {
 	_u.mutation.Where(ps...)
@@ -567,6 +824,24 @@ func (_u *OrderLineUpdateOne) sqlSave(ctx context.Context) (_node *OrderLine, er
 	if value, ok := _u.mutation.AddedQuantity(); ok {
 		_spec.AddField(orderline.FieldQuantity, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Condition(); ok {
+		_spec.SetField(orderline.FieldCondition, field.TypeString, value)
+	}
+	if _u.mutation.ConditionCleared() {
+		_spec.ClearField(orderline.FieldCondition, field.TypeString)
+	}
+	if value, ok := _u.mutation.Disposition(); ok {
+		_spec.SetField(orderline.FieldDisposition, field.TypeString, value)
+	}
+	if _u.mutation.DispositionCleared() {
+		_spec.ClearField(orderline.FieldDisposition, field.TypeString)
+	}
+	if value, ok := _u.mutation.InspectedAt(); ok {
+		_spec.SetField(orderline.FieldInspectedAt, field.TypeTime, value)
+	}
+	if _u.mutation.InspectedAtCleared() {
+		_spec.ClearField(orderline.FieldInspectedAt, field.TypeTime)
+	}
 	if _u.mutation.OrderCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -699,6 +974,51 @@ func (_u *OrderLineUpdateOne) sqlSave(ctx context.Context) (_node *OrderLine, er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ReceiptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   orderline.ReceiptsTable,
+			Columns: []string{orderline.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receipt.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReceiptsIDs(); len(nodes) > 0 && !_u.mutation.ReceiptsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   orderline.ReceiptsTable,
+			Columns: []string{orderline.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReceiptsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   orderline.ReceiptsTable,
+			Columns: []string{orderline.ReceiptsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(receipt.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &OrderLine{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -18,8 +18,14 @@ type PickList struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID int `json:"id,omitempty"`
+	// Number holds the value of the "number" field.
+	Number *string `json:"number,omitempty"`
 	// Status holds the value of the "status" field.
 	Status string `json:"status,omitempty"`
+	// AssignedTo holds the value of the "assigned_to" field.
+	AssignedTo string `json:"assigned_to,omitempty"`
+	// AssignedAt holds the value of the "assigned_at" field.
+	AssignedAt *time.Time `json:"assigned_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// StartedAt holds the value of the "started_at" field.
@@ -71,9 +77,9 @@ func (*PickList) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case picklist.FieldID:
 			values[i] = new(sql.NullInt64)
-		case picklist.FieldStatus:
+		case picklist.FieldNumber, picklist.FieldStatus, picklist.FieldAssignedTo:
 			values[i] = new(sql.NullString)
-		case picklist.FieldCreatedAt, picklist.FieldStartedAt, picklist.FieldDoneAt:
+		case picklist.FieldAssignedAt, picklist.FieldCreatedAt, picklist.FieldStartedAt, picklist.FieldDoneAt:
 			values[i] = new(sql.NullTime)
 		case picklist.ForeignKeys[0]: // order_picklist
 			values[i] = new(sql.NullInt64)
@@ -98,12 +104,32 @@ func (_m *PickList) assignValues(columns []string, values []any) error {
 				return fmt.Errorf("unexpected type %T for field id", value)
 			}
 			_m.ID = int(value.Int64)
+		case picklist.FieldNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field number", values[i])
+			} else if value.Valid {
+				_m.Number = new(string)
+				*_m.Number = value.String
+			}
 		case picklist.FieldStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
 			} else if value.Valid {
 				_m.Status = value.String
 			}
+		case picklist.FieldAssignedTo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field assigned_to", values[i])
+			} else if value.Valid {
+				_m.AssignedTo = value.String
+			}
+		case picklist.FieldAssignedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field assigned_at", values[i])
+			} else if value.Valid {
+				_m.AssignedAt = new(time.Time)
+				*_m.AssignedAt = value.Time
+			}
 		case picklist.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -177,9 +203,22 @@ func (_m *PickList) String() string {
 	var builder strings.Builder
 	builder.WriteString("PickList(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.Number; v != nil {
+		builder.WriteString("number=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(_m.Status)
 	builder.WriteString(", ")
+	builder.WriteString("assigned_to=")
+	builder.WriteString(_m.AssignedTo)
+	builder.WriteString(", ")
+	if v := _m.AssignedAt; v != nil {
+		builder.WriteString("assigned_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -22,6 +22,20 @@ type PickListCreate struct {
 	hooks    []Hook
 }
 
+// SetNumber sets the "number" field.
+func (_c *PickListCreate) SetNumber(v string) *PickListCreate {
+	_c.mutation.SetNumber(v)
+	return _c
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (_c *PickListCreate) SetNillableNumber(v *string) *PickListCreate {
+	if v != nil {
+		_c.SetNumber(*v)
+	}
+	return _c
+}
+
 // SetStatus sets the "status" field.
 func (_c *PickListCreate) SetStatus(v string) *PickListCreate {
 	_c.mutation.SetStatus(v)
@@ -36,6 +50,34 @@ func (_c *PickListCreate) SetNillableStatus(v *string) *PickListCreate {
 	return _c
 }
 
+// SetAssignedTo sets the "assigned_to" field.
+func (_c *PickListCreate) SetAssignedTo(v string) *PickListCreate {
+	_c.mutation.SetAssignedTo(v)
+	return _c
+}
+
+// SetNillableAssignedTo sets the "assigned_to" field if the given value is not nil.
+func (_c *PickListCreate) SetNillableAssignedTo(v *string) *PickListCreate {
+	if v != nil {
+		_c.SetAssignedTo(*v)
+	}
+	return _c
+}
+
+// SetAssignedAt sets the "assigned_at" field.
+func (_c *PickListCreate) SetAssignedAt(v time.Time) *PickListCreate {
+	_c.mutation.SetAssignedAt(v)
+	return _c
+}
+
+// SetNillableAssignedAt sets the "assigned_at" field if the given value is not nil.
+func (_c *PickListCreate) SetNillableAssignedAt(v *time.Time) *PickListCreate {
+	if v != nil {
+		_c.SetAssignedAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *PickListCreate) SetCreatedAt(v time.Time) *PickListCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -143,6 +185,10 @@ func (_c *PickListCreate) defaults() {
 		v := picklist.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.AssignedTo(); !ok {
+		v := picklist.DefaultAssignedTo
+		_c.mutation.SetAssignedTo(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := picklist.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -186,10 +232,22 @@ func (_c *PickListCreate) createSpec() (*PickList, *sqlgraph.CreateSpec) {
 		_node = &PickList{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(picklist.Table, sqlgraph.NewFieldSpec(picklist.FieldID, field.TypeInt))
 	)
+	if value, ok := _c.mutation.Number(); ok {
+		_spec.SetField(picklist.FieldNumber, field.TypeString, value)
+		_node.Number = &value
+	}
 	if value, ok := _c.mutation.Status(); ok {
 		_spec.SetField(picklist.FieldStatus, field.TypeString, value)
 		_node.Status = value
 	}
+	if value, ok := _c.mutation.AssignedTo(); ok {
+		_spec.SetField(picklist.FieldAssignedTo, field.TypeString, value)
+		_node.AssignedTo = value
+	}
+	if value, ok := _c.mutation.AssignedAt(); ok {
+		_spec.SetField(picklist.FieldAssignedAt, field.TypeTime, value)
+		_node.AssignedAt = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(picklist.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -336,12 +336,12 @@ func (_q *PickListQuery) WithTasks(opts ...func(*PickTaskQuery)) *PickListQuery
 // Example:
 //
 //	var v []struct {
-//		Status string `json:"status,omitempty"`
+//		Number string `json:"number,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.PickList.Query().
-//		GroupBy(picklist.FieldStatus).
+//		GroupBy(picklist.FieldNumber).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *PickListQuery) GroupBy(field string, fields ...string) *PickListGroupBy {
@@ -359,11 +359,11 @@ func (_q *PickListQuery) GroupBy(field string, fields ...string) *PickListGroupB
 // Example:
 //
 //	var v []struct {
-//		Status string `json:"status,omitempty"`
+//		Number string `json:"number,omitempty"`
 //	}
 //
 //	client.PickList.Query().
-//		Select(picklist.FieldStatus).
+//		Select(picklist.FieldNumber).
 //		Scan(ctx, &v)
 func (_q *PickListQuery) Select(fields ...string) *PickListSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
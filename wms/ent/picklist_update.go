@@ -30,6 +30,26 @@ func (_u *PickListUpdate) Where(ps ...predicate.PickList) *PickListUpdate {
 	return _u
 }
 
+// SetNumber sets the "number" field.
+func (_u *PickListUpdate) SetNumber(v string) *PickListUpdate {
+	_u.mutation.SetNumber(v)
+	return _u
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (_u *PickListUpdate) SetNillableNumber(v *string) *PickListUpdate {
+	if v != nil {
+		_u.SetNumber(*v)
+	}
+	return _u
+}
+
+// ClearNumber clears the value of the "number" field.
+func (_u *PickListUpdate) ClearNumber() *PickListUpdate {
+	_u.mutation.ClearNumber()
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *PickListUpdate) SetStatus(v string) *PickListUpdate {
 	_u.mutation.SetStatus(v)
@@ -44,6 +64,46 @@ func (_u *PickListUpdate) SetNillableStatus(v *string) *PickListUpdate {
 	return _u
 }
 
+// SetAssignedTo sets the "assigned_to" field.
+func (_u *PickListUpdate) SetAssignedTo(v string) *PickListUpdate {
+	_u.mutation.SetAssignedTo(v)
+	return _u
+}
+
+// SetNillableAssignedTo sets the "assigned_to" field if the given value is not nil.
+func (_u *PickListUpdate) SetNillableAssignedTo(v *string) *PickListUpdate {
+	if v != nil {
+		_u.SetAssignedTo(*v)
+	}
+	return _u
+}
+
+// ClearAssignedTo clears the value of the "assigned_to" field.
+func (_u *PickListUpdate) ClearAssignedTo() *PickListUpdate {
+	_u.mutation.ClearAssignedTo()
+	return _u
+}
+
+// SetAssignedAt sets the "assigned_at" field.
+func (_u *PickListUpdate) SetAssignedAt(v time.Time) *PickListUpdate {
+	_u.mutation.SetAssignedAt(v)
+	return _u
+}
+
+// SetNillableAssignedAt sets the "assigned_at" field if the given value is not nil.
+func (_u *PickListUpdate) SetNillableAssignedAt(v *time.Time) *PickListUpdate {
+	if v != nil {
+		_u.SetAssignedAt(*v)
+	}
+	return _u
+}
+
+// ClearAssignedAt clears the value of the "assigned_at" field.
+func (_u *PickListUpdate) ClearAssignedAt() *PickListUpdate {
+	_u.mutation.ClearAssignedAt()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *PickListUpdate) SetCreatedAt(v time.Time) *PickListUpdate {
 	_u.mutation.SetCreatedAt(v)
@@ -203,9 +263,27 @@ func (_u *PickListUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
+	if value, ok := _u.mutation.Number(); ok {
+		_spec.SetField(picklist.FieldNumber, field.TypeString, value)
+	}
+	if _u.mutation.NumberCleared() {
+		_spec.ClearField(picklist.FieldNumber, field.TypeString)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(picklist.FieldStatus, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.AssignedTo(); ok {
+		_spec.SetField(picklist.FieldAssignedTo, field.TypeString, value)
+	}
+	if _u.mutation.AssignedToCleared() {
+		_spec.ClearField(picklist.FieldAssignedTo, field.TypeString)
+	}
+	if value, ok := _u.mutation.AssignedAt(); ok {
+		_spec.SetField(picklist.FieldAssignedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AssignedAtCleared() {
+		_spec.ClearField(picklist.FieldAssignedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(picklist.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -315,6 +393,26 @@ type PickListUpdateOne struct {
 	mutation *PickListMutation
 }
 
+// SetNumber sets the "number" field.
+func (_u *PickListUpdateOne) SetNumber(v string) *PickListUpdateOne {
+	_u.mutation.SetNumber(v)
+	return _u
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (_u *PickListUpdateOne) SetNillableNumber(v *string) *PickListUpdateOne {
+	if v != nil {
+		_u.SetNumber(*v)
+	}
+	return _u
+}
+
+// ClearNumber clears the value of the "number" field.
+func (_u *PickListUpdateOne) ClearNumber() *PickListUpdateOne {
+	_u.mutation.ClearNumber()
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *PickListUpdateOne) SetStatus(v string) *PickListUpdateOne {
 	_u.mutation.SetStatus(v)
@@ -329,6 +427,46 @@ func (_u *PickListUpdateOne) SetNillableStatus(v *string) *PickListUpdateOne {
 	return _u
 }
 
+// SetAssignedTo sets the "assigned_to" field.
+func (_u *PickListUpdateOne) SetAssignedTo(v string) *PickListUpdateOne {
+	_u.mutation.SetAssignedTo(v)
+	return _u
+}
+
+// SetNillableAssignedTo sets the "assigned_to" field if the given value is not nil.
+func (_u *PickListUpdateOne) SetNillableAssignedTo(v *string) *PickListUpdateOne {
+	if v != nil {
+		_u.SetAssignedTo(*v)
+	}
+	return _u
+}
+
+// ClearAssignedTo clears the value of the "assigned_to" field.
+func (_u *PickListUpdateOne) ClearAssignedTo() *PickListUpdateOne {
+	_u.mutation.ClearAssignedTo()
+	return _u
+}
+
+// SetAssignedAt sets the "assigned_at" field.
+func (_u *PickListUpdateOne) SetAssignedAt(v time.Time) *PickListUpdateOne {
+	_u.mutation.SetAssignedAt(v)
+	return _u
+}
+
+// SetNillableAssignedAt sets the "assigned_at" field if the given value is not nil.
+func (_u *PickListUpdateOne) SetNillableAssignedAt(v *time.Time) *PickListUpdateOne {
+	if v != nil {
+		_u.SetAssignedAt(*v)
+	}
+	return _u
+}
+
+// ClearAssignedAt clears the value of the "assigned_at" field.
+func (_u *PickListUpdateOne) ClearAssignedAt() *PickListUpdateOne {
+	_u.mutation.ClearAssignedAt()
+	return _u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_u *PickListUpdateOne) SetCreatedAt(v time.Time) *PickListUpdateOne {
 	_u.mutation.SetCreatedAt(v)
@@ -518,9 +656,27 @@ func (_u *PickListUpdateOne) sqlSave(ctx context.Context) (_node *PickList, err
 			}
 		}
 	}
+	if value, ok := _u.mutation.Number(); ok {
+		_spec.SetField(picklist.FieldNumber, field.TypeString, value)
+	}
+	if _u.mutation.NumberCleared() {
+		_spec.ClearField(picklist.FieldNumber, field.TypeString)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(picklist.FieldStatus, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.AssignedTo(); ok {
+		_spec.SetField(picklist.FieldAssignedTo, field.TypeString, value)
+	}
+	if _u.mutation.AssignedToCleared() {
+		_spec.ClearField(picklist.FieldAssignedTo, field.TypeString)
+	}
+	if value, ok := _u.mutation.AssignedAt(); ok {
+		_spec.SetField(picklist.FieldAssignedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AssignedAtCleared() {
+		_spec.ClearField(picklist.FieldAssignedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(picklist.FieldCreatedAt, field.TypeTime, value)
 	}
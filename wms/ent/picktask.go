@@ -21,12 +21,18 @@ type PickTask struct {
 	ID int `json:"id,omitempty"`
 	// Quantity holds the value of the "quantity" field.
 	Quantity int `json:"quantity,omitempty"`
+	// Picked holds the value of the "picked" field.
+	Picked int `json:"picked,omitempty"`
 	// Status holds the value of the "status" field.
 	Status string `json:"status,omitempty"`
 	// PickedAt holds the value of the "picked_at" field.
 	PickedAt *time.Time `json:"picked_at,omitempty"`
 	// BinID holds the value of the "bin_id" field.
 	BinID *int `json:"bin_id,omitempty"`
+	// AssignedTo holds the value of the "assigned_to" field.
+	AssignedTo string `json:"assigned_to,omitempty"`
+	// PickedBy holds the value of the "picked_by" field.
+	PickedBy string `json:"picked_by,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PickTaskQuery when eager-loading is set.
 	Edges                 PickTaskEdges `json:"edges"`
@@ -73,9 +79,9 @@ func (*PickTask) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case picktask.FieldID, picktask.FieldQuantity, picktask.FieldBinID:
+		case picktask.FieldID, picktask.FieldQuantity, picktask.FieldPicked, picktask.FieldBinID:
 			values[i] = new(sql.NullInt64)
-		case picktask.FieldStatus:
+		case picktask.FieldStatus, picktask.FieldAssignedTo, picktask.FieldPickedBy:
 			values[i] = new(sql.NullString)
 		case picktask.FieldPickedAt:
 			values[i] = new(sql.NullTime)
@@ -110,6 +116,12 @@ func (_m *PickTask) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Quantity = int(value.Int64)
 			}
+		case picktask.FieldPicked:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field picked", values[i])
+			} else if value.Valid {
+				_m.Picked = int(value.Int64)
+			}
 		case picktask.FieldStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
@@ -130,6 +142,18 @@ func (_m *PickTask) assignValues(columns []string, values []any) error {
 				_m.BinID = new(int)
 				*_m.BinID = int(value.Int64)
 			}
+		case picktask.FieldAssignedTo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field assigned_to", values[i])
+			} else if value.Valid {
+				_m.AssignedTo = value.String
+			}
+		case picktask.FieldPickedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field picked_by", values[i])
+			} else if value.Valid {
+				_m.PickedBy = value.String
+			}
 		case picktask.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field order_line_pick_tasks", value)
@@ -193,6 +217,9 @@ func (_m *PickTask) String() string {
 	builder.WriteString("quantity=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
 	builder.WriteString(", ")
+	builder.WriteString("picked=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Picked))
+	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(_m.Status)
 	builder.WriteString(", ")
@@ -205,6 +232,12 @@ func (_m *PickTask) String() string {
 		builder.WriteString("bin_id=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	builder.WriteString("assigned_to=")
+	builder.WriteString(_m.AssignedTo)
+	builder.WriteString(", ")
+	builder.WriteString("picked_by=")
+	builder.WriteString(_m.PickedBy)
 	builder.WriteByte(')')
 	return builder.String()
 }
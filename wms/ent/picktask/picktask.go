@@ -14,12 +14,18 @@ const (
 	FieldID = "id"
 	// FieldQuantity holds the string denoting the quantity field in the database.
 	FieldQuantity = "quantity"
+	// FieldPicked holds the string denoting the picked field in the database.
+	FieldPicked = "picked"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
 	// FieldPickedAt holds the string denoting the picked_at field in the database.
 	FieldPickedAt = "picked_at"
 	// FieldBinID holds the string denoting the bin_id field in the database.
 	FieldBinID = "bin_id"
+	// FieldAssignedTo holds the string denoting the assigned_to field in the database.
+	FieldAssignedTo = "assigned_to"
+	// FieldPickedBy holds the string denoting the picked_by field in the database.
+	FieldPickedBy = "picked_by"
 	// EdgePicklist holds the string denoting the picklist edge name in mutations.
 	EdgePicklist = "picklist"
 	// EdgeOrderLine holds the string denoting the order_line edge name in mutations.
@@ -46,9 +52,12 @@ const (
 var Columns = []string{
 	FieldID,
 	FieldQuantity,
+	FieldPicked,
 	FieldStatus,
 	FieldPickedAt,
 	FieldBinID,
+	FieldAssignedTo,
+	FieldPickedBy,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "pick_tasks"
@@ -76,8 +85,16 @@ func ValidColumn(column string) bool {
 var (
 	// QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
 	QuantityValidator func(int) error
+	// DefaultPicked holds the default value on creation for the "picked" field.
+	DefaultPicked int
+	// PickedValidator is a validator for the "picked" field. It is called by the builders before save.
+	PickedValidator func(int) error
 	// DefaultStatus holds the default value on creation for the "status" field.
 	DefaultStatus string
+	// DefaultAssignedTo holds the default value on creation for the "assigned_to" field.
+	DefaultAssignedTo string
+	// DefaultPickedBy holds the default value on creation for the "picked_by" field.
+	DefaultPickedBy string
 )
 
 // OrderOption defines the ordering options for the PickTask queries.
@@ -93,6 +110,11 @@ func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
 }
 
+// ByPicked orders the results by the picked field.
+func ByPicked(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPicked, opts...).ToFunc()
+}
+
 // ByStatus orders the results by the status field.
 func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
@@ -108,6 +130,16 @@ func ByBinID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBinID, opts...).ToFunc()
 }
 
+// ByAssignedTo orders the results by the assigned_to field.
+func ByAssignedTo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssignedTo, opts...).ToFunc()
+}
+
+// ByPickedBy orders the results by the picked_by field.
+func ByPickedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPickedBy, opts...).ToFunc()
+}
+
 // ByPicklistField orders the results by picklist field.
 func ByPicklistField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -60,6 +60,11 @@ func Quantity(v int) predicate.PickTask {
 	return predicate.PickTask(sql.FieldEQ(FieldQuantity, v))
 }
 
+// Picked applies equality check predicate on the "picked" field. It's identical to PickedEQ.
+func Picked(v int) predicate.PickTask {
+	return predicate.PickTask(sql.FieldEQ(FieldPicked, v))
+}
+
 // Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
 func Status(v string) predicate.PickTask {
 	return predicate.PickTask(sql.FieldEQ(FieldStatus, v))
@@ -75,6 +80,16 @@ func BinID(v int) predicate.PickTask {
 	return predicate.PickTask(sql.FieldEQ(FieldBinID, v))
 }
 
+// AssignedTo applies equality check predicate on the "assigned_to" field. It's identical to AssignedToEQ.
+func AssignedTo(v string) predicate.PickTask {
+	return predicate.PickTask(sql.FieldEQ(FieldAssignedTo, v))
+}
+
+// PickedBy applies equality check predicate on the "picked_by" field. It's identical to PickedByEQ.
+func PickedBy(v string) predicate.PickTask {
+	return predicate.PickTask(sql.FieldEQ(FieldPickedBy, v))
+}
+
 // QuantityEQ applies the EQ predicate on the "quantity" field.
 func QuantityEQ(v int) predicate.PickTask {
 	return predicate.PickTask(sql.FieldEQ(FieldQuantity, v))
@@ -115,6 +130,46 @@ func QuantityLTE(v int) predicate.PickTask {
 	return predicate.PickTask(sql.FieldLTE(FieldQuantity, v))
 }
 
+// PickedEQ applies the EQ predicate on the "picked" field.
+func PickedEQ(v int) predicate.PickTask {
+	return predicate.PickTask(sql.FieldEQ(FieldPicked, v))
+}
+
+// PickedNEQ applies the NEQ predicate on the "picked" field.
+func PickedNEQ(v int) predicate.PickTask {
+	return predicate.PickTask(sql.FieldNEQ(FieldPicked, v))
+}
+
+// PickedIn applies the In predicate on the "picked" field.
+func PickedIn(vs ...int) predicate.PickTask {
+	return predicate.PickTask(sql.FieldIn(FieldPicked, vs...))
+}
+
+// PickedNotIn applies the NotIn predicate on the "picked" field.
+func PickedNotIn(vs ...int) predicate.PickTask {
+	return predicate.PickTask(sql.FieldNotIn(FieldPicked, vs...))
+}
+
+// PickedGT applies the GT predicate on the "picked" field.
+func PickedGT(v int) predicate.PickTask {
+	return predicate.PickTask(sql.FieldGT(FieldPicked, v))
+}
+
+// PickedGTE applies the GTE predicate on the "picked" field.
+func PickedGTE(v int) predicate.PickTask {
+	return predicate.PickTask(sql.FieldGTE(FieldPicked, v))
+}
+
+// PickedLT applies the LT predicate on the "picked" field.
+func PickedLT(v int) predicate.PickTask {
+	return predicate.PickTask(sql.FieldLT(FieldPicked, v))
+}
+
+// PickedLTE applies the LTE predicate on the "picked" field.
+func PickedLTE(v int) predicate.PickTask {
+	return predicate.PickTask(sql.FieldLTE(FieldPicked, v))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v string) predicate.PickTask {
 	return predicate.PickTask(sql.FieldEQ(FieldStatus, v))
@@ -280,6 +335,156 @@ func BinIDNotNil() predicate.PickTask {
 	return predicate.PickTask(sql.FieldNotNull(FieldBinID))
 }
 
+// AssignedToEQ applies the EQ predicate on the "assigned_to" field.
+func AssignedToEQ(v string) predicate.PickTask {
+	return predicate.PickTask(sql.FieldEQ(FieldAssignedTo, v))
+}
+
+// AssignedToNEQ applies the NEQ predicate on the "assigned_to" field.
+func AssignedToNEQ(v string) predicate.PickTask {
+	return predicate.PickTask(sql.FieldNEQ(FieldAssignedTo, v))
+}
+
+// AssignedToIn applies the In predicate on the "assigned_to" field.
+func AssignedToIn(vs ...string) predicate.PickTask {
+	return predicate.PickTask(sql.FieldIn(FieldAssignedTo, vs...))
+}
+
+// AssignedToNotIn applies the NotIn predicate on the "assigned_to" field.
+func AssignedToNotIn(vs ...string) predicate.PickTask {
+	return predicate.PickTask(sql.FieldNotIn(FieldAssignedTo, vs...))
+}
+
+// AssignedToGT applies the GT predicate on the "assigned_to" field.
+func AssignedToGT(v string) predicate.PickTask {
+	return predicate.PickTask(sql.FieldGT(FieldAssignedTo, v))
+}
+
+// AssignedToGTE applies the GTE predicate on the "assigned_to" field.
+func AssignedToGTE(v string) predicate.PickTask {
+	return predicate.PickTask(sql.FieldGTE(FieldAssignedTo, v))
+}
+
+// AssignedToLT applies the LT predicate on the "assigned_to" field.
+func AssignedToLT(v string) predicate.PickTask {
+	return predicate.PickTask(sql.FieldLT(FieldAssignedTo, v))
+}
+
+// AssignedToLTE applies the LTE predicate on the "assigned_to" field.
+func AssignedToLTE(v string) predicate.PickTask {
+	return predicate.PickTask(sql.FieldLTE(FieldAssignedTo, v))
+}
+
+// AssignedToContains applies the Contains predicate on the "assigned_to" field.
+func AssignedToContains(v string) predicate.PickTask {
+	return predicate.PickTask(sql.FieldContains(FieldAssignedTo, v))
+}
+
+// AssignedToHasPrefix applies the HasPrefix predicate on the "assigned_to" field.
+func AssignedToHasPrefix(v string) predicate.PickTask {
+	return predicate.PickTask(sql.FieldHasPrefix(FieldAssignedTo, v))
+}
+
+// AssignedToHasSuffix applies the HasSuffix predicate on the "assigned_to" field.
+func AssignedToHasSuffix(v string) predicate.PickTask {
+	return predicate.PickTask(sql.FieldHasSuffix(FieldAssignedTo, v))
+}
+
+// AssignedToIsNil applies the IsNil predicate on the "assigned_to" field.
+func AssignedToIsNil() predicate.PickTask {
+	return predicate.PickTask(sql.FieldIsNull(FieldAssignedTo))
+}
+
+// AssignedToNotNil applies the NotNil predicate on the "assigned_to" field.
+func AssignedToNotNil() predicate.PickTask {
+	return predicate.PickTask(sql.FieldNotNull(FieldAssignedTo))
+}
+
+// AssignedToEqualFold applies the EqualFold predicate on the "assigned_to" field.
+func AssignedToEqualFold(v string) predicate.PickTask {
+	return predicate.PickTask(sql.FieldEqualFold(FieldAssignedTo, v))
+}
+
+// AssignedToContainsFold applies the ContainsFold predicate on the "assigned_to" field.
+func AssignedToContainsFold(v string) predicate.PickTask {
+	return predicate.PickTask(sql.FieldContainsFold(FieldAssignedTo, v))
+}
+
+// PickedByEQ applies the EQ predicate on the "picked_by" field.
+func PickedByEQ(v string) predicate.PickTask {
+	return predicate.PickTask(sql.FieldEQ(FieldPickedBy, v))
+}
+
+// PickedByNEQ applies the NEQ predicate on the "picked_by" field.
+func PickedByNEQ(v string) predicate.PickTask {
+	return predicate.PickTask(sql.FieldNEQ(FieldPickedBy, v))
+}
+
+// PickedByIn applies the In predicate on the "picked_by" field.
+func PickedByIn(vs ...string) predicate.PickTask {
+	return predicate.PickTask(sql.FieldIn(FieldPickedBy, vs...))
+}
+
+// PickedByNotIn applies the NotIn predicate on the "picked_by" field.
+func PickedByNotIn(vs ...string) predicate.PickTask {
+	return predicate.PickTask(sql.FieldNotIn(FieldPickedBy, vs...))
+}
+
+// PickedByGT applies the GT predicate on the "picked_by" field.
+func PickedByGT(v string) predicate.PickTask {
+	return predicate.PickTask(sql.FieldGT(FieldPickedBy, v))
+}
+
+// PickedByGTE applies the GTE predicate on the "picked_by" field.
+func PickedByGTE(v string) predicate.PickTask {
+	return predicate.PickTask(sql.FieldGTE(FieldPickedBy, v))
+}
+
+// PickedByLT applies the LT predicate on the "picked_by" field.
+func PickedByLT(v string) predicate.PickTask {
+	return predicate.PickTask(sql.FieldLT(FieldPickedBy, v))
+}
+
+// PickedByLTE applies the LTE predicate on the "picked_by" field.
+func PickedByLTE(v string) predicate.PickTask {
+	return predicate.PickTask(sql.FieldLTE(FieldPickedBy, v))
+}
+
+// PickedByContains applies the Contains predicate on the "picked_by" field.
+func PickedByContains(v string) predicate.PickTask {
+	return predicate.PickTask(sql.FieldContains(FieldPickedBy, v))
+}
+
+// PickedByHasPrefix applies the HasPrefix predicate on the "picked_by" field.
+func PickedByHasPrefix(v string) predicate.PickTask {
+	return predicate.PickTask(sql.FieldHasPrefix(FieldPickedBy, v))
+}
+
+// PickedByHasSuffix applies the HasSuffix predicate on the "picked_by" field.
+func PickedByHasSuffix(v string) predicate.PickTask {
+	return predicate.PickTask(sql.FieldHasSuffix(FieldPickedBy, v))
+}
+
+// PickedByIsNil applies the IsNil predicate on the "picked_by" field.
+func PickedByIsNil() predicate.PickTask {
+	return predicate.PickTask(sql.FieldIsNull(FieldPickedBy))
+}
+
+// PickedByNotNil applies the NotNil predicate on the "picked_by" field.
+func PickedByNotNil() predicate.PickTask {
+	return predicate.PickTask(sql.FieldNotNull(FieldPickedBy))
+}
+
+// PickedByEqualFold applies the EqualFold predicate on the "picked_by" field.
+func PickedByEqualFold(v string) predicate.PickTask {
+	return predicate.PickTask(sql.FieldEqualFold(FieldPickedBy, v))
+}
+
+// PickedByContainsFold applies the ContainsFold predicate on the "picked_by" field.
+func PickedByContainsFold(v string) predicate.PickTask {
+	return predicate.PickTask(sql.FieldContainsFold(FieldPickedBy, v))
+}
+
 // HasPicklist applies the HasEdge predicate on the "picklist" edge.
 func HasPicklist() predicate.PickTask {
 	return predicate.PickTask(func(s *sql.Selector) {
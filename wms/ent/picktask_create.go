@@ -28,6 +28,20 @@ func (_c *PickTaskCreate) SetQuantity(v int) *PickTaskCreate {
 	return _c
 }
 
+// SetPicked sets the "picked" field.
+func (_c *PickTaskCreate) SetPicked(v int) *PickTaskCreate {
+	_c.mutation.SetPicked(v)
+	return _c
+}
+
+// SetNillablePicked sets the "picked" field if the given value is not nil.
+func (_c *PickTaskCreate) SetNillablePicked(v *int) *PickTaskCreate {
+	if v != nil {
+		_c.SetPicked(*v)
+	}
+	return _c
+}
+
 // SetStatus sets the "status" field.
 func (_c *PickTaskCreate) SetStatus(v string) *PickTaskCreate {
 	_c.mutation.SetStatus(v)
@@ -70,6 +84,34 @@ func (_c *PickTaskCreate) SetNillableBinID(v *int) *PickTaskCreate {
 	return _c
 }
 
+// SetAssignedTo sets the "assigned_to" field.
+func (_c *PickTaskCreate) SetAssignedTo(v string) *PickTaskCreate {
+	_c.mutation.SetAssignedTo(v)
+	return _c
+}
+
+// SetNillableAssignedTo sets the "assigned_to" field if the given value is not nil.
+func (_c *PickTaskCreate) SetNillableAssignedTo(v *string) *PickTaskCreate {
+	if v != nil {
+		_c.SetAssignedTo(*v)
+	}
+	return _c
+}
+
+// SetPickedBy sets the "picked_by" field.
+func (_c *PickTaskCreate) SetPickedBy(v string) *PickTaskCreate {
+	_c.mutation.SetPickedBy(v)
+	return _c
+}
+
+// SetNillablePickedBy sets the "picked_by" field if the given value is not nil.
+func (_c *PickTaskCreate) SetNillablePickedBy(v *string) *PickTaskCreate {
+	if v != nil {
+		_c.SetPickedBy(*v)
+	}
+	return _c
+}
+
 // SetPicklistID sets the "picklist" edge to the PickList entity by ID.
 func (_c *PickTaskCreate) SetPicklistID(id int) *PickTaskCreate {
 	_c.mutation.SetPicklistID(id)
@@ -127,10 +169,22 @@ func (_c *PickTaskCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *PickTaskCreate) defaults() {
+	if _, ok := _c.mutation.Picked(); !ok {
+		v := picktask.DefaultPicked
+		_c.mutation.SetPicked(v)
+	}
 	if _, ok := _c.mutation.Status(); !ok {
 		v := picktask.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.AssignedTo(); !ok {
+		v := picktask.DefaultAssignedTo
+		_c.mutation.SetAssignedTo(v)
+	}
+	if _, ok := _c.mutation.PickedBy(); !ok {
+		v := picktask.DefaultPickedBy
+		_c.mutation.SetPickedBy(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -143,6 +197,14 @@ func (_c *PickTaskCreate) check() error {
 			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "PickTask.quantity": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Picked(); !ok {
+		return &ValidationError{Name: "picked", err: errors.New(`ent: missing required field "PickTask.picked"`)}
+	}
+	if v, ok := _c.mutation.Picked(); ok {
+		if err := picktask.PickedValidator(v); err != nil {
+			return &ValidationError{Name: "picked", err: fmt.Errorf(`ent: validator failed for field "PickTask.picked": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "PickTask.status"`)}
 	}
@@ -182,6 +244,10 @@ func (_c *PickTaskCreate) createSpec() (*PickTask, *sqlgraph.CreateSpec) {
 		_spec.SetField(picktask.FieldQuantity, field.TypeInt, value)
 		_node.Quantity = value
 	}
+	if value, ok := _c.mutation.Picked(); ok {
+		_spec.SetField(picktask.FieldPicked, field.TypeInt, value)
+		_node.Picked = value
+	}
 	if value, ok := _c.mutation.Status(); ok {
 		_spec.SetField(picktask.FieldStatus, field.TypeString, value)
 		_node.Status = value
@@ -194,6 +260,14 @@ func (_c *PickTaskCreate) createSpec() (*PickTask, *sqlgraph.CreateSpec) {
 		_spec.SetField(picktask.FieldBinID, field.TypeInt, value)
 		_node.BinID = &value
 	}
+	if value, ok := _c.mutation.AssignedTo(); ok {
+		_spec.SetField(picktask.FieldAssignedTo, field.TypeString, value)
+		_node.AssignedTo = value
+	}
+	if value, ok := _c.mutation.PickedBy(); ok {
+		_spec.SetField(picktask.FieldPickedBy, field.TypeString, value)
+		_node.PickedBy = value
+	}
 	if nodes := _c.mutation.PicklistIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
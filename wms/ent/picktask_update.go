@@ -51,6 +51,27 @@ func (_u *PickTaskUpdate) AddQuantity(v int) *PickTaskUpdate {
 	return _u
 }
 
+// SetPicked sets the "picked" field.
+func (_u *PickTaskUpdate) SetPicked(v int) *PickTaskUpdate {
+	_u.mutation.ResetPicked()
+	_u.mutation.SetPicked(v)
+	return _u
+}
+
+// SetNillablePicked sets the "picked" field if the given value is not nil.
+func (_u *PickTaskUpdate) SetNillablePicked(v *int) *PickTaskUpdate {
+	if v != nil {
+		_u.SetPicked(*v)
+	}
+	return _u
+}
+
+// AddPicked adds value to the "picked" field.
+func (_u *PickTaskUpdate) AddPicked(v int) *PickTaskUpdate {
+	_u.mutation.AddPicked(v)
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *PickTaskUpdate) SetStatus(v string) *PickTaskUpdate {
 	_u.mutation.SetStatus(v)
@@ -112,6 +133,46 @@ func (_u *PickTaskUpdate) ClearBinID() *PickTaskUpdate {
 	return _u
 }
 
+// SetAssignedTo sets the "assigned_to" field.
+func (_u *PickTaskUpdate) SetAssignedTo(v string) *PickTaskUpdate {
+	_u.mutation.SetAssignedTo(v)
+	return _u
+}
+
+// SetNillableAssignedTo sets the "assigned_to" field if the given value is not nil.
+func (_u *PickTaskUpdate) SetNillableAssignedTo(v *string) *PickTaskUpdate {
+	if v != nil {
+		_u.SetAssignedTo(*v)
+	}
+	return _u
+}
+
+// ClearAssignedTo clears the value of the "assigned_to" field.
+func (_u *PickTaskUpdate) ClearAssignedTo() *PickTaskUpdate {
+	_u.mutation.ClearAssignedTo()
+	return _u
+}
+
+// SetPickedBy sets the "picked_by" field.
+func (_u *PickTaskUpdate) SetPickedBy(v string) *PickTaskUpdate {
+	_u.mutation.SetPickedBy(v)
+	return _u
+}
+
+// SetNillablePickedBy sets the "picked_by" field if the given value is not nil.
+func (_u *PickTaskUpdate) SetNillablePickedBy(v *string) *PickTaskUpdate {
+	if v != nil {
+		_u.SetPickedBy(*v)
+	}
+	return _u
+}
+
+// ClearPickedBy clears the value of the "picked_by" field.
+func (_u *PickTaskUpdate) ClearPickedBy() *PickTaskUpdate {
+	_u.mutation.ClearPickedBy()
+	return _u
+}
+
 // SetPicklistID sets the "picklist" edge to the PickList entity by ID.
 func (_u *PickTaskUpdate) SetPicklistID(id int) *PickTaskUpdate {
 	_u.mutation.SetPicklistID(id)
@@ -185,6 +246,11 @@ func (_u *PickTaskUpdate) check() error {
 			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "PickTask.quantity": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Picked(); ok {
+		if err := picktask.PickedValidator(v); err != nil {
+			return &ValidationError{Name: "picked", err: fmt.Errorf(`ent: validator failed for field "PickTask.picked": %w`, err)}
+		}
+	}
 	if _u.mutation.PicklistCleared() && len(_u.mutation.PicklistIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "PickTask.picklist"`)
 	}
@@ -212,6 +278,12 @@ func (_u *PickTaskUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedQuantity(); ok {
 		_spec.AddField(picktask.FieldQuantity, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Picked(); ok {
+		_spec.SetField(picktask.FieldPicked, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPicked(); ok {
+		_spec.AddField(picktask.FieldPicked, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(picktask.FieldStatus, field.TypeString, value)
 	}
@@ -230,6 +302,18 @@ func (_u *PickTaskUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.BinIDCleared() {
 		_spec.ClearField(picktask.FieldBinID, field.TypeInt)
 	}
+	if value, ok := _u.mutation.AssignedTo(); ok {
+		_spec.SetField(picktask.FieldAssignedTo, field.TypeString, value)
+	}
+	if _u.mutation.AssignedToCleared() {
+		_spec.ClearField(picktask.FieldAssignedTo, field.TypeString)
+	}
+	if value, ok := _u.mutation.PickedBy(); ok {
+		_spec.SetField(picktask.FieldPickedBy, field.TypeString, value)
+	}
+	if _u.mutation.PickedByCleared() {
+		_spec.ClearField(picktask.FieldPickedBy, field.TypeString)
+	}
 	if _u.mutation.PicklistCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -329,6 +413,27 @@ func (_u *PickTaskUpdateOne) AddQuantity(v int) *PickTaskUpdateOne {
 	return _u
 }
 
+// SetPicked sets the "picked" field.
+func (_u *PickTaskUpdateOne) SetPicked(v int) *PickTaskUpdateOne {
+	_u.mutation.ResetPicked()
+	_u.mutation.SetPicked(v)
+	return _u
+}
+
+// SetNillablePicked sets the "picked" field if the given value is not nil.
+func (_u *PickTaskUpdateOne) SetNillablePicked(v *int) *PickTaskUpdateOne {
+	if v != nil {
+		_u.SetPicked(*v)
+	}
+	return _u
+}
+
+// AddPicked adds value to the "picked" field.
+func (_u *PickTaskUpdateOne) AddPicked(v int) *PickTaskUpdateOne {
+	_u.mutation.AddPicked(v)
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *PickTaskUpdateOne) SetStatus(v string) *PickTaskUpdateOne {
 	_u.mutation.SetStatus(v)
@@ -390,6 +495,46 @@ func (_u *PickTaskUpdateOne) ClearBinID() *PickTaskUpdateOne {
 	return _u
 }
 
+// SetAssignedTo sets the "assigned_to" field.
+func (_u *PickTaskUpdateOne) SetAssignedTo(v string) *PickTaskUpdateOne {
+	_u.mutation.SetAssignedTo(v)
+	return _u
+}
+
+// SetNillableAssignedTo sets the "assigned_to" field if the given value is not nil.
+func (_u *PickTaskUpdateOne) SetNillableAssignedTo(v *string) *PickTaskUpdateOne {
+	if v != nil {
+		_u.SetAssignedTo(*v)
+	}
+	return _u
+}
+
+// ClearAssignedTo clears the value of the "assigned_to" field.
+func (_u *PickTaskUpdateOne) ClearAssignedTo() *PickTaskUpdateOne {
+	_u.mutation.ClearAssignedTo()
+	return _u
+}
+
+// SetPickedBy sets the "picked_by" field.
+func (_u *PickTaskUpdateOne) SetPickedBy(v string) *PickTaskUpdateOne {
+	_u.mutation.SetPickedBy(v)
+	return _u
+}
+
+// SetNillablePickedBy sets the "picked_by" field if the given value is not nil.
+func (_u *PickTaskUpdateOne) SetNillablePickedBy(v *string) *PickTaskUpdateOne {
+	if v != nil {
+		_u.SetPickedBy(*v)
+	}
+	return _u
+}
+
+// ClearPickedBy clears the value of the "picked_by" field.
+func (_u *PickTaskUpdateOne) ClearPickedBy() *PickTaskUpdateOne {
+	_u.mutation.ClearPickedBy()
+	return _u
+}
+
 // SetPicklistID sets the "picklist" edge to the PickList entity by ID.
 func (_u *PickTaskUpdateOne) SetPicklistID(id int) *PickTaskUpdateOne {
 	_u.mutation.SetPicklistID(id)
@@ -476,6 +621,11 @@ func (_u *PickTaskUpdateOne) check() error {
 			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "PickTask.quantity": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Picked(); ok {
+		if err := picktask.PickedValidator(v); err != nil {
+			return &ValidationError{Name: "picked", err: fmt.Errorf(`ent: validator failed for field "PickTask.picked": %w`, err)}
+		}
+	}
 	if _u.mutation.PicklistCleared() && len(_u.mutation.PicklistIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "PickTask.picklist"`)
 	}
@@ -520,6 +670,12 @@ func (_u *PickTaskUpdateOne) sqlSave(ctx context.Context) (_node *PickTask, err
 	if value, ok := _u.mutation.AddedQuantity(); ok {
 		_spec.AddField(picktask.FieldQuantity, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Picked(); ok {
+		_spec.SetField(picktask.FieldPicked, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPicked(); ok {
+		_spec.AddField(picktask.FieldPicked, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(picktask.FieldStatus, field.TypeString, value)
 	}
@@ -538,6 +694,18 @@ func (_u *PickTaskUpdateOne) sqlSave(ctx context.Context) (_node *PickTask, err
 	if _u.mutation.BinIDCleared() {
 		_spec.ClearField(picktask.FieldBinID, field.TypeInt)
 	}
+	if value, ok := _u.mutation.AssignedTo(); ok {
+		_spec.SetField(picktask.FieldAssignedTo, field.TypeString, value)
+	}
+	if _u.mutation.AssignedToCleared() {
+		_spec.ClearField(picktask.FieldAssignedTo, field.TypeString)
+	}
+	if value, ok := _u.mutation.PickedBy(); ok {
+		_spec.SetField(picktask.FieldPickedBy, field.TypeString, value)
+	}
+	if _u.mutation.PickedByCleared() {
+		_spec.ClearField(picktask.FieldPickedBy, field.TypeString)
+	}
 	if _u.mutation.PicklistCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
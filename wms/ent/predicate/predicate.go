@@ -12,6 +12,21 @@ type AuditEvent func(*sql.Selector)
 // Bin is the predicate function for bin builders.
 type Bin func(*sql.Selector)
 
+// BinStock is the predicate function for binstock builders.
+type BinStock func(*sql.Selector)
+
+// Carton is the predicate function for carton builders.
+type Carton func(*sql.Selector)
+
+// CartonLine is the predicate function for cartonline builders.
+type CartonLine func(*sql.Selector)
+
+// CartonType is the predicate function for cartontype builders.
+type CartonType func(*sql.Selector)
+
+// EventRule is the predicate function for eventrule builders.
+type EventRule func(*sql.Selector)
+
 // Item is the predicate function for item builders.
 type Item func(*sql.Selector)
 
@@ -30,6 +45,27 @@ type PickList func(*sql.Selector)
 // PickTask is the predicate function for picktask builders.
 type PickTask func(*sql.Selector)
 
+// PutawayTask is the predicate function for putawaytask builders.
+type PutawayTask func(*sql.Selector)
+
+// Receipt is the predicate function for receipt builders.
+type Receipt func(*sql.Selector)
+
+// ReorderPolicy is the predicate function for reorderpolicy builders.
+type ReorderPolicy func(*sql.Selector)
+
+// ReplenishmentRule is the predicate function for replenishmentrule builders.
+type ReplenishmentRule func(*sql.Selector)
+
+// ReplenishmentTask is the predicate function for replenishmenttask builders.
+type ReplenishmentTask func(*sql.Selector)
+
+// Sequence is the predicate function for sequence builders.
+type Sequence func(*sql.Selector)
+
+// StockDiscrepancy is the predicate function for stockdiscrepancy builders.
+type StockDiscrepancy func(*sql.Selector)
+
 // StockMovement is the predicate function for stockmovement builders.
 type StockMovement func(*sql.Selector)
 
@@ -45,5 +81,14 @@ type Warehouse func(*sql.Selector)
 // WarehouseLocation is the predicate function for warehouselocation builders.
 type WarehouseLocation func(*sql.Selector)
 
+// Wave is the predicate function for wave builders.
+type Wave func(*sql.Selector)
+
+// WavePick is the predicate function for wavepick builders.
+type WavePick func(*sql.Selector)
+
+// WaveTask is the predicate function for wavetask builders.
+type WaveTask func(*sql.Selector)
+
 // Zone is the predicate function for zone builders.
 type Zone func(*sql.Selector)
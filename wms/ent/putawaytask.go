@@ -0,0 +1,260 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mxV03/wms/ent/item"
+	"github.com/mxV03/wms/ent/location"
+	"github.com/mxV03/wms/ent/putawaytask"
+)
+
+// PutawayTask is the model entity for the PutawayTask schema.
+type PutawayTask struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity int `json:"quantity,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// Reference holds the value of the "reference" field.
+	Reference string `json:"reference,omitempty"`
+	// SuggestedBinID holds the value of the "suggested_bin_id" field.
+	SuggestedBinID *int `json:"suggested_bin_id,omitempty"`
+	// Strategy holds the value of the "strategy" field.
+	Strategy string `json:"strategy,omitempty"`
+	// BinID holds the value of the "bin_id" field.
+	BinID *int `json:"bin_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// DoneAt holds the value of the "done_at" field.
+	DoneAt *time.Time `json:"done_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PutawayTaskQuery when eager-loading is set.
+	Edges                 PutawayTaskEdges `json:"edges"`
+	putaway_task_item     *int
+	putaway_task_location *int
+	selectValues          sql.SelectValues
+}
+
+// PutawayTaskEdges holds the relations/edges for other nodes in the graph.
+type PutawayTaskEdges struct {
+	// Item holds the value of the item edge.
+	Item *Item `json:"item,omitempty"`
+	// Location holds the value of the location edge.
+	Location *Location `json:"location,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ItemOrErr returns the Item value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PutawayTaskEdges) ItemOrErr() (*Item, error) {
+	if e.Item != nil {
+		return e.Item, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: item.Label}
+	}
+	return nil, &NotLoadedError{edge: "item"}
+}
+
+// LocationOrErr returns the Location value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PutawayTaskEdges) LocationOrErr() (*Location, error) {
+	if e.Location != nil {
+		return e.Location, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: location.Label}
+	}
+	return nil, &NotLoadedError{edge: "location"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PutawayTask) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case putawaytask.FieldID, putawaytask.FieldQuantity, putawaytask.FieldSuggestedBinID, putawaytask.FieldBinID:
+			values[i] = new(sql.NullInt64)
+		case putawaytask.FieldStatus, putawaytask.FieldReference, putawaytask.FieldStrategy:
+			values[i] = new(sql.NullString)
+		case putawaytask.FieldCreatedAt, putawaytask.FieldDoneAt:
+			values[i] = new(sql.NullTime)
+		case putawaytask.ForeignKeys[0]: // putaway_task_item
+			values[i] = new(sql.NullInt64)
+		case putawaytask.ForeignKeys[1]: // putaway_task_location
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PutawayTask fields.
+func (_m *PutawayTask) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case putawaytask.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case putawaytask.FieldQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				_m.Quantity = int(value.Int64)
+			}
+		case putawaytask.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case putawaytask.FieldReference:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reference", values[i])
+			} else if value.Valid {
+				_m.Reference = value.String
+			}
+		case putawaytask.FieldSuggestedBinID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field suggested_bin_id", values[i])
+			} else if value.Valid {
+				_m.SuggestedBinID = new(int)
+				*_m.SuggestedBinID = int(value.Int64)
+			}
+		case putawaytask.FieldStrategy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field strategy", values[i])
+			} else if value.Valid {
+				_m.Strategy = value.String
+			}
+		case putawaytask.FieldBinID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field bin_id", values[i])
+			} else if value.Valid {
+				_m.BinID = new(int)
+				*_m.BinID = int(value.Int64)
+			}
+		case putawaytask.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case putawaytask.FieldDoneAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field done_at", values[i])
+			} else if value.Valid {
+				_m.DoneAt = new(time.Time)
+				*_m.DoneAt = value.Time
+			}
+		case putawaytask.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field putaway_task_item", value)
+			} else if value.Valid {
+				_m.putaway_task_item = new(int)
+				*_m.putaway_task_item = int(value.Int64)
+			}
+		case putawaytask.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field putaway_task_location", value)
+			} else if value.Valid {
+				_m.putaway_task_location = new(int)
+				*_m.putaway_task_location = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PutawayTask.
+// This includes values selected through modifiers, order, etc.
+func (_m *PutawayTask) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryItem queries the "item" edge of the PutawayTask entity.
+func (_m *PutawayTask) QueryItem() *ItemQuery {
+	return NewPutawayTaskClient(_m.config).QueryItem(_m)
+}
+
+// QueryLocation queries the "location" edge of the PutawayTask entity.
+func (_m *PutawayTask) QueryLocation() *LocationQuery {
+	return NewPutawayTaskClient(_m.config).QueryLocation(_m)
+}
+
+// Update returns a builder for updating this PutawayTask.
+// Note that you need to call PutawayTask.Unwrap() before calling this method if this PutawayTask
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PutawayTask) Update() *PutawayTaskUpdateOne {
+	return NewPutawayTaskClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PutawayTask entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PutawayTask) Unwrap() *PutawayTask {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PutawayTask is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PutawayTask) String() string {
+	var builder strings.Builder
+	builder.WriteString("PutawayTask(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("reference=")
+	builder.WriteString(_m.Reference)
+	builder.WriteString(", ")
+	if v := _m.SuggestedBinID; v != nil {
+		builder.WriteString("suggested_bin_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("strategy=")
+	builder.WriteString(_m.Strategy)
+	builder.WriteString(", ")
+	if v := _m.BinID; v != nil {
+		builder.WriteString("bin_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DoneAt; v != nil {
+		builder.WriteString("done_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PutawayTasks is a parsable slice of PutawayTask.
+type PutawayTasks []*PutawayTask
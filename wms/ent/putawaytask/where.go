@@ -0,0 +1,602 @@
+// Code generated by ent, DO NOT EDIT.
+
+package putawaytask
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/mxV03/wms/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldLTE(FieldID, id))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldEQ(FieldQuantity, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldEQ(FieldStatus, v))
+}
+
+// Reference applies equality check predicate on the "reference" field. It's identical to ReferenceEQ.
+func Reference(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldEQ(FieldReference, v))
+}
+
+// SuggestedBinID applies equality check predicate on the "suggested_bin_id" field. It's identical to SuggestedBinIDEQ.
+func SuggestedBinID(v int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldEQ(FieldSuggestedBinID, v))
+}
+
+// Strategy applies equality check predicate on the "strategy" field. It's identical to StrategyEQ.
+func Strategy(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldEQ(FieldStrategy, v))
+}
+
+// BinID applies equality check predicate on the "bin_id" field. It's identical to BinIDEQ.
+func BinID(v int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldEQ(FieldBinID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// DoneAt applies equality check predicate on the "done_at" field. It's identical to DoneAtEQ.
+func DoneAt(v time.Time) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldEQ(FieldDoneAt, v))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldLTE(FieldQuantity, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// ReferenceEQ applies the EQ predicate on the "reference" field.
+func ReferenceEQ(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldEQ(FieldReference, v))
+}
+
+// ReferenceNEQ applies the NEQ predicate on the "reference" field.
+func ReferenceNEQ(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldNEQ(FieldReference, v))
+}
+
+// ReferenceIn applies the In predicate on the "reference" field.
+func ReferenceIn(vs ...string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldIn(FieldReference, vs...))
+}
+
+// ReferenceNotIn applies the NotIn predicate on the "reference" field.
+func ReferenceNotIn(vs ...string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldNotIn(FieldReference, vs...))
+}
+
+// ReferenceGT applies the GT predicate on the "reference" field.
+func ReferenceGT(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldGT(FieldReference, v))
+}
+
+// ReferenceGTE applies the GTE predicate on the "reference" field.
+func ReferenceGTE(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldGTE(FieldReference, v))
+}
+
+// ReferenceLT applies the LT predicate on the "reference" field.
+func ReferenceLT(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldLT(FieldReference, v))
+}
+
+// ReferenceLTE applies the LTE predicate on the "reference" field.
+func ReferenceLTE(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldLTE(FieldReference, v))
+}
+
+// ReferenceContains applies the Contains predicate on the "reference" field.
+func ReferenceContains(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldContains(FieldReference, v))
+}
+
+// ReferenceHasPrefix applies the HasPrefix predicate on the "reference" field.
+func ReferenceHasPrefix(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldHasPrefix(FieldReference, v))
+}
+
+// ReferenceHasSuffix applies the HasSuffix predicate on the "reference" field.
+func ReferenceHasSuffix(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldHasSuffix(FieldReference, v))
+}
+
+// ReferenceIsNil applies the IsNil predicate on the "reference" field.
+func ReferenceIsNil() predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldIsNull(FieldReference))
+}
+
+// ReferenceNotNil applies the NotNil predicate on the "reference" field.
+func ReferenceNotNil() predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldNotNull(FieldReference))
+}
+
+// ReferenceEqualFold applies the EqualFold predicate on the "reference" field.
+func ReferenceEqualFold(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldEqualFold(FieldReference, v))
+}
+
+// ReferenceContainsFold applies the ContainsFold predicate on the "reference" field.
+func ReferenceContainsFold(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldContainsFold(FieldReference, v))
+}
+
+// SuggestedBinIDEQ applies the EQ predicate on the "suggested_bin_id" field.
+func SuggestedBinIDEQ(v int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldEQ(FieldSuggestedBinID, v))
+}
+
+// SuggestedBinIDNEQ applies the NEQ predicate on the "suggested_bin_id" field.
+func SuggestedBinIDNEQ(v int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldNEQ(FieldSuggestedBinID, v))
+}
+
+// SuggestedBinIDIn applies the In predicate on the "suggested_bin_id" field.
+func SuggestedBinIDIn(vs ...int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldIn(FieldSuggestedBinID, vs...))
+}
+
+// SuggestedBinIDNotIn applies the NotIn predicate on the "suggested_bin_id" field.
+func SuggestedBinIDNotIn(vs ...int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldNotIn(FieldSuggestedBinID, vs...))
+}
+
+// SuggestedBinIDGT applies the GT predicate on the "suggested_bin_id" field.
+func SuggestedBinIDGT(v int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldGT(FieldSuggestedBinID, v))
+}
+
+// SuggestedBinIDGTE applies the GTE predicate on the "suggested_bin_id" field.
+func SuggestedBinIDGTE(v int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldGTE(FieldSuggestedBinID, v))
+}
+
+// SuggestedBinIDLT applies the LT predicate on the "suggested_bin_id" field.
+func SuggestedBinIDLT(v int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldLT(FieldSuggestedBinID, v))
+}
+
+// SuggestedBinIDLTE applies the LTE predicate on the "suggested_bin_id" field.
+func SuggestedBinIDLTE(v int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldLTE(FieldSuggestedBinID, v))
+}
+
+// SuggestedBinIDIsNil applies the IsNil predicate on the "suggested_bin_id" field.
+func SuggestedBinIDIsNil() predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldIsNull(FieldSuggestedBinID))
+}
+
+// SuggestedBinIDNotNil applies the NotNil predicate on the "suggested_bin_id" field.
+func SuggestedBinIDNotNil() predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldNotNull(FieldSuggestedBinID))
+}
+
+// StrategyEQ applies the EQ predicate on the "strategy" field.
+func StrategyEQ(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldEQ(FieldStrategy, v))
+}
+
+// StrategyNEQ applies the NEQ predicate on the "strategy" field.
+func StrategyNEQ(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldNEQ(FieldStrategy, v))
+}
+
+// StrategyIn applies the In predicate on the "strategy" field.
+func StrategyIn(vs ...string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldIn(FieldStrategy, vs...))
+}
+
+// StrategyNotIn applies the NotIn predicate on the "strategy" field.
+func StrategyNotIn(vs ...string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldNotIn(FieldStrategy, vs...))
+}
+
+// StrategyGT applies the GT predicate on the "strategy" field.
+func StrategyGT(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldGT(FieldStrategy, v))
+}
+
+// StrategyGTE applies the GTE predicate on the "strategy" field.
+func StrategyGTE(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldGTE(FieldStrategy, v))
+}
+
+// StrategyLT applies the LT predicate on the "strategy" field.
+func StrategyLT(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldLT(FieldStrategy, v))
+}
+
+// StrategyLTE applies the LTE predicate on the "strategy" field.
+func StrategyLTE(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldLTE(FieldStrategy, v))
+}
+
+// StrategyContains applies the Contains predicate on the "strategy" field.
+func StrategyContains(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldContains(FieldStrategy, v))
+}
+
+// StrategyHasPrefix applies the HasPrefix predicate on the "strategy" field.
+func StrategyHasPrefix(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldHasPrefix(FieldStrategy, v))
+}
+
+// StrategyHasSuffix applies the HasSuffix predicate on the "strategy" field.
+func StrategyHasSuffix(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldHasSuffix(FieldStrategy, v))
+}
+
+// StrategyIsNil applies the IsNil predicate on the "strategy" field.
+func StrategyIsNil() predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldIsNull(FieldStrategy))
+}
+
+// StrategyNotNil applies the NotNil predicate on the "strategy" field.
+func StrategyNotNil() predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldNotNull(FieldStrategy))
+}
+
+// StrategyEqualFold applies the EqualFold predicate on the "strategy" field.
+func StrategyEqualFold(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldEqualFold(FieldStrategy, v))
+}
+
+// StrategyContainsFold applies the ContainsFold predicate on the "strategy" field.
+func StrategyContainsFold(v string) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldContainsFold(FieldStrategy, v))
+}
+
+// BinIDEQ applies the EQ predicate on the "bin_id" field.
+func BinIDEQ(v int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldEQ(FieldBinID, v))
+}
+
+// BinIDNEQ applies the NEQ predicate on the "bin_id" field.
+func BinIDNEQ(v int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldNEQ(FieldBinID, v))
+}
+
+// BinIDIn applies the In predicate on the "bin_id" field.
+func BinIDIn(vs ...int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldIn(FieldBinID, vs...))
+}
+
+// BinIDNotIn applies the NotIn predicate on the "bin_id" field.
+func BinIDNotIn(vs ...int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldNotIn(FieldBinID, vs...))
+}
+
+// BinIDGT applies the GT predicate on the "bin_id" field.
+func BinIDGT(v int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldGT(FieldBinID, v))
+}
+
+// BinIDGTE applies the GTE predicate on the "bin_id" field.
+func BinIDGTE(v int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldGTE(FieldBinID, v))
+}
+
+// BinIDLT applies the LT predicate on the "bin_id" field.
+func BinIDLT(v int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldLT(FieldBinID, v))
+}
+
+// BinIDLTE applies the LTE predicate on the "bin_id" field.
+func BinIDLTE(v int) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldLTE(FieldBinID, v))
+}
+
+// BinIDIsNil applies the IsNil predicate on the "bin_id" field.
+func BinIDIsNil() predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldIsNull(FieldBinID))
+}
+
+// BinIDNotNil applies the NotNil predicate on the "bin_id" field.
+func BinIDNotNil() predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldNotNull(FieldBinID))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// DoneAtEQ applies the EQ predicate on the "done_at" field.
+func DoneAtEQ(v time.Time) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldEQ(FieldDoneAt, v))
+}
+
+// DoneAtNEQ applies the NEQ predicate on the "done_at" field.
+func DoneAtNEQ(v time.Time) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldNEQ(FieldDoneAt, v))
+}
+
+// DoneAtIn applies the In predicate on the "done_at" field.
+func DoneAtIn(vs ...time.Time) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldIn(FieldDoneAt, vs...))
+}
+
+// DoneAtNotIn applies the NotIn predicate on the "done_at" field.
+func DoneAtNotIn(vs ...time.Time) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldNotIn(FieldDoneAt, vs...))
+}
+
+// DoneAtGT applies the GT predicate on the "done_at" field.
+func DoneAtGT(v time.Time) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldGT(FieldDoneAt, v))
+}
+
+// DoneAtGTE applies the GTE predicate on the "done_at" field.
+func DoneAtGTE(v time.Time) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldGTE(FieldDoneAt, v))
+}
+
+// DoneAtLT applies the LT predicate on the "done_at" field.
+func DoneAtLT(v time.Time) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldLT(FieldDoneAt, v))
+}
+
+// DoneAtLTE applies the LTE predicate on the "done_at" field.
+func DoneAtLTE(v time.Time) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldLTE(FieldDoneAt, v))
+}
+
+// DoneAtIsNil applies the IsNil predicate on the "done_at" field.
+func DoneAtIsNil() predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldIsNull(FieldDoneAt))
+}
+
+// DoneAtNotNil applies the NotNil predicate on the "done_at" field.
+func DoneAtNotNil() predicate.PutawayTask {
+	return predicate.PutawayTask(sql.FieldNotNull(FieldDoneAt))
+}
+
+// HasItem applies the HasEdge predicate on the "item" edge.
+func HasItem() predicate.PutawayTask {
+	return predicate.PutawayTask(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ItemTable, ItemColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemWith applies the HasEdge predicate on the "item" edge with a given conditions (other predicates).
+func HasItemWith(preds ...predicate.Item) predicate.PutawayTask {
+	return predicate.PutawayTask(func(s *sql.Selector) {
+		step := newItemStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLocation applies the HasEdge predicate on the "location" edge.
+func HasLocation() predicate.PutawayTask {
+	return predicate.PutawayTask(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, LocationTable, LocationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLocationWith applies the HasEdge predicate on the "location" edge with a given conditions (other predicates).
+func HasLocationWith(preds ...predicate.Location) predicate.PutawayTask {
+	return predicate.PutawayTask(func(s *sql.Selector) {
+		step := newLocationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PutawayTask) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PutawayTask) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PutawayTask) predicate.PutawayTask {
+	return predicate.PutawayTask(sql.NotPredicates(p))
+}
@@ -0,0 +1,407 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/item"
+	"github.com/mxV03/wms/ent/location"
+	"github.com/mxV03/wms/ent/putawaytask"
+)
+
+// PutawayTaskCreate is the builder for creating a PutawayTask entity.
+type PutawayTaskCreate struct {
+	config
+	mutation *PutawayTaskMutation
+	hooks    []Hook
+}
+
+// SetQuantity sets the "quantity" field.
+func (_c *PutawayTaskCreate) SetQuantity(v int) *PutawayTaskCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *PutawayTaskCreate) SetStatus(v string) *PutawayTaskCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *PutawayTaskCreate) SetNillableStatus(v *string) *PutawayTaskCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetReference sets the "reference" field.
+func (_c *PutawayTaskCreate) SetReference(v string) *PutawayTaskCreate {
+	_c.mutation.SetReference(v)
+	return _c
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_c *PutawayTaskCreate) SetNillableReference(v *string) *PutawayTaskCreate {
+	if v != nil {
+		_c.SetReference(*v)
+	}
+	return _c
+}
+
+// SetSuggestedBinID sets the "suggested_bin_id" field.
+func (_c *PutawayTaskCreate) SetSuggestedBinID(v int) *PutawayTaskCreate {
+	_c.mutation.SetSuggestedBinID(v)
+	return _c
+}
+
+// SetNillableSuggestedBinID sets the "suggested_bin_id" field if the given value is not nil.
+func (_c *PutawayTaskCreate) SetNillableSuggestedBinID(v *int) *PutawayTaskCreate {
+	if v != nil {
+		_c.SetSuggestedBinID(*v)
+	}
+	return _c
+}
+
+// SetStrategy sets the "strategy" field.
+func (_c *PutawayTaskCreate) SetStrategy(v string) *PutawayTaskCreate {
+	_c.mutation.SetStrategy(v)
+	return _c
+}
+
+// SetNillableStrategy sets the "strategy" field if the given value is not nil.
+func (_c *PutawayTaskCreate) SetNillableStrategy(v *string) *PutawayTaskCreate {
+	if v != nil {
+		_c.SetStrategy(*v)
+	}
+	return _c
+}
+
+// SetBinID sets the "bin_id" field.
+func (_c *PutawayTaskCreate) SetBinID(v int) *PutawayTaskCreate {
+	_c.mutation.SetBinID(v)
+	return _c
+}
+
+// SetNillableBinID sets the "bin_id" field if the given value is not nil.
+func (_c *PutawayTaskCreate) SetNillableBinID(v *int) *PutawayTaskCreate {
+	if v != nil {
+		_c.SetBinID(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PutawayTaskCreate) SetCreatedAt(v time.Time) *PutawayTaskCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PutawayTaskCreate) SetNillableCreatedAt(v *time.Time) *PutawayTaskCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetDoneAt sets the "done_at" field.
+func (_c *PutawayTaskCreate) SetDoneAt(v time.Time) *PutawayTaskCreate {
+	_c.mutation.SetDoneAt(v)
+	return _c
+}
+
+// SetNillableDoneAt sets the "done_at" field if the given value is not nil.
+func (_c *PutawayTaskCreate) SetNillableDoneAt(v *time.Time) *PutawayTaskCreate {
+	if v != nil {
+		_c.SetDoneAt(*v)
+	}
+	return _c
+}
+
+// SetItemID sets the "item" edge to the Item entity by ID.
+func (_c *PutawayTaskCreate) SetItemID(id int) *PutawayTaskCreate {
+	_c.mutation.SetItemID(id)
+	return _c
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_c *PutawayTaskCreate) SetItem(v *Item) *PutawayTaskCreate {
+	return _c.SetItemID(v.ID)
+}
+
+// SetLocationID sets the "location" edge to the Location entity by ID.
+func (_c *PutawayTaskCreate) SetLocationID(id int) *PutawayTaskCreate {
+	_c.mutation.SetLocationID(id)
+	return _c
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (_c *PutawayTaskCreate) SetLocation(v *Location) *PutawayTaskCreate {
+	return _c.SetLocationID(v.ID)
+}
+
+// Mutation returns the PutawayTaskMutation object of the builder.
+func (_c *PutawayTaskCreate) Mutation() *PutawayTaskMutation {
+	return _c.mutation
+}
+
+// Save creates the PutawayTask in the database.
+func (_c *PutawayTaskCreate) Save(ctx context.Context) (*PutawayTask, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PutawayTaskCreate) SaveX(ctx context.Context) *PutawayTask {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PutawayTaskCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PutawayTaskCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PutawayTaskCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := putawaytask.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Reference(); !ok {
+		v := putawaytask.DefaultReference
+		_c.mutation.SetReference(v)
+	}
+	if _, ok := _c.mutation.Strategy(); !ok {
+		v := putawaytask.DefaultStrategy
+		_c.mutation.SetStrategy(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := putawaytask.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PutawayTaskCreate) check() error {
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "PutawayTask.quantity"`)}
+	}
+	if v, ok := _c.mutation.Quantity(); ok {
+		if err := putawaytask.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "PutawayTask.quantity": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "PutawayTask.status"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PutawayTask.created_at"`)}
+	}
+	if len(_c.mutation.ItemIDs()) == 0 {
+		return &ValidationError{Name: "item", err: errors.New(`ent: missing required edge "PutawayTask.item"`)}
+	}
+	if len(_c.mutation.LocationIDs()) == 0 {
+		return &ValidationError{Name: "location", err: errors.New(`ent: missing required edge "PutawayTask.location"`)}
+	}
+	return nil
+}
+
+func (_c *PutawayTaskCreate) sqlSave(ctx context.Context) (*PutawayTask, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PutawayTaskCreate) createSpec() (*PutawayTask, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PutawayTask{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(putawaytask.Table, sqlgraph.NewFieldSpec(putawaytask.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(putawaytask.FieldQuantity, field.TypeInt, value)
+		_node.Quantity = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(putawaytask.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Reference(); ok {
+		_spec.SetField(putawaytask.FieldReference, field.TypeString, value)
+		_node.Reference = value
+	}
+	if value, ok := _c.mutation.SuggestedBinID(); ok {
+		_spec.SetField(putawaytask.FieldSuggestedBinID, field.TypeInt, value)
+		_node.SuggestedBinID = &value
+	}
+	if value, ok := _c.mutation.Strategy(); ok {
+		_spec.SetField(putawaytask.FieldStrategy, field.TypeString, value)
+		_node.Strategy = value
+	}
+	if value, ok := _c.mutation.BinID(); ok {
+		_spec.SetField(putawaytask.FieldBinID, field.TypeInt, value)
+		_node.BinID = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(putawaytask.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.DoneAt(); ok {
+		_spec.SetField(putawaytask.FieldDoneAt, field.TypeTime, value)
+		_node.DoneAt = &value
+	}
+	if nodes := _c.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   putawaytask.ItemTable,
+			Columns: []string{putawaytask.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.putaway_task_item = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   putawaytask.LocationTable,
+			Columns: []string{putawaytask.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.putaway_task_location = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PutawayTaskCreateBulk is the builder for creating many PutawayTask entities in bulk.
+type PutawayTaskCreateBulk struct {
+	config
+	err      error
+	builders []*PutawayTaskCreate
+}
+
+// Save creates the PutawayTask entities in the database.
+func (_c *PutawayTaskCreateBulk) Save(ctx context.Context) ([]*PutawayTask, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PutawayTask, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PutawayTaskMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PutawayTaskCreateBulk) SaveX(ctx context.Context) []*PutawayTask {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PutawayTaskCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PutawayTaskCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
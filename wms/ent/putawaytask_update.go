@@ -0,0 +1,822 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/item"
+	"github.com/mxV03/wms/ent/location"
+	"github.com/mxV03/wms/ent/predicate"
+	"github.com/mxV03/wms/ent/putawaytask"
+)
+
+// PutawayTaskUpdate is the builder for updating PutawayTask entities.
+type PutawayTaskUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PutawayTaskMutation
+}
+
+// Where appends a list predicates to the PutawayTaskUpdate builder.
+func (_u *PutawayTaskUpdate) Where(ps ...predicate.PutawayTask) *PutawayTaskUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *PutawayTaskUpdate) SetQuantity(v int) *PutawayTaskUpdate {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *PutawayTaskUpdate) SetNillableQuantity(v *int) *PutawayTaskUpdate {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *PutawayTaskUpdate) AddQuantity(v int) *PutawayTaskUpdate {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PutawayTaskUpdate) SetStatus(v string) *PutawayTaskUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PutawayTaskUpdate) SetNillableStatus(v *string) *PutawayTaskUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReference sets the "reference" field.
+func (_u *PutawayTaskUpdate) SetReference(v string) *PutawayTaskUpdate {
+	_u.mutation.SetReference(v)
+	return _u
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_u *PutawayTaskUpdate) SetNillableReference(v *string) *PutawayTaskUpdate {
+	if v != nil {
+		_u.SetReference(*v)
+	}
+	return _u
+}
+
+// ClearReference clears the value of the "reference" field.
+func (_u *PutawayTaskUpdate) ClearReference() *PutawayTaskUpdate {
+	_u.mutation.ClearReference()
+	return _u
+}
+
+// SetSuggestedBinID sets the "suggested_bin_id" field.
+func (_u *PutawayTaskUpdate) SetSuggestedBinID(v int) *PutawayTaskUpdate {
+	_u.mutation.ResetSuggestedBinID()
+	_u.mutation.SetSuggestedBinID(v)
+	return _u
+}
+
+// SetNillableSuggestedBinID sets the "suggested_bin_id" field if the given value is not nil.
+func (_u *PutawayTaskUpdate) SetNillableSuggestedBinID(v *int) *PutawayTaskUpdate {
+	if v != nil {
+		_u.SetSuggestedBinID(*v)
+	}
+	return _u
+}
+
+// AddSuggestedBinID adds value to the "suggested_bin_id" field.
+func (_u *PutawayTaskUpdate) AddSuggestedBinID(v int) *PutawayTaskUpdate {
+	_u.mutation.AddSuggestedBinID(v)
+	return _u
+}
+
+// ClearSuggestedBinID clears the value of the "suggested_bin_id" field.
+func (_u *PutawayTaskUpdate) ClearSuggestedBinID() *PutawayTaskUpdate {
+	_u.mutation.ClearSuggestedBinID()
+	return _u
+}
+
+// SetStrategy sets the "strategy" field.
+func (_u *PutawayTaskUpdate) SetStrategy(v string) *PutawayTaskUpdate {
+	_u.mutation.SetStrategy(v)
+	return _u
+}
+
+// SetNillableStrategy sets the "strategy" field if the given value is not nil.
+func (_u *PutawayTaskUpdate) SetNillableStrategy(v *string) *PutawayTaskUpdate {
+	if v != nil {
+		_u.SetStrategy(*v)
+	}
+	return _u
+}
+
+// ClearStrategy clears the value of the "strategy" field.
+func (_u *PutawayTaskUpdate) ClearStrategy() *PutawayTaskUpdate {
+	_u.mutation.ClearStrategy()
+	return _u
+}
+
+// SetBinID sets the "bin_id" field.
+func (_u *PutawayTaskUpdate) SetBinID(v int) *PutawayTaskUpdate {
+	_u.mutation.ResetBinID()
+	_u.mutation.SetBinID(v)
+	return _u
+}
+
+// SetNillableBinID sets the "bin_id" field if the given value is not nil.
+func (_u *PutawayTaskUpdate) SetNillableBinID(v *int) *PutawayTaskUpdate {
+	if v != nil {
+		_u.SetBinID(*v)
+	}
+	return _u
+}
+
+// AddBinID adds value to the "bin_id" field.
+func (_u *PutawayTaskUpdate) AddBinID(v int) *PutawayTaskUpdate {
+	_u.mutation.AddBinID(v)
+	return _u
+}
+
+// ClearBinID clears the value of the "bin_id" field.
+func (_u *PutawayTaskUpdate) ClearBinID() *PutawayTaskUpdate {
+	_u.mutation.ClearBinID()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *PutawayTaskUpdate) SetCreatedAt(v time.Time) *PutawayTaskUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *PutawayTaskUpdate) SetNillableCreatedAt(v *time.Time) *PutawayTaskUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetDoneAt sets the "done_at" field.
+func (_u *PutawayTaskUpdate) SetDoneAt(v time.Time) *PutawayTaskUpdate {
+	_u.mutation.SetDoneAt(v)
+	return _u
+}
+
+// SetNillableDoneAt sets the "done_at" field if the given value is not nil.
+func (_u *PutawayTaskUpdate) SetNillableDoneAt(v *time.Time) *PutawayTaskUpdate {
+	if v != nil {
+		_u.SetDoneAt(*v)
+	}
+	return _u
+}
+
+// ClearDoneAt clears the value of the "done_at" field.
+func (_u *PutawayTaskUpdate) ClearDoneAt() *PutawayTaskUpdate {
+	_u.mutation.ClearDoneAt()
+	return _u
+}
+
+// SetItemID sets the "item" edge to the Item entity by ID.
+func (_u *PutawayTaskUpdate) SetItemID(id int) *PutawayTaskUpdate {
+	_u.mutation.SetItemID(id)
+	return _u
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_u *PutawayTaskUpdate) SetItem(v *Item) *PutawayTaskUpdate {
+	return _u.SetItemID(v.ID)
+}
+
+// SetLocationID sets the "location" edge to the Location entity by ID.
+func (_u *PutawayTaskUpdate) SetLocationID(id int) *PutawayTaskUpdate {
+	_u.mutation.SetLocationID(id)
+	return _u
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (_u *PutawayTaskUpdate) SetLocation(v *Location) *PutawayTaskUpdate {
+	return _u.SetLocationID(v.ID)
+}
+
+// Mutation returns the PutawayTaskMutation object of the builder.
+func (_u *PutawayTaskUpdate) Mutation() *PutawayTaskMutation {
+	return _u.mutation
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (_u *PutawayTaskUpdate) ClearItem() *PutawayTaskUpdate {
+	_u.mutation.ClearItem()
+	return _u
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (_u *PutawayTaskUpdate) ClearLocation() *PutawayTaskUpdate {
+	_u.mutation.ClearLocation()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PutawayTaskUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PutawayTaskUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PutawayTaskUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PutawayTaskUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PutawayTaskUpdate) check() error {
+	if v, ok := _u.mutation.Quantity(); ok {
+		if err := putawaytask.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "PutawayTask.quantity": %w`, err)}
+		}
+	}
+	if _u.mutation.ItemCleared() && len(_u.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PutawayTask.item"`)
+	}
+	if _u.mutation.LocationCleared() && len(_u.mutation.LocationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PutawayTask.location"`)
+	}
+	return nil
+}
+
+func (_u *PutawayTaskUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(putawaytask.Table, putawaytask.Columns, sqlgraph.NewFieldSpec(putawaytask.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(putawaytask.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(putawaytask.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(putawaytask.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reference(); ok {
+		_spec.SetField(putawaytask.FieldReference, field.TypeString, value)
+	}
+	if _u.mutation.ReferenceCleared() {
+		_spec.ClearField(putawaytask.FieldReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.SuggestedBinID(); ok {
+		_spec.SetField(putawaytask.FieldSuggestedBinID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSuggestedBinID(); ok {
+		_spec.AddField(putawaytask.FieldSuggestedBinID, field.TypeInt, value)
+	}
+	if _u.mutation.SuggestedBinIDCleared() {
+		_spec.ClearField(putawaytask.FieldSuggestedBinID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Strategy(); ok {
+		_spec.SetField(putawaytask.FieldStrategy, field.TypeString, value)
+	}
+	if _u.mutation.StrategyCleared() {
+		_spec.ClearField(putawaytask.FieldStrategy, field.TypeString)
+	}
+	if value, ok := _u.mutation.BinID(); ok {
+		_spec.SetField(putawaytask.FieldBinID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBinID(); ok {
+		_spec.AddField(putawaytask.FieldBinID, field.TypeInt, value)
+	}
+	if _u.mutation.BinIDCleared() {
+		_spec.ClearField(putawaytask.FieldBinID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(putawaytask.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DoneAt(); ok {
+		_spec.SetField(putawaytask.FieldDoneAt, field.TypeTime, value)
+	}
+	if _u.mutation.DoneAtCleared() {
+		_spec.ClearField(putawaytask.FieldDoneAt, field.TypeTime)
+	}
+	if _u.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   putawaytask.ItemTable,
+			Columns: []string{putawaytask.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   putawaytask.ItemTable,
+			Columns: []string{putawaytask.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   putawaytask.LocationTable,
+			Columns: []string{putawaytask.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   putawaytask.LocationTable,
+			Columns: []string{putawaytask.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{putawaytask.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PutawayTaskUpdateOne is the builder for updating a single PutawayTask entity.
+type PutawayTaskUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PutawayTaskMutation
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *PutawayTaskUpdateOne) SetQuantity(v int) *PutawayTaskUpdateOne {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *PutawayTaskUpdateOne) SetNillableQuantity(v *int) *PutawayTaskUpdateOne {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *PutawayTaskUpdateOne) AddQuantity(v int) *PutawayTaskUpdateOne {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *PutawayTaskUpdateOne) SetStatus(v string) *PutawayTaskUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *PutawayTaskUpdateOne) SetNillableStatus(v *string) *PutawayTaskUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetReference sets the "reference" field.
+func (_u *PutawayTaskUpdateOne) SetReference(v string) *PutawayTaskUpdateOne {
+	_u.mutation.SetReference(v)
+	return _u
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_u *PutawayTaskUpdateOne) SetNillableReference(v *string) *PutawayTaskUpdateOne {
+	if v != nil {
+		_u.SetReference(*v)
+	}
+	return _u
+}
+
+// ClearReference clears the value of the "reference" field.
+func (_u *PutawayTaskUpdateOne) ClearReference() *PutawayTaskUpdateOne {
+	_u.mutation.ClearReference()
+	return _u
+}
+
+// SetSuggestedBinID sets the "suggested_bin_id" field.
+func (_u *PutawayTaskUpdateOne) SetSuggestedBinID(v int) *PutawayTaskUpdateOne {
+	_u.mutation.ResetSuggestedBinID()
+	_u.mutation.SetSuggestedBinID(v)
+	return _u
+}
+
+// SetNillableSuggestedBinID sets the "suggested_bin_id" field if the given value is not nil.
+func (_u *PutawayTaskUpdateOne) SetNillableSuggestedBinID(v *int) *PutawayTaskUpdateOne {
+	if v != nil {
+		_u.SetSuggestedBinID(*v)
+	}
+	return _u
+}
+
+// AddSuggestedBinID adds value to the "suggested_bin_id" field.
+func (_u *PutawayTaskUpdateOne) AddSuggestedBinID(v int) *PutawayTaskUpdateOne {
+	_u.mutation.AddSuggestedBinID(v)
+	return _u
+}
+
+// ClearSuggestedBinID clears the value of the "suggested_bin_id" field.
+func (_u *PutawayTaskUpdateOne) ClearSuggestedBinID() *PutawayTaskUpdateOne {
+	_u.mutation.ClearSuggestedBinID()
+	return _u
+}
+
+// SetStrategy sets the "strategy" field.
+func (_u *PutawayTaskUpdateOne) SetStrategy(v string) *PutawayTaskUpdateOne {
+	_u.mutation.SetStrategy(v)
+	return _u
+}
+
+// SetNillableStrategy sets the "strategy" field if the given value is not nil.
+func (_u *PutawayTaskUpdateOne) SetNillableStrategy(v *string) *PutawayTaskUpdateOne {
+	if v != nil {
+		_u.SetStrategy(*v)
+	}
+	return _u
+}
+
+// ClearStrategy clears the value of the "strategy" field.
+func (_u *PutawayTaskUpdateOne) ClearStrategy() *PutawayTaskUpdateOne {
+	_u.mutation.ClearStrategy()
+	return _u
+}
+
+// SetBinID sets the "bin_id" field.
+func (_u *PutawayTaskUpdateOne) SetBinID(v int) *PutawayTaskUpdateOne {
+	_u.mutation.ResetBinID()
+	_u.mutation.SetBinID(v)
+	return _u
+}
+
+// SetNillableBinID sets the "bin_id" field if the given value is not nil.
+func (_u *PutawayTaskUpdateOne) SetNillableBinID(v *int) *PutawayTaskUpdateOne {
+	if v != nil {
+		_u.SetBinID(*v)
+	}
+	return _u
+}
+
+// AddBinID adds value to the "bin_id" field.
+func (_u *PutawayTaskUpdateOne) AddBinID(v int) *PutawayTaskUpdateOne {
+	_u.mutation.AddBinID(v)
+	return _u
+}
+
+// ClearBinID clears the value of the "bin_id" field.
+func (_u *PutawayTaskUpdateOne) ClearBinID() *PutawayTaskUpdateOne {
+	_u.mutation.ClearBinID()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *PutawayTaskUpdateOne) SetCreatedAt(v time.Time) *PutawayTaskUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *PutawayTaskUpdateOne) SetNillableCreatedAt(v *time.Time) *PutawayTaskUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetDoneAt sets the "done_at" field.
+func (_u *PutawayTaskUpdateOne) SetDoneAt(v time.Time) *PutawayTaskUpdateOne {
+	_u.mutation.SetDoneAt(v)
+	return _u
+}
+
+// SetNillableDoneAt sets the "done_at" field if the given value is not nil.
+func (_u *PutawayTaskUpdateOne) SetNillableDoneAt(v *time.Time) *PutawayTaskUpdateOne {
+	if v != nil {
+		_u.SetDoneAt(*v)
+	}
+	return _u
+}
+
+// ClearDoneAt clears the value of the "done_at" field.
+func (_u *PutawayTaskUpdateOne) ClearDoneAt() *PutawayTaskUpdateOne {
+	_u.mutation.ClearDoneAt()
+	return _u
+}
+
+// SetItemID sets the "item" edge to the Item entity by ID.
+func (_u *PutawayTaskUpdateOne) SetItemID(id int) *PutawayTaskUpdateOne {
+	_u.mutation.SetItemID(id)
+	return _u
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_u *PutawayTaskUpdateOne) SetItem(v *Item) *PutawayTaskUpdateOne {
+	return _u.SetItemID(v.ID)
+}
+
+// SetLocationID sets the "location" edge to the Location entity by ID.
+func (_u *PutawayTaskUpdateOne) SetLocationID(id int) *PutawayTaskUpdateOne {
+	_u.mutation.SetLocationID(id)
+	return _u
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (_u *PutawayTaskUpdateOne) SetLocation(v *Location) *PutawayTaskUpdateOne {
+	return _u.SetLocationID(v.ID)
+}
+
+// Mutation returns the PutawayTaskMutation object of the builder.
+func (_u *PutawayTaskUpdateOne) Mutation() *PutawayTaskMutation {
+	return _u.mutation
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (_u *PutawayTaskUpdateOne) ClearItem() *PutawayTaskUpdateOne {
+	_u.mutation.ClearItem()
+	return _u
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (_u *PutawayTaskUpdateOne) ClearLocation() *PutawayTaskUpdateOne {
+	_u.mutation.ClearLocation()
+	return _u
+}
+
+// Where appends a list predicates to the PutawayTaskUpdate builder.
+func (_u *PutawayTaskUpdateOne) Where(ps ...predicate.PutawayTask) *PutawayTaskUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PutawayTaskUpdateOne) Select(field string, fields ...string) *PutawayTaskUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PutawayTask entity.
+func (_u *PutawayTaskUpdateOne) Save(ctx context.Context) (*PutawayTask, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PutawayTaskUpdateOne) SaveX(ctx context.Context) *PutawayTask {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PutawayTaskUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PutawayTaskUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PutawayTaskUpdateOne) check() error {
+	if v, ok := _u.mutation.Quantity(); ok {
+		if err := putawaytask.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "PutawayTask.quantity": %w`, err)}
+		}
+	}
+	if _u.mutation.ItemCleared() && len(_u.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PutawayTask.item"`)
+	}
+	if _u.mutation.LocationCleared() && len(_u.mutation.LocationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PutawayTask.location"`)
+	}
+	return nil
+}
+
+func (_u *PutawayTaskUpdateOne) sqlSave(ctx context.Context) (_node *PutawayTask, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(putawaytask.Table, putawaytask.Columns, sqlgraph.NewFieldSpec(putawaytask.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PutawayTask.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, putawaytask.FieldID)
+		for _, f := range fields {
+			if !putawaytask.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != putawaytask.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(putawaytask.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(putawaytask.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(putawaytask.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reference(); ok {
+		_spec.SetField(putawaytask.FieldReference, field.TypeString, value)
+	}
+	if _u.mutation.ReferenceCleared() {
+		_spec.ClearField(putawaytask.FieldReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.SuggestedBinID(); ok {
+		_spec.SetField(putawaytask.FieldSuggestedBinID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSuggestedBinID(); ok {
+		_spec.AddField(putawaytask.FieldSuggestedBinID, field.TypeInt, value)
+	}
+	if _u.mutation.SuggestedBinIDCleared() {
+		_spec.ClearField(putawaytask.FieldSuggestedBinID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Strategy(); ok {
+		_spec.SetField(putawaytask.FieldStrategy, field.TypeString, value)
+	}
+	if _u.mutation.StrategyCleared() {
+		_spec.ClearField(putawaytask.FieldStrategy, field.TypeString)
+	}
+	if value, ok := _u.mutation.BinID(); ok {
+		_spec.SetField(putawaytask.FieldBinID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBinID(); ok {
+		_spec.AddField(putawaytask.FieldBinID, field.TypeInt, value)
+	}
+	if _u.mutation.BinIDCleared() {
+		_spec.ClearField(putawaytask.FieldBinID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(putawaytask.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DoneAt(); ok {
+		_spec.SetField(putawaytask.FieldDoneAt, field.TypeTime, value)
+	}
+	if _u.mutation.DoneAtCleared() {
+		_spec.ClearField(putawaytask.FieldDoneAt, field.TypeTime)
+	}
+	if _u.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   putawaytask.ItemTable,
+			Columns: []string{putawaytask.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   putawaytask.ItemTable,
+			Columns: []string{putawaytask.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   putawaytask.LocationTable,
+			Columns: []string{putawaytask.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   putawaytask.LocationTable,
+			Columns: []string{putawaytask.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PutawayTask{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{putawaytask.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,178 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mxV03/wms/ent/orderline"
+	"github.com/mxV03/wms/ent/receipt"
+)
+
+// Receipt is the model entity for the Receipt schema.
+type Receipt struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity int `json:"quantity,omitempty"`
+	// Damaged holds the value of the "damaged" field.
+	Damaged int `json:"damaged,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReceiptQuery when eager-loading is set.
+	Edges               ReceiptEdges `json:"edges"`
+	order_line_receipts *int
+	selectValues        sql.SelectValues
+}
+
+// ReceiptEdges holds the relations/edges for other nodes in the graph.
+type ReceiptEdges struct {
+	// OrderLine holds the value of the order_line edge.
+	OrderLine *OrderLine `json:"order_line,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OrderLineOrErr returns the OrderLine value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReceiptEdges) OrderLineOrErr() (*OrderLine, error) {
+	if e.OrderLine != nil {
+		return e.OrderLine, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: orderline.Label}
+	}
+	return nil, &NotLoadedError{edge: "order_line"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Receipt) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case receipt.FieldID, receipt.FieldQuantity, receipt.FieldDamaged:
+			values[i] = new(sql.NullInt64)
+		case receipt.FieldNote:
+			values[i] = new(sql.NullString)
+		case receipt.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case receipt.ForeignKeys[0]: // order_line_receipts
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Receipt fields.
+func (_m *Receipt) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case receipt.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case receipt.FieldQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				_m.Quantity = int(value.Int64)
+			}
+		case receipt.FieldDamaged:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field damaged", values[i])
+			} else if value.Valid {
+				_m.Damaged = int(value.Int64)
+			}
+		case receipt.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
+		case receipt.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case receipt.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field order_line_receipts", value)
+			} else if value.Valid {
+				_m.order_line_receipts = new(int)
+				*_m.order_line_receipts = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Receipt.
+// This includes values selected through modifiers, order, etc.
+func (_m *Receipt) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryOrderLine queries the "order_line" edge of the Receipt entity.
+func (_m *Receipt) QueryOrderLine() *OrderLineQuery {
+	return NewReceiptClient(_m.config).QueryOrderLine(_m)
+}
+
+// Update returns a builder for updating this Receipt.
+// Note that you need to call Receipt.Unwrap() before calling this method if this Receipt
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Receipt) Update() *ReceiptUpdateOne {
+	return NewReceiptClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Receipt entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Receipt) Unwrap() *Receipt {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Receipt is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Receipt) String() string {
+	var builder strings.Builder
+	builder.WriteString("Receipt(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("damaged=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Damaged))
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Receipts is a parsable slice of Receipt.
+type Receipts []*Receipt
@@ -0,0 +1,121 @@
+// Code generated by ent, DO NOT EDIT.
+
+package receipt
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the receipt type in the database.
+	Label = "receipt"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldDamaged holds the string denoting the damaged field in the database.
+	FieldDamaged = "damaged"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeOrderLine holds the string denoting the order_line edge name in mutations.
+	EdgeOrderLine = "order_line"
+	// Table holds the table name of the receipt in the database.
+	Table = "receipts"
+	// OrderLineTable is the table that holds the order_line relation/edge.
+	OrderLineTable = "receipts"
+	// OrderLineInverseTable is the table name for the OrderLine entity.
+	// It exists in this package in order to avoid circular dependency with the "orderline" package.
+	OrderLineInverseTable = "order_lines"
+	// OrderLineColumn is the table column denoting the order_line relation/edge.
+	OrderLineColumn = "order_line_receipts"
+)
+
+// Columns holds all SQL columns for receipt fields.
+var Columns = []string{
+	FieldID,
+	FieldQuantity,
+	FieldDamaged,
+	FieldNote,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "receipts"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"order_line_receipts",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	QuantityValidator func(int) error
+	// DefaultDamaged holds the default value on creation for the "damaged" field.
+	DefaultDamaged int
+	// DamagedValidator is a validator for the "damaged" field. It is called by the builders before save.
+	DamagedValidator func(int) error
+	// DefaultNote holds the default value on creation for the "note" field.
+	DefaultNote string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Receipt queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByQuantity orders the results by the quantity field.
+func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
+}
+
+// ByDamaged orders the results by the damaged field.
+func ByDamaged(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDamaged, opts...).ToFunc()
+}
+
+// ByNote orders the results by the note field.
+func ByNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNote, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByOrderLineField orders the results by order_line field.
+func ByOrderLineField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrderLineStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newOrderLineStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrderLineInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OrderLineTable, OrderLineColumn),
+	)
+}
@@ -0,0 +1,309 @@
+// Code generated by ent, DO NOT EDIT.
+
+package receipt
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/mxV03/wms/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Receipt {
+	return predicate.Receipt(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Receipt {
+	return predicate.Receipt(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Receipt {
+	return predicate.Receipt(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Receipt {
+	return predicate.Receipt(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Receipt {
+	return predicate.Receipt(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Receipt {
+	return predicate.Receipt(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Receipt {
+	return predicate.Receipt(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Receipt {
+	return predicate.Receipt(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Receipt {
+	return predicate.Receipt(sql.FieldLTE(FieldID, id))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v int) predicate.Receipt {
+	return predicate.Receipt(sql.FieldEQ(FieldQuantity, v))
+}
+
+// Damaged applies equality check predicate on the "damaged" field. It's identical to DamagedEQ.
+func Damaged(v int) predicate.Receipt {
+	return predicate.Receipt(sql.FieldEQ(FieldDamaged, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldEQ(FieldNote, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Receipt {
+	return predicate.Receipt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v int) predicate.Receipt {
+	return predicate.Receipt(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v int) predicate.Receipt {
+	return predicate.Receipt(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...int) predicate.Receipt {
+	return predicate.Receipt(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...int) predicate.Receipt {
+	return predicate.Receipt(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v int) predicate.Receipt {
+	return predicate.Receipt(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v int) predicate.Receipt {
+	return predicate.Receipt(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v int) predicate.Receipt {
+	return predicate.Receipt(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v int) predicate.Receipt {
+	return predicate.Receipt(sql.FieldLTE(FieldQuantity, v))
+}
+
+// DamagedEQ applies the EQ predicate on the "damaged" field.
+func DamagedEQ(v int) predicate.Receipt {
+	return predicate.Receipt(sql.FieldEQ(FieldDamaged, v))
+}
+
+// DamagedNEQ applies the NEQ predicate on the "damaged" field.
+func DamagedNEQ(v int) predicate.Receipt {
+	return predicate.Receipt(sql.FieldNEQ(FieldDamaged, v))
+}
+
+// DamagedIn applies the In predicate on the "damaged" field.
+func DamagedIn(vs ...int) predicate.Receipt {
+	return predicate.Receipt(sql.FieldIn(FieldDamaged, vs...))
+}
+
+// DamagedNotIn applies the NotIn predicate on the "damaged" field.
+func DamagedNotIn(vs ...int) predicate.Receipt {
+	return predicate.Receipt(sql.FieldNotIn(FieldDamaged, vs...))
+}
+
+// DamagedGT applies the GT predicate on the "damaged" field.
+func DamagedGT(v int) predicate.Receipt {
+	return predicate.Receipt(sql.FieldGT(FieldDamaged, v))
+}
+
+// DamagedGTE applies the GTE predicate on the "damaged" field.
+func DamagedGTE(v int) predicate.Receipt {
+	return predicate.Receipt(sql.FieldGTE(FieldDamaged, v))
+}
+
+// DamagedLT applies the LT predicate on the "damaged" field.
+func DamagedLT(v int) predicate.Receipt {
+	return predicate.Receipt(sql.FieldLT(FieldDamaged, v))
+}
+
+// DamagedLTE applies the LTE predicate on the "damaged" field.
+func DamagedLTE(v int) predicate.Receipt {
+	return predicate.Receipt(sql.FieldLTE(FieldDamaged, v))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteIsNil applies the IsNil predicate on the "note" field.
+func NoteIsNil() predicate.Receipt {
+	return predicate.Receipt(sql.FieldIsNull(FieldNote))
+}
+
+// NoteNotNil applies the NotNil predicate on the "note" field.
+func NoteNotNil() predicate.Receipt {
+	return predicate.Receipt(sql.FieldNotNull(FieldNote))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.Receipt {
+	return predicate.Receipt(sql.FieldContainsFold(FieldNote, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Receipt {
+	return predicate.Receipt(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Receipt {
+	return predicate.Receipt(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Receipt {
+	return predicate.Receipt(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Receipt {
+	return predicate.Receipt(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Receipt {
+	return predicate.Receipt(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Receipt {
+	return predicate.Receipt(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Receipt {
+	return predicate.Receipt(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Receipt {
+	return predicate.Receipt(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasOrderLine applies the HasEdge predicate on the "order_line" edge.
+func HasOrderLine() predicate.Receipt {
+	return predicate.Receipt(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrderLineTable, OrderLineColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrderLineWith applies the HasEdge predicate on the "order_line" edge with a given conditions (other predicates).
+func HasOrderLineWith(preds ...predicate.OrderLine) predicate.Receipt {
+	return predicate.Receipt(func(s *sql.Selector) {
+		step := newOrderLineStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Receipt) predicate.Receipt {
+	return predicate.Receipt(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Receipt) predicate.Receipt {
+	return predicate.Receipt(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Receipt) predicate.Receipt {
+	return predicate.Receipt(sql.NotPredicates(p))
+}
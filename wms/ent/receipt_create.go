@@ -0,0 +1,304 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/orderline"
+	"github.com/mxV03/wms/ent/receipt"
+)
+
+// ReceiptCreate is the builder for creating a Receipt entity.
+type ReceiptCreate struct {
+	config
+	mutation *ReceiptMutation
+	hooks    []Hook
+}
+
+// SetQuantity sets the "quantity" field.
+func (_c *ReceiptCreate) SetQuantity(v int) *ReceiptCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetDamaged sets the "damaged" field.
+func (_c *ReceiptCreate) SetDamaged(v int) *ReceiptCreate {
+	_c.mutation.SetDamaged(v)
+	return _c
+}
+
+// SetNillableDamaged sets the "damaged" field if the given value is not nil.
+func (_c *ReceiptCreate) SetNillableDamaged(v *int) *ReceiptCreate {
+	if v != nil {
+		_c.SetDamaged(*v)
+	}
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *ReceiptCreate) SetNote(v string) *ReceiptCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *ReceiptCreate) SetNillableNote(v *string) *ReceiptCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ReceiptCreate) SetCreatedAt(v time.Time) *ReceiptCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ReceiptCreate) SetNillableCreatedAt(v *time.Time) *ReceiptCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetOrderLineID sets the "order_line" edge to the OrderLine entity by ID.
+func (_c *ReceiptCreate) SetOrderLineID(id int) *ReceiptCreate {
+	_c.mutation.SetOrderLineID(id)
+	return _c
+}
+
+// SetOrderLine sets the "order_line" edge to the OrderLine entity.
+func (_c *ReceiptCreate) SetOrderLine(v *OrderLine) *ReceiptCreate {
+	return _c.SetOrderLineID(v.ID)
+}
+
+// Mutation returns the ReceiptMutation object of the builder.
+func (_c *ReceiptCreate) Mutation() *ReceiptMutation {
+	return _c.mutation
+}
+
+// Save creates the Receipt in the database.
+func (_c *ReceiptCreate) Save(ctx context.Context) (*Receipt, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ReceiptCreate) SaveX(ctx context.Context) *Receipt {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReceiptCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReceiptCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ReceiptCreate) defaults() {
+	if _, ok := _c.mutation.Damaged(); !ok {
+		v := receipt.DefaultDamaged
+		_c.mutation.SetDamaged(v)
+	}
+	if _, ok := _c.mutation.Note(); !ok {
+		v := receipt.DefaultNote
+		_c.mutation.SetNote(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := receipt.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ReceiptCreate) check() error {
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "Receipt.quantity"`)}
+	}
+	if v, ok := _c.mutation.Quantity(); ok {
+		if err := receipt.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "Receipt.quantity": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Damaged(); !ok {
+		return &ValidationError{Name: "damaged", err: errors.New(`ent: missing required field "Receipt.damaged"`)}
+	}
+	if v, ok := _c.mutation.Damaged(); ok {
+		if err := receipt.DamagedValidator(v); err != nil {
+			return &ValidationError{Name: "damaged", err: fmt.Errorf(`ent: validator failed for field "Receipt.damaged": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Receipt.created_at"`)}
+	}
+	if len(_c.mutation.OrderLineIDs()) == 0 {
+		return &ValidationError{Name: "order_line", err: errors.New(`ent: missing required edge "Receipt.order_line"`)}
+	}
+	return nil
+}
+
+func (_c *ReceiptCreate) sqlSave(ctx context.Context) (*Receipt, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ReceiptCreate) createSpec() (*Receipt, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Receipt{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(receipt.Table, sqlgraph.NewFieldSpec(receipt.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(receipt.FieldQuantity, field.TypeInt, value)
+		_node.Quantity = value
+	}
+	if value, ok := _c.mutation.Damaged(); ok {
+		_spec.SetField(receipt.FieldDamaged, field.TypeInt, value)
+		_node.Damaged = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(receipt.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(receipt.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.OrderLineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   receipt.OrderLineTable,
+			Columns: []string{receipt.OrderLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.order_line_receipts = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ReceiptCreateBulk is the builder for creating many Receipt entities in bulk.
+type ReceiptCreateBulk struct {
+	config
+	err      error
+	builders []*ReceiptCreate
+}
+
+// Save creates the Receipt entities in the database.
+func (_c *ReceiptCreateBulk) Save(ctx context.Context) ([]*Receipt, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Receipt, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReceiptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ReceiptCreateBulk) SaveX(ctx context.Context) []*Receipt {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReceiptCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReceiptCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
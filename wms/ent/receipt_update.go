@@ -0,0 +1,505 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/orderline"
+	"github.com/mxV03/wms/ent/predicate"
+	"github.com/mxV03/wms/ent/receipt"
+)
+
+// ReceiptUpdate is the builder for updating Receipt entities.
+type ReceiptUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReceiptMutation
+}
+
+// Where appends a list predicates to the ReceiptUpdate builder.
+func (_u *ReceiptUpdate) Where(ps ...predicate.Receipt) *ReceiptUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *ReceiptUpdate) SetQuantity(v int) *ReceiptUpdate {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *ReceiptUpdate) SetNillableQuantity(v *int) *ReceiptUpdate {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *ReceiptUpdate) AddQuantity(v int) *ReceiptUpdate {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetDamaged sets the "damaged" field.
+func (_u *ReceiptUpdate) SetDamaged(v int) *ReceiptUpdate {
+	_u.mutation.ResetDamaged()
+	_u.mutation.SetDamaged(v)
+	return _u
+}
+
+// SetNillableDamaged sets the "damaged" field if the given value is not nil.
+func (_u *ReceiptUpdate) SetNillableDamaged(v *int) *ReceiptUpdate {
+	if v != nil {
+		_u.SetDamaged(*v)
+	}
+	return _u
+}
+
+// AddDamaged adds value to the "damaged" field.
+func (_u *ReceiptUpdate) AddDamaged(v int) *ReceiptUpdate {
+	_u.mutation.AddDamaged(v)
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *ReceiptUpdate) SetNote(v string) *ReceiptUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *ReceiptUpdate) SetNillableNote(v *string) *ReceiptUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *ReceiptUpdate) ClearNote() *ReceiptUpdate {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ReceiptUpdate) SetCreatedAt(v time.Time) *ReceiptUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ReceiptUpdate) SetNillableCreatedAt(v *time.Time) *ReceiptUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetOrderLineID sets the "order_line" edge to the OrderLine entity by ID.
+func (_u *ReceiptUpdate) SetOrderLineID(id int) *ReceiptUpdate {
+	_u.mutation.SetOrderLineID(id)
+	return _u
+}
+
+// SetOrderLine sets the "order_line" edge to the OrderLine entity.
+func (_u *ReceiptUpdate) SetOrderLine(v *OrderLine) *ReceiptUpdate {
+	return _u.SetOrderLineID(v.ID)
+}
+
+// Mutation returns the ReceiptMutation object of the builder.
+func (_u *ReceiptUpdate) Mutation() *ReceiptMutation {
+	return _u.mutation
+}
+
+// ClearOrderLine clears the "order_line" edge to the OrderLine entity.
+func (_u *ReceiptUpdate) ClearOrderLine() *ReceiptUpdate {
+	_u.mutation.ClearOrderLine()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ReceiptUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReceiptUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ReceiptUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReceiptUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReceiptUpdate) check() error {
+	if v, ok := _u.mutation.Quantity(); ok {
+		if err := receipt.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "Receipt.quantity": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Damaged(); ok {
+		if err := receipt.DamagedValidator(v); err != nil {
+			return &ValidationError{Name: "damaged", err: fmt.Errorf(`ent: validator failed for field "Receipt.damaged": %w`, err)}
+		}
+	}
+	if _u.mutation.OrderLineCleared() && len(_u.mutation.OrderLineIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Receipt.order_line"`)
+	}
+	return nil
+}
+
+func (_u *ReceiptUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(receipt.Table, receipt.Columns, sqlgraph.NewFieldSpec(receipt.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(receipt.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(receipt.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Damaged(); ok {
+		_spec.SetField(receipt.FieldDamaged, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDamaged(); ok {
+		_spec.AddField(receipt.FieldDamaged, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(receipt.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(receipt.FieldNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(receipt.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.OrderLineCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   receipt.OrderLineTable,
+			Columns: []string{receipt.OrderLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrderLineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   receipt.OrderLineTable,
+			Columns: []string{receipt.OrderLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{receipt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ReceiptUpdateOne is the builder for updating a single Receipt entity.
+type ReceiptUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReceiptMutation
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *ReceiptUpdateOne) SetQuantity(v int) *ReceiptUpdateOne {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *ReceiptUpdateOne) SetNillableQuantity(v *int) *ReceiptUpdateOne {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *ReceiptUpdateOne) AddQuantity(v int) *ReceiptUpdateOne {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetDamaged sets the "damaged" field.
+func (_u *ReceiptUpdateOne) SetDamaged(v int) *ReceiptUpdateOne {
+	_u.mutation.ResetDamaged()
+	_u.mutation.SetDamaged(v)
+	return _u
+}
+
+// SetNillableDamaged sets the "damaged" field if the given value is not nil.
+func (_u *ReceiptUpdateOne) SetNillableDamaged(v *int) *ReceiptUpdateOne {
+	if v != nil {
+		_u.SetDamaged(*v)
+	}
+	return _u
+}
+
+// AddDamaged adds value to the "damaged" field.
+func (_u *ReceiptUpdateOne) AddDamaged(v int) *ReceiptUpdateOne {
+	_u.mutation.AddDamaged(v)
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *ReceiptUpdateOne) SetNote(v string) *ReceiptUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *ReceiptUpdateOne) SetNillableNote(v *string) *ReceiptUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *ReceiptUpdateOne) ClearNote() *ReceiptUpdateOne {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ReceiptUpdateOne) SetCreatedAt(v time.Time) *ReceiptUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ReceiptUpdateOne) SetNillableCreatedAt(v *time.Time) *ReceiptUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetOrderLineID sets the "order_line" edge to the OrderLine entity by ID.
+func (_u *ReceiptUpdateOne) SetOrderLineID(id int) *ReceiptUpdateOne {
+	_u.mutation.SetOrderLineID(id)
+	return _u
+}
+
+// SetOrderLine sets the "order_line" edge to the OrderLine entity.
+func (_u *ReceiptUpdateOne) SetOrderLine(v *OrderLine) *ReceiptUpdateOne {
+	return _u.SetOrderLineID(v.ID)
+}
+
+// Mutation returns the ReceiptMutation object of the builder.
+func (_u *ReceiptUpdateOne) Mutation() *ReceiptMutation {
+	return _u.mutation
+}
+
+// ClearOrderLine clears the "order_line" edge to the OrderLine entity.
+func (_u *ReceiptUpdateOne) ClearOrderLine() *ReceiptUpdateOne {
+	_u.mutation.ClearOrderLine()
+	return _u
+}
+
+// Where appends a list predicates to the ReceiptUpdate builder.
+func (_u *ReceiptUpdateOne) Where(ps ...predicate.Receipt) *ReceiptUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ReceiptUpdateOne) Select(field string, fields ...string) *ReceiptUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Receipt entity.
+func (_u *ReceiptUpdateOne) Save(ctx context.Context) (*Receipt, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReceiptUpdateOne) SaveX(ctx context.Context) *Receipt {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ReceiptUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReceiptUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReceiptUpdateOne) check() error {
+	if v, ok := _u.mutation.Quantity(); ok {
+		if err := receipt.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "Receipt.quantity": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Damaged(); ok {
+		if err := receipt.DamagedValidator(v); err != nil {
+			return &ValidationError{Name: "damaged", err: fmt.Errorf(`ent: validator failed for field "Receipt.damaged": %w`, err)}
+		}
+	}
+	if _u.mutation.OrderLineCleared() && len(_u.mutation.OrderLineIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Receipt.order_line"`)
+	}
+	return nil
+}
+
+func (_u *ReceiptUpdateOne) sqlSave(ctx context.Context) (_node *Receipt, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(receipt.Table, receipt.Columns, sqlgraph.NewFieldSpec(receipt.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Receipt.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, receipt.FieldID)
+		for _, f := range fields {
+			if !receipt.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != receipt.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(receipt.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(receipt.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Damaged(); ok {
+		_spec.SetField(receipt.FieldDamaged, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDamaged(); ok {
+		_spec.AddField(receipt.FieldDamaged, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(receipt.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(receipt.FieldNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(receipt.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.OrderLineCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   receipt.OrderLineTable,
+			Columns: []string{receipt.OrderLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrderLineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   receipt.OrderLineTable,
+			Columns: []string{receipt.OrderLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Receipt{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{receipt.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,216 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mxV03/wms/ent/item"
+	"github.com/mxV03/wms/ent/location"
+	"github.com/mxV03/wms/ent/reorderpolicy"
+)
+
+// ReorderPolicy is the model entity for the ReorderPolicy schema.
+type ReorderPolicy struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ReorderPoint holds the value of the "reorder_point" field.
+	ReorderPoint int `json:"reorder_point,omitempty"`
+	// SafetyStock holds the value of the "safety_stock" field.
+	SafetyStock int `json:"safety_stock,omitempty"`
+	// ReorderQuantity holds the value of the "reorder_quantity" field.
+	ReorderQuantity int `json:"reorder_quantity,omitempty"`
+	// LeadTimeDays holds the value of the "lead_time_days" field.
+	LeadTimeDays int `json:"lead_time_days,omitempty"`
+	// ItemID holds the value of the "item_id" field.
+	ItemID int `json:"item_id,omitempty"`
+	// Supplier holds the value of the "supplier" field.
+	Supplier string `json:"supplier,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReorderPolicyQuery when eager-loading is set.
+	Edges                   ReorderPolicyEdges `json:"edges"`
+	reorder_policy_location *int
+	selectValues            sql.SelectValues
+}
+
+// ReorderPolicyEdges holds the relations/edges for other nodes in the graph.
+type ReorderPolicyEdges struct {
+	// Item holds the value of the item edge.
+	Item *Item `json:"item,omitempty"`
+	// Location holds the value of the location edge.
+	Location *Location `json:"location,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ItemOrErr returns the Item value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReorderPolicyEdges) ItemOrErr() (*Item, error) {
+	if e.Item != nil {
+		return e.Item, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: item.Label}
+	}
+	return nil, &NotLoadedError{edge: "item"}
+}
+
+// LocationOrErr returns the Location value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReorderPolicyEdges) LocationOrErr() (*Location, error) {
+	if e.Location != nil {
+		return e.Location, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: location.Label}
+	}
+	return nil, &NotLoadedError{edge: "location"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReorderPolicy) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case reorderpolicy.FieldID, reorderpolicy.FieldReorderPoint, reorderpolicy.FieldSafetyStock, reorderpolicy.FieldReorderQuantity, reorderpolicy.FieldLeadTimeDays, reorderpolicy.FieldItemID:
+			values[i] = new(sql.NullInt64)
+		case reorderpolicy.FieldSupplier:
+			values[i] = new(sql.NullString)
+		case reorderpolicy.ForeignKeys[0]: // reorder_policy_location
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReorderPolicy fields.
+func (_m *ReorderPolicy) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case reorderpolicy.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case reorderpolicy.FieldReorderPoint:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reorder_point", values[i])
+			} else if value.Valid {
+				_m.ReorderPoint = int(value.Int64)
+			}
+		case reorderpolicy.FieldSafetyStock:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field safety_stock", values[i])
+			} else if value.Valid {
+				_m.SafetyStock = int(value.Int64)
+			}
+		case reorderpolicy.FieldReorderQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reorder_quantity", values[i])
+			} else if value.Valid {
+				_m.ReorderQuantity = int(value.Int64)
+			}
+		case reorderpolicy.FieldLeadTimeDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field lead_time_days", values[i])
+			} else if value.Valid {
+				_m.LeadTimeDays = int(value.Int64)
+			}
+		case reorderpolicy.FieldItemID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field item_id", values[i])
+			} else if value.Valid {
+				_m.ItemID = int(value.Int64)
+			}
+		case reorderpolicy.FieldSupplier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field supplier", values[i])
+			} else if value.Valid {
+				_m.Supplier = value.String
+			}
+		case reorderpolicy.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field reorder_policy_location", value)
+			} else if value.Valid {
+				_m.reorder_policy_location = new(int)
+				*_m.reorder_policy_location = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ReorderPolicy.
+// This includes values selected through modifiers, order, etc.
+func (_m *ReorderPolicy) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryItem queries the "item" edge of the ReorderPolicy entity.
+func (_m *ReorderPolicy) QueryItem() *ItemQuery {
+	return NewReorderPolicyClient(_m.config).QueryItem(_m)
+}
+
+// QueryLocation queries the "location" edge of the ReorderPolicy entity.
+func (_m *ReorderPolicy) QueryLocation() *LocationQuery {
+	return NewReorderPolicyClient(_m.config).QueryLocation(_m)
+}
+
+// Update returns a builder for updating this ReorderPolicy.
+// Note that you need to call ReorderPolicy.Unwrap() before calling this method if this ReorderPolicy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ReorderPolicy) Update() *ReorderPolicyUpdateOne {
+	return NewReorderPolicyClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ReorderPolicy entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ReorderPolicy) Unwrap() *ReorderPolicy {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReorderPolicy is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ReorderPolicy) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReorderPolicy(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("reorder_point=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReorderPoint))
+	builder.WriteString(", ")
+	builder.WriteString("safety_stock=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SafetyStock))
+	builder.WriteString(", ")
+	builder.WriteString("reorder_quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReorderQuantity))
+	builder.WriteString(", ")
+	builder.WriteString("lead_time_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LeadTimeDays))
+	builder.WriteString(", ")
+	builder.WriteString("item_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ItemID))
+	builder.WriteString(", ")
+	builder.WriteString("supplier=")
+	builder.WriteString(_m.Supplier)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReorderPolicies is a parsable slice of ReorderPolicy.
+type ReorderPolicies []*ReorderPolicy
@@ -0,0 +1,162 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reorderpolicy
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the reorderpolicy type in the database.
+	Label = "reorder_policy"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldReorderPoint holds the string denoting the reorder_point field in the database.
+	FieldReorderPoint = "reorder_point"
+	// FieldSafetyStock holds the string denoting the safety_stock field in the database.
+	FieldSafetyStock = "safety_stock"
+	// FieldReorderQuantity holds the string denoting the reorder_quantity field in the database.
+	FieldReorderQuantity = "reorder_quantity"
+	// FieldLeadTimeDays holds the string denoting the lead_time_days field in the database.
+	FieldLeadTimeDays = "lead_time_days"
+	// FieldItemID holds the string denoting the item_id field in the database.
+	FieldItemID = "item_id"
+	// FieldSupplier holds the string denoting the supplier field in the database.
+	FieldSupplier = "supplier"
+	// EdgeItem holds the string denoting the item edge name in mutations.
+	EdgeItem = "item"
+	// EdgeLocation holds the string denoting the location edge name in mutations.
+	EdgeLocation = "location"
+	// Table holds the table name of the reorderpolicy in the database.
+	Table = "reorder_policies"
+	// ItemTable is the table that holds the item relation/edge.
+	ItemTable = "reorder_policies"
+	// ItemInverseTable is the table name for the Item entity.
+	// It exists in this package in order to avoid circular dependency with the "item" package.
+	ItemInverseTable = "items"
+	// ItemColumn is the table column denoting the item relation/edge.
+	ItemColumn = "item_id"
+	// LocationTable is the table that holds the location relation/edge.
+	LocationTable = "reorder_policies"
+	// LocationInverseTable is the table name for the Location entity.
+	// It exists in this package in order to avoid circular dependency with the "location" package.
+	LocationInverseTable = "locations"
+	// LocationColumn is the table column denoting the location relation/edge.
+	LocationColumn = "reorder_policy_location"
+)
+
+// Columns holds all SQL columns for reorderpolicy fields.
+var Columns = []string{
+	FieldID,
+	FieldReorderPoint,
+	FieldSafetyStock,
+	FieldReorderQuantity,
+	FieldLeadTimeDays,
+	FieldItemID,
+	FieldSupplier,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "reorder_policies"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"reorder_policy_location",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ReorderPointValidator is a validator for the "reorder_point" field. It is called by the builders before save.
+	ReorderPointValidator func(int) error
+	// DefaultSafetyStock holds the default value on creation for the "safety_stock" field.
+	DefaultSafetyStock int
+	// SafetyStockValidator is a validator for the "safety_stock" field. It is called by the builders before save.
+	SafetyStockValidator func(int) error
+	// ReorderQuantityValidator is a validator for the "reorder_quantity" field. It is called by the builders before save.
+	ReorderQuantityValidator func(int) error
+	// DefaultLeadTimeDays holds the default value on creation for the "lead_time_days" field.
+	DefaultLeadTimeDays int
+	// LeadTimeDaysValidator is a validator for the "lead_time_days" field. It is called by the builders before save.
+	LeadTimeDaysValidator func(int) error
+	// DefaultSupplier holds the default value on creation for the "supplier" field.
+	DefaultSupplier string
+)
+
+// OrderOption defines the ordering options for the ReorderPolicy queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByReorderPoint orders the results by the reorder_point field.
+func ByReorderPoint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReorderPoint, opts...).ToFunc()
+}
+
+// BySafetyStock orders the results by the safety_stock field.
+func BySafetyStock(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSafetyStock, opts...).ToFunc()
+}
+
+// ByReorderQuantity orders the results by the reorder_quantity field.
+func ByReorderQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReorderQuantity, opts...).ToFunc()
+}
+
+// ByLeadTimeDays orders the results by the lead_time_days field.
+func ByLeadTimeDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLeadTimeDays, opts...).ToFunc()
+}
+
+// ByItemID orders the results by the item_id field.
+func ByItemID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldItemID, opts...).ToFunc()
+}
+
+// BySupplier orders the results by the supplier field.
+func BySupplier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSupplier, opts...).ToFunc()
+}
+
+// ByItemField orders the results by item field.
+func ByItemField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newItemStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByLocationField orders the results by location field.
+func ByLocationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLocationStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newItemStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ItemInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ItemTable, ItemColumn),
+	)
+}
+func newLocationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LocationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, LocationTable, LocationColumn),
+	)
+}
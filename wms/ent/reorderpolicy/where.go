@@ -0,0 +1,400 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reorderpolicy
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/mxV03/wms/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldLTE(FieldID, id))
+}
+
+// ReorderPoint applies equality check predicate on the "reorder_point" field. It's identical to ReorderPointEQ.
+func ReorderPoint(v int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldEQ(FieldReorderPoint, v))
+}
+
+// SafetyStock applies equality check predicate on the "safety_stock" field. It's identical to SafetyStockEQ.
+func SafetyStock(v int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldEQ(FieldSafetyStock, v))
+}
+
+// ReorderQuantity applies equality check predicate on the "reorder_quantity" field. It's identical to ReorderQuantityEQ.
+func ReorderQuantity(v int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldEQ(FieldReorderQuantity, v))
+}
+
+// LeadTimeDays applies equality check predicate on the "lead_time_days" field. It's identical to LeadTimeDaysEQ.
+func LeadTimeDays(v int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldEQ(FieldLeadTimeDays, v))
+}
+
+// ItemID applies equality check predicate on the "item_id" field. It's identical to ItemIDEQ.
+func ItemID(v int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldEQ(FieldItemID, v))
+}
+
+// Supplier applies equality check predicate on the "supplier" field. It's identical to SupplierEQ.
+func Supplier(v string) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldEQ(FieldSupplier, v))
+}
+
+// ReorderPointEQ applies the EQ predicate on the "reorder_point" field.
+func ReorderPointEQ(v int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldEQ(FieldReorderPoint, v))
+}
+
+// ReorderPointNEQ applies the NEQ predicate on the "reorder_point" field.
+func ReorderPointNEQ(v int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldNEQ(FieldReorderPoint, v))
+}
+
+// ReorderPointIn applies the In predicate on the "reorder_point" field.
+func ReorderPointIn(vs ...int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldIn(FieldReorderPoint, vs...))
+}
+
+// ReorderPointNotIn applies the NotIn predicate on the "reorder_point" field.
+func ReorderPointNotIn(vs ...int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldNotIn(FieldReorderPoint, vs...))
+}
+
+// ReorderPointGT applies the GT predicate on the "reorder_point" field.
+func ReorderPointGT(v int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldGT(FieldReorderPoint, v))
+}
+
+// ReorderPointGTE applies the GTE predicate on the "reorder_point" field.
+func ReorderPointGTE(v int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldGTE(FieldReorderPoint, v))
+}
+
+// ReorderPointLT applies the LT predicate on the "reorder_point" field.
+func ReorderPointLT(v int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldLT(FieldReorderPoint, v))
+}
+
+// ReorderPointLTE applies the LTE predicate on the "reorder_point" field.
+func ReorderPointLTE(v int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldLTE(FieldReorderPoint, v))
+}
+
+// SafetyStockEQ applies the EQ predicate on the "safety_stock" field.
+func SafetyStockEQ(v int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldEQ(FieldSafetyStock, v))
+}
+
+// SafetyStockNEQ applies the NEQ predicate on the "safety_stock" field.
+func SafetyStockNEQ(v int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldNEQ(FieldSafetyStock, v))
+}
+
+// SafetyStockIn applies the In predicate on the "safety_stock" field.
+func SafetyStockIn(vs ...int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldIn(FieldSafetyStock, vs...))
+}
+
+// SafetyStockNotIn applies the NotIn predicate on the "safety_stock" field.
+func SafetyStockNotIn(vs ...int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldNotIn(FieldSafetyStock, vs...))
+}
+
+// SafetyStockGT applies the GT predicate on the "safety_stock" field.
+func SafetyStockGT(v int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldGT(FieldSafetyStock, v))
+}
+
+// SafetyStockGTE applies the GTE predicate on the "safety_stock" field.
+func SafetyStockGTE(v int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldGTE(FieldSafetyStock, v))
+}
+
+// SafetyStockLT applies the LT predicate on the "safety_stock" field.
+func SafetyStockLT(v int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldLT(FieldSafetyStock, v))
+}
+
+// SafetyStockLTE applies the LTE predicate on the "safety_stock" field.
+func SafetyStockLTE(v int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldLTE(FieldSafetyStock, v))
+}
+
+// ReorderQuantityEQ applies the EQ predicate on the "reorder_quantity" field.
+func ReorderQuantityEQ(v int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldEQ(FieldReorderQuantity, v))
+}
+
+// ReorderQuantityNEQ applies the NEQ predicate on the "reorder_quantity" field.
+func ReorderQuantityNEQ(v int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldNEQ(FieldReorderQuantity, v))
+}
+
+// ReorderQuantityIn applies the In predicate on the "reorder_quantity" field.
+func ReorderQuantityIn(vs ...int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldIn(FieldReorderQuantity, vs...))
+}
+
+// ReorderQuantityNotIn applies the NotIn predicate on the "reorder_quantity" field.
+func ReorderQuantityNotIn(vs ...int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldNotIn(FieldReorderQuantity, vs...))
+}
+
+// ReorderQuantityGT applies the GT predicate on the "reorder_quantity" field.
+func ReorderQuantityGT(v int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldGT(FieldReorderQuantity, v))
+}
+
+// ReorderQuantityGTE applies the GTE predicate on the "reorder_quantity" field.
+func ReorderQuantityGTE(v int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldGTE(FieldReorderQuantity, v))
+}
+
+// ReorderQuantityLT applies the LT predicate on the "reorder_quantity" field.
+func ReorderQuantityLT(v int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldLT(FieldReorderQuantity, v))
+}
+
+// ReorderQuantityLTE applies the LTE predicate on the "reorder_quantity" field.
+func ReorderQuantityLTE(v int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldLTE(FieldReorderQuantity, v))
+}
+
+// LeadTimeDaysEQ applies the EQ predicate on the "lead_time_days" field.
+func LeadTimeDaysEQ(v int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldEQ(FieldLeadTimeDays, v))
+}
+
+// LeadTimeDaysNEQ applies the NEQ predicate on the "lead_time_days" field.
+func LeadTimeDaysNEQ(v int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldNEQ(FieldLeadTimeDays, v))
+}
+
+// LeadTimeDaysIn applies the In predicate on the "lead_time_days" field.
+func LeadTimeDaysIn(vs ...int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldIn(FieldLeadTimeDays, vs...))
+}
+
+// LeadTimeDaysNotIn applies the NotIn predicate on the "lead_time_days" field.
+func LeadTimeDaysNotIn(vs ...int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldNotIn(FieldLeadTimeDays, vs...))
+}
+
+// LeadTimeDaysGT applies the GT predicate on the "lead_time_days" field.
+func LeadTimeDaysGT(v int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldGT(FieldLeadTimeDays, v))
+}
+
+// LeadTimeDaysGTE applies the GTE predicate on the "lead_time_days" field.
+func LeadTimeDaysGTE(v int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldGTE(FieldLeadTimeDays, v))
+}
+
+// LeadTimeDaysLT applies the LT predicate on the "lead_time_days" field.
+func LeadTimeDaysLT(v int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldLT(FieldLeadTimeDays, v))
+}
+
+// LeadTimeDaysLTE applies the LTE predicate on the "lead_time_days" field.
+func LeadTimeDaysLTE(v int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldLTE(FieldLeadTimeDays, v))
+}
+
+// ItemIDEQ applies the EQ predicate on the "item_id" field.
+func ItemIDEQ(v int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldEQ(FieldItemID, v))
+}
+
+// ItemIDNEQ applies the NEQ predicate on the "item_id" field.
+func ItemIDNEQ(v int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldNEQ(FieldItemID, v))
+}
+
+// ItemIDIn applies the In predicate on the "item_id" field.
+func ItemIDIn(vs ...int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldIn(FieldItemID, vs...))
+}
+
+// ItemIDNotIn applies the NotIn predicate on the "item_id" field.
+func ItemIDNotIn(vs ...int) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldNotIn(FieldItemID, vs...))
+}
+
+// SupplierEQ applies the EQ predicate on the "supplier" field.
+func SupplierEQ(v string) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldEQ(FieldSupplier, v))
+}
+
+// SupplierNEQ applies the NEQ predicate on the "supplier" field.
+func SupplierNEQ(v string) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldNEQ(FieldSupplier, v))
+}
+
+// SupplierIn applies the In predicate on the "supplier" field.
+func SupplierIn(vs ...string) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldIn(FieldSupplier, vs...))
+}
+
+// SupplierNotIn applies the NotIn predicate on the "supplier" field.
+func SupplierNotIn(vs ...string) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldNotIn(FieldSupplier, vs...))
+}
+
+// SupplierGT applies the GT predicate on the "supplier" field.
+func SupplierGT(v string) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldGT(FieldSupplier, v))
+}
+
+// SupplierGTE applies the GTE predicate on the "supplier" field.
+func SupplierGTE(v string) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldGTE(FieldSupplier, v))
+}
+
+// SupplierLT applies the LT predicate on the "supplier" field.
+func SupplierLT(v string) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldLT(FieldSupplier, v))
+}
+
+// SupplierLTE applies the LTE predicate on the "supplier" field.
+func SupplierLTE(v string) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldLTE(FieldSupplier, v))
+}
+
+// SupplierContains applies the Contains predicate on the "supplier" field.
+func SupplierContains(v string) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldContains(FieldSupplier, v))
+}
+
+// SupplierHasPrefix applies the HasPrefix predicate on the "supplier" field.
+func SupplierHasPrefix(v string) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldHasPrefix(FieldSupplier, v))
+}
+
+// SupplierHasSuffix applies the HasSuffix predicate on the "supplier" field.
+func SupplierHasSuffix(v string) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldHasSuffix(FieldSupplier, v))
+}
+
+// SupplierIsNil applies the IsNil predicate on the "supplier" field.
+func SupplierIsNil() predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldIsNull(FieldSupplier))
+}
+
+// SupplierNotNil applies the NotNil predicate on the "supplier" field.
+func SupplierNotNil() predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldNotNull(FieldSupplier))
+}
+
+// SupplierEqualFold applies the EqualFold predicate on the "supplier" field.
+func SupplierEqualFold(v string) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldEqualFold(FieldSupplier, v))
+}
+
+// SupplierContainsFold applies the ContainsFold predicate on the "supplier" field.
+func SupplierContainsFold(v string) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.FieldContainsFold(FieldSupplier, v))
+}
+
+// HasItem applies the HasEdge predicate on the "item" edge.
+func HasItem() predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ItemTable, ItemColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemWith applies the HasEdge predicate on the "item" edge with a given conditions (other predicates).
+func HasItemWith(preds ...predicate.Item) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(func(s *sql.Selector) {
+		step := newItemStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLocation applies the HasEdge predicate on the "location" edge.
+func HasLocation() predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, LocationTable, LocationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLocationWith applies the HasEdge predicate on the "location" edge with a given conditions (other predicates).
+func HasLocationWith(preds ...predicate.Location) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(func(s *sql.Selector) {
+		step := newLocationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReorderPolicy) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReorderPolicy) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReorderPolicy) predicate.ReorderPolicy {
+	return predicate.ReorderPolicy(sql.NotPredicates(p))
+}
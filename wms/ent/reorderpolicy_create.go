@@ -0,0 +1,361 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/item"
+	"github.com/mxV03/wms/ent/location"
+	"github.com/mxV03/wms/ent/reorderpolicy"
+)
+
+// ReorderPolicyCreate is the builder for creating a ReorderPolicy entity.
+type ReorderPolicyCreate struct {
+	config
+	mutation *ReorderPolicyMutation
+	hooks    []Hook
+}
+
+// SetReorderPoint sets the "reorder_point" field.
+func (_c *ReorderPolicyCreate) SetReorderPoint(v int) *ReorderPolicyCreate {
+	_c.mutation.SetReorderPoint(v)
+	return _c
+}
+
+// SetSafetyStock sets the "safety_stock" field.
+func (_c *ReorderPolicyCreate) SetSafetyStock(v int) *ReorderPolicyCreate {
+	_c.mutation.SetSafetyStock(v)
+	return _c
+}
+
+// SetNillableSafetyStock sets the "safety_stock" field if the given value is not nil.
+func (_c *ReorderPolicyCreate) SetNillableSafetyStock(v *int) *ReorderPolicyCreate {
+	if v != nil {
+		_c.SetSafetyStock(*v)
+	}
+	return _c
+}
+
+// SetReorderQuantity sets the "reorder_quantity" field.
+func (_c *ReorderPolicyCreate) SetReorderQuantity(v int) *ReorderPolicyCreate {
+	_c.mutation.SetReorderQuantity(v)
+	return _c
+}
+
+// SetLeadTimeDays sets the "lead_time_days" field.
+func (_c *ReorderPolicyCreate) SetLeadTimeDays(v int) *ReorderPolicyCreate {
+	_c.mutation.SetLeadTimeDays(v)
+	return _c
+}
+
+// SetNillableLeadTimeDays sets the "lead_time_days" field if the given value is not nil.
+func (_c *ReorderPolicyCreate) SetNillableLeadTimeDays(v *int) *ReorderPolicyCreate {
+	if v != nil {
+		_c.SetLeadTimeDays(*v)
+	}
+	return _c
+}
+
+// SetItemID sets the "item_id" field.
+func (_c *ReorderPolicyCreate) SetItemID(v int) *ReorderPolicyCreate {
+	_c.mutation.SetItemID(v)
+	return _c
+}
+
+// SetSupplier sets the "supplier" field.
+func (_c *ReorderPolicyCreate) SetSupplier(v string) *ReorderPolicyCreate {
+	_c.mutation.SetSupplier(v)
+	return _c
+}
+
+// SetNillableSupplier sets the "supplier" field if the given value is not nil.
+func (_c *ReorderPolicyCreate) SetNillableSupplier(v *string) *ReorderPolicyCreate {
+	if v != nil {
+		_c.SetSupplier(*v)
+	}
+	return _c
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_c *ReorderPolicyCreate) SetItem(v *Item) *ReorderPolicyCreate {
+	return _c.SetItemID(v.ID)
+}
+
+// SetLocationID sets the "location" edge to the Location entity by ID.
+func (_c *ReorderPolicyCreate) SetLocationID(id int) *ReorderPolicyCreate {
+	_c.mutation.SetLocationID(id)
+	return _c
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (_c *ReorderPolicyCreate) SetLocation(v *Location) *ReorderPolicyCreate {
+	return _c.SetLocationID(v.ID)
+}
+
+// Mutation returns the ReorderPolicyMutation object of the builder.
+func (_c *ReorderPolicyCreate) Mutation() *ReorderPolicyMutation {
+	return _c.mutation
+}
+
+// Save creates the ReorderPolicy in the database.
+func (_c *ReorderPolicyCreate) Save(ctx context.Context) (*ReorderPolicy, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ReorderPolicyCreate) SaveX(ctx context.Context) *ReorderPolicy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReorderPolicyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReorderPolicyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ReorderPolicyCreate) defaults() {
+	if _, ok := _c.mutation.SafetyStock(); !ok {
+		v := reorderpolicy.DefaultSafetyStock
+		_c.mutation.SetSafetyStock(v)
+	}
+	if _, ok := _c.mutation.LeadTimeDays(); !ok {
+		v := reorderpolicy.DefaultLeadTimeDays
+		_c.mutation.SetLeadTimeDays(v)
+	}
+	if _, ok := _c.mutation.Supplier(); !ok {
+		v := reorderpolicy.DefaultSupplier
+		_c.mutation.SetSupplier(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ReorderPolicyCreate) check() error {
+	if _, ok := _c.mutation.ReorderPoint(); !ok {
+		return &ValidationError{Name: "reorder_point", err: errors.New(`ent: missing required field "ReorderPolicy.reorder_point"`)}
+	}
+	if v, ok := _c.mutation.ReorderPoint(); ok {
+		if err := reorderpolicy.ReorderPointValidator(v); err != nil {
+			return &ValidationError{Name: "reorder_point", err: fmt.Errorf(`ent: validator failed for field "ReorderPolicy.reorder_point": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SafetyStock(); !ok {
+		return &ValidationError{Name: "safety_stock", err: errors.New(`ent: missing required field "ReorderPolicy.safety_stock"`)}
+	}
+	if v, ok := _c.mutation.SafetyStock(); ok {
+		if err := reorderpolicy.SafetyStockValidator(v); err != nil {
+			return &ValidationError{Name: "safety_stock", err: fmt.Errorf(`ent: validator failed for field "ReorderPolicy.safety_stock": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ReorderQuantity(); !ok {
+		return &ValidationError{Name: "reorder_quantity", err: errors.New(`ent: missing required field "ReorderPolicy.reorder_quantity"`)}
+	}
+	if v, ok := _c.mutation.ReorderQuantity(); ok {
+		if err := reorderpolicy.ReorderQuantityValidator(v); err != nil {
+			return &ValidationError{Name: "reorder_quantity", err: fmt.Errorf(`ent: validator failed for field "ReorderPolicy.reorder_quantity": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LeadTimeDays(); !ok {
+		return &ValidationError{Name: "lead_time_days", err: errors.New(`ent: missing required field "ReorderPolicy.lead_time_days"`)}
+	}
+	if v, ok := _c.mutation.LeadTimeDays(); ok {
+		if err := reorderpolicy.LeadTimeDaysValidator(v); err != nil {
+			return &ValidationError{Name: "lead_time_days", err: fmt.Errorf(`ent: validator failed for field "ReorderPolicy.lead_time_days": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ItemID(); !ok {
+		return &ValidationError{Name: "item_id", err: errors.New(`ent: missing required field "ReorderPolicy.item_id"`)}
+	}
+	if len(_c.mutation.ItemIDs()) == 0 {
+		return &ValidationError{Name: "item", err: errors.New(`ent: missing required edge "ReorderPolicy.item"`)}
+	}
+	if len(_c.mutation.LocationIDs()) == 0 {
+		return &ValidationError{Name: "location", err: errors.New(`ent: missing required edge "ReorderPolicy.location"`)}
+	}
+	return nil
+}
+
+func (_c *ReorderPolicyCreate) sqlSave(ctx context.Context) (*ReorderPolicy, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ReorderPolicyCreate) createSpec() (*ReorderPolicy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReorderPolicy{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(reorderpolicy.Table, sqlgraph.NewFieldSpec(reorderpolicy.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.ReorderPoint(); ok {
+		_spec.SetField(reorderpolicy.FieldReorderPoint, field.TypeInt, value)
+		_node.ReorderPoint = value
+	}
+	if value, ok := _c.mutation.SafetyStock(); ok {
+		_spec.SetField(reorderpolicy.FieldSafetyStock, field.TypeInt, value)
+		_node.SafetyStock = value
+	}
+	if value, ok := _c.mutation.ReorderQuantity(); ok {
+		_spec.SetField(reorderpolicy.FieldReorderQuantity, field.TypeInt, value)
+		_node.ReorderQuantity = value
+	}
+	if value, ok := _c.mutation.LeadTimeDays(); ok {
+		_spec.SetField(reorderpolicy.FieldLeadTimeDays, field.TypeInt, value)
+		_node.LeadTimeDays = value
+	}
+	if value, ok := _c.mutation.Supplier(); ok {
+		_spec.SetField(reorderpolicy.FieldSupplier, field.TypeString, value)
+		_node.Supplier = value
+	}
+	if nodes := _c.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   reorderpolicy.ItemTable,
+			Columns: []string{reorderpolicy.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ItemID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   reorderpolicy.LocationTable,
+			Columns: []string{reorderpolicy.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.reorder_policy_location = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ReorderPolicyCreateBulk is the builder for creating many ReorderPolicy entities in bulk.
+type ReorderPolicyCreateBulk struct {
+	config
+	err      error
+	builders []*ReorderPolicyCreate
+}
+
+// Save creates the ReorderPolicy entities in the database.
+func (_c *ReorderPolicyCreateBulk) Save(ctx context.Context) ([]*ReorderPolicy, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ReorderPolicy, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReorderPolicyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ReorderPolicyCreateBulk) SaveX(ctx context.Context) []*ReorderPolicy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReorderPolicyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReorderPolicyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,713 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/item"
+	"github.com/mxV03/wms/ent/location"
+	"github.com/mxV03/wms/ent/predicate"
+	"github.com/mxV03/wms/ent/reorderpolicy"
+)
+
+// ReorderPolicyUpdate is the builder for updating ReorderPolicy entities.
+type ReorderPolicyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReorderPolicyMutation
+}
+
+// Where appends a list predicates to the ReorderPolicyUpdate builder.
+func (_u *ReorderPolicyUpdate) Where(ps ...predicate.ReorderPolicy) *ReorderPolicyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetReorderPoint sets the "reorder_point" field.
+func (_u *ReorderPolicyUpdate) SetReorderPoint(v int) *ReorderPolicyUpdate {
+	_u.mutation.ResetReorderPoint()
+	_u.mutation.SetReorderPoint(v)
+	return _u
+}
+
+// SetNillableReorderPoint sets the "reorder_point" field if the given value is not nil.
+func (_u *ReorderPolicyUpdate) SetNillableReorderPoint(v *int) *ReorderPolicyUpdate {
+	if v != nil {
+		_u.SetReorderPoint(*v)
+	}
+	return _u
+}
+
+// AddReorderPoint adds value to the "reorder_point" field.
+func (_u *ReorderPolicyUpdate) AddReorderPoint(v int) *ReorderPolicyUpdate {
+	_u.mutation.AddReorderPoint(v)
+	return _u
+}
+
+// SetSafetyStock sets the "safety_stock" field.
+func (_u *ReorderPolicyUpdate) SetSafetyStock(v int) *ReorderPolicyUpdate {
+	_u.mutation.ResetSafetyStock()
+	_u.mutation.SetSafetyStock(v)
+	return _u
+}
+
+// SetNillableSafetyStock sets the "safety_stock" field if the given value is not nil.
+func (_u *ReorderPolicyUpdate) SetNillableSafetyStock(v *int) *ReorderPolicyUpdate {
+	if v != nil {
+		_u.SetSafetyStock(*v)
+	}
+	return _u
+}
+
+// AddSafetyStock adds value to the "safety_stock" field.
+func (_u *ReorderPolicyUpdate) AddSafetyStock(v int) *ReorderPolicyUpdate {
+	_u.mutation.AddSafetyStock(v)
+	return _u
+}
+
+// SetReorderQuantity sets the "reorder_quantity" field.
+func (_u *ReorderPolicyUpdate) SetReorderQuantity(v int) *ReorderPolicyUpdate {
+	_u.mutation.ResetReorderQuantity()
+	_u.mutation.SetReorderQuantity(v)
+	return _u
+}
+
+// SetNillableReorderQuantity sets the "reorder_quantity" field if the given value is not nil.
+func (_u *ReorderPolicyUpdate) SetNillableReorderQuantity(v *int) *ReorderPolicyUpdate {
+	if v != nil {
+		_u.SetReorderQuantity(*v)
+	}
+	return _u
+}
+
+// AddReorderQuantity adds value to the "reorder_quantity" field.
+func (_u *ReorderPolicyUpdate) AddReorderQuantity(v int) *ReorderPolicyUpdate {
+	_u.mutation.AddReorderQuantity(v)
+	return _u
+}
+
+// SetLeadTimeDays sets the "lead_time_days" field.
+func (_u *ReorderPolicyUpdate) SetLeadTimeDays(v int) *ReorderPolicyUpdate {
+	_u.mutation.ResetLeadTimeDays()
+	_u.mutation.SetLeadTimeDays(v)
+	return _u
+}
+
+// SetNillableLeadTimeDays sets the "lead_time_days" field if the given value is not nil.
+func (_u *ReorderPolicyUpdate) SetNillableLeadTimeDays(v *int) *ReorderPolicyUpdate {
+	if v != nil {
+		_u.SetLeadTimeDays(*v)
+	}
+	return _u
+}
+
+// AddLeadTimeDays adds value to the "lead_time_days" field.
+func (_u *ReorderPolicyUpdate) AddLeadTimeDays(v int) *ReorderPolicyUpdate {
+	_u.mutation.AddLeadTimeDays(v)
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *ReorderPolicyUpdate) SetItemID(v int) *ReorderPolicyUpdate {
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *ReorderPolicyUpdate) SetNillableItemID(v *int) *ReorderPolicyUpdate {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// SetSupplier sets the "supplier" field.
+func (_u *ReorderPolicyUpdate) SetSupplier(v string) *ReorderPolicyUpdate {
+	_u.mutation.SetSupplier(v)
+	return _u
+}
+
+// SetNillableSupplier sets the "supplier" field if the given value is not nil.
+func (_u *ReorderPolicyUpdate) SetNillableSupplier(v *string) *ReorderPolicyUpdate {
+	if v != nil {
+		_u.SetSupplier(*v)
+	}
+	return _u
+}
+
+// ClearSupplier clears the value of the "supplier" field.
+func (_u *ReorderPolicyUpdate) ClearSupplier() *ReorderPolicyUpdate {
+	_u.mutation.ClearSupplier()
+	return _u
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_u *ReorderPolicyUpdate) SetItem(v *Item) *ReorderPolicyUpdate {
+	return _u.SetItemID(v.ID)
+}
+
+// SetLocationID sets the "location" edge to the Location entity by ID.
+func (_u *ReorderPolicyUpdate) SetLocationID(id int) *ReorderPolicyUpdate {
+	_u.mutation.SetLocationID(id)
+	return _u
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (_u *ReorderPolicyUpdate) SetLocation(v *Location) *ReorderPolicyUpdate {
+	return _u.SetLocationID(v.ID)
+}
+
+// Mutation returns the ReorderPolicyMutation object of the builder.
+func (_u *ReorderPolicyUpdate) Mutation() *ReorderPolicyMutation {
+	return _u.mutation
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (_u *ReorderPolicyUpdate) ClearItem() *ReorderPolicyUpdate {
+	_u.mutation.ClearItem()
+	return _u
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (_u *ReorderPolicyUpdate) ClearLocation() *ReorderPolicyUpdate {
+	_u.mutation.ClearLocation()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ReorderPolicyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReorderPolicyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ReorderPolicyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReorderPolicyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReorderPolicyUpdate) check() error {
+	if v, ok := _u.mutation.ReorderPoint(); ok {
+		if err := reorderpolicy.ReorderPointValidator(v); err != nil {
+			return &ValidationError{Name: "reorder_point", err: fmt.Errorf(`ent: validator failed for field "ReorderPolicy.reorder_point": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SafetyStock(); ok {
+		if err := reorderpolicy.SafetyStockValidator(v); err != nil {
+			return &ValidationError{Name: "safety_stock", err: fmt.Errorf(`ent: validator failed for field "ReorderPolicy.safety_stock": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ReorderQuantity(); ok {
+		if err := reorderpolicy.ReorderQuantityValidator(v); err != nil {
+			return &ValidationError{Name: "reorder_quantity", err: fmt.Errorf(`ent: validator failed for field "ReorderPolicy.reorder_quantity": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LeadTimeDays(); ok {
+		if err := reorderpolicy.LeadTimeDaysValidator(v); err != nil {
+			return &ValidationError{Name: "lead_time_days", err: fmt.Errorf(`ent: validator failed for field "ReorderPolicy.lead_time_days": %w`, err)}
+		}
+	}
+	if _u.mutation.ItemCleared() && len(_u.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReorderPolicy.item"`)
+	}
+	if _u.mutation.LocationCleared() && len(_u.mutation.LocationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReorderPolicy.location"`)
+	}
+	return nil
+}
+
+func (_u *ReorderPolicyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reorderpolicy.Table, reorderpolicy.Columns, sqlgraph.NewFieldSpec(reorderpolicy.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ReorderPoint(); ok {
+		_spec.SetField(reorderpolicy.FieldReorderPoint, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReorderPoint(); ok {
+		_spec.AddField(reorderpolicy.FieldReorderPoint, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SafetyStock(); ok {
+		_spec.SetField(reorderpolicy.FieldSafetyStock, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSafetyStock(); ok {
+		_spec.AddField(reorderpolicy.FieldSafetyStock, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ReorderQuantity(); ok {
+		_spec.SetField(reorderpolicy.FieldReorderQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReorderQuantity(); ok {
+		_spec.AddField(reorderpolicy.FieldReorderQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LeadTimeDays(); ok {
+		_spec.SetField(reorderpolicy.FieldLeadTimeDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLeadTimeDays(); ok {
+		_spec.AddField(reorderpolicy.FieldLeadTimeDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Supplier(); ok {
+		_spec.SetField(reorderpolicy.FieldSupplier, field.TypeString, value)
+	}
+	if _u.mutation.SupplierCleared() {
+		_spec.ClearField(reorderpolicy.FieldSupplier, field.TypeString)
+	}
+	if _u.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   reorderpolicy.ItemTable,
+			Columns: []string{reorderpolicy.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   reorderpolicy.ItemTable,
+			Columns: []string{reorderpolicy.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   reorderpolicy.LocationTable,
+			Columns: []string{reorderpolicy.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   reorderpolicy.LocationTable,
+			Columns: []string{reorderpolicy.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reorderpolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ReorderPolicyUpdateOne is the builder for updating a single ReorderPolicy entity.
+type ReorderPolicyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReorderPolicyMutation
+}
+
+// SetReorderPoint sets the "reorder_point" field.
+func (_u *ReorderPolicyUpdateOne) SetReorderPoint(v int) *ReorderPolicyUpdateOne {
+	_u.mutation.ResetReorderPoint()
+	_u.mutation.SetReorderPoint(v)
+	return _u
+}
+
+// SetNillableReorderPoint sets the "reorder_point" field if the given value is not nil.
+func (_u *ReorderPolicyUpdateOne) SetNillableReorderPoint(v *int) *ReorderPolicyUpdateOne {
+	if v != nil {
+		_u.SetReorderPoint(*v)
+	}
+	return _u
+}
+
+// AddReorderPoint adds value to the "reorder_point" field.
+func (_u *ReorderPolicyUpdateOne) AddReorderPoint(v int) *ReorderPolicyUpdateOne {
+	_u.mutation.AddReorderPoint(v)
+	return _u
+}
+
+// SetSafetyStock sets the "safety_stock" field.
+func (_u *ReorderPolicyUpdateOne) SetSafetyStock(v int) *ReorderPolicyUpdateOne {
+	_u.mutation.ResetSafetyStock()
+	_u.mutation.SetSafetyStock(v)
+	return _u
+}
+
+// SetNillableSafetyStock sets the "safety_stock" field if the given value is not nil.
+func (_u *ReorderPolicyUpdateOne) SetNillableSafetyStock(v *int) *ReorderPolicyUpdateOne {
+	if v != nil {
+		_u.SetSafetyStock(*v)
+	}
+	return _u
+}
+
+// AddSafetyStock adds value to the "safety_stock" field.
+func (_u *ReorderPolicyUpdateOne) AddSafetyStock(v int) *ReorderPolicyUpdateOne {
+	_u.mutation.AddSafetyStock(v)
+	return _u
+}
+
+// SetReorderQuantity sets the "reorder_quantity" field.
+func (_u *ReorderPolicyUpdateOne) SetReorderQuantity(v int) *ReorderPolicyUpdateOne {
+	_u.mutation.ResetReorderQuantity()
+	_u.mutation.SetReorderQuantity(v)
+	return _u
+}
+
+// SetNillableReorderQuantity sets the "reorder_quantity" field if the given value is not nil.
+func (_u *ReorderPolicyUpdateOne) SetNillableReorderQuantity(v *int) *ReorderPolicyUpdateOne {
+	if v != nil {
+		_u.SetReorderQuantity(*v)
+	}
+	return _u
+}
+
+// AddReorderQuantity adds value to the "reorder_quantity" field.
+func (_u *ReorderPolicyUpdateOne) AddReorderQuantity(v int) *ReorderPolicyUpdateOne {
+	_u.mutation.AddReorderQuantity(v)
+	return _u
+}
+
+// SetLeadTimeDays sets the "lead_time_days" field.
+func (_u *ReorderPolicyUpdateOne) SetLeadTimeDays(v int) *ReorderPolicyUpdateOne {
+	_u.mutation.ResetLeadTimeDays()
+	_u.mutation.SetLeadTimeDays(v)
+	return _u
+}
+
+// SetNillableLeadTimeDays sets the "lead_time_days" field if the given value is not nil.
+func (_u *ReorderPolicyUpdateOne) SetNillableLeadTimeDays(v *int) *ReorderPolicyUpdateOne {
+	if v != nil {
+		_u.SetLeadTimeDays(*v)
+	}
+	return _u
+}
+
+// AddLeadTimeDays adds value to the "lead_time_days" field.
+func (_u *ReorderPolicyUpdateOne) AddLeadTimeDays(v int) *ReorderPolicyUpdateOne {
+	_u.mutation.AddLeadTimeDays(v)
+	return _u
+}
+
+// SetItemID sets the "item_id" field.
+func (_u *ReorderPolicyUpdateOne) SetItemID(v int) *ReorderPolicyUpdateOne {
+	_u.mutation.SetItemID(v)
+	return _u
+}
+
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_u *ReorderPolicyUpdateOne) SetNillableItemID(v *int) *ReorderPolicyUpdateOne {
+	if v != nil {
+		_u.SetItemID(*v)
+	}
+	return _u
+}
+
+// SetSupplier sets the "supplier" field.
+func (_u *ReorderPolicyUpdateOne) SetSupplier(v string) *ReorderPolicyUpdateOne {
+	_u.mutation.SetSupplier(v)
+	return _u
+}
+
+// SetNillableSupplier sets the "supplier" field if the given value is not nil.
+func (_u *ReorderPolicyUpdateOne) SetNillableSupplier(v *string) *ReorderPolicyUpdateOne {
+	if v != nil {
+		_u.SetSupplier(*v)
+	}
+	return _u
+}
+
+// ClearSupplier clears the value of the "supplier" field.
+func (_u *ReorderPolicyUpdateOne) ClearSupplier() *ReorderPolicyUpdateOne {
+	_u.mutation.ClearSupplier()
+	return _u
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_u *ReorderPolicyUpdateOne) SetItem(v *Item) *ReorderPolicyUpdateOne {
+	return _u.SetItemID(v.ID)
+}
+
+// SetLocationID sets the "location" edge to the Location entity by ID.
+func (_u *ReorderPolicyUpdateOne) SetLocationID(id int) *ReorderPolicyUpdateOne {
+	_u.mutation.SetLocationID(id)
+	return _u
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (_u *ReorderPolicyUpdateOne) SetLocation(v *Location) *ReorderPolicyUpdateOne {
+	return _u.SetLocationID(v.ID)
+}
+
+// Mutation returns the ReorderPolicyMutation object of the builder.
+func (_u *ReorderPolicyUpdateOne) Mutation() *ReorderPolicyMutation {
+	return _u.mutation
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (_u *ReorderPolicyUpdateOne) ClearItem() *ReorderPolicyUpdateOne {
+	_u.mutation.ClearItem()
+	return _u
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (_u *ReorderPolicyUpdateOne) ClearLocation() *ReorderPolicyUpdateOne {
+	_u.mutation.ClearLocation()
+	return _u
+}
+
+// Where appends a list predicates to the ReorderPolicyUpdate builder.
+func (_u *ReorderPolicyUpdateOne) Where(ps ...predicate.ReorderPolicy) *ReorderPolicyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ReorderPolicyUpdateOne) Select(field string, fields ...string) *ReorderPolicyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ReorderPolicy entity.
+func (_u *ReorderPolicyUpdateOne) Save(ctx context.Context) (*ReorderPolicy, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReorderPolicyUpdateOne) SaveX(ctx context.Context) *ReorderPolicy {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ReorderPolicyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReorderPolicyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReorderPolicyUpdateOne) check() error {
+	if v, ok := _u.mutation.ReorderPoint(); ok {
+		if err := reorderpolicy.ReorderPointValidator(v); err != nil {
+			return &ValidationError{Name: "reorder_point", err: fmt.Errorf(`ent: validator failed for field "ReorderPolicy.reorder_point": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SafetyStock(); ok {
+		if err := reorderpolicy.SafetyStockValidator(v); err != nil {
+			return &ValidationError{Name: "safety_stock", err: fmt.Errorf(`ent: validator failed for field "ReorderPolicy.safety_stock": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ReorderQuantity(); ok {
+		if err := reorderpolicy.ReorderQuantityValidator(v); err != nil {
+			return &ValidationError{Name: "reorder_quantity", err: fmt.Errorf(`ent: validator failed for field "ReorderPolicy.reorder_quantity": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LeadTimeDays(); ok {
+		if err := reorderpolicy.LeadTimeDaysValidator(v); err != nil {
+			return &ValidationError{Name: "lead_time_days", err: fmt.Errorf(`ent: validator failed for field "ReorderPolicy.lead_time_days": %w`, err)}
+		}
+	}
+	if _u.mutation.ItemCleared() && len(_u.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReorderPolicy.item"`)
+	}
+	if _u.mutation.LocationCleared() && len(_u.mutation.LocationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReorderPolicy.location"`)
+	}
+	return nil
+}
+
+func (_u *ReorderPolicyUpdateOne) sqlSave(ctx context.Context) (_node *ReorderPolicy, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reorderpolicy.Table, reorderpolicy.Columns, sqlgraph.NewFieldSpec(reorderpolicy.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReorderPolicy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reorderpolicy.FieldID)
+		for _, f := range fields {
+			if !reorderpolicy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reorderpolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ReorderPoint(); ok {
+		_spec.SetField(reorderpolicy.FieldReorderPoint, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReorderPoint(); ok {
+		_spec.AddField(reorderpolicy.FieldReorderPoint, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SafetyStock(); ok {
+		_spec.SetField(reorderpolicy.FieldSafetyStock, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSafetyStock(); ok {
+		_spec.AddField(reorderpolicy.FieldSafetyStock, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ReorderQuantity(); ok {
+		_spec.SetField(reorderpolicy.FieldReorderQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReorderQuantity(); ok {
+		_spec.AddField(reorderpolicy.FieldReorderQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LeadTimeDays(); ok {
+		_spec.SetField(reorderpolicy.FieldLeadTimeDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLeadTimeDays(); ok {
+		_spec.AddField(reorderpolicy.FieldLeadTimeDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Supplier(); ok {
+		_spec.SetField(reorderpolicy.FieldSupplier, field.TypeString, value)
+	}
+	if _u.mutation.SupplierCleared() {
+		_spec.ClearField(reorderpolicy.FieldSupplier, field.TypeString)
+	}
+	if _u.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   reorderpolicy.ItemTable,
+			Columns: []string{reorderpolicy.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   reorderpolicy.ItemTable,
+			Columns: []string{reorderpolicy.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   reorderpolicy.LocationTable,
+			Columns: []string{reorderpolicy.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   reorderpolicy.LocationTable,
+			Columns: []string{reorderpolicy.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ReorderPolicy{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reorderpolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
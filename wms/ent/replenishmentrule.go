@@ -0,0 +1,180 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mxV03/wms/ent/bin"
+	"github.com/mxV03/wms/ent/item"
+	"github.com/mxV03/wms/ent/replenishmentrule"
+)
+
+// ReplenishmentRule is the model entity for the ReplenishmentRule schema.
+type ReplenishmentRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Min holds the value of the "min" field.
+	Min int `json:"min,omitempty"`
+	// Max holds the value of the "max" field.
+	Max int `json:"max,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReplenishmentRuleQuery when eager-loading is set.
+	Edges                   ReplenishmentRuleEdges `json:"edges"`
+	replenishment_rule_bin  *int
+	replenishment_rule_item *int
+	selectValues            sql.SelectValues
+}
+
+// ReplenishmentRuleEdges holds the relations/edges for other nodes in the graph.
+type ReplenishmentRuleEdges struct {
+	// Bin holds the value of the bin edge.
+	Bin *Bin `json:"bin,omitempty"`
+	// Item holds the value of the item edge.
+	Item *Item `json:"item,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// BinOrErr returns the Bin value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReplenishmentRuleEdges) BinOrErr() (*Bin, error) {
+	if e.Bin != nil {
+		return e.Bin, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: bin.Label}
+	}
+	return nil, &NotLoadedError{edge: "bin"}
+}
+
+// ItemOrErr returns the Item value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReplenishmentRuleEdges) ItemOrErr() (*Item, error) {
+	if e.Item != nil {
+		return e.Item, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: item.Label}
+	}
+	return nil, &NotLoadedError{edge: "item"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReplenishmentRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case replenishmentrule.FieldID, replenishmentrule.FieldMin, replenishmentrule.FieldMax:
+			values[i] = new(sql.NullInt64)
+		case replenishmentrule.ForeignKeys[0]: // replenishment_rule_bin
+			values[i] = new(sql.NullInt64)
+		case replenishmentrule.ForeignKeys[1]: // replenishment_rule_item
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReplenishmentRule fields.
+func (_m *ReplenishmentRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case replenishmentrule.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case replenishmentrule.FieldMin:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field min", values[i])
+			} else if value.Valid {
+				_m.Min = int(value.Int64)
+			}
+		case replenishmentrule.FieldMax:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max", values[i])
+			} else if value.Valid {
+				_m.Max = int(value.Int64)
+			}
+		case replenishmentrule.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field replenishment_rule_bin", value)
+			} else if value.Valid {
+				_m.replenishment_rule_bin = new(int)
+				*_m.replenishment_rule_bin = int(value.Int64)
+			}
+		case replenishmentrule.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field replenishment_rule_item", value)
+			} else if value.Valid {
+				_m.replenishment_rule_item = new(int)
+				*_m.replenishment_rule_item = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ReplenishmentRule.
+// This includes values selected through modifiers, order, etc.
+func (_m *ReplenishmentRule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryBin queries the "bin" edge of the ReplenishmentRule entity.
+func (_m *ReplenishmentRule) QueryBin() *BinQuery {
+	return NewReplenishmentRuleClient(_m.config).QueryBin(_m)
+}
+
+// QueryItem queries the "item" edge of the ReplenishmentRule entity.
+func (_m *ReplenishmentRule) QueryItem() *ItemQuery {
+	return NewReplenishmentRuleClient(_m.config).QueryItem(_m)
+}
+
+// Update returns a builder for updating this ReplenishmentRule.
+// Note that you need to call ReplenishmentRule.Unwrap() before calling this method if this ReplenishmentRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ReplenishmentRule) Update() *ReplenishmentRuleUpdateOne {
+	return NewReplenishmentRuleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ReplenishmentRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ReplenishmentRule) Unwrap() *ReplenishmentRule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReplenishmentRule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ReplenishmentRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReplenishmentRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("min=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Min))
+	builder.WriteString(", ")
+	builder.WriteString("max=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Max))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReplenishmentRules is a parsable slice of ReplenishmentRule.
+type ReplenishmentRules []*ReplenishmentRule
@@ -0,0 +1,121 @@
+// Code generated by ent, DO NOT EDIT.
+
+package replenishmentrule
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the replenishmentrule type in the database.
+	Label = "replenishment_rule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldMin holds the string denoting the min field in the database.
+	FieldMin = "min"
+	// FieldMax holds the string denoting the max field in the database.
+	FieldMax = "max"
+	// EdgeBin holds the string denoting the bin edge name in mutations.
+	EdgeBin = "bin"
+	// EdgeItem holds the string denoting the item edge name in mutations.
+	EdgeItem = "item"
+	// Table holds the table name of the replenishmentrule in the database.
+	Table = "replenishment_rules"
+	// BinTable is the table that holds the bin relation/edge.
+	BinTable = "replenishment_rules"
+	// BinInverseTable is the table name for the Bin entity.
+	// It exists in this package in order to avoid circular dependency with the "bin" package.
+	BinInverseTable = "bins"
+	// BinColumn is the table column denoting the bin relation/edge.
+	BinColumn = "replenishment_rule_bin"
+	// ItemTable is the table that holds the item relation/edge.
+	ItemTable = "replenishment_rules"
+	// ItemInverseTable is the table name for the Item entity.
+	// It exists in this package in order to avoid circular dependency with the "item" package.
+	ItemInverseTable = "items"
+	// ItemColumn is the table column denoting the item relation/edge.
+	ItemColumn = "replenishment_rule_item"
+)
+
+// Columns holds all SQL columns for replenishmentrule fields.
+var Columns = []string{
+	FieldID,
+	FieldMin,
+	FieldMax,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "replenishment_rules"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"replenishment_rule_bin",
+	"replenishment_rule_item",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// MinValidator is a validator for the "min" field. It is called by the builders before save.
+	MinValidator func(int) error
+	// MaxValidator is a validator for the "max" field. It is called by the builders before save.
+	MaxValidator func(int) error
+)
+
+// OrderOption defines the ordering options for the ReplenishmentRule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByMin orders the results by the min field.
+func ByMin(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMin, opts...).ToFunc()
+}
+
+// ByMax orders the results by the max field.
+func ByMax(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMax, opts...).ToFunc()
+}
+
+// ByBinField orders the results by bin field.
+func ByBinField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBinStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByItemField orders the results by item field.
+func ByItemField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newItemStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newBinStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BinInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, BinTable, BinColumn),
+	)
+}
+func newItemStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ItemInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ItemTable, ItemColumn),
+	)
+}
@@ -0,0 +1,205 @@
+// Code generated by ent, DO NOT EDIT.
+
+package replenishmentrule
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/mxV03/wms/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ReplenishmentRule {
+	return predicate.ReplenishmentRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ReplenishmentRule {
+	return predicate.ReplenishmentRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ReplenishmentRule {
+	return predicate.ReplenishmentRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ReplenishmentRule {
+	return predicate.ReplenishmentRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ReplenishmentRule {
+	return predicate.ReplenishmentRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ReplenishmentRule {
+	return predicate.ReplenishmentRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ReplenishmentRule {
+	return predicate.ReplenishmentRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ReplenishmentRule {
+	return predicate.ReplenishmentRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ReplenishmentRule {
+	return predicate.ReplenishmentRule(sql.FieldLTE(FieldID, id))
+}
+
+// Min applies equality check predicate on the "min" field. It's identical to MinEQ.
+func Min(v int) predicate.ReplenishmentRule {
+	return predicate.ReplenishmentRule(sql.FieldEQ(FieldMin, v))
+}
+
+// Max applies equality check predicate on the "max" field. It's identical to MaxEQ.
+func Max(v int) predicate.ReplenishmentRule {
+	return predicate.ReplenishmentRule(sql.FieldEQ(FieldMax, v))
+}
+
+// MinEQ applies the EQ predicate on the "min" field.
+func MinEQ(v int) predicate.ReplenishmentRule {
+	return predicate.ReplenishmentRule(sql.FieldEQ(FieldMin, v))
+}
+
+// MinNEQ applies the NEQ predicate on the "min" field.
+func MinNEQ(v int) predicate.ReplenishmentRule {
+	return predicate.ReplenishmentRule(sql.FieldNEQ(FieldMin, v))
+}
+
+// MinIn applies the In predicate on the "min" field.
+func MinIn(vs ...int) predicate.ReplenishmentRule {
+	return predicate.ReplenishmentRule(sql.FieldIn(FieldMin, vs...))
+}
+
+// MinNotIn applies the NotIn predicate on the "min" field.
+func MinNotIn(vs ...int) predicate.ReplenishmentRule {
+	return predicate.ReplenishmentRule(sql.FieldNotIn(FieldMin, vs...))
+}
+
+// MinGT applies the GT predicate on the "min" field.
+func MinGT(v int) predicate.ReplenishmentRule {
+	return predicate.ReplenishmentRule(sql.FieldGT(FieldMin, v))
+}
+
+// MinGTE applies the GTE predicate on the "min" field.
+func MinGTE(v int) predicate.ReplenishmentRule {
+	return predicate.ReplenishmentRule(sql.FieldGTE(FieldMin, v))
+}
+
+// MinLT applies the LT predicate on the "min" field.
+func MinLT(v int) predicate.ReplenishmentRule {
+	return predicate.ReplenishmentRule(sql.FieldLT(FieldMin, v))
+}
+
+// MinLTE applies the LTE predicate on the "min" field.
+func MinLTE(v int) predicate.ReplenishmentRule {
+	return predicate.ReplenishmentRule(sql.FieldLTE(FieldMin, v))
+}
+
+// MaxEQ applies the EQ predicate on the "max" field.
+func MaxEQ(v int) predicate.ReplenishmentRule {
+	return predicate.ReplenishmentRule(sql.FieldEQ(FieldMax, v))
+}
+
+// MaxNEQ applies the NEQ predicate on the "max" field.
+func MaxNEQ(v int) predicate.ReplenishmentRule {
+	return predicate.ReplenishmentRule(sql.FieldNEQ(FieldMax, v))
+}
+
+// MaxIn applies the In predicate on the "max" field.
+func MaxIn(vs ...int) predicate.ReplenishmentRule {
+	return predicate.ReplenishmentRule(sql.FieldIn(FieldMax, vs...))
+}
+
+// MaxNotIn applies the NotIn predicate on the "max" field.
+func MaxNotIn(vs ...int) predicate.ReplenishmentRule {
+	return predicate.ReplenishmentRule(sql.FieldNotIn(FieldMax, vs...))
+}
+
+// MaxGT applies the GT predicate on the "max" field.
+func MaxGT(v int) predicate.ReplenishmentRule {
+	return predicate.ReplenishmentRule(sql.FieldGT(FieldMax, v))
+}
+
+// MaxGTE applies the GTE predicate on the "max" field.
+func MaxGTE(v int) predicate.ReplenishmentRule {
+	return predicate.ReplenishmentRule(sql.FieldGTE(FieldMax, v))
+}
+
+// MaxLT applies the LT predicate on the "max" field.
+func MaxLT(v int) predicate.ReplenishmentRule {
+	return predicate.ReplenishmentRule(sql.FieldLT(FieldMax, v))
+}
+
+// MaxLTE applies the LTE predicate on the "max" field.
+func MaxLTE(v int) predicate.ReplenishmentRule {
+	return predicate.ReplenishmentRule(sql.FieldLTE(FieldMax, v))
+}
+
+// HasBin applies the HasEdge predicate on the "bin" edge.
+func HasBin() predicate.ReplenishmentRule {
+	return predicate.ReplenishmentRule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, BinTable, BinColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBinWith applies the HasEdge predicate on the "bin" edge with a given conditions (other predicates).
+func HasBinWith(preds ...predicate.Bin) predicate.ReplenishmentRule {
+	return predicate.ReplenishmentRule(func(s *sql.Selector) {
+		step := newBinStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasItem applies the HasEdge predicate on the "item" edge.
+func HasItem() predicate.ReplenishmentRule {
+	return predicate.ReplenishmentRule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ItemTable, ItemColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemWith applies the HasEdge predicate on the "item" edge with a given conditions (other predicates).
+func HasItemWith(preds ...predicate.Item) predicate.ReplenishmentRule {
+	return predicate.ReplenishmentRule(func(s *sql.Selector) {
+		step := newItemStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReplenishmentRule) predicate.ReplenishmentRule {
+	return predicate.ReplenishmentRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReplenishmentRule) predicate.ReplenishmentRule {
+	return predicate.ReplenishmentRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReplenishmentRule) predicate.ReplenishmentRule {
+	return predicate.ReplenishmentRule(sql.NotPredicates(p))
+}
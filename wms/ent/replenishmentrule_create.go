@@ -0,0 +1,270 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/bin"
+	"github.com/mxV03/wms/ent/item"
+	"github.com/mxV03/wms/ent/replenishmentrule"
+)
+
+// ReplenishmentRuleCreate is the builder for creating a ReplenishmentRule entity.
+type ReplenishmentRuleCreate struct {
+	config
+	mutation *ReplenishmentRuleMutation
+	hooks    []Hook
+}
+
+// SetMin sets the "min" field.
+func (_c *ReplenishmentRuleCreate) SetMin(v int) *ReplenishmentRuleCreate {
+	_c.mutation.SetMin(v)
+	return _c
+}
+
+// SetMax sets the "max" field.
+func (_c *ReplenishmentRuleCreate) SetMax(v int) *ReplenishmentRuleCreate {
+	_c.mutation.SetMax(v)
+	return _c
+}
+
+// SetBinID sets the "bin" edge to the Bin entity by ID.
+func (_c *ReplenishmentRuleCreate) SetBinID(id int) *ReplenishmentRuleCreate {
+	_c.mutation.SetBinID(id)
+	return _c
+}
+
+// SetBin sets the "bin" edge to the Bin entity.
+func (_c *ReplenishmentRuleCreate) SetBin(v *Bin) *ReplenishmentRuleCreate {
+	return _c.SetBinID(v.ID)
+}
+
+// SetItemID sets the "item" edge to the Item entity by ID.
+func (_c *ReplenishmentRuleCreate) SetItemID(id int) *ReplenishmentRuleCreate {
+	_c.mutation.SetItemID(id)
+	return _c
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_c *ReplenishmentRuleCreate) SetItem(v *Item) *ReplenishmentRuleCreate {
+	return _c.SetItemID(v.ID)
+}
+
+// Mutation returns the ReplenishmentRuleMutation object of the builder.
+func (_c *ReplenishmentRuleCreate) Mutation() *ReplenishmentRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the ReplenishmentRule in the database.
+func (_c *ReplenishmentRuleCreate) Save(ctx context.Context) (*ReplenishmentRule, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ReplenishmentRuleCreate) SaveX(ctx context.Context) *ReplenishmentRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReplenishmentRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReplenishmentRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ReplenishmentRuleCreate) check() error {
+	if _, ok := _c.mutation.Min(); !ok {
+		return &ValidationError{Name: "min", err: errors.New(`ent: missing required field "ReplenishmentRule.min"`)}
+	}
+	if v, ok := _c.mutation.Min(); ok {
+		if err := replenishmentrule.MinValidator(v); err != nil {
+			return &ValidationError{Name: "min", err: fmt.Errorf(`ent: validator failed for field "ReplenishmentRule.min": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Max(); !ok {
+		return &ValidationError{Name: "max", err: errors.New(`ent: missing required field "ReplenishmentRule.max"`)}
+	}
+	if v, ok := _c.mutation.Max(); ok {
+		if err := replenishmentrule.MaxValidator(v); err != nil {
+			return &ValidationError{Name: "max", err: fmt.Errorf(`ent: validator failed for field "ReplenishmentRule.max": %w`, err)}
+		}
+	}
+	if len(_c.mutation.BinIDs()) == 0 {
+		return &ValidationError{Name: "bin", err: errors.New(`ent: missing required edge "ReplenishmentRule.bin"`)}
+	}
+	if len(_c.mutation.ItemIDs()) == 0 {
+		return &ValidationError{Name: "item", err: errors.New(`ent: missing required edge "ReplenishmentRule.item"`)}
+	}
+	return nil
+}
+
+func (_c *ReplenishmentRuleCreate) sqlSave(ctx context.Context) (*ReplenishmentRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ReplenishmentRuleCreate) createSpec() (*ReplenishmentRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReplenishmentRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(replenishmentrule.Table, sqlgraph.NewFieldSpec(replenishmentrule.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Min(); ok {
+		_spec.SetField(replenishmentrule.FieldMin, field.TypeInt, value)
+		_node.Min = value
+	}
+	if value, ok := _c.mutation.Max(); ok {
+		_spec.SetField(replenishmentrule.FieldMax, field.TypeInt, value)
+		_node.Max = value
+	}
+	if nodes := _c.mutation.BinIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   replenishmentrule.BinTable,
+			Columns: []string{replenishmentrule.BinColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bin.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.replenishment_rule_bin = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   replenishmentrule.ItemTable,
+			Columns: []string{replenishmentrule.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.replenishment_rule_item = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ReplenishmentRuleCreateBulk is the builder for creating many ReplenishmentRule entities in bulk.
+type ReplenishmentRuleCreateBulk struct {
+	config
+	err      error
+	builders []*ReplenishmentRuleCreate
+}
+
+// Save creates the ReplenishmentRule entities in the database.
+func (_c *ReplenishmentRuleCreateBulk) Save(ctx context.Context) ([]*ReplenishmentRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ReplenishmentRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReplenishmentRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ReplenishmentRuleCreateBulk) SaveX(ctx context.Context) []*ReplenishmentRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReplenishmentRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReplenishmentRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
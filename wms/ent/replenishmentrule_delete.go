@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/predicate"
+	"github.com/mxV03/wms/ent/replenishmentrule"
+)
+
+// ReplenishmentRuleDelete is the builder for deleting a ReplenishmentRule entity.
+type ReplenishmentRuleDelete struct {
+	config
+	hooks    []Hook
+	mutation *ReplenishmentRuleMutation
+}
+
+// Where appends a list predicates to the ReplenishmentRuleDelete builder.
+func (_d *ReplenishmentRuleDelete) Where(ps ...predicate.ReplenishmentRule) *ReplenishmentRuleDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ReplenishmentRuleDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ReplenishmentRuleDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ReplenishmentRuleDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(replenishmentrule.Table, sqlgraph.NewFieldSpec(replenishmentrule.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ReplenishmentRuleDeleteOne is the builder for deleting a single ReplenishmentRule entity.
+type ReplenishmentRuleDeleteOne struct {
+	_d *ReplenishmentRuleDelete
+}
+
+// Where appends a list predicates to the ReplenishmentRuleDelete builder.
+func (_d *ReplenishmentRuleDeleteOne) Where(ps ...predicate.ReplenishmentRule) *ReplenishmentRuleDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ReplenishmentRuleDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{replenishmentrule.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ReplenishmentRuleDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,517 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/bin"
+	"github.com/mxV03/wms/ent/item"
+	"github.com/mxV03/wms/ent/predicate"
+	"github.com/mxV03/wms/ent/replenishmentrule"
+)
+
+// ReplenishmentRuleUpdate is the builder for updating ReplenishmentRule entities.
+type ReplenishmentRuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReplenishmentRuleMutation
+}
+
+// Where appends a list predicates to the ReplenishmentRuleUpdate builder.
+func (_u *ReplenishmentRuleUpdate) Where(ps ...predicate.ReplenishmentRule) *ReplenishmentRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetMin sets the "min" field.
+func (_u *ReplenishmentRuleUpdate) SetMin(v int) *ReplenishmentRuleUpdate {
+	_u.mutation.ResetMin()
+	_u.mutation.SetMin(v)
+	return _u
+}
+
+// SetNillableMin sets the "min" field if the given value is not nil.
+func (_u *ReplenishmentRuleUpdate) SetNillableMin(v *int) *ReplenishmentRuleUpdate {
+	if v != nil {
+		_u.SetMin(*v)
+	}
+	return _u
+}
+
+// AddMin adds value to the "min" field.
+func (_u *ReplenishmentRuleUpdate) AddMin(v int) *ReplenishmentRuleUpdate {
+	_u.mutation.AddMin(v)
+	return _u
+}
+
+// SetMax sets the "max" field.
+func (_u *ReplenishmentRuleUpdate) SetMax(v int) *ReplenishmentRuleUpdate {
+	_u.mutation.ResetMax()
+	_u.mutation.SetMax(v)
+	return _u
+}
+
+// SetNillableMax sets the "max" field if the given value is not nil.
+func (_u *ReplenishmentRuleUpdate) SetNillableMax(v *int) *ReplenishmentRuleUpdate {
+	if v != nil {
+		_u.SetMax(*v)
+	}
+	return _u
+}
+
+// AddMax adds value to the "max" field.
+func (_u *ReplenishmentRuleUpdate) AddMax(v int) *ReplenishmentRuleUpdate {
+	_u.mutation.AddMax(v)
+	return _u
+}
+
+// SetBinID sets the "bin" edge to the Bin entity by ID.
+func (_u *ReplenishmentRuleUpdate) SetBinID(id int) *ReplenishmentRuleUpdate {
+	_u.mutation.SetBinID(id)
+	return _u
+}
+
+// SetBin sets the "bin" edge to the Bin entity.
+func (_u *ReplenishmentRuleUpdate) SetBin(v *Bin) *ReplenishmentRuleUpdate {
+	return _u.SetBinID(v.ID)
+}
+
+// SetItemID sets the "item" edge to the Item entity by ID.
+func (_u *ReplenishmentRuleUpdate) SetItemID(id int) *ReplenishmentRuleUpdate {
+	_u.mutation.SetItemID(id)
+	return _u
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_u *ReplenishmentRuleUpdate) SetItem(v *Item) *ReplenishmentRuleUpdate {
+	return _u.SetItemID(v.ID)
+}
+
+// Mutation returns the ReplenishmentRuleMutation object of the builder.
+func (_u *ReplenishmentRuleUpdate) Mutation() *ReplenishmentRuleMutation {
+	return _u.mutation
+}
+
+// ClearBin clears the "bin" edge to the Bin entity.
+func (_u *ReplenishmentRuleUpdate) ClearBin() *ReplenishmentRuleUpdate {
+	_u.mutation.ClearBin()
+	return _u
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (_u *ReplenishmentRuleUpdate) ClearItem() *ReplenishmentRuleUpdate {
+	_u.mutation.ClearItem()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ReplenishmentRuleUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReplenishmentRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ReplenishmentRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReplenishmentRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReplenishmentRuleUpdate) check() error {
+	if v, ok := _u.mutation.Min(); ok {
+		if err := replenishmentrule.MinValidator(v); err != nil {
+			return &ValidationError{Name: "min", err: fmt.Errorf(`ent: validator failed for field "ReplenishmentRule.min": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Max(); ok {
+		if err := replenishmentrule.MaxValidator(v); err != nil {
+			return &ValidationError{Name: "max", err: fmt.Errorf(`ent: validator failed for field "ReplenishmentRule.max": %w`, err)}
+		}
+	}
+	if _u.mutation.BinCleared() && len(_u.mutation.BinIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReplenishmentRule.bin"`)
+	}
+	if _u.mutation.ItemCleared() && len(_u.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReplenishmentRule.item"`)
+	}
+	return nil
+}
+
+func (_u *ReplenishmentRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(replenishmentrule.Table, replenishmentrule.Columns, sqlgraph.NewFieldSpec(replenishmentrule.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Min(); ok {
+		_spec.SetField(replenishmentrule.FieldMin, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMin(); ok {
+		_spec.AddField(replenishmentrule.FieldMin, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Max(); ok {
+		_spec.SetField(replenishmentrule.FieldMax, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMax(); ok {
+		_spec.AddField(replenishmentrule.FieldMax, field.TypeInt, value)
+	}
+	if _u.mutation.BinCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   replenishmentrule.BinTable,
+			Columns: []string{replenishmentrule.BinColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bin.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BinIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   replenishmentrule.BinTable,
+			Columns: []string{replenishmentrule.BinColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bin.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   replenishmentrule.ItemTable,
+			Columns: []string{replenishmentrule.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   replenishmentrule.ItemTable,
+			Columns: []string{replenishmentrule.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{replenishmentrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ReplenishmentRuleUpdateOne is the builder for updating a single ReplenishmentRule entity.
+type ReplenishmentRuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReplenishmentRuleMutation
+}
+
+// SetMin sets the "min" field.
+func (_u *ReplenishmentRuleUpdateOne) SetMin(v int) *ReplenishmentRuleUpdateOne {
+	_u.mutation.ResetMin()
+	_u.mutation.SetMin(v)
+	return _u
+}
+
+// SetNillableMin sets the "min" field if the given value is not nil.
+func (_u *ReplenishmentRuleUpdateOne) SetNillableMin(v *int) *ReplenishmentRuleUpdateOne {
+	if v != nil {
+		_u.SetMin(*v)
+	}
+	return _u
+}
+
+// AddMin adds value to the "min" field.
+func (_u *ReplenishmentRuleUpdateOne) AddMin(v int) *ReplenishmentRuleUpdateOne {
+	_u.mutation.AddMin(v)
+	return _u
+}
+
+// SetMax sets the "max" field.
+func (_u *ReplenishmentRuleUpdateOne) SetMax(v int) *ReplenishmentRuleUpdateOne {
+	_u.mutation.ResetMax()
+	_u.mutation.SetMax(v)
+	return _u
+}
+
+// SetNillableMax sets the "max" field if the given value is not nil.
+func (_u *ReplenishmentRuleUpdateOne) SetNillableMax(v *int) *ReplenishmentRuleUpdateOne {
+	if v != nil {
+		_u.SetMax(*v)
+	}
+	return _u
+}
+
+// AddMax adds value to the "max" field.
+func (_u *ReplenishmentRuleUpdateOne) AddMax(v int) *ReplenishmentRuleUpdateOne {
+	_u.mutation.AddMax(v)
+	return _u
+}
+
+// SetBinID sets the "bin" edge to the Bin entity by ID.
+func (_u *ReplenishmentRuleUpdateOne) SetBinID(id int) *ReplenishmentRuleUpdateOne {
+	_u.mutation.SetBinID(id)
+	return _u
+}
+
+// SetBin sets the "bin" edge to the Bin entity.
+func (_u *ReplenishmentRuleUpdateOne) SetBin(v *Bin) *ReplenishmentRuleUpdateOne {
+	return _u.SetBinID(v.ID)
+}
+
+// SetItemID sets the "item" edge to the Item entity by ID.
+func (_u *ReplenishmentRuleUpdateOne) SetItemID(id int) *ReplenishmentRuleUpdateOne {
+	_u.mutation.SetItemID(id)
+	return _u
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_u *ReplenishmentRuleUpdateOne) SetItem(v *Item) *ReplenishmentRuleUpdateOne {
+	return _u.SetItemID(v.ID)
+}
+
+// Mutation returns the ReplenishmentRuleMutation object of the builder.
+func (_u *ReplenishmentRuleUpdateOne) Mutation() *ReplenishmentRuleMutation {
+	return _u.mutation
+}
+
+// ClearBin clears the "bin" edge to the Bin entity.
+func (_u *ReplenishmentRuleUpdateOne) ClearBin() *ReplenishmentRuleUpdateOne {
+	_u.mutation.ClearBin()
+	return _u
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (_u *ReplenishmentRuleUpdateOne) ClearItem() *ReplenishmentRuleUpdateOne {
+	_u.mutation.ClearItem()
+	return _u
+}
+
+// Where appends a list predicates to the ReplenishmentRuleUpdate builder.
+func (_u *ReplenishmentRuleUpdateOne) Where(ps ...predicate.ReplenishmentRule) *ReplenishmentRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ReplenishmentRuleUpdateOne) Select(field string, fields ...string) *ReplenishmentRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ReplenishmentRule entity.
+func (_u *ReplenishmentRuleUpdateOne) Save(ctx context.Context) (*ReplenishmentRule, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReplenishmentRuleUpdateOne) SaveX(ctx context.Context) *ReplenishmentRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ReplenishmentRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReplenishmentRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReplenishmentRuleUpdateOne) check() error {
+	if v, ok := _u.mutation.Min(); ok {
+		if err := replenishmentrule.MinValidator(v); err != nil {
+			return &ValidationError{Name: "min", err: fmt.Errorf(`ent: validator failed for field "ReplenishmentRule.min": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Max(); ok {
+		if err := replenishmentrule.MaxValidator(v); err != nil {
+			return &ValidationError{Name: "max", err: fmt.Errorf(`ent: validator failed for field "ReplenishmentRule.max": %w`, err)}
+		}
+	}
+	if _u.mutation.BinCleared() && len(_u.mutation.BinIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReplenishmentRule.bin"`)
+	}
+	if _u.mutation.ItemCleared() && len(_u.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReplenishmentRule.item"`)
+	}
+	return nil
+}
+
+func (_u *ReplenishmentRuleUpdateOne) sqlSave(ctx context.Context) (_node *ReplenishmentRule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(replenishmentrule.Table, replenishmentrule.Columns, sqlgraph.NewFieldSpec(replenishmentrule.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReplenishmentRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, replenishmentrule.FieldID)
+		for _, f := range fields {
+			if !replenishmentrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != replenishmentrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Min(); ok {
+		_spec.SetField(replenishmentrule.FieldMin, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMin(); ok {
+		_spec.AddField(replenishmentrule.FieldMin, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Max(); ok {
+		_spec.SetField(replenishmentrule.FieldMax, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMax(); ok {
+		_spec.AddField(replenishmentrule.FieldMax, field.TypeInt, value)
+	}
+	if _u.mutation.BinCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   replenishmentrule.BinTable,
+			Columns: []string{replenishmentrule.BinColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bin.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BinIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   replenishmentrule.BinTable,
+			Columns: []string{replenishmentrule.BinColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bin.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   replenishmentrule.ItemTable,
+			Columns: []string{replenishmentrule.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   replenishmentrule.ItemTable,
+			Columns: []string{replenishmentrule.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ReplenishmentRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{replenishmentrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
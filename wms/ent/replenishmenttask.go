@@ -0,0 +1,249 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mxV03/wms/ent/bin"
+	"github.com/mxV03/wms/ent/item"
+	"github.com/mxV03/wms/ent/replenishmenttask"
+)
+
+// ReplenishmentTask is the model entity for the ReplenishmentTask schema.
+type ReplenishmentTask struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity int `json:"quantity,omitempty"`
+	// Kind holds the value of the "kind" field.
+	Kind string `json:"kind,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// DoneAt holds the value of the "done_at" field.
+	DoneAt *time.Time `json:"done_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReplenishmentTaskQuery when eager-loading is set.
+	Edges                       ReplenishmentTaskEdges `json:"edges"`
+	replenishment_task_item     *int
+	replenishment_task_from_bin *int
+	replenishment_task_to_bin   *int
+	selectValues                sql.SelectValues
+}
+
+// ReplenishmentTaskEdges holds the relations/edges for other nodes in the graph.
+type ReplenishmentTaskEdges struct {
+	// Item holds the value of the item edge.
+	Item *Item `json:"item,omitempty"`
+	// FromBin holds the value of the from_bin edge.
+	FromBin *Bin `json:"from_bin,omitempty"`
+	// ToBin holds the value of the to_bin edge.
+	ToBin *Bin `json:"to_bin,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// ItemOrErr returns the Item value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReplenishmentTaskEdges) ItemOrErr() (*Item, error) {
+	if e.Item != nil {
+		return e.Item, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: item.Label}
+	}
+	return nil, &NotLoadedError{edge: "item"}
+}
+
+// FromBinOrErr returns the FromBin value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReplenishmentTaskEdges) FromBinOrErr() (*Bin, error) {
+	if e.FromBin != nil {
+		return e.FromBin, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: bin.Label}
+	}
+	return nil, &NotLoadedError{edge: "from_bin"}
+}
+
+// ToBinOrErr returns the ToBin value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReplenishmentTaskEdges) ToBinOrErr() (*Bin, error) {
+	if e.ToBin != nil {
+		return e.ToBin, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: bin.Label}
+	}
+	return nil, &NotLoadedError{edge: "to_bin"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReplenishmentTask) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case replenishmenttask.FieldID, replenishmenttask.FieldQuantity:
+			values[i] = new(sql.NullInt64)
+		case replenishmenttask.FieldKind, replenishmenttask.FieldStatus:
+			values[i] = new(sql.NullString)
+		case replenishmenttask.FieldCreatedAt, replenishmenttask.FieldDoneAt:
+			values[i] = new(sql.NullTime)
+		case replenishmenttask.ForeignKeys[0]: // replenishment_task_item
+			values[i] = new(sql.NullInt64)
+		case replenishmenttask.ForeignKeys[1]: // replenishment_task_from_bin
+			values[i] = new(sql.NullInt64)
+		case replenishmenttask.ForeignKeys[2]: // replenishment_task_to_bin
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReplenishmentTask fields.
+func (_m *ReplenishmentTask) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case replenishmenttask.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case replenishmenttask.FieldQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				_m.Quantity = int(value.Int64)
+			}
+		case replenishmenttask.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = value.String
+			}
+		case replenishmenttask.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case replenishmenttask.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case replenishmenttask.FieldDoneAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field done_at", values[i])
+			} else if value.Valid {
+				_m.DoneAt = new(time.Time)
+				*_m.DoneAt = value.Time
+			}
+		case replenishmenttask.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field replenishment_task_item", value)
+			} else if value.Valid {
+				_m.replenishment_task_item = new(int)
+				*_m.replenishment_task_item = int(value.Int64)
+			}
+		case replenishmenttask.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field replenishment_task_from_bin", value)
+			} else if value.Valid {
+				_m.replenishment_task_from_bin = new(int)
+				*_m.replenishment_task_from_bin = int(value.Int64)
+			}
+		case replenishmenttask.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field replenishment_task_to_bin", value)
+			} else if value.Valid {
+				_m.replenishment_task_to_bin = new(int)
+				*_m.replenishment_task_to_bin = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ReplenishmentTask.
+// This includes values selected through modifiers, order, etc.
+func (_m *ReplenishmentTask) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryItem queries the "item" edge of the ReplenishmentTask entity.
+func (_m *ReplenishmentTask) QueryItem() *ItemQuery {
+	return NewReplenishmentTaskClient(_m.config).QueryItem(_m)
+}
+
+// QueryFromBin queries the "from_bin" edge of the ReplenishmentTask entity.
+func (_m *ReplenishmentTask) QueryFromBin() *BinQuery {
+	return NewReplenishmentTaskClient(_m.config).QueryFromBin(_m)
+}
+
+// QueryToBin queries the "to_bin" edge of the ReplenishmentTask entity.
+func (_m *ReplenishmentTask) QueryToBin() *BinQuery {
+	return NewReplenishmentTaskClient(_m.config).QueryToBin(_m)
+}
+
+// Update returns a builder for updating this ReplenishmentTask.
+// Note that you need to call ReplenishmentTask.Unwrap() before calling this method if this ReplenishmentTask
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ReplenishmentTask) Update() *ReplenishmentTaskUpdateOne {
+	return NewReplenishmentTaskClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ReplenishmentTask entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ReplenishmentTask) Unwrap() *ReplenishmentTask {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReplenishmentTask is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ReplenishmentTask) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReplenishmentTask(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(_m.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DoneAt; v != nil {
+		builder.WriteString("done_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReplenishmentTasks is a parsable slice of ReplenishmentTask.
+type ReplenishmentTasks []*ReplenishmentTask
@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package replenishmenttask
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the replenishmenttask type in the database.
+	Label = "replenishment_task"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldDoneAt holds the string denoting the done_at field in the database.
+	FieldDoneAt = "done_at"
+	// EdgeItem holds the string denoting the item edge name in mutations.
+	EdgeItem = "item"
+	// EdgeFromBin holds the string denoting the from_bin edge name in mutations.
+	EdgeFromBin = "from_bin"
+	// EdgeToBin holds the string denoting the to_bin edge name in mutations.
+	EdgeToBin = "to_bin"
+	// Table holds the table name of the replenishmenttask in the database.
+	Table = "replenishment_tasks"
+	// ItemTable is the table that holds the item relation/edge.
+	ItemTable = "replenishment_tasks"
+	// ItemInverseTable is the table name for the Item entity.
+	// It exists in this package in order to avoid circular dependency with the "item" package.
+	ItemInverseTable = "items"
+	// ItemColumn is the table column denoting the item relation/edge.
+	ItemColumn = "replenishment_task_item"
+	// FromBinTable is the table that holds the from_bin relation/edge.
+	FromBinTable = "replenishment_tasks"
+	// FromBinInverseTable is the table name for the Bin entity.
+	// It exists in this package in order to avoid circular dependency with the "bin" package.
+	FromBinInverseTable = "bins"
+	// FromBinColumn is the table column denoting the from_bin relation/edge.
+	FromBinColumn = "replenishment_task_from_bin"
+	// ToBinTable is the table that holds the to_bin relation/edge.
+	ToBinTable = "replenishment_tasks"
+	// ToBinInverseTable is the table name for the Bin entity.
+	// It exists in this package in order to avoid circular dependency with the "bin" package.
+	ToBinInverseTable = "bins"
+	// ToBinColumn is the table column denoting the to_bin relation/edge.
+	ToBinColumn = "replenishment_task_to_bin"
+)
+
+// Columns holds all SQL columns for replenishmenttask fields.
+var Columns = []string{
+	FieldID,
+	FieldQuantity,
+	FieldKind,
+	FieldStatus,
+	FieldCreatedAt,
+	FieldDoneAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "replenishment_tasks"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"replenishment_task_item",
+	"replenishment_task_from_bin",
+	"replenishment_task_to_bin",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	QuantityValidator func(int) error
+	// DefaultKind holds the default value on creation for the "kind" field.
+	DefaultKind string
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the ReplenishmentTask queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByQuantity orders the results by the quantity field.
+func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
+}
+
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByDoneAt orders the results by the done_at field.
+func ByDoneAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDoneAt, opts...).ToFunc()
+}
+
+// ByItemField orders the results by item field.
+func ByItemField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newItemStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByFromBinField orders the results by from_bin field.
+func ByFromBinField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFromBinStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByToBinField orders the results by to_bin field.
+func ByToBinField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newToBinStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newItemStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ItemInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ItemTable, ItemColumn),
+	)
+}
+func newFromBinStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FromBinInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, FromBinTable, FromBinColumn),
+	)
+}
+func newToBinStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ToBinInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ToBinTable, ToBinColumn),
+	)
+}
@@ -0,0 +1,425 @@
+// Code generated by ent, DO NOT EDIT.
+
+package replenishmenttask
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/mxV03/wms/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldLTE(FieldID, id))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v int) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldEQ(FieldQuantity, v))
+}
+
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldEQ(FieldKind, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// DoneAt applies equality check predicate on the "done_at" field. It's identical to DoneAtEQ.
+func DoneAt(v time.Time) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldEQ(FieldDoneAt, v))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v int) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v int) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...int) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...int) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v int) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v int) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v int) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v int) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldLTE(FieldQuantity, v))
+}
+
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldContainsFold(FieldKind, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// DoneAtEQ applies the EQ predicate on the "done_at" field.
+func DoneAtEQ(v time.Time) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldEQ(FieldDoneAt, v))
+}
+
+// DoneAtNEQ applies the NEQ predicate on the "done_at" field.
+func DoneAtNEQ(v time.Time) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldNEQ(FieldDoneAt, v))
+}
+
+// DoneAtIn applies the In predicate on the "done_at" field.
+func DoneAtIn(vs ...time.Time) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldIn(FieldDoneAt, vs...))
+}
+
+// DoneAtNotIn applies the NotIn predicate on the "done_at" field.
+func DoneAtNotIn(vs ...time.Time) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldNotIn(FieldDoneAt, vs...))
+}
+
+// DoneAtGT applies the GT predicate on the "done_at" field.
+func DoneAtGT(v time.Time) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldGT(FieldDoneAt, v))
+}
+
+// DoneAtGTE applies the GTE predicate on the "done_at" field.
+func DoneAtGTE(v time.Time) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldGTE(FieldDoneAt, v))
+}
+
+// DoneAtLT applies the LT predicate on the "done_at" field.
+func DoneAtLT(v time.Time) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldLT(FieldDoneAt, v))
+}
+
+// DoneAtLTE applies the LTE predicate on the "done_at" field.
+func DoneAtLTE(v time.Time) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldLTE(FieldDoneAt, v))
+}
+
+// DoneAtIsNil applies the IsNil predicate on the "done_at" field.
+func DoneAtIsNil() predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldIsNull(FieldDoneAt))
+}
+
+// DoneAtNotNil applies the NotNil predicate on the "done_at" field.
+func DoneAtNotNil() predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.FieldNotNull(FieldDoneAt))
+}
+
+// HasItem applies the HasEdge predicate on the "item" edge.
+func HasItem() predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ItemTable, ItemColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemWith applies the HasEdge predicate on the "item" edge with a given conditions (other predicates).
+func HasItemWith(preds ...predicate.Item) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(func(s *sql.Selector) {
+		step := newItemStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFromBin applies the HasEdge predicate on the "from_bin" edge.
+func HasFromBin() predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, FromBinTable, FromBinColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFromBinWith applies the HasEdge predicate on the "from_bin" edge with a given conditions (other predicates).
+func HasFromBinWith(preds ...predicate.Bin) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(func(s *sql.Selector) {
+		step := newFromBinStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasToBin applies the HasEdge predicate on the "to_bin" edge.
+func HasToBin() predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ToBinTable, ToBinColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasToBinWith applies the HasEdge predicate on the "to_bin" edge with a given conditions (other predicates).
+func HasToBinWith(preds ...predicate.Bin) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(func(s *sql.Selector) {
+		step := newToBinStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReplenishmentTask) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReplenishmentTask) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReplenishmentTask) predicate.ReplenishmentTask {
+	return predicate.ReplenishmentTask(sql.NotPredicates(p))
+}
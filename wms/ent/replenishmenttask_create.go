@@ -0,0 +1,383 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/bin"
+	"github.com/mxV03/wms/ent/item"
+	"github.com/mxV03/wms/ent/replenishmenttask"
+)
+
+// ReplenishmentTaskCreate is the builder for creating a ReplenishmentTask entity.
+type ReplenishmentTaskCreate struct {
+	config
+	mutation *ReplenishmentTaskMutation
+	hooks    []Hook
+}
+
+// SetQuantity sets the "quantity" field.
+func (_c *ReplenishmentTaskCreate) SetQuantity(v int) *ReplenishmentTaskCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *ReplenishmentTaskCreate) SetKind(v string) *ReplenishmentTaskCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_c *ReplenishmentTaskCreate) SetNillableKind(v *string) *ReplenishmentTaskCreate {
+	if v != nil {
+		_c.SetKind(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *ReplenishmentTaskCreate) SetStatus(v string) *ReplenishmentTaskCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ReplenishmentTaskCreate) SetNillableStatus(v *string) *ReplenishmentTaskCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ReplenishmentTaskCreate) SetCreatedAt(v time.Time) *ReplenishmentTaskCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ReplenishmentTaskCreate) SetNillableCreatedAt(v *time.Time) *ReplenishmentTaskCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetDoneAt sets the "done_at" field.
+func (_c *ReplenishmentTaskCreate) SetDoneAt(v time.Time) *ReplenishmentTaskCreate {
+	_c.mutation.SetDoneAt(v)
+	return _c
+}
+
+// SetNillableDoneAt sets the "done_at" field if the given value is not nil.
+func (_c *ReplenishmentTaskCreate) SetNillableDoneAt(v *time.Time) *ReplenishmentTaskCreate {
+	if v != nil {
+		_c.SetDoneAt(*v)
+	}
+	return _c
+}
+
+// SetItemID sets the "item" edge to the Item entity by ID.
+func (_c *ReplenishmentTaskCreate) SetItemID(id int) *ReplenishmentTaskCreate {
+	_c.mutation.SetItemID(id)
+	return _c
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_c *ReplenishmentTaskCreate) SetItem(v *Item) *ReplenishmentTaskCreate {
+	return _c.SetItemID(v.ID)
+}
+
+// SetFromBinID sets the "from_bin" edge to the Bin entity by ID.
+func (_c *ReplenishmentTaskCreate) SetFromBinID(id int) *ReplenishmentTaskCreate {
+	_c.mutation.SetFromBinID(id)
+	return _c
+}
+
+// SetFromBin sets the "from_bin" edge to the Bin entity.
+func (_c *ReplenishmentTaskCreate) SetFromBin(v *Bin) *ReplenishmentTaskCreate {
+	return _c.SetFromBinID(v.ID)
+}
+
+// SetToBinID sets the "to_bin" edge to the Bin entity by ID.
+func (_c *ReplenishmentTaskCreate) SetToBinID(id int) *ReplenishmentTaskCreate {
+	_c.mutation.SetToBinID(id)
+	return _c
+}
+
+// SetToBin sets the "to_bin" edge to the Bin entity.
+func (_c *ReplenishmentTaskCreate) SetToBin(v *Bin) *ReplenishmentTaskCreate {
+	return _c.SetToBinID(v.ID)
+}
+
+// Mutation returns the ReplenishmentTaskMutation object of the builder.
+func (_c *ReplenishmentTaskCreate) Mutation() *ReplenishmentTaskMutation {
+	return _c.mutation
+}
+
+// Save creates the ReplenishmentTask in the database.
+func (_c *ReplenishmentTaskCreate) Save(ctx context.Context) (*ReplenishmentTask, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ReplenishmentTaskCreate) SaveX(ctx context.Context) *ReplenishmentTask {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReplenishmentTaskCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReplenishmentTaskCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ReplenishmentTaskCreate) defaults() {
+	if _, ok := _c.mutation.Kind(); !ok {
+		v := replenishmenttask.DefaultKind
+		_c.mutation.SetKind(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := replenishmenttask.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := replenishmenttask.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ReplenishmentTaskCreate) check() error {
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "ReplenishmentTask.quantity"`)}
+	}
+	if v, ok := _c.mutation.Quantity(); ok {
+		if err := replenishmenttask.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "ReplenishmentTask.quantity": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "ReplenishmentTask.kind"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ReplenishmentTask.status"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ReplenishmentTask.created_at"`)}
+	}
+	if len(_c.mutation.ItemIDs()) == 0 {
+		return &ValidationError{Name: "item", err: errors.New(`ent: missing required edge "ReplenishmentTask.item"`)}
+	}
+	if len(_c.mutation.FromBinIDs()) == 0 {
+		return &ValidationError{Name: "from_bin", err: errors.New(`ent: missing required edge "ReplenishmentTask.from_bin"`)}
+	}
+	if len(_c.mutation.ToBinIDs()) == 0 {
+		return &ValidationError{Name: "to_bin", err: errors.New(`ent: missing required edge "ReplenishmentTask.to_bin"`)}
+	}
+	return nil
+}
+
+func (_c *ReplenishmentTaskCreate) sqlSave(ctx context.Context) (*ReplenishmentTask, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ReplenishmentTaskCreate) createSpec() (*ReplenishmentTask, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReplenishmentTask{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(replenishmenttask.Table, sqlgraph.NewFieldSpec(replenishmenttask.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(replenishmenttask.FieldQuantity, field.TypeInt, value)
+		_node.Quantity = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(replenishmenttask.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(replenishmenttask.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(replenishmenttask.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.DoneAt(); ok {
+		_spec.SetField(replenishmenttask.FieldDoneAt, field.TypeTime, value)
+		_node.DoneAt = &value
+	}
+	if nodes := _c.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   replenishmenttask.ItemTable,
+			Columns: []string{replenishmenttask.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.replenishment_task_item = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.FromBinIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   replenishmenttask.FromBinTable,
+			Columns: []string{replenishmenttask.FromBinColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bin.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.replenishment_task_from_bin = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ToBinIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   replenishmenttask.ToBinTable,
+			Columns: []string{replenishmenttask.ToBinColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bin.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.replenishment_task_to_bin = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ReplenishmentTaskCreateBulk is the builder for creating many ReplenishmentTask entities in bulk.
+type ReplenishmentTaskCreateBulk struct {
+	config
+	err      error
+	builders []*ReplenishmentTaskCreate
+}
+
+// Save creates the ReplenishmentTask entities in the database.
+func (_c *ReplenishmentTaskCreateBulk) Save(ctx context.Context) ([]*ReplenishmentTask, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ReplenishmentTask, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReplenishmentTaskMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ReplenishmentTaskCreateBulk) SaveX(ctx context.Context) []*ReplenishmentTask {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReplenishmentTaskCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReplenishmentTaskCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
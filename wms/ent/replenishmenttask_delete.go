@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/predicate"
+	"github.com/mxV03/wms/ent/replenishmenttask"
+)
+
+// ReplenishmentTaskDelete is the builder for deleting a ReplenishmentTask entity.
+type ReplenishmentTaskDelete struct {
+	config
+	hooks    []Hook
+	mutation *ReplenishmentTaskMutation
+}
+
+// Where appends a list predicates to the ReplenishmentTaskDelete builder.
+func (_d *ReplenishmentTaskDelete) Where(ps ...predicate.ReplenishmentTask) *ReplenishmentTaskDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ReplenishmentTaskDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ReplenishmentTaskDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ReplenishmentTaskDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(replenishmenttask.Table, sqlgraph.NewFieldSpec(replenishmenttask.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ReplenishmentTaskDeleteOne is the builder for deleting a single ReplenishmentTask entity.
+type ReplenishmentTaskDeleteOne struct {
+	_d *ReplenishmentTaskDelete
+}
+
+// Where appends a list predicates to the ReplenishmentTaskDelete builder.
+func (_d *ReplenishmentTaskDeleteOne) Where(ps ...predicate.ReplenishmentTask) *ReplenishmentTaskDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ReplenishmentTaskDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{replenishmenttask.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ReplenishmentTaskDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,763 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/bin"
+	"github.com/mxV03/wms/ent/item"
+	"github.com/mxV03/wms/ent/predicate"
+	"github.com/mxV03/wms/ent/replenishmenttask"
+)
+
+// ReplenishmentTaskQuery is the builder for querying ReplenishmentTask entities.
+type ReplenishmentTaskQuery struct {
+	config
+	ctx         *QueryContext
+	order       []replenishmenttask.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.ReplenishmentTask
+	withItem    *ItemQuery
+	withFromBin *BinQuery
+	withToBin   *BinQuery
+	withFKs     bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ReplenishmentTaskQuery builder.
+func (_q *ReplenishmentTaskQuery) Where(ps ...predicate.ReplenishmentTask) *ReplenishmentTaskQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *ReplenishmentTaskQuery) Limit(limit int) *ReplenishmentTaskQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *ReplenishmentTaskQuery) Offset(offset int) *ReplenishmentTaskQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *ReplenishmentTaskQuery) Unique(unique bool) *ReplenishmentTaskQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *ReplenishmentTaskQuery) Order(o ...replenishmenttask.OrderOption) *ReplenishmentTaskQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryItem chains the current query on the "item" edge.
+func (_q *ReplenishmentTaskQuery) QueryItem() *ItemQuery {
+	query := (&ItemClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(replenishmenttask.Table, replenishmenttask.FieldID, selector),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, replenishmenttask.ItemTable, replenishmenttask.ItemColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryFromBin chains the current query on the "from_bin" edge.
+func (_q *ReplenishmentTaskQuery) QueryFromBin() *BinQuery {
+	query := (&BinClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(replenishmenttask.Table, replenishmenttask.FieldID, selector),
+			sqlgraph.To(bin.Table, bin.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, replenishmenttask.FromBinTable, replenishmenttask.FromBinColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryToBin chains the current query on the "to_bin" edge.
+func (_q *ReplenishmentTaskQuery) QueryToBin() *BinQuery {
+	query := (&BinClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(replenishmenttask.Table, replenishmenttask.FieldID, selector),
+			sqlgraph.To(bin.Table, bin.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, replenishmenttask.ToBinTable, replenishmenttask.ToBinColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ReplenishmentTask entity from the query.
+// Returns a *NotFoundError when no ReplenishmentTask was found.
+func (_q *ReplenishmentTaskQuery) First(ctx context.Context) (*ReplenishmentTask, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{replenishmenttask.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *ReplenishmentTaskQuery) FirstX(ctx context.Context) *ReplenishmentTask {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ReplenishmentTask ID from the query.
+// Returns a *NotFoundError when no ReplenishmentTask ID was found.
+func (_q *ReplenishmentTaskQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{replenishmenttask.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *ReplenishmentTaskQuery) FirstIDX(ctx context.Context) int {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ReplenishmentTask entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ReplenishmentTask entity is found.
+// Returns a *NotFoundError when no ReplenishmentTask entities are found.
+func (_q *ReplenishmentTaskQuery) Only(ctx context.Context) (*ReplenishmentTask, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{replenishmenttask.Label}
+	default:
+		return nil, &NotSingularError{replenishmenttask.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *ReplenishmentTaskQuery) OnlyX(ctx context.Context) *ReplenishmentTask {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ReplenishmentTask ID in the query.
+// Returns a *NotSingularError when more than one ReplenishmentTask ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *ReplenishmentTaskQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{replenishmenttask.Label}
+	default:
+		err = &NotSingularError{replenishmenttask.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *ReplenishmentTaskQuery) OnlyIDX(ctx context.Context) int {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ReplenishmentTasks.
+func (_q *ReplenishmentTaskQuery) All(ctx context.Context) ([]*ReplenishmentTask, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ReplenishmentTask, *ReplenishmentTaskQuery]()
+	return withInterceptors[[]*ReplenishmentTask](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *ReplenishmentTaskQuery) AllX(ctx context.Context) []*ReplenishmentTask {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ReplenishmentTask IDs.
+func (_q *ReplenishmentTaskQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(replenishmenttask.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *ReplenishmentTaskQuery) IDsX(ctx context.Context) []int {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *ReplenishmentTaskQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*ReplenishmentTaskQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *ReplenishmentTaskQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *ReplenishmentTaskQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *ReplenishmentTaskQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ReplenishmentTaskQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *ReplenishmentTaskQuery) Clone() *ReplenishmentTaskQuery {
+	if _q == nil {
+		return nil
+	}
+	return &ReplenishmentTaskQuery{
+		config:      _q.config,
+		ctx:         _q.ctx.Clone(),
+		order:       append([]replenishmenttask.OrderOption{}, _q.order...),
+		inters:      append([]Interceptor{}, _q.inters...),
+		predicates:  append([]predicate.ReplenishmentTask{}, _q.predicates...),
+		withItem:    _q.withItem.Clone(),
+		withFromBin: _q.withFromBin.Clone(),
+		withToBin:   _q.withToBin.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithItem tells the query-builder to eager-load the nodes that are connected to
+// the "item" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ReplenishmentTaskQuery) WithItem(opts ...func(*ItemQuery)) *ReplenishmentTaskQuery {
+	query := (&ItemClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withItem = query
+	return _q
+}
+
+// WithFromBin tells the query-builder to eager-load the nodes that are connected to
+// the "from_bin" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ReplenishmentTaskQuery) WithFromBin(opts ...func(*BinQuery)) *ReplenishmentTaskQuery {
+	query := (&BinClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withFromBin = query
+	return _q
+}
+
+// WithToBin tells the query-builder to eager-load the nodes that are connected to
+// the "to_bin" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ReplenishmentTaskQuery) WithToBin(opts ...func(*BinQuery)) *ReplenishmentTaskQuery {
+	query := (&BinClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withToBin = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Quantity int `json:"quantity,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ReplenishmentTask.Query().
+//		GroupBy(replenishmenttask.FieldQuantity).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *ReplenishmentTaskQuery) GroupBy(field string, fields ...string) *ReplenishmentTaskGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ReplenishmentTaskGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = replenishmenttask.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Quantity int `json:"quantity,omitempty"`
+//	}
+//
+//	client.ReplenishmentTask.Query().
+//		Select(replenishmenttask.FieldQuantity).
+//		Scan(ctx, &v)
+func (_q *ReplenishmentTaskQuery) Select(fields ...string) *ReplenishmentTaskSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &ReplenishmentTaskSelect{ReplenishmentTaskQuery: _q}
+	sbuild.label = replenishmenttask.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ReplenishmentTaskSelect configured with the given aggregations.
+func (_q *ReplenishmentTaskQuery) Aggregate(fns ...AggregateFunc) *ReplenishmentTaskSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *ReplenishmentTaskQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !replenishmenttask.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *ReplenishmentTaskQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ReplenishmentTask, error) {
+	var (
+		nodes       = []*ReplenishmentTask{}
+		withFKs     = _q.withFKs
+		_spec       = _q.querySpec()
+		loadedTypes = [3]bool{
+			_q.withItem != nil,
+			_q.withFromBin != nil,
+			_q.withToBin != nil,
+		}
+	)
+	if _q.withItem != nil || _q.withFromBin != nil || _q.withToBin != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, replenishmenttask.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ReplenishmentTask).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ReplenishmentTask{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withItem; query != nil {
+		if err := _q.loadItem(ctx, query, nodes, nil,
+			func(n *ReplenishmentTask, e *Item) { n.Edges.Item = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withFromBin; query != nil {
+		if err := _q.loadFromBin(ctx, query, nodes, nil,
+			func(n *ReplenishmentTask, e *Bin) { n.Edges.FromBin = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withToBin; query != nil {
+		if err := _q.loadToBin(ctx, query, nodes, nil,
+			func(n *ReplenishmentTask, e *Bin) { n.Edges.ToBin = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *ReplenishmentTaskQuery) loadItem(ctx context.Context, query *ItemQuery, nodes []*ReplenishmentTask, init func(*ReplenishmentTask), assign func(*ReplenishmentTask, *Item)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ReplenishmentTask)
+	for i := range nodes {
+		if nodes[i].replenishment_task_item == nil {
+			continue
+		}
+		fk := *nodes[i].replenishment_task_item
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(item.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "replenishment_task_item" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *ReplenishmentTaskQuery) loadFromBin(ctx context.Context, query *BinQuery, nodes []*ReplenishmentTask, init func(*ReplenishmentTask), assign func(*ReplenishmentTask, *Bin)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ReplenishmentTask)
+	for i := range nodes {
+		if nodes[i].replenishment_task_from_bin == nil {
+			continue
+		}
+		fk := *nodes[i].replenishment_task_from_bin
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(bin.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "replenishment_task_from_bin" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *ReplenishmentTaskQuery) loadToBin(ctx context.Context, query *BinQuery, nodes []*ReplenishmentTask, init func(*ReplenishmentTask), assign func(*ReplenishmentTask, *Bin)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*ReplenishmentTask)
+	for i := range nodes {
+		if nodes[i].replenishment_task_to_bin == nil {
+			continue
+		}
+		fk := *nodes[i].replenishment_task_to_bin
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(bin.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "replenishment_task_to_bin" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (_q *ReplenishmentTaskQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *ReplenishmentTaskQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(replenishmenttask.Table, replenishmenttask.Columns, sqlgraph.NewFieldSpec(replenishmenttask.FieldID, field.TypeInt))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, replenishmenttask.FieldID)
+		for i := range fields {
+			if fields[i] != replenishmenttask.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *ReplenishmentTaskQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(replenishmenttask.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = replenishmenttask.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ReplenishmentTaskGroupBy is the group-by builder for ReplenishmentTask entities.
+type ReplenishmentTaskGroupBy struct {
+	selector
+	build *ReplenishmentTaskQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *ReplenishmentTaskGroupBy) Aggregate(fns ...AggregateFunc) *ReplenishmentTaskGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *ReplenishmentTaskGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ReplenishmentTaskQuery, *ReplenishmentTaskGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *ReplenishmentTaskGroupBy) sqlScan(ctx context.Context, root *ReplenishmentTaskQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ReplenishmentTaskSelect is the builder for selecting fields of ReplenishmentTask entities.
+type ReplenishmentTaskSelect struct {
+	*ReplenishmentTaskQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *ReplenishmentTaskSelect) Aggregate(fns ...AggregateFunc) *ReplenishmentTaskSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *ReplenishmentTaskSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ReplenishmentTaskQuery, *ReplenishmentTaskSelect](ctx, _s.ReplenishmentTaskQuery, _s, _s.inters, v)
+}
+
+func (_s *ReplenishmentTaskSelect) sqlScan(ctx context.Context, root *ReplenishmentTaskQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,706 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/bin"
+	"github.com/mxV03/wms/ent/item"
+	"github.com/mxV03/wms/ent/predicate"
+	"github.com/mxV03/wms/ent/replenishmenttask"
+)
+
+// ReplenishmentTaskUpdate is the builder for updating ReplenishmentTask entities.
+type ReplenishmentTaskUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReplenishmentTaskMutation
+}
+
+// Where appends a list predicates to the ReplenishmentTaskUpdate builder.
+func (_u *ReplenishmentTaskUpdate) Where(ps ...predicate.ReplenishmentTask) *ReplenishmentTaskUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *ReplenishmentTaskUpdate) SetQuantity(v int) *ReplenishmentTaskUpdate {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *ReplenishmentTaskUpdate) SetNillableQuantity(v *int) *ReplenishmentTaskUpdate {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *ReplenishmentTaskUpdate) AddQuantity(v int) *ReplenishmentTaskUpdate {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *ReplenishmentTaskUpdate) SetKind(v string) *ReplenishmentTaskUpdate {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *ReplenishmentTaskUpdate) SetNillableKind(v *string) *ReplenishmentTaskUpdate {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ReplenishmentTaskUpdate) SetStatus(v string) *ReplenishmentTaskUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ReplenishmentTaskUpdate) SetNillableStatus(v *string) *ReplenishmentTaskUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ReplenishmentTaskUpdate) SetCreatedAt(v time.Time) *ReplenishmentTaskUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ReplenishmentTaskUpdate) SetNillableCreatedAt(v *time.Time) *ReplenishmentTaskUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetDoneAt sets the "done_at" field.
+func (_u *ReplenishmentTaskUpdate) SetDoneAt(v time.Time) *ReplenishmentTaskUpdate {
+	_u.mutation.SetDoneAt(v)
+	return _u
+}
+
+// SetNillableDoneAt sets the "done_at" field if the given value is not nil.
+func (_u *ReplenishmentTaskUpdate) SetNillableDoneAt(v *time.Time) *ReplenishmentTaskUpdate {
+	if v != nil {
+		_u.SetDoneAt(*v)
+	}
+	return _u
+}
+
+// ClearDoneAt clears the value of the "done_at" field.
+func (_u *ReplenishmentTaskUpdate) ClearDoneAt() *ReplenishmentTaskUpdate {
+	_u.mutation.ClearDoneAt()
+	return _u
+}
+
+// SetItemID sets the "item" edge to the Item entity by ID.
+func (_u *ReplenishmentTaskUpdate) SetItemID(id int) *ReplenishmentTaskUpdate {
+	_u.mutation.SetItemID(id)
+	return _u
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_u *ReplenishmentTaskUpdate) SetItem(v *Item) *ReplenishmentTaskUpdate {
+	return _u.SetItemID(v.ID)
+}
+
+// SetFromBinID sets the "from_bin" edge to the Bin entity by ID.
+func (_u *ReplenishmentTaskUpdate) SetFromBinID(id int) *ReplenishmentTaskUpdate {
+	_u.mutation.SetFromBinID(id)
+	return _u
+}
+
+// SetFromBin sets the "from_bin" edge to the Bin entity.
+func (_u *ReplenishmentTaskUpdate) SetFromBin(v *Bin) *ReplenishmentTaskUpdate {
+	return _u.SetFromBinID(v.ID)
+}
+
+// SetToBinID sets the "to_bin" edge to the Bin entity by ID.
+func (_u *ReplenishmentTaskUpdate) SetToBinID(id int) *ReplenishmentTaskUpdate {
+	_u.mutation.SetToBinID(id)
+	return _u
+}
+
+// SetToBin sets the "to_bin" edge to the Bin entity.
+func (_u *ReplenishmentTaskUpdate) SetToBin(v *Bin) *ReplenishmentTaskUpdate {
+	return _u.SetToBinID(v.ID)
+}
+
+// Mutation returns the ReplenishmentTaskMutation object of the builder.
+func (_u *ReplenishmentTaskUpdate) Mutation() *ReplenishmentTaskMutation {
+	return _u.mutation
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (_u *ReplenishmentTaskUpdate) ClearItem() *ReplenishmentTaskUpdate {
+	_u.mutation.ClearItem()
+	return _u
+}
+
+// ClearFromBin clears the "from_bin" edge to the Bin entity.
+func (_u *ReplenishmentTaskUpdate) ClearFromBin() *ReplenishmentTaskUpdate {
+	_u.mutation.ClearFromBin()
+	return _u
+}
+
+// ClearToBin clears the "to_bin" edge to the Bin entity.
+func (_u *ReplenishmentTaskUpdate) ClearToBin() *ReplenishmentTaskUpdate {
+	_u.mutation.ClearToBin()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ReplenishmentTaskUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReplenishmentTaskUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ReplenishmentTaskUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReplenishmentTaskUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReplenishmentTaskUpdate) check() error {
+	if v, ok := _u.mutation.Quantity(); ok {
+		if err := replenishmenttask.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "ReplenishmentTask.quantity": %w`, err)}
+		}
+	}
+	if _u.mutation.ItemCleared() && len(_u.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReplenishmentTask.item"`)
+	}
+	if _u.mutation.FromBinCleared() && len(_u.mutation.FromBinIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReplenishmentTask.from_bin"`)
+	}
+	if _u.mutation.ToBinCleared() && len(_u.mutation.ToBinIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReplenishmentTask.to_bin"`)
+	}
+	return nil
+}
+
+func (_u *ReplenishmentTaskUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(replenishmenttask.Table, replenishmenttask.Columns, sqlgraph.NewFieldSpec(replenishmenttask.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(replenishmenttask.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(replenishmenttask.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(replenishmenttask.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(replenishmenttask.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(replenishmenttask.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DoneAt(); ok {
+		_spec.SetField(replenishmenttask.FieldDoneAt, field.TypeTime, value)
+	}
+	if _u.mutation.DoneAtCleared() {
+		_spec.ClearField(replenishmenttask.FieldDoneAt, field.TypeTime)
+	}
+	if _u.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   replenishmenttask.ItemTable,
+			Columns: []string{replenishmenttask.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   replenishmenttask.ItemTable,
+			Columns: []string{replenishmenttask.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FromBinCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   replenishmenttask.FromBinTable,
+			Columns: []string{replenishmenttask.FromBinColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bin.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FromBinIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   replenishmenttask.FromBinTable,
+			Columns: []string{replenishmenttask.FromBinColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bin.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ToBinCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   replenishmenttask.ToBinTable,
+			Columns: []string{replenishmenttask.ToBinColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bin.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ToBinIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   replenishmenttask.ToBinTable,
+			Columns: []string{replenishmenttask.ToBinColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bin.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{replenishmenttask.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ReplenishmentTaskUpdateOne is the builder for updating a single ReplenishmentTask entity.
+type ReplenishmentTaskUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReplenishmentTaskMutation
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *ReplenishmentTaskUpdateOne) SetQuantity(v int) *ReplenishmentTaskUpdateOne {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *ReplenishmentTaskUpdateOne) SetNillableQuantity(v *int) *ReplenishmentTaskUpdateOne {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *ReplenishmentTaskUpdateOne) AddQuantity(v int) *ReplenishmentTaskUpdateOne {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetKind sets the "kind" field.
+func (_u *ReplenishmentTaskUpdateOne) SetKind(v string) *ReplenishmentTaskUpdateOne {
+	_u.mutation.SetKind(v)
+	return _u
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (_u *ReplenishmentTaskUpdateOne) SetNillableKind(v *string) *ReplenishmentTaskUpdateOne {
+	if v != nil {
+		_u.SetKind(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *ReplenishmentTaskUpdateOne) SetStatus(v string) *ReplenishmentTaskUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ReplenishmentTaskUpdateOne) SetNillableStatus(v *string) *ReplenishmentTaskUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *ReplenishmentTaskUpdateOne) SetCreatedAt(v time.Time) *ReplenishmentTaskUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *ReplenishmentTaskUpdateOne) SetNillableCreatedAt(v *time.Time) *ReplenishmentTaskUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetDoneAt sets the "done_at" field.
+func (_u *ReplenishmentTaskUpdateOne) SetDoneAt(v time.Time) *ReplenishmentTaskUpdateOne {
+	_u.mutation.SetDoneAt(v)
+	return _u
+}
+
+// SetNillableDoneAt sets the "done_at" field if the given value is not nil.
+func (_u *ReplenishmentTaskUpdateOne) SetNillableDoneAt(v *time.Time) *ReplenishmentTaskUpdateOne {
+	if v != nil {
+		_u.SetDoneAt(*v)
+	}
+	return _u
+}
+
+// ClearDoneAt clears the value of the "done_at" field.
+func (_u *ReplenishmentTaskUpdateOne) ClearDoneAt() *ReplenishmentTaskUpdateOne {
+	_u.mutation.ClearDoneAt()
+	return _u
+}
+
+// SetItemID sets the "item" edge to the Item entity by ID.
+func (_u *ReplenishmentTaskUpdateOne) SetItemID(id int) *ReplenishmentTaskUpdateOne {
+	_u.mutation.SetItemID(id)
+	return _u
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_u *ReplenishmentTaskUpdateOne) SetItem(v *Item) *ReplenishmentTaskUpdateOne {
+	return _u.SetItemID(v.ID)
+}
+
+// SetFromBinID sets the "from_bin" edge to the Bin entity by ID.
+func (_u *ReplenishmentTaskUpdateOne) SetFromBinID(id int) *ReplenishmentTaskUpdateOne {
+	_u.mutation.SetFromBinID(id)
+	return _u
+}
+
+// SetFromBin sets the "from_bin" edge to the Bin entity.
+func (_u *ReplenishmentTaskUpdateOne) SetFromBin(v *Bin) *ReplenishmentTaskUpdateOne {
+	return _u.SetFromBinID(v.ID)
+}
+
+// SetToBinID sets the "to_bin" edge to the Bin entity by ID.
+func (_u *ReplenishmentTaskUpdateOne) SetToBinID(id int) *ReplenishmentTaskUpdateOne {
+	_u.mutation.SetToBinID(id)
+	return _u
+}
+
+// SetToBin sets the "to_bin" edge to the Bin entity.
+func (_u *ReplenishmentTaskUpdateOne) SetToBin(v *Bin) *ReplenishmentTaskUpdateOne {
+	return _u.SetToBinID(v.ID)
+}
+
+// Mutation returns the ReplenishmentTaskMutation object of the builder.
+func (_u *ReplenishmentTaskUpdateOne) Mutation() *ReplenishmentTaskMutation {
+	return _u.mutation
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (_u *ReplenishmentTaskUpdateOne) ClearItem() *ReplenishmentTaskUpdateOne {
+	_u.mutation.ClearItem()
+	return _u
+}
+
+// ClearFromBin clears the "from_bin" edge to the Bin entity.
+func (_u *ReplenishmentTaskUpdateOne) ClearFromBin() *ReplenishmentTaskUpdateOne {
+	_u.mutation.ClearFromBin()
+	return _u
+}
+
+// ClearToBin clears the "to_bin" edge to the Bin entity.
+func (_u *ReplenishmentTaskUpdateOne) ClearToBin() *ReplenishmentTaskUpdateOne {
+	_u.mutation.ClearToBin()
+	return _u
+}
+
+// Where appends a list predicates to the ReplenishmentTaskUpdate builder.
+func (_u *ReplenishmentTaskUpdateOne) Where(ps ...predicate.ReplenishmentTask) *ReplenishmentTaskUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ReplenishmentTaskUpdateOne) Select(field string, fields ...string) *ReplenishmentTaskUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ReplenishmentTask entity.
+func (_u *ReplenishmentTaskUpdateOne) Save(ctx context.Context) (*ReplenishmentTask, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReplenishmentTaskUpdateOne) SaveX(ctx context.Context) *ReplenishmentTask {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ReplenishmentTaskUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReplenishmentTaskUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReplenishmentTaskUpdateOne) check() error {
+	if v, ok := _u.mutation.Quantity(); ok {
+		if err := replenishmenttask.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "ReplenishmentTask.quantity": %w`, err)}
+		}
+	}
+	if _u.mutation.ItemCleared() && len(_u.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReplenishmentTask.item"`)
+	}
+	if _u.mutation.FromBinCleared() && len(_u.mutation.FromBinIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReplenishmentTask.from_bin"`)
+	}
+	if _u.mutation.ToBinCleared() && len(_u.mutation.ToBinIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReplenishmentTask.to_bin"`)
+	}
+	return nil
+}
+
+func (_u *ReplenishmentTaskUpdateOne) sqlSave(ctx context.Context) (_node *ReplenishmentTask, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(replenishmenttask.Table, replenishmenttask.Columns, sqlgraph.NewFieldSpec(replenishmenttask.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReplenishmentTask.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, replenishmenttask.FieldID)
+		for _, f := range fields {
+			if !replenishmenttask.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != replenishmenttask.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(replenishmenttask.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(replenishmenttask.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Kind(); ok {
+		_spec.SetField(replenishmenttask.FieldKind, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(replenishmenttask.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(replenishmenttask.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DoneAt(); ok {
+		_spec.SetField(replenishmenttask.FieldDoneAt, field.TypeTime, value)
+	}
+	if _u.mutation.DoneAtCleared() {
+		_spec.ClearField(replenishmenttask.FieldDoneAt, field.TypeTime)
+	}
+	if _u.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   replenishmenttask.ItemTable,
+			Columns: []string{replenishmenttask.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   replenishmenttask.ItemTable,
+			Columns: []string{replenishmenttask.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FromBinCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   replenishmenttask.FromBinTable,
+			Columns: []string{replenishmenttask.FromBinColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bin.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FromBinIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   replenishmenttask.FromBinTable,
+			Columns: []string{replenishmenttask.FromBinColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bin.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ToBinCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   replenishmenttask.ToBinTable,
+			Columns: []string{replenishmenttask.ToBinColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bin.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ToBinIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   replenishmenttask.ToBinTable,
+			Columns: []string{replenishmenttask.ToBinColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bin.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ReplenishmentTask{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{replenishmenttask.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
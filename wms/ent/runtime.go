@@ -7,17 +7,32 @@ import (
 
 	"github.com/mxV03/wms/ent/auditevent"
 	"github.com/mxV03/wms/ent/bin"
+	"github.com/mxV03/wms/ent/binstock"
+	"github.com/mxV03/wms/ent/carton"
+	"github.com/mxV03/wms/ent/cartonline"
+	"github.com/mxV03/wms/ent/cartontype"
+	"github.com/mxV03/wms/ent/eventrule"
 	"github.com/mxV03/wms/ent/item"
 	"github.com/mxV03/wms/ent/location"
 	"github.com/mxV03/wms/ent/order"
 	"github.com/mxV03/wms/ent/orderline"
 	"github.com/mxV03/wms/ent/picklist"
 	"github.com/mxV03/wms/ent/picktask"
+	"github.com/mxV03/wms/ent/putawaytask"
+	"github.com/mxV03/wms/ent/receipt"
+	"github.com/mxV03/wms/ent/reorderpolicy"
+	"github.com/mxV03/wms/ent/replenishmentrule"
+	"github.com/mxV03/wms/ent/replenishmenttask"
 	"github.com/mxV03/wms/ent/schema"
+	"github.com/mxV03/wms/ent/sequence"
+	"github.com/mxV03/wms/ent/stockdiscrepancy"
 	"github.com/mxV03/wms/ent/stockmovement"
 	"github.com/mxV03/wms/ent/tracking"
 	"github.com/mxV03/wms/ent/user"
 	"github.com/mxV03/wms/ent/warehouse"
+	"github.com/mxV03/wms/ent/wave"
+	"github.com/mxV03/wms/ent/wavepick"
+	"github.com/mxV03/wms/ent/wavetask"
 	"github.com/mxV03/wms/ent/zone"
 )
 
@@ -57,6 +72,132 @@ func init() {
 	binDescCode := binFields[0].Descriptor()
 	// bin.CodeValidator is a validator for the "code" field. It is called by the builders before save.
 	bin.CodeValidator = binDescCode.Validators[0].(func(string) error)
+	// binDescAisle is the schema descriptor for aisle field.
+	binDescAisle := binFields[2].Descriptor()
+	// bin.DefaultAisle holds the default value on creation for the aisle field.
+	bin.DefaultAisle = binDescAisle.Default.(int)
+	// bin.AisleValidator is a validator for the "aisle" field. It is called by the builders before save.
+	bin.AisleValidator = binDescAisle.Validators[0].(func(int) error)
+	// binDescPosition is the schema descriptor for position field.
+	binDescPosition := binFields[3].Descriptor()
+	// bin.DefaultPosition holds the default value on creation for the position field.
+	bin.DefaultPosition = binDescPosition.Default.(int)
+	// bin.PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	bin.PositionValidator = binDescPosition.Validators[0].(func(int) error)
+	// binDescLevel is the schema descriptor for level field.
+	binDescLevel := binFields[4].Descriptor()
+	// bin.DefaultLevel holds the default value on creation for the level field.
+	bin.DefaultLevel = binDescLevel.Default.(int)
+	// bin.LevelValidator is a validator for the "level" field. It is called by the builders before save.
+	bin.LevelValidator = binDescLevel.Validators[0].(func(int) error)
+	binstockFields := schema.BinStock{}.Fields()
+	_ = binstockFields
+	// binstockDescQuantity is the schema descriptor for quantity field.
+	binstockDescQuantity := binstockFields[0].Descriptor()
+	// binstock.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	binstock.QuantityValidator = binstockDescQuantity.Validators[0].(func(int) error)
+	// binstockDescReceivedAt is the schema descriptor for received_at field.
+	binstockDescReceivedAt := binstockFields[1].Descriptor()
+	// binstock.DefaultReceivedAt holds the default value on creation for the received_at field.
+	binstock.DefaultReceivedAt = binstockDescReceivedAt.Default.(func() time.Time)
+	cartonFields := schema.Carton{}.Fields()
+	_ = cartonFields
+	// cartonDescNumber is the schema descriptor for number field.
+	cartonDescNumber := cartonFields[0].Descriptor()
+	// carton.NumberValidator is a validator for the "number" field. It is called by the builders before save.
+	carton.NumberValidator = cartonDescNumber.Validators[0].(func(string) error)
+	// cartonDescStatus is the schema descriptor for status field.
+	cartonDescStatus := cartonFields[1].Descriptor()
+	// carton.DefaultStatus holds the default value on creation for the status field.
+	carton.DefaultStatus = cartonDescStatus.Default.(string)
+	// cartonDescLength is the schema descriptor for length field.
+	cartonDescLength := cartonFields[2].Descriptor()
+	// carton.DefaultLength holds the default value on creation for the length field.
+	carton.DefaultLength = cartonDescLength.Default.(float64)
+	// cartonDescWidth is the schema descriptor for width field.
+	cartonDescWidth := cartonFields[3].Descriptor()
+	// carton.DefaultWidth holds the default value on creation for the width field.
+	carton.DefaultWidth = cartonDescWidth.Default.(float64)
+	// cartonDescHeight is the schema descriptor for height field.
+	cartonDescHeight := cartonFields[4].Descriptor()
+	// carton.DefaultHeight holds the default value on creation for the height field.
+	carton.DefaultHeight = cartonDescHeight.Default.(float64)
+	// cartonDescWeight is the schema descriptor for weight field.
+	cartonDescWeight := cartonFields[5].Descriptor()
+	// carton.DefaultWeight holds the default value on creation for the weight field.
+	carton.DefaultWeight = cartonDescWeight.Default.(float64)
+	// cartonDescCreatedAt is the schema descriptor for created_at field.
+	cartonDescCreatedAt := cartonFields[6].Descriptor()
+	// carton.DefaultCreatedAt holds the default value on creation for the created_at field.
+	carton.DefaultCreatedAt = cartonDescCreatedAt.Default.(func() time.Time)
+	cartonlineFields := schema.CartonLine{}.Fields()
+	_ = cartonlineFields
+	// cartonlineDescQuantity is the schema descriptor for quantity field.
+	cartonlineDescQuantity := cartonlineFields[0].Descriptor()
+	// cartonline.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	cartonline.QuantityValidator = cartonlineDescQuantity.Validators[0].(func(int) error)
+	cartontypeFields := schema.CartonType{}.Fields()
+	_ = cartontypeFields
+	// cartontypeDescCode is the schema descriptor for code field.
+	cartontypeDescCode := cartontypeFields[0].Descriptor()
+	// cartontype.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	cartontype.CodeValidator = cartontypeDescCode.Validators[0].(func(string) error)
+	// cartontypeDescLength is the schema descriptor for length field.
+	cartontypeDescLength := cartontypeFields[1].Descriptor()
+	// cartontype.LengthValidator is a validator for the "length" field. It is called by the builders before save.
+	cartontype.LengthValidator = cartontypeDescLength.Validators[0].(func(float64) error)
+	// cartontypeDescWidth is the schema descriptor for width field.
+	cartontypeDescWidth := cartontypeFields[2].Descriptor()
+	// cartontype.WidthValidator is a validator for the "width" field. It is called by the builders before save.
+	cartontype.WidthValidator = cartontypeDescWidth.Validators[0].(func(float64) error)
+	// cartontypeDescHeight is the schema descriptor for height field.
+	cartontypeDescHeight := cartontypeFields[3].Descriptor()
+	// cartontype.HeightValidator is a validator for the "height" field. It is called by the builders before save.
+	cartontype.HeightValidator = cartontypeDescHeight.Validators[0].(func(float64) error)
+	// cartontypeDescMaxWeight is the schema descriptor for max_weight field.
+	cartontypeDescMaxWeight := cartontypeFields[4].Descriptor()
+	// cartontype.MaxWeightValidator is a validator for the "max_weight" field. It is called by the builders before save.
+	cartontype.MaxWeightValidator = cartontypeDescMaxWeight.Validators[0].(func(float64) error)
+	// cartontypeDescTareWeight is the schema descriptor for tare_weight field.
+	cartontypeDescTareWeight := cartontypeFields[5].Descriptor()
+	// cartontype.DefaultTareWeight holds the default value on creation for the tare_weight field.
+	cartontype.DefaultTareWeight = cartontypeDescTareWeight.Default.(float64)
+	eventruleFields := schema.EventRule{}.Fields()
+	_ = eventruleFields
+	// eventruleDescName is the schema descriptor for name field.
+	eventruleDescName := eventruleFields[0].Descriptor()
+	// eventrule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	eventrule.NameValidator = eventruleDescName.Validators[0].(func(string) error)
+	// eventruleDescEvent is the schema descriptor for event field.
+	eventruleDescEvent := eventruleFields[1].Descriptor()
+	// eventrule.EventValidator is a validator for the "event" field. It is called by the builders before save.
+	eventrule.EventValidator = eventruleDescEvent.Validators[0].(func(string) error)
+	// eventruleDescConditions is the schema descriptor for conditions field.
+	eventruleDescConditions := eventruleFields[2].Descriptor()
+	// eventrule.DefaultConditions holds the default value on creation for the conditions field.
+	eventrule.DefaultConditions = eventruleDescConditions.Default.(string)
+	// eventruleDescAction is the schema descriptor for action field.
+	eventruleDescAction := eventruleFields[3].Descriptor()
+	// eventrule.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	eventrule.ActionValidator = eventruleDescAction.Validators[0].(func(string) error)
+	// eventruleDescArgument is the schema descriptor for argument field.
+	eventruleDescArgument := eventruleFields[4].Descriptor()
+	// eventrule.DefaultArgument holds the default value on creation for the argument field.
+	eventrule.DefaultArgument = eventruleDescArgument.Default.(string)
+	// eventruleDescEnabled is the schema descriptor for enabled field.
+	eventruleDescEnabled := eventruleFields[5].Descriptor()
+	// eventrule.DefaultEnabled holds the default value on creation for the enabled field.
+	eventrule.DefaultEnabled = eventruleDescEnabled.Default.(bool)
+	// eventruleDescFired is the schema descriptor for fired field.
+	eventruleDescFired := eventruleFields[6].Descriptor()
+	// eventrule.DefaultFired holds the default value on creation for the fired field.
+	eventrule.DefaultFired = eventruleDescFired.Default.(int)
+	// eventrule.FiredValidator is a validator for the "fired" field. It is called by the builders before save.
+	eventrule.FiredValidator = eventruleDescFired.Validators[0].(func(int) error)
+	// eventruleDescCreatedAt is the schema descriptor for created_at field.
+	eventruleDescCreatedAt := eventruleFields[8].Descriptor()
+	// eventrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	eventrule.DefaultCreatedAt = eventruleDescCreatedAt.Default.(func() time.Time)
 	itemFields := schema.Item{}.Fields()
 	_ = itemFields
 	// itemDescSKU is the schema descriptor for SKU field.
@@ -67,6 +208,26 @@ func init() {
 	itemDescName := itemFields[1].Descriptor()
 	// item.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	item.NameValidator = itemDescName.Validators[0].(func(string) error)
+	// itemDescLength is the schema descriptor for length field.
+	itemDescLength := itemFields[3].Descriptor()
+	// item.DefaultLength holds the default value on creation for the length field.
+	item.DefaultLength = itemDescLength.Default.(float64)
+	// itemDescWidth is the schema descriptor for width field.
+	itemDescWidth := itemFields[4].Descriptor()
+	// item.DefaultWidth holds the default value on creation for the width field.
+	item.DefaultWidth = itemDescWidth.Default.(float64)
+	// itemDescHeight is the schema descriptor for height field.
+	itemDescHeight := itemFields[5].Descriptor()
+	// item.DefaultHeight holds the default value on creation for the height field.
+	item.DefaultHeight = itemDescHeight.Default.(float64)
+	// itemDescWeight is the schema descriptor for weight field.
+	itemDescWeight := itemFields[6].Descriptor()
+	// item.DefaultWeight holds the default value on creation for the weight field.
+	item.DefaultWeight = itemDescWeight.Default.(float64)
+	// itemDescUnitPrice is the schema descriptor for unit_price field.
+	itemDescUnitPrice := itemFields[7].Descriptor()
+	// item.DefaultUnitPrice holds the default value on creation for the unit_price field.
+	item.DefaultUnitPrice = itemDescUnitPrice.Default.(float64)
 	locationFields := schema.Location{}.Fields()
 	_ = locationFields
 	// locationDescCode is the schema descriptor for code field.
@@ -77,6 +238,14 @@ func init() {
 	locationDescName := locationFields[1].Descriptor()
 	// location.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	location.NameValidator = locationDescName.Validators[0].(func(string) error)
+	// locationDescOnHold is the schema descriptor for on_hold field.
+	locationDescOnHold := locationFields[2].Descriptor()
+	// location.DefaultOnHold holds the default value on creation for the on_hold field.
+	location.DefaultOnHold = locationDescOnHold.Default.(bool)
+	// locationDescHoldReason is the schema descriptor for hold_reason field.
+	locationDescHoldReason := locationFields[3].Descriptor()
+	// location.DefaultHoldReason holds the default value on creation for the hold_reason field.
+	location.DefaultHoldReason = locationDescHoldReason.Default.(string)
 	orderFields := schema.Order{}.Fields()
 	_ = orderFields
 	// orderDescOrderNumber is the schema descriptor for order_number field.
@@ -97,6 +266,12 @@ func init() {
 	orderDescCreatedAt := orderFields[3].Descriptor()
 	// order.DefaultCreatedAt holds the default value on creation for the created_at field.
 	order.DefaultCreatedAt = orderDescCreatedAt.Default.(func() time.Time)
+	// orderDescPriority is the schema descriptor for priority field.
+	orderDescPriority := orderFields[4].Descriptor()
+	// order.DefaultPriority holds the default value on creation for the priority field.
+	order.DefaultPriority = orderDescPriority.Default.(string)
+	// order.PriorityValidator is a validator for the "priority" field. It is called by the builders before save.
+	order.PriorityValidator = orderDescPriority.Validators[0].(func(string) error)
 	orderlineFields := schema.OrderLine{}.Fields()
 	_ = orderlineFields
 	// orderlineDescQuantity is the schema descriptor for quantity field.
@@ -106,11 +281,15 @@ func init() {
 	picklistFields := schema.PickList{}.Fields()
 	_ = picklistFields
 	// picklistDescStatus is the schema descriptor for status field.
-	picklistDescStatus := picklistFields[0].Descriptor()
+	picklistDescStatus := picklistFields[1].Descriptor()
 	// picklist.DefaultStatus holds the default value on creation for the status field.
 	picklist.DefaultStatus = picklistDescStatus.Default.(string)
+	// picklistDescAssignedTo is the schema descriptor for assigned_to field.
+	picklistDescAssignedTo := picklistFields[2].Descriptor()
+	// picklist.DefaultAssignedTo holds the default value on creation for the assigned_to field.
+	picklist.DefaultAssignedTo = picklistDescAssignedTo.Default.(string)
 	// picklistDescCreatedAt is the schema descriptor for created_at field.
-	picklistDescCreatedAt := picklistFields[1].Descriptor()
+	picklistDescCreatedAt := picklistFields[4].Descriptor()
 	// picklist.DefaultCreatedAt holds the default value on creation for the created_at field.
 	picklist.DefaultCreatedAt = picklistDescCreatedAt.Default.(func() time.Time)
 	picktaskFields := schema.PickTask{}.Fields()
@@ -119,10 +298,168 @@ func init() {
 	picktaskDescQuantity := picktaskFields[0].Descriptor()
 	// picktask.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
 	picktask.QuantityValidator = picktaskDescQuantity.Validators[0].(func(int) error)
+	// picktaskDescPicked is the schema descriptor for picked field.
+	picktaskDescPicked := picktaskFields[1].Descriptor()
+	// picktask.DefaultPicked holds the default value on creation for the picked field.
+	picktask.DefaultPicked = picktaskDescPicked.Default.(int)
+	// picktask.PickedValidator is a validator for the "picked" field. It is called by the builders before save.
+	picktask.PickedValidator = picktaskDescPicked.Validators[0].(func(int) error)
 	// picktaskDescStatus is the schema descriptor for status field.
-	picktaskDescStatus := picktaskFields[1].Descriptor()
+	picktaskDescStatus := picktaskFields[2].Descriptor()
 	// picktask.DefaultStatus holds the default value on creation for the status field.
 	picktask.DefaultStatus = picktaskDescStatus.Default.(string)
+	// picktaskDescAssignedTo is the schema descriptor for assigned_to field.
+	picktaskDescAssignedTo := picktaskFields[5].Descriptor()
+	// picktask.DefaultAssignedTo holds the default value on creation for the assigned_to field.
+	picktask.DefaultAssignedTo = picktaskDescAssignedTo.Default.(string)
+	// picktaskDescPickedBy is the schema descriptor for picked_by field.
+	picktaskDescPickedBy := picktaskFields[6].Descriptor()
+	// picktask.DefaultPickedBy holds the default value on creation for the picked_by field.
+	picktask.DefaultPickedBy = picktaskDescPickedBy.Default.(string)
+	putawaytaskFields := schema.PutawayTask{}.Fields()
+	_ = putawaytaskFields
+	// putawaytaskDescQuantity is the schema descriptor for quantity field.
+	putawaytaskDescQuantity := putawaytaskFields[0].Descriptor()
+	// putawaytask.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	putawaytask.QuantityValidator = putawaytaskDescQuantity.Validators[0].(func(int) error)
+	// putawaytaskDescStatus is the schema descriptor for status field.
+	putawaytaskDescStatus := putawaytaskFields[1].Descriptor()
+	// putawaytask.DefaultStatus holds the default value on creation for the status field.
+	putawaytask.DefaultStatus = putawaytaskDescStatus.Default.(string)
+	// putawaytaskDescReference is the schema descriptor for reference field.
+	putawaytaskDescReference := putawaytaskFields[2].Descriptor()
+	// putawaytask.DefaultReference holds the default value on creation for the reference field.
+	putawaytask.DefaultReference = putawaytaskDescReference.Default.(string)
+	// putawaytaskDescStrategy is the schema descriptor for strategy field.
+	putawaytaskDescStrategy := putawaytaskFields[4].Descriptor()
+	// putawaytask.DefaultStrategy holds the default value on creation for the strategy field.
+	putawaytask.DefaultStrategy = putawaytaskDescStrategy.Default.(string)
+	// putawaytaskDescCreatedAt is the schema descriptor for created_at field.
+	putawaytaskDescCreatedAt := putawaytaskFields[6].Descriptor()
+	// putawaytask.DefaultCreatedAt holds the default value on creation for the created_at field.
+	putawaytask.DefaultCreatedAt = putawaytaskDescCreatedAt.Default.(func() time.Time)
+	receiptFields := schema.Receipt{}.Fields()
+	_ = receiptFields
+	// receiptDescQuantity is the schema descriptor for quantity field.
+	receiptDescQuantity := receiptFields[0].Descriptor()
+	// receipt.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	receipt.QuantityValidator = receiptDescQuantity.Validators[0].(func(int) error)
+	// receiptDescDamaged is the schema descriptor for damaged field.
+	receiptDescDamaged := receiptFields[1].Descriptor()
+	// receipt.DefaultDamaged holds the default value on creation for the damaged field.
+	receipt.DefaultDamaged = receiptDescDamaged.Default.(int)
+	// receipt.DamagedValidator is a validator for the "damaged" field. It is called by the builders before save.
+	receipt.DamagedValidator = receiptDescDamaged.Validators[0].(func(int) error)
+	// receiptDescNote is the schema descriptor for note field.
+	receiptDescNote := receiptFields[2].Descriptor()
+	// receipt.DefaultNote holds the default value on creation for the note field.
+	receipt.DefaultNote = receiptDescNote.Default.(string)
+	// receiptDescCreatedAt is the schema descriptor for created_at field.
+	receiptDescCreatedAt := receiptFields[3].Descriptor()
+	// receipt.DefaultCreatedAt holds the default value on creation for the created_at field.
+	receipt.DefaultCreatedAt = receiptDescCreatedAt.Default.(func() time.Time)
+	reorderpolicyFields := schema.ReorderPolicy{}.Fields()
+	_ = reorderpolicyFields
+	// reorderpolicyDescReorderPoint is the schema descriptor for reorder_point field.
+	reorderpolicyDescReorderPoint := reorderpolicyFields[0].Descriptor()
+	// reorderpolicy.ReorderPointValidator is a validator for the "reorder_point" field. It is called by the builders before save.
+	reorderpolicy.ReorderPointValidator = reorderpolicyDescReorderPoint.Validators[0].(func(int) error)
+	// reorderpolicyDescSafetyStock is the schema descriptor for safety_stock field.
+	reorderpolicyDescSafetyStock := reorderpolicyFields[1].Descriptor()
+	// reorderpolicy.DefaultSafetyStock holds the default value on creation for the safety_stock field.
+	reorderpolicy.DefaultSafetyStock = reorderpolicyDescSafetyStock.Default.(int)
+	// reorderpolicy.SafetyStockValidator is a validator for the "safety_stock" field. It is called by the builders before save.
+	reorderpolicy.SafetyStockValidator = reorderpolicyDescSafetyStock.Validators[0].(func(int) error)
+	// reorderpolicyDescReorderQuantity is the schema descriptor for reorder_quantity field.
+	reorderpolicyDescReorderQuantity := reorderpolicyFields[2].Descriptor()
+	// reorderpolicy.ReorderQuantityValidator is a validator for the "reorder_quantity" field. It is called by the builders before save.
+	reorderpolicy.ReorderQuantityValidator = reorderpolicyDescReorderQuantity.Validators[0].(func(int) error)
+	// reorderpolicyDescLeadTimeDays is the schema descriptor for lead_time_days field.
+	reorderpolicyDescLeadTimeDays := reorderpolicyFields[3].Descriptor()
+	// reorderpolicy.DefaultLeadTimeDays holds the default value on creation for the lead_time_days field.
+	reorderpolicy.DefaultLeadTimeDays = reorderpolicyDescLeadTimeDays.Default.(int)
+	// reorderpolicy.LeadTimeDaysValidator is a validator for the "lead_time_days" field. It is called by the builders before save.
+	reorderpolicy.LeadTimeDaysValidator = reorderpolicyDescLeadTimeDays.Validators[0].(func(int) error)
+	// reorderpolicyDescSupplier is the schema descriptor for supplier field.
+	reorderpolicyDescSupplier := reorderpolicyFields[5].Descriptor()
+	// reorderpolicy.DefaultSupplier holds the default value on creation for the supplier field.
+	reorderpolicy.DefaultSupplier = reorderpolicyDescSupplier.Default.(string)
+	replenishmentruleFields := schema.ReplenishmentRule{}.Fields()
+	_ = replenishmentruleFields
+	// replenishmentruleDescMin is the schema descriptor for min field.
+	replenishmentruleDescMin := replenishmentruleFields[0].Descriptor()
+	// replenishmentrule.MinValidator is a validator for the "min" field. It is called by the builders before save.
+	replenishmentrule.MinValidator = replenishmentruleDescMin.Validators[0].(func(int) error)
+	// replenishmentruleDescMax is the schema descriptor for max field.
+	replenishmentruleDescMax := replenishmentruleFields[1].Descriptor()
+	// replenishmentrule.MaxValidator is a validator for the "max" field. It is called by the builders before save.
+	replenishmentrule.MaxValidator = replenishmentruleDescMax.Validators[0].(func(int) error)
+	replenishmenttaskFields := schema.ReplenishmentTask{}.Fields()
+	_ = replenishmenttaskFields
+	// replenishmenttaskDescQuantity is the schema descriptor for quantity field.
+	replenishmenttaskDescQuantity := replenishmenttaskFields[0].Descriptor()
+	// replenishmenttask.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	replenishmenttask.QuantityValidator = replenishmenttaskDescQuantity.Validators[0].(func(int) error)
+	// replenishmenttaskDescKind is the schema descriptor for kind field.
+	replenishmenttaskDescKind := replenishmenttaskFields[1].Descriptor()
+	// replenishmenttask.DefaultKind holds the default value on creation for the kind field.
+	replenishmenttask.DefaultKind = replenishmenttaskDescKind.Default.(string)
+	// replenishmenttaskDescStatus is the schema descriptor for status field.
+	replenishmenttaskDescStatus := replenishmenttaskFields[2].Descriptor()
+	// replenishmenttask.DefaultStatus holds the default value on creation for the status field.
+	replenishmenttask.DefaultStatus = replenishmenttaskDescStatus.Default.(string)
+	// replenishmenttaskDescCreatedAt is the schema descriptor for created_at field.
+	replenishmenttaskDescCreatedAt := replenishmenttaskFields[3].Descriptor()
+	// replenishmenttask.DefaultCreatedAt holds the default value on creation for the created_at field.
+	replenishmenttask.DefaultCreatedAt = replenishmenttaskDescCreatedAt.Default.(func() time.Time)
+	sequenceFields := schema.Sequence{}.Fields()
+	_ = sequenceFields
+	// sequenceDescName is the schema descriptor for name field.
+	sequenceDescName := sequenceFields[0].Descriptor()
+	// sequence.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	sequence.NameValidator = sequenceDescName.Validators[0].(func(string) error)
+	// sequenceDescPrefix is the schema descriptor for prefix field.
+	sequenceDescPrefix := sequenceFields[1].Descriptor()
+	// sequence.DefaultPrefix holds the default value on creation for the prefix field.
+	sequence.DefaultPrefix = sequenceDescPrefix.Default.(string)
+	// sequenceDescDatePattern is the schema descriptor for date_pattern field.
+	sequenceDescDatePattern := sequenceFields[2].Descriptor()
+	// sequence.DefaultDatePattern holds the default value on creation for the date_pattern field.
+	sequence.DefaultDatePattern = sequenceDescDatePattern.Default.(string)
+	// sequenceDescWidth is the schema descriptor for width field.
+	sequenceDescWidth := sequenceFields[3].Descriptor()
+	// sequence.DefaultWidth holds the default value on creation for the width field.
+	sequence.DefaultWidth = sequenceDescWidth.Default.(int)
+	// sequence.WidthValidator is a validator for the "width" field. It is called by the builders before save.
+	sequence.WidthValidator = sequenceDescWidth.Validators[0].(func(int) error)
+	// sequenceDescValue is the schema descriptor for value field.
+	sequenceDescValue := sequenceFields[4].Descriptor()
+	// sequence.DefaultValue holds the default value on creation for the value field.
+	sequence.DefaultValue = sequenceDescValue.Default.(int)
+	// sequence.ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	sequence.ValueValidator = sequenceDescValue.Validators[0].(func(int) error)
+	// sequenceDescPeriod is the schema descriptor for period field.
+	sequenceDescPeriod := sequenceFields[5].Descriptor()
+	// sequence.DefaultPeriod holds the default value on creation for the period field.
+	sequence.DefaultPeriod = sequenceDescPeriod.Default.(string)
+	stockdiscrepancyFields := schema.StockDiscrepancy{}.Fields()
+	_ = stockdiscrepancyFields
+	// stockdiscrepancyDescReference is the schema descriptor for reference field.
+	stockdiscrepancyDescReference := stockdiscrepancyFields[1].Descriptor()
+	// stockdiscrepancy.DefaultReference holds the default value on creation for the reference field.
+	stockdiscrepancy.DefaultReference = stockdiscrepancyDescReference.Default.(string)
+	// stockdiscrepancyDescExpected is the schema descriptor for expected field.
+	stockdiscrepancyDescExpected := stockdiscrepancyFields[2].Descriptor()
+	// stockdiscrepancy.ExpectedValidator is a validator for the "expected" field. It is called by the builders before save.
+	stockdiscrepancy.ExpectedValidator = stockdiscrepancyDescExpected.Validators[0].(func(int) error)
+	// stockdiscrepancyDescFound is the schema descriptor for found field.
+	stockdiscrepancyDescFound := stockdiscrepancyFields[3].Descriptor()
+	// stockdiscrepancy.FoundValidator is a validator for the "found" field. It is called by the builders before save.
+	stockdiscrepancy.FoundValidator = stockdiscrepancyDescFound.Validators[0].(func(int) error)
+	// stockdiscrepancyDescCreatedAt is the schema descriptor for created_at field.
+	stockdiscrepancyDescCreatedAt := stockdiscrepancyFields[5].Descriptor()
+	// stockdiscrepancy.DefaultCreatedAt holds the default value on creation for the created_at field.
+	stockdiscrepancy.DefaultCreatedAt = stockdiscrepancyDescCreatedAt.Default.(func() time.Time)
 	stockmovementFields := schema.StockMovement{}.Fields()
 	_ = stockmovementFields
 	// stockmovementDescType is the schema descriptor for type field.
@@ -199,10 +536,76 @@ func init() {
 	warehouseDescName := warehouseFields[1].Descriptor()
 	// warehouse.DefaultName holds the default value on creation for the name field.
 	warehouse.DefaultName = warehouseDescName.Default.(string)
+	waveFields := schema.Wave{}.Fields()
+	_ = waveFields
+	// waveDescNumber is the schema descriptor for number field.
+	waveDescNumber := waveFields[0].Descriptor()
+	// wave.NumberValidator is a validator for the "number" field. It is called by the builders before save.
+	wave.NumberValidator = waveDescNumber.Validators[0].(func(string) error)
+	// waveDescStatus is the schema descriptor for status field.
+	waveDescStatus := waveFields[1].Descriptor()
+	// wave.DefaultStatus holds the default value on creation for the status field.
+	wave.DefaultStatus = waveDescStatus.Default.(string)
+	// waveDescCarrier is the schema descriptor for carrier field.
+	waveDescCarrier := waveFields[2].Descriptor()
+	// wave.DefaultCarrier holds the default value on creation for the carrier field.
+	wave.DefaultCarrier = waveDescCarrier.Default.(string)
+	// waveDescZone is the schema descriptor for zone field.
+	waveDescZone := waveFields[3].Descriptor()
+	// wave.DefaultZone holds the default value on creation for the zone field.
+	wave.DefaultZone = waveDescZone.Default.(string)
+	// waveDescMaxLines is the schema descriptor for max_lines field.
+	waveDescMaxLines := waveFields[5].Descriptor()
+	// wave.DefaultMaxLines holds the default value on creation for the max_lines field.
+	wave.DefaultMaxLines = waveDescMaxLines.Default.(int)
+	// wave.MaxLinesValidator is a validator for the "max_lines" field. It is called by the builders before save.
+	wave.MaxLinesValidator = waveDescMaxLines.Validators[0].(func(int) error)
+	// waveDescCreatedAt is the schema descriptor for created_at field.
+	waveDescCreatedAt := waveFields[6].Descriptor()
+	// wave.DefaultCreatedAt holds the default value on creation for the created_at field.
+	wave.DefaultCreatedAt = waveDescCreatedAt.Default.(func() time.Time)
+	wavepickFields := schema.WavePick{}.Fields()
+	_ = wavepickFields
+	// wavepickDescQuantity is the schema descriptor for quantity field.
+	wavepickDescQuantity := wavepickFields[0].Descriptor()
+	// wavepick.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	wavepick.QuantityValidator = wavepickDescQuantity.Validators[0].(func(int) error)
+	// wavepickDescPicked is the schema descriptor for picked field.
+	wavepickDescPicked := wavepickFields[1].Descriptor()
+	// wavepick.DefaultPicked holds the default value on creation for the picked field.
+	wavepick.DefaultPicked = wavepickDescPicked.Default.(int)
+	// wavepick.PickedValidator is a validator for the "picked" field. It is called by the builders before save.
+	wavepick.PickedValidator = wavepickDescPicked.Validators[0].(func(int) error)
+	wavetaskFields := schema.WaveTask{}.Fields()
+	_ = wavetaskFields
+	// wavetaskDescQuantity is the schema descriptor for quantity field.
+	wavetaskDescQuantity := wavetaskFields[0].Descriptor()
+	// wavetask.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	wavetask.QuantityValidator = wavetaskDescQuantity.Validators[0].(func(int) error)
+	// wavetaskDescPicked is the schema descriptor for picked field.
+	wavetaskDescPicked := wavetaskFields[1].Descriptor()
+	// wavetask.DefaultPicked holds the default value on creation for the picked field.
+	wavetask.DefaultPicked = wavetaskDescPicked.Default.(int)
+	// wavetask.PickedValidator is a validator for the "picked" field. It is called by the builders before save.
+	wavetask.PickedValidator = wavetaskDescPicked.Validators[0].(func(int) error)
+	// wavetaskDescStatus is the schema descriptor for status field.
+	wavetaskDescStatus := wavetaskFields[2].Descriptor()
+	// wavetask.DefaultStatus holds the default value on creation for the status field.
+	wavetask.DefaultStatus = wavetaskDescStatus.Default.(string)
+	// wavetaskDescPickedBy is the schema descriptor for picked_by field.
+	wavetaskDescPickedBy := wavetaskFields[5].Descriptor()
+	// wavetask.DefaultPickedBy holds the default value on creation for the picked_by field.
+	wavetask.DefaultPickedBy = wavetaskDescPickedBy.Default.(string)
 	zoneFields := schema.Zone{}.Fields()
 	_ = zoneFields
 	// zoneDescCode is the schema descriptor for code field.
 	zoneDescCode := zoneFields[0].Descriptor()
 	// zone.CodeValidator is a validator for the "code" field. It is called by the builders before save.
 	zone.CodeValidator = zoneDescCode.Validators[0].(func(string) error)
+	// zoneDescWalkSequence is the schema descriptor for walk_sequence field.
+	zoneDescWalkSequence := zoneFields[2].Descriptor()
+	// zone.DefaultWalkSequence holds the default value on creation for the walk_sequence field.
+	zone.DefaultWalkSequence = zoneDescWalkSequence.Default.(int)
+	// zone.WalkSequenceValidator is a validator for the "walk_sequence" field. It is called by the builders before save.
+	zone.WalkSequenceValidator = zoneDescWalkSequence.Validators[0].(func(int) error)
 }
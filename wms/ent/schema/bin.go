@@ -18,6 +18,10 @@ func (Bin) Fields() []ent.Field {
 		field.String("code").
 			NotEmpty(),
 		field.String("name").Optional(),
+		// Walk coordinates; aisle 0 means the bin has no coordinates.
+		field.Int("aisle").NonNegative().Default(0),
+		field.Int("position").NonNegative().Default(0),
+		field.Int("level").NonNegative().Default(0),
 	}
 }
 
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// BinStock holds the schema definition for the BinStock entity.
+// It is the quantity of an item lying in a bin; StockMovement stays the
+// source of truth per location.
+type BinStock struct {
+	ent.Schema
+}
+
+// Fields of the BinStock.
+func (BinStock) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("quantity").NonNegative(),
+		field.Time("received_at").
+			Default(time.Now), // oldest receipt still in the bin, for FIFO
+	}
+}
+
+func (BinStock) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("bin", "item").Unique(),
+	}
+}
+
+// Edges of the BinStock.
+func (BinStock) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("bin", Bin.Type).
+			Unique().
+			Required(),
+		edge.To("item", Item.Type).
+			Unique().
+			Required(),
+	}
+}
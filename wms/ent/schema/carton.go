@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Carton holds the schema definition for the Carton entity.
+// A carton is one parcel packed for an outbound order.
+type Carton struct {
+	ent.Schema
+}
+
+// Fields of the Carton.
+func (Carton) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("number").
+			Unique().
+			NotEmpty(),
+		field.String("status").
+			Default("OPEN"), // OPEN, CLOSED
+		// outer dimensions in cm and gross weight in kg, recorded on close
+		field.Float("length").Optional().Default(0),
+		field.Float("width").Optional().Default(0),
+		field.Float("height").Optional().Default(0),
+		field.Float("weight").Optional().Default(0),
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("closed_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Carton.
+func (Carton) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("order", Order.Type).
+			Ref("cartons").
+			Unique().
+			Required(),
+		edge.From("carton_type", CartonType.Type).
+			Ref("cartons").
+			Unique(),
+		edge.To("lines", CartonLine.Type),
+		// set once the order has a tracking record
+		edge.To("tracking", Tracking.Type).
+			Unique(),
+	}
+}
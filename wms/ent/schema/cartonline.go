@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CartonLine holds the schema definition for the CartonLine entity.
+type CartonLine struct {
+	ent.Schema
+}
+
+// Fields of the CartonLine.
+func (CartonLine) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("quantity").Positive(),
+	}
+}
+
+func (CartonLine) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("carton", "item").Unique(),
+	}
+}
+
+// Edges of the CartonLine.
+func (CartonLine) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("carton", Carton.Type).
+			Ref("lines").
+			Unique().
+			Required(),
+		edge.To("item", Item.Type).
+			Unique().
+			Required(),
+	}
+}
@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// CartonType holds the schema definition for the CartonType entity.
+// A carton type is a box size from the packing station catalog.
+type CartonType struct {
+	ent.Schema
+}
+
+// Fields of the CartonType.
+func (CartonType) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("code").
+			Unique().
+			NotEmpty(),
+		// inner dimensions in cm
+		field.Float("length").Positive(),
+		field.Float("width").Positive(),
+		field.Float("height").Positive(),
+		// maximum content weight and empty box weight in kg
+		field.Float("max_weight").Positive(),
+		field.Float("tare_weight").Default(0),
+	}
+}
+
+// Edges of the CartonType.
+func (CartonType) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("cartons", Carton.Type),
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// EventRule holds the schema definition for the EventRule entity.
+// It runs an action whenever a logged event matches its conditions.
+type EventRule struct {
+	ent.Schema
+}
+
+// Fields of the EventRule.
+func (EventRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			Unique().
+			NotEmpty(),
+		field.String("event").
+			NotEmpty(), // audit action, e.g. stock.out
+		field.String("conditions").
+			Optional().
+			Default(""), // e.g. "stock < 10 and location = L1"
+		field.String("action").
+			NotEmpty(), // NOTIFY, REPLENISH, HOLD
+		field.String("argument").
+			Optional().
+			Default(""),
+		field.Bool("enabled").
+			Default(true),
+		field.Int("fired").
+			NonNegative().
+			Default(0),
+		field.Time("last_fired_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
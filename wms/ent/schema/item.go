@@ -21,6 +21,13 @@ func (Item) Fields() []ent.Field {
 			NotEmpty(),
 		field.String("description").
 			Optional(),
+		// packed dimensions in cm and weight in kg, 0 when unknown
+		field.Float("length").Optional().Default(0),
+		field.Float("width").Optional().Default(0),
+		field.Float("height").Optional().Default(0),
+		field.Float("weight").Optional().Default(0),
+		// value of one unit for ABC analysis, 0 when unknown
+		field.Float("unit_price").Optional().Default(0),
 	}
 }
 
@@ -19,6 +19,12 @@ func (Location) Fields() []ent.Field {
 			NotEmpty(),
 		field.String("name").
 			NotEmpty(),
+		// a location on hold takes no stock movements
+		field.Bool("on_hold").
+			Default(false),
+		field.String("hold_reason").
+			Optional().
+			Default(""),
 	}
 }
 
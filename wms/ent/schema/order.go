@@ -20,12 +20,30 @@ func (Order) Fields() []ent.Field {
 			Unique().
 			NotEmpty(),
 		field.String("type").
-			NotEmpty(), // "INBOUND" or "OUTBOUND"
+			NotEmpty(), // "INBOUND", "OUTBOUND", "RETURN" or "TRANSFER"
 		field.String("status").
-			NotEmpty(). // "DRAFT", "POSTED", "CANCELLED"
+			NotEmpty(). // "DRAFT", "POSTED", "CANCELLED", "PARTIALLY_RECEIVED", "RECEIVED", "IN_TRANSIT", "REVERSED"
 			Default("DRAFT"),
 		field.Time("created_at").
 			Default(time.Now),
+		field.String("priority").
+			NotEmpty(). // "LOW", "NORMAL", "HIGH", "URGENT"
+			Default("NORMAL"),
+		field.Time("ship_by").
+			Optional().
+			Nillable(),
+		field.Time("posted_at").
+			Optional().
+			Nillable(),
+		field.Time("reversed_at").
+			Optional().
+			Nillable(),
+		field.String("reversal_reason").
+			Optional(),
+		field.String("idempotency_key").
+			Optional().
+			Nillable().
+			Unique(),
 	}
 }
 
@@ -35,5 +53,21 @@ func (Order) Edges() []ent.Edge {
 		edge.To("lines", OrderLine.Type),
 		edge.To("picklist", PickList.Type).Unique(),
 		edge.To("tracking", Tracking.Type).Unique(),
+		edge.To("cartons", Carton.Type),
+
+		edge.To("returns", Order.Type).
+			From("return_of").
+			Unique(),
+
+		edge.From("source_warehouse", Warehouse.Type).
+			Ref("outgoing_transfers").
+			Unique(),
+		edge.From("destination_warehouse", Warehouse.Type).
+			Ref("incoming_transfers").
+			Unique(),
+
+		edge.From("wave", Wave.Type).
+			Ref("orders").
+			Unique(),
 	}
 }
@@ -16,6 +16,13 @@ func (OrderLine) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int("quantity").
 			Positive(),
+		field.String("condition").
+			Optional(), // return inspection, e.g. "GOOD", "DAMAGED"
+		field.String("disposition").
+			Optional(), // "RESTOCK", "QUARANTINE" or "SCRAP"
+		field.Time("inspected_at").
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -38,5 +45,6 @@ func (OrderLine) Edges() []ent.Edge {
 			Required(),
 
 		edge.To("pick_tasks", PickTask.Type),
+		edge.To("receipts", Receipt.Type),
 	}
 }
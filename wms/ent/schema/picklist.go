@@ -17,8 +17,18 @@ type PickList struct {
 // Fields of the PickList.
 func (PickList) Fields() []ent.Field {
 	return []ent.Field{
+		field.String("number").
+			Optional().
+			Nillable().
+			Unique(),
 		field.String("status").
 			Default("CREATED"),
+		field.String("assigned_to").
+			Optional().
+			Default(""), // username of the picker
+		field.Time("assigned_at").
+			Optional().
+			Nillable(),
 		field.Time("created_at").
 			Default(time.Now),
 		field.Time("started_at").
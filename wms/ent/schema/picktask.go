@@ -16,15 +16,20 @@ type PickTask struct {
 func (PickTask) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int("quantity").Positive(),
-		field.String("status").Default("OPEN"),
+		field.Int("picked").NonNegative().Default(0),
+		field.String("status").Default("OPEN"), // OPEN, PICKED, SHORT
 		field.Time("picked_at").Optional().Nillable(),
 		field.Int("bin_id").Optional().Nillable(),
+		field.String("assigned_to").Optional().Default(""),
+		// user who confirmed the task; empty without the auth feature
+		field.String("picked_by").Optional().Default(""),
 	}
 }
 
 func (PickTask) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Edges("picklist", "order_line").Unique(),
+		// a line split across several bins has one task per bin
+		index.Fields("bin_id").Edges("picklist", "order_line").Unique(),
 	}
 }
 
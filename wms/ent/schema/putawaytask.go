@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// PutawayTask holds the schema definition for the PutawayTask entity.
+// One task is created per inbound receipt and tells where the goods go.
+type PutawayTask struct {
+	ent.Schema
+}
+
+// Fields of the PutawayTask.
+func (PutawayTask) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("quantity").Positive(),
+		field.String("status").Default("OPEN"), // OPEN, DONE, CANCELLED
+		field.String("reference").
+			Optional().
+			Default(""), // inbound order number
+		field.Int("suggested_bin_id").Optional().Nillable(),
+		field.String("strategy").
+			Optional().
+			Default(""), // strategy that produced the suggestion
+		field.Int("bin_id").Optional().Nillable(), // bin the goods were put into
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("done_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the PutawayTask.
+func (PutawayTask) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("item", Item.Type).
+			Unique().
+			Required(),
+		// receiving location the goods are taken from
+		edge.To("location", Location.Type).
+			Unique().
+			Required(),
+	}
+}
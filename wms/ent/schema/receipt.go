@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Receipt holds the schema definition for the Receipt entity.
+type Receipt struct {
+	ent.Schema
+}
+
+// Fields of the Receipt.
+func (Receipt) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("quantity").
+			NonNegative(), // counted units in good condition
+		field.Int("damaged").
+			NonNegative().
+			Default(0),
+		field.String("note").
+			Optional().
+			Default(""),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the Receipt.
+func (Receipt) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("order_line", OrderLine.Type).
+			Ref("receipts").
+			Unique().
+			Required(),
+	}
+}
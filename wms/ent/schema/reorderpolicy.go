@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// ReorderPolicy holds the schema definition for the ReorderPolicy entity.
+// It decides when and how much of an item is purchased, and where it is received.
+type ReorderPolicy struct {
+	ent.Schema
+}
+
+// Fields of the ReorderPolicy.
+func (ReorderPolicy) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("reorder_point").NonNegative(),
+		field.Int("safety_stock").NonNegative().Default(0),
+		field.Int("reorder_quantity").Positive(),
+		field.Int("lead_time_days").NonNegative().Default(0),
+		field.Int("item_id").
+			Unique(),
+		field.String("supplier").
+			Optional().
+			Default(""),
+	}
+}
+
+// Edges of the ReorderPolicy.
+func (ReorderPolicy) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("item", Item.Type).
+			Unique().
+			Required().
+			Field("item_id"),
+		edge.To("location", Location.Type).
+			Unique().
+			Required(),
+	}
+}
@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ReplenishmentRule holds the schema definition for the ReplenishmentRule entity.
+// It makes a bin the pick bin of an item and keeps its stock between min and max.
+type ReplenishmentRule struct {
+	ent.Schema
+}
+
+// Fields of the ReplenishmentRule.
+func (ReplenishmentRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("min").NonNegative(),
+		field.Int("max").Positive(),
+	}
+}
+
+func (ReplenishmentRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("bin", "item").Unique(),
+	}
+}
+
+// Edges of the ReplenishmentRule.
+func (ReplenishmentRule) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("bin", Bin.Type).
+			Unique().
+			Required(),
+		edge.To("item", Item.Type).
+			Unique().
+			Required(),
+	}
+}
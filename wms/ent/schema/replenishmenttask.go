@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// ReplenishmentTask holds the schema definition for the ReplenishmentTask entity.
+// It moves units of an item from a reserve bin to a pick bin.
+type ReplenishmentTask struct {
+	ent.Schema
+}
+
+// Fields of the ReplenishmentTask.
+func (ReplenishmentTask) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("quantity").Positive(),
+		field.String("kind").
+			Default("REPLENISH"), // REPLENISH, SLOTTING
+		field.String("status").
+			Default("OPEN"), // OPEN, DONE, CANCELLED
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("done_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the ReplenishmentTask.
+func (ReplenishmentTask) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("item", Item.Type).
+			Unique().
+			Required(),
+		edge.To("from_bin", Bin.Type).
+			Unique().
+			Required(),
+		edge.To("to_bin", Bin.Type).
+			Unique().
+			Required(),
+	}
+}
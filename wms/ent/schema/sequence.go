@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Sequence holds the schema definition for the Sequence entity.
+type Sequence struct {
+	ent.Schema
+}
+
+// Fields of the Sequence.
+func (Sequence) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			Unique().
+			NotEmpty(), // "INBOUND", "OUTBOUND", "RETURN", "PICKLIST", "COUNT", ...
+		field.String("prefix").
+			Default(""),
+		field.String("date_pattern").
+			Default(""), // e.g. "YYYY" or "YYYYMMDD", empty for none
+		field.Int("width").
+			Positive().
+			Default(6),
+		field.Int("value").
+			NonNegative().
+			Default(0),
+		field.String("period").
+			Default(""), // rendered date part the value belongs to
+	}
+}
+
+// Edges of the Sequence.
+func (Sequence) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// StockDiscrepancy holds the schema definition for the StockDiscrepancy entity.
+// It records a place where fewer units were found than the system expected,
+// e.g. a short pick.
+type StockDiscrepancy struct {
+	ent.Schema
+}
+
+// Fields of the StockDiscrepancy.
+func (StockDiscrepancy) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("source"), // PICK, WAVE
+		field.String("reference").
+			Optional().
+			Default(""),
+		field.Int("expected").NonNegative(),
+		field.Int("found").NonNegative(),
+		field.Int("bin_id").Optional().Nillable(),
+		field.Time("created_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the StockDiscrepancy.
+func (StockDiscrepancy) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("item", Item.Type).
+			Unique().
+			Required(),
+		edge.To("location", Location.Type).
+			Unique().
+			Required(),
+	}
+}
@@ -24,6 +24,10 @@ func (StockMovement) Fields() []ent.Field {
 			Default(time.Now),
 		field.String("reference").
 			Optional(),
+		field.String("idempotency_key").
+			Optional().
+			Nillable().
+			Unique(),
 	}
 }
 
@@ -30,5 +30,7 @@ func (Warehouse) Indexes() []ent.Index {
 func (Warehouse) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("warehouse_locations", WarehouseLocation.Type),
+		edge.To("outgoing_transfers", Order.Type),
+		edge.To("incoming_transfers", Order.Type),
 	}
 }
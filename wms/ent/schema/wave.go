@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Wave holds the schema definition for the Wave entity.
+type Wave struct {
+	ent.Schema
+}
+
+// Fields of the Wave.
+func (Wave) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("number").
+			Unique().
+			NotEmpty(),
+		field.String("status").
+			Default("CREATED"), // "CREATED", "IN_PROGRESS", "DONE"
+		field.String("carrier").
+			Optional().
+			Default(""),
+		field.String("zone").
+			Optional().
+			Default(""),
+		field.Time("due_by").
+			Optional().
+			Nillable(),
+		field.Int("max_lines").
+			NonNegative().
+			Default(0),
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("started_at").
+			Optional().
+			Nillable(),
+		field.Time("done_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Wave.
+func (Wave) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("orders", Order.Type),
+		edge.To("tasks", WaveTask.Type),
+	}
+}
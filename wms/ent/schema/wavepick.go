@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// WavePick holds the schema definition for the WavePick entity.
+// It is the share of a wave task that belongs to one order line.
+type WavePick struct {
+	ent.Schema
+}
+
+// Fields of the WavePick.
+func (WavePick) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("quantity").Positive(),
+		field.Int("picked").NonNegative().Default(0),
+	}
+}
+
+// Edges of the WavePick.
+func (WavePick) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("task", WaveTask.Type).
+			Ref("picks").
+			Unique().
+			Required(),
+
+		edge.To("order_line", OrderLine.Type).
+			Unique().
+			Required(),
+	}
+}
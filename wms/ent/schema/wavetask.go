@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// WaveTask holds the schema definition for the WaveTask entity.
+// One task consolidates all order lines of a wave for the same item, location and bin.
+type WaveTask struct {
+	ent.Schema
+}
+
+// Fields of the WaveTask.
+func (WaveTask) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("quantity").Positive(),
+		field.Int("picked").NonNegative().Default(0),
+		field.String("status").Default("OPEN"),
+		field.Time("picked_at").Optional().Nillable(),
+		field.Int("bin_id").Optional().Nillable(),
+		// user who confirmed the task; empty without the auth feature
+		field.String("picked_by").Optional().Default(""),
+	}
+}
+
+// Edges of the WaveTask.
+func (WaveTask) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("wave", Wave.Type).
+			Ref("tasks").
+			Unique().
+			Required(),
+
+		edge.To("item", Item.Type).
+			Unique().
+			Required(),
+		edge.To("location", Location.Type).
+			Unique().
+			Required(),
+
+		edge.To("picks", WavePick.Type),
+	}
+}
@@ -19,6 +19,9 @@ func (Zone) Fields() []ent.Field {
 			NotEmpty(),
 		field.String("name").
 			Optional(),
+		field.Int("walk_sequence").
+			NonNegative().
+			Default(0), // order in which pickers walk the zones of a location
 	}
 }
 
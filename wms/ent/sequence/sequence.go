@@ -0,0 +1,106 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sequence
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the sequence type in the database.
+	Label = "sequence"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldPrefix holds the string denoting the prefix field in the database.
+	FieldPrefix = "prefix"
+	// FieldDatePattern holds the string denoting the date_pattern field in the database.
+	FieldDatePattern = "date_pattern"
+	// FieldWidth holds the string denoting the width field in the database.
+	FieldWidth = "width"
+	// FieldValue holds the string denoting the value field in the database.
+	FieldValue = "value"
+	// FieldPeriod holds the string denoting the period field in the database.
+	FieldPeriod = "period"
+	// Table holds the table name of the sequence in the database.
+	Table = "sequences"
+)
+
+// Columns holds all SQL columns for sequence fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldPrefix,
+	FieldDatePattern,
+	FieldWidth,
+	FieldValue,
+	FieldPeriod,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultPrefix holds the default value on creation for the "prefix" field.
+	DefaultPrefix string
+	// DefaultDatePattern holds the default value on creation for the "date_pattern" field.
+	DefaultDatePattern string
+	// DefaultWidth holds the default value on creation for the "width" field.
+	DefaultWidth int
+	// WidthValidator is a validator for the "width" field. It is called by the builders before save.
+	WidthValidator func(int) error
+	// DefaultValue holds the default value on creation for the "value" field.
+	DefaultValue int
+	// ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	ValueValidator func(int) error
+	// DefaultPeriod holds the default value on creation for the "period" field.
+	DefaultPeriod string
+)
+
+// OrderOption defines the ordering options for the Sequence queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByPrefix orders the results by the prefix field.
+func ByPrefix(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrefix, opts...).ToFunc()
+}
+
+// ByDatePattern orders the results by the date_pattern field.
+func ByDatePattern(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDatePattern, opts...).ToFunc()
+}
+
+// ByWidth orders the results by the width field.
+func ByWidth(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWidth, opts...).ToFunc()
+}
+
+// ByValue orders the results by the value field.
+func ByValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValue, opts...).ToFunc()
+}
+
+// ByPeriod orders the results by the period field.
+func ByPeriod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriod, opts...).ToFunc()
+}
@@ -0,0 +1,438 @@
+// Code generated by ent, DO NOT EDIT.
+
+package sequence
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"github.com/mxV03/wms/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Sequence {
+	return predicate.Sequence(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Sequence {
+	return predicate.Sequence(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Sequence {
+	return predicate.Sequence(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Sequence {
+	return predicate.Sequence(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Sequence {
+	return predicate.Sequence(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Sequence {
+	return predicate.Sequence(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Sequence {
+	return predicate.Sequence(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Sequence {
+	return predicate.Sequence(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Sequence {
+	return predicate.Sequence(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldEQ(FieldName, v))
+}
+
+// Prefix applies equality check predicate on the "prefix" field. It's identical to PrefixEQ.
+func Prefix(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldEQ(FieldPrefix, v))
+}
+
+// DatePattern applies equality check predicate on the "date_pattern" field. It's identical to DatePatternEQ.
+func DatePattern(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldEQ(FieldDatePattern, v))
+}
+
+// Width applies equality check predicate on the "width" field. It's identical to WidthEQ.
+func Width(v int) predicate.Sequence {
+	return predicate.Sequence(sql.FieldEQ(FieldWidth, v))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v int) predicate.Sequence {
+	return predicate.Sequence(sql.FieldEQ(FieldValue, v))
+}
+
+// Period applies equality check predicate on the "period" field. It's identical to PeriodEQ.
+func Period(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldEQ(FieldPeriod, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldContainsFold(FieldName, v))
+}
+
+// PrefixEQ applies the EQ predicate on the "prefix" field.
+func PrefixEQ(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldEQ(FieldPrefix, v))
+}
+
+// PrefixNEQ applies the NEQ predicate on the "prefix" field.
+func PrefixNEQ(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldNEQ(FieldPrefix, v))
+}
+
+// PrefixIn applies the In predicate on the "prefix" field.
+func PrefixIn(vs ...string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldIn(FieldPrefix, vs...))
+}
+
+// PrefixNotIn applies the NotIn predicate on the "prefix" field.
+func PrefixNotIn(vs ...string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldNotIn(FieldPrefix, vs...))
+}
+
+// PrefixGT applies the GT predicate on the "prefix" field.
+func PrefixGT(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldGT(FieldPrefix, v))
+}
+
+// PrefixGTE applies the GTE predicate on the "prefix" field.
+func PrefixGTE(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldGTE(FieldPrefix, v))
+}
+
+// PrefixLT applies the LT predicate on the "prefix" field.
+func PrefixLT(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldLT(FieldPrefix, v))
+}
+
+// PrefixLTE applies the LTE predicate on the "prefix" field.
+func PrefixLTE(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldLTE(FieldPrefix, v))
+}
+
+// PrefixContains applies the Contains predicate on the "prefix" field.
+func PrefixContains(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldContains(FieldPrefix, v))
+}
+
+// PrefixHasPrefix applies the HasPrefix predicate on the "prefix" field.
+func PrefixHasPrefix(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldHasPrefix(FieldPrefix, v))
+}
+
+// PrefixHasSuffix applies the HasSuffix predicate on the "prefix" field.
+func PrefixHasSuffix(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldHasSuffix(FieldPrefix, v))
+}
+
+// PrefixEqualFold applies the EqualFold predicate on the "prefix" field.
+func PrefixEqualFold(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldEqualFold(FieldPrefix, v))
+}
+
+// PrefixContainsFold applies the ContainsFold predicate on the "prefix" field.
+func PrefixContainsFold(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldContainsFold(FieldPrefix, v))
+}
+
+// DatePatternEQ applies the EQ predicate on the "date_pattern" field.
+func DatePatternEQ(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldEQ(FieldDatePattern, v))
+}
+
+// DatePatternNEQ applies the NEQ predicate on the "date_pattern" field.
+func DatePatternNEQ(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldNEQ(FieldDatePattern, v))
+}
+
+// DatePatternIn applies the In predicate on the "date_pattern" field.
+func DatePatternIn(vs ...string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldIn(FieldDatePattern, vs...))
+}
+
+// DatePatternNotIn applies the NotIn predicate on the "date_pattern" field.
+func DatePatternNotIn(vs ...string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldNotIn(FieldDatePattern, vs...))
+}
+
+// DatePatternGT applies the GT predicate on the "date_pattern" field.
+func DatePatternGT(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldGT(FieldDatePattern, v))
+}
+
+// DatePatternGTE applies the GTE predicate on the "date_pattern" field.
+func DatePatternGTE(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldGTE(FieldDatePattern, v))
+}
+
+// DatePatternLT applies the LT predicate on the "date_pattern" field.
+func DatePatternLT(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldLT(FieldDatePattern, v))
+}
+
+// DatePatternLTE applies the LTE predicate on the "date_pattern" field.
+func DatePatternLTE(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldLTE(FieldDatePattern, v))
+}
+
+// DatePatternContains applies the Contains predicate on the "date_pattern" field.
+func DatePatternContains(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldContains(FieldDatePattern, v))
+}
+
+// DatePatternHasPrefix applies the HasPrefix predicate on the "date_pattern" field.
+func DatePatternHasPrefix(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldHasPrefix(FieldDatePattern, v))
+}
+
+// DatePatternHasSuffix applies the HasSuffix predicate on the "date_pattern" field.
+func DatePatternHasSuffix(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldHasSuffix(FieldDatePattern, v))
+}
+
+// DatePatternEqualFold applies the EqualFold predicate on the "date_pattern" field.
+func DatePatternEqualFold(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldEqualFold(FieldDatePattern, v))
+}
+
+// DatePatternContainsFold applies the ContainsFold predicate on the "date_pattern" field.
+func DatePatternContainsFold(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldContainsFold(FieldDatePattern, v))
+}
+
+// WidthEQ applies the EQ predicate on the "width" field.
+func WidthEQ(v int) predicate.Sequence {
+	return predicate.Sequence(sql.FieldEQ(FieldWidth, v))
+}
+
+// WidthNEQ applies the NEQ predicate on the "width" field.
+func WidthNEQ(v int) predicate.Sequence {
+	return predicate.Sequence(sql.FieldNEQ(FieldWidth, v))
+}
+
+// WidthIn applies the In predicate on the "width" field.
+func WidthIn(vs ...int) predicate.Sequence {
+	return predicate.Sequence(sql.FieldIn(FieldWidth, vs...))
+}
+
+// WidthNotIn applies the NotIn predicate on the "width" field.
+func WidthNotIn(vs ...int) predicate.Sequence {
+	return predicate.Sequence(sql.FieldNotIn(FieldWidth, vs...))
+}
+
+// WidthGT applies the GT predicate on the "width" field.
+func WidthGT(v int) predicate.Sequence {
+	return predicate.Sequence(sql.FieldGT(FieldWidth, v))
+}
+
+// WidthGTE applies the GTE predicate on the "width" field.
+func WidthGTE(v int) predicate.Sequence {
+	return predicate.Sequence(sql.FieldGTE(FieldWidth, v))
+}
+
+// WidthLT applies the LT predicate on the "width" field.
+func WidthLT(v int) predicate.Sequence {
+	return predicate.Sequence(sql.FieldLT(FieldWidth, v))
+}
+
+// WidthLTE applies the LTE predicate on the "width" field.
+func WidthLTE(v int) predicate.Sequence {
+	return predicate.Sequence(sql.FieldLTE(FieldWidth, v))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v int) predicate.Sequence {
+	return predicate.Sequence(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v int) predicate.Sequence {
+	return predicate.Sequence(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...int) predicate.Sequence {
+	return predicate.Sequence(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...int) predicate.Sequence {
+	return predicate.Sequence(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v int) predicate.Sequence {
+	return predicate.Sequence(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v int) predicate.Sequence {
+	return predicate.Sequence(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v int) predicate.Sequence {
+	return predicate.Sequence(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v int) predicate.Sequence {
+	return predicate.Sequence(sql.FieldLTE(FieldValue, v))
+}
+
+// PeriodEQ applies the EQ predicate on the "period" field.
+func PeriodEQ(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldEQ(FieldPeriod, v))
+}
+
+// PeriodNEQ applies the NEQ predicate on the "period" field.
+func PeriodNEQ(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldNEQ(FieldPeriod, v))
+}
+
+// PeriodIn applies the In predicate on the "period" field.
+func PeriodIn(vs ...string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldIn(FieldPeriod, vs...))
+}
+
+// PeriodNotIn applies the NotIn predicate on the "period" field.
+func PeriodNotIn(vs ...string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldNotIn(FieldPeriod, vs...))
+}
+
+// PeriodGT applies the GT predicate on the "period" field.
+func PeriodGT(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldGT(FieldPeriod, v))
+}
+
+// PeriodGTE applies the GTE predicate on the "period" field.
+func PeriodGTE(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldGTE(FieldPeriod, v))
+}
+
+// PeriodLT applies the LT predicate on the "period" field.
+func PeriodLT(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldLT(FieldPeriod, v))
+}
+
+// PeriodLTE applies the LTE predicate on the "period" field.
+func PeriodLTE(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldLTE(FieldPeriod, v))
+}
+
+// PeriodContains applies the Contains predicate on the "period" field.
+func PeriodContains(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldContains(FieldPeriod, v))
+}
+
+// PeriodHasPrefix applies the HasPrefix predicate on the "period" field.
+func PeriodHasPrefix(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldHasPrefix(FieldPeriod, v))
+}
+
+// PeriodHasSuffix applies the HasSuffix predicate on the "period" field.
+func PeriodHasSuffix(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldHasSuffix(FieldPeriod, v))
+}
+
+// PeriodEqualFold applies the EqualFold predicate on the "period" field.
+func PeriodEqualFold(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldEqualFold(FieldPeriod, v))
+}
+
+// PeriodContainsFold applies the ContainsFold predicate on the "period" field.
+func PeriodContainsFold(v string) predicate.Sequence {
+	return predicate.Sequence(sql.FieldContainsFold(FieldPeriod, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Sequence) predicate.Sequence {
+	return predicate.Sequence(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Sequence) predicate.Sequence {
+	return predicate.Sequence(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Sequence) predicate.Sequence {
+	return predicate.Sequence(sql.NotPredicates(p))
+}
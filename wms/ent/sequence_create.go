@@ -0,0 +1,329 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/sequence"
+)
+
+// SequenceCreate is the builder for creating a Sequence entity.
+type SequenceCreate struct {
+	config
+	mutation *SequenceMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (_c *SequenceCreate) SetName(v string) *SequenceCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetPrefix sets the "prefix" field.
+func (_c *SequenceCreate) SetPrefix(v string) *SequenceCreate {
+	_c.mutation.SetPrefix(v)
+	return _c
+}
+
+// SetNillablePrefix sets the "prefix" field if the given value is not nil.
+func (_c *SequenceCreate) SetNillablePrefix(v *string) *SequenceCreate {
+	if v != nil {
+		_c.SetPrefix(*v)
+	}
+	return _c
+}
+
+// SetDatePattern sets the "date_pattern" field.
+func (_c *SequenceCreate) SetDatePattern(v string) *SequenceCreate {
+	_c.mutation.SetDatePattern(v)
+	return _c
+}
+
+// SetNillableDatePattern sets the "date_pattern" field if the given value is not nil.
+func (_c *SequenceCreate) SetNillableDatePattern(v *string) *SequenceCreate {
+	if v != nil {
+		_c.SetDatePattern(*v)
+	}
+	return _c
+}
+
+// SetWidth sets the "width" field.
+func (_c *SequenceCreate) SetWidth(v int) *SequenceCreate {
+	_c.mutation.SetWidth(v)
+	return _c
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (_c *SequenceCreate) SetNillableWidth(v *int) *SequenceCreate {
+	if v != nil {
+		_c.SetWidth(*v)
+	}
+	return _c
+}
+
+// SetValue sets the "value" field.
+func (_c *SequenceCreate) SetValue(v int) *SequenceCreate {
+	_c.mutation.SetValue(v)
+	return _c
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_c *SequenceCreate) SetNillableValue(v *int) *SequenceCreate {
+	if v != nil {
+		_c.SetValue(*v)
+	}
+	return _c
+}
+
+// SetPeriod sets the "period" field.
+func (_c *SequenceCreate) SetPeriod(v string) *SequenceCreate {
+	_c.mutation.SetPeriod(v)
+	return _c
+}
+
+// SetNillablePeriod sets the "period" field if the given value is not nil.
+func (_c *SequenceCreate) SetNillablePeriod(v *string) *SequenceCreate {
+	if v != nil {
+		_c.SetPeriod(*v)
+	}
+	return _c
+}
+
+// Mutation returns the SequenceMutation object of the builder.
+func (_c *SequenceCreate) Mutation() *SequenceMutation {
+	return _c.mutation
+}
+
+// Save creates the Sequence in the database.
+func (_c *SequenceCreate) Save(ctx context.Context) (*Sequence, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SequenceCreate) SaveX(ctx context.Context) *Sequence {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SequenceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SequenceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SequenceCreate) defaults() {
+	if _, ok := _c.mutation.Prefix(); !ok {
+		v := sequence.DefaultPrefix
+		_c.mutation.SetPrefix(v)
+	}
+	if _, ok := _c.mutation.DatePattern(); !ok {
+		v := sequence.DefaultDatePattern
+		_c.mutation.SetDatePattern(v)
+	}
+	if _, ok := _c.mutation.Width(); !ok {
+		v := sequence.DefaultWidth
+		_c.mutation.SetWidth(v)
+	}
+	if _, ok := _c.mutation.Value(); !ok {
+		v := sequence.DefaultValue
+		_c.mutation.SetValue(v)
+	}
+	if _, ok := _c.mutation.Period(); !ok {
+		v := sequence.DefaultPeriod
+		_c.mutation.SetPeriod(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SequenceCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Sequence.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := sequence.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Sequence.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Prefix(); !ok {
+		return &ValidationError{Name: "prefix", err: errors.New(`ent: missing required field "Sequence.prefix"`)}
+	}
+	if _, ok := _c.mutation.DatePattern(); !ok {
+		return &ValidationError{Name: "date_pattern", err: errors.New(`ent: missing required field "Sequence.date_pattern"`)}
+	}
+	if _, ok := _c.mutation.Width(); !ok {
+		return &ValidationError{Name: "width", err: errors.New(`ent: missing required field "Sequence.width"`)}
+	}
+	if v, ok := _c.mutation.Width(); ok {
+		if err := sequence.WidthValidator(v); err != nil {
+			return &ValidationError{Name: "width", err: fmt.Errorf(`ent: validator failed for field "Sequence.width": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "Sequence.value"`)}
+	}
+	if v, ok := _c.mutation.Value(); ok {
+		if err := sequence.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "Sequence.value": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Period(); !ok {
+		return &ValidationError{Name: "period", err: errors.New(`ent: missing required field "Sequence.period"`)}
+	}
+	return nil
+}
+
+func (_c *SequenceCreate) sqlSave(ctx context.Context) (*Sequence, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SequenceCreate) createSpec() (*Sequence, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Sequence{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(sequence.Table, sqlgraph.NewFieldSpec(sequence.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(sequence.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Prefix(); ok {
+		_spec.SetField(sequence.FieldPrefix, field.TypeString, value)
+		_node.Prefix = value
+	}
+	if value, ok := _c.mutation.DatePattern(); ok {
+		_spec.SetField(sequence.FieldDatePattern, field.TypeString, value)
+		_node.DatePattern = value
+	}
+	if value, ok := _c.mutation.Width(); ok {
+		_spec.SetField(sequence.FieldWidth, field.TypeInt, value)
+		_node.Width = value
+	}
+	if value, ok := _c.mutation.Value(); ok {
+		_spec.SetField(sequence.FieldValue, field.TypeInt, value)
+		_node.Value = value
+	}
+	if value, ok := _c.mutation.Period(); ok {
+		_spec.SetField(sequence.FieldPeriod, field.TypeString, value)
+		_node.Period = value
+	}
+	return _node, _spec
+}
+
+// SequenceCreateBulk is the builder for creating many Sequence entities in bulk.
+type SequenceCreateBulk struct {
+	config
+	err      error
+	builders []*SequenceCreate
+}
+
+// Save creates the Sequence entities in the database.
+func (_c *SequenceCreateBulk) Save(ctx context.Context) ([]*Sequence, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Sequence, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SequenceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SequenceCreateBulk) SaveX(ctx context.Context) []*Sequence {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SequenceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SequenceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,465 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/predicate"
+	"github.com/mxV03/wms/ent/sequence"
+)
+
+// SequenceUpdate is the builder for updating Sequence entities.
+type SequenceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SequenceMutation
+}
+
+// Where appends a list predicates to the SequenceUpdate builder.
+func (_u *SequenceUpdate) Where(ps ...predicate.Sequence) *SequenceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *SequenceUpdate) SetName(v string) *SequenceUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SequenceUpdate) SetNillableName(v *string) *SequenceUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetPrefix sets the "prefix" field.
+func (_u *SequenceUpdate) SetPrefix(v string) *SequenceUpdate {
+	_u.mutation.SetPrefix(v)
+	return _u
+}
+
+// SetNillablePrefix sets the "prefix" field if the given value is not nil.
+func (_u *SequenceUpdate) SetNillablePrefix(v *string) *SequenceUpdate {
+	if v != nil {
+		_u.SetPrefix(*v)
+	}
+	return _u
+}
+
+// SetDatePattern sets the "date_pattern" field.
+func (_u *SequenceUpdate) SetDatePattern(v string) *SequenceUpdate {
+	_u.mutation.SetDatePattern(v)
+	return _u
+}
+
+// SetNillableDatePattern sets the "date_pattern" field if the given value is not nil.
+func (_u *SequenceUpdate) SetNillableDatePattern(v *string) *SequenceUpdate {
+	if v != nil {
+		_u.SetDatePattern(*v)
+	}
+	return _u
+}
+
+// SetWidth sets the "width" field.
+func (_u *SequenceUpdate) SetWidth(v int) *SequenceUpdate {
+	_u.mutation.ResetWidth()
+	_u.mutation.SetWidth(v)
+	return _u
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (_u *SequenceUpdate) SetNillableWidth(v *int) *SequenceUpdate {
+	if v != nil {
+		_u.SetWidth(*v)
+	}
+	return _u
+}
+
+// AddWidth adds value to the "width" field.
+func (_u *SequenceUpdate) AddWidth(v int) *SequenceUpdate {
+	_u.mutation.AddWidth(v)
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *SequenceUpdate) SetValue(v int) *SequenceUpdate {
+	_u.mutation.ResetValue()
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *SequenceUpdate) SetNillableValue(v *int) *SequenceUpdate {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// AddValue adds value to the "value" field.
+func (_u *SequenceUpdate) AddValue(v int) *SequenceUpdate {
+	_u.mutation.AddValue(v)
+	return _u
+}
+
+// SetPeriod sets the "period" field.
+func (_u *SequenceUpdate) SetPeriod(v string) *SequenceUpdate {
+	_u.mutation.SetPeriod(v)
+	return _u
+}
+
+// SetNillablePeriod sets the "period" field if the given value is not nil.
+func (_u *SequenceUpdate) SetNillablePeriod(v *string) *SequenceUpdate {
+	if v != nil {
+		_u.SetPeriod(*v)
+	}
+	return _u
+}
+
+// Mutation returns the SequenceMutation object of the builder.
+func (_u *SequenceUpdate) Mutation() *SequenceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SequenceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SequenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SequenceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SequenceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SequenceUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := sequence.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Sequence.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Width(); ok {
+		if err := sequence.WidthValidator(v); err != nil {
+			return &ValidationError{Name: "width", err: fmt.Errorf(`ent: validator failed for field "Sequence.width": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Value(); ok {
+		if err := sequence.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "Sequence.value": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SequenceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sequence.Table, sequence.Columns, sqlgraph.NewFieldSpec(sequence.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(sequence.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Prefix(); ok {
+		_spec.SetField(sequence.FieldPrefix, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DatePattern(); ok {
+		_spec.SetField(sequence.FieldDatePattern, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Width(); ok {
+		_spec.SetField(sequence.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWidth(); ok {
+		_spec.AddField(sequence.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(sequence.FieldValue, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValue(); ok {
+		_spec.AddField(sequence.FieldValue, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Period(); ok {
+		_spec.SetField(sequence.FieldPeriod, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sequence.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SequenceUpdateOne is the builder for updating a single Sequence entity.
+type SequenceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SequenceMutation
+}
+
+// SetName sets the "name" field.
+func (_u *SequenceUpdateOne) SetName(v string) *SequenceUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SequenceUpdateOne) SetNillableName(v *string) *SequenceUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetPrefix sets the "prefix" field.
+func (_u *SequenceUpdateOne) SetPrefix(v string) *SequenceUpdateOne {
+	_u.mutation.SetPrefix(v)
+	return _u
+}
+
+// SetNillablePrefix sets the "prefix" field if the given value is not nil.
+func (_u *SequenceUpdateOne) SetNillablePrefix(v *string) *SequenceUpdateOne {
+	if v != nil {
+		_u.SetPrefix(*v)
+	}
+	return _u
+}
+
+// SetDatePattern sets the "date_pattern" field.
+func (_u *SequenceUpdateOne) SetDatePattern(v string) *SequenceUpdateOne {
+	_u.mutation.SetDatePattern(v)
+	return _u
+}
+
+// SetNillableDatePattern sets the "date_pattern" field if the given value is not nil.
+func (_u *SequenceUpdateOne) SetNillableDatePattern(v *string) *SequenceUpdateOne {
+	if v != nil {
+		_u.SetDatePattern(*v)
+	}
+	return _u
+}
+
+// SetWidth sets the "width" field.
+func (_u *SequenceUpdateOne) SetWidth(v int) *SequenceUpdateOne {
+	_u.mutation.ResetWidth()
+	_u.mutation.SetWidth(v)
+	return _u
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (_u *SequenceUpdateOne) SetNillableWidth(v *int) *SequenceUpdateOne {
+	if v != nil {
+		_u.SetWidth(*v)
+	}
+	return _u
+}
+
+// AddWidth adds value to the "width" field.
+func (_u *SequenceUpdateOne) AddWidth(v int) *SequenceUpdateOne {
+	_u.mutation.AddWidth(v)
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *SequenceUpdateOne) SetValue(v int) *SequenceUpdateOne {
+	_u.mutation.ResetValue()
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *SequenceUpdateOne) SetNillableValue(v *int) *SequenceUpdateOne {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// AddValue adds value to the "value" field.
+func (_u *SequenceUpdateOne) AddValue(v int) *SequenceUpdateOne {
+	_u.mutation.AddValue(v)
+	return _u
+}
+
+// SetPeriod sets the "period" field.
+func (_u *SequenceUpdateOne) SetPeriod(v string) *SequenceUpdateOne {
+	_u.mutation.SetPeriod(v)
+	return _u
+}
+
+// SetNillablePeriod sets the "period" field if the given value is not nil.
+func (_u *SequenceUpdateOne) SetNillablePeriod(v *string) *SequenceUpdateOne {
+	if v != nil {
+		_u.SetPeriod(*v)
+	}
+	return _u
+}
+
+// Mutation returns the SequenceMutation object of the builder.
+func (_u *SequenceUpdateOne) Mutation() *SequenceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SequenceUpdate builder.
+func (_u *SequenceUpdateOne) Where(ps ...predicate.Sequence) *SequenceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SequenceUpdateOne) Select(field string, fields ...string) *SequenceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Sequence entity.
+func (_u *SequenceUpdateOne) Save(ctx context.Context) (*Sequence, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SequenceUpdateOne) SaveX(ctx context.Context) *Sequence {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SequenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SequenceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SequenceUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := sequence.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Sequence.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Width(); ok {
+		if err := sequence.WidthValidator(v); err != nil {
+			return &ValidationError{Name: "width", err: fmt.Errorf(`ent: validator failed for field "Sequence.width": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Value(); ok {
+		if err := sequence.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "Sequence.value": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SequenceUpdateOne) sqlSave(ctx context.Context) (_node *Sequence, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(sequence.Table, sequence.Columns, sqlgraph.NewFieldSpec(sequence.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Sequence.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, sequence.FieldID)
+		for _, f := range fields {
+			if !sequence.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != sequence.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(sequence.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Prefix(); ok {
+		_spec.SetField(sequence.FieldPrefix, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DatePattern(); ok {
+		_spec.SetField(sequence.FieldDatePattern, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Width(); ok {
+		_spec.SetField(sequence.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWidth(); ok {
+		_spec.AddField(sequence.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(sequence.FieldValue, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedValue(); ok {
+		_spec.AddField(sequence.FieldValue, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Period(); ok {
+		_spec.SetField(sequence.FieldPeriod, field.TypeString, value)
+	}
+	_node = &Sequence{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{sequence.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
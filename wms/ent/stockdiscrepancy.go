@@ -0,0 +1,232 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mxV03/wms/ent/item"
+	"github.com/mxV03/wms/ent/location"
+	"github.com/mxV03/wms/ent/stockdiscrepancy"
+)
+
+// StockDiscrepancy is the model entity for the StockDiscrepancy schema.
+type StockDiscrepancy struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Source holds the value of the "source" field.
+	Source string `json:"source,omitempty"`
+	// Reference holds the value of the "reference" field.
+	Reference string `json:"reference,omitempty"`
+	// Expected holds the value of the "expected" field.
+	Expected int `json:"expected,omitempty"`
+	// Found holds the value of the "found" field.
+	Found int `json:"found,omitempty"`
+	// BinID holds the value of the "bin_id" field.
+	BinID *int `json:"bin_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the StockDiscrepancyQuery when eager-loading is set.
+	Edges                      StockDiscrepancyEdges `json:"edges"`
+	stock_discrepancy_item     *int
+	stock_discrepancy_location *int
+	selectValues               sql.SelectValues
+}
+
+// StockDiscrepancyEdges holds the relations/edges for other nodes in the graph.
+type StockDiscrepancyEdges struct {
+	// Item holds the value of the item edge.
+	Item *Item `json:"item,omitempty"`
+	// Location holds the value of the location edge.
+	Location *Location `json:"location,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ItemOrErr returns the Item value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StockDiscrepancyEdges) ItemOrErr() (*Item, error) {
+	if e.Item != nil {
+		return e.Item, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: item.Label}
+	}
+	return nil, &NotLoadedError{edge: "item"}
+}
+
+// LocationOrErr returns the Location value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e StockDiscrepancyEdges) LocationOrErr() (*Location, error) {
+	if e.Location != nil {
+		return e.Location, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: location.Label}
+	}
+	return nil, &NotLoadedError{edge: "location"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*StockDiscrepancy) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case stockdiscrepancy.FieldID, stockdiscrepancy.FieldExpected, stockdiscrepancy.FieldFound, stockdiscrepancy.FieldBinID:
+			values[i] = new(sql.NullInt64)
+		case stockdiscrepancy.FieldSource, stockdiscrepancy.FieldReference:
+			values[i] = new(sql.NullString)
+		case stockdiscrepancy.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case stockdiscrepancy.ForeignKeys[0]: // stock_discrepancy_item
+			values[i] = new(sql.NullInt64)
+		case stockdiscrepancy.ForeignKeys[1]: // stock_discrepancy_location
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the StockDiscrepancy fields.
+func (_m *StockDiscrepancy) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case stockdiscrepancy.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case stockdiscrepancy.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = value.String
+			}
+		case stockdiscrepancy.FieldReference:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reference", values[i])
+			} else if value.Valid {
+				_m.Reference = value.String
+			}
+		case stockdiscrepancy.FieldExpected:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field expected", values[i])
+			} else if value.Valid {
+				_m.Expected = int(value.Int64)
+			}
+		case stockdiscrepancy.FieldFound:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field found", values[i])
+			} else if value.Valid {
+				_m.Found = int(value.Int64)
+			}
+		case stockdiscrepancy.FieldBinID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field bin_id", values[i])
+			} else if value.Valid {
+				_m.BinID = new(int)
+				*_m.BinID = int(value.Int64)
+			}
+		case stockdiscrepancy.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case stockdiscrepancy.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field stock_discrepancy_item", value)
+			} else if value.Valid {
+				_m.stock_discrepancy_item = new(int)
+				*_m.stock_discrepancy_item = int(value.Int64)
+			}
+		case stockdiscrepancy.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field stock_discrepancy_location", value)
+			} else if value.Valid {
+				_m.stock_discrepancy_location = new(int)
+				*_m.stock_discrepancy_location = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the StockDiscrepancy.
+// This includes values selected through modifiers, order, etc.
+func (_m *StockDiscrepancy) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryItem queries the "item" edge of the StockDiscrepancy entity.
+func (_m *StockDiscrepancy) QueryItem() *ItemQuery {
+	return NewStockDiscrepancyClient(_m.config).QueryItem(_m)
+}
+
+// QueryLocation queries the "location" edge of the StockDiscrepancy entity.
+func (_m *StockDiscrepancy) QueryLocation() *LocationQuery {
+	return NewStockDiscrepancyClient(_m.config).QueryLocation(_m)
+}
+
+// Update returns a builder for updating this StockDiscrepancy.
+// Note that you need to call StockDiscrepancy.Unwrap() before calling this method if this StockDiscrepancy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *StockDiscrepancy) Update() *StockDiscrepancyUpdateOne {
+	return NewStockDiscrepancyClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the StockDiscrepancy entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *StockDiscrepancy) Unwrap() *StockDiscrepancy {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: StockDiscrepancy is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *StockDiscrepancy) String() string {
+	var builder strings.Builder
+	builder.WriteString("StockDiscrepancy(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("source=")
+	builder.WriteString(_m.Source)
+	builder.WriteString(", ")
+	builder.WriteString("reference=")
+	builder.WriteString(_m.Reference)
+	builder.WriteString(", ")
+	builder.WriteString("expected=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Expected))
+	builder.WriteString(", ")
+	builder.WriteString("found=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Found))
+	builder.WriteString(", ")
+	if v := _m.BinID; v != nil {
+		builder.WriteString("bin_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// StockDiscrepancies is a parsable slice of StockDiscrepancy.
+type StockDiscrepancies []*StockDiscrepancy
@@ -0,0 +1,159 @@
+// Code generated by ent, DO NOT EDIT.
+
+package stockdiscrepancy
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the stockdiscrepancy type in the database.
+	Label = "stock_discrepancy"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldReference holds the string denoting the reference field in the database.
+	FieldReference = "reference"
+	// FieldExpected holds the string denoting the expected field in the database.
+	FieldExpected = "expected"
+	// FieldFound holds the string denoting the found field in the database.
+	FieldFound = "found"
+	// FieldBinID holds the string denoting the bin_id field in the database.
+	FieldBinID = "bin_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeItem holds the string denoting the item edge name in mutations.
+	EdgeItem = "item"
+	// EdgeLocation holds the string denoting the location edge name in mutations.
+	EdgeLocation = "location"
+	// Table holds the table name of the stockdiscrepancy in the database.
+	Table = "stock_discrepancies"
+	// ItemTable is the table that holds the item relation/edge.
+	ItemTable = "stock_discrepancies"
+	// ItemInverseTable is the table name for the Item entity.
+	// It exists in this package in order to avoid circular dependency with the "item" package.
+	ItemInverseTable = "items"
+	// ItemColumn is the table column denoting the item relation/edge.
+	ItemColumn = "stock_discrepancy_item"
+	// LocationTable is the table that holds the location relation/edge.
+	LocationTable = "stock_discrepancies"
+	// LocationInverseTable is the table name for the Location entity.
+	// It exists in this package in order to avoid circular dependency with the "location" package.
+	LocationInverseTable = "locations"
+	// LocationColumn is the table column denoting the location relation/edge.
+	LocationColumn = "stock_discrepancy_location"
+)
+
+// Columns holds all SQL columns for stockdiscrepancy fields.
+var Columns = []string{
+	FieldID,
+	FieldSource,
+	FieldReference,
+	FieldExpected,
+	FieldFound,
+	FieldBinID,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "stock_discrepancies"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"stock_discrepancy_item",
+	"stock_discrepancy_location",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultReference holds the default value on creation for the "reference" field.
+	DefaultReference string
+	// ExpectedValidator is a validator for the "expected" field. It is called by the builders before save.
+	ExpectedValidator func(int) error
+	// FoundValidator is a validator for the "found" field. It is called by the builders before save.
+	FoundValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the StockDiscrepancy queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByReference orders the results by the reference field.
+func ByReference(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReference, opts...).ToFunc()
+}
+
+// ByExpected orders the results by the expected field.
+func ByExpected(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpected, opts...).ToFunc()
+}
+
+// ByFound orders the results by the found field.
+func ByFound(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFound, opts...).ToFunc()
+}
+
+// ByBinID orders the results by the bin_id field.
+func ByBinID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBinID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByItemField orders the results by item field.
+func ByItemField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newItemStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByLocationField orders the results by location field.
+func ByLocationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLocationStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newItemStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ItemInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ItemTable, ItemColumn),
+	)
+}
+func newLocationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LocationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, LocationTable, LocationColumn),
+	)
+}
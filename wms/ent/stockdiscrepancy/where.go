@@ -0,0 +1,457 @@
+// Code generated by ent, DO NOT EDIT.
+
+package stockdiscrepancy
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/mxV03/wms/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldLTE(FieldID, id))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldEQ(FieldSource, v))
+}
+
+// Reference applies equality check predicate on the "reference" field. It's identical to ReferenceEQ.
+func Reference(v string) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldEQ(FieldReference, v))
+}
+
+// Expected applies equality check predicate on the "expected" field. It's identical to ExpectedEQ.
+func Expected(v int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldEQ(FieldExpected, v))
+}
+
+// Found applies equality check predicate on the "found" field. It's identical to FoundEQ.
+func Found(v int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldEQ(FieldFound, v))
+}
+
+// BinID applies equality check predicate on the "bin_id" field. It's identical to BinIDEQ.
+func BinID(v int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldEQ(FieldBinID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldContainsFold(FieldSource, v))
+}
+
+// ReferenceEQ applies the EQ predicate on the "reference" field.
+func ReferenceEQ(v string) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldEQ(FieldReference, v))
+}
+
+// ReferenceNEQ applies the NEQ predicate on the "reference" field.
+func ReferenceNEQ(v string) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldNEQ(FieldReference, v))
+}
+
+// ReferenceIn applies the In predicate on the "reference" field.
+func ReferenceIn(vs ...string) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldIn(FieldReference, vs...))
+}
+
+// ReferenceNotIn applies the NotIn predicate on the "reference" field.
+func ReferenceNotIn(vs ...string) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldNotIn(FieldReference, vs...))
+}
+
+// ReferenceGT applies the GT predicate on the "reference" field.
+func ReferenceGT(v string) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldGT(FieldReference, v))
+}
+
+// ReferenceGTE applies the GTE predicate on the "reference" field.
+func ReferenceGTE(v string) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldGTE(FieldReference, v))
+}
+
+// ReferenceLT applies the LT predicate on the "reference" field.
+func ReferenceLT(v string) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldLT(FieldReference, v))
+}
+
+// ReferenceLTE applies the LTE predicate on the "reference" field.
+func ReferenceLTE(v string) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldLTE(FieldReference, v))
+}
+
+// ReferenceContains applies the Contains predicate on the "reference" field.
+func ReferenceContains(v string) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldContains(FieldReference, v))
+}
+
+// ReferenceHasPrefix applies the HasPrefix predicate on the "reference" field.
+func ReferenceHasPrefix(v string) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldHasPrefix(FieldReference, v))
+}
+
+// ReferenceHasSuffix applies the HasSuffix predicate on the "reference" field.
+func ReferenceHasSuffix(v string) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldHasSuffix(FieldReference, v))
+}
+
+// ReferenceIsNil applies the IsNil predicate on the "reference" field.
+func ReferenceIsNil() predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldIsNull(FieldReference))
+}
+
+// ReferenceNotNil applies the NotNil predicate on the "reference" field.
+func ReferenceNotNil() predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldNotNull(FieldReference))
+}
+
+// ReferenceEqualFold applies the EqualFold predicate on the "reference" field.
+func ReferenceEqualFold(v string) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldEqualFold(FieldReference, v))
+}
+
+// ReferenceContainsFold applies the ContainsFold predicate on the "reference" field.
+func ReferenceContainsFold(v string) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldContainsFold(FieldReference, v))
+}
+
+// ExpectedEQ applies the EQ predicate on the "expected" field.
+func ExpectedEQ(v int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldEQ(FieldExpected, v))
+}
+
+// ExpectedNEQ applies the NEQ predicate on the "expected" field.
+func ExpectedNEQ(v int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldNEQ(FieldExpected, v))
+}
+
+// ExpectedIn applies the In predicate on the "expected" field.
+func ExpectedIn(vs ...int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldIn(FieldExpected, vs...))
+}
+
+// ExpectedNotIn applies the NotIn predicate on the "expected" field.
+func ExpectedNotIn(vs ...int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldNotIn(FieldExpected, vs...))
+}
+
+// ExpectedGT applies the GT predicate on the "expected" field.
+func ExpectedGT(v int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldGT(FieldExpected, v))
+}
+
+// ExpectedGTE applies the GTE predicate on the "expected" field.
+func ExpectedGTE(v int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldGTE(FieldExpected, v))
+}
+
+// ExpectedLT applies the LT predicate on the "expected" field.
+func ExpectedLT(v int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldLT(FieldExpected, v))
+}
+
+// ExpectedLTE applies the LTE predicate on the "expected" field.
+func ExpectedLTE(v int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldLTE(FieldExpected, v))
+}
+
+// FoundEQ applies the EQ predicate on the "found" field.
+func FoundEQ(v int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldEQ(FieldFound, v))
+}
+
+// FoundNEQ applies the NEQ predicate on the "found" field.
+func FoundNEQ(v int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldNEQ(FieldFound, v))
+}
+
+// FoundIn applies the In predicate on the "found" field.
+func FoundIn(vs ...int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldIn(FieldFound, vs...))
+}
+
+// FoundNotIn applies the NotIn predicate on the "found" field.
+func FoundNotIn(vs ...int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldNotIn(FieldFound, vs...))
+}
+
+// FoundGT applies the GT predicate on the "found" field.
+func FoundGT(v int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldGT(FieldFound, v))
+}
+
+// FoundGTE applies the GTE predicate on the "found" field.
+func FoundGTE(v int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldGTE(FieldFound, v))
+}
+
+// FoundLT applies the LT predicate on the "found" field.
+func FoundLT(v int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldLT(FieldFound, v))
+}
+
+// FoundLTE applies the LTE predicate on the "found" field.
+func FoundLTE(v int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldLTE(FieldFound, v))
+}
+
+// BinIDEQ applies the EQ predicate on the "bin_id" field.
+func BinIDEQ(v int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldEQ(FieldBinID, v))
+}
+
+// BinIDNEQ applies the NEQ predicate on the "bin_id" field.
+func BinIDNEQ(v int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldNEQ(FieldBinID, v))
+}
+
+// BinIDIn applies the In predicate on the "bin_id" field.
+func BinIDIn(vs ...int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldIn(FieldBinID, vs...))
+}
+
+// BinIDNotIn applies the NotIn predicate on the "bin_id" field.
+func BinIDNotIn(vs ...int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldNotIn(FieldBinID, vs...))
+}
+
+// BinIDGT applies the GT predicate on the "bin_id" field.
+func BinIDGT(v int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldGT(FieldBinID, v))
+}
+
+// BinIDGTE applies the GTE predicate on the "bin_id" field.
+func BinIDGTE(v int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldGTE(FieldBinID, v))
+}
+
+// BinIDLT applies the LT predicate on the "bin_id" field.
+func BinIDLT(v int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldLT(FieldBinID, v))
+}
+
+// BinIDLTE applies the LTE predicate on the "bin_id" field.
+func BinIDLTE(v int) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldLTE(FieldBinID, v))
+}
+
+// BinIDIsNil applies the IsNil predicate on the "bin_id" field.
+func BinIDIsNil() predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldIsNull(FieldBinID))
+}
+
+// BinIDNotNil applies the NotNil predicate on the "bin_id" field.
+func BinIDNotNil() predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldNotNull(FieldBinID))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasItem applies the HasEdge predicate on the "item" edge.
+func HasItem() predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ItemTable, ItemColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemWith applies the HasEdge predicate on the "item" edge with a given conditions (other predicates).
+func HasItemWith(preds ...predicate.Item) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(func(s *sql.Selector) {
+		step := newItemStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLocation applies the HasEdge predicate on the "location" edge.
+func HasLocation() predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, LocationTable, LocationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLocationWith applies the HasEdge predicate on the "location" edge with a given conditions (other predicates).
+func HasLocationWith(preds ...predicate.Location) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(func(s *sql.Selector) {
+		step := newLocationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.StockDiscrepancy) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.StockDiscrepancy) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.StockDiscrepancy) predicate.StockDiscrepancy {
+	return predicate.StockDiscrepancy(sql.NotPredicates(p))
+}
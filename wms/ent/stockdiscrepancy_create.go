@@ -0,0 +1,355 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/item"
+	"github.com/mxV03/wms/ent/location"
+	"github.com/mxV03/wms/ent/stockdiscrepancy"
+)
+
+// StockDiscrepancyCreate is the builder for creating a StockDiscrepancy entity.
+type StockDiscrepancyCreate struct {
+	config
+	mutation *StockDiscrepancyMutation
+	hooks    []Hook
+}
+
+// SetSource sets the "source" field.
+func (_c *StockDiscrepancyCreate) SetSource(v string) *StockDiscrepancyCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetReference sets the "reference" field.
+func (_c *StockDiscrepancyCreate) SetReference(v string) *StockDiscrepancyCreate {
+	_c.mutation.SetReference(v)
+	return _c
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_c *StockDiscrepancyCreate) SetNillableReference(v *string) *StockDiscrepancyCreate {
+	if v != nil {
+		_c.SetReference(*v)
+	}
+	return _c
+}
+
+// SetExpected sets the "expected" field.
+func (_c *StockDiscrepancyCreate) SetExpected(v int) *StockDiscrepancyCreate {
+	_c.mutation.SetExpected(v)
+	return _c
+}
+
+// SetFound sets the "found" field.
+func (_c *StockDiscrepancyCreate) SetFound(v int) *StockDiscrepancyCreate {
+	_c.mutation.SetFound(v)
+	return _c
+}
+
+// SetBinID sets the "bin_id" field.
+func (_c *StockDiscrepancyCreate) SetBinID(v int) *StockDiscrepancyCreate {
+	_c.mutation.SetBinID(v)
+	return _c
+}
+
+// SetNillableBinID sets the "bin_id" field if the given value is not nil.
+func (_c *StockDiscrepancyCreate) SetNillableBinID(v *int) *StockDiscrepancyCreate {
+	if v != nil {
+		_c.SetBinID(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *StockDiscrepancyCreate) SetCreatedAt(v time.Time) *StockDiscrepancyCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *StockDiscrepancyCreate) SetNillableCreatedAt(v *time.Time) *StockDiscrepancyCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetItemID sets the "item" edge to the Item entity by ID.
+func (_c *StockDiscrepancyCreate) SetItemID(id int) *StockDiscrepancyCreate {
+	_c.mutation.SetItemID(id)
+	return _c
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_c *StockDiscrepancyCreate) SetItem(v *Item) *StockDiscrepancyCreate {
+	return _c.SetItemID(v.ID)
+}
+
+// SetLocationID sets the "location" edge to the Location entity by ID.
+func (_c *StockDiscrepancyCreate) SetLocationID(id int) *StockDiscrepancyCreate {
+	_c.mutation.SetLocationID(id)
+	return _c
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (_c *StockDiscrepancyCreate) SetLocation(v *Location) *StockDiscrepancyCreate {
+	return _c.SetLocationID(v.ID)
+}
+
+// Mutation returns the StockDiscrepancyMutation object of the builder.
+func (_c *StockDiscrepancyCreate) Mutation() *StockDiscrepancyMutation {
+	return _c.mutation
+}
+
+// Save creates the StockDiscrepancy in the database.
+func (_c *StockDiscrepancyCreate) Save(ctx context.Context) (*StockDiscrepancy, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *StockDiscrepancyCreate) SaveX(ctx context.Context) *StockDiscrepancy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StockDiscrepancyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StockDiscrepancyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *StockDiscrepancyCreate) defaults() {
+	if _, ok := _c.mutation.Reference(); !ok {
+		v := stockdiscrepancy.DefaultReference
+		_c.mutation.SetReference(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := stockdiscrepancy.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *StockDiscrepancyCreate) check() error {
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "StockDiscrepancy.source"`)}
+	}
+	if _, ok := _c.mutation.Expected(); !ok {
+		return &ValidationError{Name: "expected", err: errors.New(`ent: missing required field "StockDiscrepancy.expected"`)}
+	}
+	if v, ok := _c.mutation.Expected(); ok {
+		if err := stockdiscrepancy.ExpectedValidator(v); err != nil {
+			return &ValidationError{Name: "expected", err: fmt.Errorf(`ent: validator failed for field "StockDiscrepancy.expected": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Found(); !ok {
+		return &ValidationError{Name: "found", err: errors.New(`ent: missing required field "StockDiscrepancy.found"`)}
+	}
+	if v, ok := _c.mutation.Found(); ok {
+		if err := stockdiscrepancy.FoundValidator(v); err != nil {
+			return &ValidationError{Name: "found", err: fmt.Errorf(`ent: validator failed for field "StockDiscrepancy.found": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "StockDiscrepancy.created_at"`)}
+	}
+	if len(_c.mutation.ItemIDs()) == 0 {
+		return &ValidationError{Name: "item", err: errors.New(`ent: missing required edge "StockDiscrepancy.item"`)}
+	}
+	if len(_c.mutation.LocationIDs()) == 0 {
+		return &ValidationError{Name: "location", err: errors.New(`ent: missing required edge "StockDiscrepancy.location"`)}
+	}
+	return nil
+}
+
+func (_c *StockDiscrepancyCreate) sqlSave(ctx context.Context) (*StockDiscrepancy, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *StockDiscrepancyCreate) createSpec() (*StockDiscrepancy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &StockDiscrepancy{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(stockdiscrepancy.Table, sqlgraph.NewFieldSpec(stockdiscrepancy.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(stockdiscrepancy.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.Reference(); ok {
+		_spec.SetField(stockdiscrepancy.FieldReference, field.TypeString, value)
+		_node.Reference = value
+	}
+	if value, ok := _c.mutation.Expected(); ok {
+		_spec.SetField(stockdiscrepancy.FieldExpected, field.TypeInt, value)
+		_node.Expected = value
+	}
+	if value, ok := _c.mutation.Found(); ok {
+		_spec.SetField(stockdiscrepancy.FieldFound, field.TypeInt, value)
+		_node.Found = value
+	}
+	if value, ok := _c.mutation.BinID(); ok {
+		_spec.SetField(stockdiscrepancy.FieldBinID, field.TypeInt, value)
+		_node.BinID = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(stockdiscrepancy.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   stockdiscrepancy.ItemTable,
+			Columns: []string{stockdiscrepancy.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.stock_discrepancy_item = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   stockdiscrepancy.LocationTable,
+			Columns: []string{stockdiscrepancy.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.stock_discrepancy_location = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// StockDiscrepancyCreateBulk is the builder for creating many StockDiscrepancy entities in bulk.
+type StockDiscrepancyCreateBulk struct {
+	config
+	err      error
+	builders []*StockDiscrepancyCreate
+}
+
+// Save creates the StockDiscrepancy entities in the database.
+func (_c *StockDiscrepancyCreateBulk) Save(ctx context.Context) ([]*StockDiscrepancy, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*StockDiscrepancy, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*StockDiscrepancyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *StockDiscrepancyCreateBulk) SaveX(ctx context.Context) []*StockDiscrepancy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *StockDiscrepancyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *StockDiscrepancyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
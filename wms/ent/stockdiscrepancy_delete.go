@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/predicate"
+	"github.com/mxV03/wms/ent/stockdiscrepancy"
+)
+
+// StockDiscrepancyDelete is the builder for deleting a StockDiscrepancy entity.
+type StockDiscrepancyDelete struct {
+	config
+	hooks    []Hook
+	mutation *StockDiscrepancyMutation
+}
+
+// Where appends a list predicates to the StockDiscrepancyDelete builder.
+func (_d *StockDiscrepancyDelete) Where(ps ...predicate.StockDiscrepancy) *StockDiscrepancyDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *StockDiscrepancyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *StockDiscrepancyDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *StockDiscrepancyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(stockdiscrepancy.Table, sqlgraph.NewFieldSpec(stockdiscrepancy.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// StockDiscrepancyDeleteOne is the builder for deleting a single StockDiscrepancy entity.
+type StockDiscrepancyDeleteOne struct {
+	_d *StockDiscrepancyDelete
+}
+
+// Where appends a list predicates to the StockDiscrepancyDelete builder.
+func (_d *StockDiscrepancyDeleteOne) Where(ps ...predicate.StockDiscrepancy) *StockDiscrepancyDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *StockDiscrepancyDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{stockdiscrepancy.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *StockDiscrepancyDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
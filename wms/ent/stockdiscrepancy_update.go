@@ -0,0 +1,710 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/item"
+	"github.com/mxV03/wms/ent/location"
+	"github.com/mxV03/wms/ent/predicate"
+	"github.com/mxV03/wms/ent/stockdiscrepancy"
+)
+
+// StockDiscrepancyUpdate is the builder for updating StockDiscrepancy entities.
+type StockDiscrepancyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *StockDiscrepancyMutation
+}
+
+// Where appends a list predicates to the StockDiscrepancyUpdate builder.
+func (_u *StockDiscrepancyUpdate) Where(ps ...predicate.StockDiscrepancy) *StockDiscrepancyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *StockDiscrepancyUpdate) SetSource(v string) *StockDiscrepancyUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *StockDiscrepancyUpdate) SetNillableSource(v *string) *StockDiscrepancyUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetReference sets the "reference" field.
+func (_u *StockDiscrepancyUpdate) SetReference(v string) *StockDiscrepancyUpdate {
+	_u.mutation.SetReference(v)
+	return _u
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_u *StockDiscrepancyUpdate) SetNillableReference(v *string) *StockDiscrepancyUpdate {
+	if v != nil {
+		_u.SetReference(*v)
+	}
+	return _u
+}
+
+// ClearReference clears the value of the "reference" field.
+func (_u *StockDiscrepancyUpdate) ClearReference() *StockDiscrepancyUpdate {
+	_u.mutation.ClearReference()
+	return _u
+}
+
+// SetExpected sets the "expected" field.
+func (_u *StockDiscrepancyUpdate) SetExpected(v int) *StockDiscrepancyUpdate {
+	_u.mutation.ResetExpected()
+	_u.mutation.SetExpected(v)
+	return _u
+}
+
+// SetNillableExpected sets the "expected" field if the given value is not nil.
+func (_u *StockDiscrepancyUpdate) SetNillableExpected(v *int) *StockDiscrepancyUpdate {
+	if v != nil {
+		_u.SetExpected(*v)
+	}
+	return _u
+}
+
+// AddExpected adds value to the "expected" field.
+func (_u *StockDiscrepancyUpdate) AddExpected(v int) *StockDiscrepancyUpdate {
+	_u.mutation.AddExpected(v)
+	return _u
+}
+
+// SetFound sets the "found" field.
+func (_u *StockDiscrepancyUpdate) SetFound(v int) *StockDiscrepancyUpdate {
+	_u.mutation.ResetFound()
+	_u.mutation.SetFound(v)
+	return _u
+}
+
+// SetNillableFound sets the "found" field if the given value is not nil.
+func (_u *StockDiscrepancyUpdate) SetNillableFound(v *int) *StockDiscrepancyUpdate {
+	if v != nil {
+		_u.SetFound(*v)
+	}
+	return _u
+}
+
+// AddFound adds value to the "found" field.
+func (_u *StockDiscrepancyUpdate) AddFound(v int) *StockDiscrepancyUpdate {
+	_u.mutation.AddFound(v)
+	return _u
+}
+
+// SetBinID sets the "bin_id" field.
+func (_u *StockDiscrepancyUpdate) SetBinID(v int) *StockDiscrepancyUpdate {
+	_u.mutation.ResetBinID()
+	_u.mutation.SetBinID(v)
+	return _u
+}
+
+// SetNillableBinID sets the "bin_id" field if the given value is not nil.
+func (_u *StockDiscrepancyUpdate) SetNillableBinID(v *int) *StockDiscrepancyUpdate {
+	if v != nil {
+		_u.SetBinID(*v)
+	}
+	return _u
+}
+
+// AddBinID adds value to the "bin_id" field.
+func (_u *StockDiscrepancyUpdate) AddBinID(v int) *StockDiscrepancyUpdate {
+	_u.mutation.AddBinID(v)
+	return _u
+}
+
+// ClearBinID clears the value of the "bin_id" field.
+func (_u *StockDiscrepancyUpdate) ClearBinID() *StockDiscrepancyUpdate {
+	_u.mutation.ClearBinID()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *StockDiscrepancyUpdate) SetCreatedAt(v time.Time) *StockDiscrepancyUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *StockDiscrepancyUpdate) SetNillableCreatedAt(v *time.Time) *StockDiscrepancyUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item" edge to the Item entity by ID.
+func (_u *StockDiscrepancyUpdate) SetItemID(id int) *StockDiscrepancyUpdate {
+	_u.mutation.SetItemID(id)
+	return _u
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_u *StockDiscrepancyUpdate) SetItem(v *Item) *StockDiscrepancyUpdate {
+	return _u.SetItemID(v.ID)
+}
+
+// SetLocationID sets the "location" edge to the Location entity by ID.
+func (_u *StockDiscrepancyUpdate) SetLocationID(id int) *StockDiscrepancyUpdate {
+	_u.mutation.SetLocationID(id)
+	return _u
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (_u *StockDiscrepancyUpdate) SetLocation(v *Location) *StockDiscrepancyUpdate {
+	return _u.SetLocationID(v.ID)
+}
+
+// Mutation returns the StockDiscrepancyMutation object of the builder.
+func (_u *StockDiscrepancyUpdate) Mutation() *StockDiscrepancyMutation {
+	return _u.mutation
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (_u *StockDiscrepancyUpdate) ClearItem() *StockDiscrepancyUpdate {
+	_u.mutation.ClearItem()
+	return _u
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (_u *StockDiscrepancyUpdate) ClearLocation() *StockDiscrepancyUpdate {
+	_u.mutation.ClearLocation()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *StockDiscrepancyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StockDiscrepancyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *StockDiscrepancyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StockDiscrepancyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *StockDiscrepancyUpdate) check() error {
+	if v, ok := _u.mutation.Expected(); ok {
+		if err := stockdiscrepancy.ExpectedValidator(v); err != nil {
+			return &ValidationError{Name: "expected", err: fmt.Errorf(`ent: validator failed for field "StockDiscrepancy.expected": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Found(); ok {
+		if err := stockdiscrepancy.FoundValidator(v); err != nil {
+			return &ValidationError{Name: "found", err: fmt.Errorf(`ent: validator failed for field "StockDiscrepancy.found": %w`, err)}
+		}
+	}
+	if _u.mutation.ItemCleared() && len(_u.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StockDiscrepancy.item"`)
+	}
+	if _u.mutation.LocationCleared() && len(_u.mutation.LocationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StockDiscrepancy.location"`)
+	}
+	return nil
+}
+
+func (_u *StockDiscrepancyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(stockdiscrepancy.Table, stockdiscrepancy.Columns, sqlgraph.NewFieldSpec(stockdiscrepancy.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(stockdiscrepancy.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reference(); ok {
+		_spec.SetField(stockdiscrepancy.FieldReference, field.TypeString, value)
+	}
+	if _u.mutation.ReferenceCleared() {
+		_spec.ClearField(stockdiscrepancy.FieldReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.Expected(); ok {
+		_spec.SetField(stockdiscrepancy.FieldExpected, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedExpected(); ok {
+		_spec.AddField(stockdiscrepancy.FieldExpected, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Found(); ok {
+		_spec.SetField(stockdiscrepancy.FieldFound, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFound(); ok {
+		_spec.AddField(stockdiscrepancy.FieldFound, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BinID(); ok {
+		_spec.SetField(stockdiscrepancy.FieldBinID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBinID(); ok {
+		_spec.AddField(stockdiscrepancy.FieldBinID, field.TypeInt, value)
+	}
+	if _u.mutation.BinIDCleared() {
+		_spec.ClearField(stockdiscrepancy.FieldBinID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(stockdiscrepancy.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   stockdiscrepancy.ItemTable,
+			Columns: []string{stockdiscrepancy.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   stockdiscrepancy.ItemTable,
+			Columns: []string{stockdiscrepancy.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   stockdiscrepancy.LocationTable,
+			Columns: []string{stockdiscrepancy.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   stockdiscrepancy.LocationTable,
+			Columns: []string{stockdiscrepancy.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stockdiscrepancy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// StockDiscrepancyUpdateOne is the builder for updating a single StockDiscrepancy entity.
+type StockDiscrepancyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *StockDiscrepancyMutation
+}
+
+// SetSource sets the "source" field.
+func (_u *StockDiscrepancyUpdateOne) SetSource(v string) *StockDiscrepancyUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *StockDiscrepancyUpdateOne) SetNillableSource(v *string) *StockDiscrepancyUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetReference sets the "reference" field.
+func (_u *StockDiscrepancyUpdateOne) SetReference(v string) *StockDiscrepancyUpdateOne {
+	_u.mutation.SetReference(v)
+	return _u
+}
+
+// SetNillableReference sets the "reference" field if the given value is not nil.
+func (_u *StockDiscrepancyUpdateOne) SetNillableReference(v *string) *StockDiscrepancyUpdateOne {
+	if v != nil {
+		_u.SetReference(*v)
+	}
+	return _u
+}
+
+// ClearReference clears the value of the "reference" field.
+func (_u *StockDiscrepancyUpdateOne) ClearReference() *StockDiscrepancyUpdateOne {
+	_u.mutation.ClearReference()
+	return _u
+}
+
+// SetExpected sets the "expected" field.
+func (_u *StockDiscrepancyUpdateOne) SetExpected(v int) *StockDiscrepancyUpdateOne {
+	_u.mutation.ResetExpected()
+	_u.mutation.SetExpected(v)
+	return _u
+}
+
+// SetNillableExpected sets the "expected" field if the given value is not nil.
+func (_u *StockDiscrepancyUpdateOne) SetNillableExpected(v *int) *StockDiscrepancyUpdateOne {
+	if v != nil {
+		_u.SetExpected(*v)
+	}
+	return _u
+}
+
+// AddExpected adds value to the "expected" field.
+func (_u *StockDiscrepancyUpdateOne) AddExpected(v int) *StockDiscrepancyUpdateOne {
+	_u.mutation.AddExpected(v)
+	return _u
+}
+
+// SetFound sets the "found" field.
+func (_u *StockDiscrepancyUpdateOne) SetFound(v int) *StockDiscrepancyUpdateOne {
+	_u.mutation.ResetFound()
+	_u.mutation.SetFound(v)
+	return _u
+}
+
+// SetNillableFound sets the "found" field if the given value is not nil.
+func (_u *StockDiscrepancyUpdateOne) SetNillableFound(v *int) *StockDiscrepancyUpdateOne {
+	if v != nil {
+		_u.SetFound(*v)
+	}
+	return _u
+}
+
+// AddFound adds value to the "found" field.
+func (_u *StockDiscrepancyUpdateOne) AddFound(v int) *StockDiscrepancyUpdateOne {
+	_u.mutation.AddFound(v)
+	return _u
+}
+
+// SetBinID sets the "bin_id" field.
+func (_u *StockDiscrepancyUpdateOne) SetBinID(v int) *StockDiscrepancyUpdateOne {
+	_u.mutation.ResetBinID()
+	_u.mutation.SetBinID(v)
+	return _u
+}
+
+// SetNillableBinID sets the "bin_id" field if the given value is not nil.
+func (_u *StockDiscrepancyUpdateOne) SetNillableBinID(v *int) *StockDiscrepancyUpdateOne {
+	if v != nil {
+		_u.SetBinID(*v)
+	}
+	return _u
+}
+
+// AddBinID adds value to the "bin_id" field.
+func (_u *StockDiscrepancyUpdateOne) AddBinID(v int) *StockDiscrepancyUpdateOne {
+	_u.mutation.AddBinID(v)
+	return _u
+}
+
+// ClearBinID clears the value of the "bin_id" field.
+func (_u *StockDiscrepancyUpdateOne) ClearBinID() *StockDiscrepancyUpdateOne {
+	_u.mutation.ClearBinID()
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *StockDiscrepancyUpdateOne) SetCreatedAt(v time.Time) *StockDiscrepancyUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *StockDiscrepancyUpdateOne) SetNillableCreatedAt(v *time.Time) *StockDiscrepancyUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetItemID sets the "item" edge to the Item entity by ID.
+func (_u *StockDiscrepancyUpdateOne) SetItemID(id int) *StockDiscrepancyUpdateOne {
+	_u.mutation.SetItemID(id)
+	return _u
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_u *StockDiscrepancyUpdateOne) SetItem(v *Item) *StockDiscrepancyUpdateOne {
+	return _u.SetItemID(v.ID)
+}
+
+// SetLocationID sets the "location" edge to the Location entity by ID.
+func (_u *StockDiscrepancyUpdateOne) SetLocationID(id int) *StockDiscrepancyUpdateOne {
+	_u.mutation.SetLocationID(id)
+	return _u
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (_u *StockDiscrepancyUpdateOne) SetLocation(v *Location) *StockDiscrepancyUpdateOne {
+	return _u.SetLocationID(v.ID)
+}
+
+// Mutation returns the StockDiscrepancyMutation object of the builder.
+func (_u *StockDiscrepancyUpdateOne) Mutation() *StockDiscrepancyMutation {
+	return _u.mutation
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (_u *StockDiscrepancyUpdateOne) ClearItem() *StockDiscrepancyUpdateOne {
+	_u.mutation.ClearItem()
+	return _u
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (_u *StockDiscrepancyUpdateOne) ClearLocation() *StockDiscrepancyUpdateOne {
+	_u.mutation.ClearLocation()
+	return _u
+}
+
+// Where appends a list predicates to the StockDiscrepancyUpdate builder.
+func (_u *StockDiscrepancyUpdateOne) Where(ps ...predicate.StockDiscrepancy) *StockDiscrepancyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *StockDiscrepancyUpdateOne) Select(field string, fields ...string) *StockDiscrepancyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated StockDiscrepancy entity.
+func (_u *StockDiscrepancyUpdateOne) Save(ctx context.Context) (*StockDiscrepancy, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StockDiscrepancyUpdateOne) SaveX(ctx context.Context) *StockDiscrepancy {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *StockDiscrepancyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StockDiscrepancyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *StockDiscrepancyUpdateOne) check() error {
+	if v, ok := _u.mutation.Expected(); ok {
+		if err := stockdiscrepancy.ExpectedValidator(v); err != nil {
+			return &ValidationError{Name: "expected", err: fmt.Errorf(`ent: validator failed for field "StockDiscrepancy.expected": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Found(); ok {
+		if err := stockdiscrepancy.FoundValidator(v); err != nil {
+			return &ValidationError{Name: "found", err: fmt.Errorf(`ent: validator failed for field "StockDiscrepancy.found": %w`, err)}
+		}
+	}
+	if _u.mutation.ItemCleared() && len(_u.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StockDiscrepancy.item"`)
+	}
+	if _u.mutation.LocationCleared() && len(_u.mutation.LocationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StockDiscrepancy.location"`)
+	}
+	return nil
+}
+
+func (_u *StockDiscrepancyUpdateOne) sqlSave(ctx context.Context) (_node *StockDiscrepancy, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(stockdiscrepancy.Table, stockdiscrepancy.Columns, sqlgraph.NewFieldSpec(stockdiscrepancy.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StockDiscrepancy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, stockdiscrepancy.FieldID)
+		for _, f := range fields {
+			if !stockdiscrepancy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != stockdiscrepancy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(stockdiscrepancy.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Reference(); ok {
+		_spec.SetField(stockdiscrepancy.FieldReference, field.TypeString, value)
+	}
+	if _u.mutation.ReferenceCleared() {
+		_spec.ClearField(stockdiscrepancy.FieldReference, field.TypeString)
+	}
+	if value, ok := _u.mutation.Expected(); ok {
+		_spec.SetField(stockdiscrepancy.FieldExpected, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedExpected(); ok {
+		_spec.AddField(stockdiscrepancy.FieldExpected, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Found(); ok {
+		_spec.SetField(stockdiscrepancy.FieldFound, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFound(); ok {
+		_spec.AddField(stockdiscrepancy.FieldFound, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.BinID(); ok {
+		_spec.SetField(stockdiscrepancy.FieldBinID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBinID(); ok {
+		_spec.AddField(stockdiscrepancy.FieldBinID, field.TypeInt, value)
+	}
+	if _u.mutation.BinIDCleared() {
+		_spec.ClearField(stockdiscrepancy.FieldBinID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(stockdiscrepancy.FieldCreatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   stockdiscrepancy.ItemTable,
+			Columns: []string{stockdiscrepancy.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   stockdiscrepancy.ItemTable,
+			Columns: []string{stockdiscrepancy.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   stockdiscrepancy.LocationTable,
+			Columns: []string{stockdiscrepancy.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   stockdiscrepancy.LocationTable,
+			Columns: []string{stockdiscrepancy.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &StockDiscrepancy{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{stockdiscrepancy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
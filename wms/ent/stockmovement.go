@@ -27,6 +27,8 @@ type StockMovement struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Reference holds the value of the "reference" field.
 	Reference string `json:"reference,omitempty"`
+	// IdempotencyKey holds the value of the "idempotency_key" field.
+	IdempotencyKey *string `json:"idempotency_key,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the StockMovementQuery when eager-loading is set.
 	Edges              StockMovementEdges `json:"edges"`
@@ -75,7 +77,7 @@ func (*StockMovement) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case stockmovement.FieldID, stockmovement.FieldQuantity:
 			values[i] = new(sql.NullInt64)
-		case stockmovement.FieldType, stockmovement.FieldReference:
+		case stockmovement.FieldType, stockmovement.FieldReference, stockmovement.FieldIdempotencyKey:
 			values[i] = new(sql.NullString)
 		case stockmovement.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -128,6 +130,13 @@ func (_m *StockMovement) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Reference = value.String
 			}
+		case stockmovement.FieldIdempotencyKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field idempotency_key", values[i])
+			} else if value.Valid {
+				_m.IdempotencyKey = new(string)
+				*_m.IdempotencyKey = value.String
+			}
 		case stockmovement.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field item_movements", value)
@@ -199,6 +208,11 @@ func (_m *StockMovement) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("reference=")
 	builder.WriteString(_m.Reference)
+	builder.WriteString(", ")
+	if v := _m.IdempotencyKey; v != nil {
+		builder.WriteString("idempotency_key=")
+		builder.WriteString(*v)
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -22,6 +22,8 @@ const (
 	FieldCreatedAt = "created_at"
 	// FieldReference holds the string denoting the reference field in the database.
 	FieldReference = "reference"
+	// FieldIdempotencyKey holds the string denoting the idempotency_key field in the database.
+	FieldIdempotencyKey = "idempotency_key"
 	// EdgeItem holds the string denoting the item edge name in mutations.
 	EdgeItem = "item"
 	// EdgeLocation holds the string denoting the location edge name in mutations.
@@ -51,6 +53,7 @@ var Columns = []string{
 	FieldQuantity,
 	FieldCreatedAt,
 	FieldReference,
+	FieldIdempotencyKey,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "stock_movements"
@@ -112,6 +115,11 @@ func ByReference(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldReference, opts...).ToFunc()
 }
 
+// ByIdempotencyKey orders the results by the idempotency_key field.
+func ByIdempotencyKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdempotencyKey, opts...).ToFunc()
+}
+
 // ByItemField orders the results by item field.
 func ByItemField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
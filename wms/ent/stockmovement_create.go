@@ -62,6 +62,20 @@ func (_c *StockMovementCreate) SetNillableReference(v *string) *StockMovementCre
 	return _c
 }
 
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (_c *StockMovementCreate) SetIdempotencyKey(v string) *StockMovementCreate {
+	_c.mutation.SetIdempotencyKey(v)
+	return _c
+}
+
+// SetNillableIdempotencyKey sets the "idempotency_key" field if the given value is not nil.
+func (_c *StockMovementCreate) SetNillableIdempotencyKey(v *string) *StockMovementCreate {
+	if v != nil {
+		_c.SetIdempotencyKey(*v)
+	}
+	return _c
+}
+
 // SetItemID sets the "item" edge to the Item entity by ID.
 func (_c *StockMovementCreate) SetItemID(id int) *StockMovementCreate {
 	_c.mutation.SetItemID(id)
@@ -194,6 +208,10 @@ func (_c *StockMovementCreate) createSpec() (*StockMovement, *sqlgraph.CreateSpe
 		_spec.SetField(stockmovement.FieldReference, field.TypeString, value)
 		_node.Reference = value
 	}
+	if value, ok := _c.mutation.IdempotencyKey(); ok {
+		_spec.SetField(stockmovement.FieldIdempotencyKey, field.TypeString, value)
+		_node.IdempotencyKey = &value
+	}
 	if nodes := _c.mutation.ItemIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -99,6 +99,26 @@ func (_u *StockMovementUpdate) ClearReference() *StockMovementUpdate {
 	return _u
 }
 
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (_u *StockMovementUpdate) SetIdempotencyKey(v string) *StockMovementUpdate {
+	_u.mutation.SetIdempotencyKey(v)
+	return _u
+}
+
+// SetNillableIdempotencyKey sets the "idempotency_key" field if the given value is not nil.
+func (_u *StockMovementUpdate) SetNillableIdempotencyKey(v *string) *StockMovementUpdate {
+	if v != nil {
+		_u.SetIdempotencyKey(*v)
+	}
+	return _u
+}
+
+// ClearIdempotencyKey clears the value of the "idempotency_key" field.
+func (_u *StockMovementUpdate) ClearIdempotencyKey() *StockMovementUpdate {
+	_u.mutation.ClearIdempotencyKey()
+	return _u
+}
+
 // SetItemID sets the "item" edge to the Item entity by ID.
 func (_u *StockMovementUpdate) SetItemID(id int) *StockMovementUpdate {
 	_u.mutation.SetItemID(id)
@@ -216,6 +236,12 @@ func (_u *StockMovementUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if _u.mutation.ReferenceCleared() {
 		_spec.ClearField(stockmovement.FieldReference, field.TypeString)
 	}
+	if value, ok := _u.mutation.IdempotencyKey(); ok {
+		_spec.SetField(stockmovement.FieldIdempotencyKey, field.TypeString, value)
+	}
+	if _u.mutation.IdempotencyKeyCleared() {
+		_spec.ClearField(stockmovement.FieldIdempotencyKey, field.TypeString)
+	}
 	if _u.mutation.ItemCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -363,6 +389,26 @@ func (_u *StockMovementUpdateOne) ClearReference() *StockMovementUpdateOne {
 	return _u
 }
 
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (_u *StockMovementUpdateOne) SetIdempotencyKey(v string) *StockMovementUpdateOne {
+	_u.mutation.SetIdempotencyKey(v)
+	return _u
+}
+
+// SetNillableIdempotencyKey sets the "idempotency_key" field if the given value is not nil.
+func (_u *StockMovementUpdateOne) SetNillableIdempotencyKey(v *string) *StockMovementUpdateOne {
+	if v != nil {
+		_u.SetIdempotencyKey(*v)
+	}
+	return _u
+}
+
+// ClearIdempotencyKey clears the value of the "idempotency_key" field.
+func (_u *StockMovementUpdateOne) ClearIdempotencyKey() *StockMovementUpdateOne {
+	_u.mutation.ClearIdempotencyKey()
+	return _u
+}
+
 // SetItemID sets the "item" edge to the Item entity by ID.
 func (_u *StockMovementUpdateOne) SetItemID(id int) *StockMovementUpdateOne {
 	_u.mutation.SetItemID(id)
@@ -510,6 +556,12 @@ func (_u *StockMovementUpdateOne) sqlSave(ctx context.Context) (_node *StockMove
 	if _u.mutation.ReferenceCleared() {
 		_spec.ClearField(stockmovement.FieldReference, field.TypeString)
 	}
+	if value, ok := _u.mutation.IdempotencyKey(); ok {
+		_spec.SetField(stockmovement.FieldIdempotencyKey, field.TypeString, value)
+	}
+	if _u.mutation.IdempotencyKeyCleared() {
+		_spec.ClearField(stockmovement.FieldIdempotencyKey, field.TypeString)
+	}
 	if _u.mutation.ItemCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
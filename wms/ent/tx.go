@@ -16,6 +16,16 @@ type Tx struct {
 	AuditEvent *AuditEventClient
 	// Bin is the client for interacting with the Bin builders.
 	Bin *BinClient
+	// BinStock is the client for interacting with the BinStock builders.
+	BinStock *BinStockClient
+	// Carton is the client for interacting with the Carton builders.
+	Carton *CartonClient
+	// CartonLine is the client for interacting with the CartonLine builders.
+	CartonLine *CartonLineClient
+	// CartonType is the client for interacting with the CartonType builders.
+	CartonType *CartonTypeClient
+	// EventRule is the client for interacting with the EventRule builders.
+	EventRule *EventRuleClient
 	// Item is the client for interacting with the Item builders.
 	Item *ItemClient
 	// Location is the client for interacting with the Location builders.
@@ -28,6 +38,20 @@ type Tx struct {
 	PickList *PickListClient
 	// PickTask is the client for interacting with the PickTask builders.
 	PickTask *PickTaskClient
+	// PutawayTask is the client for interacting with the PutawayTask builders.
+	PutawayTask *PutawayTaskClient
+	// Receipt is the client for interacting with the Receipt builders.
+	Receipt *ReceiptClient
+	// ReorderPolicy is the client for interacting with the ReorderPolicy builders.
+	ReorderPolicy *ReorderPolicyClient
+	// ReplenishmentRule is the client for interacting with the ReplenishmentRule builders.
+	ReplenishmentRule *ReplenishmentRuleClient
+	// ReplenishmentTask is the client for interacting with the ReplenishmentTask builders.
+	ReplenishmentTask *ReplenishmentTaskClient
+	// Sequence is the client for interacting with the Sequence builders.
+	Sequence *SequenceClient
+	// StockDiscrepancy is the client for interacting with the StockDiscrepancy builders.
+	StockDiscrepancy *StockDiscrepancyClient
 	// StockMovement is the client for interacting with the StockMovement builders.
 	StockMovement *StockMovementClient
 	// Tracking is the client for interacting with the Tracking builders.
@@ -38,6 +62,12 @@ type Tx struct {
 	Warehouse *WarehouseClient
 	// WarehouseLocation is the client for interacting with the WarehouseLocation builders.
 	WarehouseLocation *WarehouseLocationClient
+	// Wave is the client for interacting with the Wave builders.
+	Wave *WaveClient
+	// WavePick is the client for interacting with the WavePick builders.
+	WavePick *WavePickClient
+	// WaveTask is the client for interacting with the WaveTask builders.
+	WaveTask *WaveTaskClient
 	// Zone is the client for interacting with the Zone builders.
 	Zone *ZoneClient
 
@@ -173,17 +203,32 @@ func (tx *Tx) Client() *Client {
 func (tx *Tx) init() {
 	tx.AuditEvent = NewAuditEventClient(tx.config)
 	tx.Bin = NewBinClient(tx.config)
+	tx.BinStock = NewBinStockClient(tx.config)
+	tx.Carton = NewCartonClient(tx.config)
+	tx.CartonLine = NewCartonLineClient(tx.config)
+	tx.CartonType = NewCartonTypeClient(tx.config)
+	tx.EventRule = NewEventRuleClient(tx.config)
 	tx.Item = NewItemClient(tx.config)
 	tx.Location = NewLocationClient(tx.config)
 	tx.Order = NewOrderClient(tx.config)
 	tx.OrderLine = NewOrderLineClient(tx.config)
 	tx.PickList = NewPickListClient(tx.config)
 	tx.PickTask = NewPickTaskClient(tx.config)
+	tx.PutawayTask = NewPutawayTaskClient(tx.config)
+	tx.Receipt = NewReceiptClient(tx.config)
+	tx.ReorderPolicy = NewReorderPolicyClient(tx.config)
+	tx.ReplenishmentRule = NewReplenishmentRuleClient(tx.config)
+	tx.ReplenishmentTask = NewReplenishmentTaskClient(tx.config)
+	tx.Sequence = NewSequenceClient(tx.config)
+	tx.StockDiscrepancy = NewStockDiscrepancyClient(tx.config)
 	tx.StockMovement = NewStockMovementClient(tx.config)
 	tx.Tracking = NewTrackingClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.Warehouse = NewWarehouseClient(tx.config)
 	tx.WarehouseLocation = NewWarehouseLocationClient(tx.config)
+	tx.Wave = NewWaveClient(tx.config)
+	tx.WavePick = NewWavePickClient(tx.config)
+	tx.WaveTask = NewWaveTaskClient(tx.config)
 	tx.Zone = NewZoneClient(tx.config)
 }
 
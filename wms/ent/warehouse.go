@@ -30,9 +30,13 @@ type Warehouse struct {
 type WarehouseEdges struct {
 	// WarehouseLocations holds the value of the warehouse_locations edge.
 	WarehouseLocations []*WarehouseLocation `json:"warehouse_locations,omitempty"`
+	// OutgoingTransfers holds the value of the outgoing_transfers edge.
+	OutgoingTransfers []*Order `json:"outgoing_transfers,omitempty"`
+	// IncomingTransfers holds the value of the incoming_transfers edge.
+	IncomingTransfers []*Order `json:"incoming_transfers,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
 }
 
 // WarehouseLocationsOrErr returns the WarehouseLocations value or an error if the edge
@@ -44,6 +48,24 @@ func (e WarehouseEdges) WarehouseLocationsOrErr() ([]*WarehouseLocation, error)
 	return nil, &NotLoadedError{edge: "warehouse_locations"}
 }
 
+// OutgoingTransfersOrErr returns the OutgoingTransfers value or an error if the edge
+// was not loaded in eager-loading.
+func (e WarehouseEdges) OutgoingTransfersOrErr() ([]*Order, error) {
+	if e.loadedTypes[1] {
+		return e.OutgoingTransfers, nil
+	}
+	return nil, &NotLoadedError{edge: "outgoing_transfers"}
+}
+
+// IncomingTransfersOrErr returns the IncomingTransfers value or an error if the edge
+// was not loaded in eager-loading.
+func (e WarehouseEdges) IncomingTransfersOrErr() ([]*Order, error) {
+	if e.loadedTypes[2] {
+		return e.IncomingTransfers, nil
+	}
+	return nil, &NotLoadedError{edge: "incoming_transfers"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Warehouse) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -104,6 +126,16 @@ func (_m *Warehouse) QueryWarehouseLocations() *WarehouseLocationQuery {
 	return NewWarehouseClient(_m.config).QueryWarehouseLocations(_m)
 }
 
+// QueryOutgoingTransfers queries the "outgoing_transfers" edge of the Warehouse entity.
+func (_m *Warehouse) QueryOutgoingTransfers() *OrderQuery {
+	return NewWarehouseClient(_m.config).QueryOutgoingTransfers(_m)
+}
+
+// QueryIncomingTransfers queries the "incoming_transfers" edge of the Warehouse entity.
+func (_m *Warehouse) QueryIncomingTransfers() *OrderQuery {
+	return NewWarehouseClient(_m.config).QueryIncomingTransfers(_m)
+}
+
 // Update returns a builder for updating this Warehouse.
 // Note that you need to call Warehouse.Unwrap() before calling this method if this Warehouse
 // was returned from a transaction, and the transaction was committed or rolled back.
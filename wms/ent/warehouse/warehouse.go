@@ -18,6 +18,10 @@ const (
 	FieldName = "name"
 	// EdgeWarehouseLocations holds the string denoting the warehouse_locations edge name in mutations.
 	EdgeWarehouseLocations = "warehouse_locations"
+	// EdgeOutgoingTransfers holds the string denoting the outgoing_transfers edge name in mutations.
+	EdgeOutgoingTransfers = "outgoing_transfers"
+	// EdgeIncomingTransfers holds the string denoting the incoming_transfers edge name in mutations.
+	EdgeIncomingTransfers = "incoming_transfers"
 	// Table holds the table name of the warehouse in the database.
 	Table = "warehouses"
 	// WarehouseLocationsTable is the table that holds the warehouse_locations relation/edge.
@@ -27,6 +31,20 @@ const (
 	WarehouseLocationsInverseTable = "warehouse_locations"
 	// WarehouseLocationsColumn is the table column denoting the warehouse_locations relation/edge.
 	WarehouseLocationsColumn = "warehouse_warehouse_locations"
+	// OutgoingTransfersTable is the table that holds the outgoing_transfers relation/edge.
+	OutgoingTransfersTable = "orders"
+	// OutgoingTransfersInverseTable is the table name for the Order entity.
+	// It exists in this package in order to avoid circular dependency with the "order" package.
+	OutgoingTransfersInverseTable = "orders"
+	// OutgoingTransfersColumn is the table column denoting the outgoing_transfers relation/edge.
+	OutgoingTransfersColumn = "warehouse_outgoing_transfers"
+	// IncomingTransfersTable is the table that holds the incoming_transfers relation/edge.
+	IncomingTransfersTable = "orders"
+	// IncomingTransfersInverseTable is the table name for the Order entity.
+	// It exists in this package in order to avoid circular dependency with the "order" package.
+	IncomingTransfersInverseTable = "orders"
+	// IncomingTransfersColumn is the table column denoting the incoming_transfers relation/edge.
+	IncomingTransfersColumn = "warehouse_incoming_transfers"
 )
 
 // Columns holds all SQL columns for warehouse fields.
@@ -84,6 +102,34 @@ func ByWarehouseLocations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOptio
 		sqlgraph.OrderByNeighborTerms(s, newWarehouseLocationsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByOutgoingTransfersCount orders the results by outgoing_transfers count.
+func ByOutgoingTransfersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOutgoingTransfersStep(), opts...)
+	}
+}
+
+// ByOutgoingTransfers orders the results by outgoing_transfers terms.
+func ByOutgoingTransfers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOutgoingTransfersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByIncomingTransfersCount orders the results by incoming_transfers count.
+func ByIncomingTransfersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newIncomingTransfersStep(), opts...)
+	}
+}
+
+// ByIncomingTransfers orders the results by incoming_transfers terms.
+func ByIncomingTransfers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newIncomingTransfersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newWarehouseLocationsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -91,3 +137,17 @@ func newWarehouseLocationsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, WarehouseLocationsTable, WarehouseLocationsColumn),
 	)
 }
+func newOutgoingTransfersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OutgoingTransfersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OutgoingTransfersTable, OutgoingTransfersColumn),
+	)
+}
+func newIncomingTransfersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(IncomingTransfersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, IncomingTransfersTable, IncomingTransfersColumn),
+	)
+}
@@ -226,6 +226,52 @@ func HasWarehouseLocationsWith(preds ...predicate.WarehouseLocation) predicate.W
 	})
 }
 
+// HasOutgoingTransfers applies the HasEdge predicate on the "outgoing_transfers" edge.
+func HasOutgoingTransfers() predicate.Warehouse {
+	return predicate.Warehouse(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OutgoingTransfersTable, OutgoingTransfersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOutgoingTransfersWith applies the HasEdge predicate on the "outgoing_transfers" edge with a given conditions (other predicates).
+func HasOutgoingTransfersWith(preds ...predicate.Order) predicate.Warehouse {
+	return predicate.Warehouse(func(s *sql.Selector) {
+		step := newOutgoingTransfersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasIncomingTransfers applies the HasEdge predicate on the "incoming_transfers" edge.
+func HasIncomingTransfers() predicate.Warehouse {
+	return predicate.Warehouse(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, IncomingTransfersTable, IncomingTransfersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasIncomingTransfersWith applies the HasEdge predicate on the "incoming_transfers" edge with a given conditions (other predicates).
+func HasIncomingTransfersWith(preds ...predicate.Order) predicate.Warehouse {
+	return predicate.Warehouse(func(s *sql.Selector) {
+		step := newIncomingTransfersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Warehouse) predicate.Warehouse {
 	return predicate.Warehouse(sql.AndPredicates(predicates...))
@@ -9,6 +9,7 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/order"
 	"github.com/mxV03/wms/ent/warehouse"
 	"github.com/mxV03/wms/ent/warehouselocation"
 )
@@ -55,6 +56,36 @@ func (_c *WarehouseCreate) AddWarehouseLocations(v ...*WarehouseLocation) *Wareh
 	return _c.AddWarehouseLocationIDs(ids...)
 }
 
+// AddOutgoingTransferIDs adds the "outgoing_transfers" edge to the Order entity by IDs.
+func (_c *WarehouseCreate) AddOutgoingTransferIDs(ids ...int) *WarehouseCreate {
+	_c.mutation.AddOutgoingTransferIDs(ids...)
+	return _c
+}
+
+// AddOutgoingTransfers adds the "outgoing_transfers" edges to the Order entity.
+func (_c *WarehouseCreate) AddOutgoingTransfers(v ...*Order) *WarehouseCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddOutgoingTransferIDs(ids...)
+}
+
+// AddIncomingTransferIDs adds the "incoming_transfers" edge to the Order entity by IDs.
+func (_c *WarehouseCreate) AddIncomingTransferIDs(ids ...int) *WarehouseCreate {
+	_c.mutation.AddIncomingTransferIDs(ids...)
+	return _c
+}
+
+// AddIncomingTransfers adds the "incoming_transfers" edges to the Order entity.
+func (_c *WarehouseCreate) AddIncomingTransfers(v ...*Order) *WarehouseCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddIncomingTransferIDs(ids...)
+}
+
 // Mutation returns the WarehouseMutation object of the builder.
 func (_c *WarehouseCreate) Mutation() *WarehouseMutation {
 	return _c.mutation
@@ -156,6 +187,38 @@ func (_c *WarehouseCreate) createSpec() (*Warehouse, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.OutgoingTransfersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.OutgoingTransfersTable,
+			Columns: []string{warehouse.OutgoingTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.IncomingTransfersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.IncomingTransfersTable,
+			Columns: []string{warehouse.IncomingTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/order"
 	"github.com/mxV03/wms/ent/predicate"
 	"github.com/mxV03/wms/ent/warehouse"
 	"github.com/mxV03/wms/ent/warehouselocation"
@@ -25,6 +26,8 @@ type WarehouseQuery struct {
 	inters                 []Interceptor
 	predicates             []predicate.Warehouse
 	withWarehouseLocations *WarehouseLocationQuery
+	withOutgoingTransfers  *OrderQuery
+	withIncomingTransfers  *OrderQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +86,50 @@ func (_q *WarehouseQuery) QueryWarehouseLocations() *WarehouseLocationQuery {
 	return query
 }
 
+// QueryOutgoingTransfers chains the current query on the "outgoing_transfers" edge.
+func (_q *WarehouseQuery) QueryOutgoingTransfers() *OrderQuery {
+	query := (&OrderClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(warehouse.Table, warehouse.FieldID, selector),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, warehouse.OutgoingTransfersTable, warehouse.OutgoingTransfersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryIncomingTransfers chains the current query on the "incoming_transfers" edge.
+func (_q *WarehouseQuery) QueryIncomingTransfers() *OrderQuery {
+	query := (&OrderClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(warehouse.Table, warehouse.FieldID, selector),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, warehouse.IncomingTransfersTable, warehouse.IncomingTransfersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Warehouse entity from the query.
 // Returns a *NotFoundError when no Warehouse was found.
 func (_q *WarehouseQuery) First(ctx context.Context) (*Warehouse, error) {
@@ -276,6 +323,8 @@ func (_q *WarehouseQuery) Clone() *WarehouseQuery {
 		inters:                 append([]Interceptor{}, _q.inters...),
 		predicates:             append([]predicate.Warehouse{}, _q.predicates...),
 		withWarehouseLocations: _q.withWarehouseLocations.Clone(),
+		withOutgoingTransfers:  _q.withOutgoingTransfers.Clone(),
+		withIncomingTransfers:  _q.withIncomingTransfers.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -293,6 +342,28 @@ func (_q *WarehouseQuery) WithWarehouseLocations(opts ...func(*WarehouseLocation
 	return _q
 }
 
+// WithOutgoingTransfers tells the query-builder to eager-load the nodes that are connected to
+// the "outgoing_transfers" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *WarehouseQuery) WithOutgoingTransfers(opts ...func(*OrderQuery)) *WarehouseQuery {
+	query := (&OrderClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withOutgoingTransfers = query
+	return _q
+}
+
+// WithIncomingTransfers tells the query-builder to eager-load the nodes that are connected to
+// the "incoming_transfers" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *WarehouseQuery) WithIncomingTransfers(opts ...func(*OrderQuery)) *WarehouseQuery {
+	query := (&OrderClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withIncomingTransfers = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -371,8 +442,10 @@ func (_q *WarehouseQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Wa
 	var (
 		nodes       = []*Warehouse{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [3]bool{
 			_q.withWarehouseLocations != nil,
+			_q.withOutgoingTransfers != nil,
+			_q.withIncomingTransfers != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -402,6 +475,20 @@ func (_q *WarehouseQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Wa
 			return nil, err
 		}
 	}
+	if query := _q.withOutgoingTransfers; query != nil {
+		if err := _q.loadOutgoingTransfers(ctx, query, nodes,
+			func(n *Warehouse) { n.Edges.OutgoingTransfers = []*Order{} },
+			func(n *Warehouse, e *Order) { n.Edges.OutgoingTransfers = append(n.Edges.OutgoingTransfers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withIncomingTransfers; query != nil {
+		if err := _q.loadIncomingTransfers(ctx, query, nodes,
+			func(n *Warehouse) { n.Edges.IncomingTransfers = []*Order{} },
+			func(n *Warehouse, e *Order) { n.Edges.IncomingTransfers = append(n.Edges.IncomingTransfers, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -436,6 +523,68 @@ func (_q *WarehouseQuery) loadWarehouseLocations(ctx context.Context, query *War
 	}
 	return nil
 }
+func (_q *WarehouseQuery) loadOutgoingTransfers(ctx context.Context, query *OrderQuery, nodes []*Warehouse, init func(*Warehouse), assign func(*Warehouse, *Order)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Warehouse)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Order(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(warehouse.OutgoingTransfersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.warehouse_outgoing_transfers
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "warehouse_outgoing_transfers" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "warehouse_outgoing_transfers" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *WarehouseQuery) loadIncomingTransfers(ctx context.Context, query *OrderQuery, nodes []*Warehouse, init func(*Warehouse), assign func(*Warehouse, *Order)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Warehouse)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Order(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(warehouse.IncomingTransfersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.warehouse_incoming_transfers
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "warehouse_incoming_transfers" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "warehouse_incoming_transfers" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *WarehouseQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/order"
 	"github.com/mxV03/wms/ent/predicate"
 	"github.com/mxV03/wms/ent/warehouse"
 	"github.com/mxV03/wms/ent/warehouselocation"
@@ -77,6 +78,36 @@ func (_u *WarehouseUpdate) AddWarehouseLocations(v ...*WarehouseLocation) *Wareh
 	return _u.AddWarehouseLocationIDs(ids...)
 }
 
+// AddOutgoingTransferIDs adds the "outgoing_transfers" edge to the Order entity by IDs.
+func (_u *WarehouseUpdate) AddOutgoingTransferIDs(ids ...int) *WarehouseUpdate {
+	_u.mutation.AddOutgoingTransferIDs(ids...)
+	return _u
+}
+
+// AddOutgoingTransfers adds the "outgoing_transfers" edges to the Order entity.
+func (_u *WarehouseUpdate) AddOutgoingTransfers(v ...*Order) *WarehouseUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOutgoingTransferIDs(ids...)
+}
+
+// AddIncomingTransferIDs adds the "incoming_transfers" edge to the Order entity by IDs.
+func (_u *WarehouseUpdate) AddIncomingTransferIDs(ids ...int) *WarehouseUpdate {
+	_u.mutation.AddIncomingTransferIDs(ids...)
+	return _u
+}
+
+// AddIncomingTransfers adds the "incoming_transfers" edges to the Order entity.
+func (_u *WarehouseUpdate) AddIncomingTransfers(v ...*Order) *WarehouseUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddIncomingTransferIDs(ids...)
+}
+
 // Mutation returns the WarehouseMutation object of the builder.
 func (_u *WarehouseUpdate) Mutation() *WarehouseMutation {
 	return _u.mutation
@@ -103,6 +134,48 @@ func (_u *WarehouseUpdate) RemoveWarehouseLocations(v ...*WarehouseLocation) *Wa
 	return _u.RemoveWarehouseLocationIDs(ids...)
 }
 
+// ClearOutgoingTransfers clears all "outgoing_transfers" edges to the Order entity.
+func (_u *WarehouseUpdate) ClearOutgoingTransfers() *WarehouseUpdate {
+	_u.mutation.ClearOutgoingTransfers()
+	return _u
+}
+
+// RemoveOutgoingTransferIDs removes the "outgoing_transfers" edge to Order entities by IDs.
+func (_u *WarehouseUpdate) RemoveOutgoingTransferIDs(ids ...int) *WarehouseUpdate {
+	_u.mutation.RemoveOutgoingTransferIDs(ids...)
+	return _u
+}
+
+// RemoveOutgoingTransfers removes "outgoing_transfers" edges to Order entities.
+func (_u *WarehouseUpdate) RemoveOutgoingTransfers(v ...*Order) *WarehouseUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOutgoingTransferIDs(ids...)
+}
+
+// ClearIncomingTransfers clears all "incoming_transfers" edges to the Order entity.
+func (_u *WarehouseUpdate) ClearIncomingTransfers() *WarehouseUpdate {
+	_u.mutation.ClearIncomingTransfers()
+	return _u
+}
+
+// RemoveIncomingTransferIDs removes the "incoming_transfers" edge to Order entities by IDs.
+func (_u *WarehouseUpdate) RemoveIncomingTransferIDs(ids ...int) *WarehouseUpdate {
+	_u.mutation.RemoveIncomingTransferIDs(ids...)
+	return _u
+}
+
+// RemoveIncomingTransfers removes "incoming_transfers" edges to Order entities.
+func (_u *WarehouseUpdate) RemoveIncomingTransfers(v ...*Order) *WarehouseUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveIncomingTransferIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *WarehouseUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -206,6 +279,96 @@ func (_u *WarehouseUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.OutgoingTransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.OutgoingTransfersTable,
+			Columns: []string{warehouse.OutgoingTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOutgoingTransfersIDs(); len(nodes) > 0 && !_u.mutation.OutgoingTransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.OutgoingTransfersTable,
+			Columns: []string{warehouse.OutgoingTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OutgoingTransfersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.OutgoingTransfersTable,
+			Columns: []string{warehouse.OutgoingTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.IncomingTransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.IncomingTransfersTable,
+			Columns: []string{warehouse.IncomingTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedIncomingTransfersIDs(); len(nodes) > 0 && !_u.mutation.IncomingTransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.IncomingTransfersTable,
+			Columns: []string{warehouse.IncomingTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.IncomingTransfersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.IncomingTransfersTable,
+			Columns: []string{warehouse.IncomingTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{warehouse.Label}
@@ -275,6 +438,36 @@ func (_u *WarehouseUpdateOne) AddWarehouseLocations(v ...*WarehouseLocation) *Wa
 	return _u.AddWarehouseLocationIDs(ids...)
 }
 
+// AddOutgoingTransferIDs adds the "outgoing_transfers" edge to the Order entity by IDs.
+func (_u *WarehouseUpdateOne) AddOutgoingTransferIDs(ids ...int) *WarehouseUpdateOne {
+	_u.mutation.AddOutgoingTransferIDs(ids...)
+	return _u
+}
+
+// AddOutgoingTransfers adds the "outgoing_transfers" edges to the Order entity.
+func (_u *WarehouseUpdateOne) AddOutgoingTransfers(v ...*Order) *WarehouseUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOutgoingTransferIDs(ids...)
+}
+
+// AddIncomingTransferIDs adds the "incoming_transfers" edge to the Order entity by IDs.
+func (_u *WarehouseUpdateOne) AddIncomingTransferIDs(ids ...int) *WarehouseUpdateOne {
+	_u.mutation.AddIncomingTransferIDs(ids...)
+	return _u
+}
+
+// AddIncomingTransfers adds the "incoming_transfers" edges to the Order entity.
+func (_u *WarehouseUpdateOne) AddIncomingTransfers(v ...*Order) *WarehouseUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddIncomingTransferIDs(ids...)
+}
+
 // Mutation returns the WarehouseMutation object of the builder.
 func (_u *WarehouseUpdateOne) Mutation() *WarehouseMutation {
 	return _u.mutation
@@ -301,6 +494,48 @@ func (_u *WarehouseUpdateOne) RemoveWarehouseLocations(v ...*WarehouseLocation)
 	return _u.RemoveWarehouseLocationIDs(ids...)
 }
 
+// ClearOutgoingTransfers clears all "outgoing_transfers" edges to the Order entity.
+func (_u *WarehouseUpdateOne) ClearOutgoingTransfers() *WarehouseUpdateOne {
+	_u.mutation.ClearOutgoingTransfers()
+	return _u
+}
+
+// RemoveOutgoingTransferIDs removes the "outgoing_transfers" edge to Order entities by IDs.
+func (_u *WarehouseUpdateOne) RemoveOutgoingTransferIDs(ids ...int) *WarehouseUpdateOne {
+	_u.mutation.RemoveOutgoingTransferIDs(ids...)
+	return _u
+}
+
+// RemoveOutgoingTransfers removes "outgoing_transfers" edges to Order entities.
+func (_u *WarehouseUpdateOne) RemoveOutgoingTransfers(v ...*Order) *WarehouseUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOutgoingTransferIDs(ids...)
+}
+
+// ClearIncomingTransfers clears all "incoming_transfers" edges to the Order entity.
+func (_u *WarehouseUpdateOne) ClearIncomingTransfers() *WarehouseUpdateOne {
+	_u.mutation.ClearIncomingTransfers()
+	return _u
+}
+
+// RemoveIncomingTransferIDs removes the "incoming_transfers" edge to Order entities by IDs.
+func (_u *WarehouseUpdateOne) RemoveIncomingTransferIDs(ids ...int) *WarehouseUpdateOne {
+	_u.mutation.RemoveIncomingTransferIDs(ids...)
+	return _u
+}
+
+// RemoveIncomingTransfers removes "incoming_transfers" edges to Order entities.
+func (_u *WarehouseUpdateOne) RemoveIncomingTransfers(v ...*Order) *WarehouseUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveIncomingTransferIDs(ids...)
+}
+
 // Where appends a list predicates to the WarehouseUpdate builder.
 func (_u *WarehouseUpdateOne) Where(ps ...predicate.Warehouse) *WarehouseUpdateOne {
 	_u.mutation.Where(ps...)
@@ -434,6 +669,96 @@ func (_u *WarehouseUpdateOne) sqlSave(ctx context.Context) (_node *Warehouse, er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.OutgoingTransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.OutgoingTransfersTable,
+			Columns: []string{warehouse.OutgoingTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOutgoingTransfersIDs(); len(nodes) > 0 && !_u.mutation.OutgoingTransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.OutgoingTransfersTable,
+			Columns: []string{warehouse.OutgoingTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OutgoingTransfersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.OutgoingTransfersTable,
+			Columns: []string{warehouse.OutgoingTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.IncomingTransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.IncomingTransfersTable,
+			Columns: []string{warehouse.IncomingTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedIncomingTransfersIDs(); len(nodes) > 0 && !_u.mutation.IncomingTransfersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.IncomingTransfersTable,
+			Columns: []string{warehouse.IncomingTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.IncomingTransfersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   warehouse.IncomingTransfersTable,
+			Columns: []string{warehouse.IncomingTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Warehouse{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
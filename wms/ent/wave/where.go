@@ -0,0 +1,672 @@
+// Code generated by ent, DO NOT EDIT.
+
+package wave
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/mxV03/wms/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Wave {
+	return predicate.Wave(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Wave {
+	return predicate.Wave(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Wave {
+	return predicate.Wave(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Wave {
+	return predicate.Wave(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Wave {
+	return predicate.Wave(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Wave {
+	return predicate.Wave(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Wave {
+	return predicate.Wave(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Wave {
+	return predicate.Wave(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Wave {
+	return predicate.Wave(sql.FieldLTE(FieldID, id))
+}
+
+// Number applies equality check predicate on the "number" field. It's identical to NumberEQ.
+func Number(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldEQ(FieldNumber, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldEQ(FieldStatus, v))
+}
+
+// Carrier applies equality check predicate on the "carrier" field. It's identical to CarrierEQ.
+func Carrier(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldEQ(FieldCarrier, v))
+}
+
+// Zone applies equality check predicate on the "zone" field. It's identical to ZoneEQ.
+func Zone(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldEQ(FieldZone, v))
+}
+
+// DueBy applies equality check predicate on the "due_by" field. It's identical to DueByEQ.
+func DueBy(v time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldEQ(FieldDueBy, v))
+}
+
+// MaxLines applies equality check predicate on the "max_lines" field. It's identical to MaxLinesEQ.
+func MaxLines(v int) predicate.Wave {
+	return predicate.Wave(sql.FieldEQ(FieldMaxLines, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// DoneAt applies equality check predicate on the "done_at" field. It's identical to DoneAtEQ.
+func DoneAt(v time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldEQ(FieldDoneAt, v))
+}
+
+// NumberEQ applies the EQ predicate on the "number" field.
+func NumberEQ(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldEQ(FieldNumber, v))
+}
+
+// NumberNEQ applies the NEQ predicate on the "number" field.
+func NumberNEQ(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldNEQ(FieldNumber, v))
+}
+
+// NumberIn applies the In predicate on the "number" field.
+func NumberIn(vs ...string) predicate.Wave {
+	return predicate.Wave(sql.FieldIn(FieldNumber, vs...))
+}
+
+// NumberNotIn applies the NotIn predicate on the "number" field.
+func NumberNotIn(vs ...string) predicate.Wave {
+	return predicate.Wave(sql.FieldNotIn(FieldNumber, vs...))
+}
+
+// NumberGT applies the GT predicate on the "number" field.
+func NumberGT(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldGT(FieldNumber, v))
+}
+
+// NumberGTE applies the GTE predicate on the "number" field.
+func NumberGTE(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldGTE(FieldNumber, v))
+}
+
+// NumberLT applies the LT predicate on the "number" field.
+func NumberLT(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldLT(FieldNumber, v))
+}
+
+// NumberLTE applies the LTE predicate on the "number" field.
+func NumberLTE(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldLTE(FieldNumber, v))
+}
+
+// NumberContains applies the Contains predicate on the "number" field.
+func NumberContains(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldContains(FieldNumber, v))
+}
+
+// NumberHasPrefix applies the HasPrefix predicate on the "number" field.
+func NumberHasPrefix(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldHasPrefix(FieldNumber, v))
+}
+
+// NumberHasSuffix applies the HasSuffix predicate on the "number" field.
+func NumberHasSuffix(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldHasSuffix(FieldNumber, v))
+}
+
+// NumberEqualFold applies the EqualFold predicate on the "number" field.
+func NumberEqualFold(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldEqualFold(FieldNumber, v))
+}
+
+// NumberContainsFold applies the ContainsFold predicate on the "number" field.
+func NumberContainsFold(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldContainsFold(FieldNumber, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.Wave {
+	return predicate.Wave(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.Wave {
+	return predicate.Wave(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CarrierEQ applies the EQ predicate on the "carrier" field.
+func CarrierEQ(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldEQ(FieldCarrier, v))
+}
+
+// CarrierNEQ applies the NEQ predicate on the "carrier" field.
+func CarrierNEQ(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldNEQ(FieldCarrier, v))
+}
+
+// CarrierIn applies the In predicate on the "carrier" field.
+func CarrierIn(vs ...string) predicate.Wave {
+	return predicate.Wave(sql.FieldIn(FieldCarrier, vs...))
+}
+
+// CarrierNotIn applies the NotIn predicate on the "carrier" field.
+func CarrierNotIn(vs ...string) predicate.Wave {
+	return predicate.Wave(sql.FieldNotIn(FieldCarrier, vs...))
+}
+
+// CarrierGT applies the GT predicate on the "carrier" field.
+func CarrierGT(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldGT(FieldCarrier, v))
+}
+
+// CarrierGTE applies the GTE predicate on the "carrier" field.
+func CarrierGTE(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldGTE(FieldCarrier, v))
+}
+
+// CarrierLT applies the LT predicate on the "carrier" field.
+func CarrierLT(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldLT(FieldCarrier, v))
+}
+
+// CarrierLTE applies the LTE predicate on the "carrier" field.
+func CarrierLTE(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldLTE(FieldCarrier, v))
+}
+
+// CarrierContains applies the Contains predicate on the "carrier" field.
+func CarrierContains(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldContains(FieldCarrier, v))
+}
+
+// CarrierHasPrefix applies the HasPrefix predicate on the "carrier" field.
+func CarrierHasPrefix(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldHasPrefix(FieldCarrier, v))
+}
+
+// CarrierHasSuffix applies the HasSuffix predicate on the "carrier" field.
+func CarrierHasSuffix(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldHasSuffix(FieldCarrier, v))
+}
+
+// CarrierIsNil applies the IsNil predicate on the "carrier" field.
+func CarrierIsNil() predicate.Wave {
+	return predicate.Wave(sql.FieldIsNull(FieldCarrier))
+}
+
+// CarrierNotNil applies the NotNil predicate on the "carrier" field.
+func CarrierNotNil() predicate.Wave {
+	return predicate.Wave(sql.FieldNotNull(FieldCarrier))
+}
+
+// CarrierEqualFold applies the EqualFold predicate on the "carrier" field.
+func CarrierEqualFold(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldEqualFold(FieldCarrier, v))
+}
+
+// CarrierContainsFold applies the ContainsFold predicate on the "carrier" field.
+func CarrierContainsFold(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldContainsFold(FieldCarrier, v))
+}
+
+// ZoneEQ applies the EQ predicate on the "zone" field.
+func ZoneEQ(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldEQ(FieldZone, v))
+}
+
+// ZoneNEQ applies the NEQ predicate on the "zone" field.
+func ZoneNEQ(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldNEQ(FieldZone, v))
+}
+
+// ZoneIn applies the In predicate on the "zone" field.
+func ZoneIn(vs ...string) predicate.Wave {
+	return predicate.Wave(sql.FieldIn(FieldZone, vs...))
+}
+
+// ZoneNotIn applies the NotIn predicate on the "zone" field.
+func ZoneNotIn(vs ...string) predicate.Wave {
+	return predicate.Wave(sql.FieldNotIn(FieldZone, vs...))
+}
+
+// ZoneGT applies the GT predicate on the "zone" field.
+func ZoneGT(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldGT(FieldZone, v))
+}
+
+// ZoneGTE applies the GTE predicate on the "zone" field.
+func ZoneGTE(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldGTE(FieldZone, v))
+}
+
+// ZoneLT applies the LT predicate on the "zone" field.
+func ZoneLT(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldLT(FieldZone, v))
+}
+
+// ZoneLTE applies the LTE predicate on the "zone" field.
+func ZoneLTE(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldLTE(FieldZone, v))
+}
+
+// ZoneContains applies the Contains predicate on the "zone" field.
+func ZoneContains(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldContains(FieldZone, v))
+}
+
+// ZoneHasPrefix applies the HasPrefix predicate on the "zone" field.
+func ZoneHasPrefix(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldHasPrefix(FieldZone, v))
+}
+
+// ZoneHasSuffix applies the HasSuffix predicate on the "zone" field.
+func ZoneHasSuffix(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldHasSuffix(FieldZone, v))
+}
+
+// ZoneIsNil applies the IsNil predicate on the "zone" field.
+func ZoneIsNil() predicate.Wave {
+	return predicate.Wave(sql.FieldIsNull(FieldZone))
+}
+
+// ZoneNotNil applies the NotNil predicate on the "zone" field.
+func ZoneNotNil() predicate.Wave {
+	return predicate.Wave(sql.FieldNotNull(FieldZone))
+}
+
+// ZoneEqualFold applies the EqualFold predicate on the "zone" field.
+func ZoneEqualFold(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldEqualFold(FieldZone, v))
+}
+
+// ZoneContainsFold applies the ContainsFold predicate on the "zone" field.
+func ZoneContainsFold(v string) predicate.Wave {
+	return predicate.Wave(sql.FieldContainsFold(FieldZone, v))
+}
+
+// DueByEQ applies the EQ predicate on the "due_by" field.
+func DueByEQ(v time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldEQ(FieldDueBy, v))
+}
+
+// DueByNEQ applies the NEQ predicate on the "due_by" field.
+func DueByNEQ(v time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldNEQ(FieldDueBy, v))
+}
+
+// DueByIn applies the In predicate on the "due_by" field.
+func DueByIn(vs ...time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldIn(FieldDueBy, vs...))
+}
+
+// DueByNotIn applies the NotIn predicate on the "due_by" field.
+func DueByNotIn(vs ...time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldNotIn(FieldDueBy, vs...))
+}
+
+// DueByGT applies the GT predicate on the "due_by" field.
+func DueByGT(v time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldGT(FieldDueBy, v))
+}
+
+// DueByGTE applies the GTE predicate on the "due_by" field.
+func DueByGTE(v time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldGTE(FieldDueBy, v))
+}
+
+// DueByLT applies the LT predicate on the "due_by" field.
+func DueByLT(v time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldLT(FieldDueBy, v))
+}
+
+// DueByLTE applies the LTE predicate on the "due_by" field.
+func DueByLTE(v time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldLTE(FieldDueBy, v))
+}
+
+// DueByIsNil applies the IsNil predicate on the "due_by" field.
+func DueByIsNil() predicate.Wave {
+	return predicate.Wave(sql.FieldIsNull(FieldDueBy))
+}
+
+// DueByNotNil applies the NotNil predicate on the "due_by" field.
+func DueByNotNil() predicate.Wave {
+	return predicate.Wave(sql.FieldNotNull(FieldDueBy))
+}
+
+// MaxLinesEQ applies the EQ predicate on the "max_lines" field.
+func MaxLinesEQ(v int) predicate.Wave {
+	return predicate.Wave(sql.FieldEQ(FieldMaxLines, v))
+}
+
+// MaxLinesNEQ applies the NEQ predicate on the "max_lines" field.
+func MaxLinesNEQ(v int) predicate.Wave {
+	return predicate.Wave(sql.FieldNEQ(FieldMaxLines, v))
+}
+
+// MaxLinesIn applies the In predicate on the "max_lines" field.
+func MaxLinesIn(vs ...int) predicate.Wave {
+	return predicate.Wave(sql.FieldIn(FieldMaxLines, vs...))
+}
+
+// MaxLinesNotIn applies the NotIn predicate on the "max_lines" field.
+func MaxLinesNotIn(vs ...int) predicate.Wave {
+	return predicate.Wave(sql.FieldNotIn(FieldMaxLines, vs...))
+}
+
+// MaxLinesGT applies the GT predicate on the "max_lines" field.
+func MaxLinesGT(v int) predicate.Wave {
+	return predicate.Wave(sql.FieldGT(FieldMaxLines, v))
+}
+
+// MaxLinesGTE applies the GTE predicate on the "max_lines" field.
+func MaxLinesGTE(v int) predicate.Wave {
+	return predicate.Wave(sql.FieldGTE(FieldMaxLines, v))
+}
+
+// MaxLinesLT applies the LT predicate on the "max_lines" field.
+func MaxLinesLT(v int) predicate.Wave {
+	return predicate.Wave(sql.FieldLT(FieldMaxLines, v))
+}
+
+// MaxLinesLTE applies the LTE predicate on the "max_lines" field.
+func MaxLinesLTE(v int) predicate.Wave {
+	return predicate.Wave(sql.FieldLTE(FieldMaxLines, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// StartedAtIsNil applies the IsNil predicate on the "started_at" field.
+func StartedAtIsNil() predicate.Wave {
+	return predicate.Wave(sql.FieldIsNull(FieldStartedAt))
+}
+
+// StartedAtNotNil applies the NotNil predicate on the "started_at" field.
+func StartedAtNotNil() predicate.Wave {
+	return predicate.Wave(sql.FieldNotNull(FieldStartedAt))
+}
+
+// DoneAtEQ applies the EQ predicate on the "done_at" field.
+func DoneAtEQ(v time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldEQ(FieldDoneAt, v))
+}
+
+// DoneAtNEQ applies the NEQ predicate on the "done_at" field.
+func DoneAtNEQ(v time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldNEQ(FieldDoneAt, v))
+}
+
+// DoneAtIn applies the In predicate on the "done_at" field.
+func DoneAtIn(vs ...time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldIn(FieldDoneAt, vs...))
+}
+
+// DoneAtNotIn applies the NotIn predicate on the "done_at" field.
+func DoneAtNotIn(vs ...time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldNotIn(FieldDoneAt, vs...))
+}
+
+// DoneAtGT applies the GT predicate on the "done_at" field.
+func DoneAtGT(v time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldGT(FieldDoneAt, v))
+}
+
+// DoneAtGTE applies the GTE predicate on the "done_at" field.
+func DoneAtGTE(v time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldGTE(FieldDoneAt, v))
+}
+
+// DoneAtLT applies the LT predicate on the "done_at" field.
+func DoneAtLT(v time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldLT(FieldDoneAt, v))
+}
+
+// DoneAtLTE applies the LTE predicate on the "done_at" field.
+func DoneAtLTE(v time.Time) predicate.Wave {
+	return predicate.Wave(sql.FieldLTE(FieldDoneAt, v))
+}
+
+// DoneAtIsNil applies the IsNil predicate on the "done_at" field.
+func DoneAtIsNil() predicate.Wave {
+	return predicate.Wave(sql.FieldIsNull(FieldDoneAt))
+}
+
+// DoneAtNotNil applies the NotNil predicate on the "done_at" field.
+func DoneAtNotNil() predicate.Wave {
+	return predicate.Wave(sql.FieldNotNull(FieldDoneAt))
+}
+
+// HasOrders applies the HasEdge predicate on the "orders" edge.
+func HasOrders() predicate.Wave {
+	return predicate.Wave(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OrdersTable, OrdersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrdersWith applies the HasEdge predicate on the "orders" edge with a given conditions (other predicates).
+func HasOrdersWith(preds ...predicate.Order) predicate.Wave {
+	return predicate.Wave(func(s *sql.Selector) {
+		step := newOrdersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTasks applies the HasEdge predicate on the "tasks" edge.
+func HasTasks() predicate.Wave {
+	return predicate.Wave(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TasksTable, TasksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTasksWith applies the HasEdge predicate on the "tasks" edge with a given conditions (other predicates).
+func HasTasksWith(preds ...predicate.WaveTask) predicate.Wave {
+	return predicate.Wave(func(s *sql.Selector) {
+		step := newTasksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Wave) predicate.Wave {
+	return predicate.Wave(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Wave) predicate.Wave {
+	return predicate.Wave(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Wave) predicate.Wave {
+	return predicate.Wave(sql.NotPredicates(p))
+}
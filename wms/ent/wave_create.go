@@ -0,0 +1,437 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/order"
+	"github.com/mxV03/wms/ent/wave"
+	"github.com/mxV03/wms/ent/wavetask"
+)
+
+// WaveCreate is the builder for creating a Wave entity.
+type WaveCreate struct {
+	config
+	mutation *WaveMutation
+	hooks    []Hook
+}
+
+// SetNumber sets the "number" field.
+func (_c *WaveCreate) SetNumber(v string) *WaveCreate {
+	_c.mutation.SetNumber(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *WaveCreate) SetStatus(v string) *WaveCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *WaveCreate) SetNillableStatus(v *string) *WaveCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetCarrier sets the "carrier" field.
+func (_c *WaveCreate) SetCarrier(v string) *WaveCreate {
+	_c.mutation.SetCarrier(v)
+	return _c
+}
+
+// SetNillableCarrier sets the "carrier" field if the given value is not nil.
+func (_c *WaveCreate) SetNillableCarrier(v *string) *WaveCreate {
+	if v != nil {
+		_c.SetCarrier(*v)
+	}
+	return _c
+}
+
+// SetZone sets the "zone" field.
+func (_c *WaveCreate) SetZone(v string) *WaveCreate {
+	_c.mutation.SetZone(v)
+	return _c
+}
+
+// SetNillableZone sets the "zone" field if the given value is not nil.
+func (_c *WaveCreate) SetNillableZone(v *string) *WaveCreate {
+	if v != nil {
+		_c.SetZone(*v)
+	}
+	return _c
+}
+
+// SetDueBy sets the "due_by" field.
+func (_c *WaveCreate) SetDueBy(v time.Time) *WaveCreate {
+	_c.mutation.SetDueBy(v)
+	return _c
+}
+
+// SetNillableDueBy sets the "due_by" field if the given value is not nil.
+func (_c *WaveCreate) SetNillableDueBy(v *time.Time) *WaveCreate {
+	if v != nil {
+		_c.SetDueBy(*v)
+	}
+	return _c
+}
+
+// SetMaxLines sets the "max_lines" field.
+func (_c *WaveCreate) SetMaxLines(v int) *WaveCreate {
+	_c.mutation.SetMaxLines(v)
+	return _c
+}
+
+// SetNillableMaxLines sets the "max_lines" field if the given value is not nil.
+func (_c *WaveCreate) SetNillableMaxLines(v *int) *WaveCreate {
+	if v != nil {
+		_c.SetMaxLines(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WaveCreate) SetCreatedAt(v time.Time) *WaveCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WaveCreate) SetNillableCreatedAt(v *time.Time) *WaveCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *WaveCreate) SetStartedAt(v time.Time) *WaveCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *WaveCreate) SetNillableStartedAt(v *time.Time) *WaveCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetDoneAt sets the "done_at" field.
+func (_c *WaveCreate) SetDoneAt(v time.Time) *WaveCreate {
+	_c.mutation.SetDoneAt(v)
+	return _c
+}
+
+// SetNillableDoneAt sets the "done_at" field if the given value is not nil.
+func (_c *WaveCreate) SetNillableDoneAt(v *time.Time) *WaveCreate {
+	if v != nil {
+		_c.SetDoneAt(*v)
+	}
+	return _c
+}
+
+// AddOrderIDs adds the "orders" edge to the Order entity by IDs.
+func (_c *WaveCreate) AddOrderIDs(ids ...int) *WaveCreate {
+	_c.mutation.AddOrderIDs(ids...)
+	return _c
+}
+
+// AddOrders adds the "orders" edges to the Order entity.
+func (_c *WaveCreate) AddOrders(v ...*Order) *WaveCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddOrderIDs(ids...)
+}
+
+// AddTaskIDs adds the "tasks" edge to the WaveTask entity by IDs.
+func (_c *WaveCreate) AddTaskIDs(ids ...int) *WaveCreate {
+	_c.mutation.AddTaskIDs(ids...)
+	return _c
+}
+
+// AddTasks adds the "tasks" edges to the WaveTask entity.
+func (_c *WaveCreate) AddTasks(v ...*WaveTask) *WaveCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTaskIDs(ids...)
+}
+
+// Mutation returns the WaveMutation object of the builder.
+func (_c *WaveCreate) Mutation() *WaveMutation {
+	return _c.mutation
+}
+
+// Save creates the Wave in the database.
+func (_c *WaveCreate) Save(ctx context.Context) (*Wave, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WaveCreate) SaveX(ctx context.Context) *Wave {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WaveCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WaveCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WaveCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := wave.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Carrier(); !ok {
+		v := wave.DefaultCarrier
+		_c.mutation.SetCarrier(v)
+	}
+	if _, ok := _c.mutation.Zone(); !ok {
+		v := wave.DefaultZone
+		_c.mutation.SetZone(v)
+	}
+	if _, ok := _c.mutation.MaxLines(); !ok {
+		v := wave.DefaultMaxLines
+		_c.mutation.SetMaxLines(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := wave.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WaveCreate) check() error {
+	if _, ok := _c.mutation.Number(); !ok {
+		return &ValidationError{Name: "number", err: errors.New(`ent: missing required field "Wave.number"`)}
+	}
+	if v, ok := _c.mutation.Number(); ok {
+		if err := wave.NumberValidator(v); err != nil {
+			return &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "Wave.number": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Wave.status"`)}
+	}
+	if _, ok := _c.mutation.MaxLines(); !ok {
+		return &ValidationError{Name: "max_lines", err: errors.New(`ent: missing required field "Wave.max_lines"`)}
+	}
+	if v, ok := _c.mutation.MaxLines(); ok {
+		if err := wave.MaxLinesValidator(v); err != nil {
+			return &ValidationError{Name: "max_lines", err: fmt.Errorf(`ent: validator failed for field "Wave.max_lines": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Wave.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *WaveCreate) sqlSave(ctx context.Context) (*Wave, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WaveCreate) createSpec() (*Wave, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Wave{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(wave.Table, sqlgraph.NewFieldSpec(wave.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Number(); ok {
+		_spec.SetField(wave.FieldNumber, field.TypeString, value)
+		_node.Number = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(wave.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Carrier(); ok {
+		_spec.SetField(wave.FieldCarrier, field.TypeString, value)
+		_node.Carrier = value
+	}
+	if value, ok := _c.mutation.Zone(); ok {
+		_spec.SetField(wave.FieldZone, field.TypeString, value)
+		_node.Zone = value
+	}
+	if value, ok := _c.mutation.DueBy(); ok {
+		_spec.SetField(wave.FieldDueBy, field.TypeTime, value)
+		_node.DueBy = &value
+	}
+	if value, ok := _c.mutation.MaxLines(); ok {
+		_spec.SetField(wave.FieldMaxLines, field.TypeInt, value)
+		_node.MaxLines = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(wave.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(wave.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := _c.mutation.DoneAt(); ok {
+		_spec.SetField(wave.FieldDoneAt, field.TypeTime, value)
+		_node.DoneAt = &value
+	}
+	if nodes := _c.mutation.OrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wave.OrdersTable,
+			Columns: []string{wave.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wave.TasksTable,
+			Columns: []string{wave.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wavetask.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WaveCreateBulk is the builder for creating many Wave entities in bulk.
+type WaveCreateBulk struct {
+	config
+	err      error
+	builders []*WaveCreate
+}
+
+// Save creates the Wave entities in the database.
+func (_c *WaveCreateBulk) Save(ctx context.Context) ([]*Wave, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Wave, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WaveMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WaveCreateBulk) SaveX(ctx context.Context) []*Wave {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WaveCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WaveCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
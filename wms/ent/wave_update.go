@@ -0,0 +1,954 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/order"
+	"github.com/mxV03/wms/ent/predicate"
+	"github.com/mxV03/wms/ent/wave"
+	"github.com/mxV03/wms/ent/wavetask"
+)
+
+// WaveUpdate is the builder for updating Wave entities.
+type WaveUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WaveMutation
+}
+
+// Where appends a list predicates to the WaveUpdate builder.
+func (_u *WaveUpdate) Where(ps ...predicate.Wave) *WaveUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetNumber sets the "number" field.
+func (_u *WaveUpdate) SetNumber(v string) *WaveUpdate {
+	_u.mutation.SetNumber(v)
+	return _u
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (_u *WaveUpdate) SetNillableNumber(v *string) *WaveUpdate {
+	if v != nil {
+		_u.SetNumber(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *WaveUpdate) SetStatus(v string) *WaveUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *WaveUpdate) SetNillableStatus(v *string) *WaveUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetCarrier sets the "carrier" field.
+func (_u *WaveUpdate) SetCarrier(v string) *WaveUpdate {
+	_u.mutation.SetCarrier(v)
+	return _u
+}
+
+// SetNillableCarrier sets the "carrier" field if the given value is not nil.
+func (_u *WaveUpdate) SetNillableCarrier(v *string) *WaveUpdate {
+	if v != nil {
+		_u.SetCarrier(*v)
+	}
+	return _u
+}
+
+// ClearCarrier clears the value of the "carrier" field.
+func (_u *WaveUpdate) ClearCarrier() *WaveUpdate {
+	_u.mutation.ClearCarrier()
+	return _u
+}
+
+// SetZone sets the "zone" field.
+func (_u *WaveUpdate) SetZone(v string) *WaveUpdate {
+	_u.mutation.SetZone(v)
+	return _u
+}
+
+// SetNillableZone sets the "zone" field if the given value is not nil.
+func (_u *WaveUpdate) SetNillableZone(v *string) *WaveUpdate {
+	if v != nil {
+		_u.SetZone(*v)
+	}
+	return _u
+}
+
+// ClearZone clears the value of the "zone" field.
+func (_u *WaveUpdate) ClearZone() *WaveUpdate {
+	_u.mutation.ClearZone()
+	return _u
+}
+
+// SetDueBy sets the "due_by" field.
+func (_u *WaveUpdate) SetDueBy(v time.Time) *WaveUpdate {
+	_u.mutation.SetDueBy(v)
+	return _u
+}
+
+// SetNillableDueBy sets the "due_by" field if the given value is not nil.
+func (_u *WaveUpdate) SetNillableDueBy(v *time.Time) *WaveUpdate {
+	if v != nil {
+		_u.SetDueBy(*v)
+	}
+	return _u
+}
+
+// ClearDueBy clears the value of the "due_by" field.
+func (_u *WaveUpdate) ClearDueBy() *WaveUpdate {
+	_u.mutation.ClearDueBy()
+	return _u
+}
+
+// SetMaxLines sets the "max_lines" field.
+func (_u *WaveUpdate) SetMaxLines(v int) *WaveUpdate {
+	_u.mutation.ResetMaxLines()
+	_u.mutation.SetMaxLines(v)
+	return _u
+}
+
+// SetNillableMaxLines sets the "max_lines" field if the given value is not nil.
+func (_u *WaveUpdate) SetNillableMaxLines(v *int) *WaveUpdate {
+	if v != nil {
+		_u.SetMaxLines(*v)
+	}
+	return _u
+}
+
+// AddMaxLines adds value to the "max_lines" field.
+func (_u *WaveUpdate) AddMaxLines(v int) *WaveUpdate {
+	_u.mutation.AddMaxLines(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *WaveUpdate) SetCreatedAt(v time.Time) *WaveUpdate {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *WaveUpdate) SetNillableCreatedAt(v *time.Time) *WaveUpdate {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *WaveUpdate) SetStartedAt(v time.Time) *WaveUpdate {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *WaveUpdate) SetNillableStartedAt(v *time.Time) *WaveUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *WaveUpdate) ClearStartedAt() *WaveUpdate {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetDoneAt sets the "done_at" field.
+func (_u *WaveUpdate) SetDoneAt(v time.Time) *WaveUpdate {
+	_u.mutation.SetDoneAt(v)
+	return _u
+}
+
+// SetNillableDoneAt sets the "done_at" field if the given value is not nil.
+func (_u *WaveUpdate) SetNillableDoneAt(v *time.Time) *WaveUpdate {
+	if v != nil {
+		_u.SetDoneAt(*v)
+	}
+	return _u
+}
+
+// ClearDoneAt clears the value of the "done_at" field.
+func (_u *WaveUpdate) ClearDoneAt() *WaveUpdate {
+	_u.mutation.ClearDoneAt()
+	return _u
+}
+
+// AddOrderIDs adds the "orders" edge to the Order entity by IDs.
+func (_u *WaveUpdate) AddOrderIDs(ids ...int) *WaveUpdate {
+	_u.mutation.AddOrderIDs(ids...)
+	return _u
+}
+
+// AddOrders adds the "orders" edges to the Order entity.
+func (_u *WaveUpdate) AddOrders(v ...*Order) *WaveUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOrderIDs(ids...)
+}
+
+// AddTaskIDs adds the "tasks" edge to the WaveTask entity by IDs.
+func (_u *WaveUpdate) AddTaskIDs(ids ...int) *WaveUpdate {
+	_u.mutation.AddTaskIDs(ids...)
+	return _u
+}
+
+// AddTasks adds the "tasks" edges to the WaveTask entity.
+func (_u *WaveUpdate) AddTasks(v ...*WaveTask) *WaveUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTaskIDs(ids...)
+}
+
+// Mutation returns the WaveMutation object of the builder.
+func (_u *WaveUpdate) Mutation() *WaveMutation {
+	return _u.mutation
+}
+
+// ClearOrders clears all "orders" edges to the Order entity.
+func (_u *WaveUpdate) ClearOrders() *WaveUpdate {
+	_u.mutation.ClearOrders()
+	return _u
+}
+
+// RemoveOrderIDs removes the "orders" edge to Order entities by IDs.
+func (_u *WaveUpdate) RemoveOrderIDs(ids ...int) *WaveUpdate {
+	_u.mutation.RemoveOrderIDs(ids...)
+	return _u
+}
+
+// RemoveOrders removes "orders" edges to Order entities.
+func (_u *WaveUpdate) RemoveOrders(v ...*Order) *WaveUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOrderIDs(ids...)
+}
+
+// ClearTasks clears all "tasks" edges to the WaveTask entity.
+func (_u *WaveUpdate) ClearTasks() *WaveUpdate {
+	_u.mutation.ClearTasks()
+	return _u
+}
+
+// RemoveTaskIDs removes the "tasks" edge to WaveTask entities by IDs.
+func (_u *WaveUpdate) RemoveTaskIDs(ids ...int) *WaveUpdate {
+	_u.mutation.RemoveTaskIDs(ids...)
+	return _u
+}
+
+// RemoveTasks removes "tasks" edges to WaveTask entities.
+func (_u *WaveUpdate) RemoveTasks(v ...*WaveTask) *WaveUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTaskIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WaveUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WaveUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WaveUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WaveUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WaveUpdate) check() error {
+	if v, ok := _u.mutation.Number(); ok {
+		if err := wave.NumberValidator(v); err != nil {
+			return &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "Wave.number": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxLines(); ok {
+		if err := wave.MaxLinesValidator(v); err != nil {
+			return &ValidationError{Name: "max_lines", err: fmt.Errorf(`ent: validator failed for field "Wave.max_lines": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *WaveUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(wave.Table, wave.Columns, sqlgraph.NewFieldSpec(wave.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Number(); ok {
+		_spec.SetField(wave.FieldNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(wave.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Carrier(); ok {
+		_spec.SetField(wave.FieldCarrier, field.TypeString, value)
+	}
+	if _u.mutation.CarrierCleared() {
+		_spec.ClearField(wave.FieldCarrier, field.TypeString)
+	}
+	if value, ok := _u.mutation.Zone(); ok {
+		_spec.SetField(wave.FieldZone, field.TypeString, value)
+	}
+	if _u.mutation.ZoneCleared() {
+		_spec.ClearField(wave.FieldZone, field.TypeString)
+	}
+	if value, ok := _u.mutation.DueBy(); ok {
+		_spec.SetField(wave.FieldDueBy, field.TypeTime, value)
+	}
+	if _u.mutation.DueByCleared() {
+		_spec.ClearField(wave.FieldDueBy, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MaxLines(); ok {
+		_spec.SetField(wave.FieldMaxLines, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxLines(); ok {
+		_spec.AddField(wave.FieldMaxLines, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(wave.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(wave.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(wave.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DoneAt(); ok {
+		_spec.SetField(wave.FieldDoneAt, field.TypeTime, value)
+	}
+	if _u.mutation.DoneAtCleared() {
+		_spec.ClearField(wave.FieldDoneAt, field.TypeTime)
+	}
+	if _u.mutation.OrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wave.OrdersTable,
+			Columns: []string{wave.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOrdersIDs(); len(nodes) > 0 && !_u.mutation.OrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wave.OrdersTable,
+			Columns: []string{wave.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wave.OrdersTable,
+			Columns: []string{wave.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wave.TasksTable,
+			Columns: []string{wave.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wavetask.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTasksIDs(); len(nodes) > 0 && !_u.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wave.TasksTable,
+			Columns: []string{wave.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wavetask.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wave.TasksTable,
+			Columns: []string{wave.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wavetask.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wave.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WaveUpdateOne is the builder for updating a single Wave entity.
+type WaveUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WaveMutation
+}
+
+// SetNumber sets the "number" field.
+func (_u *WaveUpdateOne) SetNumber(v string) *WaveUpdateOne {
+	_u.mutation.SetNumber(v)
+	return _u
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (_u *WaveUpdateOne) SetNillableNumber(v *string) *WaveUpdateOne {
+	if v != nil {
+		_u.SetNumber(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *WaveUpdateOne) SetStatus(v string) *WaveUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *WaveUpdateOne) SetNillableStatus(v *string) *WaveUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetCarrier sets the "carrier" field.
+func (_u *WaveUpdateOne) SetCarrier(v string) *WaveUpdateOne {
+	_u.mutation.SetCarrier(v)
+	return _u
+}
+
+// SetNillableCarrier sets the "carrier" field if the given value is not nil.
+func (_u *WaveUpdateOne) SetNillableCarrier(v *string) *WaveUpdateOne {
+	if v != nil {
+		_u.SetCarrier(*v)
+	}
+	return _u
+}
+
+// ClearCarrier clears the value of the "carrier" field.
+func (_u *WaveUpdateOne) ClearCarrier() *WaveUpdateOne {
+	_u.mutation.ClearCarrier()
+	return _u
+}
+
+// SetZone sets the "zone" field.
+func (_u *WaveUpdateOne) SetZone(v string) *WaveUpdateOne {
+	_u.mutation.SetZone(v)
+	return _u
+}
+
+// SetNillableZone sets the "zone" field if the given value is not nil.
+func (_u *WaveUpdateOne) SetNillableZone(v *string) *WaveUpdateOne {
+	if v != nil {
+		_u.SetZone(*v)
+	}
+	return _u
+}
+
+// ClearZone clears the value of the "zone" field.
+func (_u *WaveUpdateOne) ClearZone() *WaveUpdateOne {
+	_u.mutation.ClearZone()
+	return _u
+}
+
+// SetDueBy sets the "due_by" field.
+func (_u *WaveUpdateOne) SetDueBy(v time.Time) *WaveUpdateOne {
+	_u.mutation.SetDueBy(v)
+	return _u
+}
+
+// SetNillableDueBy sets the "due_by" field if the given value is not nil.
+func (_u *WaveUpdateOne) SetNillableDueBy(v *time.Time) *WaveUpdateOne {
+	if v != nil {
+		_u.SetDueBy(*v)
+	}
+	return _u
+}
+
+// ClearDueBy clears the value of the "due_by" field.
+func (_u *WaveUpdateOne) ClearDueBy() *WaveUpdateOne {
+	_u.mutation.ClearDueBy()
+	return _u
+}
+
+// SetMaxLines sets the "max_lines" field.
+func (_u *WaveUpdateOne) SetMaxLines(v int) *WaveUpdateOne {
+	_u.mutation.ResetMaxLines()
+	_u.mutation.SetMaxLines(v)
+	return _u
+}
+
+// SetNillableMaxLines sets the "max_lines" field if the given value is not nil.
+func (_u *WaveUpdateOne) SetNillableMaxLines(v *int) *WaveUpdateOne {
+	if v != nil {
+		_u.SetMaxLines(*v)
+	}
+	return _u
+}
+
+// AddMaxLines adds value to the "max_lines" field.
+func (_u *WaveUpdateOne) AddMaxLines(v int) *WaveUpdateOne {
+	_u.mutation.AddMaxLines(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *WaveUpdateOne) SetCreatedAt(v time.Time) *WaveUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *WaveUpdateOne) SetNillableCreatedAt(v *time.Time) *WaveUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *WaveUpdateOne) SetStartedAt(v time.Time) *WaveUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *WaveUpdateOne) SetNillableStartedAt(v *time.Time) *WaveUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *WaveUpdateOne) ClearStartedAt() *WaveUpdateOne {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetDoneAt sets the "done_at" field.
+func (_u *WaveUpdateOne) SetDoneAt(v time.Time) *WaveUpdateOne {
+	_u.mutation.SetDoneAt(v)
+	return _u
+}
+
+// SetNillableDoneAt sets the "done_at" field if the given value is not nil.
+func (_u *WaveUpdateOne) SetNillableDoneAt(v *time.Time) *WaveUpdateOne {
+	if v != nil {
+		_u.SetDoneAt(*v)
+	}
+	return _u
+}
+
+// ClearDoneAt clears the value of the "done_at" field.
+func (_u *WaveUpdateOne) ClearDoneAt() *WaveUpdateOne {
+	_u.mutation.ClearDoneAt()
+	return _u
+}
+
+// AddOrderIDs adds the "orders" edge to the Order entity by IDs.
+func (_u *WaveUpdateOne) AddOrderIDs(ids ...int) *WaveUpdateOne {
+	_u.mutation.AddOrderIDs(ids...)
+	return _u
+}
+
+// AddOrders adds the "orders" edges to the Order entity.
+func (_u *WaveUpdateOne) AddOrders(v ...*Order) *WaveUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOrderIDs(ids...)
+}
+
+// AddTaskIDs adds the "tasks" edge to the WaveTask entity by IDs.
+func (_u *WaveUpdateOne) AddTaskIDs(ids ...int) *WaveUpdateOne {
+	_u.mutation.AddTaskIDs(ids...)
+	return _u
+}
+
+// AddTasks adds the "tasks" edges to the WaveTask entity.
+func (_u *WaveUpdateOne) AddTasks(v ...*WaveTask) *WaveUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTaskIDs(ids...)
+}
+
+// Mutation returns the WaveMutation object of the builder.
+func (_u *WaveUpdateOne) Mutation() *WaveMutation {
+	return _u.mutation
+}
+
+// ClearOrders clears all "orders" edges to the Order entity.
+func (_u *WaveUpdateOne) ClearOrders() *WaveUpdateOne {
+	_u.mutation.ClearOrders()
+	return _u
+}
+
+// RemoveOrderIDs removes the "orders" edge to Order entities by IDs.
+func (_u *WaveUpdateOne) RemoveOrderIDs(ids ...int) *WaveUpdateOne {
+	_u.mutation.RemoveOrderIDs(ids...)
+	return _u
+}
+
+// RemoveOrders removes "orders" edges to Order entities.
+func (_u *WaveUpdateOne) RemoveOrders(v ...*Order) *WaveUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOrderIDs(ids...)
+}
+
+// ClearTasks clears all "tasks" edges to the WaveTask entity.
+func (_u *WaveUpdateOne) ClearTasks() *WaveUpdateOne {
+	_u.mutation.ClearTasks()
+	return _u
+}
+
+// RemoveTaskIDs removes the "tasks" edge to WaveTask entities by IDs.
+func (_u *WaveUpdateOne) RemoveTaskIDs(ids ...int) *WaveUpdateOne {
+	_u.mutation.RemoveTaskIDs(ids...)
+	return _u
+}
+
+// RemoveTasks removes "tasks" edges to WaveTask entities.
+func (_u *WaveUpdateOne) RemoveTasks(v ...*WaveTask) *WaveUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTaskIDs(ids...)
+}
+
+// Where appends a list predicates to the WaveUpdate builder.
+func (_u *WaveUpdateOne) Where(ps ...predicate.Wave) *WaveUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WaveUpdateOne) Select(field string, fields ...string) *WaveUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Wave entity.
+func (_u *WaveUpdateOne) Save(ctx context.Context) (*Wave, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WaveUpdateOne) SaveX(ctx context.Context) *Wave {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WaveUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WaveUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WaveUpdateOne) check() error {
+	if v, ok := _u.mutation.Number(); ok {
+		if err := wave.NumberValidator(v); err != nil {
+			return &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "Wave.number": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.MaxLines(); ok {
+		if err := wave.MaxLinesValidator(v); err != nil {
+			return &ValidationError{Name: "max_lines", err: fmt.Errorf(`ent: validator failed for field "Wave.max_lines": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *WaveUpdateOne) sqlSave(ctx context.Context) (_node *Wave, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(wave.Table, wave.Columns, sqlgraph.NewFieldSpec(wave.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Wave.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, wave.FieldID)
+		for _, f := range fields {
+			if !wave.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != wave.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Number(); ok {
+		_spec.SetField(wave.FieldNumber, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(wave.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Carrier(); ok {
+		_spec.SetField(wave.FieldCarrier, field.TypeString, value)
+	}
+	if _u.mutation.CarrierCleared() {
+		_spec.ClearField(wave.FieldCarrier, field.TypeString)
+	}
+	if value, ok := _u.mutation.Zone(); ok {
+		_spec.SetField(wave.FieldZone, field.TypeString, value)
+	}
+	if _u.mutation.ZoneCleared() {
+		_spec.ClearField(wave.FieldZone, field.TypeString)
+	}
+	if value, ok := _u.mutation.DueBy(); ok {
+		_spec.SetField(wave.FieldDueBy, field.TypeTime, value)
+	}
+	if _u.mutation.DueByCleared() {
+		_spec.ClearField(wave.FieldDueBy, field.TypeTime)
+	}
+	if value, ok := _u.mutation.MaxLines(); ok {
+		_spec.SetField(wave.FieldMaxLines, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxLines(); ok {
+		_spec.AddField(wave.FieldMaxLines, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(wave.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(wave.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(wave.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DoneAt(); ok {
+		_spec.SetField(wave.FieldDoneAt, field.TypeTime, value)
+	}
+	if _u.mutation.DoneAtCleared() {
+		_spec.ClearField(wave.FieldDoneAt, field.TypeTime)
+	}
+	if _u.mutation.OrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wave.OrdersTable,
+			Columns: []string{wave.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOrdersIDs(); len(nodes) > 0 && !_u.mutation.OrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wave.OrdersTable,
+			Columns: []string{wave.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wave.OrdersTable,
+			Columns: []string{wave.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wave.TasksTable,
+			Columns: []string{wave.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wavetask.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTasksIDs(); len(nodes) > 0 && !_u.mutation.TasksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wave.TasksTable,
+			Columns: []string{wave.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wavetask.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TasksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wave.TasksTable,
+			Columns: []string{wave.TasksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wavetask.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Wave{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wave.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,180 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mxV03/wms/ent/orderline"
+	"github.com/mxV03/wms/ent/wavepick"
+	"github.com/mxV03/wms/ent/wavetask"
+)
+
+// WavePick is the model entity for the WavePick schema.
+type WavePick struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity int `json:"quantity,omitempty"`
+	// Picked holds the value of the "picked" field.
+	Picked int `json:"picked,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WavePickQuery when eager-loading is set.
+	Edges                WavePickEdges `json:"edges"`
+	wave_pick_order_line *int
+	wave_task_picks      *int
+	selectValues         sql.SelectValues
+}
+
+// WavePickEdges holds the relations/edges for other nodes in the graph.
+type WavePickEdges struct {
+	// Task holds the value of the task edge.
+	Task *WaveTask `json:"task,omitempty"`
+	// OrderLine holds the value of the order_line edge.
+	OrderLine *OrderLine `json:"order_line,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TaskOrErr returns the Task value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WavePickEdges) TaskOrErr() (*WaveTask, error) {
+	if e.Task != nil {
+		return e.Task, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: wavetask.Label}
+	}
+	return nil, &NotLoadedError{edge: "task"}
+}
+
+// OrderLineOrErr returns the OrderLine value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WavePickEdges) OrderLineOrErr() (*OrderLine, error) {
+	if e.OrderLine != nil {
+		return e.OrderLine, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: orderline.Label}
+	}
+	return nil, &NotLoadedError{edge: "order_line"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WavePick) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case wavepick.FieldID, wavepick.FieldQuantity, wavepick.FieldPicked:
+			values[i] = new(sql.NullInt64)
+		case wavepick.ForeignKeys[0]: // wave_pick_order_line
+			values[i] = new(sql.NullInt64)
+		case wavepick.ForeignKeys[1]: // wave_task_picks
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WavePick fields.
+func (_m *WavePick) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case wavepick.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case wavepick.FieldQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				_m.Quantity = int(value.Int64)
+			}
+		case wavepick.FieldPicked:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field picked", values[i])
+			} else if value.Valid {
+				_m.Picked = int(value.Int64)
+			}
+		case wavepick.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field wave_pick_order_line", value)
+			} else if value.Valid {
+				_m.wave_pick_order_line = new(int)
+				*_m.wave_pick_order_line = int(value.Int64)
+			}
+		case wavepick.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field wave_task_picks", value)
+			} else if value.Valid {
+				_m.wave_task_picks = new(int)
+				*_m.wave_task_picks = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WavePick.
+// This includes values selected through modifiers, order, etc.
+func (_m *WavePick) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTask queries the "task" edge of the WavePick entity.
+func (_m *WavePick) QueryTask() *WaveTaskQuery {
+	return NewWavePickClient(_m.config).QueryTask(_m)
+}
+
+// QueryOrderLine queries the "order_line" edge of the WavePick entity.
+func (_m *WavePick) QueryOrderLine() *OrderLineQuery {
+	return NewWavePickClient(_m.config).QueryOrderLine(_m)
+}
+
+// Update returns a builder for updating this WavePick.
+// Note that you need to call WavePick.Unwrap() before calling this method if this WavePick
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *WavePick) Update() *WavePickUpdateOne {
+	return NewWavePickClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the WavePick entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *WavePick) Unwrap() *WavePick {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WavePick is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *WavePick) String() string {
+	var builder strings.Builder
+	builder.WriteString("WavePick(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("picked=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Picked))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WavePicks is a parsable slice of WavePick.
+type WavePicks []*WavePick
@@ -0,0 +1,123 @@
+// Code generated by ent, DO NOT EDIT.
+
+package wavepick
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the wavepick type in the database.
+	Label = "wave_pick"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldPicked holds the string denoting the picked field in the database.
+	FieldPicked = "picked"
+	// EdgeTask holds the string denoting the task edge name in mutations.
+	EdgeTask = "task"
+	// EdgeOrderLine holds the string denoting the order_line edge name in mutations.
+	EdgeOrderLine = "order_line"
+	// Table holds the table name of the wavepick in the database.
+	Table = "wave_picks"
+	// TaskTable is the table that holds the task relation/edge.
+	TaskTable = "wave_picks"
+	// TaskInverseTable is the table name for the WaveTask entity.
+	// It exists in this package in order to avoid circular dependency with the "wavetask" package.
+	TaskInverseTable = "wave_tasks"
+	// TaskColumn is the table column denoting the task relation/edge.
+	TaskColumn = "wave_task_picks"
+	// OrderLineTable is the table that holds the order_line relation/edge.
+	OrderLineTable = "wave_picks"
+	// OrderLineInverseTable is the table name for the OrderLine entity.
+	// It exists in this package in order to avoid circular dependency with the "orderline" package.
+	OrderLineInverseTable = "order_lines"
+	// OrderLineColumn is the table column denoting the order_line relation/edge.
+	OrderLineColumn = "wave_pick_order_line"
+)
+
+// Columns holds all SQL columns for wavepick fields.
+var Columns = []string{
+	FieldID,
+	FieldQuantity,
+	FieldPicked,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "wave_picks"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"wave_pick_order_line",
+	"wave_task_picks",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	QuantityValidator func(int) error
+	// DefaultPicked holds the default value on creation for the "picked" field.
+	DefaultPicked int
+	// PickedValidator is a validator for the "picked" field. It is called by the builders before save.
+	PickedValidator func(int) error
+)
+
+// OrderOption defines the ordering options for the WavePick queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByQuantity orders the results by the quantity field.
+func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
+}
+
+// ByPicked orders the results by the picked field.
+func ByPicked(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPicked, opts...).ToFunc()
+}
+
+// ByTaskField orders the results by task field.
+func ByTaskField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTaskStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByOrderLineField orders the results by order_line field.
+func ByOrderLineField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrderLineStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newTaskStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TaskInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, TaskTable, TaskColumn),
+	)
+}
+func newOrderLineStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrderLineInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, OrderLineTable, OrderLineColumn),
+	)
+}
@@ -0,0 +1,205 @@
+// Code generated by ent, DO NOT EDIT.
+
+package wavepick
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/mxV03/wms/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.WavePick {
+	return predicate.WavePick(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.WavePick {
+	return predicate.WavePick(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.WavePick {
+	return predicate.WavePick(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.WavePick {
+	return predicate.WavePick(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.WavePick {
+	return predicate.WavePick(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.WavePick {
+	return predicate.WavePick(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.WavePick {
+	return predicate.WavePick(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.WavePick {
+	return predicate.WavePick(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.WavePick {
+	return predicate.WavePick(sql.FieldLTE(FieldID, id))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v int) predicate.WavePick {
+	return predicate.WavePick(sql.FieldEQ(FieldQuantity, v))
+}
+
+// Picked applies equality check predicate on the "picked" field. It's identical to PickedEQ.
+func Picked(v int) predicate.WavePick {
+	return predicate.WavePick(sql.FieldEQ(FieldPicked, v))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v int) predicate.WavePick {
+	return predicate.WavePick(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v int) predicate.WavePick {
+	return predicate.WavePick(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...int) predicate.WavePick {
+	return predicate.WavePick(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...int) predicate.WavePick {
+	return predicate.WavePick(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v int) predicate.WavePick {
+	return predicate.WavePick(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v int) predicate.WavePick {
+	return predicate.WavePick(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v int) predicate.WavePick {
+	return predicate.WavePick(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v int) predicate.WavePick {
+	return predicate.WavePick(sql.FieldLTE(FieldQuantity, v))
+}
+
+// PickedEQ applies the EQ predicate on the "picked" field.
+func PickedEQ(v int) predicate.WavePick {
+	return predicate.WavePick(sql.FieldEQ(FieldPicked, v))
+}
+
+// PickedNEQ applies the NEQ predicate on the "picked" field.
+func PickedNEQ(v int) predicate.WavePick {
+	return predicate.WavePick(sql.FieldNEQ(FieldPicked, v))
+}
+
+// PickedIn applies the In predicate on the "picked" field.
+func PickedIn(vs ...int) predicate.WavePick {
+	return predicate.WavePick(sql.FieldIn(FieldPicked, vs...))
+}
+
+// PickedNotIn applies the NotIn predicate on the "picked" field.
+func PickedNotIn(vs ...int) predicate.WavePick {
+	return predicate.WavePick(sql.FieldNotIn(FieldPicked, vs...))
+}
+
+// PickedGT applies the GT predicate on the "picked" field.
+func PickedGT(v int) predicate.WavePick {
+	return predicate.WavePick(sql.FieldGT(FieldPicked, v))
+}
+
+// PickedGTE applies the GTE predicate on the "picked" field.
+func PickedGTE(v int) predicate.WavePick {
+	return predicate.WavePick(sql.FieldGTE(FieldPicked, v))
+}
+
+// PickedLT applies the LT predicate on the "picked" field.
+func PickedLT(v int) predicate.WavePick {
+	return predicate.WavePick(sql.FieldLT(FieldPicked, v))
+}
+
+// PickedLTE applies the LTE predicate on the "picked" field.
+func PickedLTE(v int) predicate.WavePick {
+	return predicate.WavePick(sql.FieldLTE(FieldPicked, v))
+}
+
+// HasTask applies the HasEdge predicate on the "task" edge.
+func HasTask() predicate.WavePick {
+	return predicate.WavePick(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, TaskTable, TaskColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTaskWith applies the HasEdge predicate on the "task" edge with a given conditions (other predicates).
+func HasTaskWith(preds ...predicate.WaveTask) predicate.WavePick {
+	return predicate.WavePick(func(s *sql.Selector) {
+		step := newTaskStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOrderLine applies the HasEdge predicate on the "order_line" edge.
+func HasOrderLine() predicate.WavePick {
+	return predicate.WavePick(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, OrderLineTable, OrderLineColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrderLineWith applies the HasEdge predicate on the "order_line" edge with a given conditions (other predicates).
+func HasOrderLineWith(preds ...predicate.OrderLine) predicate.WavePick {
+	return predicate.WavePick(func(s *sql.Selector) {
+		step := newOrderLineStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WavePick) predicate.WavePick {
+	return predicate.WavePick(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WavePick) predicate.WavePick {
+	return predicate.WavePick(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WavePick) predicate.WavePick {
+	return predicate.WavePick(sql.NotPredicates(p))
+}
@@ -0,0 +1,288 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/orderline"
+	"github.com/mxV03/wms/ent/wavepick"
+	"github.com/mxV03/wms/ent/wavetask"
+)
+
+// WavePickCreate is the builder for creating a WavePick entity.
+type WavePickCreate struct {
+	config
+	mutation *WavePickMutation
+	hooks    []Hook
+}
+
+// SetQuantity sets the "quantity" field.
+func (_c *WavePickCreate) SetQuantity(v int) *WavePickCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetPicked sets the "picked" field.
+func (_c *WavePickCreate) SetPicked(v int) *WavePickCreate {
+	_c.mutation.SetPicked(v)
+	return _c
+}
+
+// SetNillablePicked sets the "picked" field if the given value is not nil.
+func (_c *WavePickCreate) SetNillablePicked(v *int) *WavePickCreate {
+	if v != nil {
+		_c.SetPicked(*v)
+	}
+	return _c
+}
+
+// SetTaskID sets the "task" edge to the WaveTask entity by ID.
+func (_c *WavePickCreate) SetTaskID(id int) *WavePickCreate {
+	_c.mutation.SetTaskID(id)
+	return _c
+}
+
+// SetTask sets the "task" edge to the WaveTask entity.
+func (_c *WavePickCreate) SetTask(v *WaveTask) *WavePickCreate {
+	return _c.SetTaskID(v.ID)
+}
+
+// SetOrderLineID sets the "order_line" edge to the OrderLine entity by ID.
+func (_c *WavePickCreate) SetOrderLineID(id int) *WavePickCreate {
+	_c.mutation.SetOrderLineID(id)
+	return _c
+}
+
+// SetOrderLine sets the "order_line" edge to the OrderLine entity.
+func (_c *WavePickCreate) SetOrderLine(v *OrderLine) *WavePickCreate {
+	return _c.SetOrderLineID(v.ID)
+}
+
+// Mutation returns the WavePickMutation object of the builder.
+func (_c *WavePickCreate) Mutation() *WavePickMutation {
+	return _c.mutation
+}
+
+// Save creates the WavePick in the database.
+func (_c *WavePickCreate) Save(ctx context.Context) (*WavePick, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WavePickCreate) SaveX(ctx context.Context) *WavePick {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WavePickCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WavePickCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WavePickCreate) defaults() {
+	if _, ok := _c.mutation.Picked(); !ok {
+		v := wavepick.DefaultPicked
+		_c.mutation.SetPicked(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WavePickCreate) check() error {
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "WavePick.quantity"`)}
+	}
+	if v, ok := _c.mutation.Quantity(); ok {
+		if err := wavepick.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "WavePick.quantity": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Picked(); !ok {
+		return &ValidationError{Name: "picked", err: errors.New(`ent: missing required field "WavePick.picked"`)}
+	}
+	if v, ok := _c.mutation.Picked(); ok {
+		if err := wavepick.PickedValidator(v); err != nil {
+			return &ValidationError{Name: "picked", err: fmt.Errorf(`ent: validator failed for field "WavePick.picked": %w`, err)}
+		}
+	}
+	if len(_c.mutation.TaskIDs()) == 0 {
+		return &ValidationError{Name: "task", err: errors.New(`ent: missing required edge "WavePick.task"`)}
+	}
+	if len(_c.mutation.OrderLineIDs()) == 0 {
+		return &ValidationError{Name: "order_line", err: errors.New(`ent: missing required edge "WavePick.order_line"`)}
+	}
+	return nil
+}
+
+func (_c *WavePickCreate) sqlSave(ctx context.Context) (*WavePick, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WavePickCreate) createSpec() (*WavePick, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WavePick{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(wavepick.Table, sqlgraph.NewFieldSpec(wavepick.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(wavepick.FieldQuantity, field.TypeInt, value)
+		_node.Quantity = value
+	}
+	if value, ok := _c.mutation.Picked(); ok {
+		_spec.SetField(wavepick.FieldPicked, field.TypeInt, value)
+		_node.Picked = value
+	}
+	if nodes := _c.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wavepick.TaskTable,
+			Columns: []string{wavepick.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wavetask.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.wave_task_picks = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OrderLineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   wavepick.OrderLineTable,
+			Columns: []string{wavepick.OrderLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.wave_pick_order_line = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WavePickCreateBulk is the builder for creating many WavePick entities in bulk.
+type WavePickCreateBulk struct {
+	config
+	err      error
+	builders []*WavePickCreate
+}
+
+// Save creates the WavePick entities in the database.
+func (_c *WavePickCreateBulk) Save(ctx context.Context) ([]*WavePick, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WavePick, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WavePickMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WavePickCreateBulk) SaveX(ctx context.Context) []*WavePick {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WavePickCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WavePickCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,517 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/orderline"
+	"github.com/mxV03/wms/ent/predicate"
+	"github.com/mxV03/wms/ent/wavepick"
+	"github.com/mxV03/wms/ent/wavetask"
+)
+
+// WavePickUpdate is the builder for updating WavePick entities.
+type WavePickUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WavePickMutation
+}
+
+// Where appends a list predicates to the WavePickUpdate builder.
+func (_u *WavePickUpdate) Where(ps ...predicate.WavePick) *WavePickUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *WavePickUpdate) SetQuantity(v int) *WavePickUpdate {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *WavePickUpdate) SetNillableQuantity(v *int) *WavePickUpdate {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *WavePickUpdate) AddQuantity(v int) *WavePickUpdate {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetPicked sets the "picked" field.
+func (_u *WavePickUpdate) SetPicked(v int) *WavePickUpdate {
+	_u.mutation.ResetPicked()
+	_u.mutation.SetPicked(v)
+	return _u
+}
+
+// SetNillablePicked sets the "picked" field if the given value is not nil.
+func (_u *WavePickUpdate) SetNillablePicked(v *int) *WavePickUpdate {
+	if v != nil {
+		_u.SetPicked(*v)
+	}
+	return _u
+}
+
+// AddPicked adds value to the "picked" field.
+func (_u *WavePickUpdate) AddPicked(v int) *WavePickUpdate {
+	_u.mutation.AddPicked(v)
+	return _u
+}
+
+// SetTaskID sets the "task" edge to the WaveTask entity by ID.
+func (_u *WavePickUpdate) SetTaskID(id int) *WavePickUpdate {
+	_u.mutation.SetTaskID(id)
+	return _u
+}
+
+// SetTask sets the "task" edge to the WaveTask entity.
+func (_u *WavePickUpdate) SetTask(v *WaveTask) *WavePickUpdate {
+	return _u.SetTaskID(v.ID)
+}
+
+// SetOrderLineID sets the "order_line" edge to the OrderLine entity by ID.
+func (_u *WavePickUpdate) SetOrderLineID(id int) *WavePickUpdate {
+	_u.mutation.SetOrderLineID(id)
+	return _u
+}
+
+// SetOrderLine sets the "order_line" edge to the OrderLine entity.
+func (_u *WavePickUpdate) SetOrderLine(v *OrderLine) *WavePickUpdate {
+	return _u.SetOrderLineID(v.ID)
+}
+
+// Mutation returns the WavePickMutation object of the builder.
+func (_u *WavePickUpdate) Mutation() *WavePickMutation {
+	return _u.mutation
+}
+
+// ClearTask clears the "task" edge to the WaveTask entity.
+func (_u *WavePickUpdate) ClearTask() *WavePickUpdate {
+	_u.mutation.ClearTask()
+	return _u
+}
+
+// ClearOrderLine clears the "order_line" edge to the OrderLine entity.
+func (_u *WavePickUpdate) ClearOrderLine() *WavePickUpdate {
+	_u.mutation.ClearOrderLine()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WavePickUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WavePickUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WavePickUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WavePickUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WavePickUpdate) check() error {
+	if v, ok := _u.mutation.Quantity(); ok {
+		if err := wavepick.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "WavePick.quantity": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Picked(); ok {
+		if err := wavepick.PickedValidator(v); err != nil {
+			return &ValidationError{Name: "picked", err: fmt.Errorf(`ent: validator failed for field "WavePick.picked": %w`, err)}
+		}
+	}
+	if _u.mutation.TaskCleared() && len(_u.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WavePick.task"`)
+	}
+	if _u.mutation.OrderLineCleared() && len(_u.mutation.OrderLineIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WavePick.order_line"`)
+	}
+	return nil
+}
+
+func (_u *WavePickUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(wavepick.Table, wavepick.Columns, sqlgraph.NewFieldSpec(wavepick.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(wavepick.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(wavepick.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Picked(); ok {
+		_spec.SetField(wavepick.FieldPicked, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPicked(); ok {
+		_spec.AddField(wavepick.FieldPicked, field.TypeInt, value)
+	}
+	if _u.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wavepick.TaskTable,
+			Columns: []string{wavepick.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wavetask.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wavepick.TaskTable,
+			Columns: []string{wavepick.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wavetask.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OrderLineCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   wavepick.OrderLineTable,
+			Columns: []string{wavepick.OrderLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrderLineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   wavepick.OrderLineTable,
+			Columns: []string{wavepick.OrderLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wavepick.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WavePickUpdateOne is the builder for updating a single WavePick entity.
+type WavePickUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WavePickMutation
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *WavePickUpdateOne) SetQuantity(v int) *WavePickUpdateOne {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *WavePickUpdateOne) SetNillableQuantity(v *int) *WavePickUpdateOne {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *WavePickUpdateOne) AddQuantity(v int) *WavePickUpdateOne {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetPicked sets the "picked" field.
+func (_u *WavePickUpdateOne) SetPicked(v int) *WavePickUpdateOne {
+	_u.mutation.ResetPicked()
+	_u.mutation.SetPicked(v)
+	return _u
+}
+
+// SetNillablePicked sets the "picked" field if the given value is not nil.
+func (_u *WavePickUpdateOne) SetNillablePicked(v *int) *WavePickUpdateOne {
+	if v != nil {
+		_u.SetPicked(*v)
+	}
+	return _u
+}
+
+// AddPicked adds value to the "picked" field.
+func (_u *WavePickUpdateOne) AddPicked(v int) *WavePickUpdateOne {
+	_u.mutation.AddPicked(v)
+	return _u
+}
+
+// SetTaskID sets the "task" edge to the WaveTask entity by ID.
+func (_u *WavePickUpdateOne) SetTaskID(id int) *WavePickUpdateOne {
+	_u.mutation.SetTaskID(id)
+	return _u
+}
+
+// SetTask sets the "task" edge to the WaveTask entity.
+func (_u *WavePickUpdateOne) SetTask(v *WaveTask) *WavePickUpdateOne {
+	return _u.SetTaskID(v.ID)
+}
+
+// SetOrderLineID sets the "order_line" edge to the OrderLine entity by ID.
+func (_u *WavePickUpdateOne) SetOrderLineID(id int) *WavePickUpdateOne {
+	_u.mutation.SetOrderLineID(id)
+	return _u
+}
+
+// SetOrderLine sets the "order_line" edge to the OrderLine entity.
+func (_u *WavePickUpdateOne) SetOrderLine(v *OrderLine) *WavePickUpdateOne {
+	return _u.SetOrderLineID(v.ID)
+}
+
+// Mutation returns the WavePickMutation object of the builder.
+func (_u *WavePickUpdateOne) Mutation() *WavePickMutation {
+	return _u.mutation
+}
+
+// ClearTask clears the "task" edge to the WaveTask entity.
+func (_u *WavePickUpdateOne) ClearTask() *WavePickUpdateOne {
+	_u.mutation.ClearTask()
+	return _u
+}
+
+// ClearOrderLine clears the "order_line" edge to the OrderLine entity.
+func (_u *WavePickUpdateOne) ClearOrderLine() *WavePickUpdateOne {
+	_u.mutation.ClearOrderLine()
+	return _u
+}
+
+// Where appends a list predicates to the WavePickUpdate builder.
+func (_u *WavePickUpdateOne) Where(ps ...predicate.WavePick) *WavePickUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WavePickUpdateOne) Select(field string, fields ...string) *WavePickUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WavePick entity.
+func (_u *WavePickUpdateOne) Save(ctx context.Context) (*WavePick, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WavePickUpdateOne) SaveX(ctx context.Context) *WavePick {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WavePickUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WavePickUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WavePickUpdateOne) check() error {
+	if v, ok := _u.mutation.Quantity(); ok {
+		if err := wavepick.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "WavePick.quantity": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Picked(); ok {
+		if err := wavepick.PickedValidator(v); err != nil {
+			return &ValidationError{Name: "picked", err: fmt.Errorf(`ent: validator failed for field "WavePick.picked": %w`, err)}
+		}
+	}
+	if _u.mutation.TaskCleared() && len(_u.mutation.TaskIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WavePick.task"`)
+	}
+	if _u.mutation.OrderLineCleared() && len(_u.mutation.OrderLineIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WavePick.order_line"`)
+	}
+	return nil
+}
+
+func (_u *WavePickUpdateOne) sqlSave(ctx context.Context) (_node *WavePick, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(wavepick.Table, wavepick.Columns, sqlgraph.NewFieldSpec(wavepick.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WavePick.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, wavepick.FieldID)
+		for _, f := range fields {
+			if !wavepick.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != wavepick.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(wavepick.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(wavepick.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Picked(); ok {
+		_spec.SetField(wavepick.FieldPicked, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPicked(); ok {
+		_spec.AddField(wavepick.FieldPicked, field.TypeInt, value)
+	}
+	if _u.mutation.TaskCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wavepick.TaskTable,
+			Columns: []string{wavepick.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wavetask.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TaskIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wavepick.TaskTable,
+			Columns: []string{wavepick.TaskColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wavetask.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OrderLineCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   wavepick.OrderLineTable,
+			Columns: []string{wavepick.OrderLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderline.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrderLineIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   wavepick.OrderLineTable,
+			Columns: []string{wavepick.OrderLineColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderline.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WavePick{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wavepick.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
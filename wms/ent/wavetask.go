@@ -0,0 +1,280 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/mxV03/wms/ent/item"
+	"github.com/mxV03/wms/ent/location"
+	"github.com/mxV03/wms/ent/wave"
+	"github.com/mxV03/wms/ent/wavetask"
+)
+
+// WaveTask is the model entity for the WaveTask schema.
+type WaveTask struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity int `json:"quantity,omitempty"`
+	// Picked holds the value of the "picked" field.
+	Picked int `json:"picked,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// PickedAt holds the value of the "picked_at" field.
+	PickedAt *time.Time `json:"picked_at,omitempty"`
+	// BinID holds the value of the "bin_id" field.
+	BinID *int `json:"bin_id,omitempty"`
+	// PickedBy holds the value of the "picked_by" field.
+	PickedBy string `json:"picked_by,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WaveTaskQuery when eager-loading is set.
+	Edges              WaveTaskEdges `json:"edges"`
+	wave_tasks         *int
+	wave_task_item     *int
+	wave_task_location *int
+	selectValues       sql.SelectValues
+}
+
+// WaveTaskEdges holds the relations/edges for other nodes in the graph.
+type WaveTaskEdges struct {
+	// Wave holds the value of the wave edge.
+	Wave *Wave `json:"wave,omitempty"`
+	// Item holds the value of the item edge.
+	Item *Item `json:"item,omitempty"`
+	// Location holds the value of the location edge.
+	Location *Location `json:"location,omitempty"`
+	// Picks holds the value of the picks edge.
+	Picks []*WavePick `json:"picks,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// WaveOrErr returns the Wave value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WaveTaskEdges) WaveOrErr() (*Wave, error) {
+	if e.Wave != nil {
+		return e.Wave, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: wave.Label}
+	}
+	return nil, &NotLoadedError{edge: "wave"}
+}
+
+// ItemOrErr returns the Item value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WaveTaskEdges) ItemOrErr() (*Item, error) {
+	if e.Item != nil {
+		return e.Item, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: item.Label}
+	}
+	return nil, &NotLoadedError{edge: "item"}
+}
+
+// LocationOrErr returns the Location value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WaveTaskEdges) LocationOrErr() (*Location, error) {
+	if e.Location != nil {
+		return e.Location, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: location.Label}
+	}
+	return nil, &NotLoadedError{edge: "location"}
+}
+
+// PicksOrErr returns the Picks value or an error if the edge
+// was not loaded in eager-loading.
+func (e WaveTaskEdges) PicksOrErr() ([]*WavePick, error) {
+	if e.loadedTypes[3] {
+		return e.Picks, nil
+	}
+	return nil, &NotLoadedError{edge: "picks"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WaveTask) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case wavetask.FieldID, wavetask.FieldQuantity, wavetask.FieldPicked, wavetask.FieldBinID:
+			values[i] = new(sql.NullInt64)
+		case wavetask.FieldStatus, wavetask.FieldPickedBy:
+			values[i] = new(sql.NullString)
+		case wavetask.FieldPickedAt:
+			values[i] = new(sql.NullTime)
+		case wavetask.ForeignKeys[0]: // wave_tasks
+			values[i] = new(sql.NullInt64)
+		case wavetask.ForeignKeys[1]: // wave_task_item
+			values[i] = new(sql.NullInt64)
+		case wavetask.ForeignKeys[2]: // wave_task_location
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WaveTask fields.
+func (_m *WaveTask) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case wavetask.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case wavetask.FieldQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				_m.Quantity = int(value.Int64)
+			}
+		case wavetask.FieldPicked:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field picked", values[i])
+			} else if value.Valid {
+				_m.Picked = int(value.Int64)
+			}
+		case wavetask.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = value.String
+			}
+		case wavetask.FieldPickedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field picked_at", values[i])
+			} else if value.Valid {
+				_m.PickedAt = new(time.Time)
+				*_m.PickedAt = value.Time
+			}
+		case wavetask.FieldBinID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field bin_id", values[i])
+			} else if value.Valid {
+				_m.BinID = new(int)
+				*_m.BinID = int(value.Int64)
+			}
+		case wavetask.FieldPickedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field picked_by", values[i])
+			} else if value.Valid {
+				_m.PickedBy = value.String
+			}
+		case wavetask.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field wave_tasks", value)
+			} else if value.Valid {
+				_m.wave_tasks = new(int)
+				*_m.wave_tasks = int(value.Int64)
+			}
+		case wavetask.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field wave_task_item", value)
+			} else if value.Valid {
+				_m.wave_task_item = new(int)
+				*_m.wave_task_item = int(value.Int64)
+			}
+		case wavetask.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field wave_task_location", value)
+			} else if value.Valid {
+				_m.wave_task_location = new(int)
+				*_m.wave_task_location = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WaveTask.
+// This includes values selected through modifiers, order, etc.
+func (_m *WaveTask) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryWave queries the "wave" edge of the WaveTask entity.
+func (_m *WaveTask) QueryWave() *WaveQuery {
+	return NewWaveTaskClient(_m.config).QueryWave(_m)
+}
+
+// QueryItem queries the "item" edge of the WaveTask entity.
+func (_m *WaveTask) QueryItem() *ItemQuery {
+	return NewWaveTaskClient(_m.config).QueryItem(_m)
+}
+
+// QueryLocation queries the "location" edge of the WaveTask entity.
+func (_m *WaveTask) QueryLocation() *LocationQuery {
+	return NewWaveTaskClient(_m.config).QueryLocation(_m)
+}
+
+// QueryPicks queries the "picks" edge of the WaveTask entity.
+func (_m *WaveTask) QueryPicks() *WavePickQuery {
+	return NewWaveTaskClient(_m.config).QueryPicks(_m)
+}
+
+// Update returns a builder for updating this WaveTask.
+// Note that you need to call WaveTask.Unwrap() before calling this method if this WaveTask
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *WaveTask) Update() *WaveTaskUpdateOne {
+	return NewWaveTaskClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the WaveTask entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *WaveTask) Unwrap() *WaveTask {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WaveTask is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *WaveTask) String() string {
+	var builder strings.Builder
+	builder.WriteString("WaveTask(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("picked=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Picked))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(_m.Status)
+	builder.WriteString(", ")
+	if v := _m.PickedAt; v != nil {
+		builder.WriteString("picked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.BinID; v != nil {
+		builder.WriteString("bin_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("picked_by=")
+	builder.WriteString(_m.PickedBy)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WaveTasks is a parsable slice of WaveTask.
+type WaveTasks []*WaveTask
@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package wavetask
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the wavetask type in the database.
+	Label = "wave_task"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldPicked holds the string denoting the picked field in the database.
+	FieldPicked = "picked"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldPickedAt holds the string denoting the picked_at field in the database.
+	FieldPickedAt = "picked_at"
+	// FieldBinID holds the string denoting the bin_id field in the database.
+	FieldBinID = "bin_id"
+	// FieldPickedBy holds the string denoting the picked_by field in the database.
+	FieldPickedBy = "picked_by"
+	// EdgeWave holds the string denoting the wave edge name in mutations.
+	EdgeWave = "wave"
+	// EdgeItem holds the string denoting the item edge name in mutations.
+	EdgeItem = "item"
+	// EdgeLocation holds the string denoting the location edge name in mutations.
+	EdgeLocation = "location"
+	// EdgePicks holds the string denoting the picks edge name in mutations.
+	EdgePicks = "picks"
+	// Table holds the table name of the wavetask in the database.
+	Table = "wave_tasks"
+	// WaveTable is the table that holds the wave relation/edge.
+	WaveTable = "wave_tasks"
+	// WaveInverseTable is the table name for the Wave entity.
+	// It exists in this package in order to avoid circular dependency with the "wave" package.
+	WaveInverseTable = "waves"
+	// WaveColumn is the table column denoting the wave relation/edge.
+	WaveColumn = "wave_tasks"
+	// ItemTable is the table that holds the item relation/edge.
+	ItemTable = "wave_tasks"
+	// ItemInverseTable is the table name for the Item entity.
+	// It exists in this package in order to avoid circular dependency with the "item" package.
+	ItemInverseTable = "items"
+	// ItemColumn is the table column denoting the item relation/edge.
+	ItemColumn = "wave_task_item"
+	// LocationTable is the table that holds the location relation/edge.
+	LocationTable = "wave_tasks"
+	// LocationInverseTable is the table name for the Location entity.
+	// It exists in this package in order to avoid circular dependency with the "location" package.
+	LocationInverseTable = "locations"
+	// LocationColumn is the table column denoting the location relation/edge.
+	LocationColumn = "wave_task_location"
+	// PicksTable is the table that holds the picks relation/edge.
+	PicksTable = "wave_picks"
+	// PicksInverseTable is the table name for the WavePick entity.
+	// It exists in this package in order to avoid circular dependency with the "wavepick" package.
+	PicksInverseTable = "wave_picks"
+	// PicksColumn is the table column denoting the picks relation/edge.
+	PicksColumn = "wave_task_picks"
+)
+
+// Columns holds all SQL columns for wavetask fields.
+var Columns = []string{
+	FieldID,
+	FieldQuantity,
+	FieldPicked,
+	FieldStatus,
+	FieldPickedAt,
+	FieldBinID,
+	FieldPickedBy,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "wave_tasks"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"wave_tasks",
+	"wave_task_item",
+	"wave_task_location",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	QuantityValidator func(int) error
+	// DefaultPicked holds the default value on creation for the "picked" field.
+	DefaultPicked int
+	// PickedValidator is a validator for the "picked" field. It is called by the builders before save.
+	PickedValidator func(int) error
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultPickedBy holds the default value on creation for the "picked_by" field.
+	DefaultPickedBy string
+)
+
+// OrderOption defines the ordering options for the WaveTask queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByQuantity orders the results by the quantity field.
+func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
+}
+
+// ByPicked orders the results by the picked field.
+func ByPicked(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPicked, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByPickedAt orders the results by the picked_at field.
+func ByPickedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPickedAt, opts...).ToFunc()
+}
+
+// ByBinID orders the results by the bin_id field.
+func ByBinID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBinID, opts...).ToFunc()
+}
+
+// ByPickedBy orders the results by the picked_by field.
+func ByPickedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPickedBy, opts...).ToFunc()
+}
+
+// ByWaveField orders the results by wave field.
+func ByWaveField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWaveStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByItemField orders the results by item field.
+func ByItemField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newItemStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByLocationField orders the results by location field.
+func ByLocationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLocationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPicksCount orders the results by picks count.
+func ByPicksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPicksStep(), opts...)
+	}
+}
+
+// ByPicks orders the results by picks terms.
+func ByPicks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPicksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newWaveStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WaveInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, WaveTable, WaveColumn),
+	)
+}
+func newItemStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ItemInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ItemTable, ItemColumn),
+	)
+}
+func newLocationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LocationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, LocationTable, LocationColumn),
+	)
+}
+func newPicksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PicksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PicksTable, PicksColumn),
+	)
+}
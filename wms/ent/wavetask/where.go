@@ -0,0 +1,513 @@
+// Code generated by ent, DO NOT EDIT.
+
+package wavetask
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/mxV03/wms/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldLTE(FieldID, id))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldEQ(FieldQuantity, v))
+}
+
+// Picked applies equality check predicate on the "picked" field. It's identical to PickedEQ.
+func Picked(v int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldEQ(FieldPicked, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldEQ(FieldStatus, v))
+}
+
+// PickedAt applies equality check predicate on the "picked_at" field. It's identical to PickedAtEQ.
+func PickedAt(v time.Time) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldEQ(FieldPickedAt, v))
+}
+
+// BinID applies equality check predicate on the "bin_id" field. It's identical to BinIDEQ.
+func BinID(v int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldEQ(FieldBinID, v))
+}
+
+// PickedBy applies equality check predicate on the "picked_by" field. It's identical to PickedByEQ.
+func PickedBy(v string) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldEQ(FieldPickedBy, v))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldLTE(FieldQuantity, v))
+}
+
+// PickedEQ applies the EQ predicate on the "picked" field.
+func PickedEQ(v int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldEQ(FieldPicked, v))
+}
+
+// PickedNEQ applies the NEQ predicate on the "picked" field.
+func PickedNEQ(v int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldNEQ(FieldPicked, v))
+}
+
+// PickedIn applies the In predicate on the "picked" field.
+func PickedIn(vs ...int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldIn(FieldPicked, vs...))
+}
+
+// PickedNotIn applies the NotIn predicate on the "picked" field.
+func PickedNotIn(vs ...int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldNotIn(FieldPicked, vs...))
+}
+
+// PickedGT applies the GT predicate on the "picked" field.
+func PickedGT(v int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldGT(FieldPicked, v))
+}
+
+// PickedGTE applies the GTE predicate on the "picked" field.
+func PickedGTE(v int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldGTE(FieldPicked, v))
+}
+
+// PickedLT applies the LT predicate on the "picked" field.
+func PickedLT(v int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldLT(FieldPicked, v))
+}
+
+// PickedLTE applies the LTE predicate on the "picked" field.
+func PickedLTE(v int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldLTE(FieldPicked, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// PickedAtEQ applies the EQ predicate on the "picked_at" field.
+func PickedAtEQ(v time.Time) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldEQ(FieldPickedAt, v))
+}
+
+// PickedAtNEQ applies the NEQ predicate on the "picked_at" field.
+func PickedAtNEQ(v time.Time) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldNEQ(FieldPickedAt, v))
+}
+
+// PickedAtIn applies the In predicate on the "picked_at" field.
+func PickedAtIn(vs ...time.Time) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldIn(FieldPickedAt, vs...))
+}
+
+// PickedAtNotIn applies the NotIn predicate on the "picked_at" field.
+func PickedAtNotIn(vs ...time.Time) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldNotIn(FieldPickedAt, vs...))
+}
+
+// PickedAtGT applies the GT predicate on the "picked_at" field.
+func PickedAtGT(v time.Time) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldGT(FieldPickedAt, v))
+}
+
+// PickedAtGTE applies the GTE predicate on the "picked_at" field.
+func PickedAtGTE(v time.Time) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldGTE(FieldPickedAt, v))
+}
+
+// PickedAtLT applies the LT predicate on the "picked_at" field.
+func PickedAtLT(v time.Time) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldLT(FieldPickedAt, v))
+}
+
+// PickedAtLTE applies the LTE predicate on the "picked_at" field.
+func PickedAtLTE(v time.Time) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldLTE(FieldPickedAt, v))
+}
+
+// PickedAtIsNil applies the IsNil predicate on the "picked_at" field.
+func PickedAtIsNil() predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldIsNull(FieldPickedAt))
+}
+
+// PickedAtNotNil applies the NotNil predicate on the "picked_at" field.
+func PickedAtNotNil() predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldNotNull(FieldPickedAt))
+}
+
+// BinIDEQ applies the EQ predicate on the "bin_id" field.
+func BinIDEQ(v int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldEQ(FieldBinID, v))
+}
+
+// BinIDNEQ applies the NEQ predicate on the "bin_id" field.
+func BinIDNEQ(v int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldNEQ(FieldBinID, v))
+}
+
+// BinIDIn applies the In predicate on the "bin_id" field.
+func BinIDIn(vs ...int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldIn(FieldBinID, vs...))
+}
+
+// BinIDNotIn applies the NotIn predicate on the "bin_id" field.
+func BinIDNotIn(vs ...int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldNotIn(FieldBinID, vs...))
+}
+
+// BinIDGT applies the GT predicate on the "bin_id" field.
+func BinIDGT(v int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldGT(FieldBinID, v))
+}
+
+// BinIDGTE applies the GTE predicate on the "bin_id" field.
+func BinIDGTE(v int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldGTE(FieldBinID, v))
+}
+
+// BinIDLT applies the LT predicate on the "bin_id" field.
+func BinIDLT(v int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldLT(FieldBinID, v))
+}
+
+// BinIDLTE applies the LTE predicate on the "bin_id" field.
+func BinIDLTE(v int) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldLTE(FieldBinID, v))
+}
+
+// BinIDIsNil applies the IsNil predicate on the "bin_id" field.
+func BinIDIsNil() predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldIsNull(FieldBinID))
+}
+
+// BinIDNotNil applies the NotNil predicate on the "bin_id" field.
+func BinIDNotNil() predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldNotNull(FieldBinID))
+}
+
+// PickedByEQ applies the EQ predicate on the "picked_by" field.
+func PickedByEQ(v string) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldEQ(FieldPickedBy, v))
+}
+
+// PickedByNEQ applies the NEQ predicate on the "picked_by" field.
+func PickedByNEQ(v string) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldNEQ(FieldPickedBy, v))
+}
+
+// PickedByIn applies the In predicate on the "picked_by" field.
+func PickedByIn(vs ...string) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldIn(FieldPickedBy, vs...))
+}
+
+// PickedByNotIn applies the NotIn predicate on the "picked_by" field.
+func PickedByNotIn(vs ...string) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldNotIn(FieldPickedBy, vs...))
+}
+
+// PickedByGT applies the GT predicate on the "picked_by" field.
+func PickedByGT(v string) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldGT(FieldPickedBy, v))
+}
+
+// PickedByGTE applies the GTE predicate on the "picked_by" field.
+func PickedByGTE(v string) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldGTE(FieldPickedBy, v))
+}
+
+// PickedByLT applies the LT predicate on the "picked_by" field.
+func PickedByLT(v string) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldLT(FieldPickedBy, v))
+}
+
+// PickedByLTE applies the LTE predicate on the "picked_by" field.
+func PickedByLTE(v string) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldLTE(FieldPickedBy, v))
+}
+
+// PickedByContains applies the Contains predicate on the "picked_by" field.
+func PickedByContains(v string) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldContains(FieldPickedBy, v))
+}
+
+// PickedByHasPrefix applies the HasPrefix predicate on the "picked_by" field.
+func PickedByHasPrefix(v string) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldHasPrefix(FieldPickedBy, v))
+}
+
+// PickedByHasSuffix applies the HasSuffix predicate on the "picked_by" field.
+func PickedByHasSuffix(v string) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldHasSuffix(FieldPickedBy, v))
+}
+
+// PickedByIsNil applies the IsNil predicate on the "picked_by" field.
+func PickedByIsNil() predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldIsNull(FieldPickedBy))
+}
+
+// PickedByNotNil applies the NotNil predicate on the "picked_by" field.
+func PickedByNotNil() predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldNotNull(FieldPickedBy))
+}
+
+// PickedByEqualFold applies the EqualFold predicate on the "picked_by" field.
+func PickedByEqualFold(v string) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldEqualFold(FieldPickedBy, v))
+}
+
+// PickedByContainsFold applies the ContainsFold predicate on the "picked_by" field.
+func PickedByContainsFold(v string) predicate.WaveTask {
+	return predicate.WaveTask(sql.FieldContainsFold(FieldPickedBy, v))
+}
+
+// HasWave applies the HasEdge predicate on the "wave" edge.
+func HasWave() predicate.WaveTask {
+	return predicate.WaveTask(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WaveTable, WaveColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWaveWith applies the HasEdge predicate on the "wave" edge with a given conditions (other predicates).
+func HasWaveWith(preds ...predicate.Wave) predicate.WaveTask {
+	return predicate.WaveTask(func(s *sql.Selector) {
+		step := newWaveStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasItem applies the HasEdge predicate on the "item" edge.
+func HasItem() predicate.WaveTask {
+	return predicate.WaveTask(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ItemTable, ItemColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemWith applies the HasEdge predicate on the "item" edge with a given conditions (other predicates).
+func HasItemWith(preds ...predicate.Item) predicate.WaveTask {
+	return predicate.WaveTask(func(s *sql.Selector) {
+		step := newItemStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLocation applies the HasEdge predicate on the "location" edge.
+func HasLocation() predicate.WaveTask {
+	return predicate.WaveTask(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, LocationTable, LocationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLocationWith applies the HasEdge predicate on the "location" edge with a given conditions (other predicates).
+func HasLocationWith(preds ...predicate.Location) predicate.WaveTask {
+	return predicate.WaveTask(func(s *sql.Selector) {
+		step := newLocationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPicks applies the HasEdge predicate on the "picks" edge.
+func HasPicks() predicate.WaveTask {
+	return predicate.WaveTask(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PicksTable, PicksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPicksWith applies the HasEdge predicate on the "picks" edge with a given conditions (other predicates).
+func HasPicksWith(preds ...predicate.WavePick) predicate.WaveTask {
+	return predicate.WaveTask(func(s *sql.Selector) {
+		step := newPicksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WaveTask) predicate.WaveTask {
+	return predicate.WaveTask(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WaveTask) predicate.WaveTask {
+	return predicate.WaveTask(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WaveTask) predicate.WaveTask {
+	return predicate.WaveTask(sql.NotPredicates(p))
+}
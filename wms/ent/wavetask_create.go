@@ -0,0 +1,436 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/item"
+	"github.com/mxV03/wms/ent/location"
+	"github.com/mxV03/wms/ent/wave"
+	"github.com/mxV03/wms/ent/wavepick"
+	"github.com/mxV03/wms/ent/wavetask"
+)
+
+// WaveTaskCreate is the builder for creating a WaveTask entity.
+type WaveTaskCreate struct {
+	config
+	mutation *WaveTaskMutation
+	hooks    []Hook
+}
+
+// SetQuantity sets the "quantity" field.
+func (_c *WaveTaskCreate) SetQuantity(v int) *WaveTaskCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetPicked sets the "picked" field.
+func (_c *WaveTaskCreate) SetPicked(v int) *WaveTaskCreate {
+	_c.mutation.SetPicked(v)
+	return _c
+}
+
+// SetNillablePicked sets the "picked" field if the given value is not nil.
+func (_c *WaveTaskCreate) SetNillablePicked(v *int) *WaveTaskCreate {
+	if v != nil {
+		_c.SetPicked(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *WaveTaskCreate) SetStatus(v string) *WaveTaskCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *WaveTaskCreate) SetNillableStatus(v *string) *WaveTaskCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetPickedAt sets the "picked_at" field.
+func (_c *WaveTaskCreate) SetPickedAt(v time.Time) *WaveTaskCreate {
+	_c.mutation.SetPickedAt(v)
+	return _c
+}
+
+// SetNillablePickedAt sets the "picked_at" field if the given value is not nil.
+func (_c *WaveTaskCreate) SetNillablePickedAt(v *time.Time) *WaveTaskCreate {
+	if v != nil {
+		_c.SetPickedAt(*v)
+	}
+	return _c
+}
+
+// SetBinID sets the "bin_id" field.
+func (_c *WaveTaskCreate) SetBinID(v int) *WaveTaskCreate {
+	_c.mutation.SetBinID(v)
+	return _c
+}
+
+// SetNillableBinID sets the "bin_id" field if the given value is not nil.
+func (_c *WaveTaskCreate) SetNillableBinID(v *int) *WaveTaskCreate {
+	if v != nil {
+		_c.SetBinID(*v)
+	}
+	return _c
+}
+
+// SetPickedBy sets the "picked_by" field.
+func (_c *WaveTaskCreate) SetPickedBy(v string) *WaveTaskCreate {
+	_c.mutation.SetPickedBy(v)
+	return _c
+}
+
+// SetNillablePickedBy sets the "picked_by" field if the given value is not nil.
+func (_c *WaveTaskCreate) SetNillablePickedBy(v *string) *WaveTaskCreate {
+	if v != nil {
+		_c.SetPickedBy(*v)
+	}
+	return _c
+}
+
+// SetWaveID sets the "wave" edge to the Wave entity by ID.
+func (_c *WaveTaskCreate) SetWaveID(id int) *WaveTaskCreate {
+	_c.mutation.SetWaveID(id)
+	return _c
+}
+
+// SetWave sets the "wave" edge to the Wave entity.
+func (_c *WaveTaskCreate) SetWave(v *Wave) *WaveTaskCreate {
+	return _c.SetWaveID(v.ID)
+}
+
+// SetItemID sets the "item" edge to the Item entity by ID.
+func (_c *WaveTaskCreate) SetItemID(id int) *WaveTaskCreate {
+	_c.mutation.SetItemID(id)
+	return _c
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_c *WaveTaskCreate) SetItem(v *Item) *WaveTaskCreate {
+	return _c.SetItemID(v.ID)
+}
+
+// SetLocationID sets the "location" edge to the Location entity by ID.
+func (_c *WaveTaskCreate) SetLocationID(id int) *WaveTaskCreate {
+	_c.mutation.SetLocationID(id)
+	return _c
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (_c *WaveTaskCreate) SetLocation(v *Location) *WaveTaskCreate {
+	return _c.SetLocationID(v.ID)
+}
+
+// AddPickIDs adds the "picks" edge to the WavePick entity by IDs.
+func (_c *WaveTaskCreate) AddPickIDs(ids ...int) *WaveTaskCreate {
+	_c.mutation.AddPickIDs(ids...)
+	return _c
+}
+
+// AddPicks adds the "picks" edges to the WavePick entity.
+func (_c *WaveTaskCreate) AddPicks(v ...*WavePick) *WaveTaskCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPickIDs(ids...)
+}
+
+// Mutation returns the WaveTaskMutation object of the builder.
+func (_c *WaveTaskCreate) Mutation() *WaveTaskMutation {
+	return _c.mutation
+}
+
+// Save creates the WaveTask in the database.
+func (_c *WaveTaskCreate) Save(ctx context.Context) (*WaveTask, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WaveTaskCreate) SaveX(ctx context.Context) *WaveTask {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WaveTaskCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WaveTaskCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WaveTaskCreate) defaults() {
+	if _, ok := _c.mutation.Picked(); !ok {
+		v := wavetask.DefaultPicked
+		_c.mutation.SetPicked(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := wavetask.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.PickedBy(); !ok {
+		v := wavetask.DefaultPickedBy
+		_c.mutation.SetPickedBy(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WaveTaskCreate) check() error {
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "WaveTask.quantity"`)}
+	}
+	if v, ok := _c.mutation.Quantity(); ok {
+		if err := wavetask.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "WaveTask.quantity": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Picked(); !ok {
+		return &ValidationError{Name: "picked", err: errors.New(`ent: missing required field "WaveTask.picked"`)}
+	}
+	if v, ok := _c.mutation.Picked(); ok {
+		if err := wavetask.PickedValidator(v); err != nil {
+			return &ValidationError{Name: "picked", err: fmt.Errorf(`ent: validator failed for field "WaveTask.picked": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "WaveTask.status"`)}
+	}
+	if len(_c.mutation.WaveIDs()) == 0 {
+		return &ValidationError{Name: "wave", err: errors.New(`ent: missing required edge "WaveTask.wave"`)}
+	}
+	if len(_c.mutation.ItemIDs()) == 0 {
+		return &ValidationError{Name: "item", err: errors.New(`ent: missing required edge "WaveTask.item"`)}
+	}
+	if len(_c.mutation.LocationIDs()) == 0 {
+		return &ValidationError{Name: "location", err: errors.New(`ent: missing required edge "WaveTask.location"`)}
+	}
+	return nil
+}
+
+func (_c *WaveTaskCreate) sqlSave(ctx context.Context) (*WaveTask, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WaveTaskCreate) createSpec() (*WaveTask, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WaveTask{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(wavetask.Table, sqlgraph.NewFieldSpec(wavetask.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(wavetask.FieldQuantity, field.TypeInt, value)
+		_node.Quantity = value
+	}
+	if value, ok := _c.mutation.Picked(); ok {
+		_spec.SetField(wavetask.FieldPicked, field.TypeInt, value)
+		_node.Picked = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(wavetask.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.PickedAt(); ok {
+		_spec.SetField(wavetask.FieldPickedAt, field.TypeTime, value)
+		_node.PickedAt = &value
+	}
+	if value, ok := _c.mutation.BinID(); ok {
+		_spec.SetField(wavetask.FieldBinID, field.TypeInt, value)
+		_node.BinID = &value
+	}
+	if value, ok := _c.mutation.PickedBy(); ok {
+		_spec.SetField(wavetask.FieldPickedBy, field.TypeString, value)
+		_node.PickedBy = value
+	}
+	if nodes := _c.mutation.WaveIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wavetask.WaveTable,
+			Columns: []string{wavetask.WaveColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wave.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.wave_tasks = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   wavetask.ItemTable,
+			Columns: []string{wavetask.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.wave_task_item = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   wavetask.LocationTable,
+			Columns: []string{wavetask.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.wave_task_location = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.PicksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wavetask.PicksTable,
+			Columns: []string{wavetask.PicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wavepick.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WaveTaskCreateBulk is the builder for creating many WaveTask entities in bulk.
+type WaveTaskCreateBulk struct {
+	config
+	err      error
+	builders []*WaveTaskCreate
+}
+
+// Save creates the WaveTask entities in the database.
+func (_c *WaveTaskCreateBulk) Save(ctx context.Context) ([]*WaveTask, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WaveTask, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WaveTaskMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WaveTaskCreateBulk) SaveX(ctx context.Context) []*WaveTask {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WaveTaskCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WaveTaskCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
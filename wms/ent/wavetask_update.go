@@ -0,0 +1,990 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/mxV03/wms/ent/item"
+	"github.com/mxV03/wms/ent/location"
+	"github.com/mxV03/wms/ent/predicate"
+	"github.com/mxV03/wms/ent/wave"
+	"github.com/mxV03/wms/ent/wavepick"
+	"github.com/mxV03/wms/ent/wavetask"
+)
+
+// WaveTaskUpdate is the builder for updating WaveTask entities.
+type WaveTaskUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WaveTaskMutation
+}
+
+// Where appends a list predicates to the WaveTaskUpdate builder.
+func (_u *WaveTaskUpdate) Where(ps ...predicate.WaveTask) *WaveTaskUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *WaveTaskUpdate) SetQuantity(v int) *WaveTaskUpdate {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *WaveTaskUpdate) SetNillableQuantity(v *int) *WaveTaskUpdate {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *WaveTaskUpdate) AddQuantity(v int) *WaveTaskUpdate {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetPicked sets the "picked" field.
+func (_u *WaveTaskUpdate) SetPicked(v int) *WaveTaskUpdate {
+	_u.mutation.ResetPicked()
+	_u.mutation.SetPicked(v)
+	return _u
+}
+
+// SetNillablePicked sets the "picked" field if the given value is not nil.
+func (_u *WaveTaskUpdate) SetNillablePicked(v *int) *WaveTaskUpdate {
+	if v != nil {
+		_u.SetPicked(*v)
+	}
+	return _u
+}
+
+// AddPicked adds value to the "picked" field.
+func (_u *WaveTaskUpdate) AddPicked(v int) *WaveTaskUpdate {
+	_u.mutation.AddPicked(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *WaveTaskUpdate) SetStatus(v string) *WaveTaskUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *WaveTaskUpdate) SetNillableStatus(v *string) *WaveTaskUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetPickedAt sets the "picked_at" field.
+func (_u *WaveTaskUpdate) SetPickedAt(v time.Time) *WaveTaskUpdate {
+	_u.mutation.SetPickedAt(v)
+	return _u
+}
+
+// SetNillablePickedAt sets the "picked_at" field if the given value is not nil.
+func (_u *WaveTaskUpdate) SetNillablePickedAt(v *time.Time) *WaveTaskUpdate {
+	if v != nil {
+		_u.SetPickedAt(*v)
+	}
+	return _u
+}
+
+// ClearPickedAt clears the value of the "picked_at" field.
+func (_u *WaveTaskUpdate) ClearPickedAt() *WaveTaskUpdate {
+	_u.mutation.ClearPickedAt()
+	return _u
+}
+
+// SetBinID sets the "bin_id" field.
+func (_u *WaveTaskUpdate) SetBinID(v int) *WaveTaskUpdate {
+	_u.mutation.ResetBinID()
+	_u.mutation.SetBinID(v)
+	return _u
+}
+
+// SetNillableBinID sets the "bin_id" field if the given value is not nil.
+func (_u *WaveTaskUpdate) SetNillableBinID(v *int) *WaveTaskUpdate {
+	if v != nil {
+		_u.SetBinID(*v)
+	}
+	return _u
+}
+
+// AddBinID adds value to the "bin_id" field.
+func (_u *WaveTaskUpdate) AddBinID(v int) *WaveTaskUpdate {
+	_u.mutation.AddBinID(v)
+	return _u
+}
+
+// ClearBinID clears the value of the "bin_id" field.
+func (_u *WaveTaskUpdate) ClearBinID() *WaveTaskUpdate {
+	_u.mutation.ClearBinID()
+	return _u
+}
+
+// SetPickedBy sets the "picked_by" field.
+func (_u *WaveTaskUpdate) SetPickedBy(v string) *WaveTaskUpdate {
+	_u.mutation.SetPickedBy(v)
+	return _u
+}
+
+// SetNillablePickedBy sets the "picked_by" field if the given value is not nil.
+func (_u *WaveTaskUpdate) SetNillablePickedBy(v *string) *WaveTaskUpdate {
+	if v != nil {
+		_u.SetPickedBy(*v)
+	}
+	return _u
+}
+
+// ClearPickedBy clears the value of the "picked_by" field.
+func (_u *WaveTaskUpdate) ClearPickedBy() *WaveTaskUpdate {
+	_u.mutation.ClearPickedBy()
+	return _u
+}
+
+// SetWaveID sets the "wave" edge to the Wave entity by ID.
+func (_u *WaveTaskUpdate) SetWaveID(id int) *WaveTaskUpdate {
+	_u.mutation.SetWaveID(id)
+	return _u
+}
+
+// SetWave sets the "wave" edge to the Wave entity.
+func (_u *WaveTaskUpdate) SetWave(v *Wave) *WaveTaskUpdate {
+	return _u.SetWaveID(v.ID)
+}
+
+// SetItemID sets the "item" edge to the Item entity by ID.
+func (_u *WaveTaskUpdate) SetItemID(id int) *WaveTaskUpdate {
+	_u.mutation.SetItemID(id)
+	return _u
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_u *WaveTaskUpdate) SetItem(v *Item) *WaveTaskUpdate {
+	return _u.SetItemID(v.ID)
+}
+
+// SetLocationID sets the "location" edge to the Location entity by ID.
+func (_u *WaveTaskUpdate) SetLocationID(id int) *WaveTaskUpdate {
+	_u.mutation.SetLocationID(id)
+	return _u
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (_u *WaveTaskUpdate) SetLocation(v *Location) *WaveTaskUpdate {
+	return _u.SetLocationID(v.ID)
+}
+
+// AddPickIDs adds the "picks" edge to the WavePick entity by IDs.
+func (_u *WaveTaskUpdate) AddPickIDs(ids ...int) *WaveTaskUpdate {
+	_u.mutation.AddPickIDs(ids...)
+	return _u
+}
+
+// AddPicks adds the "picks" edges to the WavePick entity.
+func (_u *WaveTaskUpdate) AddPicks(v ...*WavePick) *WaveTaskUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPickIDs(ids...)
+}
+
+// Mutation returns the WaveTaskMutation object of the builder.
+func (_u *WaveTaskUpdate) Mutation() *WaveTaskMutation {
+	return _u.mutation
+}
+
+// ClearWave clears the "wave" edge to the Wave entity.
+func (_u *WaveTaskUpdate) ClearWave() *WaveTaskUpdate {
+	_u.mutation.ClearWave()
+	return _u
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (_u *WaveTaskUpdate) ClearItem() *WaveTaskUpdate {
+	_u.mutation.ClearItem()
+	return _u
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (_u *WaveTaskUpdate) ClearLocation() *WaveTaskUpdate {
+	_u.mutation.ClearLocation()
+	return _u
+}
+
+// ClearPicks clears all "picks" edges to the WavePick entity.
+func (_u *WaveTaskUpdate) ClearPicks() *WaveTaskUpdate {
+	_u.mutation.ClearPicks()
+	return _u
+}
+
+// RemovePickIDs removes the "picks" edge to WavePick entities by IDs.
+func (_u *WaveTaskUpdate) RemovePickIDs(ids ...int) *WaveTaskUpdate {
+	_u.mutation.RemovePickIDs(ids...)
+	return _u
+}
+
+// RemovePicks removes "picks" edges to WavePick entities.
+func (_u *WaveTaskUpdate) RemovePicks(v ...*WavePick) *WaveTaskUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePickIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WaveTaskUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WaveTaskUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WaveTaskUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WaveTaskUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WaveTaskUpdate) check() error {
+	if v, ok := _u.mutation.Quantity(); ok {
+		if err := wavetask.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "WaveTask.quantity": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Picked(); ok {
+		if err := wavetask.PickedValidator(v); err != nil {
+			return &ValidationError{Name: "picked", err: fmt.Errorf(`ent: validator failed for field "WaveTask.picked": %w`, err)}
+		}
+	}
+	if _u.mutation.WaveCleared() && len(_u.mutation.WaveIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WaveTask.wave"`)
+	}
+	if _u.mutation.ItemCleared() && len(_u.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WaveTask.item"`)
+	}
+	if _u.mutation.LocationCleared() && len(_u.mutation.LocationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WaveTask.location"`)
+	}
+	return nil
+}
+
+func (_u *WaveTaskUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(wavetask.Table, wavetask.Columns, sqlgraph.NewFieldSpec(wavetask.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(wavetask.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(wavetask.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Picked(); ok {
+		_spec.SetField(wavetask.FieldPicked, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPicked(); ok {
+		_spec.AddField(wavetask.FieldPicked, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(wavetask.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PickedAt(); ok {
+		_spec.SetField(wavetask.FieldPickedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PickedAtCleared() {
+		_spec.ClearField(wavetask.FieldPickedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.BinID(); ok {
+		_spec.SetField(wavetask.FieldBinID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBinID(); ok {
+		_spec.AddField(wavetask.FieldBinID, field.TypeInt, value)
+	}
+	if _u.mutation.BinIDCleared() {
+		_spec.ClearField(wavetask.FieldBinID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.PickedBy(); ok {
+		_spec.SetField(wavetask.FieldPickedBy, field.TypeString, value)
+	}
+	if _u.mutation.PickedByCleared() {
+		_spec.ClearField(wavetask.FieldPickedBy, field.TypeString)
+	}
+	if _u.mutation.WaveCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wavetask.WaveTable,
+			Columns: []string{wavetask.WaveColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wave.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WaveIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wavetask.WaveTable,
+			Columns: []string{wavetask.WaveColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wave.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   wavetask.ItemTable,
+			Columns: []string{wavetask.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   wavetask.ItemTable,
+			Columns: []string{wavetask.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   wavetask.LocationTable,
+			Columns: []string{wavetask.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   wavetask.LocationTable,
+			Columns: []string{wavetask.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PicksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wavetask.PicksTable,
+			Columns: []string{wavetask.PicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wavepick.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPicksIDs(); len(nodes) > 0 && !_u.mutation.PicksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wavetask.PicksTable,
+			Columns: []string{wavetask.PicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wavepick.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PicksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wavetask.PicksTable,
+			Columns: []string{wavetask.PicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wavepick.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wavetask.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WaveTaskUpdateOne is the builder for updating a single WaveTask entity.
+type WaveTaskUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WaveTaskMutation
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *WaveTaskUpdateOne) SetQuantity(v int) *WaveTaskUpdateOne {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *WaveTaskUpdateOne) SetNillableQuantity(v *int) *WaveTaskUpdateOne {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *WaveTaskUpdateOne) AddQuantity(v int) *WaveTaskUpdateOne {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
+// SetPicked sets the "picked" field.
+func (_u *WaveTaskUpdateOne) SetPicked(v int) *WaveTaskUpdateOne {
+	_u.mutation.ResetPicked()
+	_u.mutation.SetPicked(v)
+	return _u
+}
+
+// SetNillablePicked sets the "picked" field if the given value is not nil.
+func (_u *WaveTaskUpdateOne) SetNillablePicked(v *int) *WaveTaskUpdateOne {
+	if v != nil {
+		_u.SetPicked(*v)
+	}
+	return _u
+}
+
+// AddPicked adds value to the "picked" field.
+func (_u *WaveTaskUpdateOne) AddPicked(v int) *WaveTaskUpdateOne {
+	_u.mutation.AddPicked(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *WaveTaskUpdateOne) SetStatus(v string) *WaveTaskUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *WaveTaskUpdateOne) SetNillableStatus(v *string) *WaveTaskUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetPickedAt sets the "picked_at" field.
+func (_u *WaveTaskUpdateOne) SetPickedAt(v time.Time) *WaveTaskUpdateOne {
+	_u.mutation.SetPickedAt(v)
+	return _u
+}
+
+// SetNillablePickedAt sets the "picked_at" field if the given value is not nil.
+func (_u *WaveTaskUpdateOne) SetNillablePickedAt(v *time.Time) *WaveTaskUpdateOne {
+	if v != nil {
+		_u.SetPickedAt(*v)
+	}
+	return _u
+}
+
+// ClearPickedAt clears the value of the "picked_at" field.
+func (_u *WaveTaskUpdateOne) ClearPickedAt() *WaveTaskUpdateOne {
+	_u.mutation.ClearPickedAt()
+	return _u
+}
+
+// SetBinID sets the "bin_id" field.
+func (_u *WaveTaskUpdateOne) SetBinID(v int) *WaveTaskUpdateOne {
+	_u.mutation.ResetBinID()
+	_u.mutation.SetBinID(v)
+	return _u
+}
+
+// SetNillableBinID sets the "bin_id" field if the given value is not nil.
+func (_u *WaveTaskUpdateOne) SetNillableBinID(v *int) *WaveTaskUpdateOne {
+	if v != nil {
+		_u.SetBinID(*v)
+	}
+	return _u
+}
+
+// AddBinID adds value to the "bin_id" field.
+func (_u *WaveTaskUpdateOne) AddBinID(v int) *WaveTaskUpdateOne {
+	_u.mutation.AddBinID(v)
+	return _u
+}
+
+// ClearBinID clears the value of the "bin_id" field.
+func (_u *WaveTaskUpdateOne) ClearBinID() *WaveTaskUpdateOne {
+	_u.mutation.ClearBinID()
+	return _u
+}
+
+// SetPickedBy sets the "picked_by" field.
+func (_u *WaveTaskUpdateOne) SetPickedBy(v string) *WaveTaskUpdateOne {
+	_u.mutation.SetPickedBy(v)
+	return _u
+}
+
+// SetNillablePickedBy sets the "picked_by" field if the given value is not nil.
+func (_u *WaveTaskUpdateOne) SetNillablePickedBy(v *string) *WaveTaskUpdateOne {
+	if v != nil {
+		_u.SetPickedBy(*v)
+	}
+	return _u
+}
+
+// ClearPickedBy clears the value of the "picked_by" field.
+func (_u *WaveTaskUpdateOne) ClearPickedBy() *WaveTaskUpdateOne {
+	_u.mutation.ClearPickedBy()
+	return _u
+}
+
+// SetWaveID sets the "wave" edge to the Wave entity by ID.
+func (_u *WaveTaskUpdateOne) SetWaveID(id int) *WaveTaskUpdateOne {
+	_u.mutation.SetWaveID(id)
+	return _u
+}
+
+// SetWave sets the "wave" edge to the Wave entity.
+func (_u *WaveTaskUpdateOne) SetWave(v *Wave) *WaveTaskUpdateOne {
+	return _u.SetWaveID(v.ID)
+}
+
+// SetItemID sets the "item" edge to the Item entity by ID.
+func (_u *WaveTaskUpdateOne) SetItemID(id int) *WaveTaskUpdateOne {
+	_u.mutation.SetItemID(id)
+	return _u
+}
+
+// SetItem sets the "item" edge to the Item entity.
+func (_u *WaveTaskUpdateOne) SetItem(v *Item) *WaveTaskUpdateOne {
+	return _u.SetItemID(v.ID)
+}
+
+// SetLocationID sets the "location" edge to the Location entity by ID.
+func (_u *WaveTaskUpdateOne) SetLocationID(id int) *WaveTaskUpdateOne {
+	_u.mutation.SetLocationID(id)
+	return _u
+}
+
+// SetLocation sets the "location" edge to the Location entity.
+func (_u *WaveTaskUpdateOne) SetLocation(v *Location) *WaveTaskUpdateOne {
+	return _u.SetLocationID(v.ID)
+}
+
+// AddPickIDs adds the "picks" edge to the WavePick entity by IDs.
+func (_u *WaveTaskUpdateOne) AddPickIDs(ids ...int) *WaveTaskUpdateOne {
+	_u.mutation.AddPickIDs(ids...)
+	return _u
+}
+
+// AddPicks adds the "picks" edges to the WavePick entity.
+func (_u *WaveTaskUpdateOne) AddPicks(v ...*WavePick) *WaveTaskUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPickIDs(ids...)
+}
+
+// Mutation returns the WaveTaskMutation object of the builder.
+func (_u *WaveTaskUpdateOne) Mutation() *WaveTaskMutation {
+	return _u.mutation
+}
+
+// ClearWave clears the "wave" edge to the Wave entity.
+func (_u *WaveTaskUpdateOne) ClearWave() *WaveTaskUpdateOne {
+	_u.mutation.ClearWave()
+	return _u
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (_u *WaveTaskUpdateOne) ClearItem() *WaveTaskUpdateOne {
+	_u.mutation.ClearItem()
+	return _u
+}
+
+// ClearLocation clears the "location" edge to the Location entity.
+func (_u *WaveTaskUpdateOne) ClearLocation() *WaveTaskUpdateOne {
+	_u.mutation.ClearLocation()
+	return _u
+}
+
+// ClearPicks clears all "picks" edges to the WavePick entity.
+func (_u *WaveTaskUpdateOne) ClearPicks() *WaveTaskUpdateOne {
+	_u.mutation.ClearPicks()
+	return _u
+}
+
+// RemovePickIDs removes the "picks" edge to WavePick entities by IDs.
+func (_u *WaveTaskUpdateOne) RemovePickIDs(ids ...int) *WaveTaskUpdateOne {
+	_u.mutation.RemovePickIDs(ids...)
+	return _u
+}
+
+// RemovePicks removes "picks" edges to WavePick entities.
+func (_u *WaveTaskUpdateOne) RemovePicks(v ...*WavePick) *WaveTaskUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePickIDs(ids...)
+}
+
+// Where appends a list predicates to the WaveTaskUpdate builder.
+func (_u *WaveTaskUpdateOne) Where(ps ...predicate.WaveTask) *WaveTaskUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WaveTaskUpdateOne) Select(field string, fields ...string) *WaveTaskUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WaveTask entity.
+func (_u *WaveTaskUpdateOne) Save(ctx context.Context) (*WaveTask, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WaveTaskUpdateOne) SaveX(ctx context.Context) *WaveTask {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WaveTaskUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WaveTaskUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WaveTaskUpdateOne) check() error {
+	if v, ok := _u.mutation.Quantity(); ok {
+		if err := wavetask.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "WaveTask.quantity": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Picked(); ok {
+		if err := wavetask.PickedValidator(v); err != nil {
+			return &ValidationError{Name: "picked", err: fmt.Errorf(`ent: validator failed for field "WaveTask.picked": %w`, err)}
+		}
+	}
+	if _u.mutation.WaveCleared() && len(_u.mutation.WaveIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WaveTask.wave"`)
+	}
+	if _u.mutation.ItemCleared() && len(_u.mutation.ItemIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WaveTask.item"`)
+	}
+	if _u.mutation.LocationCleared() && len(_u.mutation.LocationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WaveTask.location"`)
+	}
+	return nil
+}
+
+func (_u *WaveTaskUpdateOne) sqlSave(ctx context.Context) (_node *WaveTask, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(wavetask.Table, wavetask.Columns, sqlgraph.NewFieldSpec(wavetask.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WaveTask.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, wavetask.FieldID)
+		for _, f := range fields {
+			if !wavetask.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != wavetask.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(wavetask.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(wavetask.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Picked(); ok {
+		_spec.SetField(wavetask.FieldPicked, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPicked(); ok {
+		_spec.AddField(wavetask.FieldPicked, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(wavetask.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PickedAt(); ok {
+		_spec.SetField(wavetask.FieldPickedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PickedAtCleared() {
+		_spec.ClearField(wavetask.FieldPickedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.BinID(); ok {
+		_spec.SetField(wavetask.FieldBinID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedBinID(); ok {
+		_spec.AddField(wavetask.FieldBinID, field.TypeInt, value)
+	}
+	if _u.mutation.BinIDCleared() {
+		_spec.ClearField(wavetask.FieldBinID, field.TypeInt)
+	}
+	if value, ok := _u.mutation.PickedBy(); ok {
+		_spec.SetField(wavetask.FieldPickedBy, field.TypeString, value)
+	}
+	if _u.mutation.PickedByCleared() {
+		_spec.ClearField(wavetask.FieldPickedBy, field.TypeString)
+	}
+	if _u.mutation.WaveCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wavetask.WaveTable,
+			Columns: []string{wavetask.WaveColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wave.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WaveIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wavetask.WaveTable,
+			Columns: []string{wavetask.WaveColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wave.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   wavetask.ItemTable,
+			Columns: []string{wavetask.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   wavetask.ItemTable,
+			Columns: []string{wavetask.ItemColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(item.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   wavetask.LocationTable,
+			Columns: []string{wavetask.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   wavetask.LocationTable,
+			Columns: []string{wavetask.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.PicksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wavetask.PicksTable,
+			Columns: []string{wavetask.PicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wavepick.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPicksIDs(); len(nodes) > 0 && !_u.mutation.PicksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wavetask.PicksTable,
+			Columns: []string{wavetask.PicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wavepick.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PicksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wavetask.PicksTable,
+			Columns: []string{wavetask.PicksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wavepick.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WaveTask{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wavetask.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -21,6 +21,8 @@ type Zone struct {
 	Code string `json:"code,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// WalkSequence holds the value of the "walk_sequence" field.
+	WalkSequence int `json:"walk_sequence,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ZoneQuery when eager-loading is set.
 	Edges          ZoneEdges `json:"edges"`
@@ -64,7 +66,7 @@ func (*Zone) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case zone.FieldID:
+		case zone.FieldID, zone.FieldWalkSequence:
 			values[i] = new(sql.NullInt64)
 		case zone.FieldCode, zone.FieldName:
 			values[i] = new(sql.NullString)
@@ -103,6 +105,12 @@ func (_m *Zone) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Name = value.String
 			}
+		case zone.FieldWalkSequence:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field walk_sequence", values[i])
+			} else if value.Valid {
+				_m.WalkSequence = int(value.Int64)
+			}
 		case zone.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field location_zones", value)
@@ -161,6 +169,9 @@ func (_m *Zone) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("walk_sequence=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WalkSequence))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -63,6 +63,11 @@ func Name(v string) predicate.Zone {
 	return predicate.Zone(sql.FieldEQ(FieldName, v))
 }
 
+// WalkSequence applies equality check predicate on the "walk_sequence" field. It's identical to WalkSequenceEQ.
+func WalkSequence(v int) predicate.Zone {
+	return predicate.Zone(sql.FieldEQ(FieldWalkSequence, v))
+}
+
 // CodeEQ applies the EQ predicate on the "code" field.
 func CodeEQ(v string) predicate.Zone {
 	return predicate.Zone(sql.FieldEQ(FieldCode, v))
@@ -203,6 +208,46 @@ func NameContainsFold(v string) predicate.Zone {
 	return predicate.Zone(sql.FieldContainsFold(FieldName, v))
 }
 
+// WalkSequenceEQ applies the EQ predicate on the "walk_sequence" field.
+func WalkSequenceEQ(v int) predicate.Zone {
+	return predicate.Zone(sql.FieldEQ(FieldWalkSequence, v))
+}
+
+// WalkSequenceNEQ applies the NEQ predicate on the "walk_sequence" field.
+func WalkSequenceNEQ(v int) predicate.Zone {
+	return predicate.Zone(sql.FieldNEQ(FieldWalkSequence, v))
+}
+
+// WalkSequenceIn applies the In predicate on the "walk_sequence" field.
+func WalkSequenceIn(vs ...int) predicate.Zone {
+	return predicate.Zone(sql.FieldIn(FieldWalkSequence, vs...))
+}
+
+// WalkSequenceNotIn applies the NotIn predicate on the "walk_sequence" field.
+func WalkSequenceNotIn(vs ...int) predicate.Zone {
+	return predicate.Zone(sql.FieldNotIn(FieldWalkSequence, vs...))
+}
+
+// WalkSequenceGT applies the GT predicate on the "walk_sequence" field.
+func WalkSequenceGT(v int) predicate.Zone {
+	return predicate.Zone(sql.FieldGT(FieldWalkSequence, v))
+}
+
+// WalkSequenceGTE applies the GTE predicate on the "walk_sequence" field.
+func WalkSequenceGTE(v int) predicate.Zone {
+	return predicate.Zone(sql.FieldGTE(FieldWalkSequence, v))
+}
+
+// WalkSequenceLT applies the LT predicate on the "walk_sequence" field.
+func WalkSequenceLT(v int) predicate.Zone {
+	return predicate.Zone(sql.FieldLT(FieldWalkSequence, v))
+}
+
+// WalkSequenceLTE applies the LTE predicate on the "walk_sequence" field.
+func WalkSequenceLTE(v int) predicate.Zone {
+	return predicate.Zone(sql.FieldLTE(FieldWalkSequence, v))
+}
+
 // HasLocation applies the HasEdge predicate on the "location" edge.
 func HasLocation() predicate.Zone {
 	return predicate.Zone(func(s *sql.Selector) {
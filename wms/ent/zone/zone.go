@@ -16,6 +16,8 @@ const (
 	FieldCode = "code"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldWalkSequence holds the string denoting the walk_sequence field in the database.
+	FieldWalkSequence = "walk_sequence"
 	// EdgeLocation holds the string denoting the location edge name in mutations.
 	EdgeLocation = "location"
 	// EdgeBins holds the string denoting the bins edge name in mutations.
@@ -43,6 +45,7 @@ var Columns = []string{
 	FieldID,
 	FieldCode,
 	FieldName,
+	FieldWalkSequence,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "zones"
@@ -69,6 +72,10 @@ func ValidColumn(column string) bool {
 var (
 	// CodeValidator is a validator for the "code" field. It is called by the builders before save.
 	CodeValidator func(string) error
+	// DefaultWalkSequence holds the default value on creation for the "walk_sequence" field.
+	DefaultWalkSequence int
+	// WalkSequenceValidator is a validator for the "walk_sequence" field. It is called by the builders before save.
+	WalkSequenceValidator func(int) error
 )
 
 // OrderOption defines the ordering options for the Zone queries.
@@ -89,6 +96,11 @@ func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
 }
 
+// ByWalkSequence orders the results by the walk_sequence field.
+func ByWalkSequence(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWalkSequence, opts...).ToFunc()
+}
+
 // ByLocationField orders the results by location field.
 func ByLocationField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -41,6 +41,20 @@ func (_c *ZoneCreate) SetNillableName(v *string) *ZoneCreate {
 	return _c
 }
 
+// SetWalkSequence sets the "walk_sequence" field.
+func (_c *ZoneCreate) SetWalkSequence(v int) *ZoneCreate {
+	_c.mutation.SetWalkSequence(v)
+	return _c
+}
+
+// SetNillableWalkSequence sets the "walk_sequence" field if the given value is not nil.
+func (_c *ZoneCreate) SetNillableWalkSequence(v *int) *ZoneCreate {
+	if v != nil {
+		_c.SetWalkSequence(*v)
+	}
+	return _c
+}
+
 // SetLocationID sets the "location" edge to the Location entity by ID.
 func (_c *ZoneCreate) SetLocationID(id int) *ZoneCreate {
 	_c.mutation.SetLocationID(id)
@@ -74,6 +88,7 @@ func (_c *ZoneCreate) Mutation() *ZoneMutation {
 
 // Save creates the Zone in the database.
 func (_c *ZoneCreate) Save(ctx context.Context) (*Zone, error) {
+	_c.defaults()
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -99,6 +114,14 @@ func (_c *ZoneCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_c *ZoneCreate) defaults() {
+	if _, ok := _c.mutation.WalkSequence(); !ok {
+		v := zone.DefaultWalkSequence
+		_c.mutation.SetWalkSequence(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_c *ZoneCreate) check() error {
 	if _, ok := _c.mutation.Code(); !ok {
@@ -109,6 +132,14 @@ func (_c *ZoneCreate) check() error {
 			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Zone.code": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.WalkSequence(); !ok {
+		return &ValidationError{Name: "walk_sequence", err: errors.New(`ent: missing required field "Zone.walk_sequence"`)}
+	}
+	if v, ok := _c.mutation.WalkSequence(); ok {
+		if err := zone.WalkSequenceValidator(v); err != nil {
+			return &ValidationError{Name: "walk_sequence", err: fmt.Errorf(`ent: validator failed for field "Zone.walk_sequence": %w`, err)}
+		}
+	}
 	if len(_c.mutation.LocationIDs()) == 0 {
 		return &ValidationError{Name: "location", err: errors.New(`ent: missing required edge "Zone.location"`)}
 	}
@@ -146,6 +177,10 @@ func (_c *ZoneCreate) createSpec() (*Zone, *sqlgraph.CreateSpec) {
 		_spec.SetField(zone.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := _c.mutation.WalkSequence(); ok {
+		_spec.SetField(zone.FieldWalkSequence, field.TypeInt, value)
+		_node.WalkSequence = value
+	}
 	if nodes := _c.mutation.LocationIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -200,6 +235,7 @@ func (_c *ZoneCreateBulk) Save(ctx context.Context) ([]*Zone, error) {
 	for i := range _c.builders {
 		func(i int, root context.Context) {
 			builder := _c.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*ZoneMutation)
 				if !ok {
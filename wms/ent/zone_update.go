@@ -63,6 +63,27 @@ func (_u *ZoneUpdate) ClearName() *ZoneUpdate {
 	return _u
 }
 
+// SetWalkSequence sets the "walk_sequence" field.
+func (_u *ZoneUpdate) SetWalkSequence(v int) *ZoneUpdate {
+	_u.mutation.ResetWalkSequence()
+	_u.mutation.SetWalkSequence(v)
+	return _u
+}
+
+// SetNillableWalkSequence sets the "walk_sequence" field if the given value is not nil.
+func (_u *ZoneUpdate) SetNillableWalkSequence(v *int) *ZoneUpdate {
+	if v != nil {
+		_u.SetWalkSequence(*v)
+	}
+	return _u
+}
+
+// AddWalkSequence adds value to the "walk_sequence" field.
+func (_u *ZoneUpdate) AddWalkSequence(v int) *ZoneUpdate {
+	_u.mutation.AddWalkSequence(v)
+	return _u
+}
+
 // SetLocationID sets the "location" edge to the Location entity by ID.
 func (_u *ZoneUpdate) SetLocationID(id int) *ZoneUpdate {
 	_u.mutation.SetLocationID(id)
@@ -155,6 +176,11 @@ func (_u *ZoneUpdate) check() error {
 			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Zone.code": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.WalkSequence(); ok {
+		if err := zone.WalkSequenceValidator(v); err != nil {
+			return &ValidationError{Name: "walk_sequence", err: fmt.Errorf(`ent: validator failed for field "Zone.walk_sequence": %w`, err)}
+		}
+	}
 	if _u.mutation.LocationCleared() && len(_u.mutation.LocationIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Zone.location"`)
 	}
@@ -182,6 +208,12 @@ func (_u *ZoneUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.NameCleared() {
 		_spec.ClearField(zone.FieldName, field.TypeString)
 	}
+	if value, ok := _u.mutation.WalkSequence(); ok {
+		_spec.SetField(zone.FieldWalkSequence, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWalkSequence(); ok {
+		_spec.AddField(zone.FieldWalkSequence, field.TypeInt, value)
+	}
 	if _u.mutation.LocationCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -310,6 +342,27 @@ func (_u *ZoneUpdateOne) ClearName() *ZoneUpdateOne {
 	return _u
 }
 
+// SetWalkSequence sets the "walk_sequence" field.
+func (_u *ZoneUpdateOne) SetWalkSequence(v int) *ZoneUpdateOne {
+	_u.mutation.ResetWalkSequence()
+	_u.mutation.SetWalkSequence(v)
+	return _u
+}
+
+// SetNillableWalkSequence sets the "walk_sequence" field if the given value is not nil.
+func (_u *ZoneUpdateOne) SetNillableWalkSequence(v *int) *ZoneUpdateOne {
+	if v != nil {
+		_u.SetWalkSequence(*v)
+	}
+	return _u
+}
+
+// AddWalkSequence adds value to the "walk_sequence" field.
+func (_u *ZoneUpdateOne) AddWalkSequence(v int) *ZoneUpdateOne {
+	_u.mutation.AddWalkSequence(v)
+	return _u
+}
+
 // SetLocationID sets the "location" edge to the Location entity by ID.
 func (_u *ZoneUpdateOne) SetLocationID(id int) *ZoneUpdateOne {
 	_u.mutation.SetLocationID(id)
@@ -415,6 +468,11 @@ func (_u *ZoneUpdateOne) check() error {
 			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Zone.code": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.WalkSequence(); ok {
+		if err := zone.WalkSequenceValidator(v); err != nil {
+			return &ValidationError{Name: "walk_sequence", err: fmt.Errorf(`ent: validator failed for field "Zone.walk_sequence": %w`, err)}
+		}
+	}
 	if _u.mutation.LocationCleared() && len(_u.mutation.LocationIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Zone.location"`)
 	}
@@ -459,6 +517,12 @@ func (_u *ZoneUpdateOne) sqlSave(ctx context.Context) (_node *Zone, err error) {
 	if _u.mutation.NameCleared() {
 		_spec.ClearField(zone.FieldName, field.TypeString)
 	}
+	if value, ok := _u.mutation.WalkSequence(); ok {
+		_spec.SetField(zone.FieldWalkSequence, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWalkSequence(); ok {
+		_spec.AddField(zone.FieldWalkSequence, field.TypeInt, value)
+	}
 	if _u.mutation.LocationCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -2,7 +2,15 @@
 
 package auditlog
 
-import "context"
+import (
+	"context"
+	"fmt"
+)
 
-func Log(ctx context.Context, action, entity, entityRef, details string)              {}
-func Logf(ctx context.Context, action, entity, entityRef, format string, args ...any) {}
+func Log(ctx context.Context, action, entity, entityRef, details string) {
+	publish(ctx, Event{Action: action, Entity: entity, EntityRef: entityRef, Details: details})
+}
+
+func Logf(ctx context.Context, action, entity, entityRef, format string, args ...any) {
+	Log(ctx, action, entity, entityRef, fmt.Sprintf(format, args...))
+}
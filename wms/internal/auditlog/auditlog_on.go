@@ -8,6 +8,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/mxV03/wms/ent"
 	"github.com/mxV03/wms/internal/features/audit"
 	"github.com/mxV03/wms/internal/features/interfaces/cli/clictx"
 )
@@ -21,9 +22,13 @@ func Log(ctx context.Context, action, entity, entityRef, details string) {
 		actor = "system"
 	}
 
-	client := clictx.AppCtx().Client()
+	client, ok := ctx.Value(clientKey{}).(*ent.Client)
+	if !ok {
+		client = clictx.AppCtx().Client()
+	}
 
 	_ = audit.NewAuditService(client).Log(ctx, actor, action, entity, entityRef, details)
+	publish(ctx, Event{Action: action, Entity: entity, EntityRef: entityRef, Details: details})
 }
 
 func Logf(ctx context.Context, action, entity, entityRef, format string, args ...any) {
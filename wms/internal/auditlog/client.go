@@ -0,0 +1,27 @@
+package auditlog
+
+import (
+	"context"
+
+	"github.com/mxV03/wms/ent"
+)
+
+type clientKey struct{}
+
+// WithClient makes Log write the audit row through client instead of the
+// application client. Services logging inside a transaction pass the
+// transaction client, so the row does not wait for the transaction's own
+// write lock and is rolled back with it.
+func WithClient(ctx context.Context, client *ent.Client) context.Context {
+	return context.WithValue(ctx, clientKey{}, client)
+}
+
+type txKey struct{}
+
+// InTx is WithClient for the client of tx, and additionally holds back the
+// events logged with the returned context until tx commits, so subscribers
+// never react to a rolled back operation. Log after the commit with the
+// outer context; the hooks of a finished transaction never run.
+func InTx(ctx context.Context, tx *ent.Tx) context.Context {
+	return context.WithValue(WithClient(ctx, tx.Client()), txKey{}, tx)
+}
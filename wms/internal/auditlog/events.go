@@ -0,0 +1,93 @@
+package auditlog
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/mxV03/wms/ent"
+)
+
+// maxEvents bounds the events handled in one Flush, so subscribers whose
+// reactions log new events cannot loop forever.
+const maxEvents = 1000
+
+// Event is one logged operation, as passed to Log.
+type Event struct {
+	Action    string
+	Entity    string
+	EntityRef string
+	Details   string
+}
+
+var (
+	mu          sync.Mutex
+	subscribers []func(ctx context.Context, e Event)
+	pending     []Event
+)
+
+// Subscribe registers a handler for every logged event, whether or not the
+// audit feature is built in. Handlers run in Flush, after the operation
+// that logged the event has finished.
+func Subscribe(fn func(ctx context.Context, e Event)) {
+	mu.Lock()
+	defer mu.Unlock()
+	subscribers = append(subscribers, fn)
+}
+
+// Flush hands the events logged so far to the subscribers. Events logged by
+// the subscribers themselves are handled in the same Flush. Flush must not
+// run concurrently with itself: the CLI flushes once per command and a
+// watch loop between its runs.
+func Flush(ctx context.Context) {
+	for n := 0; ; n++ {
+		mu.Lock()
+		if len(pending) == 0 {
+			mu.Unlock()
+			return
+		}
+		if n == maxEvents {
+			fmt.Fprintf(os.Stderr, "auditlog: dropped %d events after %d handled\n", len(pending), maxEvents)
+			pending = nil
+			mu.Unlock()
+			return
+		}
+		e := pending[0]
+		pending = pending[1:]
+		subs := subscribers
+		mu.Unlock()
+
+		// unlocked, as handlers log events of their own
+		for _, fn := range subs {
+			fn(ctx, e)
+		}
+	}
+}
+
+// publish queues e for the subscribers. An event logged inside a transaction
+// (see InTx) is only queued once that transaction commits.
+func publish(ctx context.Context, e Event) {
+	tx, ok := ctx.Value(txKey{}).(*ent.Tx)
+	if !ok {
+		queue(e)
+		return
+	}
+	tx.OnCommit(func(next ent.Committer) ent.Committer {
+		return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+			if err := next.Commit(ctx, tx); err != nil {
+				return err
+			}
+			queue(e)
+			return nil
+		})
+	})
+}
+
+func queue(e Event) {
+	mu.Lock()
+	defer mu.Unlock()
+	if len(subscribers) > 0 {
+		pending = append(pending, e)
+	}
+}
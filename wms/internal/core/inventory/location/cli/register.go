@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	corelocation "github.com/mxV03/wms/internal/core/inventory/location"
 	"github.com/mxV03/wms/internal/features/interfaces/cli/clictx"
@@ -46,7 +47,7 @@ func init() {
 			if err != nil {
 				return err
 			}
-			fmt.Printf("location: CODE=%s NAME=%s\n", dto.Code, dto.Name)
+			printLocation(dto)
 			return nil
 		},
 	})
@@ -80,12 +81,51 @@ func init() {
 			}
 
 			for _, dto := range locations {
-				fmt.Printf("location: CODE=%s NAME=%s\n", dto.Code, dto.Name)
+				printLocation(dto)
 			}
 			return nil
 		},
 	})
 
+	registry.Register(registry.Command{
+		Name:        "location.hold",
+		Usage:       "location.hold <code> [reason]",
+		Group:       "Core / Location",
+		Description: "Put a location on hold; no stock leaves it and no picks are released from it until it is released.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) < 1 {
+				return fmt.Errorf("usage: location.hold <code> [reason]")
+			}
+
+			svc := corelocation.NewLocationService(clictx.AppCtx().Client())
+			reason := strings.Join(args[1:], " ")
+			if err := svc.Hold(ctx, args[0], reason); err != nil {
+				return err
+			}
+			fmt.Printf("location on hold: CODE=%s\n", args[0])
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "location.release",
+		Usage:       "location.release <code>",
+		Group:       "Core / Location",
+		Description: "Release a location from hold.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("usage: location.release <code>")
+			}
+
+			svc := corelocation.NewLocationService(clictx.AppCtx().Client())
+			if err := svc.Release(ctx, args[0]); err != nil {
+				return err
+			}
+			fmt.Printf("location released: CODE=%s\n", args[0])
+			return nil
+		},
+	})
+
 	registry.Register(registry.Command{
 		Name:        "location.del",
 		Usage:       "location.del <code>",
@@ -106,3 +146,11 @@ func init() {
 		},
 	})
 }
+
+func printLocation(dto *corelocation.LocationDTO) {
+	if !dto.OnHold {
+		fmt.Printf("location: CODE=%s NAME=%s\n", dto.Code, dto.Name)
+		return
+	}
+	fmt.Printf("location: CODE=%s NAME=%s HOLD=%q\n", dto.Code, dto.Name, dto.HoldReason)
+}
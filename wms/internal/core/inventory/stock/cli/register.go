@@ -30,6 +30,13 @@ func init() {
 			}
 
 			stockService := corestock.NewStockService(clictx.AppCtx().Client())
+			if key := clictx.IdempotencyKey(); key != "" {
+				_, replayed, err := stockService.INWithKey(ctx, key, args[0], args[1], qty, ref)
+				if err == nil && replayed {
+					fmt.Printf("Already booked with idempotency key '%s'.\n", key)
+				}
+				return err
+			}
 			return stockService.IN(ctx, args[0], args[1], qty, ref)
 		},
 	})
@@ -58,6 +65,13 @@ func init() {
 			}
 
 			stockService := corestock.NewStockService(clictx.AppCtx().Client())
+			if key := clictx.IdempotencyKey(); key != "" {
+				_, replayed, err := stockService.OUTWithKey(ctx, key, sku, locCode, qty, ref)
+				if err == nil && replayed {
+					fmt.Printf("Already booked with idempotency key '%s'.\n", key)
+				}
+				return err
+			}
 			return stockService.OUT(ctx, sku, locCode, qty, ref)
 		},
 	})
@@ -0,0 +1,18 @@
+package stock
+
+import "context"
+
+type settlingKey struct{}
+
+// Settling marks movements that settle work released or booked before a
+// location went on hold: issuing picked orders, reversals and return
+// dispositions. A hold only stops new stock from leaving the location, so
+// these are booked regardless.
+func Settling(ctx context.Context) context.Context {
+	return context.WithValue(ctx, settlingKey{}, true)
+}
+
+func settling(ctx context.Context) bool {
+	v, _ := ctx.Value(settlingKey{}).(bool)
+	return v
+}
@@ -17,6 +17,10 @@ var (
 	ErrInsufficientStock = fmt.Errorf("insufficient stock available")
 	ErrInvalidSKU        = fmt.Errorf("invalid stock SKU")
 	ErrInvalidLocation   = fmt.Errorf("invalid stock location")
+	ErrLocationOnHold    = fmt.Errorf("location is on hold")
+
+	ErrInvalidIdempotencyKey = fmt.Errorf("invalid idempotency key")
+	ErrIdempotencyConflict   = fmt.Errorf("idempotency key already used for a different request")
 )
 
 type MovementType string
@@ -42,95 +46,138 @@ type StockDTO struct {
 }
 
 func (s *StockService) IN(ctx context.Context, sku, locCode string, qty int, ref string) error {
-	sku = strings.TrimSpace(sku)
-	locCode = strings.TrimSpace(locCode)
-	ref = strings.TrimSpace(ref)
-
-	if sku == "" {
-		return ErrInvalidSKU
-	}
-	if locCode == "" {
-		return ErrInvalidLocation
-	}
-	if qty <= 0 {
-		return ErrInvalidQuantity
-	}
-
-	item, err := s.client.Item.Query().Where(item.SKU(sku)).Only(ctx)
-	if err != nil {
-		return fmt.Errorf("fetching item: %w", err)
-	}
-
-	loc, err := s.client.Location.Query().Where(location.Code(locCode)).Only(ctx)
-	if err != nil {
-		return fmt.Errorf("fetching location: %w", err)
-	}
+	_, _, err := s.book(ctx, "", MovementTypeIn, sku, locCode, qty, ref)
+	return err
+}
 
-	create := s.client.StockMovement.Create().
-		SetItem(item).
-		SetLocation(loc).
-		SetQuantity(qty).
-		SetType(string(MovementTypeIn))
+func (s *StockService) OUT(ctx context.Context, sku, locCode string, qty int, ref string) error {
+	_, _, err := s.book(ctx, "", MovementTypeOut, sku, locCode, qty, ref)
+	return err
+}
 
-	if ref != "" {
-		create.SetReference(ref)
+// INWithKey books incoming stock at most once per idempotency key. Repeating
+// a request with the same key and payload returns the original movement and
+// reports it as replayed; the same key with a different payload fails with
+// ErrIdempotencyConflict.
+func (s *StockService) INWithKey(ctx context.Context, key, sku, locCode string, qty int, ref string) (*ent.StockMovement, bool, error) {
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return nil, false, ErrInvalidIdempotencyKey
 	}
+	return s.book(ctx, key, MovementTypeIn, sku, locCode, qty, ref)
+}
 
-	if _, err := create.Save(ctx); err != nil {
-		return fmt.Errorf("creating stock movement IN: %w", err)
+// OUTWithKey is the idempotent counterpart of OUT, see INWithKey.
+func (s *StockService) OUTWithKey(ctx context.Context, key, sku, locCode string, qty int, ref string) (*ent.StockMovement, bool, error) {
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return nil, false, ErrInvalidIdempotencyKey
 	}
-	auditlog.Logf(ctx, "stock.in", "stock_movement", sku+"@"+locCode, "qty=%d ref=%s", qty, ref)
-	return nil
+	return s.book(ctx, key, MovementTypeOut, sku, locCode, qty, ref)
 }
 
-func (s *StockService) OUT(ctx context.Context, sku, locCode string, qty int, ref string) error {
+func (s *StockService) book(ctx context.Context, key string, mt MovementType, sku, locCode string, qty int, ref string) (*ent.StockMovement, bool, error) {
 	sku = strings.TrimSpace(sku)
 	locCode = strings.TrimSpace(locCode)
 	ref = strings.TrimSpace(ref)
 
 	if sku == "" {
-		return ErrInvalidSKU
+		return nil, false, ErrInvalidSKU
 	}
 	if locCode == "" {
-		return ErrInvalidLocation
+		return nil, false, ErrInvalidLocation
 	}
 	if qty <= 0 {
-		return ErrInvalidQuantity
+		return nil, false, ErrInvalidQuantity
 	}
 
-	current, err := s.StockAtLocation(ctx, sku, locCode)
-	if err != nil {
-		return err
+	// A replayed OUT must not be rejected for stock it already consumed, so
+	// the key is checked before anything else.
+	if key != "" {
+		if m, err := s.replay(ctx, key, mt, sku, locCode, qty, ref); m != nil || err != nil {
+			return m, m != nil, err
+		}
 	}
-	if current < qty {
-		return ErrInsufficientStock
+
+	if mt == MovementTypeOut {
+		current, err := s.StockAtLocation(ctx, sku, locCode)
+		if err != nil {
+			return nil, false, err
+		}
+		if current < qty {
+			return nil, false, ErrInsufficientStock
+		}
 	}
 
 	item, err := s.client.Item.Query().Where(item.SKU(sku)).Only(ctx)
 	if err != nil {
-		return fmt.Errorf("fetching item: %w", err)
+		return nil, false, fmt.Errorf("fetching item: %w", err)
 	}
 
 	loc, err := s.client.Location.Query().Where(location.Code(locCode)).Only(ctx)
 	if err != nil {
-		return fmt.Errorf("fetching location: %w", err)
+		return nil, false, fmt.Errorf("fetching location: %w", err)
+	}
+	// A hold stops new stock from leaving; receipts and settling work pass.
+	if loc.OnHold && mt == MovementTypeOut && !settling(ctx) {
+		return nil, false, ErrLocationOnHold
 	}
 
 	create := s.client.StockMovement.Create().
 		SetItem(item).
 		SetLocation(loc).
 		SetQuantity(qty).
-		SetType(string(MovementTypeOut))
+		SetType(string(mt))
 
 	if ref != "" {
 		create.SetReference(ref)
 	}
+	if key != "" {
+		create.SetIdempotencyKey(key)
+	}
 
-	if _, err := create.Save(ctx); err != nil {
-		return fmt.Errorf("creating stock movement OUT: %w", err)
+	m, err := create.Save(ctx)
+	if err != nil {
+		// Lost the race against a concurrent request with the same key.
+		if key != "" && ent.IsConstraintError(err) {
+			if m, rerr := s.replay(ctx, key, mt, sku, locCode, qty, ref); m != nil || rerr != nil {
+				return m, m != nil, rerr
+			}
+		}
+		return nil, false, fmt.Errorf("creating stock movement %s: %w", mt, err)
+	}
+	// s.client is the transaction client when called inside one
+	ctx = auditlog.WithClient(ctx, s.client)
+	if mt == MovementTypeIn {
+		auditlog.Logf(ctx, "stock.in", "stock_movement", sku+"@"+locCode, "qty=%d ref=%s", qty, ref)
+	} else {
+		auditlog.Logf(ctx, "stock.out", "stock_movement", sku+"@"+locCode, "qty=%d ref=%s", qty, ref)
+	}
+	return m, false, nil
+}
+
+// replay returns the movement already booked under key, or nil if the key is
+// unused. A movement with a different payload is an ErrIdempotencyConflict.
+func (s *StockService) replay(ctx context.Context, key string, mt MovementType, sku, locCode string, qty int, ref string) (*ent.StockMovement, error) {
+	m, err := s.client.StockMovement.Query().
+		Where(stockmovement.IdempotencyKey(key)).
+		WithItem().
+		WithLocation().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("fetching stock movement by idempotency key: %w", err)
+	}
+	if m.Type != string(mt) ||
+		m.Edges.Item.SKU != sku ||
+		m.Edges.Location.Code != locCode ||
+		m.Quantity != qty ||
+		m.Reference != ref {
+		return nil, ErrIdempotencyConflict
 	}
-	auditlog.Logf(ctx, "stock.out", "stock_movement", sku+"@"+locCode, "qty=%d ref=%s", qty, ref)
-	return nil
+	return m, nil
 }
 
 func (s *StockService) StockAtLocation(ctx context.Context, sku, locCode string) (int, error) {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	coreorders "github.com/mxV03/wms/internal/core/ordermanagement/orders"
 	"github.com/mxV03/wms/internal/features/interfaces/cli/clictx"
@@ -13,17 +14,37 @@ import (
 func init() {
 	registry.Register(registry.Command{
 		Name:        "order.in",
-		Usage:       "order.in <order_number>",
+		Usage:       "order.in [order_number]",
 		Group:       "Core / Orders",
-		Description: "Create inbound order.",
+		Description: "Create inbound order; allocates the next number from the INBOUND sequence if none is given.",
 		Run: func(ctx context.Context, args []string) error {
-			if len(args) != 1 {
-				return fmt.Errorf("usage: order.in <order_number>")
+			if len(args) > 1 {
+				return fmt.Errorf("usage: order.in [order_number]")
+			}
+			number := ""
+			if len(args) == 1 {
+				number = args[0]
 			}
 			orderService := coreorders.NewOrderService(clictx.AppCtx().Client())
-			_, err := orderService.CreateInboundOrder(ctx, args[0])
+			if key := clictx.IdempotencyKey(); key != "" {
+				o, replayed, err := orderService.CreateOrderWithKey(ctx, key, number, coreorders.OrderTypeInbound)
+				if err == nil && replayed {
+					fmt.Printf("Inbound order '%s' already created with idempotency key '%s'.\n", o.OrderNumber, key)
+				} else if err == nil {
+					fmt.Printf("Inbound order '%s' created successfully.\n", o.OrderNumber)
+				}
+				return err
+			}
+			if number == "" {
+				nr, err := orderService.NextOrderNumber(ctx, coreorders.OrderTypeInbound)
+				if err != nil {
+					return err
+				}
+				number = nr
+			}
+			_, err := orderService.CreateInboundOrder(ctx, number)
 			if err == nil {
-				fmt.Printf("Inbound order '%s' created successfully.\n", args[0])
+				fmt.Printf("Inbound order '%s' created successfully.\n", number)
 			}
 			return err
 		},
@@ -31,17 +52,37 @@ func init() {
 
 	registry.Register(registry.Command{
 		Name:        "order.out",
-		Usage:       "order.out <order_number>",
+		Usage:       "order.out [order_number]",
 		Group:       "Core / Orders",
-		Description: "Create outbound order.",
+		Description: "Create outbound order; allocates the next number from the OUTBOUND sequence if none is given.",
 		Run: func(ctx context.Context, args []string) error {
-			if len(args) != 1 {
-				return fmt.Errorf("usage: order.out <order_number>")
+			if len(args) > 1 {
+				return fmt.Errorf("usage: order.out [order_number]")
+			}
+			number := ""
+			if len(args) == 1 {
+				number = args[0]
 			}
 			orderService := coreorders.NewOrderService(clictx.AppCtx().Client())
-			_, err := orderService.CreateOutboundOrder(ctx, args[0])
+			if key := clictx.IdempotencyKey(); key != "" {
+				o, replayed, err := orderService.CreateOrderWithKey(ctx, key, number, coreorders.OrderTypeOutbound)
+				if err == nil && replayed {
+					fmt.Printf("Outbound order '%s' already created with idempotency key '%s'.\n", o.OrderNumber, key)
+				} else if err == nil {
+					fmt.Printf("Outbound order '%s' created successfully.\n", o.OrderNumber)
+				}
+				return err
+			}
+			if number == "" {
+				nr, err := orderService.NextOrderNumber(ctx, coreorders.OrderTypeOutbound)
+				if err != nil {
+					return err
+				}
+				number = nr
+			}
+			_, err := orderService.CreateOutboundOrder(ctx, number)
 			if err == nil {
-				fmt.Printf("Outbound order '%s' created successfully.\n", args[0])
+				fmt.Printf("Outbound order '%s' created successfully.\n", number)
 			}
 			return err
 		},
@@ -102,4 +143,22 @@ func init() {
 			return nil
 		},
 	})
+
+	registry.Register(registry.Command{
+		Name:        "order.reverse",
+		Usage:       "order.reverse <order_number> <reason>",
+		Group:       "Core / Orders",
+		Description: "Reverse a POSTED order: books counter-movements and marks it REVERSED.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) < 2 {
+				return fmt.Errorf("usage: order.reverse <order_number> <reason>")
+			}
+			orderService := coreorders.NewOrderService(clictx.AppCtx().Client())
+			if err := orderService.ReverseOrder(ctx, args[0], strings.Join(args[1:], " ")); err != nil {
+				return err
+			}
+			fmt.Printf("Order '%s' reversed successfully.\n", args[0])
+			return nil
+		},
+	})
 }
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	coreorders "github.com/mxV03/wms/internal/core/ordermanagement/orders"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/clictx"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/registry"
+)
+
+func init() {
+	registry.Register(registry.Command{
+		Name:        "order.import",
+		Usage:       "order.import <file.csv|file.json> [csv|json]",
+		Group:       "Core / Orders",
+		Description: "Bulk import orders with lines; each order is created all-or-nothing.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) < 1 || len(args) > 2 {
+				return fmt.Errorf("usage: order.import <file.csv|file.json> [csv|json]")
+			}
+			format := strings.TrimPrefix(strings.ToLower(filepath.Ext(args[0])), ".")
+			if len(args) == 2 {
+				format = args[1]
+			}
+
+			f, err := os.Open(args[0])
+			if err != nil {
+				return fmt.Errorf("open import file: %w", err)
+			}
+			defer f.Close()
+
+			orderService := coreorders.NewOrderService(clictx.AppCtx().Client())
+			rep, err := orderService.ImportOrders(ctx, f, format)
+			if err != nil {
+				return err
+			}
+			for _, r := range rep.Results {
+				fmt.Printf("row %-4d %-12s %-8s %s\n", r.Row, r.OrderNumber, r.Status, r.Message)
+			}
+			fmt.Printf("import finished: created=%d skipped=%d failed=%d\n", rep.Created, rep.Skipped, rep.Failed)
+			return nil
+		},
+	})
+}
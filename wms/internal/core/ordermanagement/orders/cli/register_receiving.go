@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	coreorders "github.com/mxV03/wms/internal/core/ordermanagement/orders"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/clictx"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/registry"
+)
+
+func init() {
+	registry.Register(registry.Command{
+		Name:        "order.receive",
+		Usage:       "order.receive <order_number> <line_id> <quantity> [damaged] [note]",
+		Group:       "Core / Receiving",
+		Description: "Record a receipt for an inbound order line; books only the counted good quantity.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) < 3 {
+				return fmt.Errorf("usage: order.receive <order_number> <line_id> <quantity> [damaged] [note]")
+			}
+			lineID, err := strconv.Atoi(args[1])
+			if err != nil {
+				return fmt.Errorf("line_id must be an integer")
+			}
+			qty, err := strconv.Atoi(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid quantity: %w", err)
+			}
+			damaged := 0
+			if len(args) >= 4 {
+				damaged, err = strconv.Atoi(args[3])
+				if err != nil {
+					return fmt.Errorf("invalid damaged quantity: %w", err)
+				}
+			}
+			note := ""
+			if len(args) >= 5 {
+				note = strings.Join(args[4:], " ")
+			}
+
+			orderService := coreorders.NewOrderService(clictx.AppCtx().Client())
+			if err := orderService.ReceiveLine(ctx, args[0], lineID, qty, damaged, note); err != nil {
+				return err
+			}
+			fmt.Printf("Receipt for order '%s' line %d recorded.\n", args[0], lineID)
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "order.receiving",
+		Usage:       "order.receiving <order_number>",
+		Group:       "Core / Receiving",
+		Description: "Show expected vs. received quantities of an inbound order.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("usage: order.receiving <order_number>")
+			}
+			orderService := coreorders.NewOrderService(clictx.AppCtx().Client())
+			lines, err := orderService.ReceivingStatus(ctx, args[0])
+			if err != nil {
+				return err
+			}
+			if len(lines) == 0 {
+				fmt.Println("no order lines found")
+				return nil
+			}
+			fmt.Printf("Order %s STATUS=%s\n", args[0], lines[0].Status)
+			for _, l := range lines {
+				printReceivingLine(l)
+			}
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "order.discrepancies",
+		Usage:       "order.discrepancies [limit]",
+		Group:       "Core / Receiving",
+		Description: "List over, short and damaged receipts of inbound orders.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) > 1 {
+				return fmt.Errorf("usage: order.discrepancies [limit]")
+			}
+			limit := 100
+			if len(args) == 1 {
+				v, err := strconv.Atoi(args[0])
+				if err != nil {
+					return fmt.Errorf("limit must be an integer")
+				}
+				limit = v
+			}
+			orderService := coreorders.NewOrderService(clictx.AppCtx().Client())
+			lines, err := orderService.Discrepancies(ctx, limit)
+			if err != nil {
+				return err
+			}
+			if len(lines) == 0 {
+				fmt.Println("no discrepancies found")
+				return nil
+			}
+			for _, l := range lines {
+				fmt.Printf("%s ", l.OrderNumber)
+				printReceivingLine(l)
+			}
+			return nil
+		},
+	})
+}
+
+func printReceivingLine(l coreorders.ReceivingLineDTO) {
+	fmt.Printf("  line %d: SKU=%s LOC=%s EXPECTED=%d RECEIVED=%d DAMAGED=%d OVER=%d SHORT=%d RECEIPTS=%d\n",
+		l.LineID, l.SKU, l.LocationCode, l.Expected, l.Received, l.Damaged, l.Over(), l.Short(), l.Receipts)
+}
@@ -0,0 +1,123 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	coreorders "github.com/mxV03/wms/internal/core/ordermanagement/orders"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/clictx"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/registry"
+)
+
+func init() {
+	registry.Register(registry.Command{
+		Name:        "order.return",
+		Usage:       "order.return [return_number] <original_order_number>",
+		Group:       "Core / Returns",
+		Description: "Create a RETURN order for a posted outbound order; allocates the number from the RETURN sequence if none is given.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) < 1 || len(args) > 2 {
+				return fmt.Errorf("usage: order.return [return_number] <original_order_number>")
+			}
+			orderService := coreorders.NewOrderService(clictx.AppCtx().Client())
+			number, original := "", args[len(args)-1]
+			if len(args) == 2 {
+				number = args[0]
+			} else {
+				nr, err := orderService.NextOrderNumber(ctx, coreorders.OrderTypeReturn)
+				if err != nil {
+					return err
+				}
+				number = nr
+			}
+			if _, err := orderService.CreateReturnOrder(ctx, number, original); err != nil {
+				return err
+			}
+			fmt.Printf("Return order '%s' for '%s' created successfully.\n", number, original)
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "return.show",
+		Usage:       "return.show <return_number>",
+		Group:       "Core / Returns",
+		Description: "Show return lines with inspection and disposition.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("usage: return.show <return_number>")
+			}
+			orderService := coreorders.NewOrderService(clictx.AppCtx().Client())
+			lines, err := orderService.ReturnLines(ctx, args[0])
+			if err != nil {
+				return err
+			}
+			if len(lines) == 0 {
+				fmt.Println("no return lines found")
+				return nil
+			}
+			for _, l := range lines {
+				fmt.Printf("line %d: SKU=%s LOC=%s QTY=%d CONDITION=%s DISPOSITION=%s\n",
+					l.Id, l.SKU, l.LocationCode, l.Quantity, dash(l.Condition), dash(l.Disposition))
+			}
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "return.inspect",
+		Usage:       "return.inspect <line_id> <condition>",
+		Group:       "Core / Returns",
+		Description: "Record the inspected condition of a return line (e.g. GOOD, DAMAGED).",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) < 2 {
+				return fmt.Errorf("usage: return.inspect <line_id> <condition>")
+			}
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("line_id must be an integer")
+			}
+			orderService := coreorders.NewOrderService(clictx.AppCtx().Client())
+			if err := orderService.InspectReturnLine(ctx, id, strings.Join(args[1:], " ")); err != nil {
+				return err
+			}
+			fmt.Printf("Return line %d inspected.\n", id)
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "return.dispose",
+		Usage:       "return.dispose <line_id> <RESTOCK|QUARANTINE|SCRAP> [location]",
+		Group:       "Core / Returns",
+		Description: "Book the disposition of an inspected return line.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) < 2 || len(args) > 3 {
+				return fmt.Errorf("usage: return.dispose <line_id> <RESTOCK|QUARANTINE|SCRAP> [location]")
+			}
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("line_id must be an integer")
+			}
+			loc := ""
+			if len(args) == 3 {
+				loc = args[2]
+			}
+			orderService := coreorders.NewOrderService(clictx.AppCtx().Client())
+			if err := orderService.DisposeReturnLine(ctx, id, args[1], loc); err != nil {
+				return err
+			}
+			fmt.Printf("Return line %d disposed as %s.\n", id, strings.ToUpper(args[1]))
+			return nil
+		},
+	})
+}
+
+func dash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,126 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	coreorders "github.com/mxV03/wms/internal/core/ordermanagement/orders"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/clictx"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/registry"
+)
+
+func init() {
+	registry.Register(registry.Command{
+		Name:        "order.priority",
+		Usage:       "order.priority <order_number> <LOW|NORMAL|HIGH|URGENT>",
+		Group:       "Core / Orders",
+		Description: "Set the priority of a DRAFT order.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 2 {
+				return fmt.Errorf("usage: order.priority <order_number> <LOW|NORMAL|HIGH|URGENT>")
+			}
+			orderService := coreorders.NewOrderService(clictx.AppCtx().Client())
+			if err := orderService.SetPriority(ctx, args[0], args[1]); err != nil {
+				return err
+			}
+			fmt.Printf("Order '%s' priority set.\n", args[0])
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "order.shipby",
+		Usage:       "order.shipby <order_number> <YYYY-MM-DD[THH:MM]>",
+		Group:       "Core / Orders",
+		Description: "Set the requested ship-by date of a DRAFT order.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 2 {
+				return fmt.Errorf("usage: order.shipby <order_number> <YYYY-MM-DD[THH:MM]>")
+			}
+			shipBy, err := coreorders.ParseShipBy(args[1])
+			if err != nil {
+				return err
+			}
+			orderService := coreorders.NewOrderService(clictx.AppCtx().Client())
+			if err := orderService.SetShipBy(ctx, args[0], shipBy); err != nil {
+				return err
+			}
+			fmt.Printf("Order '%s' ship-by set to %s.\n", args[0], shipBy.Format("2006-01-02 15:04"))
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "order.queue",
+		Usage:       "order.queue [limit]",
+		Group:       "Core / Orders",
+		Description: "List open outbound orders by urgency (overdue, priority, ship-by).",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) > 1 {
+				return fmt.Errorf("usage: order.queue [limit]")
+			}
+			limit := 100
+			if len(args) == 1 {
+				v, err := strconv.Atoi(args[0])
+				if err != nil {
+					return fmt.Errorf("limit must be an integer")
+				}
+				limit = v
+			}
+
+			orderService := coreorders.NewOrderService(clictx.AppCtx().Client())
+			queue, err := orderService.Queue(ctx, time.Now(), limit)
+			if err != nil {
+				return err
+			}
+			if len(queue) == 0 {
+				fmt.Println("no open outbound orders")
+				return nil
+			}
+			for i, q := range queue {
+				flag := ""
+				if q.Overdue {
+					flag = "  OVERDUE"
+				}
+				fmt.Printf("%3d. %-12s  %-7s  ship_by=%s  lines=%d%s\n",
+					i+1, q.Number, q.Priority, formatShipBy(q.ShipBy), q.Lines, flag)
+			}
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "order.sla",
+		Usage:       "order.sla",
+		Group:       "Core / Orders",
+		Description: "List open outbound orders past their ship-by date.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 0 {
+				return fmt.Errorf("usage: order.sla")
+			}
+			orderService := coreorders.NewOrderService(clictx.AppCtx().Client())
+			breaches, err := orderService.SLABreaches(ctx, time.Now())
+			if err != nil {
+				return err
+			}
+			if len(breaches) == 0 {
+				fmt.Println("no SLA breaches")
+				return nil
+			}
+			for _, b := range breaches {
+				fmt.Printf("BREACH %-12s  %-7s  ship_by=%s  overdue=%s\n",
+					b.Number, b.Priority, formatShipBy(b.ShipBy), time.Since(*b.ShipBy).Round(time.Minute))
+			}
+			return nil
+		},
+	})
+}
+
+func formatShipBy(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return t.Format("2006-01-02 15:04")
+}
@@ -0,0 +1,363 @@
+package orders
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mxV03/wms/ent/item"
+	"github.com/mxV03/wms/ent/location"
+	"github.com/mxV03/wms/ent/order"
+	"github.com/mxV03/wms/internal/auditlog"
+)
+
+var ErrInvalidImportFormat = fmt.Errorf("invalid import format (csv or json)")
+
+const (
+	ImportCreated = "CREATED"
+	ImportSkipped = "SKIPPED"
+	ImportFailed  = "ERROR"
+)
+
+// ImportResult is one entry of the per-row import report. For CSV files Row
+// is the line number in the file, for JSON files the 1-based index of the
+// order in the array.
+type ImportResult struct {
+	Row         int
+	OrderNumber string
+	Status      string
+	Message     string
+}
+
+type ImportReport struct {
+	Created int
+	Skipped int
+	Failed  int
+	Results []ImportResult
+}
+
+type importLine struct {
+	Row      int
+	SKU      string
+	Location string
+	Quantity int
+}
+
+type importOrder struct {
+	Row      int
+	Number   string
+	Type     string
+	Priority string
+	ShipBy   string
+	Lines    []importLine
+	errs     []ImportResult
+}
+
+type jsonImportOrder struct {
+	OrderNumber string `json:"order_number"`
+	Type        string `json:"type"`
+	Priority    string `json:"priority"`
+	ShipBy      string `json:"ship_by"`
+	Lines       []struct {
+		SKU      string `json:"sku"`
+		Location string `json:"location"`
+		Quantity int    `json:"quantity"`
+	} `json:"lines"`
+}
+
+// ImportOrders reads orders from CSV or JSON, validates all SKUs and
+// locations up front and creates every order with its lines in its own
+// transaction. Orders whose number already exists are skipped; an order with
+// any invalid row is not created at all.
+//
+// CSV files need a header with order_number, sku, location and quantity and
+// may add type, priority and ship_by; rows with the same order_number form
+// one order. JSON files hold an array of orders with nested lines.
+func (s *OrderService) ImportOrders(ctx context.Context, r io.Reader, format string) (*ImportReport, error) {
+	var (
+		batch []*importOrder
+		err   error
+	)
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case "csv":
+		batch, err = parseImportCSV(r)
+	case "json":
+		batch, err = parseImportJSON(r)
+	default:
+		return nil, ErrInvalidImportFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validateImport(ctx, batch); err != nil {
+		return nil, err
+	}
+
+	rep := &ImportReport{}
+	for _, o := range batch {
+		if len(o.errs) > 0 {
+			sort.SliceStable(o.errs, func(i, j int) bool { return o.errs[i].Row < o.errs[j].Row })
+			rep.Failed++
+			rep.Results = append(rep.Results, o.errs...)
+			continue
+		}
+
+		exists, err := s.client.Order.Query().
+			Where(order.OrderNumber(o.Number)).
+			Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("checking order existence: %w", err)
+		}
+		if exists {
+			rep.Skipped++
+			rep.Results = append(rep.Results, ImportResult{Row: o.Row, OrderNumber: o.Number, Status: ImportSkipped, Message: ErrOrderExists.Error()})
+			continue
+		}
+
+		if err := s.createImported(ctx, o); err != nil {
+			rep.Failed++
+			rep.Results = append(rep.Results, ImportResult{Row: o.Row, OrderNumber: o.Number, Status: ImportFailed, Message: err.Error()})
+			continue
+		}
+		rep.Created++
+		rep.Results = append(rep.Results, ImportResult{
+			Row:         o.Row,
+			OrderNumber: o.Number,
+			Status:      ImportCreated,
+			Message:     fmt.Sprintf("%s with %d line(s)", o.Type, len(o.Lines)),
+		})
+	}
+	return rep, nil
+}
+
+func (s *OrderService) createImported(ctx context.Context, o *importOrder) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	txSvc := NewOrderService(tx.Client())
+	created, err := txSvc.create(ctx, o.Number, o.Type, nil, "")
+	if err != nil {
+		return err
+	}
+
+	upd := tx.Order.UpdateOneID(created.ID).SetPriority(o.Priority)
+	if o.ShipBy != "" {
+		shipBy, err := ParseShipBy(o.ShipBy)
+		if err != nil {
+			return err
+		}
+		upd.SetShipBy(shipBy)
+	}
+	if err := upd.Exec(ctx); err != nil {
+		return fmt.Errorf("updating order: %w", err)
+	}
+
+	for _, l := range o.Lines {
+		if _, err := txSvc.AddLine(ctx, o.Number, l.SKU, l.Location, l.Quantity); err != nil {
+			return fmt.Errorf("row %d: %w", l.Row, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	auditlog.Logf(ctx, "order.import", "order", o.Number, "type=%s lines=%d", o.Type, len(o.Lines))
+	return nil
+}
+
+// validateImport checks all rows against the database in one pass and
+// records row errors on the affected orders.
+func (s *OrderService) validateImport(ctx context.Context, batch []*importOrder) error {
+	skus := map[string]bool{}
+	locs := map[string]bool{}
+	for _, o := range batch {
+		for _, l := range o.Lines {
+			skus[l.SKU] = false
+			locs[l.Location] = false
+		}
+	}
+
+	if len(skus) > 0 {
+		items, err := s.client.Item.Query().Where(item.SKUIn(mapKeys(skus)...)).All(ctx)
+		if err != nil {
+			return fmt.Errorf("fetching items: %w", err)
+		}
+		for _, it := range items {
+			skus[it.SKU] = true
+		}
+	}
+	if len(locs) > 0 {
+		ls, err := s.client.Location.Query().Where(location.CodeIn(mapKeys(locs)...)).All(ctx)
+		if err != nil {
+			return fmt.Errorf("fetching locations: %w", err)
+		}
+		for _, l := range ls {
+			locs[l.Code] = true
+		}
+	}
+
+	for _, o := range batch {
+		fail := func(row int, msg string) {
+			o.errs = append(o.errs, ImportResult{Row: row, OrderNumber: o.Number, Status: ImportFailed, Message: msg})
+		}
+
+		if o.Number == "" {
+			fail(o.Row, ErrInvalidOrderNo.Error())
+		}
+		if o.Type != string(OrderTypeInbound) && o.Type != string(OrderTypeOutbound) {
+			fail(o.Row, fmt.Sprintf("%s: %q", ErrInvalidOrderType, o.Type))
+		}
+		if p, err := NormalizePriority(o.Priority); err != nil {
+			fail(o.Row, fmt.Sprintf("%s: %q", err, o.Priority))
+		} else {
+			o.Priority = string(p)
+		}
+		if o.ShipBy != "" {
+			if _, err := ParseShipBy(o.ShipBy); err != nil {
+				fail(o.Row, fmt.Sprintf("%s: %q", err, o.ShipBy))
+			}
+		}
+		if len(o.Lines) == 0 {
+			fail(o.Row, ErrNoLines.Error())
+		}
+		for _, l := range o.Lines {
+			if !skus[l.SKU] {
+				fail(l.Row, fmt.Sprintf("unknown SKU %q", l.SKU))
+			}
+			if !locs[l.Location] {
+				fail(l.Row, fmt.Sprintf("unknown location %q", l.Location))
+			}
+			if l.Quantity <= 0 {
+				fail(l.Row, ErrInvalidQuantity.Error())
+			}
+		}
+	}
+	return nil
+}
+
+func parseImportCSV(r io.Reader) ([]*importOrder, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading csv header: %w", err)
+	}
+	col := map[string]int{}
+	for i, h := range header {
+		col[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	for _, required := range []string{"order_number", "sku", "location", "quantity"} {
+		if _, ok := col[required]; !ok {
+			return nil, fmt.Errorf("csv header is missing column %q", required)
+		}
+	}
+	get := func(rec []string, name string) string {
+		i, ok := col[name]
+		if !ok || i >= len(rec) {
+			return ""
+		}
+		return strings.TrimSpace(rec[i])
+	}
+
+	var batch []*importOrder
+	byNumber := map[string]*importOrder{}
+	row := 1
+	for {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		row++
+		if err != nil {
+			return nil, fmt.Errorf("reading csv row %d: %w", row, err)
+		}
+
+		number := get(rec, "order_number")
+		o, ok := byNumber[number]
+		if !ok {
+			o = &importOrder{
+				Row:      row,
+				Number:   number,
+				Type:     defaultString(strings.ToUpper(get(rec, "type")), string(OrderTypeOutbound)),
+				Priority: defaultString(get(rec, "priority"), string(PriorityNormal)),
+				ShipBy:   get(rec, "ship_by"),
+			}
+			byNumber[number] = o
+			batch = append(batch, o)
+		} else if t := strings.ToUpper(get(rec, "type")); t != "" && t != o.Type {
+			o.errs = append(o.errs, ImportResult{Row: row, OrderNumber: number, Status: ImportFailed, Message: fmt.Sprintf("conflicting type %q for order", t)})
+		}
+
+		qty, err := strconv.Atoi(get(rec, "quantity"))
+		if err != nil {
+			o.errs = append(o.errs, ImportResult{Row: row, OrderNumber: number, Status: ImportFailed, Message: fmt.Sprintf("invalid quantity %q", get(rec, "quantity"))})
+			continue
+		}
+		o.Lines = append(o.Lines, importLine{
+			Row:      row,
+			SKU:      get(rec, "sku"),
+			Location: get(rec, "location"),
+			Quantity: qty,
+		})
+	}
+	return batch, nil
+}
+
+func parseImportJSON(r io.Reader) ([]*importOrder, error) {
+	var in []jsonImportOrder
+	if err := json.NewDecoder(r).Decode(&in); err != nil {
+		return nil, fmt.Errorf("decoding json: %w", err)
+	}
+
+	batch := make([]*importOrder, 0, len(in))
+	seen := map[string]bool{}
+	for i, jo := range in {
+		o := &importOrder{
+			Row:      i + 1,
+			Number:   strings.TrimSpace(jo.OrderNumber),
+			Type:     defaultString(strings.ToUpper(strings.TrimSpace(jo.Type)), string(OrderTypeOutbound)),
+			Priority: defaultString(strings.TrimSpace(jo.Priority), string(PriorityNormal)),
+			ShipBy:   strings.TrimSpace(jo.ShipBy),
+		}
+		if seen[o.Number] {
+			o.errs = append(o.errs, ImportResult{Row: o.Row, OrderNumber: o.Number, Status: ImportFailed, Message: "duplicate order number in file"})
+		}
+		seen[o.Number] = true
+		for _, l := range jo.Lines {
+			o.Lines = append(o.Lines, importLine{
+				Row:      o.Row,
+				SKU:      strings.TrimSpace(l.SKU),
+				Location: strings.TrimSpace(l.Location),
+				Quantity: l.Quantity,
+			})
+		}
+		batch = append(batch, o)
+	}
+	return batch, nil
+}
+
+func defaultString(v, def string) string {
+	if v == "" {
+		return def
+	}
+	return v
+}
+
+func mapKeys(m map[string]bool) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	return out
+}
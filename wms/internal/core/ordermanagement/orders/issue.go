@@ -0,0 +1,112 @@
+package orders
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mxV03/wms/ent"
+	"github.com/mxV03/wms/ent/order"
+	"github.com/mxV03/wms/ent/stockmovement"
+	"github.com/mxV03/wms/internal/core/inventory/stock"
+)
+
+var ErrAlreadyIssued = fmt.Errorf("stock for order has already been issued")
+
+// PickedLine is a quantity taken from a location for an outbound order.
+type PickedLine struct {
+	SKU      string
+	Location string
+	Quantity int
+}
+
+// PostingRef is the stock movement reference used when an order is posted.
+func PostingRef(number string) string {
+	return "ORDER-" + number
+}
+
+// ShippedQuantities sums the stock issued for an outbound order per SKU.
+// After short picks this is less than the ordered quantity.
+func (s *OrderService) ShippedQuantities(ctx context.Context, number string) (map[string]int, error) {
+	moves, err := s.client.StockMovement.Query().
+		Where(
+			stockmovement.Reference(PostingRef(number)),
+			stockmovement.TypeEQ(string(stock.MovementTypeOut)),
+		).
+		WithItem().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching movements: %w", err)
+	}
+	out := map[string]int{}
+	for _, m := range moves {
+		out[m.Edges.Item.SKU] += m.Quantity
+	}
+	return out, nil
+}
+
+// IssuePicked posts a DRAFT outbound order with the quantities actually
+// picked instead of the ordered ones. It does not open a transaction; callers
+// create the service on a transaction client so the issue commits together
+// with their own changes, and log order.posted once that is committed. An
+// order whose stock was already issued, by PostOrder or an earlier call, is
+// refused. Picked stock is issued even from a location put on hold since the
+// order was released.
+func (s *OrderService) IssuePicked(ctx context.Context, number string, picks []PickedLine) error {
+	number = strings.TrimSpace(number)
+	if number == "" {
+		return ErrInvalidOrderNo
+	}
+
+	o, err := s.client.Order.Query().
+		Where(order.OrderNumber(number)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrOrderNotFound
+		}
+		return fmt.Errorf("fetching order: %w", err)
+	}
+	if o.Type != string(OrderTypeOutbound) {
+		return ErrInvalidOrderType
+	}
+	if o.Status != string(OrderStatusDraft) {
+		return ErrAlreadyIssued
+	}
+
+	ref := PostingRef(number)
+	issued, err := s.client.StockMovement.Query().
+		Where(stockmovement.Reference(ref)).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("checking movements: %w", err)
+	}
+	if issued {
+		return ErrAlreadyIssued
+	}
+
+	stockSvc := stock.NewStockService(s.client)
+	for _, p := range picks {
+		if p.Quantity == 0 {
+			continue
+		}
+		if err := stockSvc.OUT(stock.Settling(ctx), p.SKU, p.Location, p.Quantity, ref); err != nil {
+			return err
+		}
+	}
+
+	// Conditional on DRAFT so a concurrent post cannot issue the order again.
+	n, err := s.client.Order.Update().
+		Where(order.ID(o.ID), order.Status(string(OrderStatusDraft))).
+		SetStatus(string(OrderStatusPosted)).
+		SetPostedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("updating order status: %w", err)
+	}
+	if n == 0 {
+		return ErrAlreadyIssued
+	}
+	return nil
+}
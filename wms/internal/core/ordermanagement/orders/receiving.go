@@ -0,0 +1,231 @@
+package orders
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mxV03/wms/ent"
+	"github.com/mxV03/wms/ent/order"
+	"github.com/mxV03/wms/ent/orderline"
+	"github.com/mxV03/wms/internal/auditlog"
+	"github.com/mxV03/wms/internal/core/inventory/stock"
+	"github.com/mxV03/wms/internal/putaway"
+)
+
+var ErrNotInbound = fmt.Errorf("order is not an inbound order")
+
+type ReceivingLineDTO struct {
+	LineID       int
+	OrderNumber  string
+	Status       string
+	SKU          string
+	LocationCode string
+	Expected     int
+	Received     int
+	Damaged      int
+	Receipts     int
+}
+
+// Over returns the units counted in excess of the expected quantity.
+func (d ReceivingLineDTO) Over() int {
+	if n := d.Received + d.Damaged - d.Expected; n > 0 {
+		return n
+	}
+	return 0
+}
+
+// Short returns the units still missing against the expected quantity.
+func (d ReceivingLineDTO) Short() int {
+	if n := d.Expected - d.Received - d.Damaged; n > 0 {
+		return n
+	}
+	return 0
+}
+
+func (d ReceivingLineDTO) HasDiscrepancy() bool {
+	return d.Over() > 0 || d.Short() > 0 || d.Damaged > 0
+}
+
+// ReceiveLine records a physical receipt for one line of an inbound order.
+// Only the units counted in good condition are booked as stock and, with
+// logistics, get a putaway task; damaged units are recorded on the receipt
+// and show up as a discrepancy. The order moves to
+// PARTIALLY_RECEIVED until every line has been counted in full, then RECEIVED.
+func (s *OrderService) ReceiveLine(ctx context.Context, number string, lineID, quantity, damaged int, note string) error {
+	number = strings.TrimSpace(number)
+	note = strings.TrimSpace(note)
+	if number == "" {
+		return ErrInvalidOrderNo
+	}
+	if quantity < 0 || damaged < 0 || quantity+damaged == 0 {
+		return ErrInvalidQuantity
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+	txCtx := auditlog.InTx(ctx, tx)
+
+	o, err := tx.Order.Query().
+		Where(order.OrderNumber(number)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrOrderNotFound
+		}
+		return fmt.Errorf("fetching order: %w", err)
+	}
+	if o.Type != string(OrderTypeInbound) {
+		return ErrNotInbound
+	}
+	if o.Status != string(OrderStatusDraft) && o.Status != string(OrderStatusPartiallyReceived) {
+		return ErrInvalidStatus
+	}
+
+	l, err := tx.OrderLine.Query().
+		Where(orderline.ID(lineID), orderline.HasOrderWith(order.ID(o.ID))).
+		WithItem().
+		WithLocation().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrLineNotFound
+		}
+		return fmt.Errorf("fetching order line: %w", err)
+	}
+
+	if quantity > 0 {
+		stockSvc := stock.NewStockService(tx.Client())
+		if err := stockSvc.IN(txCtx, l.Edges.Item.SKU, l.Edges.Location.Code, quantity, "RECEIPT-"+number); err != nil {
+			return err
+		}
+		if err := putaway.Received(ctx, tx.Client(), l.Edges.Item.SKU, l.Edges.Location.Code, quantity, number); err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.Receipt.Create().
+		SetOrderLine(l).
+		SetQuantity(quantity).
+		SetDamaged(damaged).
+		SetNote(note).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("creating receipt: %w", err)
+	}
+
+	lines, err := receivingLines(ctx, tx.Client(), o)
+	if err != nil {
+		return err
+	}
+	status := OrderStatusReceived
+	for _, rl := range lines {
+		if rl.Short() > 0 {
+			status = OrderStatusPartiallyReceived
+			break
+		}
+	}
+	if err := tx.Order.UpdateOneID(o.ID).SetStatus(string(status)).Exec(ctx); err != nil {
+		return fmt.Errorf("updating order status: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	auditlog.Logf(ctx, "order.receive", "order", number, "line=%d qty=%d damaged=%d status=%s", lineID, quantity, damaged, status)
+	return nil
+}
+
+// ReceivingStatus lists expected and counted quantities for every line of an inbound order.
+func (s *OrderService) ReceivingStatus(ctx context.Context, number string) ([]ReceivingLineDTO, error) {
+	number = strings.TrimSpace(number)
+	if number == "" {
+		return nil, ErrInvalidOrderNo
+	}
+
+	o, err := s.client.Order.Query().
+		Where(order.OrderNumber(number)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, fmt.Errorf("fetching order: %w", err)
+	}
+	if o.Type != string(OrderTypeInbound) {
+		return nil, ErrNotInbound
+	}
+	return receivingLines(ctx, s.client, o)
+}
+
+// Discrepancies lists over, short and damaged lines of all inbound orders that
+// are being received or have been received.
+func (s *OrderService) Discrepancies(ctx context.Context, limit int) ([]ReceivingLineDTO, error) {
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+
+	inbound, err := s.client.Order.Query().
+		Where(
+			order.Type(string(OrderTypeInbound)),
+			order.StatusIn(string(OrderStatusPartiallyReceived), string(OrderStatusReceived)),
+		).
+		Order(ent.Desc(order.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching orders: %w", err)
+	}
+
+	out := make([]ReceivingLineDTO, 0)
+	for _, o := range inbound {
+		lines, err := receivingLines(ctx, s.client, o)
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range lines {
+			if !l.HasDiscrepancy() {
+				continue
+			}
+			out = append(out, l)
+			if len(out) == limit {
+				return out, nil
+			}
+		}
+	}
+	return out, nil
+}
+
+func receivingLines(ctx context.Context, client *ent.Client, o *ent.Order) ([]ReceivingLineDTO, error) {
+	lines, err := client.OrderLine.Query().
+		Where(orderline.HasOrderWith(order.ID(o.ID))).
+		WithItem().
+		WithLocation().
+		WithReceipts().
+		Order(ent.Asc(orderline.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching order lines: %w", err)
+	}
+
+	out := make([]ReceivingLineDTO, 0, len(lines))
+	for _, l := range lines {
+		dto := ReceivingLineDTO{
+			LineID:       l.ID,
+			OrderNumber:  o.OrderNumber,
+			Status:       o.Status,
+			SKU:          l.Edges.Item.SKU,
+			LocationCode: l.Edges.Location.Code,
+			Expected:     l.Quantity,
+			Receipts:     len(l.Edges.Receipts),
+		}
+		for _, r := range l.Edges.Receipts {
+			dto.Received += r.Quantity
+			dto.Damaged += r.Damaged
+		}
+		out = append(out, dto)
+	}
+	return out, nil
+}
@@ -0,0 +1,331 @@
+package orders
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mxV03/wms/ent"
+	"github.com/mxV03/wms/ent/item"
+	"github.com/mxV03/wms/ent/order"
+	"github.com/mxV03/wms/ent/orderline"
+	"github.com/mxV03/wms/internal/auditlog"
+	"github.com/mxV03/wms/internal/core/inventory/stock"
+)
+
+var (
+	ErrOriginalNotShipped   = fmt.Errorf("original order is not a posted outbound order")
+	ErrReturnExceedsShipped = fmt.Errorf("return quantity exceeds shipped quantity")
+	ErrLineNotFound         = fmt.Errorf("order line not found")
+	ErrNotAReturn           = fmt.Errorf("order line does not belong to a return order")
+	ErrInvalidCondition     = fmt.Errorf("invalid condition")
+	ErrInvalidDisposition   = fmt.Errorf("invalid disposition")
+	ErrNotInspected         = fmt.Errorf("return line has not been inspected")
+	ErrAlreadyDisposed      = fmt.Errorf("return line already disposed")
+)
+
+type Disposition string
+
+const (
+	DispositionRestock    Disposition = "RESTOCK"
+	DispositionQuarantine Disposition = "QUARANTINE"
+	DispositionScrap      Disposition = "SCRAP"
+)
+
+func NormalizeDisposition(d string) (Disposition, error) {
+	switch Disposition(strings.ToUpper(strings.TrimSpace(d))) {
+	case DispositionRestock:
+		return DispositionRestock, nil
+	case DispositionQuarantine:
+		return DispositionQuarantine, nil
+	case DispositionScrap:
+		return DispositionScrap, nil
+	default:
+		return "", ErrInvalidDisposition
+	}
+}
+
+// CreateReturnOrder creates a RETURN order for goods shipped with a posted outbound order.
+func (s *OrderService) CreateReturnOrder(ctx context.Context, number, originalNumber string) (*ent.Order, error) {
+	number = strings.TrimSpace(number)
+	originalNumber = strings.TrimSpace(originalNumber)
+	if number == "" || originalNumber == "" {
+		return nil, ErrInvalidOrderNo
+	}
+
+	original, err := s.client.Order.Query().
+		Where(order.OrderNumber(originalNumber)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, fmt.Errorf("fetching original order: %w", err)
+	}
+	if original.Type != string(OrderTypeOutbound) || original.Status != string(OrderStatusPosted) {
+		return nil, ErrOriginalNotShipped
+	}
+
+	return s.create(ctx, number, string(OrderTypeReturn), original, "")
+}
+
+// addReturnLine checks the quantity against what was shipped and inserts the
+// line in one transaction, so lines added concurrently to returns of the same
+// order cannot both pass the check.
+func (s *OrderService) addReturnLine(ctx context.Context, returnOrder *ent.Order, itm *ent.Item, loc *ent.Location, quantity int) (*ent.OrderLine, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := NewOrderService(tx.Client()).checkReturnQuantity(ctx, returnOrder, itm, quantity); err != nil {
+		return nil, err
+	}
+
+	createdLine, err := tx.OrderLine.Create().
+		SetOrder(returnOrder).
+		SetItem(itm).
+		SetLocation(loc).
+		SetQuantity(quantity).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("creating order line: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+	return createdLine, nil
+}
+
+// checkReturnQuantity makes sure that all open returns for the original order
+// never exceed the quantity shipped per item, i.e. the stock issued for it.
+func (s *OrderService) checkReturnQuantity(ctx context.Context, returnOrder *ent.Order, itm *ent.Item, quantity int) error {
+	original, err := s.client.Order.Query().
+		Where(order.HasReturnsWith(order.ID(returnOrder.ID))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrOriginalNotShipped
+		}
+		return fmt.Errorf("fetching original order: %w", err)
+	}
+
+	issued, err := s.ShippedQuantities(ctx, original.OrderNumber)
+	if err != nil {
+		return err
+	}
+	shipped := issued[itm.SKU]
+
+	returned, err := sumLineQuantity(ctx, s.client.OrderLine.Query().
+		Where(
+			orderline.HasOrderWith(
+				order.HasReturnOfWith(order.ID(original.ID)),
+				order.StatusNEQ(string(OrderStatusCancelled)),
+			),
+			orderline.HasItemWith(item.ID(itm.ID)),
+		))
+	if err != nil {
+		return err
+	}
+
+	if returned+quantity > shipped {
+		return fmt.Errorf("%w: shipped=%d returned=%d requested=%d", ErrReturnExceedsShipped, shipped, returned, quantity)
+	}
+	return nil
+}
+
+func sumLineQuantity(ctx context.Context, q *ent.OrderLineQuery) (int, error) {
+	lines, err := q.All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("fetching order lines: %w", err)
+	}
+	total := 0
+	for _, l := range lines {
+		total += l.Quantity
+	}
+	return total, nil
+}
+
+func (s *OrderService) ReturnLines(ctx context.Context, number string) ([]OrderLineDTO, error) {
+	number = strings.TrimSpace(number)
+	if number == "" {
+		return nil, ErrInvalidOrderNo
+	}
+
+	o, err := s.client.Order.Query().
+		Where(order.OrderNumber(number)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, fmt.Errorf("fetching order: %w", err)
+	}
+	if o.Type != string(OrderTypeReturn) {
+		return nil, ErrInvalidOrderType
+	}
+
+	lines, err := s.client.OrderLine.Query().
+		Where(orderline.HasOrderWith(order.ID(o.ID))).
+		WithItem().
+		WithLocation().
+		Order(ent.Asc(orderline.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching order lines: %w", err)
+	}
+
+	out := make([]OrderLineDTO, 0, len(lines))
+	for _, l := range lines {
+		out = append(out, OrderLineDTO{
+			Id:           l.ID,
+			OrderNumber:  o.OrderNumber,
+			SKU:          l.Edges.Item.SKU,
+			LocationCode: l.Edges.Location.Code,
+			Quantity:     l.Quantity,
+			Condition:    l.Condition,
+			Disposition:  l.Disposition,
+		})
+	}
+	return out, nil
+}
+
+// InspectReturnLine records the condition of returned goods.
+func (s *OrderService) InspectReturnLine(ctx context.Context, lineID int, condition string) error {
+	condition = strings.ToUpper(strings.TrimSpace(condition))
+	if condition == "" {
+		return ErrInvalidCondition
+	}
+
+	l, err := s.client.OrderLine.Query().
+		Where(orderline.ID(lineID)).
+		WithOrder().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrLineNotFound
+		}
+		return fmt.Errorf("fetching order line: %w", err)
+	}
+	if l.Edges.Order.Type != string(OrderTypeReturn) {
+		return ErrNotAReturn
+	}
+	if l.Edges.Order.Status != string(OrderStatusDraft) {
+		return ErrInvalidStatus
+	}
+	if l.Disposition != "" {
+		return ErrAlreadyDisposed
+	}
+
+	err = s.client.OrderLine.UpdateOneID(l.ID).
+		SetCondition(condition).
+		SetInspectedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("updating order line: %w", err)
+	}
+	auditlog.Logf(ctx, "return.inspect", "order_line", l.Edges.Order.OrderNumber, "line=%d condition=%s", l.ID, condition)
+	return nil
+}
+
+// DisposeReturnLine books the movements for an inspected return line.
+// RESTOCK and QUARANTINE receive the goods at the given location (RESTOCK
+// defaults to the line location), SCRAP books an IN and OUT at the line
+// location so the write-off stays visible in the ledger. The return order is
+// marked POSTED once every line has a disposition.
+func (s *OrderService) DisposeReturnLine(ctx context.Context, lineID int, disposition, locCode string) error {
+	d, err := NormalizeDisposition(disposition)
+	if err != nil {
+		return err
+	}
+	locCode = strings.TrimSpace(locCode)
+	if d == DispositionQuarantine && locCode == "" {
+		return fmt.Errorf("quarantine requires a location")
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+	txCtx := auditlog.InTx(ctx, tx)
+
+	l, err := tx.OrderLine.Query().
+		Where(orderline.ID(lineID)).
+		WithOrder().
+		WithItem().
+		WithLocation().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrLineNotFound
+		}
+		return fmt.Errorf("fetching order line: %w", err)
+	}
+	o := l.Edges.Order
+	if o.Type != string(OrderTypeReturn) {
+		return ErrNotAReturn
+	}
+	if o.Status != string(OrderStatusDraft) {
+		return ErrInvalidStatus
+	}
+	if l.InspectedAt == nil {
+		return ErrNotInspected
+	}
+	if l.Disposition != "" {
+		return ErrAlreadyDisposed
+	}
+
+	sku := l.Edges.Item.SKU
+	if locCode == "" {
+		locCode = l.Edges.Location.Code
+	}
+
+	stockSvc := stock.NewStockService(tx.Client())
+	switch d {
+	case DispositionRestock:
+		if err := stockSvc.IN(txCtx, sku, locCode, l.Quantity, "RETURN-"+o.OrderNumber); err != nil {
+			return err
+		}
+	case DispositionQuarantine:
+		if err := stockSvc.IN(txCtx, sku, locCode, l.Quantity, "QUARANTINE-"+o.OrderNumber); err != nil {
+			return err
+		}
+	case DispositionScrap:
+		ref := "SCRAP-" + o.OrderNumber
+		if err := stockSvc.IN(txCtx, sku, locCode, l.Quantity, ref); err != nil {
+			return err
+		}
+		if err := stockSvc.OUT(stock.Settling(txCtx), sku, locCode, l.Quantity, ref); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.OrderLine.UpdateOneID(l.ID).SetDisposition(string(d)).Exec(ctx); err != nil {
+		return fmt.Errorf("updating order line: %w", err)
+	}
+
+	pending, err := tx.OrderLine.Query().
+		Where(
+			orderline.HasOrderWith(order.ID(o.ID)),
+			orderline.Or(orderline.DispositionIsNil(), orderline.DispositionEQ("")),
+		).
+		Count(ctx)
+	if err != nil {
+		return fmt.Errorf("counting open return lines: %w", err)
+	}
+	if pending == 0 {
+		if err := tx.Order.UpdateOneID(o.ID).SetStatus(string(OrderStatusPosted)).SetPostedAt(time.Now()).Exec(ctx); err != nil {
+			return fmt.Errorf("updating order status: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	auditlog.Logf(ctx, "return.dispose", "order_line", o.OrderNumber, "line=%d disposition=%s loc=%s", l.ID, d, locCode)
+	return nil
+}
@@ -0,0 +1,127 @@
+package orders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mxV03/wms/ent"
+	"github.com/mxV03/wms/ent/order"
+	"github.com/mxV03/wms/ent/stockmovement"
+	"github.com/mxV03/wms/internal/auditlog"
+	"github.com/mxV03/wms/internal/core/inventory/stock"
+	"github.com/mxV03/wms/internal/putaway"
+)
+
+var (
+	ErrReversalReason   = fmt.Errorf("reversal requires a reason")
+	ErrReversalNegative = fmt.Errorf("reversal would make stock negative")
+	ErrHasReturns       = fmt.Errorf("order has open or posted returns")
+)
+
+// ReverseOrder undoes a posted INBOUND or OUTBOUND order by booking the
+// opposite of every movement posted for it with reference REVERSAL-ORDER-<nr>
+// and marks the order REVERSED. Movements rather than lines are reversed, as
+// an order issued from a picklist books the picked quantities. Like PostOrder
+// it runs in one transaction; if any line would take stock below zero nothing
+// is booked.
+func (s *OrderService) ReverseOrder(ctx context.Context, number, reason string) error {
+	number = strings.TrimSpace(number)
+	reason = strings.TrimSpace(reason)
+	if number == "" {
+		return ErrInvalidOrderNo
+	}
+	if reason == "" {
+		return ErrReversalReason
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+	txCtx := auditlog.InTx(ctx, tx)
+
+	o, err := tx.Order.Query().
+		Where(order.OrderNumber(number)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrOrderNotFound
+		}
+		return fmt.Errorf("fetching order: %w", err)
+	}
+	if o.Status != string(OrderStatusPosted) {
+		return ErrInvalidStatus
+	}
+	if o.Type != string(OrderTypeInbound) && o.Type != string(OrderTypeOutbound) {
+		return ErrInvalidOrderType
+	}
+
+	// Goods that came back on a return are booked against the original
+	// shipment; reversing it would count them twice.
+	returns, err := tx.Order.Query().
+		Where(
+			order.HasReturnOfWith(order.ID(o.ID)),
+			order.StatusNEQ(string(OrderStatusCancelled)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("checking returns: %w", err)
+	}
+	if returns {
+		return ErrHasReturns
+	}
+
+	movements, err := tx.StockMovement.Query().
+		Where(stockmovement.Reference(PostingRef(number))).
+		WithItem().
+		WithLocation().
+		Order(ent.Asc(stockmovement.FieldID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("fetching movements: %w", err)
+	}
+
+	ref := "REVERSAL-" + PostingRef(number)
+	stockSvc := stock.NewStockService(tx.Client())
+	for _, m := range movements {
+		sku := m.Edges.Item.SKU
+		locCode := m.Edges.Location.Code
+
+		if m.Type == string(stock.MovementTypeOut) {
+			if err := stockSvc.IN(txCtx, sku, locCode, m.Quantity, ref); err != nil {
+				return err
+			}
+		} else {
+			if err := stockSvc.OUT(stock.Settling(txCtx), sku, locCode, m.Quantity, ref); err != nil {
+				if errors.Is(err, stock.ErrInsufficientStock) {
+					return fmt.Errorf("%w: %s at %s", ErrReversalNegative, sku, locCode)
+				}
+				return err
+			}
+		}
+	}
+
+	if o.Type == string(OrderTypeInbound) {
+		if err := putaway.Reversed(ctx, tx.Client(), number); err != nil {
+			return err
+		}
+	}
+
+	err = tx.Order.UpdateOneID(o.ID).
+		SetStatus(string(OrderStatusReversed)).
+		SetReversedAt(time.Now()).
+		SetReversalReason(reason).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("updating order status: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	auditlog.Logf(ctx, "order.reverse", "order", number, "type=%s movements=%d reason=%s", o.Type, len(movements), reason)
+	return nil
+}
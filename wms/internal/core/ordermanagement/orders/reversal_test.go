@@ -0,0 +1,76 @@
+package orders
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/mxV03/wms/ent"
+	"github.com/mxV03/wms/internal/core/inventory/item"
+	"github.com/mxV03/wms/internal/core/inventory/location"
+	"github.com/mxV03/wms/internal/core/inventory/stock"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/clictx"
+	_ "modernc.org/sqlite"
+)
+
+func openTestClient(t *testing.T) *ent.Client {
+	t.Helper()
+	db, err := sql.Open("sqlite", "file:"+t.Name()+"?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+	t.Cleanup(func() { client.Close() })
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	// audit builds write the log through the application client
+	clictx.Init(client)
+	return client
+}
+
+func TestReverseOrderOnHeldLocation(t *testing.T) {
+	ctx := context.Background()
+	client := openTestClient(t)
+
+	if _, err := item.NewItemService(client).CreateItem(ctx, "A", "Item A", ""); err != nil {
+		t.Fatal(err)
+	}
+	locs := location.NewLocationService(client)
+	if _, err := locs.CreateLocation(ctx, "L1", "Location 1"); err != nil {
+		t.Fatal(err)
+	}
+
+	svc := NewOrderService(client)
+	if _, err := svc.CreateInboundOrder(ctx, "IN1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.AddLine(ctx, "IN1", "A", "L1", 5); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.PostOrder(ctx, "IN1"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := locs.Hold(ctx, "L1", "damaged rack"); err != nil {
+		t.Fatal(err)
+	}
+	stocks := stock.NewStockService(client)
+	if err := stocks.OUT(ctx, "A", "L1", 1, "MANUAL"); !errors.Is(err, stock.ErrLocationOnHold) {
+		t.Fatalf("OUT on held location: got %v, want %v", err, stock.ErrLocationOnHold)
+	}
+
+	if err := svc.ReverseOrder(ctx, "IN1", "wrong delivery"); err != nil {
+		t.Fatalf("ReverseOrder on held location: %v", err)
+	}
+	qty, err := stocks.StockAtLocation(ctx, "A", "L1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if qty != 0 {
+		t.Errorf("stock after reversal = %d, want 0", qty)
+	}
+}
@@ -0,0 +1,214 @@
+package orders
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mxV03/wms/ent"
+	"github.com/mxV03/wms/ent/order"
+	"github.com/mxV03/wms/internal/auditlog"
+)
+
+var (
+	ErrInvalidPriority = fmt.Errorf("invalid order priority")
+	ErrInvalidShipBy   = fmt.Errorf("invalid ship-by date")
+)
+
+type OrderPriority string
+
+const (
+	PriorityLow    OrderPriority = "LOW"
+	PriorityNormal OrderPriority = "NORMAL"
+	PriorityHigh   OrderPriority = "HIGH"
+	PriorityUrgent OrderPriority = "URGENT"
+)
+
+func NormalizePriority(p string) (OrderPriority, error) {
+	switch OrderPriority(strings.ToUpper(strings.TrimSpace(p))) {
+	case PriorityLow:
+		return PriorityLow, nil
+	case PriorityNormal:
+		return PriorityNormal, nil
+	case PriorityHigh:
+		return PriorityHigh, nil
+	case PriorityUrgent:
+		return PriorityUrgent, nil
+	default:
+		return "", ErrInvalidPriority
+	}
+}
+
+// Rank orders priorities by urgency, higher is more urgent.
+func (p OrderPriority) Rank() int {
+	switch p {
+	case PriorityUrgent:
+		return 3
+	case PriorityHigh:
+		return 2
+	case PriorityNormal:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// ParseShipBy accepts "2006-01-02", "2006-01-02T15:04" or RFC3339. A plain
+// date means the end of that day in local time.
+func ParseShipBy(v string) (time.Time, error) {
+	v = strings.TrimSpace(v)
+	if t, err := time.ParseInLocation("2006-01-02", v, time.Local); err == nil {
+		return t.Add(24*time.Hour - time.Second), nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02T15:04", v, time.Local); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t, nil
+	}
+	return time.Time{}, ErrInvalidShipBy
+}
+
+type QueueEntryDTO struct {
+	Number    string
+	Priority  string
+	ShipBy    *time.Time
+	CreatedAt time.Time
+	Lines     int
+	Overdue   bool
+}
+
+func (s *OrderService) SetPriority(ctx context.Context, number, priority string) error {
+	p, err := NormalizePriority(priority)
+	if err != nil {
+		return err
+	}
+	o, err := s.openOrder(ctx, number)
+	if err != nil {
+		return err
+	}
+	if err := s.client.Order.UpdateOneID(o.ID).SetPriority(string(p)).Exec(ctx); err != nil {
+		return fmt.Errorf("updating order priority: %w", err)
+	}
+	auditlog.Logf(ctx, "order.priority", "order", o.OrderNumber, "priority=%s", p)
+	return nil
+}
+
+func (s *OrderService) SetShipBy(ctx context.Context, number string, shipBy time.Time) error {
+	if shipBy.IsZero() {
+		return ErrInvalidShipBy
+	}
+	o, err := s.openOrder(ctx, number)
+	if err != nil {
+		return err
+	}
+	if err := s.client.Order.UpdateOneID(o.ID).SetShipBy(shipBy).Exec(ctx); err != nil {
+		return fmt.Errorf("updating order ship-by date: %w", err)
+	}
+	auditlog.Logf(ctx, "order.shipby", "order", o.OrderNumber, "ship_by=%s", shipBy.Format(time.RFC3339))
+	return nil
+}
+
+func (s *OrderService) openOrder(ctx context.Context, number string) (*ent.Order, error) {
+	number = strings.TrimSpace(number)
+	if number == "" {
+		return nil, ErrInvalidOrderNo
+	}
+	o, err := s.client.Order.Query().
+		Where(order.OrderNumber(number)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, fmt.Errorf("fetching order: %w", err)
+	}
+	if o.Status != string(OrderStatusDraft) {
+		return nil, ErrInvalidStatus
+	}
+	return o, nil
+}
+
+// Queue returns open outbound orders sorted by urgency: overdue orders first,
+// then by priority, ship-by date (orders without one last) and age.
+func (s *OrderService) Queue(ctx context.Context, now time.Time, limit int) ([]QueueEntryDTO, error) {
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+
+	open, err := s.client.Order.Query().
+		Where(
+			order.Type(string(OrderTypeOutbound)),
+			order.Status(string(OrderStatusDraft)),
+		).
+		WithLines().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching open orders: %w", err)
+	}
+
+	out := make([]QueueEntryDTO, 0, len(open))
+	for _, o := range open {
+		out = append(out, QueueEntryDTO{
+			Number:    o.OrderNumber,
+			Priority:  o.Priority,
+			ShipBy:    o.ShipBy,
+			CreatedAt: o.CreatedAt,
+			Lines:     len(o.Edges.Lines),
+			Overdue:   o.ShipBy != nil && o.ShipBy.Before(now),
+		})
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		a, b := out[i], out[j]
+		if a.Overdue != b.Overdue {
+			return a.Overdue
+		}
+		if ra, rb := OrderPriority(a.Priority).Rank(), OrderPriority(b.Priority).Rank(); ra != rb {
+			return ra > rb
+		}
+		if (a.ShipBy == nil) != (b.ShipBy == nil) {
+			return a.ShipBy != nil
+		}
+		if a.ShipBy != nil && !a.ShipBy.Equal(*b.ShipBy) {
+			return a.ShipBy.Before(*b.ShipBy)
+		}
+		return a.CreatedAt.Before(b.CreatedAt)
+	})
+
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out, nil
+}
+
+// SLABreaches returns open outbound orders whose ship-by date has passed.
+func (s *OrderService) SLABreaches(ctx context.Context, now time.Time) ([]QueueEntryDTO, error) {
+	overdue, err := s.client.Order.Query().
+		Where(
+			order.Type(string(OrderTypeOutbound)),
+			order.Status(string(OrderStatusDraft)),
+			order.ShipByLT(now),
+		).
+		WithLines().
+		Order(ent.Asc(order.FieldShipBy)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetching overdue orders: %w", err)
+	}
+
+	out := make([]QueueEntryDTO, 0, len(overdue))
+	for _, o := range overdue {
+		out = append(out, QueueEntryDTO{
+			Number:    o.OrderNumber,
+			Priority:  o.Priority,
+			ShipBy:    o.ShipBy,
+			CreatedAt: o.CreatedAt,
+			Lines:     len(o.Edges.Lines),
+			Overdue:   true,
+		})
+	}
+	return out, nil
+}
@@ -2,14 +2,20 @@ package orders
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/mxV03/wms/ent"
 	"github.com/mxV03/wms/ent/item"
 	"github.com/mxV03/wms/ent/location"
 	"github.com/mxV03/wms/ent/order"
 	"github.com/mxV03/wms/ent/orderline"
+	"github.com/mxV03/wms/internal/auditlog"
 	"github.com/mxV03/wms/internal/core/inventory/stock"
+	"github.com/mxV03/wms/internal/core/sequence"
+	"github.com/mxV03/wms/internal/putaway"
 )
 
 var (
@@ -20,6 +26,7 @@ var (
 	ErrInvalidStatus    = fmt.Errorf("invalid order status transaction")
 	ErrNoLines          = fmt.Errorf("order has no lines")
 	ErrInvalidQuantity  = fmt.Errorf("invalid quantity specified")
+	ErrOrderReleased    = fmt.Errorf("order is released for picking")
 )
 
 type OrderType string
@@ -27,6 +34,7 @@ type OrderType string
 const (
 	OrderTypeInbound  OrderType = "INBOUND"
 	OrderTypeOutbound OrderType = "OUTBOUND"
+	OrderTypeReturn   OrderType = "RETURN"
 )
 
 type OrderStatus string
@@ -35,6 +43,10 @@ const (
 	OrderStatusDraft     OrderStatus = "DRAFT"
 	OrderStatusPosted    OrderStatus = "POSTED"
 	OrderStatusCancelled OrderStatus = "CANCELLED"
+
+	OrderStatusPartiallyReceived OrderStatus = "PARTIALLY_RECEIVED"
+	OrderStatusReceived          OrderStatus = "RECEIVED"
+	OrderStatusReversed          OrderStatus = "REVERSED"
 )
 
 type OrderDTO struct {
@@ -51,6 +63,8 @@ type OrderLineDTO struct {
 	SKU          string
 	LocationCode string
 	Quantity     int
+	Condition    string
+	Disposition  string
 }
 
 type OrderService struct {
@@ -62,14 +76,75 @@ func NewOrderService(client *ent.Client) *OrderService {
 }
 
 func (s *OrderService) CreateInboundOrder(ctx context.Context, number string) (*ent.Order, error) {
-	return s.create(ctx, number, string(OrderTypeInbound))
+	return s.create(ctx, number, string(OrderTypeInbound), nil, "")
 }
 
 func (s *OrderService) CreateOutboundOrder(ctx context.Context, number string) (*ent.Order, error) {
-	return s.create(ctx, number, string(OrderTypeOutbound))
+	return s.create(ctx, number, string(OrderTypeOutbound), nil, "")
+}
+
+// NextOrderNumber allocates the next number from the sequence of the order type.
+func (s *OrderService) NextOrderNumber(ctx context.Context, orderType OrderType) (string, error) {
+	return sequence.NewSequenceService(s.client).Next(ctx, string(orderType))
+}
+
+// CreateOrderWithKey creates an INBOUND or OUTBOUND order at most once per
+// idempotency key. Repeating a request with the same key, number and type
+// returns the original order and reports it as replayed; the same key with a
+// different payload fails with stock.ErrIdempotencyConflict. An empty number
+// is allocated from the sequence of the order type, so a retried request
+// without a number replays the order created first.
+func (s *OrderService) CreateOrderWithKey(ctx context.Context, key, number string, orderType OrderType) (*ent.Order, bool, error) {
+	key = strings.TrimSpace(key)
+	number = strings.TrimSpace(number)
+	if key == "" {
+		return nil, false, stock.ErrInvalidIdempotencyKey
+	}
+	if orderType != OrderTypeInbound && orderType != OrderTypeOutbound {
+		return nil, false, ErrInvalidOrderType
+	}
+
+	if o, err := s.replayCreate(ctx, key, number, orderType); o != nil || err != nil {
+		return o, o != nil, err
+	}
+	requested := number
+	if number == "" {
+		nr, err := s.NextOrderNumber(ctx, orderType)
+		if err != nil {
+			return nil, false, err
+		}
+		number = nr
+	}
+	o, err := s.create(ctx, number, string(orderType), nil, key)
+	if err != nil {
+		// A concurrent request with the same key may have won the race.
+		if errors.Is(err, ErrOrderExists) || ent.IsConstraintError(err) {
+			if o, rerr := s.replayCreate(ctx, key, requested, orderType); o != nil || rerr != nil {
+				return o, o != nil, rerr
+			}
+		}
+		return nil, false, err
+	}
+	return o, false, nil
+}
+
+func (s *OrderService) replayCreate(ctx context.Context, key, number string, orderType OrderType) (*ent.Order, error) {
+	o, err := s.client.Order.Query().
+		Where(order.IdempotencyKey(key)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("fetching order by idempotency key: %w", err)
+	}
+	if (number != "" && o.OrderNumber != number) || o.Type != string(orderType) {
+		return nil, stock.ErrIdempotencyConflict
+	}
+	return o, nil
 }
 
-func (s *OrderService) create(ctx context.Context, number string, orderType string) (*ent.Order, error) {
+func (s *OrderService) create(ctx context.Context, number string, orderType string, returnOf *ent.Order, key string) (*ent.Order, error) {
 	exists, err := s.client.Order.Query().
 		Where(order.OrderNumber(number)).
 		Exist(ctx)
@@ -84,6 +159,12 @@ func (s *OrderService) create(ctx context.Context, number string, orderType stri
 		SetOrderNumber(number).
 		SetType(string(orderType)).
 		SetStatus(string(OrderStatusDraft))
+	if returnOf != nil {
+		newOrder.SetReturnOf(returnOf)
+	}
+	if key != "" {
+		newOrder.SetIdempotencyKey(key)
+	}
 
 	createdOrder, err := newOrder.Save(ctx)
 	if err != nil {
@@ -112,6 +193,9 @@ func (s *OrderService) AddLine(ctx context.Context, orderNumber, sku, locationCo
 	if orderEntity.Status != string(OrderStatusDraft) {
 		return nil, ErrInvalidStatus
 	}
+	if err := checkNotReleased(ctx, s.client, orderEntity.ID); err != nil {
+		return nil, err
+	}
 
 	itm, err := s.client.Item.Query().
 		Where(item.SKU(sku)).
@@ -127,6 +211,10 @@ func (s *OrderService) AddLine(ctx context.Context, orderNumber, sku, locationCo
 		return nil, fmt.Errorf("fetching location: %w", err)
 	}
 
+	if orderEntity.Type == string(OrderTypeReturn) {
+		return s.addReturnLine(ctx, orderEntity, itm, loc, quantity)
+	}
+
 	line := s.client.OrderLine.Create().
 		SetOrder(orderEntity).
 		SetItem(itm).
@@ -149,6 +237,7 @@ func (s *OrderService) PostOrder(ctx context.Context, number string) error {
 		return fmt.Errorf("starting transaction: %w", err)
 	}
 	defer tx.Rollback()
+	txCtx := auditlog.InTx(ctx, tx)
 
 	orderEntity, err := tx.Order.Query().
 		Where(order.OrderNumber(number)).
@@ -162,6 +251,9 @@ func (s *OrderService) PostOrder(ctx context.Context, number string) error {
 	if orderEntity.Status != string(OrderStatusDraft) {
 		return ErrInvalidStatus
 	}
+	if err := checkNotReleased(ctx, tx.Client(), orderEntity.ID); err != nil {
+		return err
+	}
 
 	lines, err := tx.OrderLine.Query().
 		Where(orderline.HasOrderWith(order.OrderNumber(number))).
@@ -182,14 +274,17 @@ func (s *OrderService) PostOrder(ctx context.Context, number string) error {
 		locCode := line.Edges.Location.Code
 		qty := line.Quantity
 
-		ref := "ORDER-" + number
+		ref := PostingRef(number)
 
 		if orderEntity.Type == string(OrderTypeInbound) {
-			if err := stockSvc.IN(ctx, sku, locCode, qty, ref); err != nil {
+			if err := stockSvc.IN(txCtx, sku, locCode, qty, ref); err != nil {
+				return err
+			}
+			if err := putaway.Received(ctx, tx.Client(), sku, locCode, qty, number); err != nil {
 				return err
 			}
 		} else if orderEntity.Type == string(OrderTypeOutbound) {
-			if err := stockSvc.OUT(ctx, sku, locCode, qty, ref); err != nil {
+			if err := stockSvc.OUT(txCtx, sku, locCode, qty, ref); err != nil {
 				return err
 			}
 		} else {
@@ -199,6 +294,7 @@ func (s *OrderService) PostOrder(ctx context.Context, number string) error {
 
 	_, err = tx.Order.UpdateOneID(orderEntity.ID).
 		SetStatus(string(OrderStatusPosted)).
+		SetPostedAt(time.Now()).
 		Save(ctx)
 	if err != nil {
 		return fmt.Errorf("updating order status: %w", err)
@@ -206,6 +302,7 @@ func (s *OrderService) PostOrder(ctx context.Context, number string) error {
 	if err := tx.Commit(); err != nil {
 		return fmt.Errorf("committing transaction: %w", err)
 	}
+	auditlog.Logf(ctx, "order.posted", "order", number, "type=%s lines=%d", orderEntity.Type, len(lines))
 	return nil
 }
 
@@ -224,6 +321,9 @@ func (s *OrderService) CancelOrder(ctx context.Context, orderNumber string) erro
 	if o.Status != string(OrderStatusDraft) {
 		return ErrInvalidStatus
 	}
+	if err := checkNotReleased(ctx, s.client, o.ID); err != nil {
+		return err
+	}
 
 	_, err = s.client.Order.UpdateOneID(o.ID).
 		SetStatus(string(OrderStatusCancelled)).
@@ -233,3 +333,18 @@ func (s *OrderService) CancelOrder(ctx context.Context, orderNumber string) erro
 	}
 	return nil
 }
+
+// checkNotReleased refuses changes to an order that was released into a
+// picklist or wave; picking issues its stock and posts it when done.
+func checkNotReleased(ctx context.Context, client *ent.Client, id int) error {
+	released, err := client.Order.Query().
+		Where(order.ID(id), order.Or(order.HasPicklist(), order.HasWave())).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("checking picking: %w", err)
+	}
+	if released {
+		return ErrOrderReleased
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	coresequence "github.com/mxV03/wms/internal/core/sequence"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/clictx"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/registry"
+)
+
+func init() {
+	registry.Register(registry.Command{
+		Name:        "sequence.list",
+		Usage:       "sequence.list",
+		Group:       "Core / Sequences",
+		Description: "List number sequences with their format and current value.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 0 {
+				return fmt.Errorf("usage: sequence.list")
+			}
+			svc := coresequence.NewSequenceService(clictx.AppCtx().Client())
+			seqs, err := svc.List(ctx)
+			if err != nil {
+				return err
+			}
+			for _, s := range seqs {
+				fmt.Printf("%-10s prefix=%s date=%s width=%d value=%d period=%s\n",
+					s.Name, dash(s.Prefix), dash(s.DatePattern), s.Width, s.Value, dash(s.Period))
+			}
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "sequence.set",
+		Usage:       "sequence.set <name> <prefix|-> <date_pattern|-> <width>",
+		Group:       "Core / Sequences",
+		Description: "Configure a number sequence, e.g. sequence.set OUTBOUND OUT YYYY 6.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 4 {
+				return fmt.Errorf("usage: sequence.set <name> <prefix|-> <date_pattern|-> <width>")
+			}
+			width, err := strconv.Atoi(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid width: %w", err)
+			}
+			svc := coresequence.NewSequenceService(clictx.AppCtx().Client())
+			if err := svc.Configure(ctx, args[0], undash(args[1]), undash(args[2]), width); err != nil {
+				return err
+			}
+			fmt.Printf("Sequence '%s' updated.\n", args[0])
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "sequence.next",
+		Usage:       "sequence.next <name>",
+		Group:       "Core / Sequences",
+		Description: "Allocate and print the next number of a sequence.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("usage: sequence.next <name>")
+			}
+			svc := coresequence.NewSequenceService(clictx.AppCtx().Client())
+			nr, err := svc.Next(ctx, args[0])
+			if err != nil {
+				return err
+			}
+			fmt.Println(nr)
+			return nil
+		},
+	})
+}
+
+func dash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func undash(s string) string {
+	if s == "-" {
+		return ""
+	}
+	return s
+}
@@ -0,0 +1,253 @@
+package sequence
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mxV03/wms/ent"
+	"github.com/mxV03/wms/ent/sequence"
+	"github.com/mxV03/wms/internal/auditlog"
+)
+
+var (
+	ErrInvalidName        = fmt.Errorf("invalid sequence name")
+	ErrInvalidDatePattern = fmt.Errorf("invalid date pattern (use YYYY, YY, MM, DD and - / . _)")
+	ErrInvalidWidth       = fmt.Errorf("invalid counter width")
+	ErrContention         = fmt.Errorf("sequence is busy, try again")
+)
+
+const (
+	Inbound  = "INBOUND"
+	Outbound = "OUTBOUND"
+	Return   = "RETURN"
+	PickList = "PICKLIST"
+	Count    = "COUNT"
+	Wave     = "WAVE"
+	Carton   = "CARTON"
+)
+
+// maxAttempts bounds the optimistic retries of Next under contention.
+const maxAttempts = 20
+
+type SequenceDTO struct {
+	Name        string
+	Prefix      string
+	DatePattern string
+	Width       int
+	Value       int
+	Period      string
+}
+
+// defaults are used the first time a sequence is requested; sequences
+// without a default start with the upper-case name as prefix.
+var defaults = map[string]SequenceDTO{
+	Inbound:  {Prefix: "IN", DatePattern: "YYYY", Width: 6},
+	Outbound: {Prefix: "OUT", DatePattern: "YYYY", Width: 6},
+	Return:   {Prefix: "RET", DatePattern: "YYYY", Width: 6},
+	PickList: {Prefix: "PL", DatePattern: "YYYY", Width: 6},
+	Count:    {Prefix: "CNT", DatePattern: "YYYY", Width: 6},
+	Wave:     {Prefix: "WV", DatePattern: "YYYYMMDD", Width: 3},
+	Carton:   {Prefix: "CTN", DatePattern: "YYYY", Width: 6},
+}
+
+type SequenceService struct {
+	client *ent.Client
+	now    func() time.Time
+}
+
+func NewSequenceService(client *ent.Client) *SequenceService {
+	return &SequenceService{
+		client: client,
+		now:    time.Now,
+	}
+}
+
+// Next allocates the next number of a sequence, e.g. OUT-2026-000123. The
+// counter restarts at 1 whenever the date part changes. Allocation is a
+// compare-and-swap on the stored value, so concurrent callers never receive
+// the same number.
+func (s *SequenceService) Next(ctx context.Context, name string) (string, error) {
+	name = strings.ToUpper(strings.TrimSpace(name))
+	if name == "" {
+		return "", ErrInvalidName
+	}
+
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		seq, err := s.get(ctx, name)
+		if err != nil {
+			return "", err
+		}
+
+		period, err := formatDate(seq.DatePattern, s.now())
+		if err != nil {
+			return "", err
+		}
+		value := seq.Value + 1
+		if period != seq.Period {
+			value = 1
+		}
+
+		n, err := s.client.Sequence.Update().
+			Where(
+				sequence.ID(seq.ID),
+				sequence.Value(seq.Value),
+				sequence.Period(seq.Period),
+			).
+			SetValue(value).
+			SetPeriod(period).
+			Save(ctx)
+		if err != nil {
+			return "", fmt.Errorf("updating sequence: %w", err)
+		}
+		if n == 1 {
+			return render(seq.Prefix, period, seq.Width, value), nil
+		}
+	}
+	return "", ErrContention
+}
+
+// Configure sets prefix, date pattern and counter width of a sequence. The
+// counter itself is kept.
+func (s *SequenceService) Configure(ctx context.Context, name, prefix, datePattern string, width int) error {
+	name = strings.ToUpper(strings.TrimSpace(name))
+	prefix = strings.TrimSpace(prefix)
+	datePattern = strings.ToUpper(strings.TrimSpace(datePattern))
+	if name == "" {
+		return ErrInvalidName
+	}
+	if width <= 0 || width > 18 {
+		return ErrInvalidWidth
+	}
+	if _, err := formatDate(datePattern, s.now()); err != nil {
+		return err
+	}
+
+	seq, err := s.get(ctx, name)
+	if err != nil {
+		return err
+	}
+	err = s.client.Sequence.UpdateOneID(seq.ID).
+		SetPrefix(prefix).
+		SetDatePattern(datePattern).
+		SetWidth(width).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("updating sequence: %w", err)
+	}
+	auditlog.Logf(ctx, "sequence.set", "sequence", name, "prefix=%s date=%s width=%d", prefix, datePattern, width)
+	return nil
+}
+
+// List returns all stored sequences together with the defaults not used yet.
+func (s *SequenceService) List(ctx context.Context) ([]SequenceDTO, error) {
+	seqs, err := s.client.Sequence.Query().
+		Order(ent.Asc(sequence.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing sequences: %w", err)
+	}
+
+	out := make([]SequenceDTO, 0, len(seqs)+len(defaults))
+	stored := map[string]bool{}
+	for _, seq := range seqs {
+		stored[seq.Name] = true
+		out = append(out, toDTO(seq))
+	}
+	for _, name := range []string{Carton, Count, Inbound, Outbound, PickList, Return, Wave} {
+		if !stored[name] {
+			d := defaults[name]
+			d.Name = name
+			out = append(out, d)
+		}
+	}
+	return out, nil
+}
+
+// get loads a sequence and creates it from its defaults on first use.
+func (s *SequenceService) get(ctx context.Context, name string) (*ent.Sequence, error) {
+	seq, err := s.client.Sequence.Query().
+		Where(sequence.Name(name)).
+		Only(ctx)
+	if err == nil {
+		return seq, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("fetching sequence: %w", err)
+	}
+
+	d, ok := defaults[name]
+	if !ok {
+		d = SequenceDTO{Prefix: name, DatePattern: "YYYY", Width: 6}
+	}
+	seq, err = s.client.Sequence.Create().
+		SetName(name).
+		SetPrefix(d.Prefix).
+		SetDatePattern(d.DatePattern).
+		SetWidth(d.Width).
+		Save(ctx)
+	if err != nil {
+		// Created concurrently by another caller.
+		if ent.IsConstraintError(err) {
+			return s.client.Sequence.Query().Where(sequence.Name(name)).Only(ctx)
+		}
+		return nil, fmt.Errorf("creating sequence: %w", err)
+	}
+	return seq, nil
+}
+
+func toDTO(seq *ent.Sequence) SequenceDTO {
+	return SequenceDTO{
+		Name:        seq.Name,
+		Prefix:      seq.Prefix,
+		DatePattern: seq.DatePattern,
+		Width:       seq.Width,
+		Value:       seq.Value,
+		Period:      seq.Period,
+	}
+}
+
+// formatDate renders a date pattern built from YYYY, YY, MM and DD with the
+// separators - / . and _.
+func formatDate(pattern string, t time.Time) (string, error) {
+	var b strings.Builder
+	for rest := pattern; rest != ""; {
+		switch {
+		case strings.HasPrefix(rest, "YYYY"):
+			b.WriteString(t.Format("2006"))
+			rest = rest[4:]
+		case strings.HasPrefix(rest, "YY"):
+			b.WriteString(t.Format("06"))
+			rest = rest[2:]
+		case strings.HasPrefix(rest, "MM"):
+			b.WriteString(t.Format("01"))
+			rest = rest[2:]
+		case strings.HasPrefix(rest, "DD"):
+			b.WriteString(t.Format("02"))
+			rest = rest[2:]
+		case strings.ContainsRune("-/._", rune(rest[0])):
+			b.WriteByte(rest[0])
+			rest = rest[1:]
+		default:
+			return "", ErrInvalidDatePattern
+		}
+	}
+	return b.String(), nil
+}
+
+func render(prefix, period string, width, value int) string {
+	counter := strconv.Itoa(value)
+	if len(counter) < width {
+		counter = strings.Repeat("0", width-len(counter)) + counter
+	}
+
+	parts := make([]string, 0, 3)
+	for _, p := range []string{prefix, period, counter} {
+		if p != "" {
+			parts = append(parts, p)
+		}
+	}
+	return strings.Join(parts, "-")
+}
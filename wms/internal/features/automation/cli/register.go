@@ -0,0 +1,223 @@
+//go:build automation
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/mxV03/wms/internal/features/automation"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/clictx"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/registry"
+)
+
+func init() {
+	registry.Register(registry.Command{
+		Name:        "replenish.rule.set",
+		Usage:       "replenish.rule.set <locationCode> <binCode> <sku> <min> <max>",
+		Group:       "Optional / Automation",
+		Description: "Make a bin the pick bin of an item; it is refilled to max once at or below min.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 5 {
+				return fmt.Errorf("usage: replenish.rule.set <locationCode> <binCode> <sku> <min> <max>")
+			}
+			min, err := strconv.Atoi(args[3])
+			if err != nil {
+				return fmt.Errorf("min must be an integer")
+			}
+			max, err := strconv.Atoi(args[4])
+			if err != nil {
+				return fmt.Errorf("max must be an integer")
+			}
+			svc := automation.NewAutomationService(clictx.AppCtx().Client())
+			if err := svc.SetRule(ctx, args[0], args[1], args[2], min, max); err != nil {
+				return err
+			}
+			fmt.Printf("replenishment rule set: BIN=%s/%s SKU=%s MIN=%d MAX=%d\n", args[0], args[1], args[2], min, max)
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "replenish.rule.delete",
+		Usage:       "replenish.rule.delete <locationCode> <binCode> <sku>",
+		Group:       "Optional / Automation",
+		Description: "Remove the min/max levels of an item in a bin.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 3 {
+				return fmt.Errorf("usage: replenish.rule.delete <locationCode> <binCode> <sku>")
+			}
+			svc := automation.NewAutomationService(clictx.AppCtx().Client())
+			if err := svc.DeleteRule(ctx, args[0], args[1], args[2]); err != nil {
+				return err
+			}
+			fmt.Printf("replenishment rule deleted: BIN=%s/%s SKU=%s\n", args[0], args[1], args[2])
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "replenish.rules",
+		Usage:       "replenish.rules",
+		Group:       "Optional / Automation",
+		Description: "List pick bin min/max levels with current stock and open replenishments.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 0 {
+				return fmt.Errorf("usage: replenish.rules")
+			}
+			svc := automation.NewAutomationService(clictx.AppCtx().Client())
+			rules, err := svc.ListRules(ctx)
+			if err != nil {
+				return err
+			}
+			if len(rules) == 0 {
+				fmt.Println("no replenishment rules")
+				return nil
+			}
+			fmt.Println("LOCATION\tBIN\tSKU\tMIN\tMAX\tSTOCK\tINCOMING")
+			for _, r := range rules {
+				fmt.Printf("%s\t%s\t%s\t%d\t%d\t%d\t%d\n", r.Location, r.Bin, r.SKU, r.Min, r.Max, r.Stock, r.Incoming)
+			}
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "replenish.run",
+		Usage:       "replenish.run [watch [interval]]",
+		Group:       "Optional / Automation",
+		Description: "Create replenishment tasks for pick bins at or below min (watch: repeat every interval, default WMS_REPLENISH_INTERVAL or 5m).",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) > 2 || (len(args) > 0 && args[0] != "watch") {
+				return fmt.Errorf("usage: replenish.run [watch [interval]]")
+			}
+			svc := automation.NewAutomationService(clictx.AppCtx().Client())
+			if len(args) == 0 {
+				run, err := svc.Replenish(ctx)
+				if err != nil {
+					return err
+				}
+				printRun(run)
+				return nil
+			}
+
+			interval, err := automation.IntervalFromEnv()
+			if len(args) == 2 {
+				interval, err = automation.ParseInterval(args[1])
+			}
+			if err != nil {
+				return err
+			}
+			ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			fmt.Printf("replenishing every %s, stop with Ctrl+C\n", interval)
+			return svc.Watch(ctx, interval, func(run *automation.ReplenishRunDTO, err error) {
+				if err != nil {
+					fmt.Printf("%s replenishment failed: %v\n", time.Now().Format(time.TimeOnly), err)
+					return
+				}
+				printRun(run)
+			})
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "replenish.tasks",
+		Usage:       "replenish.tasks [all] [limit]",
+		Group:       "Optional / Automation",
+		Description: "List open replenishment tasks (all: include confirmed and cancelled tasks).",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) > 2 {
+				return fmt.Errorf("usage: replenish.tasks [all] [limit]")
+			}
+			all := false
+			if len(args) > 0 && args[0] == "all" {
+				all = true
+				args = args[1:]
+			}
+			limit := 100
+			if len(args) == 1 {
+				v, err := strconv.Atoi(args[0])
+				if err != nil {
+					return fmt.Errorf("limit must be an integer")
+				}
+				limit = v
+			}
+
+			svc := automation.NewAutomationService(clictx.AppCtx().Client())
+			ts, err := svc.ListTasks(ctx, all, limit)
+			if err != nil {
+				return err
+			}
+			if len(ts) == 0 {
+				fmt.Println("no replenishment tasks")
+				return nil
+			}
+			for _, t := range ts {
+				fmt.Printf("replenish: ID=%d KIND=%s SKU=%s QTY=%d LOCATION=%s FROM=%s TO=%s STATUS=%s\n",
+					t.ID, t.Kind, t.SKU, t.Quantity, t.Location, t.FromBin, t.ToBin, t.Status)
+			}
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "replenish.confirm",
+		Usage:       "replenish.confirm <taskID>",
+		Group:       "Optional / Automation",
+		Description: "Confirm a replenishment; moves the bin stock from the reserve to the pick bin.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("usage: replenish.confirm <taskID>")
+			}
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("taskID must be an integer")
+			}
+			svc := automation.NewAutomationService(clictx.AppCtx().Client())
+			if err := svc.ConfirmTask(ctx, id); err != nil {
+				return err
+			}
+			fmt.Printf("replenishment %d confirmed\n", id)
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "replenish.cancel",
+		Usage:       "replenish.cancel <taskID>",
+		Group:       "Optional / Automation",
+		Description: "Cancel an open replenishment task.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("usage: replenish.cancel <taskID>")
+			}
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("taskID must be an integer")
+			}
+			svc := automation.NewAutomationService(clictx.AppCtx().Client())
+			if err := svc.CancelTask(ctx, id); err != nil {
+				return err
+			}
+			fmt.Printf("replenishment %d cancelled\n", id)
+			return nil
+		},
+	})
+}
+
+func printRun(run *automation.ReplenishRunDTO) {
+	fmt.Printf("%s replenishment: RULES=%d TASKS=%d SHORTFALLS=%d\n",
+		run.At.Format(time.TimeOnly), run.Rules, len(run.Tasks), len(run.Shortfalls))
+	for _, t := range run.Tasks {
+		fmt.Printf("  task: ID=%d SKU=%s QTY=%d LOCATION=%s FROM=%s TO=%s\n", t.ID, t.SKU, t.Quantity, t.Location, t.FromBin, t.ToBin)
+	}
+	for _, sf := range run.Shortfalls {
+		fmt.Printf("  shortfall: SKU=%s BIN=%s/%s MISSING=%d\n", sf.SKU, sf.Location, sf.Bin, sf.Missing)
+	}
+}
@@ -0,0 +1,135 @@
+//go:build automation
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/mxV03/wms/internal/features/automation"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/clictx"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/registry"
+)
+
+func init() {
+	registry.Register(registry.Command{
+		Name:        "replenish.policy.set",
+		Usage:       "replenish.policy.set <sku> <locationCode> <reorderPoint> <safetyStock> <reorderQty> <leadTimeDays> [supplier]",
+		Group:       "Optional / Automation",
+		Description: "Set the reorder policy of an item; purchases are received into the given location.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) < 6 || len(args) > 7 {
+				return fmt.Errorf("usage: replenish.policy.set <sku> <locationCode> <reorderPoint> <safetyStock> <reorderQty> <leadTimeDays> [supplier]")
+			}
+			nums := make([]int, 4)
+			for i, name := range []string{"reorderPoint", "safetyStock", "reorderQty", "leadTimeDays"} {
+				v, err := strconv.Atoi(args[2+i])
+				if err != nil {
+					return fmt.Errorf("%s must be an integer", name)
+				}
+				nums[i] = v
+			}
+			p := automation.PolicyDTO{
+				SKU:             args[0],
+				Location:        args[1],
+				ReorderPoint:    nums[0],
+				SafetyStock:     nums[1],
+				ReorderQuantity: nums[2],
+				LeadTimeDays:    nums[3],
+			}
+			if len(args) == 7 {
+				p.Supplier = args[6]
+			}
+			svc := automation.NewAutomationService(clictx.AppCtx().Client())
+			if err := svc.SetPolicy(ctx, p); err != nil {
+				return err
+			}
+			fmt.Printf("reorder policy set: SKU=%s ROP=%d SAFETY=%d QTY=%d LEAD=%dd SUPPLIER=%s\n",
+				p.SKU, p.ReorderPoint, p.SafetyStock, p.ReorderQuantity, p.LeadTimeDays, dash(p.Supplier))
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "replenish.policy.delete",
+		Usage:       "replenish.policy.delete <sku>",
+		Group:       "Optional / Automation",
+		Description: "Remove the reorder policy of an item.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("usage: replenish.policy.delete <sku>")
+			}
+			svc := automation.NewAutomationService(clictx.AppCtx().Client())
+			if err := svc.DeletePolicy(ctx, args[0]); err != nil {
+				return err
+			}
+			fmt.Printf("reorder policy deleted: SKU=%s\n", args[0])
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "replenish.policies",
+		Usage:       "replenish.policies",
+		Group:       "Optional / Automation",
+		Description: "List reorder policies.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 0 {
+				return fmt.Errorf("usage: replenish.policies")
+			}
+			svc := automation.NewAutomationService(clictx.AppCtx().Client())
+			ps, err := svc.ListPolicies(ctx)
+			if err != nil {
+				return err
+			}
+			if len(ps) == 0 {
+				fmt.Println("no reorder policies")
+				return nil
+			}
+			fmt.Println("SKU\tLOCATION\tROP\tSAFETY\tQTY\tLEAD\tSUPPLIER")
+			for _, p := range ps {
+				fmt.Printf("%s\t%s\t%d\t%d\t%d\t%dd\t%s\n",
+					p.SKU, p.Location, p.ReorderPoint, p.SafetyStock, p.ReorderQuantity, p.LeadTimeDays, dash(p.Supplier))
+			}
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "replenish.suggest",
+		Usage:       "replenish.suggest [create]",
+		Group:       "Optional / Automation",
+		Description: "Propose purchases per supplier for items at or below their reorder point, raised to the lead-time demand forecast plus safety stock with reporting (create: as DRAFT inbound orders).",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) > 1 || (len(args) == 1 && args[0] != "create") {
+				return fmt.Errorf("usage: replenish.suggest [create]")
+			}
+			svc := automation.NewAutomationService(clictx.AppCtx().Client())
+			purchases, err := svc.SuggestPurchases(ctx, len(args) == 1)
+			if err != nil {
+				return err
+			}
+			if len(purchases) == 0 {
+				fmt.Println("nothing to order")
+				return nil
+			}
+			for _, pu := range purchases {
+				fmt.Printf("supplier: %s ORDER=%s\n", dash(pu.Supplier), dash(pu.OrderNr))
+				fmt.Println("  SKU\tQTY\tLOCATION\tON_HAND\tINBOUND\tRESERVED\tPOSITION\tROP\tFORECAST\tDUE")
+				for _, l := range pu.Lines {
+					fmt.Printf("  %s\t%d\t%s\t%d\t%d\t%d\t%d\t%d\t%d\t%s\n",
+						l.SKU, l.Quantity, l.Location, l.OnHand, l.Inbound, l.Reserved, l.Position, l.ReorderPoint, l.Forecast, l.Due.Format("2006-01-02"))
+				}
+			}
+			return nil
+		},
+	})
+}
+
+func dash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
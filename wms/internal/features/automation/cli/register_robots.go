@@ -0,0 +1,71 @@
+//go:build automation
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/mxV03/wms/internal/features/automation"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/clictx"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/registry"
+)
+
+func init() {
+	registry.Register(registry.Command{
+		Name:        "robot.sim",
+		Usage:       "robot.sim <locationCode> <robots> [replay [every]]",
+		Group:       "Optional / Automation",
+		Description: "Simulate robots doing the open pick and putaway tasks of a location; reports makespan and utilization (replay: draw the floor every n ticks).",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) < 2 || len(args) > 4 || (len(args) > 2 && args[2] != "replay") {
+				return fmt.Errorf("usage: robot.sim <locationCode> <robots> [replay [every]]")
+			}
+			robots, err := strconv.Atoi(args[1])
+			if err != nil {
+				return fmt.Errorf("robots must be an integer")
+			}
+			every := 0
+			if len(args) > 2 {
+				every = 1
+			}
+			if len(args) == 4 {
+				n, err := strconv.Atoi(args[3])
+				if err != nil || n <= 0 {
+					return fmt.Errorf("every must be a positive integer")
+				}
+				every = n
+			}
+
+			svc := automation.NewAutomationService(clictx.AppCtx().Client())
+			sim, err := svc.SimulateRobots(ctx, args[0], robots)
+			if err != nil {
+				return err
+			}
+			if every > 0 {
+				for t := 0; ; t += every {
+					t = min(t, sim.End)
+					fmt.Printf("t=%d\n%s\n", t, sim.Frame(t))
+					if t == sim.End {
+						break
+					}
+				}
+			}
+
+			fmt.Printf("robot sim: LOCATION=%s GRID=%dx%d ROBOTS=%d TASKS=%d SKIPPED=%d MAKESPAN=%d END=%d\n",
+				sim.Location, sim.Width, sim.Height, sim.Robots, len(sim.Tasks), sim.Skipped, sim.Makespan, sim.End)
+			if len(sim.Tasks) > 0 {
+				fmt.Println("ROBOT\tKIND\tID\tBIN\tSTART\tDONE")
+				for _, t := range sim.Tasks {
+					fmt.Printf("%s\t%s\t%d\t%s\t%d\t%d\n", t.Robot, t.Kind, t.ID, t.Bin, t.Start, t.Done)
+				}
+			}
+			fmt.Println("ROBOT\tTASKS\tMOVES\tWAITS\tBUSY\tUTIL")
+			for _, st := range sim.Stats {
+				fmt.Printf("%s\t%d\t%d\t%d\t%d\t%.0f%%\n", st.Robot, st.Tasks, st.Moves, st.Waits, st.Busy, st.Utilization*100)
+			}
+			return nil
+		},
+	})
+}
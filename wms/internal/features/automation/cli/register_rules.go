@@ -0,0 +1,183 @@
+//go:build automation
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mxV03/wms/internal/auditlog"
+	"github.com/mxV03/wms/internal/features/automation"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/clictx"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/registry"
+)
+
+func init() {
+	// every logged event is matched against the enabled rules once the
+	// command that logged it is done
+	auditlog.Subscribe(func(ctx context.Context, e auditlog.Event) {
+		svc := automation.NewAutomationService(clictx.AppCtx().Client())
+		runs, err := svc.FireEventRules(ctx, e)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "rules: %s %s: %v\n", e.Action, e.EntityRef, err)
+			return
+		}
+		for _, r := range runs {
+			if r.Err != nil {
+				fmt.Fprintf(os.Stderr, "rule failed: NAME=%s EVENT=%s REF=%s: %v\n", r.Rule, e.Action, e.EntityRef, r.Err)
+				continue
+			}
+			fmt.Printf("rule fired: NAME=%s EVENT=%s REF=%s %s\n", r.Rule, e.Action, e.EntityRef, r.Result)
+		}
+	})
+
+	registry.Register(registry.Command{
+		Name:        "rules.add",
+		Usage:       "rules.add <name> when <event> [and <field> <op> <value>]... then <notify|replenish|hold> [argument]",
+		Group:       "Optional / Automation",
+		Description: "Add a rule run on logged events, e.g. when stock.out and stock < 10 then replenish.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) < 5 {
+				return fmt.Errorf("usage: rules.add <name> when <event> [and <field> <op> <value>]... then <notify|replenish|hold> [argument]")
+			}
+			svc := automation.NewAutomationService(clictx.AppCtx().Client())
+			r, err := svc.AddEventRule(ctx, args[0], strings.Join(args[1:], " "))
+			if err != nil {
+				return err
+			}
+			fmt.Printf("rule added: NAME=%s RULE=%q\n", r.Name, r.String())
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "rules.list",
+		Usage:       "rules.list",
+		Group:       "Optional / Automation",
+		Description: "List rules with how often they fired.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 0 {
+				return fmt.Errorf("usage: rules.list")
+			}
+			svc := automation.NewAutomationService(clictx.AppCtx().Client())
+			rules, err := svc.ListEventRules(ctx)
+			if err != nil {
+				return err
+			}
+			if len(rules) == 0 {
+				fmt.Println("no rules")
+				return nil
+			}
+			fmt.Println("NAME\tENABLED\tFIRED\tLAST\tRULE")
+			for _, r := range rules {
+				last := "-"
+				if r.LastFiredAt != nil {
+					last = r.LastFiredAt.Format(time.DateTime)
+				}
+				fmt.Printf("%s\t%t\t%d\t%s\t%s\n", r.Name, r.Enabled, r.Fired, last, r.String())
+			}
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "rules.enable",
+		Usage:       "rules.enable <name> [on|off]",
+		Group:       "Optional / Automation",
+		Description: "Switch a rule on (default) or off.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) < 1 || len(args) > 2 {
+				return fmt.Errorf("usage: rules.enable <name> [on|off]")
+			}
+			enabled := true
+			if len(args) == 2 {
+				switch strings.ToLower(args[1]) {
+				case "on":
+				case "off":
+					enabled = false
+				default:
+					return fmt.Errorf("usage: rules.enable <name> [on|off]")
+				}
+			}
+			svc := automation.NewAutomationService(clictx.AppCtx().Client())
+			if err := svc.EnableEventRule(ctx, args[0], enabled); err != nil {
+				return err
+			}
+			fmt.Printf("rule updated: NAME=%s ENABLED=%t\n", args[0], enabled)
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "rules.delete",
+		Usage:       "rules.delete <name>",
+		Group:       "Optional / Automation",
+		Description: "Remove a rule.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("usage: rules.delete <name>")
+			}
+			svc := automation.NewAutomationService(clictx.AppCtx().Client())
+			if err := svc.DeleteEventRule(ctx, args[0]); err != nil {
+				return err
+			}
+			fmt.Printf("rule deleted: NAME=%s\n", args[0])
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "rules.test",
+		Usage:       "rules.test <event> <entity> <ref> [key=value]...",
+		Group:       "Optional / Automation",
+		Description: "Show which rules an event would fire, without running them, e.g. rules.test stock.out stock_movement SKU1@L1 qty=5.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) < 3 {
+				return fmt.Errorf("usage: rules.test <event> <entity> <ref> [key=value]...")
+			}
+			e := auditlog.Event{
+				Action:    args[0],
+				Entity:    args[1],
+				EntityRef: args[2],
+				Details:   strings.Join(args[3:], " "),
+			}
+			svc := automation.NewAutomationService(clictx.AppCtx().Client())
+			runs, facts, err := svc.TestEventRules(ctx, e)
+			if err != nil {
+				return err
+			}
+			if len(runs) == 0 {
+				fmt.Printf("no rules for event %s\n", e.Action)
+				return nil
+			}
+
+			keys := make([]string, 0, len(facts))
+			for k := range facts {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			pairs := make([]string, 0, len(keys))
+			for _, k := range keys {
+				pairs = append(pairs, k+"="+facts[k])
+			}
+			fmt.Printf("facts: %s\n", strings.Join(pairs, " "))
+
+			fmt.Println("RULE\tENABLED\tMATCH\tACTION")
+			for _, r := range runs {
+				action := "-"
+				switch {
+				case r.Err != nil:
+					action = "error: " + r.Err.Error()
+				case r.Matched:
+					action = r.Result
+				}
+				fmt.Printf("%s\t%t\t%t\t%s\n", r.Rule, r.Enabled, r.Matched, action)
+			}
+			return nil
+		},
+	})
+}
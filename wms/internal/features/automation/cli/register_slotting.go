@@ -0,0 +1,82 @@
+//go:build automation && reporting
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/mxV03/wms/internal/features/automation"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/clictx"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/registry"
+	"github.com/mxV03/wms/internal/features/reporting"
+)
+
+func init() {
+	registry.Register(registry.Command{
+		Name:        "slot.advise",
+		Usage:       "slot.advise <locationCode> [volume|value] [weeks] [create]",
+		Group:       "Optional / Automation",
+		Description: "Recommend moving A items into the bins closest to the shipping zone (WMS_SHIPPING_ZONE, default SHIP); create: as SLOTTING tasks confirmed with replenish.confirm.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) < 1 || len(args) > 4 {
+				return fmt.Errorf("usage: slot.advise <locationCode> [volume|value] [weeks] [create]")
+			}
+			create := false
+			if args[len(args)-1] == "create" {
+				create = true
+				args = args[:len(args)-1]
+			}
+			if len(args) < 1 || len(args) > 3 {
+				return fmt.Errorf("usage: slot.advise <locationCode> [volume|value] [weeks] [create]")
+			}
+			basis := reporting.ABCVolume
+			if len(args) > 1 {
+				b, err := reporting.ParseABCBasis(args[1])
+				if err != nil {
+					return err
+				}
+				basis = b
+			}
+			weeks := 13
+			if len(args) == 3 {
+				n, err := strconv.Atoi(args[2])
+				if err != nil || n < 2 {
+					return fmt.Errorf("weeks must be an integer of at least 2")
+				}
+				weeks = n
+			}
+
+			svc := automation.NewAutomationService(clictx.AppCtx().Client())
+			advice, err := svc.AdviseSlotting(ctx, args[0], basis, weeks, create)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("slotting: LOCATION=%s SHIPPING=%s BASIS=%s FAST=%d MOVES=%d\n",
+				advice.Location, advice.ShippingZone, advice.Basis, advice.FastMovers, len(advice.Moves))
+			if len(advice.Moves) == 0 {
+				fmt.Println("no moves recommended")
+				return nil
+			}
+			fmt.Println("SKU\tCLASS\tQTY\tFROM\tTO\tFROM_M\tTO_M\tSWAP\tTASK")
+			for _, m := range advice.Moves {
+				task := "-"
+				if m.TaskID != 0 {
+					task = strconv.Itoa(m.TaskID)
+				}
+				fmt.Printf("%s\t%s\t%d\t%s\t%s\t%s\t%s\t%t\t%s\n",
+					m.SKU, m.Class, m.Quantity, m.FromBin, m.ToBin, metres(m.FromDistance), metres(m.ToDistance), m.Swap, task)
+			}
+			return nil
+		},
+	})
+}
+
+func metres(d float64) string {
+	if math.IsInf(d, 1) {
+		return "-"
+	}
+	return fmt.Sprintf("%.0f", d)
+}
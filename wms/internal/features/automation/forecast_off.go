@@ -0,0 +1,13 @@
+//go:build automation && !reporting
+
+package automation
+
+import (
+	"context"
+
+	"github.com/mxV03/wms/ent"
+)
+
+func leadTimeDemand(ctx context.Context, client *ent.Client, sku string, days int) (float64, bool, error) {
+	return 0, false, nil
+}
@@ -0,0 +1,24 @@
+//go:build automation && reporting
+
+package automation
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mxV03/wms/ent"
+	"github.com/mxV03/wms/internal/features/reporting"
+)
+
+// leadTimeDemand forecasts the demand of a SKU over the next days from its
+// outbound history. ok is false when the SKU has no history yet.
+func leadTimeDemand(ctx context.Context, client *ent.Client, sku string, days int) (float64, bool, error) {
+	d, err := reporting.NewReportService(client).DailyDemand(ctx, sku, days)
+	if errors.Is(err, reporting.ErrNoDemand) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return d, true, nil
+}
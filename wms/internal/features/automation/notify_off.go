@@ -0,0 +1,14 @@
+//go:build automation && !notifications
+
+package automation
+
+import (
+	"context"
+	"fmt"
+)
+
+var ErrNoNotifications = fmt.Errorf("notifications are not part of this product")
+
+func notify(ctx context.Context, subject, message string) error {
+	return ErrNoNotifications
+}
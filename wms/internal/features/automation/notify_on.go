@@ -0,0 +1,14 @@
+//go:build automation && notifications
+
+package automation
+
+import (
+	"context"
+
+	"github.com/mxV03/wms/internal/features/notifications"
+)
+
+// notify sends a notification to the configured recipients.
+func notify(ctx context.Context, subject, message string) error {
+	return notifications.NewNotificationService(notifications.LoadConfigFromEnv()).Send(ctx, subject, message)
+}
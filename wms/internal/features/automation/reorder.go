@@ -0,0 +1,327 @@
+//go:build automation
+
+package automation
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mxV03/wms/ent"
+	"github.com/mxV03/wms/ent/location"
+	"github.com/mxV03/wms/ent/order"
+	"github.com/mxV03/wms/ent/orderline"
+	"github.com/mxV03/wms/ent/reorderpolicy"
+	"github.com/mxV03/wms/internal/auditlog"
+	"github.com/mxV03/wms/internal/core/inventory/stock"
+	"github.com/mxV03/wms/internal/core/ordermanagement/orders"
+)
+
+var (
+	ErrInvalidPolicy  = fmt.Errorf("invalid reorder policy (reorder point >= safety stock >= 0, reorder quantity > 0, lead time >= 0)")
+	ErrPolicyNotFound = fmt.Errorf("reorder policy not found")
+)
+
+type PolicyDTO struct {
+	SKU             string
+	Location        string
+	Supplier        string
+	ReorderPoint    int
+	SafetyStock     int
+	ReorderQuantity int
+	LeadTimeDays    int
+}
+
+// PurchaseLineDTO is one item to order. Position is on hand plus open
+// inbound minus reserved. Forecast is the demand expected during the lead
+// time; it is only known with the reporting feature and outbound history.
+type PurchaseLineDTO struct {
+	SKU          string
+	Location     string
+	Quantity     int
+	OnHand       int
+	Inbound      int
+	Reserved     int
+	Position     int
+	ReorderPoint int
+	Forecast     int
+	Due          time.Time
+}
+
+// PurchaseDTO groups the lines of one supplier. OrderNr is set once the
+// DRAFT inbound order has been created.
+type PurchaseDTO struct {
+	Supplier string
+	OrderNr  string
+	Lines    []PurchaseLineDTO
+}
+
+// SetPolicy creates or replaces the reorder policy of an item.
+func (s *AutomationService) SetPolicy(ctx context.Context, p PolicyDTO) error {
+	p.Supplier = strings.TrimSpace(p.Supplier)
+	if p.SafetyStock < 0 || p.ReorderPoint < p.SafetyStock || p.ReorderQuantity <= 0 || p.LeadTimeDays < 0 {
+		return ErrInvalidPolicy
+	}
+	it, err := s.getItem(ctx, s.client, p.SKU)
+	if err != nil {
+		return err
+	}
+	locCode := strings.TrimSpace(p.Location)
+	if locCode == "" {
+		return ErrInvalidLocation
+	}
+	loc, err := s.client.Location.Query().
+		Where(location.Code(locCode)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrInvalidLocation
+		}
+		return fmt.Errorf("fetch location: %w", err)
+	}
+
+	existing, err := s.client.ReorderPolicy.Query().
+		Where(reorderpolicy.ItemID(it.ID)).
+		Only(ctx)
+	switch {
+	case err == nil:
+		err = s.client.ReorderPolicy.UpdateOne(existing).
+			SetLocation(loc).
+			SetReorderPoint(p.ReorderPoint).
+			SetSafetyStock(p.SafetyStock).
+			SetReorderQuantity(p.ReorderQuantity).
+			SetLeadTimeDays(p.LeadTimeDays).
+			SetSupplier(p.Supplier).
+			Exec(ctx)
+	case ent.IsNotFound(err):
+		err = s.client.ReorderPolicy.Create().
+			SetItem(it).
+			SetLocation(loc).
+			SetReorderPoint(p.ReorderPoint).
+			SetSafetyStock(p.SafetyStock).
+			SetReorderQuantity(p.ReorderQuantity).
+			SetLeadTimeDays(p.LeadTimeDays).
+			SetSupplier(p.Supplier).
+			Exec(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("save reorder policy: %w", err)
+	}
+	auditlog.Logf(ctx, "reorder.policy", "item", it.SKU, "rop=%d safety=%d qty=%d lead=%dd supplier=%s location=%s",
+		p.ReorderPoint, p.SafetyStock, p.ReorderQuantity, p.LeadTimeDays, p.Supplier, loc.Code)
+	return nil
+}
+
+// DeletePolicy removes the reorder policy of an item.
+func (s *AutomationService) DeletePolicy(ctx context.Context, sku string) error {
+	it, err := s.getItem(ctx, s.client, sku)
+	if err != nil {
+		return err
+	}
+	n, err := s.client.ReorderPolicy.Delete().
+		Where(reorderpolicy.ItemID(it.ID)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("delete reorder policy: %w", err)
+	}
+	if n == 0 {
+		return ErrPolicyNotFound
+	}
+	auditlog.Logf(ctx, "reorder.policy.delete", "item", it.SKU, "")
+	return nil
+}
+
+func (s *AutomationService) ListPolicies(ctx context.Context) ([]PolicyDTO, error) {
+	ps, err := s.client.ReorderPolicy.Query().
+		WithItem().
+		WithLocation().
+		Order(ent.Asc(reorderpolicy.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list reorder policies: %w", err)
+	}
+	out := make([]PolicyDTO, 0, len(ps))
+	for _, p := range ps {
+		out = append(out, toPolicyDTO(p))
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].SKU < out[j].SKU })
+	return out, nil
+}
+
+// SuggestPurchases evaluates every item with a reorder policy. An item whose
+// position is at or below its reorder point is ordered in multiples of its
+// reorder quantity until the position is above the reorder point again. When
+// the forecast demand over the lead time plus safety stock exceeds the
+// policy's reorder point, that is used instead. The lines are grouped by
+// supplier; with create, one DRAFT inbound order is created per supplier.
+func (s *AutomationService) SuggestPurchases(ctx context.Context, create bool) ([]PurchaseDTO, error) {
+	ps, err := s.client.ReorderPolicy.Query().
+		WithItem().
+		WithLocation().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list reorder policies: %w", err)
+	}
+
+	inbound, err := openInbound(ctx, s.client)
+	if err != nil {
+		return nil, err
+	}
+	reserved, err := reservedOutbound(ctx, s.client)
+	if err != nil {
+		return nil, err
+	}
+
+	stockSvc := stock.NewStockService(s.client)
+	bySupplier := map[string]*PurchaseDTO{}
+	for _, p := range ps {
+		sku := p.Edges.Item.SKU
+		onHand, err := stockSvc.StockBySKU(ctx, sku)
+		if err != nil {
+			return nil, err
+		}
+		demand, ok, err := leadTimeDemand(ctx, s.client, sku, p.LeadTimeDays)
+		if err != nil {
+			return nil, err
+		}
+		rop, forecast := p.ReorderPoint, 0
+		if ok {
+			forecast = int(math.Ceil(demand))
+			rop = max(rop, forecast+p.SafetyStock)
+		}
+
+		position := onHand + inbound[sku] - reserved[sku]
+		if position > rop {
+			continue
+		}
+		n := (rop-position)/p.ReorderQuantity + 1
+
+		pu, ok := bySupplier[p.Supplier]
+		if !ok {
+			pu = &PurchaseDTO{Supplier: p.Supplier}
+			bySupplier[p.Supplier] = pu
+		}
+		pu.Lines = append(pu.Lines, PurchaseLineDTO{
+			SKU:          sku,
+			Location:     p.Edges.Location.Code,
+			Quantity:     n * p.ReorderQuantity,
+			OnHand:       onHand,
+			Inbound:      inbound[sku],
+			Reserved:     reserved[sku],
+			Position:     position,
+			ReorderPoint: rop,
+			Forecast:     forecast,
+			Due:          time.Now().AddDate(0, 0, p.LeadTimeDays),
+		})
+	}
+
+	out := make([]PurchaseDTO, 0, len(bySupplier))
+	for _, pu := range bySupplier {
+		sort.Slice(pu.Lines, func(i, j int) bool { return pu.Lines[i].SKU < pu.Lines[j].SKU })
+		out = append(out, *pu)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Supplier < out[j].Supplier })
+
+	if !create {
+		return out, nil
+	}
+	for i := range out {
+		nr, err := s.createPurchaseOrder(ctx, out[i])
+		if err != nil {
+			return nil, err
+		}
+		out[i].OrderNr = nr
+	}
+	return out, nil
+}
+
+func (s *AutomationService) createPurchaseOrder(ctx context.Context, pu PurchaseDTO) (string, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return "", fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	orderSvc := orders.NewOrderService(tx.Client())
+	nr, err := orderSvc.NextOrderNumber(ctx, orders.OrderTypeInbound)
+	if err != nil {
+		return "", err
+	}
+	if _, err := orderSvc.CreateInboundOrder(ctx, nr); err != nil {
+		return "", err
+	}
+	units := 0
+	for _, l := range pu.Lines {
+		if _, err := orderSvc.AddLine(ctx, nr, l.SKU, l.Location, l.Quantity); err != nil {
+			return "", err
+		}
+		units += l.Quantity
+	}
+	if err := tx.Commit(); err != nil {
+		return "", fmt.Errorf("committing transaction: %w", err)
+	}
+	auditlog.Logf(ctx, "reorder.purchase", "order", nr, "supplier=%s lines=%d units=%d", pu.Supplier, len(pu.Lines), units)
+	return nr, nil
+}
+
+// openInbound returns the units per SKU still expected on DRAFT and
+// partially received inbound orders.
+func openInbound(ctx context.Context, client *ent.Client) (map[string]int, error) {
+	lines, err := client.OrderLine.Query().
+		Where(orderline.HasOrderWith(
+			order.Type(string(orders.OrderTypeInbound)),
+			order.StatusIn(string(orders.OrderStatusDraft), string(orders.OrderStatusPartiallyReceived)),
+		)).
+		WithItem().
+		WithReceipts().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetch inbound lines: %w", err)
+	}
+	out := map[string]int{}
+	for _, l := range lines {
+		open := l.Quantity
+		for _, r := range l.Edges.Receipts {
+			open -= r.Quantity + r.Damaged
+		}
+		if open > 0 {
+			out[l.Edges.Item.SKU] += open
+		}
+	}
+	return out, nil
+}
+
+// reservedOutbound returns the units per SKU on DRAFT outbound orders, which
+// are promised but not yet issued.
+func reservedOutbound(ctx context.Context, client *ent.Client) (map[string]int, error) {
+	lines, err := client.OrderLine.Query().
+		Where(orderline.HasOrderWith(
+			order.Type(string(orders.OrderTypeOutbound)),
+			order.Status(string(orders.OrderStatusDraft)),
+		)).
+		WithItem().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetch outbound lines: %w", err)
+	}
+	out := map[string]int{}
+	for _, l := range lines {
+		out[l.Edges.Item.SKU] += l.Quantity
+	}
+	return out, nil
+}
+
+func toPolicyDTO(p *ent.ReorderPolicy) PolicyDTO {
+	return PolicyDTO{
+		SKU:             p.Edges.Item.SKU,
+		Location:        p.Edges.Location.Code,
+		Supplier:        p.Supplier,
+		ReorderPoint:    p.ReorderPoint,
+		SafetyStock:     p.SafetyStock,
+		ReorderQuantity: p.ReorderQuantity,
+		LeadTimeDays:    p.LeadTimeDays,
+	}
+}
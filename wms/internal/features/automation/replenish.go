@@ -0,0 +1,510 @@
+//go:build automation
+
+package automation
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/mxV03/wms/ent"
+	"github.com/mxV03/wms/ent/bin"
+	"github.com/mxV03/wms/ent/binstock"
+	"github.com/mxV03/wms/ent/item"
+	"github.com/mxV03/wms/ent/location"
+	"github.com/mxV03/wms/ent/predicate"
+	"github.com/mxV03/wms/ent/replenishmentrule"
+	"github.com/mxV03/wms/ent/replenishmenttask"
+	"github.com/mxV03/wms/internal/auditlog"
+	"github.com/mxV03/wms/internal/features/logistics"
+)
+
+var (
+	ErrInvalidLevels   = fmt.Errorf("levels must satisfy 0 <= min < max")
+	ErrRuleNotFound    = fmt.Errorf("replenishment rule not found")
+	ErrTaskNotFound    = fmt.Errorf("replenishment task not found")
+	ErrTaskNotOpen     = fmt.Errorf("replenishment task is not open")
+	ErrInvalidInterval = fmt.Errorf("invalid interval (use a duration like 30s or 5m)")
+)
+
+// Kinds of replenishment tasks. Both move stock between two bins of a
+// location; slotting tasks come from the slotting advisor.
+const (
+	TaskReplenish = "REPLENISH"
+	TaskSlotting  = "SLOTTING"
+)
+
+// DefaultInterval is the pause between replenishment runs in continuous mode.
+const DefaultInterval = 5 * time.Minute
+
+type RuleDTO struct {
+	ID       int
+	Location string
+	Bin      string
+	SKU      string
+	Min      int
+	Max      int
+	Stock    int
+	Incoming int
+}
+
+type ReplenishTaskDTO struct {
+	ID        int
+	Kind      string
+	SKU       string
+	Location  string
+	FromBin   string
+	ToBin     string
+	Quantity  int
+	Status    string
+	CreatedAt time.Time
+	DoneAt    *time.Time
+}
+
+// ShortfallDTO is the part of a refill that no reserve bin could cover.
+type ShortfallDTO struct {
+	SKU      string
+	Location string
+	Bin      string
+	Missing  int
+}
+
+type ReplenishRunDTO struct {
+	At         time.Time
+	Rules      int
+	Tasks      []ReplenishTaskDTO
+	Shortfalls []ShortfallDTO
+}
+
+// IntervalFromEnv reads the continuous mode interval from
+// WMS_REPLENISH_INTERVAL.
+func IntervalFromEnv() (time.Duration, error) {
+	return ParseInterval(os.Getenv("WMS_REPLENISH_INTERVAL"))
+}
+
+func ParseInterval(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return DefaultInterval, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d < time.Second {
+		return 0, ErrInvalidInterval
+	}
+	return d, nil
+}
+
+// SetRule makes a bin the pick bin of an item and sets its min/max levels.
+func (s *AutomationService) SetRule(ctx context.Context, locCode, binCode, sku string, min, max int) error {
+	if min < 0 || max <= min {
+		return ErrInvalidLevels
+	}
+	b, err := s.getBin(ctx, s.client, locCode, binCode)
+	if err != nil {
+		return err
+	}
+	it, err := s.getItem(ctx, s.client, sku)
+	if err != nil {
+		return err
+	}
+
+	r, err := s.client.ReplenishmentRule.Query().
+		Where(
+			replenishmentrule.HasBinWith(bin.ID(b.ID)),
+			replenishmentrule.HasItemWith(item.ID(it.ID)),
+		).
+		Only(ctx)
+	switch {
+	case err == nil:
+		err = s.client.ReplenishmentRule.UpdateOne(r).
+			SetMin(min).
+			SetMax(max).
+			Exec(ctx)
+	case ent.IsNotFound(err):
+		err = s.client.ReplenishmentRule.Create().
+			SetBin(b).
+			SetItem(it).
+			SetMin(min).
+			SetMax(max).
+			Exec(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("save replenishment rule: %w", err)
+	}
+	auditlog.Logf(ctx, "replenish.rule", "bin", b.Edges.Location.Code+"/"+b.Code, "sku=%s min=%d max=%d", it.SKU, min, max)
+	return nil
+}
+
+// DeleteRule removes the min/max levels of an item in a bin.
+func (s *AutomationService) DeleteRule(ctx context.Context, locCode, binCode, sku string) error {
+	b, err := s.getBin(ctx, s.client, locCode, binCode)
+	if err != nil {
+		return err
+	}
+	it, err := s.getItem(ctx, s.client, sku)
+	if err != nil {
+		return err
+	}
+
+	n, err := s.client.ReplenishmentRule.Delete().
+		Where(
+			replenishmentrule.HasBinWith(bin.ID(b.ID)),
+			replenishmentrule.HasItemWith(item.ID(it.ID)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("delete replenishment rule: %w", err)
+	}
+	if n == 0 {
+		return ErrRuleNotFound
+	}
+	auditlog.Logf(ctx, "replenish.rule.delete", "bin", b.Edges.Location.Code+"/"+b.Code, "sku=%s", it.SKU)
+	return nil
+}
+
+// ListRules returns all rules with the current bin stock and the units on
+// their way from open tasks.
+func (s *AutomationService) ListRules(ctx context.Context) ([]RuleDTO, error) {
+	rules, err := s.client.ReplenishmentRule.Query().
+		WithBin(func(q *ent.BinQuery) {
+			q.WithLocation()
+		}).
+		WithItem().
+		Order(ent.Asc(replenishmentrule.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list replenishment rules: %w", err)
+	}
+
+	out := make([]RuleDTO, 0, len(rules))
+	for _, r := range rules {
+		b, it := r.Edges.Bin, r.Edges.Item
+		stock, err := binQuantity(ctx, s.client, b.ID, it.ID)
+		if err != nil {
+			return nil, err
+		}
+		incoming, err := openQuantity(ctx, s.client, replenishmenttask.HasToBinWith(bin.ID(b.ID)), it.ID)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, RuleDTO{
+			ID:       r.ID,
+			Location: b.Edges.Location.Code,
+			Bin:      b.Code,
+			SKU:      it.SKU,
+			Min:      r.Min,
+			Max:      r.Max,
+			Stock:    stock,
+			Incoming: incoming,
+		})
+	}
+	return out, nil
+}
+
+// Replenish checks every pick bin once. A bin whose stock plus open
+// replenishments is at or below min is refilled up to max from the reserve
+// bins of the same location, oldest stock first. Units already promised to
+// open tasks are neither counted twice nor moved twice.
+func (s *AutomationService) Replenish(ctx context.Context) (*ReplenishRunDTO, error) {
+	return s.replenish(ctx)
+}
+
+// ReplenishItem checks only the pick bins of one item, in one location or,
+// with an empty locCode, in all of them.
+func (s *AutomationService) ReplenishItem(ctx context.Context, sku, locCode string) (*ReplenishRunDTO, error) {
+	it, err := s.getItem(ctx, s.client, sku)
+	if err != nil {
+		return nil, err
+	}
+	preds := []predicate.ReplenishmentRule{replenishmentrule.HasItemWith(item.ID(it.ID))}
+	if locCode = strings.TrimSpace(locCode); locCode != "" {
+		preds = append(preds, replenishmentrule.HasBinWith(bin.HasLocationWith(location.Code(locCode))))
+	}
+	return s.replenish(ctx, preds...)
+}
+
+func (s *AutomationService) replenish(ctx context.Context, preds ...predicate.ReplenishmentRule) (*ReplenishRunDTO, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+	client := tx.Client()
+
+	rules, err := client.ReplenishmentRule.Query().
+		Where(preds...).
+		WithBin(func(q *ent.BinQuery) {
+			q.WithLocation()
+		}).
+		WithItem().
+		Order(ent.Asc(replenishmentrule.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list replenishment rules: %w", err)
+	}
+
+	// Bins with a rule for an item are pick bins and never serve as reserve.
+	pickBins := map[[2]int]bool{}
+	for _, r := range rules {
+		pickBins[[2]int{r.Edges.Bin.ID, r.Edges.Item.ID}] = true
+	}
+
+	run := &ReplenishRunDTO{
+		At:         time.Now(),
+		Rules:      len(rules),
+		Tasks:      make([]ReplenishTaskDTO, 0),
+		Shortfalls: make([]ShortfallDTO, 0),
+	}
+	for _, r := range rules {
+		b, it := r.Edges.Bin, r.Edges.Item
+		loc := b.Edges.Location
+
+		stock, err := binQuantity(ctx, client, b.ID, it.ID)
+		if err != nil {
+			return nil, err
+		}
+		incoming, err := openQuantity(ctx, client, replenishmenttask.HasToBinWith(bin.ID(b.ID)), it.ID)
+		if err != nil {
+			return nil, err
+		}
+		if stock+incoming > r.Min {
+			continue
+		}
+		need := r.Max - stock - incoming
+
+		reserves, err := client.BinStock.Query().
+			Where(
+				binstock.HasItemWith(item.ID(it.ID)),
+				binstock.HasBinWith(bin.HasLocationWith(location.ID(loc.ID))),
+				binstock.QuantityGT(0),
+			).
+			WithBin().
+			Order(ent.Asc(binstock.FieldReceivedAt), ent.Asc(binstock.FieldID)).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("fetch reserve stock: %w", err)
+		}
+
+		for _, rs := range reserves {
+			if need == 0 {
+				break
+			}
+			from := rs.Edges.Bin
+			if pickBins[[2]int{from.ID, it.ID}] {
+				continue
+			}
+			promised, err := openQuantity(ctx, client, replenishmenttask.HasFromBinWith(bin.ID(from.ID)), it.ID)
+			if err != nil {
+				return nil, err
+			}
+			qty := min(rs.Quantity-promised, need)
+			if qty <= 0 {
+				continue
+			}
+
+			t, err := client.ReplenishmentTask.Create().
+				SetItem(it).
+				SetFromBin(from).
+				SetToBin(b).
+				SetQuantity(qty).
+				SetKind(TaskReplenish).
+				Save(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("create replenishment task: %w", err)
+			}
+			need -= qty
+			run.Tasks = append(run.Tasks, ReplenishTaskDTO{
+				ID:        t.ID,
+				Kind:      t.Kind,
+				SKU:       it.SKU,
+				Location:  loc.Code,
+				FromBin:   from.Code,
+				ToBin:     b.Code,
+				Quantity:  qty,
+				Status:    t.Status,
+				CreatedAt: t.CreatedAt,
+			})
+		}
+		if need > 0 {
+			run.Shortfalls = append(run.Shortfalls, ShortfallDTO{
+				SKU:      it.SKU,
+				Location: loc.Code,
+				Bin:      b.Code,
+				Missing:  need,
+			})
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+	if len(run.Tasks) > 0 || len(run.Shortfalls) > 0 {
+		auditlog.Logf(ctx, "replenish.run", "replenishment", "", "rules=%d tasks=%d shortfalls=%d", run.Rules, len(run.Tasks), len(run.Shortfalls))
+	}
+	return run, nil
+}
+
+// Watch runs Replenish now and then every interval until ctx is done. Each
+// result is handed to report; a failed run does not stop the loop. The loop
+// never returns to the CLI, so the events of a run are flushed after it.
+func (s *AutomationService) Watch(ctx context.Context, interval time.Duration, report func(*ReplenishRunDTO, error)) error {
+	if interval < time.Second {
+		return ErrInvalidInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		report(s.Replenish(ctx))
+		auditlog.Flush(ctx)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// ListTasks returns open replenishment tasks, or all of them, oldest first.
+func (s *AutomationService) ListTasks(ctx context.Context, all bool, limit int) ([]ReplenishTaskDTO, error) {
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+
+	q := s.client.ReplenishmentTask.Query()
+	if !all {
+		q = q.Where(replenishmenttask.Status("OPEN"))
+	}
+	ts, err := q.
+		WithItem().
+		WithFromBin(func(bq *ent.BinQuery) {
+			bq.WithLocation()
+		}).
+		WithToBin().
+		Order(ent.Asc(replenishmenttask.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list replenishment tasks: %w", err)
+	}
+
+	out := make([]ReplenishTaskDTO, 0, len(ts))
+	for _, t := range ts {
+		out = append(out, ReplenishTaskDTO{
+			ID:        t.ID,
+			Kind:      t.Kind,
+			SKU:       t.Edges.Item.SKU,
+			Location:  t.Edges.FromBin.Edges.Location.Code,
+			FromBin:   t.Edges.FromBin.Code,
+			ToBin:     t.Edges.ToBin.Code,
+			Quantity:  t.Quantity,
+			Status:    t.Status,
+			CreatedAt: t.CreatedAt,
+			DoneAt:    t.DoneAt,
+		})
+	}
+	return out, nil
+}
+
+// ConfirmTask books the move of a replenishment task from the reserve bin
+// into the pick bin.
+func (s *AutomationService) ConfirmTask(ctx context.Context, taskID int) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	t, err := s.openTask(ctx, tx.Client(), taskID)
+	if err != nil {
+		return err
+	}
+	err = logistics.NewLocationService(tx.Client()).
+		MoveBinStock(ctx, t.Edges.FromBin, t.Edges.ToBin, t.Edges.Item, t.Quantity)
+	if err != nil {
+		return err
+	}
+	err = tx.ReplenishmentTask.UpdateOne(t).
+		SetStatus("DONE").
+		SetDoneAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("update replenishment task: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	auditlog.Logf(ctx, "replenish.confirm", "replenishment", fmt.Sprint(taskID), "sku=%s qty=%d from=%s to=%s",
+		t.Edges.Item.SKU, t.Quantity, t.Edges.FromBin.Code, t.Edges.ToBin.Code)
+	return nil
+}
+
+// CancelTask drops an open replenishment task; the next run may create a new one.
+func (s *AutomationService) CancelTask(ctx context.Context, taskID int) error {
+	t, err := s.openTask(ctx, s.client, taskID)
+	if err != nil {
+		return err
+	}
+	err = s.client.ReplenishmentTask.UpdateOne(t).
+		SetStatus("CANCELLED").
+		SetDoneAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("update replenishment task: %w", err)
+	}
+	auditlog.Logf(ctx, "replenish.cancel", "replenishment", fmt.Sprint(taskID), "sku=%s qty=%d", t.Edges.Item.SKU, t.Quantity)
+	return nil
+}
+
+func (s *AutomationService) openTask(ctx context.Context, client *ent.Client, taskID int) (*ent.ReplenishmentTask, error) {
+	t, err := client.ReplenishmentTask.Query().
+		Where(replenishmenttask.ID(taskID)).
+		WithItem().
+		WithFromBin().
+		WithToBin().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, fmt.Errorf("fetch replenishment task: %w", err)
+	}
+	if t.Status != "OPEN" {
+		return nil, ErrTaskNotOpen
+	}
+	return t, nil
+}
+
+func binQuantity(ctx context.Context, client *ent.Client, binID, itemID int) (int, error) {
+	bs, err := client.BinStock.Query().
+		Where(binstock.HasBinWith(bin.ID(binID)), binstock.HasItemWith(item.ID(itemID))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("fetch bin stock: %w", err)
+	}
+	return bs.Quantity, nil
+}
+
+// openQuantity sums the open replenishment tasks of an item matching p.
+func openQuantity(ctx context.Context, client *ent.Client, p predicate.ReplenishmentTask, itemID int) (int, error) {
+	ts, err := client.ReplenishmentTask.Query().
+		Where(
+			replenishmenttask.Status("OPEN"),
+			replenishmenttask.HasItemWith(item.ID(itemID)),
+			p,
+		).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("fetch open replenishments: %w", err)
+	}
+	total := 0
+	for _, t := range ts {
+		total += t.Quantity
+	}
+	return total, nil
+}
@@ -0,0 +1,540 @@
+//go:build automation
+
+package automation
+
+import (
+	"container/heap"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mxV03/wms/ent"
+	"github.com/mxV03/wms/ent/bin"
+	"github.com/mxV03/wms/ent/location"
+	"github.com/mxV03/wms/ent/picktask"
+	"github.com/mxV03/wms/ent/putawaytask"
+)
+
+var (
+	ErrInvalidRobots = fmt.Errorf("invalid number of robots")
+	ErrNoLayout      = fmt.Errorf("no bins with coordinates in location")
+	ErrNoRobotPlan   = fmt.Errorf("no collision-free plan found, try fewer robots")
+)
+
+const (
+	// handleTicks is how long a robot stands at a bin or the dock to load or
+	// unload.
+	handleTicks = 2
+	// maxExpansions bounds a single route search.
+	maxExpansions = 500000
+)
+
+const robotNames = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// RobotTaskDTO is one task done by a robot. Start is the tick the robot
+// sets off for it, Done the tick the load is delivered.
+type RobotTaskDTO struct {
+	Robot string
+	Kind  string // PICK, PUTAWAY
+	ID    int
+	Bin   string
+	Start int
+	Done  int
+}
+
+// RobotStatsDTO sums up one robot. Busy counts the ticks spent on tasks,
+// including waits for other robots and handling.
+type RobotStatsDTO struct {
+	Robot       string
+	Tasks       int
+	Moves       int
+	Waits       int
+	Busy        int
+	Utilization float64
+}
+
+// RobotSimDTO is the outcome of a simulation. Makespan is the tick the last
+// task is delivered, End the tick the last robot is back in its parking slot.
+// Skipped counts tasks without a bin with coordinates.
+type RobotSimDTO struct {
+	Location string
+	Robots   int
+	Width    int
+	Height   int
+	Tasks    []RobotTaskDTO
+	Skipped  int
+	Makespan int
+	End      int
+	Stats    []RobotStatsDTO
+
+	grid  *robotGrid
+	paths [][]int
+}
+
+// SimulateRobots plans the open pick and putaway tasks of a location for a
+// number of autonomous mobile robots on a grid model of its bins.
+//
+// Each aisle is a corridor one cell wide between two rack columns, with cross
+// aisles in front and at the back. Robots start and end in a parking row in
+// front of the racks. Picks are carried from their bin to the dock, putaways
+// from the dock to their suggested bin; the dock is the first bin of the
+// shipping zone (WMS_SHIPPING_ZONE) or the front of aisle 1. An idle robot
+// takes the task with the nearest pickup and returns to its parking slot
+// after the drop. Trips are planned one after another with space-time A*
+// around the trips planned before, so robots never share a cell or swap
+// places, and a robot waiting in its own slot never blocks the others.
+func (s *AutomationService) SimulateRobots(ctx context.Context, locCode string, robots int) (*RobotSimDTO, error) {
+	if robots < 1 || robots > len(robotNames) {
+		return nil, ErrInvalidRobots
+	}
+	loc, err := s.getLocation(ctx, locCode)
+	if err != nil {
+		return nil, err
+	}
+	bins, err := s.client.Bin.Query().
+		Where(bin.HasLocationWith(location.ID(loc.ID))).
+		WithZone().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list bins: %w", err)
+	}
+	g, access, err := buildRobotGrid(bins, ShippingZoneFromEnv())
+	if err != nil {
+		return nil, err
+	}
+	if robots > g.width {
+		return nil, fmt.Errorf("%w: the layout has %d parking slots", ErrInvalidRobots, g.width)
+	}
+
+	tasks, skipped, err := s.robotTasks(ctx, loc.ID, bins, access, g.dock)
+	if err != nil {
+		return nil, err
+	}
+	sim, err := planRobots(g, tasks, robots)
+	if err != nil {
+		return nil, err
+	}
+	sim.Location = loc.Code
+	sim.Skipped = skipped
+	return sim, nil
+}
+
+// Frame draws the floor at a tick: '#' racks, 'D' the dock, robots by name.
+// The parking row is at the bottom.
+func (sim *RobotSimDTO) Frame(t int) string {
+	g := sim.grid
+	at := map[int]byte{}
+	for r, p := range sim.paths {
+		at[p[min(t, len(p)-1)]] = robotNames[r]
+	}
+	var sb strings.Builder
+	for y := g.height - 1; y >= 0; y-- {
+		for x := 0; x < g.width; x++ {
+			c := y*g.width + x
+			switch ch, ok := at[c]; {
+			case ok:
+				sb.WriteByte(ch)
+			case g.rack[c]:
+				sb.WriteByte('#')
+			case c == g.dock:
+				sb.WriteByte('D')
+			default:
+				sb.WriteByte('.')
+			}
+		}
+		sb.WriteByte('\n')
+	}
+	return sb.String()
+}
+
+// robotGrid is the floor; cells are numbered y*width+x.
+type robotGrid struct {
+	width  int
+	height int
+	rack   []bool
+	dock   int
+	dist   map[int][]int // walking distances to a cell, ignoring robots
+}
+
+func buildRobotGrid(bins []*ent.Bin, shippingZone string) (*robotGrid, map[int]int, error) {
+	aisles, depth := 0, 0
+	for _, b := range bins {
+		if b.Aisle > 0 {
+			aisles = max(aisles, b.Aisle)
+			depth = max(depth, b.Position)
+		}
+	}
+	if aisles == 0 {
+		return nil, nil, ErrNoLayout
+	}
+
+	// rows: 0 parking, 1 front cross aisle, 2..depth+2 bin positions,
+	// depth+3 back cross aisle; aisle a runs along column 3a-2
+	g := &robotGrid{
+		width:  3 * aisles,
+		height: depth + 4,
+		dist:   map[int][]int{},
+	}
+	g.rack = make([]bool, g.width*g.height)
+	for y := 2; y <= depth+2; y++ {
+		for x := 0; x < g.width; x++ {
+			g.rack[y*g.width+x] = x%3 != 1
+		}
+	}
+
+	access := map[int]int{}
+	g.dock = 1*g.width + 1
+	dockBin := (*ent.Bin)(nil)
+	for _, b := range bins {
+		if b.Aisle == 0 {
+			continue
+		}
+		access[b.ID] = (b.Position+2)*g.width + 3*b.Aisle - 2
+		if b.Edges.Zone != nil && b.Edges.Zone.Code == shippingZone {
+			if dockBin == nil || b.Aisle < dockBin.Aisle || (b.Aisle == dockBin.Aisle && b.Position < dockBin.Position) {
+				dockBin = b
+			}
+		}
+	}
+	if dockBin != nil {
+		g.dock = access[dockBin.ID]
+	}
+	return g, access, nil
+}
+
+func (g *robotGrid) neighbours(c int) []int {
+	x, y := c%g.width, c/g.width
+	out := make([]int, 0, 4)
+	for _, d := range [][2]int{{1, 0}, {-1, 0}, {0, 1}, {0, -1}} {
+		nx, ny := x+d[0], y+d[1]
+		if nx < 0 || ny < 0 || nx >= g.width || ny >= g.height {
+			continue
+		}
+		if n := ny*g.width + nx; !g.rack[n] {
+			out = append(out, n)
+		}
+	}
+	return out
+}
+
+// distances returns the walking distance of every cell to goal.
+func (g *robotGrid) distances(goal int) []int {
+	if d, ok := g.dist[goal]; ok {
+		return d
+	}
+	d := make([]int, len(g.rack))
+	for i := range d {
+		d[i] = -1
+	}
+	d[goal] = 0
+	queue := []int{goal}
+	for len(queue) > 0 {
+		c := queue[0]
+		queue = queue[1:]
+		for _, n := range g.neighbours(c) {
+			if d[n] < 0 {
+				d[n] = d[c] + 1
+				queue = append(queue, n)
+			}
+		}
+	}
+	g.dist[goal] = d
+	return d
+}
+
+type robotTask struct {
+	kind   string
+	id     int
+	bin    string
+	pickup int
+	drop   int
+}
+
+func (s *AutomationService) robotTasks(ctx context.Context, locID int, bins []*ent.Bin, access map[int]int, dock int) ([]robotTask, int, error) {
+	ids := make([]int, 0, len(bins))
+	codes := map[int]string{}
+	for _, b := range bins {
+		ids = append(ids, b.ID)
+		codes[b.ID] = b.Code
+	}
+
+	picks, err := s.client.PickTask.Query().
+		Where(picktask.Status("OPEN"), picktask.BinIDIn(ids...)).
+		Order(ent.Asc(picktask.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("fetch pick tasks: %w", err)
+	}
+	putaways, err := s.client.PutawayTask.Query().
+		Where(
+			putawaytask.Status("OPEN"),
+			putawaytask.HasLocationWith(location.ID(locID)),
+		).
+		Order(ent.Asc(putawaytask.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("fetch putaway tasks: %w", err)
+	}
+
+	out := make([]robotTask, 0, len(picks)+len(putaways))
+	skipped := 0
+	for _, t := range picks {
+		c, ok := access[*t.BinID]
+		if !ok {
+			skipped++
+			continue
+		}
+		out = append(out, robotTask{kind: "PICK", id: t.ID, bin: codes[*t.BinID], pickup: c, drop: dock})
+	}
+	for _, t := range putaways {
+		if t.SuggestedBinID == nil {
+			skipped++
+			continue
+		}
+		c, ok := access[*t.SuggestedBinID]
+		if !ok {
+			skipped++
+			continue
+		}
+		out = append(out, robotTask{kind: "PUTAWAY", id: t.ID, bin: codes[*t.SuggestedBinID], pickup: dock, drop: c})
+	}
+	return out, skipped, nil
+}
+
+// planner keeps the routes planned so far. Between two trips a robot stands
+// in its parking slot, which no other robot enters.
+type planner struct {
+	grid     *robotGrid
+	reserved map[[2]int]int // cell, tick -> robot
+	moves    map[[3]int]int // from, to, tick -> robot
+	home     map[int]int    // parking slot -> robot
+	last     int            // last reserved tick
+}
+
+func planRobots(g *robotGrid, tasks []robotTask, robots int) (*RobotSimDTO, error) {
+	p := &planner{
+		grid:     g,
+		reserved: map[[2]int]int{},
+		moves:    map[[3]int]int{},
+		home:     map[int]int{},
+	}
+	sim := &RobotSimDTO{
+		Robots: robots,
+		Width:  g.width,
+		Height: g.height,
+		Tasks:  make([]RobotTaskDTO, 0, len(tasks)),
+		Stats:  make([]RobotStatsDTO, robots),
+		grid:   g,
+		paths:  make([][]int, robots),
+	}
+
+	// parking slots spread over the row in front of the racks
+	home := make([]int, robots)
+	free := make([]int, robots)
+	for r := range home {
+		home[r] = (2*r + 1) * g.width / (2 * robots)
+		p.home[home[r]] = r
+		sim.Stats[r].Robot = robotNames[r : r+1]
+		sim.paths[r] = []int{home[r]}
+	}
+
+	pending := append([]robotTask(nil), tasks...)
+	for len(pending) > 0 {
+		r := 0
+		for i := range free {
+			if free[i] < free[r] {
+				r = i
+			}
+		}
+
+		// the pending task with the nearest pickup
+		best := 0
+		for i, t := range pending {
+			if g.distances(t.pickup)[home[r]] < g.distances(pending[best].pickup)[home[r]] {
+				best = i
+			}
+		}
+		t := pending[best]
+		pending = append(pending[:best], pending[best+1:]...)
+
+		route, done := p.route(r, free[r], []int{home[r], t.pickup, t.drop, home[r]})
+		if route == nil {
+			return nil, ErrNoRobotPlan
+		}
+		p.commit(r, free[r], route)
+		sim.paths[r] = append(sim.paths[r], route[1:]...)
+
+		stats := &sim.Stats[r]
+		for i := 1; i < len(route); i++ {
+			if route[i] == route[i-1] {
+				stats.Waits++
+			} else {
+				stats.Moves++
+			}
+		}
+		stats.Waits -= 2 * handleTicks
+		stats.Tasks++
+		stats.Busy += done - free[r]
+		sim.Tasks = append(sim.Tasks, RobotTaskDTO{
+			Robot: stats.Robot,
+			Kind:  t.kind,
+			ID:    t.id,
+			Bin:   t.bin,
+			Start: free[r],
+			Done:  done,
+		})
+		sim.Makespan = max(sim.Makespan, done)
+		free[r] += len(route) - 1
+		sim.End = max(sim.End, free[r])
+	}
+
+	for i := range sim.Stats {
+		if sim.Makespan > 0 {
+			sim.Stats[i].Utilization = float64(sim.Stats[i].Busy) / float64(sim.Makespan)
+		}
+	}
+	sort.SliceStable(sim.Tasks, func(i, j int) bool { return sim.Tasks[i].Start < sim.Tasks[j].Start })
+	return sim, nil
+}
+
+// commit reserves a route starting at tick t.
+func (p *planner) commit(robot, t int, route []int) {
+	for i, c := range route {
+		p.reserved[[2]int{c, t + i}] = robot
+		if i > 0 && route[i-1] != c {
+			p.moves[[3]int{route[i-1], c, t + i - 1}] = robot
+		}
+	}
+	p.last = max(p.last, t+len(route)-1)
+}
+
+func (p *planner) blocked(robot, c, t int) bool {
+	if r, ok := p.reserved[[2]int{c, t}]; ok && r != robot {
+		return true
+	}
+	if r, ok := p.home[c]; ok && r != robot {
+		return true
+	}
+	return false
+}
+
+// route searches the fastest trip along waypoints from tick t0 that avoids
+// all planned routes: the first waypoint is the start, the robot stands
+// handleTicks at every waypoint in between. It returns the cell per tick and
+// the tick the handling at the last but one waypoint is done, or nil when
+// there is no such trip.
+//
+// The trip starts and ends in the robot's own parking slot, where it can
+// wait until all other routes are done, so a trip is always found.
+func (p *planner) route(robot, t0 int, wps []int) ([]int, int) {
+	g := p.grid
+	last := len(wps) - 1
+
+	// rest[k] is the least time from waypoint k to the end of the trip
+	rest := make([]int, len(wps))
+	for k := last - 1; k >= 1; k-- {
+		d := g.distances(wps[k+1])[wps[k]]
+		if d < 0 {
+			return nil, 0
+		}
+		rest[k] = rest[k+1] + d + handleTicks
+	}
+	estimate := func(c, k int) int {
+		return g.distances(wps[k])[c] + rest[k]
+	}
+	if estimate(wps[0], 1) < 0 {
+		return nil, 0
+	}
+	horizon := max(t0, p.last) + estimate(wps[0], 1) + len(g.rack)
+
+	type node struct{ c, t, k int }
+	parent := map[node]node{}
+	seen := map[node]bool{}
+	open := &routeQueue{}
+	start := node{wps[0], t0, 1}
+	heap.Push(open, routeItem{c: start.c, t: t0, k: 1, f: t0 + estimate(start.c, 1)})
+	seen[start] = true
+
+	push := func(from, to node) {
+		if seen[to] {
+			return
+		}
+		seen[to] = true
+		parent[to] = from
+		heap.Push(open, routeItem{c: to.c, t: to.t, k: to.k, f: to.t + estimate(to.c, to.k)})
+	}
+
+	for expansions := 0; open.Len() > 0 && expansions < maxExpansions; expansions++ {
+		it := heap.Pop(open).(routeItem)
+		cur := node{it.c, it.t, it.k}
+		if cur.k == last && cur.c == wps[last] {
+			route := make([]int, cur.t-t0+1)
+			done := t0
+			for n := cur; ; n = parent[n] {
+				route[n.t-t0] = n.c
+				if n.t == t0 {
+					break
+				}
+				prev := parent[n]
+				for t := prev.t + 1; t < n.t; t++ {
+					route[t-t0] = n.c // handling
+				}
+				if prev.k == last-1 && n.k == last {
+					done = n.t
+				}
+			}
+			return route, done
+		}
+		if cur.t >= horizon {
+			continue
+		}
+
+		// handle the load at a waypoint
+		if cur.k < last && cur.c == wps[cur.k] {
+			ok := true
+			for t := cur.t + 1; t <= cur.t+handleTicks; t++ {
+				if p.blocked(robot, cur.c, t) {
+					ok = false
+					break
+				}
+			}
+			if ok {
+				push(cur, node{cur.c, cur.t + handleTicks, cur.k + 1})
+			}
+		}
+
+		for _, n := range append(g.neighbours(cur.c), cur.c) {
+			nt := cur.t + 1
+			if p.blocked(robot, n, nt) {
+				continue
+			}
+			if r, ok := p.moves[[3]int{n, cur.c, cur.t}]; ok && r != robot && n != cur.c {
+				continue // the other robot comes the opposite way
+			}
+			push(cur, node{n, nt, cur.k})
+		}
+	}
+	return nil, 0
+}
+
+type routeItem struct{ c, t, k, f int }
+
+// routeQueue orders by estimate, then prefers later ticks (deeper nodes).
+type routeQueue []routeItem
+
+func (q routeQueue) Len() int { return len(q) }
+func (q routeQueue) Less(i, j int) bool {
+	if q[i].f != q[j].f {
+		return q[i].f < q[j].f
+	}
+	return q[i].t > q[j].t
+}
+func (q routeQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+func (q *routeQueue) Push(x any)   { *q = append(*q, x.(routeItem)) }
+func (q *routeQueue) Pop() any {
+	old := *q
+	it := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return it
+}
@@ -0,0 +1,544 @@
+//go:build automation
+
+package automation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mxV03/wms/ent"
+	"github.com/mxV03/wms/ent/eventrule"
+	"github.com/mxV03/wms/ent/order"
+	"github.com/mxV03/wms/internal/auditlog"
+	corelocation "github.com/mxV03/wms/internal/core/inventory/location"
+	"github.com/mxV03/wms/internal/core/inventory/stock"
+)
+
+var (
+	ErrInvalidRuleName   = fmt.Errorf("invalid rule name")
+	ErrInvalidRuleSyntax = fmt.Errorf("invalid rule (use: when <event> [and <field> <op> <value>]... then <action> [argument])")
+	ErrInvalidCondition  = fmt.Errorf("invalid condition (use: <field> <op> <value> with op one of = != < <= > >=)")
+	ErrInvalidAction     = fmt.Errorf("invalid action (use notify, replenish or hold)")
+	ErrEventRuleExists   = fmt.Errorf("rule already exists")
+	ErrEventRuleNotFound = fmt.Errorf("rule not found")
+	ErrNoEventSKU        = fmt.Errorf("event has no sku")
+	ErrNoEventLocation   = fmt.Errorf("event has no location")
+)
+
+// Actions of event rules.
+const (
+	// ActionNotify sends a notification; the argument is the message, in
+	// which {field} is replaced by the value of the field.
+	ActionNotify = "NOTIFY"
+	// ActionReplenish checks the pick bins of the event's item at once.
+	ActionReplenish = "REPLENISH"
+	// ActionHold puts the location of the argument, or else of the event,
+	// on hold.
+	ActionHold = "HOLD"
+)
+
+var conditionOps = []string{"=", "!=", "<", "<=", ">", ">="}
+
+// Condition compares a field of an event with a value. Values that are
+// numbers on both sides are compared as numbers, others as text ignoring
+// case.
+type Condition struct {
+	Field string
+	Op    string
+	Value string
+}
+
+func (c Condition) String() string {
+	return c.Field + " " + c.Op + " " + c.Value
+}
+
+type EventRuleDTO struct {
+	ID          int
+	Name        string
+	Event       string
+	Conditions  []Condition
+	Action      string
+	Argument    string
+	Enabled     bool
+	Fired       int
+	LastFiredAt *time.Time
+}
+
+// String is the rule as it is written in rules.add.
+func (r EventRuleDTO) String() string {
+	var sb strings.Builder
+	sb.WriteString("when " + r.Event)
+	for _, c := range r.Conditions {
+		sb.WriteString(" and " + c.String())
+	}
+	sb.WriteString(" then " + strings.ToLower(r.Action))
+	if r.Argument != "" {
+		sb.WriteString(" " + r.Argument)
+	}
+	return sb.String()
+}
+
+// RuleRunDTO is the outcome of one rule for an event. Result describes what
+// the action did, or would do when testing.
+type RuleRunDTO struct {
+	Rule    string
+	Enabled bool
+	Matched bool
+	Action  string
+	Result  string
+	Err     error
+}
+
+// ParseEventRule reads "when <event> [and <field> <op> <value>]... then
+// <action> [argument]". The argument is the rest of the text.
+func ParseEventRule(text string) (*EventRuleDTO, error) {
+	words := strings.Fields(text)
+	if len(words) < 4 || !strings.EqualFold(words[0], "when") {
+		return nil, ErrInvalidRuleSyntax
+	}
+	r := &EventRuleDTO{Event: words[1], Enabled: true}
+
+	i := 2
+	for i < len(words) && strings.EqualFold(words[i], "and") {
+		if i+3 >= len(words) {
+			return nil, ErrInvalidRuleSyntax
+		}
+		c, err := parseCondition(words[i+1 : i+4])
+		if err != nil {
+			return nil, err
+		}
+		r.Conditions = append(r.Conditions, c)
+		i += 4
+	}
+	if i+1 >= len(words) || !strings.EqualFold(words[i], "then") {
+		return nil, ErrInvalidRuleSyntax
+	}
+	action, err := parseAction(words[i+1])
+	if err != nil {
+		return nil, err
+	}
+	r.Action = action
+	r.Argument = strings.Join(words[i+2:], " ")
+	return r, nil
+}
+
+func parseCondition(words []string) (Condition, error) {
+	if len(words) != 3 {
+		return Condition{}, ErrInvalidCondition
+	}
+	c := Condition{Field: strings.ToLower(words[0]), Op: words[1], Value: words[2]}
+	for _, op := range conditionOps {
+		if c.Op == op {
+			return c, nil
+		}
+	}
+	return Condition{}, ErrInvalidCondition
+}
+
+// parseConditions reads conditions as stored, joined by " and ".
+func parseConditions(s string) ([]Condition, error) {
+	out := make([]Condition, 0)
+	if strings.TrimSpace(s) == "" {
+		return out, nil
+	}
+	for _, part := range strings.Split(s, " and ") {
+		c, err := parseCondition(strings.Fields(part))
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, c)
+	}
+	return out, nil
+}
+
+func parseAction(s string) (string, error) {
+	switch a := strings.ToUpper(strings.TrimSpace(s)); a {
+	case ActionNotify, ActionReplenish, ActionHold:
+		return a, nil
+	default:
+		return "", ErrInvalidAction
+	}
+}
+
+// AddEventRule stores a new enabled rule, see ParseEventRule.
+func (s *AutomationService) AddEventRule(ctx context.Context, name, text string) (*EventRuleDTO, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || strings.ContainsAny(name, " \t") {
+		return nil, ErrInvalidRuleName
+	}
+	r, err := ParseEventRule(text)
+	if err != nil {
+		return nil, err
+	}
+
+	conds := make([]string, 0, len(r.Conditions))
+	for _, c := range r.Conditions {
+		conds = append(conds, c.String())
+	}
+	e, err := s.client.EventRule.Create().
+		SetName(name).
+		SetEvent(r.Event).
+		SetConditions(strings.Join(conds, " and ")).
+		SetAction(r.Action).
+		SetArgument(r.Argument).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, ErrEventRuleExists
+		}
+		return nil, fmt.Errorf("create rule: %w", err)
+	}
+	r.ID = e.ID
+	r.Name = e.Name
+	auditlog.Logf(ctx, "rule.add", "rule", name, "%s", r)
+	return r, nil
+}
+
+// ListEventRules returns all rules by name.
+func (s *AutomationService) ListEventRules(ctx context.Context) ([]EventRuleDTO, error) {
+	rules, err := s.client.EventRule.Query().
+		Order(ent.Asc(eventrule.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list rules: %w", err)
+	}
+	out := make([]EventRuleDTO, 0, len(rules))
+	for _, e := range rules {
+		r, err := toEventRuleDTO(e)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, *r)
+	}
+	return out, nil
+}
+
+// EnableEventRule switches a rule on or off.
+func (s *AutomationService) EnableEventRule(ctx context.Context, name string, enabled bool) error {
+	n, err := s.client.EventRule.Update().
+		Where(eventrule.Name(strings.TrimSpace(name))).
+		SetEnabled(enabled).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("update rule: %w", err)
+	}
+	if n == 0 {
+		return ErrEventRuleNotFound
+	}
+	auditlog.Logf(ctx, "rule.enable", "rule", name, "enabled=%t", enabled)
+	return nil
+}
+
+func (s *AutomationService) DeleteEventRule(ctx context.Context, name string) error {
+	n, err := s.client.EventRule.Delete().
+		Where(eventrule.Name(strings.TrimSpace(name))).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("delete rule: %w", err)
+	}
+	if n == 0 {
+		return ErrEventRuleNotFound
+	}
+	auditlog.Logf(ctx, "rule.delete", "rule", name, "")
+	return nil
+}
+
+// EventFacts are the fields conditions and messages can refer to: event,
+// entity and ref, the key=value pairs of the details that do not clash with
+// these, and what is known about the entity. Stock movements add sku,
+// location and stock (on hand at the location); orders add order, type,
+// status, priority and the location when all lines are in one.
+func (s *AutomationService) EventFacts(ctx context.Context, e auditlog.Event) (map[string]string, error) {
+	facts := map[string]string{
+		"event":  e.Action,
+		"entity": e.Entity,
+		"ref":    e.EntityRef,
+	}
+	for _, w := range strings.Fields(e.Details) {
+		k, v, ok := strings.Cut(w, "=")
+		k = strings.ToLower(k)
+		if _, taken := facts[k]; ok && k != "" && !taken {
+			facts[k] = v
+		}
+	}
+
+	switch e.Entity {
+	case "stock_movement":
+		if sku, loc, ok := strings.Cut(e.EntityRef, "@"); ok {
+			facts["sku"], facts["location"] = sku, loc
+		}
+	case "item":
+		facts["sku"] = e.EntityRef
+	case "location":
+		facts["location"] = e.EntityRef
+	case "order":
+		o, err := s.client.Order.Query().
+			Where(order.OrderNumber(e.EntityRef)).
+			WithLines(func(q *ent.OrderLineQuery) {
+				q.WithLocation()
+			}).
+			Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, fmt.Errorf("fetch order: %w", err)
+		}
+		if o != nil {
+			facts["order"] = o.OrderNumber
+			facts["type"] = o.Type
+			facts["status"] = o.Status
+			facts["priority"] = o.Priority
+			if loc := orderLocation(o); loc != "" {
+				facts["location"] = loc
+			}
+		}
+	}
+
+	if sku := facts["sku"]; sku != "" {
+		svc := stock.NewStockService(s.client)
+		var (
+			n   int
+			err error
+		)
+		if loc := facts["location"]; loc != "" {
+			n, err = svc.StockAtLocation(ctx, sku, loc)
+		} else {
+			n, err = svc.StockBySKU(ctx, sku)
+		}
+		if err == nil {
+			facts["stock"] = strconv.Itoa(n)
+		}
+	}
+	return facts, nil
+}
+
+// TestEventRules evaluates all rules for an event without running their
+// actions, disabled rules included.
+func (s *AutomationService) TestEventRules(ctx context.Context, e auditlog.Event) ([]RuleRunDTO, map[string]string, error) {
+	return s.runEventRules(ctx, e, false)
+}
+
+// FireEventRules runs the actions of the enabled rules that match an event.
+// A failing action does not stop the others; its error is in the result.
+func (s *AutomationService) FireEventRules(ctx context.Context, e auditlog.Event) ([]RuleRunDTO, error) {
+	runs, _, err := s.runEventRules(ctx, e, true)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]RuleRunDTO, 0, len(runs))
+	for _, r := range runs {
+		if r.Matched {
+			out = append(out, r)
+		}
+	}
+	return out, nil
+}
+
+func (s *AutomationService) runEventRules(ctx context.Context, e auditlog.Event, fire bool) ([]RuleRunDTO, map[string]string, error) {
+	q := s.client.EventRule.Query().
+		Where(eventrule.Event(e.Action))
+	if fire {
+		q = q.Where(eventrule.Enabled(true))
+	}
+	rules, err := q.Order(ent.Asc(eventrule.FieldName)).All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("list rules: %w", err)
+	}
+	if len(rules) == 0 {
+		return []RuleRunDTO{}, nil, nil
+	}
+	facts, err := s.EventFacts(ctx, e)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	out := make([]RuleRunDTO, 0, len(rules))
+	for _, er := range rules {
+		r, err := toEventRuleDTO(er)
+		if err != nil {
+			return nil, nil, err
+		}
+		run := RuleRunDTO{
+			Rule:    r.Name,
+			Enabled: r.Enabled,
+			Matched: matches(r.Conditions, facts),
+			Action:  r.Action,
+		}
+		if run.Matched {
+			if fire {
+				run.Result, run.Err = s.runAction(ctx, r, facts)
+				if err := s.client.EventRule.UpdateOneID(r.ID).
+					AddFired(1).
+					SetLastFiredAt(time.Now()).
+					Exec(ctx); err != nil {
+					return nil, nil, fmt.Errorf("update rule: %w", err)
+				}
+				auditlog.Logf(ctx, "rule.fire", "rule", r.Name, "event=%s ref=%s action=%s", e.Action, e.EntityRef, r.Action)
+			} else {
+				run.Result, run.Err = describeAction(r, facts)
+			}
+		}
+		out = append(out, run)
+	}
+	return out, facts, nil
+}
+
+func toEventRuleDTO(e *ent.EventRule) (*EventRuleDTO, error) {
+	conds, err := parseConditions(e.Conditions)
+	if err != nil {
+		return nil, fmt.Errorf("rule %s: %w", e.Name, err)
+	}
+	return &EventRuleDTO{
+		ID:          e.ID,
+		Name:        e.Name,
+		Event:       e.Event,
+		Conditions:  conds,
+		Action:      e.Action,
+		Argument:    e.Argument,
+		Enabled:     e.Enabled,
+		Fired:       e.Fired,
+		LastFiredAt: e.LastFiredAt,
+	}, nil
+}
+
+// matches reports whether all conditions hold. A condition on a field the
+// event does not have never holds.
+func matches(conds []Condition, facts map[string]string) bool {
+	for _, c := range conds {
+		v, ok := facts[c.Field]
+		if !ok || !compare(v, c.Op, c.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+func compare(a, op, b string) bool {
+	x, errA := strconv.ParseFloat(a, 64)
+	y, errB := strconv.ParseFloat(b, 64)
+	if errA == nil && errB == nil {
+		switch op {
+		case "=":
+			return x == y
+		case "!=":
+			return x != y
+		case "<":
+			return x < y
+		case "<=":
+			return x <= y
+		case ">":
+			return x > y
+		case ">=":
+			return x >= y
+		}
+		return false
+	}
+	switch op {
+	case "=":
+		return strings.EqualFold(a, b)
+	case "!=":
+		return !strings.EqualFold(a, b)
+	}
+	return false
+}
+
+// describeAction says what runAction would do.
+func describeAction(r *EventRuleDTO, facts map[string]string) (string, error) {
+	switch r.Action {
+	case ActionNotify:
+		return fmt.Sprintf("notify %q", message(r, facts)), nil
+	case ActionReplenish:
+		if facts["sku"] == "" {
+			return "", ErrNoEventSKU
+		}
+		return fmt.Sprintf("replenish SKU=%s LOCATION=%s", facts["sku"], dash(facts["location"])), nil
+	case ActionHold:
+		loc := holdLocation(r, facts)
+		if loc == "" {
+			return "", ErrNoEventLocation
+		}
+		return fmt.Sprintf("hold LOCATION=%s", loc), nil
+	}
+	return "", ErrInvalidAction
+}
+
+func (s *AutomationService) runAction(ctx context.Context, r *EventRuleDTO, facts map[string]string) (string, error) {
+	switch r.Action {
+	case ActionNotify:
+		msg := message(r, facts)
+		if err := notify(ctx, "RULE "+r.Name, msg); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("notified %q", msg), nil
+	case ActionReplenish:
+		if facts["sku"] == "" {
+			return "", ErrNoEventSKU
+		}
+		run, err := s.ReplenishItem(ctx, facts["sku"], facts["location"])
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("replenish TASKS=%d SHORTFALLS=%d", len(run.Tasks), len(run.Shortfalls)), nil
+	case ActionHold:
+		loc := holdLocation(r, facts)
+		if loc == "" {
+			return "", ErrNoEventLocation
+		}
+		reason := fmt.Sprintf("rule %s: %s %s", r.Name, facts["event"], facts["ref"])
+		if err := corelocation.NewLocationService(s.client).Hold(ctx, loc, reason); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("hold LOCATION=%s", loc), nil
+	}
+	return "", ErrInvalidAction
+}
+
+// message fills the {field} placeholders of a notify rule. Without an
+// argument the message names the event.
+func message(r *EventRuleDTO, facts map[string]string) string {
+	if r.Argument == "" {
+		return fmt.Sprintf("%s %s", facts["event"], facts["ref"])
+	}
+	keys := make([]string, 0, len(facts))
+	for k := range facts {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, 2*len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, "{"+k+"}", facts[k])
+	}
+	return strings.NewReplacer(pairs...).Replace(r.Argument)
+}
+
+// orderLocation is the location of all lines of an order, or "" for lines
+// in several locations.
+func orderLocation(o *ent.Order) string {
+	code := ""
+	for _, l := range o.Edges.Lines {
+		if l.Edges.Location == nil {
+			continue
+		}
+		if code != "" && code != l.Edges.Location.Code {
+			return ""
+		}
+		code = l.Edges.Location.Code
+	}
+	return code
+}
+
+func holdLocation(r *EventRuleDTO, facts map[string]string) string {
+	if r.Argument != "" {
+		return r.Argument
+	}
+	return facts["location"]
+}
+
+func dash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,104 @@
+//go:build automation
+
+package automation
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/mxV03/wms/ent"
+	"github.com/mxV03/wms/ent/bin"
+	"github.com/mxV03/wms/ent/item"
+	"github.com/mxV03/wms/ent/location"
+)
+
+var (
+	ErrInvalidLocation = fmt.Errorf("invalid location code")
+	ErrInvalidBinCode  = fmt.Errorf("invalid bin code")
+	ErrInvalidSKU      = fmt.Errorf("invalid sku")
+	ErrBinNotFound     = fmt.Errorf("bin not found")
+	ErrItemNotFound    = fmt.Errorf("item not found")
+)
+
+// DefaultShippingZone is the zone code of the shipping area when
+// WMS_SHIPPING_ZONE is not set.
+const DefaultShippingZone = "SHIP"
+
+// ShippingZoneFromEnv reads the shipping zone code from WMS_SHIPPING_ZONE.
+func ShippingZoneFromEnv() string {
+	if z := strings.TrimSpace(os.Getenv("WMS_SHIPPING_ZONE")); z != "" {
+		return z
+	}
+	return DefaultShippingZone
+}
+
+type AutomationService struct {
+	client *ent.Client
+}
+
+func NewAutomationService(client *ent.Client) *AutomationService {
+	return &AutomationService{
+		client: client,
+	}
+}
+
+func (s *AutomationService) getLocation(ctx context.Context, locCode string) (*ent.Location, error) {
+	locCode = strings.TrimSpace(locCode)
+	if locCode == "" {
+		return nil, ErrInvalidLocation
+	}
+
+	loc, err := s.client.Location.Query().
+		Where(location.Code(locCode)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrInvalidLocation
+		}
+		return nil, fmt.Errorf("fetch location: %w", err)
+	}
+	return loc, nil
+}
+
+func (s *AutomationService) getBin(ctx context.Context, client *ent.Client, locCode, binCode string) (*ent.Bin, error) {
+	locCode = strings.TrimSpace(locCode)
+	binCode = strings.TrimSpace(binCode)
+	if locCode == "" {
+		return nil, ErrInvalidLocation
+	}
+	if binCode == "" {
+		return nil, ErrInvalidBinCode
+	}
+
+	b, err := client.Bin.Query().
+		Where(bin.Code(binCode), bin.HasLocationWith(location.Code(locCode))).
+		WithLocation().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrBinNotFound
+		}
+		return nil, fmt.Errorf("fetch bin: %w", err)
+	}
+	return b, nil
+}
+
+func (s *AutomationService) getItem(ctx context.Context, client *ent.Client, sku string) (*ent.Item, error) {
+	sku = strings.TrimSpace(sku)
+	if sku == "" {
+		return nil, ErrInvalidSKU
+	}
+
+	it, err := client.Item.Query().
+		Where(item.SKU(sku)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrItemNotFound
+		}
+		return nil, fmt.Errorf("fetch item: %w", err)
+	}
+	return it, nil
+}
@@ -0,0 +1,313 @@
+//go:build automation && reporting
+
+package automation
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/mxV03/wms/ent"
+	"github.com/mxV03/wms/ent/bin"
+	"github.com/mxV03/wms/ent/binstock"
+	"github.com/mxV03/wms/ent/location"
+	"github.com/mxV03/wms/ent/replenishmentrule"
+	"github.com/mxV03/wms/ent/replenishmenttask"
+	"github.com/mxV03/wms/internal/auditlog"
+	"github.com/mxV03/wms/internal/features/logistics"
+	"github.com/mxV03/wms/internal/features/reporting"
+)
+
+var ErrNoSlottingBins = fmt.Errorf("no bins with coordinates outside the shipping zone")
+
+// SlotMoveDTO moves the stock of an item between two bins. A swap clears the
+// target bin for a fast mover by moving a slower item into the bin the fast
+// mover leaves. Distances are walks from the shipping zone; a bin without
+// coordinates is infinitely far. TaskID is set once the task is created.
+type SlotMoveDTO struct {
+	SKU          string
+	Class        string
+	Quantity     int
+	FromBin      string
+	ToBin        string
+	FromDistance float64
+	ToDistance   float64
+	Swap         bool
+	TaskID       int
+}
+
+type SlottingDTO struct {
+	Location     string
+	ShippingZone string
+	Basis        reporting.ABCBasis
+	FastMovers   int
+	Moves        []SlotMoveDTO
+}
+
+// AdviseSlotting recommends moving the A items of the ABC analysis into the
+// bins closest to the shipping zone of a location, the fastest item into the
+// closest bin. Bins with replenishment rules or open tasks are left alone.
+// With create, every move becomes an open SLOTTING task that is confirmed
+// like a replenishment.
+func (s *AutomationService) AdviseSlotting(ctx context.Context, locCode string, basis reporting.ABCBasis, weeks int, create bool) (*SlottingDTO, error) {
+	loc, err := s.getLocation(ctx, locCode)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := reporting.NewReportService(s.client).ABC(ctx, basis, weeks)
+	if err != nil {
+		return nil, err
+	}
+
+	bins, err := s.client.Bin.Query().
+		Where(bin.HasLocationWith(location.ID(loc.ID))).
+		WithZone().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list bins: %w", err)
+	}
+	locked, err := s.lockedBins(ctx, loc.ID)
+	if err != nil {
+		return nil, err
+	}
+	stocks, err := s.client.BinStock.Query().
+		Where(
+			binstock.HasBinWith(bin.HasLocationWith(location.ID(loc.ID))),
+			binstock.QuantityGT(0),
+		).
+		WithBin().
+		WithItem().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetch bin stock: %w", err)
+	}
+
+	out := &SlottingDTO{
+		Location:     loc.Code,
+		ShippingZone: ShippingZoneFromEnv(),
+		Basis:        basis,
+		Moves:        make([]SlotMoveDTO, 0),
+	}
+	dist := slotDistances(bins, out.ShippingZone)
+
+	// storage bins: outside the shipping zone and not locked
+	byID := map[int]*ent.Bin{}
+	ranked := make([]*ent.Bin, 0, len(bins))
+	for _, b := range bins {
+		if locked[b.ID] || b.Edges.Zone.Code == out.ShippingZone {
+			continue
+		}
+		byID[b.ID] = b
+		if b.Aisle > 0 {
+			ranked = append(ranked, b)
+		}
+	}
+	if len(ranked) == 0 {
+		return nil, ErrNoSlottingBins
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if di, dj := dist[ranked[i].ID], dist[ranked[j].ID]; di != dj {
+			return di < dj
+		}
+		return ranked[i].Code < ranked[j].Code
+	})
+
+	contents := map[int]map[string]int{}
+	items := map[string]*ent.Item{}
+	home := map[string]*ent.Bin{}
+	for _, bs := range stocks {
+		b, sku := byID[bs.Edges.Bin.ID], bs.Edges.Item.SKU
+		if b == nil {
+			continue
+		}
+		if contents[b.ID] == nil {
+			contents[b.ID] = map[string]int{}
+		}
+		contents[b.ID][sku] = bs.Quantity
+		items[sku] = bs.Edges.Item
+		// an item spread over several bins is slotted by its fullest bin
+		if h := home[sku]; h == nil || bs.Quantity > contents[h.ID][sku] ||
+			(bs.Quantity == contents[h.ID][sku] && dist[b.ID] < dist[h.ID]) {
+			home[sku] = b
+		}
+	}
+
+	fast := make([]reporting.ABCRowDTO, 0)
+	isFast := map[string]bool{}
+	for _, r := range rows {
+		if r.ABC == "A" && home[r.SKU] != nil {
+			fast = append(fast, r)
+			isFast[r.SKU] = true
+		}
+	}
+	out.FastMovers = len(fast)
+
+	claimed := map[int]bool{}
+	for _, r := range fast {
+		h := home[r.SKU]
+		var target *ent.Bin
+		for _, b := range ranked {
+			if claimed[b.ID] || holdsOther(contents[b.ID], r.SKU, isFast) {
+				continue
+			}
+			target = b
+			break
+		}
+		if target == nil {
+			break
+		}
+		if target.ID == h.ID || dist[h.ID] <= dist[target.ID] {
+			claimed[h.ID] = true
+			continue
+		}
+		claimed[target.ID] = true
+		claimed[h.ID] = true
+
+		out.Moves = append(out.Moves, SlotMoveDTO{
+			SKU:          r.SKU,
+			Quantity:     contents[h.ID][r.SKU],
+			FromBin:      h.Code,
+			ToBin:        target.Code,
+			FromDistance: dist[h.ID],
+			ToDistance:   dist[target.ID],
+		})
+		others := make([]string, 0, len(contents[target.ID]))
+		for sku := range contents[target.ID] {
+			others = append(others, sku)
+		}
+		sort.Strings(others)
+		for _, sku := range others {
+			out.Moves = append(out.Moves, SlotMoveDTO{
+				SKU:          sku,
+				Quantity:     contents[target.ID][sku],
+				FromBin:      target.Code,
+				ToBin:        h.Code,
+				FromDistance: dist[target.ID],
+				ToDistance:   dist[h.ID],
+				Swap:         true,
+			})
+		}
+	}
+	classes := map[string]string{}
+	for _, r := range rows {
+		classes[r.SKU] = r.Class()
+	}
+	for i := range out.Moves {
+		out.Moves[i].Class = classes[out.Moves[i].SKU]
+	}
+
+	if !create || len(out.Moves) == 0 {
+		return out, nil
+	}
+	codes := map[string]*ent.Bin{}
+	for _, b := range byID {
+		codes[b.Code] = b
+	}
+	if err := s.createSlotTasks(ctx, out, codes, items); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (s *AutomationService) createSlotTasks(ctx context.Context, advice *SlottingDTO, bins map[string]*ent.Bin, items map[string]*ent.Item) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for i, m := range advice.Moves {
+		t, err := tx.ReplenishmentTask.Create().
+			SetItem(items[m.SKU]).
+			SetFromBin(bins[m.FromBin]).
+			SetToBin(bins[m.ToBin]).
+			SetQuantity(m.Quantity).
+			SetKind(TaskSlotting).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("create slotting task: %w", err)
+		}
+		advice.Moves[i].TaskID = t.ID
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	auditlog.Logf(ctx, "slotting.create", "location", advice.Location, "basis=%s moves=%d", advice.Basis, len(advice.Moves))
+	return nil
+}
+
+// lockedBins are pick bins with replenishment rules and bins with open tasks;
+// moving stock there would interfere with replenishment.
+func (s *AutomationService) lockedBins(ctx context.Context, locID int) (map[int]bool, error) {
+	rules, err := s.client.ReplenishmentRule.Query().
+		Where(replenishmentrule.HasBinWith(bin.HasLocationWith(location.ID(locID)))).
+		WithBin().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list replenishment rules: %w", err)
+	}
+	tasks, err := s.client.ReplenishmentTask.Query().
+		Where(
+			replenishmenttask.Status("OPEN"),
+			replenishmenttask.HasFromBinWith(bin.HasLocationWith(location.ID(locID))),
+		).
+		WithFromBin().
+		WithToBin().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetch open replenishments: %w", err)
+	}
+
+	out := map[int]bool{}
+	for _, r := range rules {
+		out[r.Edges.Bin.ID] = true
+	}
+	for _, t := range tasks {
+		out[t.Edges.FromBin.ID] = true
+		out[t.Edges.ToBin.ID] = true
+	}
+	return out, nil
+}
+
+// holdsOther reports whether a bin holds a fast item other than sku.
+func holdsOther(contents map[string]int, sku string, isFast map[string]bool) bool {
+	for other := range contents {
+		if other != sku && isFast[other] {
+			return true
+		}
+	}
+	return false
+}
+
+// slotDistances is the walk from the nearest shipping zone bin to every bin.
+// Without coordinated shipping bins the front of aisle 1 is the dock, as for
+// pick routes.
+func slotDistances(bins []*ent.Bin, shippingZone string) map[int]float64 {
+	depth := 0
+	docks := make([]*ent.Bin, 0)
+	for _, b := range bins {
+		depth = max(depth, b.Position)
+		if b.Aisle > 0 && b.Edges.Zone.Code == shippingZone {
+			docks = append(docks, b)
+		}
+	}
+	if len(docks) == 0 {
+		docks = append(docks, &ent.Bin{Aisle: 1})
+	}
+
+	out := make(map[int]float64, len(bins))
+	for _, b := range bins {
+		if b.Aisle == 0 {
+			out[b.ID] = math.Inf(1)
+			continue
+		}
+		best := math.Inf(1)
+		for _, d := range docks {
+			best = math.Min(best, logistics.WalkDistance(logistics.BinSpot(d), logistics.BinSpot(b), depth))
+		}
+		out[b.ID] = best
+	}
+	return out
+}
@@ -0,0 +1,88 @@
+//go:build edi
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/mxV03/wms/internal/features/edi"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/clictx"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/registry"
+)
+
+func init() {
+	registry.Register(registry.Command{
+		Name:        "edi.config",
+		Usage:       "edi.config",
+		Group:       "Optional / EDI",
+		Description: "Show runtime EDI configuration.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 0 {
+				return fmt.Errorf("usage: edi.config")
+			}
+			c := edi.NewEDIService(clictx.AppCtx().Client()).Config()
+			fmt.Printf("sender=%s\nreceiver=%s\ndefault_location=%s\n", c.SenderID, c.ReceiverID, c.DefaultLocation)
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "edi.import",
+		Usage:       "edi.import <file>",
+		Group:       "Optional / EDI",
+		Description: "Import X12 940 or EDIFACT ORDERS messages as outbound orders.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("usage: edi.import <file>")
+			}
+			data, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("reading file: %w", err)
+			}
+
+			svc := edi.NewEDIService(clictx.AppCtx().Client())
+			results, err := svc.Import(ctx, string(data))
+			if err != nil {
+				return err
+			}
+			if len(results) == 0 {
+				fmt.Println("no orders in file")
+				return nil
+			}
+			for _, r := range results {
+				fmt.Printf("%s\t%s\t%s\tlines=%d", r.Status, r.Standard, r.OrderNumber, r.Lines)
+				if r.Message != "" {
+					fmt.Printf("\t%s", r.Message)
+				}
+				fmt.Println()
+			}
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "edi.export",
+		Usage:       "edi.export <order_number> [x12|edifact]",
+		Group:       "Optional / EDI",
+		Description: "Print the shipping advice (945/DESADV) or receiving advice (RECADV) for an order.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) < 1 || len(args) > 2 {
+				return fmt.Errorf("usage: edi.export <order_number> [x12|edifact]")
+			}
+			standard := ""
+			if len(args) == 2 {
+				standard = args[1]
+			}
+
+			svc := edi.NewEDIService(clictx.AppCtx().Client())
+			msg, err := svc.Export(ctx, args[0], standard)
+			if err != nil {
+				return err
+			}
+			fmt.Print(msg)
+			return nil
+		},
+	})
+}
@@ -0,0 +1,207 @@
+//go:build edi
+
+package edi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type edifactSyntax struct {
+	comp, elem, release, seg byte
+}
+
+var defaultSyntax = edifactSyntax{comp: ':', elem: '+', release: '?', seg: '\''}
+
+// splitEdifact breaks an interchange into segments, elements and components.
+// Separators come from the UNA service string advice when present, released
+// characters (e.g. ?+) are taken literally.
+func splitEdifact(data string) ([][][]string, error) {
+	syn := defaultSyntax
+	if strings.HasPrefix(data, "UNA") {
+		if len(data) < 9 {
+			return nil, fmt.Errorf("%w: truncated UNA", ErrMalformed)
+		}
+		syn = edifactSyntax{comp: data[3], elem: data[4], release: data[6], seg: data[8]}
+		data = data[9:]
+	}
+
+	var (
+		segs [][][]string
+		seg  [][]string
+		el   []string
+		cur  strings.Builder
+	)
+	flushComp := func() {
+		el = append(el, cur.String())
+		cur.Reset()
+	}
+	flushElem := func() {
+		flushComp()
+		seg = append(seg, el)
+		el = nil
+	}
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+		switch {
+		case c == syn.release && i+1 < len(data):
+			i++
+			cur.WriteByte(data[i])
+		case c == syn.comp:
+			flushComp()
+		case c == syn.elem:
+			flushElem()
+		case c == syn.seg:
+			flushElem()
+			segs = append(segs, seg)
+			seg = nil
+		case c == '\r' || c == '\n':
+			// line breaks between segments are not part of the data
+		default:
+			cur.WriteByte(c)
+		}
+	}
+	if strings.TrimSpace(cur.String()) != "" || len(seg) > 0 {
+		return nil, fmt.Errorf("%w: missing segment terminator", ErrMalformed)
+	}
+	return segs, nil
+}
+
+func component(seg [][]string, elem, comp int) string {
+	if elem >= len(seg) || comp >= len(seg[elem]) {
+		return ""
+	}
+	return strings.TrimSpace(seg[elem][comp])
+}
+
+// ParseEdifactOrders reads all ORDERS messages of an interchange. The order
+// number comes from BGM, the ship-by date from DTM+2 (requested delivery) or
+// DTM+10 (requested shipment), the warehouse location from LOC+18 and the
+// lines from LIN with QTY+21. A LOC+18 inside a line group overrides the
+// header location for that line.
+func ParseEdifactOrders(data string) ([]OrderMessage, error) {
+	segs, err := splitEdifact(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		out  []OrderMessage
+		cur  *OrderMessage
+		line *OrderMessageLine
+	)
+	for n, seg := range segs {
+		id := component(seg, 0, 0)
+		if cur == nil && id != "UNH" && id != "UNB" && id != "UNZ" {
+			return nil, fmt.Errorf("%w: segment %d (%s) outside of a message", ErrMalformed, n+1, id)
+		}
+		switch id {
+		case "UNH":
+			if t := component(seg, 2, 0); t != "ORDERS" {
+				return nil, fmt.Errorf("%w: EDIFACT %s", ErrUnsupportedMsg, t)
+			}
+			cur = &OrderMessage{Standard: StandardEdifact}
+			line = nil
+		case "BGM":
+			cur.OrderNumber = component(seg, 2, 0)
+		case "DTM":
+			if q := component(seg, 1, 0); q == "2" || q == "10" {
+				if f := component(seg, 1, 2); f != "" && f != "102" {
+					return nil, fmt.Errorf("%w: segment %d: unsupported date format %s", ErrMalformed, n+1, f)
+				}
+				t, err := parseDate(component(seg, 1, 1))
+				if err != nil {
+					return nil, fmt.Errorf("%w: segment %d: %v", ErrMalformed, n+1, err)
+				}
+				cur.ShipBy = &t
+			}
+		case "LOC":
+			if component(seg, 1, 0) == "18" {
+				if line != nil {
+					line.Location = component(seg, 2, 0)
+				} else {
+					cur.Location = component(seg, 2, 0)
+				}
+			}
+		case "LIN":
+			cur.Lines = append(cur.Lines, OrderMessageLine{SKU: component(seg, 3, 0)})
+			line = &cur.Lines[len(cur.Lines)-1]
+		case "QTY":
+			if line != nil && component(seg, 1, 0) == "21" {
+				qty, err := strconv.Atoi(component(seg, 1, 1))
+				if err != nil {
+					return nil, fmt.Errorf("%w: segment %d: invalid quantity %q", ErrMalformed, n+1, component(seg, 1, 1))
+				}
+				line.Quantity = qty
+			}
+		case "UNS":
+			line = nil
+		case "UNT":
+			if cur.OrderNumber == "" {
+				return nil, fmt.Errorf("%w: ORDERS without BGM order number", ErrMalformed)
+			}
+			out = append(out, *cur)
+			cur, line = nil, nil
+		}
+	}
+	if cur != nil {
+		return nil, fmt.Errorf("%w: missing UNT segment", ErrMalformed)
+	}
+	return out, nil
+}
+
+// WriteDesadv renders a DESADV despatch advice for a shipped order.
+func WriteDesadv(cfg Config, adv Advice) string {
+	return writeEdifact(cfg, adv, "DESADV", "351", "11", func(seg func(...string), l AdviceLine) {
+		seg("QTY", "12:"+strconv.Itoa(l.Quantity))
+	})
+}
+
+// WriteRecadv renders a RECADV receiving advice. Damaged units are reported
+// separately from the units received in good condition.
+func WriteRecadv(cfg Config, adv Advice) string {
+	return writeEdifact(cfg, adv, "RECADV", "632", "50", func(seg func(...string), l AdviceLine) {
+		seg("QTY", "21:"+strconv.Itoa(l.Ordered))
+		seg("QTY", "48:"+strconv.Itoa(l.Quantity))
+		if l.Damaged > 0 {
+			seg("QTY", "124:"+strconv.Itoa(l.Damaged))
+		}
+	})
+}
+
+func writeEdifact(cfg Config, adv Advice, msgType, docCode, dateQual string, qty func(func(...string), AdviceLine)) string {
+	var b strings.Builder
+	seg := func(elems ...string) {
+		b.WriteString(strings.Join(elems, "+"))
+		b.WriteString("'\n")
+	}
+
+	ctrl := strconv.Itoa(adv.Control)
+	b.WriteString("UNA:+.? '\n")
+	seg("UNB", "UNOC:3", escapeEdifact(cfg.SenderID), escapeEdifact(cfg.ReceiverID), adv.Date.Format("060102")+":"+adv.Date.Format("1504"), ctrl)
+
+	count := 0
+	msgSeg := func(elems ...string) {
+		count++
+		seg(elems...)
+	}
+	msgSeg("UNH", "1", msgType+":D:96A:UN")
+	msgSeg("BGM", docCode, escapeEdifact(adv.OrderNumber), "9")
+	msgSeg("DTM", dateQual+":"+adv.Date.Format("20060102")+":102")
+	msgSeg("RFF", "ON:"+escapeEdifact(adv.OrderNumber))
+	for i, l := range adv.Lines {
+		msgSeg("LIN", strconv.Itoa(i+1), "", escapeEdifact(l.SKU)+":SA")
+		qty(msgSeg, l)
+		msgSeg("LOC", "18", escapeEdifact(l.Location))
+	}
+	msgSeg("UNS", "S")
+	seg("UNT", strconv.Itoa(count+1), "1")
+	seg("UNZ", "1", ctrl)
+	return b.String()
+}
+
+func escapeEdifact(s string) string {
+	r := strings.NewReplacer("?", "??", ":", "?:", "+", "?+", "'", "?'")
+	return r.Replace(s)
+}
@@ -0,0 +1,97 @@
+//go:build edi
+
+package edi
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files")
+
+func golden(t *testing.T, name, got string) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != string(want) {
+		t.Errorf("%s differs:\n--- got\n%s--- want\n%s", name, got, want)
+	}
+}
+
+func formatMessages(msgs []OrderMessage) string {
+	var b strings.Builder
+	for _, m := range msgs {
+		shipBy := "-"
+		if m.ShipBy != nil {
+			shipBy = m.ShipBy.Format(time.DateTime)
+		}
+		fmt.Fprintf(&b, "%s order=%s location=%s ship_by=%s\n", m.Standard, m.OrderNumber, m.Location, shipBy)
+		for _, l := range m.Lines {
+			fmt.Fprintf(&b, "  sku=%s location=%s qty=%d\n", l.SKU, l.Location, l.Quantity)
+		}
+	}
+	return b.String()
+}
+
+func TestParseX12940(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "940.x12"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	msgs, err := ParseX12940(string(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden(t, "940.golden", formatMessages(msgs))
+}
+
+func TestParseEdifactOrders(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "orders.edi"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	msgs, err := ParseEdifactOrders(string(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden(t, "orders.golden", formatMessages(msgs))
+}
+
+var (
+	testConfig = Config{SenderID: "WMS", ReceiverID: "PARTNER"}
+	testAdvice = Advice{
+		OrderNumber: "SO-1001",
+		Control:     42,
+		Date:        time.Date(2026, 3, 2, 14, 5, 0, 0, time.UTC),
+		Lines: []AdviceLine{
+			{SKU: "SKU-A", Location: "L1", Ordered: 5, Quantity: 5},
+			{SKU: "SKU-B", Location: "L1", Ordered: 4, Quantity: 3, Damaged: 1},
+		},
+	}
+)
+
+func TestWriteX12945(t *testing.T) {
+	golden(t, "945.golden", WriteX12945(testConfig, testAdvice))
+}
+
+func TestWriteDesadv(t *testing.T) {
+	golden(t, "desadv.golden", WriteDesadv(testConfig, testAdvice))
+}
+
+func TestWriteRecadv(t *testing.T) {
+	golden(t, "recadv.golden", WriteRecadv(testConfig, testAdvice))
+}
@@ -0,0 +1,306 @@
+//go:build edi
+
+package edi
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/mxV03/wms/ent"
+	"github.com/mxV03/wms/ent/order"
+	"github.com/mxV03/wms/ent/orderline"
+	"github.com/mxV03/wms/internal/auditlog"
+	"github.com/mxV03/wms/internal/core/ordermanagement/orders"
+)
+
+const (
+	StandardX12     = "X12"
+	StandardEdifact = "EDIFACT"
+)
+
+var (
+	ErrUnknownStandard   = fmt.Errorf("unknown EDI standard (x12 or edifact)")
+	ErrUnsupportedMsg    = fmt.Errorf("unsupported EDI message")
+	ErrMalformed         = fmt.Errorf("malformed EDI message")
+	ErrNoLocation        = fmt.Errorf("no location given and WMS_EDI_DEFAULT_LOCATION not set")
+	ErrNotExportable     = fmt.Errorf("order has not been shipped or received yet")
+	ErrNoReceivingAdvice = fmt.Errorf("receiving advice is only available as EDIFACT RECADV")
+)
+
+type Config struct {
+	SenderID        string
+	ReceiverID      string
+	DefaultLocation string
+}
+
+func LoadConfig() Config {
+	c := Config{
+		SenderID:        strings.TrimSpace(os.Getenv("WMS_EDI_SENDER")),
+		ReceiverID:      strings.TrimSpace(os.Getenv("WMS_EDI_RECEIVER")),
+		DefaultLocation: strings.TrimSpace(os.Getenv("WMS_EDI_DEFAULT_LOCATION")),
+	}
+	if c.SenderID == "" {
+		c.SenderID = "WMS"
+	}
+	if c.ReceiverID == "" {
+		c.ReceiverID = "PARTNER"
+	}
+	return c
+}
+
+// OrderMessage is a shipping order parsed from an X12 940 or EDIFACT ORDERS
+// message, independent of the standard it came from.
+type OrderMessage struct {
+	Standard    string
+	OrderNumber string
+	ShipBy      *time.Time
+	Location    string
+	Lines       []OrderMessageLine
+}
+
+type OrderMessageLine struct {
+	SKU      string
+	Location string
+	Quantity int
+}
+
+// Advice is the content of an outgoing shipping (945, DESADV) or receiving
+// (RECADV) advice.
+type Advice struct {
+	OrderNumber string
+	Control     int
+	Date        time.Time
+	Lines       []AdviceLine
+}
+
+type AdviceLine struct {
+	SKU      string
+	Location string
+	Ordered  int
+	Quantity int
+	Damaged  int
+}
+
+type ImportResult struct {
+	OrderNumber string
+	Standard    string
+	Lines       int
+	Status      string
+	Message     string
+}
+
+type EDIService struct {
+	client *ent.Client
+	cfg    Config
+}
+
+func NewEDIService(client *ent.Client) *EDIService {
+	return &EDIService{
+		client: client,
+		cfg:    LoadConfig(),
+	}
+}
+
+func (s *EDIService) Config() Config {
+	return s.cfg
+}
+
+// Parse detects the standard from the envelope (ISA for X12, UNA/UNB for
+// EDIFACT) and returns all shipping orders of the interchange.
+func Parse(data string) ([]OrderMessage, error) {
+	data = strings.TrimSpace(strings.TrimPrefix(data, "\ufeff"))
+	switch {
+	case strings.HasPrefix(data, "ISA"):
+		return ParseX12940(data)
+	case strings.HasPrefix(data, "UNA"), strings.HasPrefix(data, "UNB"):
+		return ParseEdifactOrders(data)
+	default:
+		return nil, ErrUnknownStandard
+	}
+}
+
+// Import creates one outbound order per message through the order service.
+// Every order is created with its lines in its own transaction; orders whose
+// number already exists are skipped.
+func (s *EDIService) Import(ctx context.Context, data string) ([]ImportResult, error) {
+	msgs, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]ImportResult, 0, len(msgs))
+	for _, m := range msgs {
+		res := ImportResult{OrderNumber: m.OrderNumber, Standard: m.Standard, Lines: len(m.Lines)}
+
+		exists, err := s.client.Order.Query().
+			Where(order.OrderNumber(m.OrderNumber)).
+			Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("checking order existence: %w", err)
+		}
+		if exists {
+			res.Status, res.Message = orders.ImportSkipped, orders.ErrOrderExists.Error()
+			out = append(out, res)
+			continue
+		}
+
+		if err := s.createOrder(ctx, m); err != nil {
+			res.Status, res.Message = orders.ImportFailed, err.Error()
+			out = append(out, res)
+			continue
+		}
+		res.Status = orders.ImportCreated
+		out = append(out, res)
+	}
+	return out, nil
+}
+
+func (s *EDIService) createOrder(ctx context.Context, m OrderMessage) error {
+	if len(m.Lines) == 0 {
+		return orders.ErrNoLines
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	orderSvc := orders.NewOrderService(tx.Client())
+	if _, err := orderSvc.CreateOutboundOrder(ctx, m.OrderNumber); err != nil {
+		return err
+	}
+	if m.ShipBy != nil {
+		if err := orderSvc.SetShipBy(ctx, m.OrderNumber, *m.ShipBy); err != nil {
+			return err
+		}
+	}
+	for i, l := range m.Lines {
+		loc := l.Location
+		if loc == "" {
+			loc = m.Location
+		}
+		if loc == "" {
+			loc = s.cfg.DefaultLocation
+		}
+		if loc == "" {
+			return fmt.Errorf("line %d: %w", i+1, ErrNoLocation)
+		}
+		if _, err := orderSvc.AddLine(ctx, m.OrderNumber, l.SKU, loc, l.Quantity); err != nil {
+			return fmt.Errorf("line %d: %w", i+1, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	auditlog.Logf(ctx, "edi.import", "order", m.OrderNumber, "standard=%s lines=%d", m.Standard, len(m.Lines))
+	return nil
+}
+
+// Export renders the advice for an order: X12 945 or EDIFACT DESADV for
+// posted outbound orders and EDIFACT RECADV for received inbound orders. An
+// empty standard picks X12 for outbound and EDIFACT for inbound orders.
+func (s *EDIService) Export(ctx context.Context, number, standard string) (string, error) {
+	number = strings.TrimSpace(number)
+	if number == "" {
+		return "", orders.ErrInvalidOrderNo
+	}
+
+	o, err := s.client.Order.Query().
+		Where(order.OrderNumber(number)).
+		WithLines(func(lq *ent.OrderLineQuery) {
+			lq.WithItem().WithLocation().Order(ent.Asc(orderline.FieldID))
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", orders.ErrOrderNotFound
+		}
+		return "", fmt.Errorf("fetching order: %w", err)
+	}
+
+	standard = strings.ToUpper(strings.TrimSpace(standard))
+	if standard == "" {
+		standard = StandardX12
+		if o.Type == string(orders.OrderTypeInbound) {
+			standard = StandardEdifact
+		}
+	}
+	if standard != StandardX12 && standard != StandardEdifact {
+		return "", ErrUnknownStandard
+	}
+
+	adv := Advice{OrderNumber: o.OrderNumber, Control: o.ID, Date: time.Now()}
+	if o.PostedAt != nil {
+		adv.Date = *o.PostedAt
+	}
+
+	switch o.Type {
+	case string(orders.OrderTypeOutbound):
+		if o.Status != string(orders.OrderStatusPosted) {
+			return "", ErrNotExportable
+		}
+		// short picks issue less than ordered; the shipped stock is spread
+		// over the lines of a SKU in line order
+		shipped, err := orders.NewOrderService(s.client).ShippedQuantities(ctx, o.OrderNumber)
+		if err != nil {
+			return "", err
+		}
+		for _, l := range o.Edges.Lines {
+			sku := l.Edges.Item.SKU
+			qty := min(l.Quantity, shipped[sku])
+			shipped[sku] -= qty
+			adv.Lines = append(adv.Lines, AdviceLine{
+				SKU:      sku,
+				Location: l.Edges.Location.Code,
+				Ordered:  l.Quantity,
+				Quantity: qty,
+			})
+		}
+		if standard == StandardX12 {
+			return WriteX12945(s.cfg, adv), nil
+		}
+		return WriteDesadv(s.cfg, adv), nil
+
+	case string(orders.OrderTypeInbound):
+		if standard != StandardEdifact {
+			return "", ErrNoReceivingAdvice
+		}
+		switch o.Status {
+		case string(orders.OrderStatusPosted):
+			// Posted without line-level receiving: everything arrived as expected.
+			for _, l := range o.Edges.Lines {
+				adv.Lines = append(adv.Lines, AdviceLine{
+					SKU:      l.Edges.Item.SKU,
+					Location: l.Edges.Location.Code,
+					Ordered:  l.Quantity,
+					Quantity: l.Quantity,
+				})
+			}
+		case string(orders.OrderStatusPartiallyReceived), string(orders.OrderStatusReceived):
+			lines, err := orders.NewOrderService(s.client).ReceivingStatus(ctx, o.OrderNumber)
+			if err != nil {
+				return "", err
+			}
+			for _, l := range lines {
+				adv.Lines = append(adv.Lines, AdviceLine{
+					SKU:      l.SKU,
+					Location: l.LocationCode,
+					Ordered:  l.Expected,
+					Quantity: l.Received,
+					Damaged:  l.Damaged,
+				})
+			}
+		default:
+			return "", ErrNotExportable
+		}
+		return WriteRecadv(s.cfg, adv), nil
+
+	default:
+		return "", orders.ErrInvalidOrderType
+	}
+}
@@ -0,0 +1,173 @@
+//go:build edi
+
+package edi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// splitX12 breaks an interchange into segments and elements. The element
+// separator is the fourth character of ISA, the segment terminator follows
+// the component separator in ISA16.
+func splitX12(data string) ([][]string, error) {
+	if len(data) < 4 || !strings.HasPrefix(data, "ISA") {
+		return nil, fmt.Errorf("%w: missing ISA header", ErrMalformed)
+	}
+	elemSep := data[3]
+
+	term := -1
+	seps := 0
+	for i := 0; i < len(data); i++ {
+		if data[i] != elemSep {
+			continue
+		}
+		seps++
+		if seps == 16 {
+			term = i + 2
+			break
+		}
+	}
+	if term < 0 || term >= len(data) {
+		return nil, fmt.Errorf("%w: truncated ISA header", ErrMalformed)
+	}
+	segTerm := data[term]
+
+	var segs [][]string
+	for _, raw := range strings.Split(data, string(segTerm)) {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+		segs = append(segs, strings.Split(raw, string(elemSep)))
+	}
+	return segs, nil
+}
+
+func element(seg []string, i int) string {
+	if i >= len(seg) {
+		return ""
+	}
+	return strings.TrimSpace(seg[i])
+}
+
+// ParseX12940 reads all 940 warehouse shipping orders of an interchange.
+// The depositor order number comes from W05, the warehouse location from
+// N1*WH (N104), the ship-by date from G62*10 and the lines from W01 using the
+// product id in W0105 or the UPC in W0103.
+func ParseX12940(data string) ([]OrderMessage, error) {
+	segs, err := splitX12(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		out []OrderMessage
+		cur *OrderMessage
+	)
+	for n, seg := range segs {
+		id := element(seg, 0)
+		if id != "ST" && id != "ISA" && id != "GS" && id != "GE" && id != "IEA" && cur == nil {
+			return nil, fmt.Errorf("%w: segment %d (%s) outside of a transaction set", ErrMalformed, n+1, id)
+		}
+		switch id {
+		case "ST":
+			if t := element(seg, 1); t != "940" {
+				return nil, fmt.Errorf("%w: X12 %s", ErrUnsupportedMsg, t)
+			}
+			cur = &OrderMessage{Standard: StandardX12}
+		case "W05":
+			cur.OrderNumber = element(seg, 2)
+		case "N1":
+			if element(seg, 1) == "WH" {
+				cur.Location = element(seg, 4)
+			}
+		case "G62":
+			if element(seg, 1) == "10" {
+				t, err := parseDate(element(seg, 2))
+				if err != nil {
+					return nil, fmt.Errorf("%w: segment %d: %v", ErrMalformed, n+1, err)
+				}
+				cur.ShipBy = &t
+			}
+		case "W01":
+			qty, err := strconv.Atoi(element(seg, 1))
+			if err != nil {
+				return nil, fmt.Errorf("%w: segment %d: invalid quantity %q", ErrMalformed, n+1, element(seg, 1))
+			}
+			sku := element(seg, 5)
+			if sku == "" {
+				sku = element(seg, 3)
+			}
+			cur.Lines = append(cur.Lines, OrderMessageLine{SKU: sku, Quantity: qty})
+		case "SE":
+			if cur.OrderNumber == "" {
+				return nil, fmt.Errorf("%w: 940 without W05 order number", ErrMalformed)
+			}
+			out = append(out, *cur)
+			cur = nil
+		}
+	}
+	if cur != nil {
+		return nil, fmt.Errorf("%w: missing SE segment", ErrMalformed)
+	}
+	return out, nil
+}
+
+// WriteX12945 renders a 945 warehouse shipping advice with one W12 per line.
+func WriteX12945(cfg Config, adv Advice) string {
+	var b strings.Builder
+	seg := func(elems ...string) {
+		b.WriteString(strings.Join(elems, "*"))
+		b.WriteString("~\n")
+	}
+
+	ctrl := fmt.Sprintf("%09d", adv.Control)
+	seg("ISA", "00", pad("", 10), "00", pad("", 10),
+		"ZZ", pad(cfg.SenderID, 15), "ZZ", pad(cfg.ReceiverID, 15),
+		adv.Date.Format("060102"), adv.Date.Format("1504"),
+		"U", "00401", ctrl, "0", "P", ">")
+	seg("GS", "SW", cfg.SenderID, cfg.ReceiverID, adv.Date.Format("20060102"), adv.Date.Format("1504"), strconv.Itoa(adv.Control), "X", "004010")
+
+	count := 0
+	txSeg := func(elems ...string) {
+		count++
+		seg(elems...)
+	}
+	txSeg("ST", "945", "0001")
+	txSeg("W06", "F", adv.OrderNumber, adv.Date.Format("20060102"), adv.OrderNumber)
+	total := 0
+	for _, l := range adv.Lines {
+		status := "CC"
+		if l.Quantity < l.Ordered {
+			status = "CP"
+		}
+		txSeg("W12", status, strconv.Itoa(l.Ordered), strconv.Itoa(l.Quantity), strconv.Itoa(l.Ordered-l.Quantity), "EA", "", "VN", l.SKU)
+		total += l.Quantity
+	}
+	txSeg("W03", strconv.Itoa(total))
+	seg("SE", strconv.Itoa(count+1), "0001")
+
+	seg("GE", "1", strconv.Itoa(adv.Control))
+	seg("IEA", "1", ctrl)
+	return b.String()
+}
+
+func pad(s string, n int) string {
+	if len(s) >= n {
+		return s[:n]
+	}
+	return s + strings.Repeat(" ", n-len(s))
+}
+
+// parseDate reads CCYYMMDD dates as used by both standards. Dates mean the
+// end of that day in local time, like order.shipby.
+func parseDate(v string) (time.Time, error) {
+	t, err := time.ParseInLocation("20060102", v, time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q", v)
+	}
+	return t.Add(24*time.Hour - time.Second), nil
+}
@@ -4,10 +4,17 @@ import (
 	"context"
 	"os"
 
+	"github.com/mxV03/wms/internal/auditlog"
+
 	"github.com/mxV03/wms/internal/features/interfaces/cli/registry"
 )
 
 func Run() error {
 	ctx := context.Background()
-	return registry.Dispatch(ctx, os.Args[1:])
+	if err := registry.Dispatch(ctx, os.Args[1:]); err != nil {
+		return err
+	}
+	// events of a failed command may belong to rolled back changes
+	auditlog.Flush(ctx)
+	return nil
 }
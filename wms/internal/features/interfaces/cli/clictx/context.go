@@ -1,6 +1,11 @@
 package clictx
 
-import "github.com/mxV03/wms/ent"
+import (
+	"os"
+	"strings"
+
+	"github.com/mxV03/wms/ent"
+)
 
 type App struct {
 	client *ent.Client
@@ -25,3 +30,9 @@ func AppCtx() *App {
 func (a *App) Client() *ent.Client {
 	return a.client
 }
+
+// IdempotencyKey returns the client-supplied key from WMS_IDEMPOTENCY_KEY.
+// Commands that create movements or orders use it to make retries safe.
+func IdempotencyKey() string {
+	return strings.TrimSpace(os.Getenv("WMS_IDEMPOTENCY_KEY"))
+}
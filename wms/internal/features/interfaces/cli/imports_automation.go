@@ -0,0 +1,5 @@
+//go:build automation
+
+package cli
+
+import _ "github.com/mxV03/wms/internal/features/automation/cli"
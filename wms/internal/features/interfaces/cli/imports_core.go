@@ -5,4 +5,5 @@ import (
 	_ "github.com/mxV03/wms/internal/core/inventory/location/cli"
 	_ "github.com/mxV03/wms/internal/core/inventory/stock/cli"
 	_ "github.com/mxV03/wms/internal/core/ordermanagement/orders/cli"
+	_ "github.com/mxV03/wms/internal/core/sequence/cli"
 )
@@ -0,0 +1,5 @@
+//go:build edi
+
+package cli
+
+import _ "github.com/mxV03/wms/internal/features/edi/cli"
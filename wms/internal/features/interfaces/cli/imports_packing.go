@@ -0,0 +1,5 @@
+//go:build packing
+
+package cli
+
+import _ "github.com/mxV03/wms/internal/features/packing/cli"
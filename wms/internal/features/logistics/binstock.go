@@ -0,0 +1,204 @@
+//go:build logistics
+
+package logistics
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mxV03/wms/ent"
+	"github.com/mxV03/wms/ent/bin"
+	"github.com/mxV03/wms/ent/binstock"
+	"github.com/mxV03/wms/ent/item"
+	"github.com/mxV03/wms/ent/location"
+)
+
+var (
+	ErrInvalidQuantity      = fmt.Errorf("invalid quantity")
+	ErrInsufficientBinStock = fmt.Errorf("insufficient stock in bin")
+)
+
+// SetBinStock records how many units of an item lie in a bin, e.g. after a
+// count. The item is assigned to the bin if it is not yet. A bin that was
+// empty starts a new FIFO receipt date.
+func (s *LogisticsService) SetBinStock(ctx context.Context, locCode, binCode, sku string, qty int) error {
+	binCode = strings.TrimSpace(binCode)
+	sku = strings.TrimSpace(sku)
+	if binCode == "" {
+		return ErrInvalidBinCode
+	}
+	if sku == "" {
+		return ErrInvalidSKU
+	}
+	if qty < 0 {
+		return ErrInvalidQuantity
+	}
+
+	loc, err := s.getLocation(ctx, locCode)
+	if err != nil {
+		return err
+	}
+	b, err := s.client.Bin.Query().
+		Where(bin.Code(binCode), bin.HasLocationWith(location.ID(loc.ID))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("fetch bin: %w", err)
+	}
+	it, err := s.client.Item.Query().
+		Where(item.SKU(sku)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("fetch item: %w", err)
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	bs, err := tx.BinStock.Query().
+		Where(binstock.HasBinWith(bin.ID(b.ID)), binstock.HasItemWith(item.ID(it.ID))).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		err = tx.BinStock.Create().
+			SetBin(b).
+			SetItem(it).
+			SetQuantity(qty).
+			Exec(ctx)
+	case err != nil:
+		return fmt.Errorf("fetch bin stock: %w", err)
+	default:
+		upd := tx.BinStock.UpdateOne(bs).SetQuantity(qty)
+		if bs.Quantity == 0 && qty > 0 {
+			upd.SetReceivedAt(time.Now())
+		}
+		err = upd.Exec(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("save bin stock: %w", err)
+	}
+
+	assigned, err := tx.Bin.Query().
+		Where(bin.ID(b.ID), bin.HasItemsWith(item.ID(it.ID))).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("check bin item: %w", err)
+	}
+	if !assigned {
+		if err := tx.Bin.UpdateOneID(b.ID).AddItems(it).Exec(ctx); err != nil {
+			return fmt.Errorf("assign item to bin: %w", err)
+		}
+	}
+	return tx.Commit()
+}
+
+// BinQuantities returns the stocked quantity per SKU of a bin.
+func (s *LogisticsService) BinQuantities(ctx context.Context, locCode, binCode string) (map[string]int, error) {
+	loc, err := s.getLocation(ctx, locCode)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.client.BinStock.Query().
+		Where(binstock.HasBinWith(bin.Code(strings.TrimSpace(binCode)), bin.HasLocationWith(location.ID(loc.ID)))).
+		WithItem().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetch bin stock: %w", err)
+	}
+	out := make(map[string]int, len(rows))
+	for _, r := range rows {
+		out[r.Edges.Item.SKU] = r.Quantity
+	}
+	return out, nil
+}
+
+// MoveBinStock moves units of an item between two bins of the same location.
+// Stock per location is unchanged, so no movement is booked. Run it on the
+// client of a transaction to combine it with other changes.
+func (s *LogisticsService) MoveBinStock(ctx context.Context, from, to *ent.Bin, it *ent.Item, qty int) error {
+	if qty <= 0 {
+		return ErrInvalidQuantity
+	}
+	bs, err := s.client.BinStock.Query().
+		Where(binstock.HasBinWith(bin.ID(from.ID)), binstock.HasItemWith(item.ID(it.ID))).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("fetch bin stock: %w", err)
+	}
+	if bs == nil || bs.Quantity < qty {
+		return ErrInsufficientBinStock
+	}
+	if err := s.client.BinStock.UpdateOne(bs).AddQuantity(-qty).Exec(ctx); err != nil {
+		return fmt.Errorf("update bin stock: %w", err)
+	}
+	return addToBin(ctx, s.client, to, it, qty)
+}
+
+// addToBin puts units into a bin and assigns the item to it. It takes the
+// client of the surrounding transaction.
+func addToBin(ctx context.Context, client *ent.Client, b *ent.Bin, it *ent.Item, qty int) error {
+	bs, err := client.BinStock.Query().
+		Where(binstock.HasBinWith(bin.ID(b.ID)), binstock.HasItemWith(item.ID(it.ID))).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		err = client.BinStock.Create().
+			SetBin(b).
+			SetItem(it).
+			SetQuantity(qty).
+			Exec(ctx)
+	case err != nil:
+		return fmt.Errorf("fetch bin stock: %w", err)
+	default:
+		upd := client.BinStock.UpdateOne(bs).AddQuantity(qty)
+		if bs.Quantity == 0 {
+			upd.SetReceivedAt(time.Now())
+		}
+		err = upd.Exec(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("save bin stock: %w", err)
+	}
+
+	assigned, err := client.Bin.Query().
+		Where(bin.ID(b.ID), bin.HasItemsWith(item.ID(it.ID))).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("check bin item: %w", err)
+	}
+	if !assigned {
+		if err := client.Bin.UpdateOneID(b.ID).AddItems(it).Exec(ctx); err != nil {
+			return fmt.Errorf("assign item to bin: %w", err)
+		}
+	}
+	return nil
+}
+
+// takeFromBin lowers the stock of an item in a bin, never below zero. Bins
+// without a stock record are left alone.
+func takeFromBin(ctx context.Context, client *ent.Client, binID, itemID, qty int) error {
+	bs, err := client.BinStock.Query().
+		Where(binstock.HasBinWith(bin.ID(binID)), binstock.HasItemWith(item.ID(itemID))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("fetch bin stock: %w", err)
+	}
+	if err := client.BinStock.UpdateOne(bs).SetQuantity(max(bs.Quantity-qty, 0)).Exec(ctx); err != nil {
+		return fmt.Errorf("update bin stock: %w", err)
+	}
+	return nil
+}
@@ -122,6 +122,60 @@ func init() {
 		},
 	})
 
+	registry.Register(registry.Command{
+		Name:        "logistics.bin.coords",
+		Usage:       "logistics.bin.coords <locationCode> <binCode> <aisle> <position> [level]",
+		Group:       "Optional / Logistics",
+		Description: "Set the walk coordinates of a bin used for pick path ordering",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) < 4 || len(args) > 5 {
+				return fmt.Errorf("usage: logistics.bin.coords <locationCode> <binCode> <aisle> <position> [level]")
+			}
+			coords := make([]int, 0, 3)
+			for _, a := range args[2:] {
+				v, err := strconv.Atoi(a)
+				if err != nil {
+					return fmt.Errorf("aisle, position and level must be integers")
+				}
+				coords = append(coords, v)
+			}
+			level := 0
+			if len(coords) == 3 {
+				level = coords[2]
+			}
+
+			svc := logistics.NewLocationService(clictx.AppCtx().Client())
+			if err := svc.SetBinCoordinates(ctx, args[0], args[1], coords[0], coords[1], level); err != nil {
+				return err
+			}
+			fmt.Printf("bin coordinates set: LOC=%s BIN=%s AISLE=%d POS=%d LEVEL=%d\n", args[0], args[1], coords[0], coords[1], level)
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "logistics.zone.sequence",
+		Usage:       "logistics.zone.sequence <locationCode> <zoneCode> <sequence>",
+		Group:       "Optional / Logistics",
+		Description: "Set the order in which pickers walk the zones of a location",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 3 {
+				return fmt.Errorf("usage: logistics.zone.sequence <locationCode> <zoneCode> <sequence>")
+			}
+			seq, err := strconv.Atoi(args[2])
+			if err != nil {
+				return fmt.Errorf("sequence must be an integer")
+			}
+
+			svc := logistics.NewLocationService(clictx.AppCtx().Client())
+			if err := svc.SetZoneSequence(ctx, args[0], args[1], seq); err != nil {
+				return err
+			}
+			fmt.Printf("zone sequence set: LOC=%s ZONE=%s SEQ=%d\n", args[0], args[1], seq)
+			return nil
+		},
+	})
+
 	registry.Register(registry.Command{
 		Name:        "logistics.bin.list",
 		Usage:       "logistics.bin.list <locationCode> [zoneCode] [limit]",
@@ -165,7 +219,7 @@ func init() {
 				if name == "" {
 					name = "-"
 				}
-				fmt.Printf("bin: CODE=%s NAME=%s\n", z.Code, name)
+				fmt.Printf("bin: CODE=%s NAME=%s AISLE=%d POS=%d LEVEL=%d\n", z.Code, name, z.Aisle, z.Position, z.Level)
 			}
 			return nil
 		},
@@ -218,9 +272,36 @@ func init() {
 				fmt.Println("no items assigned to this bin")
 				return nil
 			}
+			qty, err := svc.BinQuantities(ctx, args[0], args[1])
+			if err != nil {
+				return err
+			}
 			for _, it := range its {
-				fmt.Printf("item: SKU=%s NAME=%s\n", it.SKU, it.Name)
+				fmt.Printf("item: SKU=%s NAME=%s QTY=%d\n", it.SKU, it.Name, qty[it.SKU])
+			}
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "logistics.bin.stock",
+		Usage:       "logistics.bin.stock <locationCode> <binCode> <sku> <quantity>",
+		Group:       "Optional / Logistics",
+		Description: "Set the quantity of an item lying in a bin (used to split pick tasks across bins).",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 4 {
+				return fmt.Errorf("usage: logistics.bin.stock <locationCode> <binCode> <sku> <quantity>")
+			}
+			qty, err := strconv.Atoi(args[3])
+			if err != nil {
+				return fmt.Errorf("quantity must be an integer")
+			}
+
+			svc := logistics.NewLocationService(clictx.AppCtx().Client())
+			if err := svc.SetBinStock(ctx, args[0], args[1], args[2], qty); err != nil {
+				return err
 			}
+			fmt.Printf("bin stock set: LOC=%s BIN=%s SKU=%s QTY=%d\n", args[0], args[1], args[2], qty)
 			return nil
 		},
 	})
@@ -0,0 +1,90 @@
+//go:build logistics
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/mxV03/wms/internal/features/interfaces/cli/clictx"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/registry"
+	"github.com/mxV03/wms/internal/features/logistics"
+)
+
+func init() {
+	registry.Register(registry.Command{
+		Name:        "putaway.list",
+		Usage:       "putaway.list [all] [limit]",
+		Group:       "Optional / Logistics",
+		Description: "List open putaway tasks with their suggested bin (all: include confirmed and cancelled tasks).",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) > 2 {
+				return fmt.Errorf("usage: putaway.list [all] [limit]")
+			}
+			all := false
+			if len(args) > 0 && args[0] == "all" {
+				all = true
+				args = args[1:]
+			}
+			limit := 100
+			if len(args) == 1 {
+				v, err := strconv.Atoi(args[0])
+				if err != nil {
+					return fmt.Errorf("limit must be an integer")
+				}
+				limit = v
+			}
+
+			svc := logistics.NewLocationService(clictx.AppCtx().Client())
+			ts, err := svc.ListPutawayTasks(ctx, all, limit)
+			if err != nil {
+				return err
+			}
+			if len(ts) == 0 {
+				fmt.Println("no putaway tasks")
+				return nil
+			}
+			for _, t := range ts {
+				fmt.Printf("putaway: ID=%d REF=%s SKU=%s QTY=%d FROM=%s SUGGESTED=%s STRATEGY=%s STATUS=%s BIN=%s\n",
+					t.ID, dash(t.Reference), t.SKU, t.Quantity, t.Location, t.Suggested, dash(t.Strategy), t.Status, t.Bin)
+			}
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "putaway.confirm",
+		Usage:       "putaway.confirm <taskID> [locationCode binCode]",
+		Group:       "Optional / Logistics",
+		Description: "Confirm a putaway into the suggested or the given bin; moves the stock into the bin.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 1 && len(args) != 3 {
+				return fmt.Errorf("usage: putaway.confirm <taskID> [locationCode binCode]")
+			}
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("taskID must be an integer")
+			}
+			locCode, binCode := "", ""
+			if len(args) == 3 {
+				locCode, binCode = args[1], args[2]
+			}
+
+			svc := logistics.NewLocationService(clictx.AppCtx().Client())
+			target, err := svc.ConfirmPutaway(ctx, id, locCode, binCode)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("putaway %d confirmed into %s\n", id, target)
+			return nil
+		},
+	})
+}
+
+func dash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,432 @@
+//go:build logistics
+
+package logistics
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mxV03/wms/ent"
+	"github.com/mxV03/wms/ent/bin"
+	"github.com/mxV03/wms/ent/binstock"
+	"github.com/mxV03/wms/ent/item"
+	"github.com/mxV03/wms/ent/location"
+	"github.com/mxV03/wms/ent/putawaytask"
+	"github.com/mxV03/wms/ent/zone"
+	"github.com/mxV03/wms/internal/auditlog"
+	"github.com/mxV03/wms/internal/core/inventory/stock"
+)
+
+var (
+	ErrPutawayNotFound        = fmt.Errorf("putaway task not found")
+	ErrPutawayDone            = fmt.Errorf("putaway task already confirmed")
+	ErrPutawayCancelled       = fmt.Errorf("putaway task was cancelled")
+	ErrNoPutawayBin           = fmt.Errorf("no bin given and no bin suggested")
+	ErrInvalidPutawayStrategy = fmt.Errorf("invalid putaway strategy (use assigned, consolidate or empty)")
+)
+
+// PutawayStrategy decides which bin is suggested for received goods. The
+// configured strategy is tried first, then the others in the order below.
+type PutawayStrategy string
+
+const (
+	// PutawayAssigned suggests a bin the item is assigned to.
+	PutawayAssigned PutawayStrategy = "assigned"
+	// PutawayConsolidate suggests the bin already holding most of the item.
+	PutawayConsolidate PutawayStrategy = "consolidate"
+	// PutawayEmpty suggests an empty bin in the zone where the item is kept.
+	PutawayEmpty PutawayStrategy = "empty"
+)
+
+var putawayStrategies = []PutawayStrategy{PutawayAssigned, PutawayConsolidate, PutawayEmpty}
+
+func ParsePutawayStrategy(s string) (PutawayStrategy, error) {
+	switch PutawayStrategy(strings.ToLower(strings.TrimSpace(s))) {
+	case "", PutawayAssigned:
+		return PutawayAssigned, nil
+	case PutawayConsolidate:
+		return PutawayConsolidate, nil
+	case PutawayEmpty:
+		return PutawayEmpty, nil
+	default:
+		return "", ErrInvalidPutawayStrategy
+	}
+}
+
+// PutawayStrategyFromEnv reads the strategy from WMS_PUTAWAY_STRATEGY.
+func PutawayStrategyFromEnv() (PutawayStrategy, error) {
+	return ParsePutawayStrategy(os.Getenv("WMS_PUTAWAY_STRATEGY"))
+}
+
+type PutawayTaskDTO struct {
+	ID        int
+	Reference string
+	SKU       string
+	Location  string
+	Quantity  int
+	Status    string
+	Suggested string // LOC/BIN or "-"
+	Strategy  string
+	Bin       string // LOC/BIN the goods went into, or "-"
+	CreatedAt time.Time
+	DoneAt    *time.Time
+}
+
+// CreatePutawayTask creates a putaway task for goods received at a location
+// and suggests a bin for them. Receiving calls it with the client of its
+// transaction.
+func (s *LogisticsService) CreatePutawayTask(ctx context.Context, sku, locCode string, qty int, ref string) (*ent.PutawayTask, error) {
+	if qty <= 0 {
+		return nil, ErrInvalidQuantity
+	}
+	strategy, err := PutawayStrategyFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := s.getLocation(ctx, locCode)
+	if err != nil {
+		return nil, err
+	}
+	it, err := s.client.Item.Query().
+		Where(item.SKU(strings.TrimSpace(sku))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("fetch item: %w", err)
+	}
+
+	b, used, err := s.suggestBin(ctx, it, loc, strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	create := s.client.PutawayTask.Create().
+		SetItem(it).
+		SetLocation(loc).
+		SetQuantity(qty).
+		SetReference(ref).
+		SetStatus("OPEN")
+	if b != nil {
+		create.SetSuggestedBinID(b.ID).SetStrategy(string(used))
+	}
+	t, err := create.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create putaway task: %w", err)
+	}
+	return t, nil
+}
+
+// CancelPutawayTasks cancels the open putaway tasks created for a reference,
+// e.g. when the receipt behind them is reversed. Run it on the client of the
+// reversing transaction.
+func (s *LogisticsService) CancelPutawayTasks(ctx context.Context, ref string) (int, error) {
+	n, err := s.client.PutawayTask.Update().
+		Where(putawaytask.Reference(ref), putawaytask.Status("OPEN")).
+		SetStatus("CANCELLED").
+		SetDoneAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("cancel putaway tasks: %w", err)
+	}
+	return n, nil
+}
+
+// TakeBackPutaways lowers the bin stock added by the finished putaway tasks
+// of a reference, when the receipt behind them is reversed and its goods are
+// booked out again. Run it on the client of the reversing transaction.
+func (s *LogisticsService) TakeBackPutaways(ctx context.Context, ref string) (int, error) {
+	tasks, err := s.client.PutawayTask.Query().
+		Where(
+			putawaytask.Reference(ref),
+			putawaytask.Status("DONE"),
+			putawaytask.BinIDNotNil(),
+		).
+		WithItem().
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("fetch putaway tasks: %w", err)
+	}
+	for _, t := range tasks {
+		if err := takeFromBin(ctx, s.client, *t.BinID, t.Edges.Item.ID, t.Quantity); err != nil {
+			return 0, err
+		}
+	}
+	return len(tasks), nil
+}
+
+// suggestBin tries the given strategy first and falls back to the others.
+func (s *LogisticsService) suggestBin(ctx context.Context, it *ent.Item, loc *ent.Location, strategy PutawayStrategy) (*ent.Bin, PutawayStrategy, error) {
+	order := []PutawayStrategy{strategy}
+	for _, st := range putawayStrategies {
+		if st != strategy {
+			order = append(order, st)
+		}
+	}
+
+	for _, st := range order {
+		var (
+			b   *ent.Bin
+			err error
+		)
+		switch st {
+		case PutawayAssigned:
+			b, err = s.assignedBin(ctx, it, loc)
+		case PutawayConsolidate:
+			b, err = s.consolidationBin(ctx, it, loc)
+		case PutawayEmpty:
+			b, err = s.emptyBin(ctx, it, loc)
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		if b != nil {
+			return b, st, nil
+		}
+	}
+	return nil, "", nil
+}
+
+func (s *LogisticsService) assignedBin(ctx context.Context, it *ent.Item, loc *ent.Location) (*ent.Bin, error) {
+	b, err := s.client.Bin.Query().
+		Where(bin.HasItemsWith(item.ID(it.ID)), bin.HasLocationWith(location.ID(loc.ID))).
+		Order(ent.Asc(bin.FieldCode)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("fetch bin: %w", err)
+	}
+	return b, nil
+}
+
+func (s *LogisticsService) consolidationBin(ctx context.Context, it *ent.Item, loc *ent.Location) (*ent.Bin, error) {
+	bs, err := s.client.BinStock.Query().
+		Where(
+			binstock.HasItemWith(item.ID(it.ID)),
+			binstock.HasBinWith(bin.HasLocationWith(location.ID(loc.ID))),
+			binstock.QuantityGT(0),
+		).
+		WithBin().
+		Order(ent.Desc(binstock.FieldQuantity), ent.Asc(binstock.FieldID)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("fetch bin stock: %w", err)
+	}
+	return bs.Edges.Bin, nil
+}
+
+// emptyBin returns a bin without assigned items or stock, in the zone of a
+// bin that already holds the item if there is one.
+func (s *LogisticsService) emptyBin(ctx context.Context, it *ent.Item, loc *ent.Location) (*ent.Bin, error) {
+	occupied, err := s.client.BinStock.Query().
+		Where(
+			binstock.QuantityGT(0),
+			binstock.HasBinWith(bin.HasLocationWith(location.ID(loc.ID))),
+		).
+		QueryBin().
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetch occupied bins: %w", err)
+	}
+
+	q := s.client.Bin.Query().
+		Where(
+			bin.HasLocationWith(location.ID(loc.ID)),
+			bin.Not(bin.HasItems()),
+			bin.IDNotIn(occupied...),
+		)
+
+	home, err := s.assignedBin(ctx, it, loc)
+	if err != nil {
+		return nil, err
+	}
+	if home == nil {
+		if home, err = s.consolidationBin(ctx, it, loc); err != nil {
+			return nil, err
+		}
+	}
+	if home != nil {
+		zoneID, err := home.QueryZone().OnlyID(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("fetch zone: %w", err)
+		}
+		q = q.Where(bin.HasZoneWith(zone.ID(zoneID)))
+	}
+
+	b, err := q.Order(ent.Asc(bin.FieldCode)).First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("fetch bin: %w", err)
+	}
+	return b, nil
+}
+
+// ListPutawayTasks returns open putaway tasks, or all of them, oldest first.
+func (s *LogisticsService) ListPutawayTasks(ctx context.Context, all bool, limit int) ([]PutawayTaskDTO, error) {
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+
+	q := s.client.PutawayTask.Query()
+	if !all {
+		q = q.Where(putawaytask.Status("OPEN"))
+	}
+	ts, err := q.
+		WithItem().
+		WithLocation().
+		Order(ent.Asc(putawaytask.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list putaway tasks: %w", err)
+	}
+
+	binIDs := make([]int, 0)
+	for _, t := range ts {
+		if t.SuggestedBinID != nil {
+			binIDs = append(binIDs, *t.SuggestedBinID)
+		}
+		if t.BinID != nil {
+			binIDs = append(binIDs, *t.BinID)
+		}
+	}
+	names := map[int]string{}
+	if len(binIDs) > 0 {
+		bins, err := s.client.Bin.Query().
+			Where(bin.IDIn(binIDs...)).
+			WithLocation().
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("fetch bins: %w", err)
+		}
+		for _, b := range bins {
+			names[b.ID] = b.Edges.Location.Code + "/" + b.Code
+		}
+	}
+	name := func(id *int) string {
+		if id == nil {
+			return "-"
+		}
+		if n, ok := names[*id]; ok {
+			return n
+		}
+		return "-"
+	}
+
+	out := make([]PutawayTaskDTO, 0, len(ts))
+	for _, t := range ts {
+		out = append(out, PutawayTaskDTO{
+			ID:        t.ID,
+			Reference: t.Reference,
+			SKU:       t.Edges.Item.SKU,
+			Location:  t.Edges.Location.Code,
+			Quantity:  t.Quantity,
+			Status:    t.Status,
+			Suggested: name(t.SuggestedBinID),
+			Strategy:  t.Strategy,
+			Bin:       name(t.BinID),
+			CreatedAt: t.CreatedAt,
+			DoneAt:    t.DoneAt,
+		})
+	}
+	return out, nil
+}
+
+// ConfirmPutaway records that the goods of a task were put into a bin, the
+// suggested one unless locCode/binCode are given. A bin at another location
+// than the receiving one moves the stock there.
+func (s *LogisticsService) ConfirmPutaway(ctx context.Context, taskID int, locCode, binCode string) (string, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return "", fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+	txCtx := auditlog.InTx(ctx, tx)
+
+	t, err := tx.PutawayTask.Query().
+		Where(putawaytask.ID(taskID)).
+		WithItem().
+		WithLocation().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", ErrPutawayNotFound
+		}
+		return "", fmt.Errorf("fetch putaway task: %w", err)
+	}
+	if t.Status == "CANCELLED" {
+		return "", ErrPutawayCancelled
+	}
+	if t.Status != "OPEN" {
+		return "", ErrPutawayDone
+	}
+
+	var b *ent.Bin
+	if strings.TrimSpace(binCode) != "" {
+		b, err = tx.Bin.Query().
+			Where(
+				bin.Code(strings.TrimSpace(binCode)),
+				bin.HasLocationWith(location.Code(strings.TrimSpace(locCode))),
+			).
+			WithLocation().
+			Only(ctx)
+	} else if t.SuggestedBinID != nil {
+		b, err = tx.Bin.Query().
+			Where(bin.ID(*t.SuggestedBinID)).
+			WithLocation().
+			Only(ctx)
+	} else {
+		return "", ErrNoPutawayBin
+	}
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", ErrNotFound
+		}
+		return "", fmt.Errorf("fetch bin: %w", err)
+	}
+
+	sku := t.Edges.Item.SKU
+	from, to := t.Edges.Location.Code, b.Edges.Location.Code
+	if from != to {
+		ref := "PUTAWAY-" + strconv.Itoa(t.ID)
+		stockSvc := stock.NewStockService(tx.Client())
+		if err := stockSvc.OUT(txCtx, sku, from, t.Quantity, ref); err != nil {
+			return "", err
+		}
+		if err := stockSvc.IN(txCtx, sku, to, t.Quantity, ref); err != nil {
+			return "", err
+		}
+	}
+	if err := addToBin(ctx, tx.Client(), b, t.Edges.Item, t.Quantity); err != nil {
+		return "", err
+	}
+
+	err = tx.PutawayTask.UpdateOne(t).
+		SetStatus("DONE").
+		SetBinID(b.ID).
+		SetDoneAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return "", fmt.Errorf("update putaway task: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return "", fmt.Errorf("committing transaction: %w", err)
+	}
+
+	target := to + "/" + b.Code
+	auditlog.Logf(ctx, "putaway.confirm", "putaway", strconv.Itoa(taskID), "sku=%s qty=%d from=%s to=%s", sku, t.Quantity, from, target)
+	return target, nil
+}
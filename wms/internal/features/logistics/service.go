@@ -20,6 +20,7 @@ var (
 	ErrInvalidBinCode   = fmt.Errorf("invalid bin code")
 	ErrInvalidSKU       = fmt.Errorf("invalid sku")
 	ErrNotFound         = fmt.Errorf("not found")
+	ErrInvalidCoords    = fmt.Errorf("invalid walk coordinates")
 )
 
 type LogisticsService struct {
@@ -160,6 +161,64 @@ func (s *LogisticsService) ListBins(ctx context.Context, locCode string, zoneCod
 	return bs, nil
 }
 
+// SetBinCoordinates stores the walk coordinates of a bin. Aisles start at 1;
+// positions count from the front of the aisle.
+func (s *LogisticsService) SetBinCoordinates(ctx context.Context, locCode, binCode string, aisle, position, level int) error {
+	binCode = strings.TrimSpace(binCode)
+	if binCode == "" {
+		return ErrInvalidBinCode
+	}
+	if aisle <= 0 || position < 0 || level < 0 {
+		return ErrInvalidCoords
+	}
+
+	loc, err := s.getLocation(ctx, locCode)
+	if err != nil {
+		return err
+	}
+
+	n, err := s.client.Bin.Update().
+		Where(bin.Code(binCode), bin.HasLocationWith(location.ID(loc.ID))).
+		SetAisle(aisle).
+		SetPosition(position).
+		SetLevel(level).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("update bin: %w", err)
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s *LogisticsService) SetZoneSequence(ctx context.Context, locCode, zoneCode string, seq int) error {
+	zoneCode = strings.TrimSpace(zoneCode)
+	if zoneCode == "" {
+		return ErrInvalidZonesCode
+	}
+	if seq < 0 {
+		return ErrInvalidCoords
+	}
+
+	loc, err := s.getLocation(ctx, locCode)
+	if err != nil {
+		return err
+	}
+
+	n, err := s.client.Zone.Update().
+		Where(zone.Code(zoneCode), zone.HasLocationWith(location.ID(loc.ID))).
+		SetWalkSequence(seq).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("update zone: %w", err)
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
 func (s *LogisticsService) AssignItemToBin(ctx context.Context, locCode, binCode, sku string) error {
 	binCode = strings.TrimSpace(binCode)
 	sku = strings.TrimSpace(sku)
@@ -0,0 +1,43 @@
+//go:build logistics
+
+package logistics
+
+import "github.com/mxV03/wms/ent"
+
+// Walking distances in metres, used for pick routes and slotting.
+const (
+	AisleSpacing = 3.0
+	BinPitch     = 1.0
+)
+
+// Spot is a place on the warehouse floor given by bin coordinates. The depot
+// is the front of aisle 1 of every location.
+type Spot struct {
+	Aisle    int
+	Position int
+}
+
+var Depot = Spot{Aisle: 1}
+
+// BinSpot returns the floor coordinates of a bin.
+func BinSpot(b *ent.Bin) Spot {
+	return Spot{Aisle: b.Aisle, Position: b.Position}
+}
+
+// WalkDistance between two spots of a location whose deepest bin is at
+// position depth. Changing aisles means leaving through the front or the back
+// cross aisle, whichever is shorter.
+func WalkDistance(a, b Spot, depth int) float64 {
+	if a.Aisle == b.Aisle {
+		return float64(abs(a.Position-b.Position)) * BinPitch
+	}
+	along := min(a.Position+b.Position, 2*depth-a.Position-b.Position)
+	return float64(along)*BinPitch + float64(abs(a.Aisle-b.Aisle))*AisleSpacing
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,68 @@
+//go:build multiwarehouse
+
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mxV03/wms/internal/features/interfaces/cli/clictx"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/registry"
+	"github.com/mxV03/wms/internal/features/multiwarehouse"
+)
+
+func init() {
+	registry.Register(registry.Command{
+		Name:        "transfer.create",
+		Usage:       "transfer.create <order_number> <fromWarehouse> <toWarehouse>",
+		Group:       "Optional / MultiWarehouse",
+		Description: "Create a TRANSFER order between two warehouses (add lines with order.addline).",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 3 {
+				return fmt.Errorf("usage: transfer.create <order_number> <fromWarehouse> <toWarehouse>")
+			}
+			svc := multiwarehouse.NewTransferService(clictx.AppCtx().Client())
+			if _, err := svc.CreateTransfer(ctx, args[0], args[1], args[2]); err != nil {
+				return err
+			}
+			fmt.Printf("created transfer: ORDER=%s FROM=%s TO=%s\n", args[0], args[1], args[2])
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "transfer.ship",
+		Usage:       "transfer.ship <order_number>",
+		Group:       "Optional / MultiWarehouse",
+		Description: "Ship a transfer: issues stock at the source locations and marks it IN_TRANSIT.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("usage: transfer.ship <order_number>")
+			}
+			svc := multiwarehouse.NewTransferService(clictx.AppCtx().Client())
+			if err := svc.ShipTransfer(ctx, args[0]); err != nil {
+				return err
+			}
+			fmt.Printf("transfer %s in transit\n", args[0])
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "transfer.receive",
+		Usage:       "transfer.receive <order_number> <destinationLocation>",
+		Group:       "Optional / MultiWarehouse",
+		Description: "Receive an in-transit transfer at a location of the destination warehouse.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 2 {
+				return fmt.Errorf("usage: transfer.receive <order_number> <destinationLocation>")
+			}
+			svc := multiwarehouse.NewTransferService(clictx.AppCtx().Client())
+			if err := svc.ReceiveTransfer(ctx, args[0], args[1]); err != nil {
+				return err
+			}
+			fmt.Printf("transfer %s received at %s\n", args[0], args[1])
+			return nil
+		},
+	})
+}
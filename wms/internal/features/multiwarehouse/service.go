@@ -20,6 +20,7 @@ var (
 	ErrWarehouseNotFound       = fmt.Errorf("warehouse not found")
 	ErrLocationNotFound        = fmt.Errorf("location not found")
 	ErrLocationAlreadyAssigned = fmt.Errorf("location already assigned to a warehouse")
+	ErrLocationNotAssigned     = fmt.Errorf("location not assigned to a warehouse")
 )
 
 type MultiwarehouseService struct {
@@ -165,3 +166,23 @@ func (s *MultiwarehouseService) ListLocations(ctx context.Context, whCode string
 	}
 	return out, nil
 }
+
+func (s *MultiwarehouseService) WarehouseOfLocation(ctx context.Context, locCode string) (*ent.Warehouse, error) {
+	locCode = strings.TrimSpace(locCode)
+	if locCode == "" {
+		return nil, ErrInvalidLocationCode
+	}
+
+	w, err := s.client.Warehouse.Query().
+		Where(warehouse.HasWarehouseLocationsWith(
+			warehouselocation.HasLocationWith(location.Code(locCode)),
+		)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrLocationNotAssigned
+		}
+		return nil, fmt.Errorf("fetch warehouse of location: %w", err)
+	}
+	return w, nil
+}
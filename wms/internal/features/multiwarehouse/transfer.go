@@ -0,0 +1,290 @@
+//go:build multiwarehouse
+
+package multiwarehouse
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mxV03/wms/ent"
+	"github.com/mxV03/wms/ent/order"
+	"github.com/mxV03/wms/ent/orderline"
+	"github.com/mxV03/wms/ent/warehouse"
+	"github.com/mxV03/wms/internal/auditlog"
+	"github.com/mxV03/wms/internal/core/inventory/stock"
+	"github.com/mxV03/wms/internal/core/ordermanagement/orders"
+)
+
+const (
+	OrderTypeTransfer    = "TRANSFER"
+	OrderStatusInTransit = "IN_TRANSIT"
+	transferRefPrefix    = "TRANSFER-"
+)
+
+var (
+	ErrNotATransfer   = fmt.Errorf("order is not a transfer order")
+	ErrSameWarehouse  = fmt.Errorf("source and destination warehouse must differ")
+	ErrWrongWarehouse = fmt.Errorf("location does not belong to the expected warehouse")
+)
+
+type TransferService struct {
+	client *ent.Client
+}
+
+func NewTransferService(client *ent.Client) *TransferService {
+	return &TransferService{
+		client: client,
+	}
+}
+
+type TransferLineDTO struct {
+	OrderNr     string
+	FromWH      string
+	ToWH        string
+	SKU         string
+	FromLoc     string
+	Quantity    int
+	OrderStatus string
+}
+
+// CreateTransfer creates a DRAFT transfer order between two warehouses.
+// Lines are added with order.addline using locations of the source warehouse.
+func (s *TransferService) CreateTransfer(ctx context.Context, number, fromWH, toWH string) (*ent.Order, error) {
+	number = strings.TrimSpace(number)
+	fromWH = strings.TrimSpace(fromWH)
+	toWH = strings.TrimSpace(toWH)
+	if number == "" {
+		return nil, orders.ErrInvalidOrderNo
+	}
+	if fromWH == "" || toWH == "" {
+		return nil, ErrInvalidWarehouseCode
+	}
+	if fromWH == toWH {
+		return nil, ErrSameWarehouse
+	}
+
+	src, err := s.getWarehouse(ctx, fromWH)
+	if err != nil {
+		return nil, err
+	}
+	dst, err := s.getWarehouse(ctx, toWH)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := s.client.Order.Query().
+		Where(order.OrderNumber(number)).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("check order existence: %w", err)
+	}
+	if exists {
+		return nil, orders.ErrOrderExists
+	}
+
+	o, err := s.client.Order.Create().
+		SetOrderNumber(number).
+		SetType(OrderTypeTransfer).
+		SetStatus(string(orders.OrderStatusDraft)).
+		SetSourceWarehouse(src).
+		SetDestinationWarehouse(dst).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create transfer order: %w", err)
+	}
+	auditlog.Logf(ctx, "transfer.create", "order", number, "from=%s to=%s", fromWH, toWH)
+	return o, nil
+}
+
+// ShipTransfer issues every line at its source location and puts the goods
+// in transit. Stock leaves the source warehouse but is not yet available at
+// the destination.
+func (s *TransferService) ShipTransfer(ctx context.Context, number string) error {
+	number = strings.TrimSpace(number)
+	if number == "" {
+		return orders.ErrInvalidOrderNo
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+	txCtx := auditlog.InTx(ctx, tx)
+
+	o, lines, err := loadTransfer(ctx, tx.Client(), number)
+	if err != nil {
+		return err
+	}
+	if o.Status != string(orders.OrderStatusDraft) {
+		return orders.ErrInvalidStatus
+	}
+	if len(lines) == 0 {
+		return orders.ErrNoLines
+	}
+
+	whSvc := NewMultiwarehouseService(tx.Client())
+	stockSvc := stock.NewStockService(tx.Client())
+	for _, l := range lines {
+		locCode := l.Edges.Location.Code
+		w, err := whSvc.WarehouseOfLocation(ctx, locCode)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", l.ID, err)
+		}
+		if w.ID != o.Edges.SourceWarehouse.ID {
+			return fmt.Errorf("line %d: %w (%s is in %s)", l.ID, ErrWrongWarehouse, locCode, w.Code)
+		}
+		if err := stockSvc.OUT(txCtx, l.Edges.Item.SKU, locCode, l.Quantity, transferRefPrefix+number); err != nil {
+			return fmt.Errorf("line %d: %w", l.ID, err)
+		}
+	}
+
+	if err := tx.Order.UpdateOneID(o.ID).SetStatus(OrderStatusInTransit).Exec(ctx); err != nil {
+		return fmt.Errorf("updating order status: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	auditlog.Logf(ctx, "transfer.ship", "order", number, "lines=%d", len(lines))
+	return nil
+}
+
+// ReceiveTransfer books all in-transit lines at a location of the destination warehouse.
+func (s *TransferService) ReceiveTransfer(ctx context.Context, number, destLoc string) error {
+	number = strings.TrimSpace(number)
+	destLoc = strings.TrimSpace(destLoc)
+	if number == "" {
+		return orders.ErrInvalidOrderNo
+	}
+	if destLoc == "" {
+		return ErrInvalidLocationCode
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+	txCtx := auditlog.InTx(ctx, tx)
+
+	o, lines, err := loadTransfer(ctx, tx.Client(), number)
+	if err != nil {
+		return err
+	}
+	if o.Status != OrderStatusInTransit {
+		return orders.ErrInvalidStatus
+	}
+
+	w, err := NewMultiwarehouseService(tx.Client()).WarehouseOfLocation(ctx, destLoc)
+	if err != nil {
+		return err
+	}
+	if w.ID != o.Edges.DestinationWarehouse.ID {
+		return fmt.Errorf("%w (%s is in %s, expected %s)", ErrWrongWarehouse, destLoc, w.Code, o.Edges.DestinationWarehouse.Code)
+	}
+
+	stockSvc := stock.NewStockService(tx.Client())
+	for _, l := range lines {
+		if err := stockSvc.IN(txCtx, l.Edges.Item.SKU, destLoc, l.Quantity, transferRefPrefix+number); err != nil {
+			return fmt.Errorf("line %d: %w", l.ID, err)
+		}
+	}
+
+	if err := tx.Order.UpdateOneID(o.ID).SetStatus(string(orders.OrderStatusReceived)).Exec(ctx); err != nil {
+		return fmt.Errorf("updating order status: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	auditlog.Logf(ctx, "transfer.receive", "order", number, "loc=%s lines=%d", destLoc, len(lines))
+	return nil
+}
+
+// InTransit lists all lines of shipped but not yet received transfers.
+// Empty warehouse codes match any warehouse.
+func (s *TransferService) InTransit(ctx context.Context, fromWH, toWH string) ([]TransferLineDTO, error) {
+	fromWH = strings.TrimSpace(fromWH)
+	toWH = strings.TrimSpace(toWH)
+
+	q := s.client.Order.Query().
+		Where(
+			order.Type(OrderTypeTransfer),
+			order.Status(OrderStatusInTransit),
+		)
+	if fromWH != "" {
+		q = q.Where(order.HasSourceWarehouseWith(warehouse.Code(fromWH)))
+	}
+	if toWH != "" {
+		q = q.Where(order.HasDestinationWarehouseWith(warehouse.Code(toWH)))
+	}
+
+	transfers, err := q.
+		WithSourceWarehouse().
+		WithDestinationWarehouse().
+		WithLines(func(lq *ent.OrderLineQuery) {
+			lq.WithItem().WithLocation().Order(ent.Asc(orderline.FieldID))
+		}).
+		Order(ent.Asc(order.FieldOrderNumber)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list transfers in transit: %w", err)
+	}
+
+	out := make([]TransferLineDTO, 0)
+	for _, o := range transfers {
+		for _, l := range o.Edges.Lines {
+			out = append(out, TransferLineDTO{
+				OrderNr:     o.OrderNumber,
+				FromWH:      o.Edges.SourceWarehouse.Code,
+				ToWH:        o.Edges.DestinationWarehouse.Code,
+				SKU:         l.Edges.Item.SKU,
+				FromLoc:     l.Edges.Location.Code,
+				Quantity:    l.Quantity,
+				OrderStatus: o.Status,
+			})
+		}
+	}
+	return out, nil
+}
+
+func (s *TransferService) getWarehouse(ctx context.Context, code string) (*ent.Warehouse, error) {
+	w, err := s.client.Warehouse.Query().
+		Where(warehouse.Code(code)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrWarehouseNotFound
+		}
+		return nil, fmt.Errorf("fetch warehouse: %w", err)
+	}
+	return w, nil
+}
+
+func loadTransfer(ctx context.Context, client *ent.Client, number string) (*ent.Order, []*ent.OrderLine, error) {
+	o, err := client.Order.Query().
+		Where(order.OrderNumber(number)).
+		WithSourceWarehouse().
+		WithDestinationWarehouse().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, orders.ErrOrderNotFound
+		}
+		return nil, nil, fmt.Errorf("fetch order: %w", err)
+	}
+	if o.Type != OrderTypeTransfer || o.Edges.SourceWarehouse == nil || o.Edges.DestinationWarehouse == nil {
+		return nil, nil, ErrNotATransfer
+	}
+
+	lines, err := client.OrderLine.Query().
+		Where(orderline.HasOrderWith(order.ID(o.ID))).
+		WithItem().
+		WithLocation().
+		Order(ent.Asc(orderline.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fetch order lines: %w", err)
+	}
+	return o, lines, nil
+}
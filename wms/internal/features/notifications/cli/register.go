@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/mxV03/wms/internal/features/interfaces/cli/clictx"
 	"github.com/mxV03/wms/internal/features/interfaces/cli/registry"
@@ -94,4 +95,35 @@ func init() {
 
 		},
 	})
+	registry.Register(registry.Command{
+		Name:        "notify.sla",
+		Group:       "Optional / Notifications",
+		Usage:       "notify.sla",
+		Description: "Send a notification for every open outbound order past its ship-by date.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 0 {
+				return fmt.Errorf("usage: notify.sla")
+			}
+
+			rep := reporting.NewReportService(clictx.AppCtx().Client())
+			breaches, err := rep.SLABreaches(ctx, time.Now())
+			if err != nil {
+				return err
+			}
+			if len(breaches) == 0 {
+				fmt.Println("ok: no SLA breaches")
+				return nil
+			}
+
+			svc := notifications.NewNotificationService(notifications.LoadConfigFromEnv())
+			for _, b := range breaches {
+				msg := fmt.Sprintf("order %s (priority=%s) not shipped, ship-by was %s",
+					b.Number, b.Priority, b.ShipBy.Format("2006-01-02 15:04"))
+				if err := svc.Send(ctx, "SLA_BREACH", msg); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	})
 }
@@ -0,0 +1,353 @@
+//go:build packing
+
+package packing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mxV03/wms/ent"
+	"github.com/mxV03/wms/ent/item"
+)
+
+var (
+	ErrNoCartonTypes     = fmt.Errorf("no carton types defined")
+	ErrMissingDimensions = fmt.Errorf("items without dimensions or weight")
+	ErrItemTooLarge      = fmt.Errorf("item fits no carton type")
+	ErrNothingToPack     = fmt.Errorf("nothing left to pack")
+	ErrTooManyUnits      = fmt.Errorf("too many units to suggest cartons for")
+)
+
+// dimDivisor converts cm³ into volumetric kg the way most parcel carriers do.
+const dimDivisor = 5000.0
+
+// eps absorbs float rounding when comparing positions and weights.
+const eps = 1e-9
+
+// maxUnits bounds the units placed by Suggest; the extreme point heuristic
+// gets slow, roughly cubic, in the number of units.
+const maxUnits = 500
+
+type SuggestedCartonDTO struct {
+	Type  CartonTypeDTO
+	Lines []CartonLineDTO
+	// Weight includes the tare weight of the box.
+	Weight           float64
+	VolumetricWeight float64
+	ChargeableWeight float64
+	// Fill is the share of the inner volume taken by the items.
+	Fill float64
+}
+
+type SuggestionDTO struct {
+	OrderNr          string
+	Cartons          []SuggestedCartonDTO
+	ChargeableWeight float64
+}
+
+// Suggest computes a small set of cartons for the units of an order that are
+// not packed yet. Units come from the finished picklist or, before picking,
+// from the order lines.
+func (s *PackingService) Suggest(ctx context.Context, orderNr string) (*SuggestionDTO, error) {
+	orderNr = strings.TrimSpace(orderNr)
+	if orderNr == "" {
+		return nil, ErrInvalidOrderNr
+	}
+
+	o, err := getOrder(ctx, s.client, orderNr)
+	if err != nil {
+		return nil, err
+	}
+	types, err := s.ListCartonTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(types) == 0 {
+		return nil, ErrNoCartonTypes
+	}
+
+	want, err := picked(ctx, s.client, o)
+	if errors.Is(err, ErrNotPicked) {
+		want, err = ordered(ctx, o)
+	}
+	if err != nil {
+		return nil, err
+	}
+	done, err := packed(ctx, s.client, o)
+	if err != nil {
+		return nil, err
+	}
+
+	skus := make([]string, 0, len(want))
+	total := 0
+	for sku, n := range want {
+		if n > done[sku] {
+			skus = append(skus, sku)
+			total += n - done[sku]
+		}
+	}
+	if len(skus) == 0 {
+		return nil, ErrNothingToPack
+	}
+	if total > maxUnits {
+		return nil, fmt.Errorf("%w: %d (max %d)", ErrTooManyUnits, total, maxUnits)
+	}
+
+	items, err := s.client.Item.Query().
+		Where(item.SKUIn(skus...)).
+		Order(ent.Asc(item.FieldSKU)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetch items: %w", err)
+	}
+	units := make([]unit, 0, total)
+	missing := make([]string, 0)
+	for _, it := range items {
+		n := want[it.SKU] - done[it.SKU]
+		if it.Length <= 0 || it.Width <= 0 || it.Height <= 0 || it.Weight <= 0 {
+			missing = append(missing, it.SKU)
+			continue
+		}
+		for i := 0; i < n; i++ {
+			units = append(units, unit{
+				sku:    it.SKU,
+				name:   it.Name,
+				dims:   [3]float64{it.Length, it.Width, it.Height},
+				weight: it.Weight,
+			})
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrMissingDimensions, strings.Join(missing, ", "))
+	}
+
+	boxes, err := cartonize(units, types)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &SuggestionDTO{
+		OrderNr: orderNr,
+		Cartons: make([]SuggestedCartonDTO, 0, len(boxes)),
+	}
+	for _, b := range boxes {
+		c := b.toDTO()
+		out.ChargeableWeight += c.ChargeableWeight
+		out.Cartons = append(out.Cartons, c)
+	}
+	return out, nil
+}
+
+// ordered returns the ordered units per SKU.
+func ordered(ctx context.Context, o *ent.Order) (map[string]int, error) {
+	lines, err := o.QueryLines().WithItem().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetch order lines: %w", err)
+	}
+	out := map[string]int{}
+	for _, ol := range lines {
+		out[ol.Edges.Item.SKU] += ol.Quantity
+	}
+	return out, nil
+}
+
+type unit struct {
+	sku    string
+	name   string
+	dims   [3]float64
+	weight float64
+}
+
+func (u unit) volume() float64 {
+	return u.dims[0] * u.dims[1] * u.dims[2]
+}
+
+// space is an axis-aligned block inside a carton, measured from the inner
+// corner at the bottom left front.
+type space struct {
+	x, y, z float64
+	l, w, h float64
+}
+
+func (a space) overlaps(b space) bool {
+	return a.x < b.x+b.l-eps && b.x < a.x+a.l-eps &&
+		a.y < b.y+b.w-eps && b.y < a.y+a.w-eps &&
+		a.z < b.z+b.h-eps && b.z < a.z+a.h-eps
+}
+
+// box is one carton being filled with the extreme point heuristic: every unit
+// goes to the lowest, rearmost, leftmost free corner where one of its six
+// orientations fits.
+type box struct {
+	t      CartonTypeDTO
+	units  []unit
+	placed []space
+	points []space
+	weight float64
+	volume float64
+}
+
+func newBox(t CartonTypeDTO) *box {
+	return &box{t: t, points: []space{{}}}
+}
+
+func (b *box) place(u unit) bool {
+	if b.weight+u.weight > b.t.MaxWeight+eps {
+		return false
+	}
+	sort.Slice(b.points, func(i, j int) bool {
+		p, q := b.points[i], b.points[j]
+		if p.z != q.z {
+			return p.z < q.z
+		}
+		if p.y != q.y {
+			return p.y < q.y
+		}
+		return p.x < q.x
+	})
+
+	for i, p := range b.points {
+		for _, o := range orientations(u.dims) {
+			s := space{x: p.x, y: p.y, z: p.z, l: o[0], w: o[1], h: o[2]}
+			if !b.fits(s) {
+				continue
+			}
+			b.units = append(b.units, u)
+			b.placed = append(b.placed, s)
+			b.weight += u.weight
+			b.volume += u.volume()
+			b.points = append(b.points[:i], b.points[i+1:]...)
+			b.points = append(b.points,
+				space{x: s.x + s.l, y: s.y, z: s.z},
+				space{x: s.x, y: s.y + s.w, z: s.z},
+				space{x: s.x, y: s.y, z: s.z + s.h},
+			)
+			return true
+		}
+	}
+	return false
+}
+
+func (b *box) fits(s space) bool {
+	if s.x+s.l > b.t.Length+eps || s.y+s.w > b.t.Width+eps || s.z+s.h > b.t.Height+eps {
+		return false
+	}
+	for _, p := range b.placed {
+		if s.overlaps(p) {
+			return false
+		}
+	}
+	return true
+}
+
+func (b *box) toDTO() SuggestedCartonDTO {
+	qty := map[string]int{}
+	names := map[string]string{}
+	for _, u := range b.units {
+		qty[u.sku]++
+		names[u.sku] = u.name
+	}
+	c := SuggestedCartonDTO{
+		Type:             b.t,
+		Weight:           b.weight + b.t.TareWeight,
+		VolumetricWeight: b.t.Volume() / dimDivisor,
+		Fill:             b.volume / b.t.Volume(),
+	}
+	c.ChargeableWeight = c.Weight
+	if c.VolumetricWeight > c.ChargeableWeight {
+		c.ChargeableWeight = c.VolumetricWeight
+	}
+	for sku, n := range qty {
+		c.Lines = append(c.Lines, CartonLineDTO{SKU: sku, ItemName: names[sku], Quantity: n})
+	}
+	sort.Slice(c.Lines, func(i, j int) bool { return c.Lines[i].SKU < c.Lines[j].SKU })
+	return c
+}
+
+func orientations(d [3]float64) [][3]float64 {
+	l, w, h := d[0], d[1], d[2]
+	return [][3]float64{
+		{l, w, h}, {w, l, h},
+		{l, h, w}, {h, l, w},
+		{w, h, l}, {h, w, l},
+	}
+}
+
+// fill packs as many units as possible into one carton of type t, largest
+// units first, and returns the box and the units left over.
+func fill(t CartonTypeDTO, units []unit) (*box, []unit) {
+	b := newBox(t)
+	rest := make([]unit, 0)
+	for _, u := range units {
+		if !b.place(u) {
+			rest = append(rest, u)
+		}
+	}
+	return b, rest
+}
+
+// cartonize repeatedly opens the smallest carton type that takes all
+// remaining units, or else the type that takes the largest volume of them.
+// types must be sorted from the smallest box up.
+func cartonize(units []unit, types []CartonTypeDTO) ([]*box, error) {
+	checked := map[string]bool{}
+	for _, u := range units {
+		if checked[u.sku] {
+			continue
+		}
+		checked[u.sku] = true
+		ok := false
+		for _, t := range types {
+			if b, _ := fill(t, []unit{u}); len(b.units) == 1 {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrItemTooLarge, u.sku)
+		}
+	}
+
+	left := append([]unit(nil), units...)
+	sort.SliceStable(left, func(i, j int) bool {
+		if left[i].volume() != left[j].volume() {
+			return left[i].volume() > left[j].volume()
+		}
+		if left[i].weight != left[j].weight {
+			return left[i].weight > left[j].weight
+		}
+		return left[i].sku < left[j].sku
+	})
+
+	boxes := make([]*box, 0)
+	for len(left) > 0 {
+		var best *box
+		var bestRest []unit
+		for _, t := range types {
+			b, rest := fill(t, left)
+			if len(rest) == 0 {
+				best, bestRest = b, rest
+				break
+			}
+			if best == nil || b.volume > best.volume+eps {
+				best, bestRest = b, rest
+			}
+		}
+		boxes = append(boxes, best)
+		left = bestRest
+	}
+	return boxes, nil
+}
+
+// sortBySize orders carton types by inner volume, smallest first.
+func sortBySize(types []CartonTypeDTO) {
+	sort.SliceStable(types, func(i, j int) bool {
+		if types[i].Volume() != types[j].Volume() {
+			return types[i].Volume() < types[j].Volume()
+		}
+		return types[i].Code < types[j].Code
+	})
+}
@@ -0,0 +1,155 @@
+//go:build packing
+
+package packing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mxV03/wms/ent"
+	"github.com/mxV03/wms/ent/cartontype"
+	"github.com/mxV03/wms/ent/item"
+	"github.com/mxV03/wms/internal/auditlog"
+)
+
+var (
+	ErrInvalidCartonType  = fmt.Errorf("invalid carton type code")
+	ErrCartonTypeNotFound = fmt.Errorf("carton type not found")
+	ErrItemNotFound       = fmt.Errorf("item not found")
+)
+
+type CartonTypeDTO struct {
+	Code       string
+	Length     float64
+	Width      float64
+	Height     float64
+	MaxWeight  float64
+	TareWeight float64
+}
+
+// Volume is the inner volume in cm³.
+func (t CartonTypeDTO) Volume() float64 {
+	return t.Length * t.Width * t.Height
+}
+
+type ItemDimensionsDTO struct {
+	SKU    string
+	Name   string
+	Length float64
+	Width  float64
+	Height float64
+	Weight float64
+}
+
+// SetCartonType creates a carton type or replaces the sizes of an existing one.
+func (s *PackingService) SetCartonType(ctx context.Context, t CartonTypeDTO) error {
+	t.Code = strings.ToUpper(strings.TrimSpace(t.Code))
+	if t.Code == "" {
+		return ErrInvalidCartonType
+	}
+	if t.Length <= 0 || t.Width <= 0 || t.Height <= 0 {
+		return ErrInvalidDimension
+	}
+	if t.MaxWeight <= 0 || t.TareWeight < 0 {
+		return ErrInvalidWeight
+	}
+
+	existing, err := s.client.CartonType.Query().
+		Where(cartontype.Code(t.Code)).
+		Only(ctx)
+	switch {
+	case err == nil:
+		err = s.client.CartonType.UpdateOne(existing).
+			SetLength(t.Length).
+			SetWidth(t.Width).
+			SetHeight(t.Height).
+			SetMaxWeight(t.MaxWeight).
+			SetTareWeight(t.TareWeight).
+			Exec(ctx)
+	case ent.IsNotFound(err):
+		err = s.client.CartonType.Create().
+			SetCode(t.Code).
+			SetLength(t.Length).
+			SetWidth(t.Width).
+			SetHeight(t.Height).
+			SetMaxWeight(t.MaxWeight).
+			SetTareWeight(t.TareWeight).
+			Exec(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("save carton type: %w", err)
+	}
+	auditlog.Logf(ctx, "cartontype.set", "cartontype", t.Code, "%gx%gx%g max=%g tare=%g", t.Length, t.Width, t.Height, t.MaxWeight, t.TareWeight)
+	return nil
+}
+
+// ListCartonTypes returns the catalog ordered from the smallest box up.
+func (s *PackingService) ListCartonTypes(ctx context.Context) ([]CartonTypeDTO, error) {
+	types, err := s.client.CartonType.Query().
+		Order(ent.Asc(cartontype.FieldCode)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list carton types: %w", err)
+	}
+	out := make([]CartonTypeDTO, 0, len(types))
+	for _, t := range types {
+		out = append(out, toCartonTypeDTO(t))
+	}
+	sortBySize(out)
+	return out, nil
+}
+
+// SetItemDimensions records the packed size and weight of one unit of an item.
+func (s *PackingService) SetItemDimensions(ctx context.Context, sku string, length, width, height, weight float64) error {
+	sku = strings.TrimSpace(sku)
+	if sku == "" {
+		return ErrInvalidSKU
+	}
+	if length <= 0 || width <= 0 || height <= 0 {
+		return ErrInvalidDimension
+	}
+	if weight <= 0 {
+		return ErrInvalidWeight
+	}
+
+	n, err := s.client.Item.Update().
+		Where(item.SKU(sku)).
+		SetLength(length).
+		SetWidth(width).
+		SetHeight(height).
+		SetWeight(weight).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("update item: %w", err)
+	}
+	if n == 0 {
+		return ErrItemNotFound
+	}
+	auditlog.Logf(ctx, "item.dimensions", "item", sku, "%gx%gx%g weight=%g", length, width, height, weight)
+	return nil
+}
+
+func (s *PackingService) cartonType(ctx context.Context, client *ent.Client, code string) (*ent.CartonType, error) {
+	t, err := client.CartonType.Query().
+		Where(cartontype.Code(strings.ToUpper(strings.TrimSpace(code)))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrCartonTypeNotFound
+		}
+		return nil, fmt.Errorf("fetch carton type: %w", err)
+	}
+	return t, nil
+}
+
+func toCartonTypeDTO(t *ent.CartonType) CartonTypeDTO {
+	return CartonTypeDTO{
+		Code:       t.Code,
+		Length:     t.Length,
+		Width:      t.Width,
+		Height:     t.Height,
+		MaxWeight:  t.MaxWeight,
+		TareWeight: t.TareWeight,
+	}
+}
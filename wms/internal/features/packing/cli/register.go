@@ -0,0 +1,167 @@
+//go:build packing
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mxV03/wms/internal/features/interfaces/cli/clictx"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/registry"
+	"github.com/mxV03/wms/internal/features/packing"
+)
+
+func init() {
+	registry.Register(registry.Command{
+		Name:        "pack.start",
+		Usage:       "pack.start <orderNr> [cartonType]",
+		Group:       "Optional / Packing",
+		Description: "Open a new carton for an order whose picklist or wave is DONE, optionally of a catalog carton type.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) < 1 || len(args) > 2 {
+				return fmt.Errorf("usage: pack.start <orderNr> [cartonType]")
+			}
+			typeCode := ""
+			if len(args) == 2 {
+				typeCode = args[1]
+			}
+			svc := packing.NewPackingService(clictx.AppCtx().Client())
+			c, err := svc.Start(ctx, args[0], typeCode)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("carton opened: CARTON=%s ORDER=%s TYPE=%s\n", c.Number, c.OrderNr, dash(c.Type))
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "pack.add",
+		Usage:       "pack.add <carton> <sku> <quantity>",
+		Group:       "Optional / Packing",
+		Description: "Pack units of an item into an open carton (up to the picked quantity).",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 3 {
+				return fmt.Errorf("usage: pack.add <carton> <sku> <quantity>")
+			}
+			qty, err := strconv.Atoi(args[2])
+			if err != nil {
+				return fmt.Errorf("quantity must be an integer")
+			}
+			svc := packing.NewPackingService(clictx.AppCtx().Client())
+			if err := svc.Add(ctx, args[0], args[1], qty); err != nil {
+				return err
+			}
+			fmt.Printf("packed: CARTON=%s SKU=%s QTY=%d\n", args[0], args[1], qty)
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "pack.close",
+		Usage:       "pack.close <carton> <weightKg> [LxWxH]",
+		Group:       "Optional / Packing",
+		Description: "Close a carton with its gross weight and optional outer dimensions in cm (default: its carton type).",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) < 2 || len(args) > 3 {
+				return fmt.Errorf("usage: pack.close <carton> <weightKg> [LxWxH]")
+			}
+			weight, err := strconv.ParseFloat(args[1], 64)
+			if err != nil {
+				return fmt.Errorf("weight must be a number")
+			}
+			var dims [3]float64
+			if len(args) == 3 {
+				if dims, err = parseDims(args[2]); err != nil {
+					return err
+				}
+			}
+			svc := packing.NewPackingService(clictx.AppCtx().Client())
+			if err := svc.Close(ctx, args[0], weight, dims[0], dims[1], dims[2]); err != nil {
+				return err
+			}
+			fmt.Printf("carton closed: CARTON=%s WEIGHT=%.2f\n", args[0], weight)
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "pack.status",
+		Usage:       "pack.status <orderNr>",
+		Group:       "Optional / Packing",
+		Description: "Compare picked and packed quantities of an order and list its cartons.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("usage: pack.status <orderNr>")
+			}
+			svc := packing.NewPackingService(clictx.AppCtx().Client())
+			st, err := svc.Status(ctx, args[0])
+			if err != nil {
+				return err
+			}
+			fmt.Printf("ORDER=%s COMPLETE=%t\n", st.OrderNr, st.Complete())
+			fmt.Println("SKU\tPICKED\tPACKED\tNAME")
+			for _, l := range st.Lines {
+				fmt.Printf("%s\t%d\t%d\t%s\n", l.SKU, l.Picked, l.Packed, l.ItemName)
+			}
+			fmt.Println("CARTON\tTYPE\tSTATUS\tWEIGHT\tDIMENSIONS\tTRACKING\tITEMS")
+			for _, c := range st.Cartons {
+				items := make([]string, 0, len(c.Lines))
+				for _, l := range c.Lines {
+					items = append(items, fmt.Sprintf("%s x%d", l.SKU, l.Quantity))
+				}
+				fmt.Printf("%s\t%s\t%s\t%.2f\t%gx%gx%g\t%s\t%s\n", c.Number, dash(c.Type), c.Status, c.Weight, c.Length, c.Width, c.Height, dash(c.Tracking), strings.Join(items, ", "))
+			}
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "pack.slip",
+		Usage:       "pack.slip <orderNr> [text|html]",
+		Group:       "Optional / Packing",
+		Description: "Print the packing slip of a completely packed order.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) < 1 || len(args) > 2 {
+				return fmt.Errorf("usage: pack.slip <orderNr> [text|html]")
+			}
+			format := ""
+			if len(args) == 2 {
+				format = args[1]
+			}
+			svc := packing.NewPackingService(clictx.AppCtx().Client())
+			slip, err := svc.Slip(ctx, args[0], format)
+			if err != nil {
+				return err
+			}
+			fmt.Print(slip)
+			return nil
+		},
+	})
+}
+
+// parseDims parses dimensions written as LxWxH, e.g. 40x30x20.
+func parseDims(s string) ([3]float64, error) {
+	var dims [3]float64
+	parts := strings.Split(strings.ToLower(s), "x")
+	if len(parts) != 3 {
+		return dims, fmt.Errorf("dimensions must be LxWxH, e.g. 40x30x20")
+	}
+	for i, p := range parts {
+		v, err := strconv.ParseFloat(p, 64)
+		if err != nil {
+			return dims, fmt.Errorf("dimensions must be LxWxH, e.g. 40x30x20")
+		}
+		dims[i] = v
+	}
+	return dims, nil
+}
+
+func dash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
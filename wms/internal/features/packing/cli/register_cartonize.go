@@ -0,0 +1,133 @@
+//go:build packing
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mxV03/wms/internal/features/interfaces/cli/clictx"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/registry"
+	"github.com/mxV03/wms/internal/features/packing"
+)
+
+func init() {
+	registry.Register(registry.Command{
+		Name:        "pack.type.set",
+		Usage:       "pack.type.set <code> <LxWxH> <maxWeightKg> [tareKg]",
+		Group:       "Optional / Packing",
+		Description: "Create or update a carton type with inner dimensions in cm.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) < 3 || len(args) > 4 {
+				return fmt.Errorf("usage: pack.type.set <code> <LxWxH> <maxWeightKg> [tareKg]")
+			}
+			dims, err := parseDims(args[1])
+			if err != nil {
+				return err
+			}
+			maxWeight, err := strconv.ParseFloat(args[2], 64)
+			if err != nil {
+				return fmt.Errorf("maxWeightKg must be a number")
+			}
+			tare := 0.0
+			if len(args) == 4 {
+				if tare, err = strconv.ParseFloat(args[3], 64); err != nil {
+					return fmt.Errorf("tareKg must be a number")
+				}
+			}
+			svc := packing.NewPackingService(clictx.AppCtx().Client())
+			err = svc.SetCartonType(ctx, packing.CartonTypeDTO{
+				Code:       args[0],
+				Length:     dims[0],
+				Width:      dims[1],
+				Height:     dims[2],
+				MaxWeight:  maxWeight,
+				TareWeight: tare,
+			})
+			if err != nil {
+				return err
+			}
+			fmt.Printf("carton type set: CODE=%s\n", strings.ToUpper(args[0]))
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "pack.type.list",
+		Usage:       "pack.type.list",
+		Group:       "Optional / Packing",
+		Description: "List carton types from the smallest up.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 0 {
+				return fmt.Errorf("usage: pack.type.list")
+			}
+			svc := packing.NewPackingService(clictx.AppCtx().Client())
+			types, err := svc.ListCartonTypes(ctx)
+			if err != nil {
+				return err
+			}
+			fmt.Println("CODE\tINNER (cm)\tMAX KG\tTARE KG")
+			for _, t := range types {
+				fmt.Printf("%s\t%gx%gx%g\t%g\t%g\n", t.Code, t.Length, t.Width, t.Height, t.MaxWeight, t.TareWeight)
+			}
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "pack.item.dims",
+		Usage:       "pack.item.dims <sku> <LxWxH> <weightKg>",
+		Group:       "Optional / Packing",
+		Description: "Set the dimensions in cm and weight of one unit of an item.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 3 {
+				return fmt.Errorf("usage: pack.item.dims <sku> <LxWxH> <weightKg>")
+			}
+			dims, err := parseDims(args[1])
+			if err != nil {
+				return err
+			}
+			weight, err := strconv.ParseFloat(args[2], 64)
+			if err != nil {
+				return fmt.Errorf("weightKg must be a number")
+			}
+			svc := packing.NewPackingService(clictx.AppCtx().Client())
+			if err := svc.SetItemDimensions(ctx, args[0], dims[0], dims[1], dims[2], weight); err != nil {
+				return err
+			}
+			fmt.Printf("item dimensions set: SKU=%s\n", args[0])
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "pack.suggest",
+		Usage:       "pack.suggest <orderNr>",
+		Group:       "Optional / Packing",
+		Description: "Suggest carton types for the unpacked units of an order, with chargeable weights.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("usage: pack.suggest <orderNr>")
+			}
+			svc := packing.NewPackingService(clictx.AppCtx().Client())
+			sg, err := svc.Suggest(ctx, args[0])
+			if err != nil {
+				return err
+			}
+			fmt.Printf("ORDER=%s CARTONS=%d CHARGEABLE=%.2fkg\n", sg.OrderNr, len(sg.Cartons), sg.ChargeableWeight)
+			fmt.Println("#\tTYPE\tFILL\tWEIGHT\tVOLUMETRIC\tCHARGEABLE\tITEMS")
+			for i, c := range sg.Cartons {
+				items := make([]string, 0, len(c.Lines))
+				for _, l := range c.Lines {
+					items = append(items, fmt.Sprintf("%s x%d", l.SKU, l.Quantity))
+				}
+				fmt.Printf("%d\t%s\t%.0f%%\t%.2f\t%.2f\t%.2f\t%s\n",
+					i+1, c.Type.Code, c.Fill*100, c.Weight, c.VolumetricWeight, c.ChargeableWeight, strings.Join(items, ", "))
+			}
+			fmt.Printf("open with: pack.start %s <type>\n", sg.OrderNr)
+			return nil
+		},
+	})
+}
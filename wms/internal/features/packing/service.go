@@ -0,0 +1,485 @@
+//go:build packing
+
+package packing
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mxV03/wms/ent"
+	"github.com/mxV03/wms/ent/carton"
+	"github.com/mxV03/wms/ent/cartonline"
+	"github.com/mxV03/wms/ent/item"
+	"github.com/mxV03/wms/ent/order"
+	"github.com/mxV03/wms/ent/orderline"
+	"github.com/mxV03/wms/ent/picklist"
+	"github.com/mxV03/wms/ent/wave"
+	"github.com/mxV03/wms/ent/wavepick"
+	"github.com/mxV03/wms/internal/auditlog"
+	"github.com/mxV03/wms/internal/core/sequence"
+)
+
+var (
+	ErrInvalidOrderNr   = fmt.Errorf("invalid order number")
+	ErrOrderNotFound    = fmt.Errorf("order not found")
+	ErrNotPicked        = fmt.Errorf("order has no finished picklist or wave")
+	ErrInvalidCarton    = fmt.Errorf("invalid carton number")
+	ErrCartonNotFound   = fmt.Errorf("carton not found")
+	ErrCartonClosed     = fmt.Errorf("carton is closed")
+	ErrCartonEmpty      = fmt.Errorf("carton is empty")
+	ErrInvalidSKU       = fmt.Errorf("invalid sku")
+	ErrInvalidQuantity  = fmt.Errorf("quantity must be > 0")
+	ErrInvalidWeight    = fmt.Errorf("weight must be > 0")
+	ErrInvalidDimension = fmt.Errorf("dimensions must be >= 0")
+	ErrOverPacked       = fmt.Errorf("packed quantity exceeds picked quantity")
+	ErrNotPacked        = fmt.Errorf("order is not completely packed")
+)
+
+type PackingService struct {
+	client *ent.Client
+}
+
+func NewPackingService(client *ent.Client) *PackingService {
+	return &PackingService{
+		client: client,
+	}
+}
+
+type CartonLineDTO struct {
+	SKU      string
+	ItemName string
+	Quantity int
+}
+
+type CartonDTO struct {
+	Number   string
+	OrderNr  string
+	Type     string
+	Status   string
+	Length   float64
+	Width    float64
+	Height   float64
+	Weight   float64
+	Tracking string
+	ClosedAt *time.Time
+	Lines    []CartonLineDTO
+}
+
+// PackLineDTO compares picked and packed units of one item of an order.
+type PackLineDTO struct {
+	SKU      string
+	ItemName string
+	Picked   int
+	Packed   int
+}
+
+type PackStatusDTO struct {
+	OrderNr string
+	Lines   []PackLineDTO
+	Cartons []CartonDTO
+}
+
+// Complete reports whether every picked unit is packed and all cartons are
+// closed.
+func (p PackStatusDTO) Complete() bool {
+	if len(p.Cartons) == 0 {
+		return false
+	}
+	for _, l := range p.Lines {
+		if l.Packed != l.Picked {
+			return false
+		}
+	}
+	for _, c := range p.Cartons {
+		if c.Status != "CLOSED" {
+			return false
+		}
+	}
+	return true
+}
+
+// Start opens a new carton for an order whose picklist or wave is DONE. typeCode
+// optionally names the carton type used, e.g. from Suggest.
+func (s *PackingService) Start(ctx context.Context, orderNr, typeCode string) (*CartonDTO, error) {
+	orderNr = strings.TrimSpace(orderNr)
+	if orderNr == "" {
+		return nil, ErrInvalidOrderNr
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	o, err := getOrder(ctx, tx.Client(), orderNr)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := picked(ctx, tx.Client(), o); err != nil {
+		return nil, err
+	}
+
+	create := tx.Carton.Create().SetOrder(o)
+	typeCode = strings.TrimSpace(typeCode)
+	if typeCode != "" {
+		t, err := s.cartonType(ctx, tx.Client(), typeCode)
+		if err != nil {
+			return nil, err
+		}
+		create.SetCartonType(t)
+		typeCode = t.Code
+	}
+
+	number, err := sequence.NewSequenceService(tx.Client()).Next(ctx, sequence.Carton)
+	if err != nil {
+		return nil, fmt.Errorf("next carton number: %w", err)
+	}
+	c, err := create.SetNumber(number).Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create carton: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+	auditlog.Logf(ctx, "carton.start", "order", orderNr, "carton=%s type=%s", number, typeCode)
+
+	return &CartonDTO{
+		Number:  c.Number,
+		OrderNr: orderNr,
+		Type:    typeCode,
+		Status:  c.Status,
+		Lines:   []CartonLineDTO{},
+	}, nil
+}
+
+// Add packs qty units of an item into an open carton. The units packed for
+// the order across all cartons may not exceed the units picked.
+func (s *PackingService) Add(ctx context.Context, cartonNr, sku string, qty int) error {
+	cartonNr = strings.TrimSpace(cartonNr)
+	sku = strings.TrimSpace(sku)
+	if cartonNr == "" {
+		return ErrInvalidCarton
+	}
+	if sku == "" {
+		return ErrInvalidSKU
+	}
+	if qty <= 0 {
+		return ErrInvalidQuantity
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	c, err := getCarton(ctx, tx.Client(), cartonNr)
+	if err != nil {
+		return err
+	}
+	if c.Status != "OPEN" {
+		return ErrCartonClosed
+	}
+	o := c.Edges.Order
+
+	pickedBySKU, err := picked(ctx, tx.Client(), o)
+	if err != nil {
+		return err
+	}
+	packedBySKU, err := packed(ctx, tx.Client(), o)
+	if err != nil {
+		return err
+	}
+	if packedBySKU[sku]+qty > pickedBySKU[sku] {
+		return fmt.Errorf("%w: %s picked=%d packed=%d adding=%d", ErrOverPacked, sku, pickedBySKU[sku], packedBySKU[sku], qty)
+	}
+
+	it, err := tx.Item.Query().
+		Where(item.SKU(sku)).
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("fetch item: %w", err)
+	}
+
+	line, err := tx.CartonLine.Query().
+		Where(
+			cartonline.HasCartonWith(carton.ID(c.ID)),
+			cartonline.HasItemWith(item.ID(it.ID)),
+		).
+		Only(ctx)
+	switch {
+	case err == nil:
+		err = tx.CartonLine.UpdateOne(line).AddQuantity(qty).Exec(ctx)
+	case ent.IsNotFound(err):
+		err = tx.CartonLine.Create().
+			SetCarton(c).
+			SetItem(it).
+			SetQuantity(qty).
+			Exec(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("save carton line: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	auditlog.Logf(ctx, "carton.add", "carton", cartonNr, "sku=%s qty=%d", sku, qty)
+	return nil
+}
+
+// Close records the gross weight and optional outer dimensions of a carton.
+// Without dimensions the sizes of its carton type are used, if any. If the
+// order already has a tracking record the carton is linked to it.
+func (s *PackingService) Close(ctx context.Context, cartonNr string, weight, length, width, height float64) error {
+	cartonNr = strings.TrimSpace(cartonNr)
+	if cartonNr == "" {
+		return ErrInvalidCarton
+	}
+	if weight <= 0 {
+		return ErrInvalidWeight
+	}
+	if length < 0 || width < 0 || height < 0 {
+		return ErrInvalidDimension
+	}
+
+	c, err := getCarton(ctx, s.client, cartonNr)
+	if err != nil {
+		return err
+	}
+	if c.Status != "OPEN" {
+		return ErrCartonClosed
+	}
+	if len(c.Edges.Lines) == 0 {
+		return ErrCartonEmpty
+	}
+	if t := c.Edges.CartonType; t != nil && length == 0 && width == 0 && height == 0 {
+		length, width, height = t.Length, t.Width, t.Height
+	}
+
+	u := s.client.Carton.UpdateOne(c).
+		Where(carton.Status("OPEN")).
+		SetStatus("CLOSED").
+		SetWeight(weight).
+		SetLength(length).
+		SetWidth(width).
+		SetHeight(height).
+		SetClosedAt(time.Now())
+	if t := c.Edges.Order.Edges.Tracking; t != nil {
+		u.SetTracking(t)
+	}
+	if err := u.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return ErrCartonClosed
+		}
+		return fmt.Errorf("close carton: %w", err)
+	}
+	auditlog.Logf(ctx, "carton.close", "carton", cartonNr, "weight=%.2f", weight)
+	return nil
+}
+
+// Status compares picked and packed units of an order and lists its cartons.
+func (s *PackingService) Status(ctx context.Context, orderNr string) (*PackStatusDTO, error) {
+	orderNr = strings.TrimSpace(orderNr)
+	if orderNr == "" {
+		return nil, ErrInvalidOrderNr
+	}
+
+	o, err := getOrder(ctx, s.client, orderNr)
+	if err != nil {
+		return nil, err
+	}
+	pickedBySKU, err := picked(ctx, s.client, o)
+	if err != nil {
+		return nil, err
+	}
+
+	cartons, err := s.client.Carton.Query().
+		Where(carton.HasOrderWith(order.ID(o.ID))).
+		WithTracking().
+		WithCartonType().
+		WithLines(func(q *ent.CartonLineQuery) {
+			q.WithItem()
+		}).
+		Order(ent.Asc(carton.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list cartons: %w", err)
+	}
+
+	names := map[string]string{}
+	packedBySKU := map[string]int{}
+	out := &PackStatusDTO{
+		OrderNr: orderNr,
+		Cartons: make([]CartonDTO, 0, len(cartons)),
+	}
+	for _, c := range cartons {
+		dto := toCartonDTO(c, orderNr)
+		for _, l := range dto.Lines {
+			packedBySKU[l.SKU] += l.Quantity
+			names[l.SKU] = l.ItemName
+		}
+		out.Cartons = append(out.Cartons, dto)
+	}
+
+	lines, err := o.QueryLines().WithItem().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetch order lines: %w", err)
+	}
+	for _, ol := range lines {
+		names[ol.Edges.Item.SKU] = ol.Edges.Item.Name
+	}
+
+	skus := make([]string, 0, len(names))
+	for sku := range names {
+		skus = append(skus, sku)
+	}
+	sort.Strings(skus)
+	for _, sku := range skus {
+		out.Lines = append(out.Lines, PackLineDTO{
+			SKU:      sku,
+			ItemName: names[sku],
+			Picked:   pickedBySKU[sku],
+			Packed:   packedBySKU[sku],
+		})
+	}
+	return out, nil
+}
+
+func getOrder(ctx context.Context, client *ent.Client, orderNr string) (*ent.Order, error) {
+	o, err := client.Order.Query().
+		Where(order.OrderNumber(orderNr)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrOrderNotFound
+		}
+		return nil, fmt.Errorf("fetch order: %w", err)
+	}
+	return o, nil
+}
+
+func getCarton(ctx context.Context, client *ent.Client, cartonNr string) (*ent.Carton, error) {
+	c, err := client.Carton.Query().
+		Where(carton.Number(cartonNr)).
+		WithOrder(func(q *ent.OrderQuery) {
+			q.WithTracking()
+		}).
+		WithCartonType().
+		WithLines().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrCartonNotFound
+		}
+		return nil, fmt.Errorf("fetch carton: %w", err)
+	}
+	return c, nil
+}
+
+// picked returns the units picked per SKU from the order's finished
+// picklist or, for an order released in a wave, from its share of the
+// finished wave.
+func picked(ctx context.Context, client *ent.Client, o *ent.Order) (map[string]int, error) {
+	pl, err := client.PickList.Query().
+		Where(picklist.HasOrderWith(order.ID(o.ID))).
+		WithTasks(func(q *ent.PickTaskQuery) {
+			q.WithOrderLine(func(olq *ent.OrderLineQuery) {
+				olq.WithItem()
+			})
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return wavePicked(ctx, client, o)
+		}
+		return nil, fmt.Errorf("fetch picklist: %w", err)
+	}
+	if pl.Status != "DONE" {
+		return nil, ErrNotPicked
+	}
+
+	out := map[string]int{}
+	for _, t := range pl.Edges.Tasks {
+		out[t.Edges.OrderLine.Edges.Item.SKU] += t.Picked
+	}
+	return out, nil
+}
+
+func wavePicked(ctx context.Context, client *ent.Client, o *ent.Order) (map[string]int, error) {
+	w, err := client.Wave.Query().
+		Where(wave.HasOrdersWith(order.ID(o.ID))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotPicked
+		}
+		return nil, fmt.Errorf("fetch wave: %w", err)
+	}
+	if w.Status != "DONE" {
+		return nil, ErrNotPicked
+	}
+
+	picks, err := client.WavePick.Query().
+		Where(wavepick.HasOrderLineWith(orderline.HasOrderWith(order.ID(o.ID)))).
+		WithOrderLine(func(olq *ent.OrderLineQuery) {
+			olq.WithItem()
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetch wave picks: %w", err)
+	}
+	out := map[string]int{}
+	for _, p := range picks {
+		out[p.Edges.OrderLine.Edges.Item.SKU] += p.Picked
+	}
+	return out, nil
+}
+
+// packed returns the units per SKU in all cartons of the order.
+func packed(ctx context.Context, client *ent.Client, o *ent.Order) (map[string]int, error) {
+	lines, err := client.CartonLine.Query().
+		Where(cartonline.HasCartonWith(carton.HasOrderWith(order.ID(o.ID)))).
+		WithItem().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetch carton lines: %w", err)
+	}
+	out := map[string]int{}
+	for _, l := range lines {
+		out[l.Edges.Item.SKU] += l.Quantity
+	}
+	return out, nil
+}
+
+func toCartonDTO(c *ent.Carton, orderNr string) CartonDTO {
+	dto := CartonDTO{
+		Number:   c.Number,
+		OrderNr:  orderNr,
+		Status:   c.Status,
+		Length:   c.Length,
+		Width:    c.Width,
+		Height:   c.Height,
+		Weight:   c.Weight,
+		ClosedAt: c.ClosedAt,
+		Lines:    make([]CartonLineDTO, 0, len(c.Edges.Lines)),
+	}
+	if t := c.Edges.Tracking; t != nil {
+		dto.Tracking = t.TrackingID
+	}
+	if t := c.Edges.CartonType; t != nil {
+		dto.Type = t.Code
+	}
+	for _, l := range c.Edges.Lines {
+		dto.Lines = append(dto.Lines, CartonLineDTO{
+			SKU:      l.Edges.Item.SKU,
+			ItemName: l.Edges.Item.Name,
+			Quantity: l.Quantity,
+		})
+	}
+	sort.Slice(dto.Lines, func(i, j int) bool { return dto.Lines[i].SKU < dto.Lines[j].SKU })
+	return dto
+}
@@ -0,0 +1,103 @@
+//go:build packing
+
+package packing
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html/template"
+	"strings"
+	"time"
+)
+
+var ErrInvalidFormat = fmt.Errorf("invalid slip format (use text or html)")
+
+// Slip renders the packing slip of a completely packed order as plain text
+// or HTML.
+func (s *PackingService) Slip(ctx context.Context, orderNr, format string) (string, error) {
+	format = strings.ToLower(strings.TrimSpace(format))
+	if format == "" {
+		format = "text"
+	}
+	if format != "text" && format != "html" {
+		return "", ErrInvalidFormat
+	}
+
+	st, err := s.Status(ctx, orderNr)
+	if err != nil {
+		return "", err
+	}
+	if !st.Complete() {
+		return "", ErrNotPacked
+	}
+
+	if format == "html" {
+		var buf bytes.Buffer
+		data := struct {
+			*PackStatusDTO
+			Printed time.Time
+		}{st, time.Now()}
+		if err := slipHTML.Execute(&buf, data); err != nil {
+			return "", fmt.Errorf("render slip: %w", err)
+		}
+		return buf.String(), nil
+	}
+	return slipText(st), nil
+}
+
+func slipText(st *PackStatusDTO) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "PACKING SLIP  order %s  (%d carton(s))\n", st.OrderNr, len(st.Cartons))
+	fmt.Fprintf(&b, "printed %s\n", time.Now().Format("2006-01-02 15:04"))
+	for i, c := range st.Cartons {
+		fmt.Fprintf(&b, "\nCarton %d/%d  %s  %.2f kg", i+1, len(st.Cartons), c.Number, c.Weight)
+		if c.Length > 0 || c.Width > 0 || c.Height > 0 {
+			fmt.Fprintf(&b, "  %gx%gx%g cm", c.Length, c.Width, c.Height)
+		}
+		if c.Tracking != "" {
+			fmt.Fprintf(&b, "  tracking %s", c.Tracking)
+		}
+		b.WriteString("\n")
+		for _, l := range c.Lines {
+			fmt.Fprintf(&b, "  %-16s %-30s %6d\n", l.SKU, l.ItemName, l.Quantity)
+		}
+	}
+	b.WriteString("\nTotals\n")
+	for _, l := range st.Lines {
+		fmt.Fprintf(&b, "  %-16s %-30s %6d\n", l.SKU, l.ItemName, l.Packed)
+	}
+	return b.String()
+}
+
+var slipHTML = template.Must(template.New("slip").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Packing slip {{.OrderNr}}</title>
+<style>
+body { font-family: sans-serif; }
+table { border-collapse: collapse; margin-bottom: 1em; }
+th, td { border: 1px solid #999; padding: 2px 8px; text-align: left; }
+td.qty { text-align: right; }
+</style>
+</head>
+<body>
+<h1>Packing slip {{.OrderNr}}</h1>
+<p>{{len .Cartons}} carton(s), printed {{.Printed.Format "2006-01-02 15:04"}}</p>
+{{range $i, $c := .Cartons}}
+<h2>Carton {{$c.Number}}</h2>
+<p>{{printf "%.2f" $c.Weight}} kg{{if gt $c.Length 0.0}}, {{$c.Length}}x{{$c.Width}}x{{$c.Height}} cm{{end}}{{if $c.Tracking}}, tracking {{$c.Tracking}}{{end}}</p>
+<table>
+<tr><th>SKU</th><th>Item</th><th>Qty</th></tr>
+{{range $c.Lines}}<tr><td>{{.SKU}}</td><td>{{.ItemName}}</td><td class="qty">{{.Quantity}}</td></tr>
+{{end}}</table>
+{{end}}
+<h2>Totals</h2>
+<table>
+<tr><th>SKU</th><th>Item</th><th>Qty</th></tr>
+{{range .Lines}}<tr><td>{{.SKU}}</td><td>{{.ItemName}}</td><td class="qty">{{.Packed}}</td></tr>
+{{end}}</table>
+</body>
+</html>
+`))
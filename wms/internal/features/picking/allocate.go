@@ -0,0 +1,244 @@
+//go:build picking
+
+package picking
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/mxV03/wms/ent"
+	"github.com/mxV03/wms/ent/bin"
+	"github.com/mxV03/wms/ent/binstock"
+	"github.com/mxV03/wms/ent/item"
+	"github.com/mxV03/wms/ent/location"
+	"github.com/mxV03/wms/internal/core/inventory/stock"
+	"github.com/mxV03/wms/internal/features/logistics"
+)
+
+// BinStrategy decides which bins a line is picked from when its stock is
+// spread over several bins.
+type BinStrategy string
+
+const (
+	// BinFewest uses as few bins as possible, preferring the smallest bin that
+	// covers the line on its own so larger bins stay intact.
+	BinFewest BinStrategy = "fewest"
+	// BinFIFO empties the bins with the oldest receipts first.
+	BinFIFO BinStrategy = "fifo"
+	// BinClosest takes the bins nearest to the depot first.
+	BinClosest BinStrategy = "closest"
+)
+
+var ErrInvalidBinStrategy = fmt.Errorf("invalid bin strategy (use fewest, fifo or closest)")
+
+func ParseBinStrategy(s string) (BinStrategy, error) {
+	switch BinStrategy(strings.ToLower(strings.TrimSpace(s))) {
+	case "", BinFewest:
+		return BinFewest, nil
+	case BinFIFO:
+		return BinFIFO, nil
+	case BinClosest:
+		return BinClosest, nil
+	default:
+		return "", ErrInvalidBinStrategy
+	}
+}
+
+// BinStrategyFromEnv reads the default strategy from WMS_PICK_BIN_STRATEGY.
+func BinStrategyFromEnv() (BinStrategy, error) {
+	return ParseBinStrategy(os.Getenv("WMS_PICK_BIN_STRATEGY"))
+}
+
+type binAlloc struct {
+	binID    *int
+	quantity int
+}
+
+// allocation tracks the units already handed to earlier lines of the same
+// picklist or wave, so lines sharing an item and location are not given the
+// same stock twice.
+type allocation struct {
+	bins map[[2]int]int // bin and item ID
+	locs map[[2]int]int // item and location ID
+}
+
+func newAllocation() *allocation {
+	return &allocation{bins: map[[2]int]int{}, locs: map[[2]int]int{}}
+}
+
+func (a *allocation) clone() *allocation {
+	c := newAllocation()
+	for k, v := range a.bins {
+		c.bins[k] = v
+	}
+	for k, v := range a.locs {
+		c.locs[k] = v
+	}
+	return c
+}
+
+// held reports whether a line of o is at a location on hold. No new picks are
+// released from there; tasks released before the hold are still picked.
+func held(o *ent.Order) bool {
+	for _, ol := range o.Edges.Lines {
+		if ol.Edges.Location.OnHold {
+			return true
+		}
+	}
+	return false
+}
+
+// allocateBins splits an order line over the bins holding its item at the
+// line location. Without bin stock the line goes to the first bin the item is
+// assigned to, or to no bin at all.
+//
+// Bin stock is only kept by putaway and picking, while the movements booked
+// for the location stay the truth, so bins are never asked for more than the
+// location holds. Whatever the bins do not cover, stock booked outside them or
+// a real shortfall, becomes a task without a bin; the picker finds it at the
+// location or records a short pick. Units in taken are treated as gone, and
+// the line's own allocation is added to it.
+func allocateBins(ctx context.Context, client *ent.Client, ol *ent.OrderLine, strategy BinStrategy, taken *allocation) ([]binAlloc, error) {
+	itemID, locID := ol.Edges.Item.ID, ol.Edges.Location.ID
+	earlier := taken.locs[[2]int{itemID, locID}]
+	taken.locs[[2]int{itemID, locID}] += ol.Quantity
+
+	stocks, err := client.BinStock.Query().
+		Where(
+			binstock.HasItemWith(item.ID(itemID)),
+			binstock.HasBinWith(bin.HasLocationWith(location.ID(locID))),
+			binstock.QuantityGT(0),
+		).
+		WithBin().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetch bin stock: %w", err)
+	}
+
+	if len(stocks) == 0 {
+		b, err := client.Bin.Query().
+			Where(
+				bin.HasItemsWith(item.ID(itemID)),
+				bin.HasLocationWith(location.ID(locID)),
+			).
+			Order(ent.Asc(bin.FieldCode)).
+			First(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return []binAlloc{{quantity: ol.Quantity}}, nil
+			}
+			return nil, fmt.Errorf("fetch bin: %w", err)
+		}
+		return []binAlloc{{binID: &b.ID, quantity: ol.Quantity}}, nil
+	}
+
+	onHand, err := stock.NewStockService(client).StockAtLocation(ctx, ol.Edges.Item.SKU, ol.Edges.Location.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	// only what earlier lines left over; the records are not saved
+	free := stocks[:0]
+	for _, bs := range stocks {
+		bs.Quantity -= taken.bins[[2]int{bs.Edges.Bin.ID, itemID}]
+		if bs.Quantity > 0 {
+			free = append(free, bs)
+		}
+	}
+	stocks = free
+	orderBinStock(stocks, strategy, ol.Quantity)
+
+	allocs := make([]binAlloc, 0, len(stocks)+1)
+	remaining := ol.Quantity
+	available := max(onHand-earlier, 0)
+	for _, bs := range stocks {
+		if remaining == 0 || available == 0 {
+			break
+		}
+		n := min(bs.Quantity, remaining, available)
+		id := bs.Edges.Bin.ID
+		allocs = append(allocs, binAlloc{binID: &id, quantity: n})
+		taken.bins[[2]int{id, itemID}] += n
+		remaining -= n
+		available -= n
+	}
+	if remaining > 0 {
+		allocs = append(allocs, binAlloc{quantity: remaining})
+	}
+	return allocs, nil
+}
+
+func orderBinStock(stocks []*ent.BinStock, strategy BinStrategy, need int) {
+	byCode := func(i, j int) bool { return stocks[i].Edges.Bin.Code < stocks[j].Edges.Bin.Code }
+
+	switch strategy {
+	case BinFIFO:
+		sort.SliceStable(stocks, func(i, j int) bool {
+			if !stocks[i].ReceivedAt.Equal(stocks[j].ReceivedAt) {
+				return stocks[i].ReceivedAt.Before(stocks[j].ReceivedAt)
+			}
+			return byCode(i, j)
+		})
+
+	case BinClosest:
+		depth := 0
+		for _, bs := range stocks {
+			depth = max(depth, bs.Edges.Bin.Position)
+		}
+		dist := func(b *ent.Bin) float64 {
+			return logistics.WalkDistance(logistics.Depot, logistics.BinSpot(b), depth)
+		}
+		sort.SliceStable(stocks, func(i, j int) bool {
+			a, b := stocks[i].Edges.Bin, stocks[j].Edges.Bin
+			// bins without coordinates come last
+			if (a.Aisle == 0) != (b.Aisle == 0) {
+				return b.Aisle == 0
+			}
+			if da, db := dist(a), dist(b); da != db {
+				return da < db
+			}
+			return byCode(i, j)
+		})
+
+	default:
+		sort.SliceStable(stocks, func(i, j int) bool {
+			if stocks[i].Quantity != stocks[j].Quantity {
+				return stocks[i].Quantity > stocks[j].Quantity
+			}
+			return byCode(i, j)
+		})
+		// Largest first, unless a smaller bin already covers the whole line.
+		best := -1
+		for i, bs := range stocks {
+			if bs.Quantity >= need {
+				best = i
+			}
+		}
+		if best > 0 {
+			covering := stocks[best]
+			copy(stocks[1:best+1], stocks[:best])
+			stocks[0] = covering
+		}
+	}
+}
+
+// takeFromBin lowers the bin stock after a pick. Bins without a stock record
+// are left alone; a record never goes below zero.
+func takeFromBin(ctx context.Context, client *ent.Client, binID, itemID, qty int) error {
+	bs, err := client.BinStock.Query().
+		Where(binstock.HasBinWith(bin.ID(binID)), binstock.HasItemWith(item.ID(itemID))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("fetch bin stock: %w", err)
+	}
+	if err := client.BinStock.UpdateOne(bs).SetQuantity(max(bs.Quantity-qty, 0)).Exec(ctx); err != nil {
+		return fmt.Errorf("update bin stock: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+//go:build picking
+
+package picking
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mxV03/wms/ent"
+	"github.com/mxV03/wms/ent/picklist"
+	"github.com/mxV03/wms/ent/picktask"
+	"github.com/mxV03/wms/ent/user"
+	"github.com/mxV03/wms/internal/auditlog"
+)
+
+var (
+	ErrUnknownPicker   = fmt.Errorf("unknown or inactive picker")
+	ErrAssignedToOther = fmt.Errorf("task is assigned to another picker")
+)
+
+// AssignPickList hands a picklist and all of its open tasks to a picker.
+func (s *PickingService) AssignPickList(ctx context.Context, pickListID int, username string) error {
+	username, err := s.picker(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	pl, err := tx.PickList.Get(ctx, pickListID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrPickListNotFound
+		}
+		return fmt.Errorf("fetch picklist: %w", err)
+	}
+	if pl.Status == "DONE" {
+		return ErrInvalidStatus
+	}
+
+	err = tx.PickList.UpdateOne(pl).
+		SetAssignedTo(username).
+		SetAssignedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("update picklist: %w", err)
+	}
+	_, err = tx.PickTask.Update().
+		Where(picktask.HasPicklistWith(picklist.ID(pickListID)), picktask.StatusEQ("OPEN")).
+		SetAssignedTo(username).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("update tasks: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	auditlog.Logf(ctx, "picklist.assign", "picklist", fmt.Sprint(pickListID), "picker=%s", username)
+	return nil
+}
+
+// AssignTask moves a single open task to another picker.
+func (s *PickingService) AssignTask(ctx context.Context, taskID int, username string) error {
+	username, err := s.picker(ctx, username)
+	if err != nil {
+		return err
+	}
+
+	t, err := s.client.PickTask.Get(ctx, taskID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrTaskNotFound
+		}
+		return fmt.Errorf("fetch task: %w", err)
+	}
+	if t.Status != "OPEN" {
+		return ErrInvalidStatus
+	}
+	if err := s.client.PickTask.UpdateOne(t).SetAssignedTo(username).Exec(ctx); err != nil {
+		return fmt.Errorf("update task: %w", err)
+	}
+	auditlog.Logf(ctx, "picktask.assign", "picktask", fmt.Sprint(taskID), "picker=%s", username)
+	return nil
+}
+
+// MyWork returns the unfinished picklists a picker has tasks on, each with
+// only the picker's open tasks in walking order.
+func (s *PickingService) MyWork(ctx context.Context, username string) ([]PickListDTO, error) {
+	username = strings.TrimSpace(username)
+	if username == "" {
+		return nil, ErrUnknownPicker
+	}
+
+	ids, err := s.client.PickList.Query().
+		Where(
+			picklist.StatusNEQ("DONE"),
+			picklist.HasTasksWith(picktask.AssignedTo(username), picktask.StatusEQ("OPEN")),
+		).
+		Order(ent.Asc(picklist.FieldCreatedAt), ent.Asc(picklist.FieldID)).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list picklists: %w", err)
+	}
+
+	out := make([]PickListDTO, 0, len(ids))
+	for _, id := range ids {
+		pl, err := s.ShowPickList(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		mine := make([]TaskDTO, 0, len(pl.Tasks))
+		for _, t := range pl.Tasks {
+			if t.Picker == username && t.Status == "OPEN" {
+				mine = append(mine, t)
+			}
+		}
+		pl.Tasks = mine
+		_, pl.Distance = routeTasks(mine, pl.Route)
+		out = append(out, *pl)
+	}
+	return out, nil
+}
+
+func (s *PickingService) picker(ctx context.Context, username string) (string, error) {
+	username = strings.TrimSpace(username)
+	if username == "" {
+		return "", ErrUnknownPicker
+	}
+	ok, err := s.client.User.Query().
+		Where(user.Username(username), user.Active(true)).
+		Exist(ctx)
+	if err != nil {
+		return "", fmt.Errorf("fetch user: %w", err)
+	}
+	if !ok {
+		return "", ErrUnknownPicker
+	}
+	return username, nil
+}
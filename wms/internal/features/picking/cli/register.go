@@ -15,19 +15,26 @@ import (
 func init() {
 	registry.Register(registry.Command{
 		Name:        "picking.picklist.create",
-		Usage:       "picking.picklist.create <orderNr>",
+		Usage:       "picking.picklist.create <orderNr> [fewest|fifo|closest]",
 		Group:       "Optional / Picking",
-		Description: "Create a picklist for an order (one task per order line).",
+		Description: "Create a picklist for an order (one task per order line and bin; default strategy from WMS_PICK_BIN_STRATEGY).",
 		Run: func(ctx context.Context, args []string) error {
-			if len(args) != 1 {
-				return fmt.Errorf("usage: picking.picklist.create <orderNr>")
+			if len(args) < 1 || len(args) > 2 {
+				return fmt.Errorf("usage: picking.picklist.create <orderNr> [fewest|fifo|closest]")
+			}
+			strategy, err := picking.BinStrategyFromEnv()
+			if len(args) == 2 {
+				strategy, err = picking.ParseBinStrategy(args[1])
+			}
+			if err != nil {
+				return err
 			}
 			svc := picking.NewPickingService(clictx.AppCtx().Client())
-			pl, err := svc.CreatePickList(ctx, args[0])
+			pl, err := svc.CreatePickListWithStrategy(ctx, args[0], strategy)
 			if err != nil {
 				return err
 			}
-			fmt.Printf("created picklist: ID=%d ORDER=%s\n", pl.ID, args[0])
+			fmt.Printf("created picklist: ID=%d NUMBER=%s ORDER=%s\n", pl.ID, *pl.Number, args[0])
 			return nil
 		},
 	})
@@ -56,22 +63,27 @@ func init() {
 
 	registry.Register(registry.Command{
 		Name:        "picking.task.pick",
-		Usage:       "picking.task.pick <taskID>",
+		Usage:       "picking.task.pick <taskID> <quantity>",
 		Group:       "Optional / Picking",
-		Description: "Mark one pick task as picked.",
+		Description: "Confirm the quantity picked for a task; less than requested marks it SHORT.",
 		Run: func(ctx context.Context, args []string) error {
-			if len(args) != 1 {
-				return fmt.Errorf("usage: picking.task.pick <taskID>")
+			if len(args) != 2 {
+				return fmt.Errorf("usage: picking.task.pick <taskID> <quantity>")
 			}
 			id, err := strconv.Atoi(args[0])
 			if err != nil {
 				return fmt.Errorf("taskID must be an integer")
 			}
+			qty, err := strconv.Atoi(args[1])
+			if err != nil || qty < 0 {
+				return fmt.Errorf("quantity must be a non-negative integer")
+			}
 			svc := picking.NewPickingService(clictx.AppCtx().Client())
-			if err := svc.MarkTaskPicked(ctx, id); err != nil {
+			status, err := svc.PickTask(ctx, id, qty)
+			if err != nil {
 				return err
 			}
-			fmt.Printf("task picked")
+			fmt.Printf("task %d %s: picked=%d\n", id, status, qty)
 			return nil
 		},
 	})
@@ -80,7 +92,7 @@ func init() {
 		Name:        "picking.picklist.done",
 		Usage:       "picking.picklist.done <pickListID>",
 		Group:       "Optional / Picking",
-		Description: "Finish a picklist.",
+		Description: "Finish a picklist: issue the picked stock, post the order and report short picks.",
 		Run: func(ctx context.Context, args []string) error {
 			if len(args) != 1 {
 				return fmt.Errorf("usage: picking.picklist.done <pickListID>")
@@ -90,38 +102,96 @@ func init() {
 				return fmt.Errorf("pickListID must be an integer")
 			}
 			svc := picking.NewPickingService(clictx.AppCtx().Client())
-			if err := svc.DonePickList(ctx, id); err != nil {
+			shortages, err := svc.DonePickList(ctx, id)
+			if err != nil {
+				return err
+			}
+			if len(shortages) == 0 {
+				fmt.Println("picklist done")
+				return nil
+			}
+			fmt.Printf("picklist done with %d shortage(s):\n", len(shortages))
+			for _, t := range shortages {
+				fmt.Printf("  Task %d: SKU=%s LOC=%s QTY=%d PICKED=%d MISSING=%d\n",
+					t.ID, t.SKU, t.Location, t.Quantity, t.Picked, t.Quantity-t.Picked)
+			}
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "picking.discrepancies",
+		Usage:       "picking.discrepancies [limit]",
+		Group:       "Optional / Picking",
+		Description: "List stock discrepancies recorded by short picks.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) > 1 {
+				return fmt.Errorf("usage: picking.discrepancies [limit]")
+			}
+			limit := 0
+			if len(args) == 1 {
+				n, err := strconv.Atoi(args[0])
+				if err != nil {
+					return fmt.Errorf("limit must be an integer")
+				}
+				limit = n
+			}
+			svc := picking.NewPickingService(clictx.AppCtx().Client())
+			ds, err := svc.ListDiscrepancies(ctx, limit)
+			if err != nil {
 				return err
 			}
-			fmt.Printf("picklist done")
+			if len(ds) == 0 {
+				fmt.Println("no discrepancies")
+				return nil
+			}
+			for _, d := range ds {
+				fmt.Printf("%d\t%s\t%s\t%s\t%s\tbin=%s\texpected=%d\tfound=%d\t%s\n",
+					d.ID, d.CreatedAt.Format("2006-01-02 15:04"), d.Source, d.Reference, d.SKU, d.Bin, d.Expected, d.Found, d.Location)
+			}
 			return nil
 		},
 	})
 
 	registry.Register(registry.Command{
 		Name:        "picking.picklist.show",
-		Usage:       "picking.picklist.show <pickListID>",
+		Usage:       "picking.picklist.show <pickListID> [sshape|shortest]",
 		Group:       "Optional / Picking",
-		Description: "Show picklist details.",
+		Description: "Show picklist details with tasks in walking order.",
 		Run: func(ctx context.Context, args []string) error {
-			if len(args) != 1 {
-				return fmt.Errorf("usage: picking.picklist.show <pickListID>")
+			if len(args) < 1 || len(args) > 2 {
+				return fmt.Errorf("usage: picking.picklist.show <pickListID> [sshape|shortest]")
 			}
 			id, err := strconv.Atoi(args[0])
 			if err != nil {
 				return fmt.Errorf("pickListID must be an integer")
 			}
+			strategy := picking.RouteSShape
+			if len(args) == 2 {
+				strategy, err = picking.ParseRouteStrategy(args[1])
+				if err != nil {
+					return err
+				}
+			}
 			svc := picking.NewPickingService(clictx.AppCtx().Client())
-			pl, err := svc.ShowPickList(ctx, id)
+			pl, err := svc.ShowPickListRoute(ctx, id, strategy)
 			if err != nil {
 				return err
 			}
-			fmt.Printf("PickList ID=%d ORDER=%s STATUS=%s\n", pl.ID, pl.OrderNr, pl.Status)
-			for _, t := range pl.Tasks {
-				fmt.Printf("  Task %d: SKU=%s QTY=%d LOC=%s BIN=%s STATUS=%s\n",
-					t.ID, t.SKU, t.Quantity, t.Location, t.Bin, t.Status)
+			fmt.Printf("PickList ID=%d NUMBER=%s ORDER=%s STATUS=%s PICKER=%s\n", pl.ID, dash(pl.Number), pl.OrderNr, pl.Status, dash(pl.Picker))
+			for i, t := range pl.Tasks {
+				fmt.Printf("  %d. Task %d: SKU=%s QTY=%d PICKED=%d LOC=%s ZONE=%s BIN=%s AISLE=%d POS=%d LEVEL=%d STATUS=%s\n",
+					i+1, t.ID, t.SKU, t.Quantity, t.Picked, t.Location, dash(t.Zone), t.Bin, t.Aisle, t.Position, t.Level, t.Status)
 			}
+			fmt.Printf("Route=%s estimated distance=%.1fm\n", pl.Route, pl.Distance)
 			return nil
 		},
 	})
 }
+
+func dash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
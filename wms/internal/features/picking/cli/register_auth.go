@@ -0,0 +1,120 @@
+//go:build picking && auth
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/mxV03/wms/internal/features/auth"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/clictx"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/registry"
+	"github.com/mxV03/wms/internal/features/picking"
+)
+
+func init() {
+	registry.Register(registry.Command{
+		Name:        "picking.picklist.assign",
+		Usage:       "picking.picklist.assign <pickListID> [username]",
+		Group:       "Optional / Picking",
+		Description: "Assign a picklist and its open tasks to yourself or, as Admin, to another user.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) < 1 || len(args) > 2 {
+				return fmt.Errorf("usage: picking.picklist.assign <pickListID> [username]")
+			}
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("pickListID must be an integer")
+			}
+			username, err := assignee(ctx, args[1:])
+			if err != nil {
+				return err
+			}
+			svc := picking.NewPickingService(clictx.AppCtx().Client())
+			if err := svc.AssignPickList(ctx, id, username); err != nil {
+				return err
+			}
+			fmt.Printf("picklist %d assigned to %s\n", id, username)
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "picking.task.assign",
+		Usage:       "picking.task.assign <taskID> [username]",
+		Group:       "Optional / Picking",
+		Description: "Assign one open pick task to yourself or, as Admin, to another user.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) < 1 || len(args) > 2 {
+				return fmt.Errorf("usage: picking.task.assign <taskID> [username]")
+			}
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("taskID must be an integer")
+			}
+			username, err := assignee(ctx, args[1:])
+			if err != nil {
+				return err
+			}
+			svc := picking.NewPickingService(clictx.AppCtx().Client())
+			if err := svc.AssignTask(ctx, id, username); err != nil {
+				return err
+			}
+			fmt.Printf("task %d assigned to %s\n", id, username)
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "picking.my",
+		Usage:       "picking.my",
+		Group:       "Optional / Picking",
+		Description: "Show the open pick tasks assigned to the current user (WMS_USER/WMS_PASS).",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 0 {
+				return fmt.Errorf("usage: picking.my")
+			}
+			client := clictx.AppCtx().Client()
+			pr, err := auth.NewAuthService(client).RequireRole(ctx, auth.RoleAdmin, auth.RoleWorker)
+			if err != nil {
+				return err
+			}
+
+			lists, err := picking.NewPickingService(client).MyWork(ctx, pr.Username)
+			if err != nil {
+				return err
+			}
+			if len(lists) == 0 {
+				fmt.Printf("no open tasks for %s\n", pr.Username)
+				return nil
+			}
+			for _, pl := range lists {
+				fmt.Printf("PickList ID=%d NUMBER=%s ORDER=%s STATUS=%s TASKS=%d DISTANCE=%.1fm\n",
+					pl.ID, dash(pl.Number), pl.OrderNr, pl.Status, len(pl.Tasks), pl.Distance)
+				for i, t := range pl.Tasks {
+					fmt.Printf("  %d. Task %d: SKU=%s QTY=%d LOC=%s BIN=%s\n",
+						i+1, t.ID, t.SKU, t.Quantity, t.Location, t.Bin)
+				}
+			}
+			return nil
+		},
+	})
+}
+
+// assignee resolves the target user of an assignment. Workers may only take
+// work themselves; assigning to someone else needs the Admin role.
+func assignee(ctx context.Context, args []string) (string, error) {
+	svc := auth.NewAuthService(clictx.AppCtx().Client())
+	pr, err := svc.RequireRole(ctx, auth.RoleAdmin, auth.RoleWorker)
+	if err != nil {
+		return "", err
+	}
+	if len(args) == 0 || args[0] == pr.Username {
+		return pr.Username, nil
+	}
+	if pr.Role != auth.RoleAdmin {
+		return "", auth.ErrForbidden
+	}
+	return args[0], nil
+}
@@ -0,0 +1,76 @@
+//go:build barcode && picking
+
+package cli
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/mxV03/wms/internal/features/barcode"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/clictx"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/registry"
+	"github.com/mxV03/wms/internal/features/picking"
+)
+
+func init() {
+	registry.Register(registry.Command{
+		Name:        "picking.scan",
+		Usage:       "picking.scan <pickListID>",
+		Group:       "Optional / Picking",
+		Description: "Interactive scan session: reads bin and item barcodes from stdin (SHORT, SKIP, QUIT).",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("usage: picking.scan <pickListID>")
+			}
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("pickListID must be an integer")
+			}
+
+			client := clictx.AppCtx().Client()
+			svc := picking.NewPickingService(client)
+			session, err := svc.NewScanSession(ctx, id, barcode.NewBarcodeService(client))
+			if err != nil {
+				return err
+			}
+
+			in := bufio.NewScanner(os.Stdin)
+			for !session.Finished() {
+				fmt.Println(session.Prompt())
+				if !in.Scan() {
+					break
+				}
+				line := strings.TrimSpace(in.Text())
+				if line == "" {
+					continue
+				}
+				if cmd := strings.ToUpper(line); cmd == "QUIT" || cmd == "EXIT" {
+					break
+				}
+
+				msg, err := session.Handle(ctx, line)
+				if err != nil {
+					if picking.IsScanRejection(err) {
+						fmt.Printf("REJECTED: %v\n", err)
+						continue
+					}
+					return err
+				}
+				fmt.Println(msg)
+			}
+			if err := in.Err(); err != nil {
+				return fmt.Errorf("reading input: %w", err)
+			}
+			if session.Finished() {
+				fmt.Println(session.Prompt())
+			} else {
+				fmt.Printf("session ended with %d open task(s)\n", session.Open())
+			}
+			return nil
+		},
+	})
+}
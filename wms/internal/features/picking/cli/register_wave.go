@@ -0,0 +1,203 @@
+//go:build picking
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mxV03/wms/internal/core/ordermanagement/orders"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/clictx"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/registry"
+	"github.com/mxV03/wms/internal/features/picking"
+)
+
+func init() {
+	registry.Register(registry.Command{
+		Name:        "picking.wave.create",
+		Usage:       "picking.wave.create [carrier=<c>] [due=<YYYY-MM-DD>] [zone=<z>] [max_lines=<n>]",
+		Group:       "Optional / Picking",
+		Description: "Release matching open outbound orders into one wave with consolidated tasks.",
+		Run: func(ctx context.Context, args []string) error {
+			usage := fmt.Errorf("usage: picking.wave.create [carrier=<c>] [due=<YYYY-MM-DD>] [zone=<z>] [max_lines=<n>]")
+			var f picking.WaveFilter
+			for _, a := range args {
+				k, v, ok := strings.Cut(a, "=")
+				if !ok {
+					return usage
+				}
+				switch k {
+				case "carrier":
+					f.Carrier = v
+				case "due":
+					due, err := orders.ParseShipBy(v)
+					if err != nil {
+						return err
+					}
+					f.DueBy = &due
+				case "zone":
+					f.Zone = v
+				case "max_lines":
+					n, err := strconv.Atoi(v)
+					if err != nil {
+						return fmt.Errorf("max_lines must be an integer")
+					}
+					f.MaxLines = n
+				default:
+					return usage
+				}
+			}
+
+			svc := picking.NewPickingService(clictx.AppCtx().Client())
+			w, err := svc.CreateWave(ctx, f)
+			if err != nil {
+				return err
+			}
+			dto, err := svc.ShowWave(ctx, w.ID)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("created wave: ID=%d NUMBER=%s ORDERS=%d TASKS=%d\n", dto.ID, dto.Number, len(dto.Orders), len(dto.Tasks))
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "picking.wave.start",
+		Usage:       "picking.wave.start <waveID>",
+		Group:       "Optional / Picking",
+		Description: "Start a wave.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("usage: picking.wave.start <waveID>")
+			}
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("waveID must be an integer")
+			}
+			svc := picking.NewPickingService(clictx.AppCtx().Client())
+			if err := svc.StartWave(ctx, id); err != nil {
+				return err
+			}
+			fmt.Println("wave started")
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "picking.wave.pick",
+		Usage:       "picking.wave.pick <waveTaskID> [quantity]",
+		Group:       "Optional / Picking",
+		Description: "Record a consolidated wave task as picked (full quantity by default).",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) < 1 || len(args) > 2 {
+				return fmt.Errorf("usage: picking.wave.pick <waveTaskID> [quantity]")
+			}
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("waveTaskID must be an integer")
+			}
+			svc := picking.NewPickingService(clictx.AppCtx().Client())
+			qty := -1
+			if len(args) == 2 {
+				if qty, err = strconv.Atoi(args[1]); err != nil || qty < 0 {
+					return fmt.Errorf("quantity must be a non-negative integer")
+				}
+			}
+			if qty < 0 {
+				qty, err = svc.WaveTaskQuantity(ctx, id)
+				if err != nil {
+					return err
+				}
+			}
+			if err := svc.PickWaveTask(ctx, id, qty); err != nil {
+				return err
+			}
+			fmt.Printf("wave task %d picked: %d\n", id, qty)
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "picking.wave.progress",
+		Usage:       "picking.wave.progress <waveID>",
+		Group:       "Optional / Picking",
+		Description: "Show wave tasks and picking progress.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("usage: picking.wave.progress <waveID>")
+			}
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("waveID must be an integer")
+			}
+			svc := picking.NewPickingService(clictx.AppCtx().Client())
+			w, err := svc.ShowWave(ctx, id)
+			if err != nil {
+				return err
+			}
+			tasksDone, tasks, unitsPicked, units := w.Progress()
+			fmt.Printf("Wave ID=%d NUMBER=%s STATUS=%s ORDERS=%s\n", w.ID, w.Number, w.Status, strings.Join(w.Orders, ","))
+			fmt.Printf("Progress: tasks %d/%d units %d/%d\n", tasksDone, tasks, unitsPicked, units)
+			for _, t := range w.Tasks {
+				fmt.Printf("  Task %d: SKU=%s QTY=%d PICKED=%d LOC=%s BIN=%s ORDERS=%d STATUS=%s\n",
+					t.ID, t.SKU, t.Quantity, t.Picked, t.Location, t.Bin, t.Orders, t.Status)
+			}
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "picking.wave.done",
+		Usage:       "picking.wave.done <waveID>",
+		Group:       "Optional / Picking",
+		Description: "Finish a wave: split the picks back to the orders, issue the picked stock and post the orders.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("usage: picking.wave.done <waveID>")
+			}
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("waveID must be an integer")
+			}
+			svc := picking.NewPickingService(clictx.AppCtx().Client())
+			if err := svc.DoneWave(ctx, id); err != nil {
+				return err
+			}
+			fmt.Println("wave done")
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "picking.wave.split",
+		Usage:       "picking.wave.split <waveID>",
+		Group:       "Optional / Picking",
+		Description: "Show the picks of a wave per order line for packing.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("usage: picking.wave.split <waveID>")
+			}
+			id, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("waveID must be an integer")
+			}
+			svc := picking.NewPickingService(clictx.AppCtx().Client())
+			rows, err := svc.WaveSplit(ctx, id)
+			if err != nil {
+				return err
+			}
+			current := ""
+			for _, r := range rows {
+				if r.OrderNr != current {
+					current = r.OrderNr
+					fmt.Printf("Order %s\n", current)
+				}
+				fmt.Printf("  line %d: SKU=%s QTY=%d PICKED=%d LOC=%s BIN=%s\n", r.LineID, r.SKU, r.Quantity, r.Picked, r.Location, r.Bin)
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,102 @@
+//go:build picking
+
+package picking
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mxV03/wms/ent"
+	"github.com/mxV03/wms/ent/bin"
+	"github.com/mxV03/wms/ent/stockdiscrepancy"
+)
+
+type DiscrepancyDTO struct {
+	ID        int
+	Source    string
+	Reference string
+	SKU       string
+	Location  string
+	Bin       string
+	Expected  int
+	Found     int
+	CreatedAt time.Time
+}
+
+// recordDiscrepancy stores a short find. It takes the client of the
+// surrounding transaction.
+func recordDiscrepancy(ctx context.Context, client *ent.Client, source, ref string, it *ent.Item, loc *ent.Location, binID *int, expected, found int) error {
+	_, err := client.StockDiscrepancy.Create().
+		SetSource(source).
+		SetReference(ref).
+		SetItem(it).
+		SetLocation(loc).
+		SetNillableBinID(binID).
+		SetExpected(expected).
+		SetFound(found).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("create discrepancy: %w", err)
+	}
+	return nil
+}
+
+// ListDiscrepancies returns the newest stock discrepancies first.
+func (s *PickingService) ListDiscrepancies(ctx context.Context, limit int) ([]DiscrepancyDTO, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+	if limit > 500 {
+		limit = 500
+	}
+
+	ds, err := s.client.StockDiscrepancy.Query().
+		WithItem().
+		WithLocation().
+		Order(ent.Desc(stockdiscrepancy.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list discrepancies: %w", err)
+	}
+
+	binIDs := make([]int, 0)
+	for _, d := range ds {
+		if d.BinID != nil {
+			binIDs = append(binIDs, *d.BinID)
+		}
+	}
+	codes := map[int]string{}
+	if len(binIDs) > 0 {
+		bins, err := s.client.Bin.Query().Where(bin.IDIn(binIDs...)).All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("fetch bins: %w", err)
+		}
+		for _, b := range bins {
+			codes[b.ID] = b.Code
+		}
+	}
+
+	out := make([]DiscrepancyDTO, 0, len(ds))
+	for _, d := range ds {
+		dto := DiscrepancyDTO{
+			ID:        d.ID,
+			Source:    d.Source,
+			Reference: d.Reference,
+			SKU:       d.Edges.Item.SKU,
+			Location:  d.Edges.Location.Code,
+			Bin:       "-",
+			Expected:  d.Expected,
+			Found:     d.Found,
+			CreatedAt: d.CreatedAt,
+		}
+		if d.BinID != nil {
+			if code, ok := codes[*d.BinID]; ok {
+				dto.Bin = code
+			}
+		}
+		out = append(out, dto)
+	}
+	return out, nil
+}
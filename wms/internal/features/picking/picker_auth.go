@@ -0,0 +1,19 @@
+//go:build picking && auth
+
+package picking
+
+import (
+	"context"
+
+	"github.com/mxV03/wms/internal/features/auth"
+)
+
+// confirmingUser returns the signed-in user (WMS_USER/WMS_PASS) confirming a
+// pick and whether they may confirm tasks assigned to someone else.
+func (s *PickingService) confirmingUser(ctx context.Context) (string, bool, error) {
+	pr, err := auth.NewAuthService(s.client).RequireRole(ctx, auth.RoleAdmin, auth.RoleWorker)
+	if err != nil {
+		return "", false, err
+	}
+	return pr.Username, pr.Role == auth.RoleAdmin, nil
+}
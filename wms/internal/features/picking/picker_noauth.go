@@ -0,0 +1,11 @@
+//go:build picking && !auth
+
+package picking
+
+import "context"
+
+// confirmingUser is unknown without the auth feature; anyone may confirm any
+// task.
+func (s *PickingService) confirmingUser(ctx context.Context) (string, bool, error) {
+	return "", true, nil
+}
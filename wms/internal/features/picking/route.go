@@ -0,0 +1,190 @@
+//go:build picking
+
+package picking
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mxV03/wms/internal/features/logistics"
+)
+
+// RouteStrategy decides the order in which the tasks of a picklist are walked.
+type RouteStrategy string
+
+const (
+	// RouteSShape walks zones by walk sequence and aisles in ascending order,
+	// going up one aisle and down the next.
+	RouteSShape RouteStrategy = "sshape"
+	// RouteShortest builds a nearest-neighbour tour and improves it with 2-opt.
+	RouteShortest RouteStrategy = "shortest"
+)
+
+var ErrInvalidRoute = fmt.Errorf("invalid route strategy (use sshape or shortest)")
+
+func ParseRouteStrategy(s string) (RouteStrategy, error) {
+	switch RouteStrategy(strings.ToLower(strings.TrimSpace(s))) {
+	case "", RouteSShape:
+		return RouteSShape, nil
+	case RouteShortest:
+		return RouteShortest, nil
+	default:
+		return "", ErrInvalidRoute
+	}
+}
+
+// stop is a task position on the warehouse floor.
+type stop struct {
+	task     int
+	location string
+	zoneSeq  int
+	aisle    int
+	position int
+	level    int
+}
+
+func (s stop) spot() logistics.Spot {
+	return logistics.Spot{Aisle: s.aisle, Position: s.position}
+}
+
+var depot = stop{aisle: logistics.Depot.Aisle, position: logistics.Depot.Position}
+
+// routeTasks returns the task indexes in walking order and the estimated
+// distance. Tasks without bin coordinates come last in their original order
+// and are not part of the estimate.
+func routeTasks(tasks []TaskDTO, strategy RouteStrategy) ([]int, float64) {
+	byLoc := map[string][]stop{}
+	locs := make([]string, 0)
+	unrouted := make([]int, 0)
+	for i, t := range tasks {
+		if t.Aisle <= 0 {
+			unrouted = append(unrouted, i)
+			continue
+		}
+		if _, ok := byLoc[t.Location]; !ok {
+			locs = append(locs, t.Location)
+		}
+		byLoc[t.Location] = append(byLoc[t.Location], stop{
+			task:     i,
+			location: t.Location,
+			zoneSeq:  t.ZoneSequence,
+			aisle:    t.Aisle,
+			position: t.Position,
+			level:    t.Level,
+		})
+	}
+	sort.Strings(locs)
+
+	order := make([]int, 0, len(tasks))
+	total := 0.0
+	for _, loc := range locs {
+		stops := byLoc[loc]
+		depth := 0
+		for _, s := range stops {
+			if s.position > depth {
+				depth = s.position
+			}
+		}
+
+		var walk []stop
+		if strategy == RouteShortest {
+			walk = shortestRoute(stops, depth)
+		} else {
+			walk = sShapeRoute(stops)
+		}
+		total += tourDistance(walk, depth)
+		for _, s := range walk {
+			order = append(order, s.task)
+		}
+	}
+	return append(order, unrouted...), total
+}
+
+func sShapeRoute(stops []stop) []stop {
+	walk := append([]stop(nil), stops...)
+	sort.SliceStable(walk, func(i, j int) bool {
+		a, b := walk[i], walk[j]
+		if a.zoneSeq != b.zoneSeq {
+			return a.zoneSeq < b.zoneSeq
+		}
+		return a.aisle < b.aisle
+	})
+
+	// Reverse the direction in every second aisle that is actually visited.
+	for start, n := 0, 0; start < len(walk); n++ {
+		end := start
+		for end < len(walk) && walk[end].zoneSeq == walk[start].zoneSeq && walk[end].aisle == walk[start].aisle {
+			end++
+		}
+		up := n%2 == 0
+		seg := walk[start:end]
+		sort.SliceStable(seg, func(i, j int) bool {
+			if seg[i].position != seg[j].position {
+				return (seg[i].position < seg[j].position) == up
+			}
+			return seg[i].level < seg[j].level
+		})
+		start = end
+	}
+	return walk
+}
+
+func shortestRoute(stops []stop, depth int) []stop {
+	left := append([]stop(nil), stops...)
+	walk := make([]stop, 0, len(stops))
+
+	cur := depot
+	for len(left) > 0 {
+		best := 0
+		for i := 1; i < len(left); i++ {
+			if walkDistance(cur, left[i], depth) < walkDistance(cur, left[best], depth) {
+				best = i
+			}
+		}
+		cur = left[best]
+		walk = append(walk, cur)
+		left = append(left[:best], left[best+1:]...)
+	}
+
+	// 2-opt: reverse segments while that shortens the tour.
+	for improved := true; improved; {
+		improved = false
+		for i := 0; i < len(walk)-1; i++ {
+			for j := i + 1; j < len(walk); j++ {
+				prev, next := depot, depot
+				if i > 0 {
+					prev = walk[i-1]
+				}
+				if j < len(walk)-1 {
+					next = walk[j+1]
+				}
+				before := walkDistance(prev, walk[i], depth) + walkDistance(walk[j], next, depth)
+				after := walkDistance(prev, walk[j], depth) + walkDistance(walk[i], next, depth)
+				if after+1e-9 < before {
+					for a, b := i, j; a < b; a, b = a+1, b-1 {
+						walk[a], walk[b] = walk[b], walk[a]
+					}
+					improved = true
+				}
+			}
+		}
+	}
+	return walk
+}
+
+// tourDistance is the walk from the depot along all stops and back.
+func tourDistance(walk []stop, depth int) float64 {
+	cur := depot
+	total := 0.0
+	for _, s := range walk {
+		total += walkDistance(cur, s, depth)
+		cur = s
+	}
+	return total + walkDistance(cur, depot, depth)
+}
+
+// walkDistance between two stops, see logistics.WalkDistance.
+func walkDistance(a, b stop, depth int) float64 {
+	return logistics.WalkDistance(a.spot(), b.spot(), depth)
+}
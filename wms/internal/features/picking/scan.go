@@ -0,0 +1,169 @@
+//go:build barcode && picking
+
+package picking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mxV03/wms/internal/features/barcode"
+)
+
+var (
+	ErrUnknownBarcode = fmt.Errorf("unknown barcode")
+	ErrScanBinFirst   = fmt.Errorf("scan the bin first")
+	ErrWrongBin       = fmt.Errorf("wrong bin")
+	ErrWrongItem      = fmt.Errorf("wrong item")
+	ErrScanFinished   = fmt.Errorf("all tasks are picked")
+)
+
+// IsScanRejection reports whether err is a rejected scan the picker can
+// correct, as opposed to a failure of the session.
+func IsScanRejection(err error) bool {
+	return errors.Is(err, ErrUnknownBarcode) ||
+		errors.Is(err, ErrScanBinFirst) ||
+		errors.Is(err, ErrWrongBin) ||
+		errors.Is(err, ErrWrongItem) ||
+		errors.Is(err, ErrScanFinished)
+}
+
+// Words a picker can enter instead of a barcode.
+const (
+	ScanShort = "SHORT" // confirm the units counted so far as a short pick
+	ScanSkip  = "SKIP"  // move the current task to the end of the route
+)
+
+// ScanSession walks the open tasks of a picklist in route order. For every
+// task the picker scans the bin and then one item barcode per unit; the task
+// is confirmed as soon as its quantity is counted.
+type ScanSession struct {
+	picking *PickingService
+	barcode *barcode.BarcodeService
+
+	pickListID int
+	tasks      []TaskDTO
+	binOK      bool
+	count      int
+}
+
+// NewScanSession starts the picklist if needed and loads its open tasks.
+func (s *PickingService) NewScanSession(ctx context.Context, pickListID int, bc *barcode.BarcodeService) (*ScanSession, error) {
+	pl, err := s.ShowPickList(ctx, pickListID)
+	if err != nil {
+		return nil, err
+	}
+	switch pl.Status {
+	case "CREATED":
+		if err := s.StartPickList(ctx, pickListID); err != nil {
+			return nil, err
+		}
+	case "IN_PROGRESS":
+	default:
+		return nil, ErrInvalidStatus
+	}
+
+	open := make([]TaskDTO, 0, len(pl.Tasks))
+	for _, t := range pl.Tasks {
+		if t.Status == "OPEN" {
+			open = append(open, t)
+		}
+	}
+	ss := &ScanSession{
+		picking:    s,
+		barcode:    bc,
+		pickListID: pickListID,
+		tasks:      open,
+	}
+	ss.reset()
+	return ss, nil
+}
+
+// Finished reports whether every task of the session has been confirmed.
+func (ss *ScanSession) Finished() bool {
+	return len(ss.tasks) == 0
+}
+
+// Open returns the number of tasks still to be picked.
+func (ss *ScanSession) Open() int {
+	return len(ss.tasks)
+}
+
+// Prompt tells the picker what to scan next.
+func (ss *ScanSession) Prompt() string {
+	if ss.Finished() {
+		return fmt.Sprintf("all tasks picked; finish with picking.picklist.done %d", ss.pickListID)
+	}
+	t := ss.tasks[0]
+	if !ss.binOK {
+		return fmt.Sprintf("task %d: go to %s/%s and scan the bin", t.ID, t.Location, t.Bin)
+	}
+	return fmt.Sprintf("task %d: scan item %s (%d/%d)", t.ID, t.SKU, ss.count, t.Quantity)
+}
+
+// Handle processes one line of scanner input and returns a confirmation.
+// Rejected scans are returned as errors for which IsScanRejection is true.
+func (ss *ScanSession) Handle(ctx context.Context, input string) (string, error) {
+	input = strings.TrimSpace(input)
+	if ss.Finished() {
+		return "", ErrScanFinished
+	}
+	t := ss.tasks[0]
+
+	switch strings.ToUpper(input) {
+	case ScanShort:
+		return ss.confirm(ctx)
+	case ScanSkip:
+		ss.tasks = append(ss.tasks[1:], t)
+		ss.reset()
+		return fmt.Sprintf("task %d skipped", t.ID), nil
+	}
+
+	res, err := ss.barcode.Scan(ctx, input)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrUnknownBarcode, err)
+	}
+
+	switch res.Kind {
+	case "BIN":
+		if res.Location != t.Location || res.Bin != t.Bin {
+			return "", fmt.Errorf("%w: expected %s/%s, scanned %s/%s", ErrWrongBin, t.Location, t.Bin, res.Location, res.Bin)
+		}
+		ss.binOK = true
+		return fmt.Sprintf("bin %s/%s ok", t.Location, t.Bin), nil
+
+	case "ITEM":
+		if !ss.binOK {
+			return "", fmt.Errorf("%w: %s/%s", ErrScanBinFirst, t.Location, t.Bin)
+		}
+		if res.SKU != t.SKU {
+			return "", fmt.Errorf("%w: expected %s, scanned %s", ErrWrongItem, t.SKU, res.SKU)
+		}
+		ss.count++
+		if ss.count < t.Quantity {
+			return fmt.Sprintf("%s %d/%d", t.SKU, ss.count, t.Quantity), nil
+		}
+		return ss.confirm(ctx)
+	}
+	return "", fmt.Errorf("%w: %s", ErrUnknownBarcode, input)
+}
+
+// confirm books the counted units for the current task and moves on.
+func (ss *ScanSession) confirm(ctx context.Context) (string, error) {
+	t := ss.tasks[0]
+	status, err := ss.picking.PickTask(ctx, t.ID, ss.count)
+	if err != nil {
+		return "", err
+	}
+	msg := fmt.Sprintf("task %d %s: picked=%d of %d", t.ID, status, ss.count, t.Quantity)
+	ss.tasks = ss.tasks[1:]
+	ss.reset()
+	return msg, nil
+}
+
+// reset prepares the current task; tasks without a bin need no bin scan.
+func (ss *ScanSession) reset() {
+	ss.count = 0
+	ss.binOK = len(ss.tasks) > 0 && ss.tasks[0].Bin == "-"
+}
@@ -6,16 +6,19 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/mxV03/wms/ent"
 	"github.com/mxV03/wms/ent/bin"
-	"github.com/mxV03/wms/ent/item"
-	"github.com/mxV03/wms/ent/location"
 	"github.com/mxV03/wms/ent/order"
 	"github.com/mxV03/wms/ent/picklist"
 	"github.com/mxV03/wms/ent/picktask"
+	"github.com/mxV03/wms/internal/auditlog"
+	"github.com/mxV03/wms/internal/core/inventory/stock"
+	"github.com/mxV03/wms/internal/core/ordermanagement/orders"
+	"github.com/mxV03/wms/internal/core/sequence"
 )
 
 var (
@@ -24,6 +27,7 @@ var (
 	ErrPickListNotFound = fmt.Errorf("picklist not found")
 	ErrTaskNotFound     = fmt.Errorf("pick task not found")
 	ErrInvalidStatus    = fmt.Errorf("invalid status transition")
+	ErrOrderNotOpen     = fmt.Errorf("order is not an open outbound order")
 )
 
 type PickingService struct {
@@ -43,20 +47,47 @@ type TaskDTO struct {
 	Location string
 	Bin      string
 	Quantity int
+	Picked   int
 	Status   string
+	Picker   string
+
+	Zone         string
+	ZoneSequence int
+	Aisle        int
+	Position     int
+	Level        int
 }
 
 type PickListDTO struct {
 	ID        int
+	Number    string
 	OrderNr   string
 	Status    string
+	Picker    string
 	CreatedAt time.Time
 	StartedAt *time.Time
 	DoneAt    *time.Time
 	Tasks     []TaskDTO
+
+	// Route and Distance describe the walking order of Tasks; Distance is
+	// the estimated walk in metres.
+	Route    RouteStrategy
+	Distance float64
 }
 
+// CreatePickList creates a picklist using the bin strategy configured in
+// WMS_PICK_BIN_STRATEGY.
 func (s *PickingService) CreatePickList(ctx context.Context, orderNr string) (*ent.PickList, error) {
+	strategy, err := BinStrategyFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	return s.CreatePickListWithStrategy(ctx, orderNr, strategy)
+}
+
+// CreatePickListWithStrategy creates a picklist with one task per order line
+// and bin; a line whose stock is spread over several bins is split.
+func (s *PickingService) CreatePickListWithStrategy(ctx context.Context, orderNr string, strategy BinStrategy) (*ent.PickList, error) {
 	orderNr = strings.TrimSpace(orderNr)
 	if orderNr == "" {
 		return nil, ErrInvalidOrderNr
@@ -74,6 +105,13 @@ func (s *PickingService) CreatePickList(ctx context.Context, orderNr string) (*e
 		}
 		return nil, fmt.Errorf("fetch order: %w", err)
 	}
+	// only what IssuePicked can post when the picklist is done
+	if o.Type != string(orders.OrderTypeOutbound) || o.Status != string(orders.OrderStatusDraft) {
+		return nil, ErrOrderNotOpen
+	}
+	if held(o) {
+		return nil, stock.ErrLocationOnHold
+	}
 
 	exists, err := s.client.PickList.Query().
 		Where(picklist.HasOrderWith(order.OrderNumber(orderNr))).
@@ -85,7 +123,29 @@ func (s *PickingService) CreatePickList(ctx context.Context, orderNr string) (*e
 		return nil, ErrPickListExists
 	}
 
-	pl, err := s.client.PickList.Create().
+	inWave, err := s.client.Order.Query().
+		Where(order.ID(o.ID), order.HasWave()).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("check order wave: %w", err)
+	}
+	if inWave {
+		return nil, ErrOrderInWave
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	number, err := sequence.NewSequenceService(tx.Client()).Next(ctx, sequence.PickList)
+	if err != nil {
+		return nil, err
+	}
+
+	pl, err := tx.PickList.Create().
+		SetNumber(number).
 		SetOrder(o).
 		SetStatus("CREATED").
 		Save(ctx)
@@ -96,44 +156,30 @@ func (s *PickingService) CreatePickList(ctx context.Context, orderNr string) (*e
 		return nil, fmt.Errorf("create picklist: %w", err)
 	}
 
+	taken := newAllocation()
 	for _, ol := range o.Edges.Lines {
-		taskCreate := s.client.PickTask.Create().
-			SetPicklist(pl).
-			SetOrderLine(ol).
-			SetQuantity(ol.Quantity).
-			SetStatus("OPEN")
-
-		binID, _ := s.getBinIDForLine(ctx, ol)
-		if binID != nil {
-			taskCreate.SetBinID(*binID)
+		allocs, err := allocateBins(ctx, tx.Client(), ol, strategy, taken)
+		if err != nil {
+			return nil, err
 		}
-
-		if _, err := taskCreate.Save(ctx); err != nil {
-			return nil, fmt.Errorf("create pick task: %w", err)
+		for _, a := range allocs {
+			_, err := tx.PickTask.Create().
+				SetPicklist(pl).
+				SetOrderLine(ol).
+				SetQuantity(a.quantity).
+				SetNillableBinID(a.binID).
+				SetStatus("OPEN").
+				Save(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("create pick task: %w", err)
+			}
 		}
 	}
 
-	return pl, nil
-}
-
-func (s *PickingService) getBinIDForLine(ctx context.Context, ol *ent.OrderLine) (*int, error) {
-	if ol == nil || ol.Edges.Item == nil {
-		return nil, nil
-	}
-
-	q := s.client.Bin.Query().
-		Where(bin.HasItemsWith(item.ID(ol.Edges.Item.ID)))
-
-	if ol.Edges.Location != nil {
-		q = q.Where(bin.HasLocationWith(location.ID(ol.Edges.Location.ID)))
-	}
-
-	b, err := q.First(ctx)
-	if err == nil {
-		id := b.ID
-		return &id, nil
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
 	}
-	return nil, nil
+	return pl.Unwrap(), nil
 }
 
 func (s *PickingService) StartPickList(ctx context.Context, pickListID int) error {
@@ -154,6 +200,7 @@ func (s *PickingService) StartPickList(ctx context.Context, pickListID int) erro
 		Exec(ctx)
 }
 
+// MarkTaskPicked confirms a task with its full quantity.
 func (s *PickingService) MarkTaskPicked(ctx context.Context, taskID int) error {
 	t, err := s.client.PickTask.Get(ctx, taskID)
 	if err != nil {
@@ -162,46 +209,181 @@ func (s *PickingService) MarkTaskPicked(ctx context.Context, taskID int) error {
 		}
 		return fmt.Errorf("fetch task: %w", err)
 	}
+	_, err = s.PickTask(ctx, taskID, t.Quantity)
+	return err
+}
+
+// PickTask records the quantity actually taken for a task and, with the auth
+// feature, the user who took it. Workers may only confirm tasks assigned to
+// them or to nobody. A task picked with less than its quantity becomes SHORT
+// and a stock discrepancy is recorded for its bin. It returns the new task
+// status.
+func (s *PickingService) PickTask(ctx context.Context, taskID, picked int) (string, error) {
+	picker, anyTask, err := s.confirmingUser(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return "", fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	t, err := tx.PickTask.Query().
+		Where(picktask.ID(taskID)).
+		WithPicklist().
+		WithOrderLine(func(olq *ent.OrderLineQuery) {
+			olq.WithItem().WithLocation()
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", ErrTaskNotFound
+		}
+		return "", fmt.Errorf("fetch task: %w", err)
+	}
 	if t.Status != "OPEN" {
-		return ErrInvalidStatus
+		return "", ErrInvalidStatus
 	}
-	now := time.Now()
-	return s.client.PickTask.UpdateOne(t).
-		SetStatus("PICKED").
-		SetPickedAt(now).
+	if !anyTask && t.AssignedTo != "" && t.AssignedTo != picker {
+		return "", ErrAssignedToOther
+	}
+	if picked < 0 || picked > t.Quantity {
+		return "", ErrInvalidPickedQty
+	}
+
+	status := "PICKED"
+	if picked < t.Quantity {
+		status = "SHORT"
+		ol := t.Edges.OrderLine
+		ref := fmt.Sprintf("PICKLIST-%d", t.Edges.Picklist.ID)
+		if t.Edges.Picklist.Number != nil {
+			ref = *t.Edges.Picklist.Number
+		}
+		err := recordDiscrepancy(ctx, tx.Client(), "PICK", ref, ol.Edges.Item, ol.Edges.Location, t.BinID, t.Quantity, picked)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	err = tx.PickTask.UpdateOne(t).
+		SetPicked(picked).
+		SetPickedBy(picker).
+		SetStatus(status).
+		SetPickedAt(time.Now()).
 		Exec(ctx)
+	if err != nil {
+		return "", fmt.Errorf("update task: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return "", fmt.Errorf("committing transaction: %w", err)
+	}
+	if status == "SHORT" {
+		auditlog.Logf(ctx, "picking.short", "picktask", strconv.Itoa(taskID), "expected=%d picked=%d", t.Quantity, picked)
+	}
+	return status, nil
 }
 
-func (s *PickingService) DonePickList(ctx context.Context, pickListID int) error {
-	pl, err := s.client.PickList.Get(ctx, pickListID)
+// DonePickList finishes a picklist once no task is OPEN. In the same
+// transaction it issues the picked quantity of every task from its location
+// and posts the order, so stock reflects what actually left the shelves. It
+// returns the tasks that were picked short.
+func (s *PickingService) DonePickList(ctx context.Context, pickListID int) ([]TaskDTO, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+	txCtx := auditlog.InTx(ctx, tx)
+
+	pl, err := tx.PickList.Query().
+		Where(picklist.ID(pickListID)).
+		WithOrder().
+		WithTasks(func(tq *ent.PickTaskQuery) {
+			tq.WithOrderLine(func(olq *ent.OrderLineQuery) {
+				olq.WithItem().WithLocation()
+			}).Order(ent.Asc(picktask.FieldID))
+		}).
+		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return ErrPickListNotFound
+			return nil, ErrPickListNotFound
 		}
-		return fmt.Errorf("fetch picklist: %w", err)
+		return nil, fmt.Errorf("fetch picklist: %w", err)
 	}
 	if pl.Status != "IN_PROGRESS" {
-		return ErrInvalidStatus
+		return nil, ErrInvalidStatus
 	}
 
-	openCount, err := s.client.PickTask.Query().
-		Where(picktask.HasPicklistWith(picklist.ID(pickListID)), picktask.StatusEQ("OPEN")).
-		Count(ctx)
-	if err != nil {
-		return fmt.Errorf("count open tasks: %w", err)
+	openCount := 0
+	for _, t := range pl.Edges.Tasks {
+		if t.Status == "OPEN" {
+			openCount++
+		}
 	}
 	if openCount > 0 {
-		return fmt.Errorf("cannot finish picklist: %d task(s) still OPEN", openCount)
+		return nil, fmt.Errorf("cannot finish picklist: %d task(s) still OPEN", openCount)
 	}
 
-	now := time.Now()
-	return s.client.PickList.UpdateOneID(pickListID).
+	picks := make([]orders.PickedLine, 0, len(pl.Edges.Tasks))
+	shortages := make([]TaskDTO, 0)
+	issued := 0
+	for _, t := range pl.Edges.Tasks {
+		ol := t.Edges.OrderLine
+		picked := t.Picked
+		picks = append(picks, orders.PickedLine{
+			SKU:      ol.Edges.Item.SKU,
+			Location: ol.Edges.Location.Code,
+			Quantity: picked,
+		})
+		issued += picked
+
+		if t.BinID != nil && picked > 0 {
+			if err := takeFromBin(ctx, tx.Client(), *t.BinID, ol.Edges.Item.ID, picked); err != nil {
+				return nil, err
+			}
+		}
+
+		if t.Status == "SHORT" {
+			shortages = append(shortages, TaskDTO{
+				ID:       t.ID,
+				SKU:      ol.Edges.Item.SKU,
+				ItemName: ol.Edges.Item.Name,
+				Location: ol.Edges.Location.Code,
+				Quantity: t.Quantity,
+				Picked:   t.Picked,
+				Status:   t.Status,
+			})
+		}
+	}
+
+	orderNr := pl.Edges.Order.OrderNumber
+	if err := orders.NewOrderService(tx.Client()).IssuePicked(txCtx, orderNr, picks); err != nil {
+		return nil, err
+	}
+
+	err = tx.PickList.UpdateOneID(pickListID).
 		SetStatus("DONE").
-		SetDoneAt(now).
+		SetDoneAt(time.Now()).
 		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("update picklist: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+	auditlog.Logf(ctx, "order.posted", "order", orderNr, "type=%s lines=%d", orders.OrderTypeOutbound, len(picks))
+	auditlog.Logf(ctx, "picklist.done", "order", orderNr, "picklist=%d units=%d short=%d", pickListID, issued, len(shortages))
+	return shortages, nil
 }
 
+// ShowPickList returns the picklist with its tasks in S-shape walking order.
 func (s *PickingService) ShowPickList(ctx context.Context, pickListID int) (*PickListDTO, error) {
+	return s.ShowPickListRoute(ctx, pickListID, RouteSShape)
+}
+
+func (s *PickingService) ShowPickListRoute(ctx context.Context, pickListID int, strategy RouteStrategy) (*PickListDTO, error) {
 	pl, err := s.client.PickList.Query().
 		Where(picklist.ID(pickListID)).
 		WithOrder().
@@ -221,10 +403,14 @@ func (s *PickingService) ShowPickList(ctx context.Context, pickListID int) (*Pic
 	dto := &PickListDTO{
 		ID:        pl.ID,
 		Status:    pl.Status,
+		Picker:    pl.AssignedTo,
 		CreatedAt: pl.CreatedAt,
 		StartedAt: pl.StartedAt,
 		DoneAt:    pl.DoneAt,
 	}
+	if pl.Number != nil {
+		dto.Number = *pl.Number
+	}
 	if pl.Edges.Order != nil {
 		dto.OrderNr = pl.Edges.Order.OrderNumber
 	}
@@ -241,15 +427,17 @@ func (s *PickingService) ShowPickList(ctx context.Context, pickListID int) (*Pic
 	}
 	sort.Ints(binIDs)
 
-	binCodeByID := map[int]string{}
+	binByID := map[int]*ent.Bin{}
 	if len(binIDs) > 0 {
 		bins, err := s.client.Bin.Query().
 			Where(bin.IDIn(binIDs...)).
+			WithZone().
 			All(ctx)
-		if err == nil {
-			for _, b := range bins {
-				binCodeByID[b.ID] = b.Code
-			}
+		if err != nil {
+			return nil, fmt.Errorf("fetch bins: %w", err)
+		}
+		for _, b := range bins {
+			binByID[b.ID] = b
 		}
 	}
 
@@ -257,13 +445,22 @@ func (s *PickingService) ShowPickList(ctx context.Context, pickListID int) (*Pic
 		td := TaskDTO{
 			ID:       t.ID,
 			Quantity: t.Quantity,
+			Picked:   t.Picked,
 			Status:   t.Status,
+			Picker:   t.AssignedTo,
 			Bin:      "-",
 		}
 
 		if t.BinID != nil {
-			if code, ok := binCodeByID[*t.BinID]; ok && strings.TrimSpace(code) != "" {
-				td.Bin = code
+			if b, ok := binByID[*t.BinID]; ok {
+				if strings.TrimSpace(b.Code) != "" {
+					td.Bin = b.Code
+				}
+				td.Aisle, td.Position, td.Level = b.Aisle, b.Position, b.Level
+				if b.Edges.Zone != nil {
+					td.Zone = b.Edges.Zone.Code
+					td.ZoneSequence = b.Edges.Zone.WalkSequence
+				}
 			}
 		}
 
@@ -279,5 +476,14 @@ func (s *PickingService) ShowPickList(ctx context.Context, pickListID int) (*Pic
 		}
 		dto.Tasks = append(dto.Tasks, td)
 	}
+
+	order, distance := routeTasks(dto.Tasks, strategy)
+	walk := make([]TaskDTO, 0, len(order))
+	for _, i := range order {
+		walk = append(walk, dto.Tasks[i])
+	}
+	dto.Tasks = walk
+	dto.Route = strategy
+	dto.Distance = distance
 	return dto, nil
 }
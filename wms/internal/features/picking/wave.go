@@ -0,0 +1,674 @@
+//go:build picking
+
+package picking
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mxV03/wms/ent"
+	"github.com/mxV03/wms/ent/bin"
+	"github.com/mxV03/wms/ent/order"
+	"github.com/mxV03/wms/ent/wave"
+	"github.com/mxV03/wms/ent/wavepick"
+	"github.com/mxV03/wms/ent/wavetask"
+	"github.com/mxV03/wms/internal/auditlog"
+	"github.com/mxV03/wms/internal/core/ordermanagement/orders"
+	"github.com/mxV03/wms/internal/core/sequence"
+)
+
+var (
+	ErrWaveNotFound       = fmt.Errorf("wave not found")
+	ErrWaveTaskNotFound   = fmt.Errorf("wave task not found")
+	ErrNoEligibleOrders   = fmt.Errorf("no open outbound orders match the wave filters")
+	ErrOrderInWave        = fmt.Errorf("order is released in a wave")
+	ErrInvalidMaxLines    = fmt.Errorf("invalid max lines")
+	ErrInvalidPickedQty   = fmt.Errorf("invalid picked quantity")
+	ErrWaveTasksStillOpen = fmt.Errorf("wave has open tasks")
+)
+
+// WaveFilter selects the open outbound orders released into a wave. Empty
+// fields do not filter; MaxLines caps the number of order lines in the wave.
+type WaveFilter struct {
+	Carrier  string
+	DueBy    *time.Time
+	Zone     string
+	MaxLines int
+}
+
+type WaveTaskDTO struct {
+	ID       int
+	SKU      string
+	ItemName string
+	Location string
+	Bin      string
+	Quantity int
+	Picked   int
+	Status   string
+	Orders   int
+}
+
+type WaveDTO struct {
+	ID        int
+	Number    string
+	Status    string
+	Orders    []string
+	CreatedAt time.Time
+	StartedAt *time.Time
+	DoneAt    *time.Time
+	Tasks     []WaveTaskDTO
+}
+
+// Progress returns the number of picked tasks and units against the totals.
+func (w WaveDTO) Progress() (tasksDone, tasks, unitsPicked, units int) {
+	for _, t := range w.Tasks {
+		tasks++
+		units += t.Quantity
+		unitsPicked += t.Picked
+		if t.Status != "OPEN" {
+			tasksDone++
+		}
+	}
+	return tasksDone, tasks, unitsPicked, units
+}
+
+// WaveSplitDTO is the share of a wave that belongs to one order line.
+type WaveSplitDTO struct {
+	OrderNr  string
+	LineID   int
+	SKU      string
+	Location string
+	Bin      string
+	Quantity int
+	Picked   int
+}
+
+// waveLine is the share of an order line picked from one bin.
+type waveLine struct {
+	line     *ent.OrderLine
+	bin      *ent.Bin
+	quantity int
+}
+
+type waveTaskKey struct {
+	itemID, locID, binID int
+}
+
+// CreateWave releases the open outbound orders matching the filter into a new
+// wave, most urgent first (see orders.Queue). Order lines are split over bins
+// like picklists (see WMS_PICK_BIN_STRATEGY); lines for the same item,
+// location and bin are consolidated into one task.
+func (s *PickingService) CreateWave(ctx context.Context, f WaveFilter) (*ent.Wave, error) {
+	f.Carrier = strings.TrimSpace(f.Carrier)
+	f.Zone = strings.TrimSpace(f.Zone)
+	if f.MaxLines < 0 {
+		return nil, ErrInvalidMaxLines
+	}
+	strategy, err := BinStrategyFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	queue, err := orders.NewOrderService(tx.Client()).Queue(ctx, time.Now(), 500)
+	if err != nil {
+		return nil, err
+	}
+	numbers := make([]string, 0, len(queue))
+	for _, q := range queue {
+		numbers = append(numbers, q.Number)
+	}
+
+	candidates, err := tx.Order.Query().
+		Where(
+			order.OrderNumberIn(numbers...),
+			order.Not(order.HasWave()),
+			order.Not(order.HasPicklist()),
+		).
+		WithTracking().
+		WithLines(func(q *ent.OrderLineQuery) {
+			q.WithItem().WithLocation()
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetch orders: %w", err)
+	}
+	byNumber := make(map[string]*ent.Order, len(candidates))
+	for _, o := range candidates {
+		byNumber[o.OrderNumber] = o
+	}
+
+	var (
+		released  []*ent.Order
+		lines     []waveLine
+		lineCount int
+	)
+	// bin stock given to the orders released so far
+	taken := newAllocation()
+	for _, nr := range numbers {
+		o, ok := byNumber[nr]
+		if !ok || len(o.Edges.Lines) == 0 || held(o) {
+			continue
+		}
+		if f.Carrier != "" && (o.Edges.Tracking == nil || !strings.EqualFold(o.Edges.Tracking.Carrier, f.Carrier)) {
+			continue
+		}
+		if f.DueBy != nil && (o.ShipBy == nil || o.ShipBy.After(*f.DueBy)) {
+			continue
+		}
+		if f.MaxLines > 0 && lineCount+len(o.Edges.Lines) > f.MaxLines {
+			continue
+		}
+
+		orderLines := make([]waveLine, 0, len(o.Edges.Lines))
+		trial := taken.clone()
+		inZone := true
+		for _, ol := range o.Edges.Lines {
+			wls, err := waveLines(ctx, tx.Client(), ol, strategy, trial)
+			if err != nil {
+				return nil, err
+			}
+			for _, wl := range wls {
+				b := wl.bin
+				if f.Zone != "" && (b == nil || b.Edges.Zone == nil || b.Edges.Zone.Code != f.Zone) {
+					inZone = false
+				}
+			}
+			if !inZone {
+				break
+			}
+			orderLines = append(orderLines, wls...)
+		}
+		if !inZone {
+			continue
+		}
+		taken = trial
+		released = append(released, o)
+		lines = append(lines, orderLines...)
+		lineCount += len(o.Edges.Lines)
+	}
+	if len(released) == 0 {
+		return nil, ErrNoEligibleOrders
+	}
+
+	number, err := sequence.NewSequenceService(tx.Client()).Next(ctx, sequence.Wave)
+	if err != nil {
+		return nil, err
+	}
+	create := tx.Wave.Create().
+		SetNumber(number).
+		SetStatus("CREATED").
+		SetCarrier(f.Carrier).
+		SetZone(f.Zone).
+		SetMaxLines(f.MaxLines)
+	if f.DueBy != nil {
+		create.SetDueBy(*f.DueBy)
+	}
+	w, err := create.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create wave: %w", err)
+	}
+
+	// The transaction only locks once it writes; claim the orders only if no
+	// concurrent wave or picklist took one since they were read.
+	ids := make([]int, 0, len(released))
+	for _, o := range released {
+		ids = append(ids, o.ID)
+	}
+	n, err := tx.Order.Update().
+		Where(
+			order.IDIn(ids...),
+			order.Not(order.HasWave()),
+			order.Not(order.HasPicklist()),
+		).
+		SetWave(w).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("release orders: %w", err)
+	}
+	if n != len(ids) {
+		return nil, ErrOrderInWave
+	}
+
+	groups := map[waveTaskKey][]waveLine{}
+	keys := make([]waveTaskKey, 0)
+	for _, wl := range lines {
+		k := waveTaskKey{itemID: wl.line.Edges.Item.ID, locID: wl.line.Edges.Location.ID}
+		if wl.bin != nil {
+			k.binID = wl.bin.ID
+		}
+		if _, ok := groups[k]; !ok {
+			keys = append(keys, k)
+		}
+		groups[k] = append(groups[k], wl)
+	}
+	sort.SliceStable(keys, func(i, j int) bool {
+		a, b := groups[keys[i]][0], groups[keys[j]][0]
+		if la, lb := a.line.Edges.Location.Code, b.line.Edges.Location.Code; la != lb {
+			return la < lb
+		}
+		if ba, bb := binCode(a.bin), binCode(b.bin); ba != bb {
+			return ba < bb
+		}
+		return a.line.Edges.Item.SKU < b.line.Edges.Item.SKU
+	})
+
+	for _, k := range keys {
+		group := groups[k]
+		qty := 0
+		for _, wl := range group {
+			qty += wl.quantity
+		}
+
+		taskCreate := tx.WaveTask.Create().
+			SetWave(w).
+			SetItemID(k.itemID).
+			SetLocationID(k.locID).
+			SetQuantity(qty).
+			SetStatus("OPEN")
+		if k.binID != 0 {
+			taskCreate.SetBinID(k.binID)
+		}
+		t, err := taskCreate.Save(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("create wave task: %w", err)
+		}
+
+		for _, wl := range group {
+			_, err := tx.WavePick.Create().
+				SetTask(t).
+				SetOrderLine(wl.line).
+				SetQuantity(wl.quantity).
+				Save(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("create wave pick: %w", err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+	auditlog.Logf(ctx, "wave.create", "wave", w.Number, "orders=%d lines=%d tasks=%d", len(released), lineCount, len(keys))
+	return w, nil
+}
+
+func (s *PickingService) StartWave(ctx context.Context, waveID int) error {
+	w, err := s.getWave(ctx, waveID)
+	if err != nil {
+		return err
+	}
+	if w.Status != "CREATED" {
+		return ErrInvalidStatus
+	}
+	return s.client.Wave.UpdateOne(w).
+		SetStatus("IN_PROGRESS").
+		SetStartedAt(time.Now()).
+		Exec(ctx)
+}
+
+// PickWaveTask records the quantity picked for a consolidated task. Less than
+// the requested quantity is a short pick; the shortage is carried into the
+// split back to the orders and recorded as a stock discrepancy. With the auth
+// feature the user confirming the task is recorded as its picker.
+func (s *PickingService) PickWaveTask(ctx context.Context, taskID, picked int) error {
+	picker, _, err := s.confirmingUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	t, err := tx.WaveTask.Query().
+		Where(wavetask.ID(taskID)).
+		WithWave().
+		WithItem().
+		WithLocation().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrWaveTaskNotFound
+		}
+		return fmt.Errorf("fetch wave task: %w", err)
+	}
+	if t.Edges.Wave.Status != "IN_PROGRESS" || t.Status != "OPEN" {
+		return ErrInvalidStatus
+	}
+	if picked < 0 || picked > t.Quantity {
+		return ErrInvalidPickedQty
+	}
+	status := "PICKED"
+	if picked < t.Quantity {
+		status = "SHORT"
+		err := recordDiscrepancy(ctx, tx.Client(), "WAVE", t.Edges.Wave.Number, t.Edges.Item, t.Edges.Location, t.BinID, t.Quantity, picked)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.WaveTask.UpdateOne(t).
+		SetPicked(picked).
+		SetPickedBy(picker).
+		SetStatus(status).
+		SetPickedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("update wave task: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
+
+// DoneWave finishes a wave and splits the picked quantity of every task back
+// to its order lines, most urgent order first, so short picks hit the least
+// urgent orders. In the same transaction it takes the picks from their bins,
+// issues each order's share from its location and posts the orders.
+func (s *PickingService) DoneWave(ctx context.Context, waveID int) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+	txCtx := auditlog.InTx(ctx, tx)
+
+	w, err := tx.Wave.Query().
+		Where(wave.ID(waveID)).
+		WithTasks(func(tq *ent.WaveTaskQuery) {
+			tq.WithItem().WithLocation().WithPicks(func(pq *ent.WavePickQuery) {
+				pq.WithOrderLine(func(olq *ent.OrderLineQuery) {
+					olq.WithOrder()
+				})
+			})
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ErrWaveNotFound
+		}
+		return fmt.Errorf("fetch wave: %w", err)
+	}
+	if w.Status != "IN_PROGRESS" {
+		return ErrInvalidStatus
+	}
+
+	short := 0
+	issues := map[string][]orders.PickedLine{}
+	for _, t := range w.Edges.Tasks {
+		if t.Status == "OPEN" {
+			return fmt.Errorf("%w: task %d", ErrWaveTasksStillOpen, t.ID)
+		}
+		if t.BinID != nil && t.Picked > 0 {
+			if err := takeFromBin(ctx, tx.Client(), *t.BinID, t.Edges.Item.ID, t.Picked); err != nil {
+				return err
+			}
+		}
+
+		picks := t.Edges.Picks
+		sort.SliceStable(picks, func(i, j int) bool {
+			a, b := picks[i].Edges.OrderLine.Edges.Order, picks[j].Edges.OrderLine.Edges.Order
+			if ra, rb := orders.OrderPriority(a.Priority).Rank(), orders.OrderPriority(b.Priority).Rank(); ra != rb {
+				return ra > rb
+			}
+			if (a.ShipBy == nil) != (b.ShipBy == nil) {
+				return a.ShipBy != nil
+			}
+			if a.ShipBy != nil && !a.ShipBy.Equal(*b.ShipBy) {
+				return a.ShipBy.Before(*b.ShipBy)
+			}
+			return picks[i].ID < picks[j].ID
+		})
+
+		remaining := t.Picked
+		for _, p := range picks {
+			n := min(p.Quantity, remaining)
+			remaining -= n
+			short += p.Quantity - n
+			if err := tx.WavePick.UpdateOne(p).SetPicked(n).Exec(ctx); err != nil {
+				return fmt.Errorf("update wave pick: %w", err)
+			}
+			nr := p.Edges.OrderLine.Edges.Order.OrderNumber
+			issues[nr] = append(issues[nr], orders.PickedLine{
+				SKU:      t.Edges.Item.SKU,
+				Location: t.Edges.Location.Code,
+				Quantity: n,
+			})
+		}
+	}
+
+	numbers := make([]string, 0, len(issues))
+	for nr := range issues {
+		numbers = append(numbers, nr)
+	}
+	sort.Strings(numbers)
+	orderSvc := orders.NewOrderService(tx.Client())
+	for _, nr := range numbers {
+		if err := orderSvc.IssuePicked(txCtx, nr, issues[nr]); err != nil {
+			return fmt.Errorf("order %s: %w", nr, err)
+		}
+	}
+
+	err = tx.Wave.UpdateOne(w).
+		SetStatus("DONE").
+		SetDoneAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("update wave: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	for _, nr := range numbers {
+		auditlog.Logf(ctx, "order.posted", "order", nr, "type=%s lines=%d", orders.OrderTypeOutbound, len(issues[nr]))
+	}
+	auditlog.Logf(ctx, "wave.done", "wave", w.Number, "tasks=%d short=%d", len(w.Edges.Tasks), short)
+	return nil
+}
+
+func (s *PickingService) ShowWave(ctx context.Context, waveID int) (*WaveDTO, error) {
+	w, err := s.client.Wave.Query().
+		Where(wave.ID(waveID)).
+		WithOrders(func(oq *ent.OrderQuery) {
+			oq.Order(ent.Asc(order.FieldOrderNumber))
+		}).
+		WithTasks(func(tq *ent.WaveTaskQuery) {
+			tq.WithItem().WithLocation().WithPicks().Order(ent.Asc(wavetask.FieldID))
+		}).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrWaveNotFound
+		}
+		return nil, fmt.Errorf("fetch wave: %w", err)
+	}
+
+	dto := &WaveDTO{
+		ID:        w.ID,
+		Number:    w.Number,
+		Status:    w.Status,
+		CreatedAt: w.CreatedAt,
+		StartedAt: w.StartedAt,
+		DoneAt:    w.DoneAt,
+	}
+	for _, o := range w.Edges.Orders {
+		dto.Orders = append(dto.Orders, o.OrderNumber)
+	}
+
+	binCodes, err := s.binCodes(ctx, w.Edges.Tasks)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range w.Edges.Tasks {
+		td := WaveTaskDTO{
+			ID:       t.ID,
+			SKU:      t.Edges.Item.SKU,
+			ItemName: t.Edges.Item.Name,
+			Location: t.Edges.Location.Code,
+			Bin:      "-",
+			Quantity: t.Quantity,
+			Picked:   t.Picked,
+			Status:   t.Status,
+			Orders:   len(t.Edges.Picks),
+		}
+		if t.BinID != nil {
+			if code, ok := binCodes[*t.BinID]; ok {
+				td.Bin = code
+			}
+		}
+		dto.Tasks = append(dto.Tasks, td)
+	}
+	return dto, nil
+}
+
+// WaveSplit lists the picks of a wave per order line, ordered by order, for packing.
+func (s *PickingService) WaveSplit(ctx context.Context, waveID int) ([]WaveSplitDTO, error) {
+	if _, err := s.getWave(ctx, waveID); err != nil {
+		return nil, err
+	}
+
+	picks, err := s.client.WavePick.Query().
+		Where(wavepick.HasTaskWith(wavetask.HasWaveWith(wave.ID(waveID)))).
+		WithTask().
+		WithOrderLine(func(olq *ent.OrderLineQuery) {
+			olq.WithOrder().WithItem().WithLocation()
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetch wave picks: %w", err)
+	}
+
+	tasks := make([]*ent.WaveTask, 0, len(picks))
+	for _, p := range picks {
+		tasks = append(tasks, p.Edges.Task)
+	}
+	binCodes, err := s.binCodes(ctx, tasks)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]WaveSplitDTO, 0, len(picks))
+	for _, p := range picks {
+		ol := p.Edges.OrderLine
+		d := WaveSplitDTO{
+			OrderNr:  ol.Edges.Order.OrderNumber,
+			LineID:   ol.ID,
+			SKU:      ol.Edges.Item.SKU,
+			Location: ol.Edges.Location.Code,
+			Bin:      "-",
+			Quantity: p.Quantity,
+			Picked:   p.Picked,
+		}
+		if id := p.Edges.Task.BinID; id != nil {
+			if code, ok := binCodes[*id]; ok {
+				d.Bin = code
+			}
+		}
+		out = append(out, d)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].OrderNr != out[j].OrderNr {
+			return out[i].OrderNr < out[j].OrderNr
+		}
+		return out[i].LineID < out[j].LineID
+	})
+	return out, nil
+}
+
+func (s *PickingService) getWave(ctx context.Context, waveID int) (*ent.Wave, error) {
+	w, err := s.client.Wave.Get(ctx, waveID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrWaveNotFound
+		}
+		return nil, fmt.Errorf("fetch wave: %w", err)
+	}
+	return w, nil
+}
+
+// waveLines splits an order line over its bins with allocateBins and loads
+// each bin with its zone.
+func waveLines(ctx context.Context, client *ent.Client, ol *ent.OrderLine, strategy BinStrategy, taken *allocation) ([]waveLine, error) {
+	allocs, err := allocateBins(ctx, client, ol, strategy, taken)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int, 0, len(allocs))
+	for _, a := range allocs {
+		if a.binID != nil {
+			ids = append(ids, *a.binID)
+		}
+	}
+	bins := map[int]*ent.Bin{}
+	if len(ids) > 0 {
+		found, err := client.Bin.Query().Where(bin.IDIn(ids...)).WithZone().All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("fetch bins: %w", err)
+		}
+		for _, b := range found {
+			bins[b.ID] = b
+		}
+	}
+
+	out := make([]waveLine, 0, len(allocs))
+	for _, a := range allocs {
+		wl := waveLine{line: ol, quantity: a.quantity}
+		if a.binID != nil {
+			wl.bin = bins[*a.binID]
+		}
+		out = append(out, wl)
+	}
+	return out, nil
+}
+
+func (s *PickingService) binCodes(ctx context.Context, tasks []*ent.WaveTask) (map[int]string, error) {
+	ids := make([]int, 0)
+	for _, t := range tasks {
+		if t.BinID != nil {
+			ids = append(ids, *t.BinID)
+		}
+	}
+	codes := map[int]string{}
+	if len(ids) == 0 {
+		return codes, nil
+	}
+	bins, err := s.client.Bin.Query().Where(bin.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetch bins: %w", err)
+	}
+	for _, b := range bins {
+		codes[b.ID] = b.Code
+	}
+	return codes, nil
+}
+
+func binCode(b *ent.Bin) string {
+	if b == nil {
+		return ""
+	}
+	return b.Code
+}
+
+// WaveTaskQuantity returns the requested quantity of a wave task.
+func (s *PickingService) WaveTaskQuantity(ctx context.Context, taskID int) (int, error) {
+	t, err := s.client.WaveTask.Get(ctx, taskID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, ErrWaveTaskNotFound
+		}
+		return 0, fmt.Errorf("fetch wave task: %w", err)
+	}
+	return t.Quantity, nil
+}
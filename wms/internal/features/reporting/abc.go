@@ -0,0 +1,202 @@
+//go:build reporting
+
+package reporting
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mxV03/wms/ent/item"
+	"github.com/mxV03/wms/ent/stockmovement"
+	"github.com/mxV03/wms/internal/auditlog"
+)
+
+var (
+	ErrInvalidBasis = fmt.Errorf("invalid ABC basis (use volume or value)")
+	ErrInvalidPrice = fmt.Errorf("invalid unit price")
+	ErrItemNotFound = fmt.Errorf("item not found")
+	ErrNoValue      = fmt.Errorf("no unit prices set for the moved items")
+)
+
+// ABCBasis is the measure SKUs are ranked by in the ABC analysis.
+type ABCBasis string
+
+const (
+	// ABCVolume ranks by units issued.
+	ABCVolume ABCBasis = "volume"
+	// ABCValue ranks by units issued times unit price.
+	ABCValue ABCBasis = "value"
+)
+
+// Class limits: A covers the top 80% of the basis, B the next 15%. XYZ is
+// decided by the coefficient of variation of the weekly demand.
+const (
+	classALimit = 0.80
+	classBLimit = 0.95
+	classXLimit = 0.5
+	classYLimit = 1.0
+)
+
+func ParseABCBasis(s string) (ABCBasis, error) {
+	switch ABCBasis(strings.ToLower(strings.TrimSpace(s))) {
+	case "", ABCVolume:
+		return ABCVolume, nil
+	case ABCValue:
+		return ABCValue, nil
+	default:
+		return "", ErrInvalidBasis
+	}
+}
+
+// ABCRowDTO classifies one SKU. Share and Cumulative are fractions of the
+// basis total; CV is NaN for SKUs without demand.
+type ABCRowDTO struct {
+	SKU        string
+	ABC        string
+	XYZ        string
+	Units      int
+	Value      float64
+	Share      float64
+	Cumulative float64
+	CV         float64
+}
+
+// Class is the combined class, e.g. "AX".
+func (r ABCRowDTO) Class() string {
+	return r.ABC + r.XYZ
+}
+
+// SetUnitPrice records the value of one unit of an item.
+func (s *ReportService) SetUnitPrice(ctx context.Context, sku string, price float64) error {
+	sku = strings.TrimSpace(sku)
+	if sku == "" {
+		return fmt.Errorf("invalid SKU")
+	}
+	if price < 0 || math.IsNaN(price) || math.IsInf(price, 0) {
+		return ErrInvalidPrice
+	}
+	n, err := s.client.Item.Update().
+		Where(item.SKU(sku)).
+		SetUnitPrice(price).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("update item: %w", err)
+	}
+	if n == 0 {
+		return ErrItemNotFound
+	}
+	auditlog.Logf(ctx, "item.price", "item", sku, "price=%.2f", price)
+	return nil
+}
+
+// ABC classifies all items by their demand over the last complete weeks,
+// highest basis first. Items that did not move are CZ.
+func (s *ReportService) ABC(ctx context.Context, basis ABCBasis, weeks int) ([]ABCRowDTO, error) {
+	if weeks < 2 {
+		weeks = 13
+	}
+	current := Weekly.start(time.Now())
+	first := Weekly.back(current, weeks)
+
+	moves, err := s.client.StockMovement.Query().
+		Where(
+			stockmovement.CreatedAtGTE(first),
+			stockmovement.CreatedAtLT(current),
+		).
+		WithItem().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query movements: %w", err)
+	}
+	demand := demandBySKU(moves, Weekly, first, weeks)
+
+	items, err := s.client.Item.Query().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list items: %w", err)
+	}
+
+	out := make([]ABCRowDTO, 0, len(items))
+	total, units := 0.0, 0
+	for _, it := range items {
+		r := ABCRowDTO{SKU: it.SKU, CV: math.NaN()}
+		if series, ok := demand[it.SKU]; ok {
+			sum := 0.0
+			for _, q := range series {
+				sum += q
+			}
+			r.Units = int(sum)
+			r.CV = variation(series)
+		}
+		r.Value = float64(r.Units) * it.UnitPrice
+		total += r.measure(basis)
+		units += r.Units
+		out = append(out, r)
+	}
+	if basis == ABCValue && total == 0 && units > 0 {
+		return nil, ErrNoValue
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		if a, b := out[i].measure(basis), out[j].measure(basis); a != b {
+			return a > b
+		}
+		return out[i].SKU < out[j].SKU
+	})
+	cum := 0.0
+	for i := range out {
+		r := &out[i]
+		if total > 0 {
+			r.Share = r.measure(basis) / total
+		}
+		// the class is decided by the share before the item, so the item
+		// crossing a limit still belongs to the higher class
+		switch {
+		case r.Share > 0 && cum < classALimit:
+			r.ABC = "A"
+		case r.Share > 0 && cum < classBLimit:
+			r.ABC = "B"
+		default:
+			r.ABC = "C"
+		}
+		cum += r.Share
+		r.Cumulative = cum
+
+		switch {
+		case math.IsNaN(r.CV) || r.CV > classYLimit:
+			r.XYZ = "Z"
+		case r.CV > classXLimit:
+			r.XYZ = "Y"
+		default:
+			r.XYZ = "X"
+		}
+	}
+	return out, nil
+}
+
+func (r ABCRowDTO) measure(basis ABCBasis) float64 {
+	if basis == ABCValue {
+		return r.Value
+	}
+	return float64(r.Units)
+}
+
+// variation is the coefficient of variation (standard deviation / mean).
+func variation(series []float64) float64 {
+	mean := 0.0
+	for _, v := range series {
+		mean += v
+	}
+	mean /= float64(len(series))
+	if mean == 0 {
+		return math.NaN()
+	}
+	ss := 0.0
+	for _, v := range series {
+		ss += (v - mean) * (v - mean)
+	}
+	return math.Sqrt(ss/float64(len(series))) / mean
+}
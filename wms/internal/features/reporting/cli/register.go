@@ -5,7 +5,9 @@ package cli
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
+	"time"
 
 	"github.com/mxV03/wms/internal/features/interfaces/cli/clictx"
 	"github.com/mxV03/wms/internal/features/interfaces/cli/registry"
@@ -75,4 +77,93 @@ func init() {
 			return nil
 		},
 	})
+	registry.Register(registry.Command{
+		Name:        "report.returns",
+		Group:       "Optional / Reporting",
+		Usage:       "report.returns [limit]",
+		Description: "RMA report: return orders with inspection and disposition (Reporting feature).",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) > 1 {
+				return fmt.Errorf("usage: report.returns [limit]")
+			}
+			limit := 20
+			if len(args) == 1 {
+				v, err := strconv.Atoi(args[0])
+				if err != nil {
+					return fmt.Errorf("limit must be an integer")
+				}
+				limit = v
+			}
+
+			svc := reporting.NewReportService(clictx.AppCtx().Client())
+			rep, err := svc.Returns(ctx, limit)
+			if err != nil {
+				return err
+			}
+			if len(rep.Returns) == 0 {
+				fmt.Println("no returns found")
+				return nil
+			}
+			for _, r := range rep.Returns {
+				fmt.Printf("%s  %-12s  original=%s  status=%s\n",
+					r.CreatedAt.Format("2006-01-02 15:04"),
+					r.Number,
+					dash(r.OriginalNr),
+					r.Status,
+				)
+				for _, l := range r.Lines {
+					fmt.Printf("    %-10s  qty=%d  condition=%s  disposition=%s\n",
+						l.SKU, l.Quantity, dash(l.Condition), dash(l.Disposition))
+				}
+			}
+			fmt.Println()
+			fmt.Println("Units by disposition:")
+			for _, d := range sortedKeys(rep.UnitsByDisposition) {
+				fmt.Printf("  %-10s %d\n", d, rep.UnitsByDisposition[d])
+			}
+			fmt.Println("Units by condition:")
+			for _, c := range sortedKeys(rep.UnitsByCondition) {
+				fmt.Printf("  %-10s %d\n", c, rep.UnitsByCondition[c])
+			}
+			return nil
+		},
+	})
+	registry.Register(registry.Command{
+		Name:        "report.sla",
+		Group:       "Optional / Reporting",
+		Usage:       "report.sla",
+		Description: "Ship-by SLA KPIs for outbound orders (Reporting feature).",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 0 {
+				return fmt.Errorf("usage: report.sla")
+			}
+			svc := reporting.NewReportService(clictx.AppCtx().Client())
+			k, err := svc.SLA(ctx, time.Now())
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Open outbound:   %d\n", k.OpenOutbound)
+			fmt.Printf("Open breached:   %d\n", k.OpenBreached)
+			fmt.Printf("Shipped on time: %d\n", k.ShippedOnTime)
+			fmt.Printf("Shipped late:    %d\n", k.ShippedLate)
+			fmt.Printf("On-time rate:    %.1f%%\n", k.OnTimeRate*100)
+			return nil
+		},
+	})
+}
+
+func dash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func sortedKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
 }
@@ -0,0 +1,86 @@
+//go:build reporting
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/mxV03/wms/internal/features/interfaces/cli/clictx"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/registry"
+	"github.com/mxV03/wms/internal/features/reporting"
+)
+
+func init() {
+	registry.Register(registry.Command{
+		Name:        "report.abc",
+		Group:       "Optional / Reporting",
+		Usage:       "report.abc [volume|value] [weeks]",
+		Description: "Classify SKUs by issued units or value (ABC) and weekly demand variability (XYZ), default 13 weeks.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) > 2 {
+				return fmt.Errorf("usage: report.abc [volume|value] [weeks]")
+			}
+			basis := reporting.ABCVolume
+			if len(args) > 0 {
+				b, err := reporting.ParseABCBasis(args[0])
+				if err != nil {
+					return err
+				}
+				basis = b
+			}
+			weeks := 13
+			if len(args) == 2 {
+				n, err := strconv.Atoi(args[1])
+				if err != nil || n < 2 {
+					return fmt.Errorf("weeks must be an integer of at least 2")
+				}
+				weeks = n
+			}
+
+			svc := reporting.NewReportService(clictx.AppCtx().Client())
+			rows, err := svc.ABC(ctx, basis, weeks)
+			if err != nil {
+				return err
+			}
+			if len(rows) == 0 {
+				fmt.Println("no items")
+				return nil
+			}
+			fmt.Println("SKU\tCLASS\tUNITS\tVALUE\tSHARE\tCUM\tCV")
+			for _, r := range rows {
+				cv := "-"
+				if !math.IsNaN(r.CV) {
+					cv = fmt.Sprintf("%.2f", r.CV)
+				}
+				fmt.Printf("%s\t%s\t%d\t%.2f\t%.1f%%\t%.1f%%\t%s\n",
+					r.SKU, r.Class(), r.Units, r.Value, r.Share*100, r.Cumulative*100, cv)
+			}
+			return nil
+		},
+	})
+
+	registry.Register(registry.Command{
+		Name:        "report.item.price",
+		Group:       "Optional / Reporting",
+		Usage:       "report.item.price <sku> <unitPrice>",
+		Description: "Set the unit price of an item, used by the value based ABC analysis.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) != 2 {
+				return fmt.Errorf("usage: report.item.price <sku> <unitPrice>")
+			}
+			price, err := strconv.ParseFloat(args[1], 64)
+			if err != nil {
+				return fmt.Errorf("unitPrice must be a number")
+			}
+			svc := reporting.NewReportService(clictx.AppCtx().Client())
+			if err := svc.SetUnitPrice(ctx, args[0], price); err != nil {
+				return err
+			}
+			fmt.Printf("unit price set: SKU=%s PRICE=%.2f\n", args[0], price)
+			return nil
+		},
+	})
+}
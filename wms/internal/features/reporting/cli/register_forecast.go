@@ -0,0 +1,81 @@
+//go:build reporting
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/mxV03/wms/internal/features/interfaces/cli/clictx"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/registry"
+	"github.com/mxV03/wms/internal/features/reporting"
+)
+
+func init() {
+	registry.Register(registry.Command{
+		Name:        "report.forecast",
+		Group:       "Optional / Reporting",
+		Usage:       "report.forecast <sku> [daily|weekly] [horizon] [auto|sma|ses|hw]",
+		Description: "Forecast outbound demand of a SKU with moving average, exponential smoothing or Holt-Winters, backtested by MAPE.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) < 1 || len(args) > 4 {
+				return fmt.Errorf("usage: report.forecast <sku> [daily|weekly] [horizon] [auto|sma|ses|hw]")
+			}
+			g, model, horizon := reporting.Daily, reporting.ModelAuto, 0
+			var err error
+			if len(args) > 1 {
+				if g, err = reporting.ParseGranularity(args[1]); err != nil {
+					return err
+				}
+			}
+			if len(args) > 2 {
+				n, err := strconv.Atoi(args[2])
+				if err != nil || n <= 0 {
+					return fmt.Errorf("horizon must be a positive integer")
+				}
+				horizon = n
+			}
+			if len(args) > 3 {
+				if model, err = reporting.ParseForecastModel(args[3]); err != nil {
+					return err
+				}
+			}
+
+			svc := reporting.NewReportService(clictx.AppCtx().Client())
+			f, err := svc.Forecast(ctx, args[0], g, model, horizon)
+			if err != nil {
+				return err
+			}
+
+			total := 0.0
+			for _, p := range f.History {
+				total += p.Quantity
+			}
+			fmt.Printf("forecast: SKU=%s GRANULARITY=%s MODEL=%s MAPE=%s HISTORY=%d AVG=%.1f\n",
+				f.SKU, f.Granularity, f.Model, percent(f.MAPE), len(f.History), total/float64(len(f.History)))
+			fmt.Println("MODEL\tMAPE")
+			for _, sc := range f.Scores {
+				fmt.Printf("%s\t%s\n", sc.Model, percent(sc.MAPE))
+			}
+			if len(f.Forecast) == 0 {
+				fmt.Println("not enough history for this model")
+				return nil
+			}
+			fmt.Println("PERIOD\tDEMAND")
+			for _, p := range f.Forecast {
+				fmt.Printf("%s\t%.1f\n", p.Start.Format("2006-01-02"), p.Quantity)
+			}
+			fmt.Printf("TOTAL\t%.1f\n", f.Total())
+			return nil
+		},
+	})
+}
+
+func percent(v float64) string {
+	if math.IsNaN(v) {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", v)
+}
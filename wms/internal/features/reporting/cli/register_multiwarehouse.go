@@ -38,11 +38,37 @@ func init() {
 			return nil
 		},
 	})
-}
+	registry.Register(registry.Command{
+		Name:        "reporting.warehouse.intransit",
+		Usage:       "reporting.warehouse.intransit [fromWarehouse] [toWarehouse]",
+		Group:       "Optional / Reporting",
+		Description: "In-transit stock per warehouse pair and SKU.",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) > 2 {
+				return fmt.Errorf("usage: reporting.warehouse.intransit [fromWarehouse] [toWarehouse]")
+			}
+			from, to := "", ""
+			if len(args) >= 1 {
+				from = args[0]
+			}
+			if len(args) == 2 {
+				to = args[1]
+			}
 
-func dash(s string) string {
-	if s == "" {
-		return "-"
-	}
-	return s
+			client := clictx.AppCtx().Client()
+			rows, err := reporting.InTransitReport(ctx, client, from, to)
+			if err != nil {
+				return err
+			}
+			if len(rows) == 0 {
+				fmt.Println("nothing in transit")
+				return nil
+			}
+			for _, r := range rows {
+				fmt.Printf("%s -> %s  %-10s  in_transit=%d  orders=%d  dest_on_hand=%d\n",
+					r.FromWH, r.ToWH, r.SKU, r.InTransit, r.Orders, r.DestOnHand)
+			}
+			return nil
+		},
+	})
 }
@@ -0,0 +1,54 @@
+//go:build reporting && picking
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/mxV03/wms/internal/features/interfaces/cli/clictx"
+	"github.com/mxV03/wms/internal/features/interfaces/cli/registry"
+	"github.com/mxV03/wms/internal/features/reporting"
+)
+
+func init() {
+	registry.Register(registry.Command{
+		Name:        "report.pickers",
+		Group:       "Optional / Reporting",
+		Usage:       "report.pickers [days]",
+		Description: "Picker productivity over finished picklists and waves: lines/hour, units/hour, error rate (default 30 days).",
+		Run: func(ctx context.Context, args []string) error {
+			if len(args) > 1 {
+				return fmt.Errorf("usage: report.pickers [days]")
+			}
+			days := 30
+			if len(args) == 1 {
+				n, err := strconv.Atoi(args[0])
+				if err != nil || n <= 0 {
+					return fmt.Errorf("days must be a positive integer")
+				}
+				days = n
+			}
+
+			svc := reporting.NewReportService(clictx.AppCtx().Client())
+			rows, err := svc.PickerProductivity(ctx, time.Now().AddDate(0, 0, -days))
+			if err != nil {
+				return err
+			}
+			if len(rows) == 0 {
+				fmt.Println("no finished picklists or waves")
+				return nil
+			}
+			fmt.Printf("%-16s %6s %6s %6s %6s %6s %8s %10s %10s %7s\n",
+				"PICKER", "LISTS", "WAVES", "LINES", "UNITS", "SHORT", "HOURS", "LINES/H", "UNITS/H", "ERROR")
+			for _, r := range rows {
+				fmt.Printf("%-16s %6d %6d %6d %6d %6d %8.2f %10.1f %10.1f %6.1f%%\n",
+					r.Picker, r.PickLists, r.Waves, r.Lines, r.Units, r.ShortLines, r.Hours,
+					r.LinesPerHour, r.UnitsPerHour, r.ErrorRate*100)
+			}
+			return nil
+		},
+	})
+}
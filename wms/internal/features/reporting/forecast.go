@@ -0,0 +1,472 @@
+//go:build reporting
+
+package reporting
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/mxV03/wms/ent"
+	"github.com/mxV03/wms/ent/item"
+	"github.com/mxV03/wms/ent/stockmovement"
+)
+
+var (
+	ErrInvalidModel       = fmt.Errorf("invalid forecast model (use auto, sma, ses or hw)")
+	ErrInvalidGranularity = fmt.Errorf("invalid granularity (use daily or weekly)")
+	ErrNoDemand           = fmt.Errorf("no outbound movements for sku")
+	ErrHistoryTooShort    = fmt.Errorf("history too short for forecast model")
+)
+
+// ForecastModel names a demand forecasting method.
+type ForecastModel string
+
+const (
+	// ModelAuto picks the model with the lowest backtest MAPE.
+	ModelAuto ForecastModel = "auto"
+	// ModelSMA is the simple moving average of the last periods.
+	ModelSMA ForecastModel = "sma"
+	// ModelSES is simple exponential smoothing.
+	ModelSES ForecastModel = "ses"
+	// ModelHoltWinters is additive Holt-Winters with trend and season.
+	ModelHoltWinters ForecastModel = "hw"
+)
+
+var forecastModels = []ForecastModel{ModelSMA, ModelSES, ModelHoltWinters}
+
+func ParseForecastModel(s string) (ForecastModel, error) {
+	switch m := ForecastModel(strings.ToLower(strings.TrimSpace(s))); m {
+	case "":
+		return ModelAuto, nil
+	case ModelAuto, ModelSMA, ModelSES, ModelHoltWinters:
+		return m, nil
+	default:
+		return "", ErrInvalidModel
+	}
+}
+
+// Granularity is the length of one demand period.
+type Granularity string
+
+const (
+	Daily  Granularity = "daily"
+	Weekly Granularity = "weekly"
+)
+
+func ParseGranularity(s string) (Granularity, error) {
+	switch g := Granularity(strings.ToLower(strings.TrimSpace(s))); g {
+	case "":
+		return Daily, nil
+	case Daily, Weekly:
+		return g, nil
+	default:
+		return "", ErrInvalidGranularity
+	}
+}
+
+// history length and season length per granularity
+func (g Granularity) periods() int {
+	if g == Weekly {
+		return 104
+	}
+	return 120
+}
+
+func (g Granularity) season() int {
+	if g == Weekly {
+		return 52
+	}
+	return 7
+}
+
+func (g Granularity) start(t time.Time) time.Time {
+	d := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	if g == Weekly {
+		// weeks start on Monday
+		d = d.AddDate(0, 0, -((int(d.Weekday()) + 6) % 7))
+	}
+	return d
+}
+
+// back returns the start of the period n periods before t.
+func (g Granularity) back(t time.Time, n int) time.Time {
+	if g == Weekly {
+		return t.AddDate(0, 0, -7*n)
+	}
+	return t.AddDate(0, 0, -n)
+}
+
+func (g Granularity) next(t time.Time) time.Time {
+	if g == Weekly {
+		return t.AddDate(0, 0, 7)
+	}
+	return t.AddDate(0, 0, 1)
+}
+
+type DemandPoint struct {
+	Start    time.Time
+	Quantity float64
+}
+
+// ModelScore is the backtest result of one model; MAPE is NaN when the
+// history is too short or has no demand in the backtest periods.
+type ModelScore struct {
+	Model ForecastModel
+	MAPE  float64
+}
+
+type ForecastDTO struct {
+	SKU         string
+	Granularity Granularity
+	Model       ForecastModel
+	MAPE        float64
+	Scores      []ModelScore
+	History     []DemandPoint
+	Forecast    []DemandPoint
+}
+
+// Total is the forecast demand over the whole horizon.
+func (f ForecastDTO) Total() float64 {
+	total := 0.0
+	for _, p := range f.Forecast {
+		total += p.Quantity
+	}
+	return total
+}
+
+// DemandHistory sums the outbound movements of a SKU per period, oldest
+// first and ending with the last complete period. Internal moves (putaway and
+// transfers), scrapping and reversed postings do not count as demand.
+func (s *ReportService) DemandHistory(ctx context.Context, sku string, g Granularity, periods int) ([]DemandPoint, error) {
+	sku = strings.TrimSpace(sku)
+	if sku == "" {
+		return nil, fmt.Errorf("invalid SKU")
+	}
+	if periods <= 0 {
+		periods = g.periods()
+	}
+
+	current := g.start(time.Now())
+	first := g.back(current, periods)
+	moves, err := s.client.StockMovement.Query().
+		Where(
+			stockmovement.HasItemWith(item.SKU(sku)),
+			stockmovement.CreatedAtGTE(first),
+			stockmovement.CreatedAtLT(current),
+		).
+		WithItem().
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query movements: %w", err)
+	}
+
+	series, ok := demandBySKU(moves, g, first, periods)[sku]
+	if !ok {
+		return nil, ErrNoDemand
+	}
+	out := make([]DemandPoint, 0, periods)
+	t := first
+	for _, q := range series {
+		out = append(out, DemandPoint{Start: t, Quantity: q})
+		t = g.next(t)
+	}
+
+	// Periods before the first demand are most likely before the item was
+	// sold at all and would drag the averages down.
+	for len(out) > 1 && out[0].Quantity == 0 {
+		out = out[1:]
+	}
+	return out, nil
+}
+
+// demandBySKU sums the demand of movements per SKU into periods starting at
+// first; the item edge must be loaded. Reversals are matched by reference, so
+// moves should cover all movements of the time span, not only the outbound
+// ones. SKUs without demand are missing from the result.
+func demandBySKU(moves []*ent.StockMovement, g Granularity, first time.Time, periods int) map[string][]float64 {
+	reversed := map[string]bool{}
+	for _, m := range moves {
+		if ref, ok := strings.CutPrefix(m.Reference, "REVERSAL-"); ok {
+			reversed[ref] = true
+		}
+	}
+
+	// keyed by Unix time: the location of scanned timestamps differs from
+	// time.Local, which would make equal times unequal map keys
+	index := map[int64]int{}
+	for i, t := 0, first; i < periods; i, t = i+1, g.next(t) {
+		index[t.Unix()] = i
+	}
+
+	out := map[string][]float64{}
+	for _, m := range moves {
+		if m.Type != "OUT" || !isDemand(m.Reference) || reversed[m.Reference] {
+			continue
+		}
+		i, ok := index[g.start(m.CreatedAt.Local()).Unix()]
+		if !ok {
+			continue
+		}
+		sku := m.Edges.Item.SKU
+		if out[sku] == nil {
+			out[sku] = make([]float64, periods)
+		}
+		out[sku][i] += float64(m.Quantity)
+	}
+	return out
+}
+
+func isDemand(ref string) bool {
+	for _, p := range []string{"PUTAWAY-", "TRANSFER-", "SCRAP-", "REVERSAL-"} {
+		if strings.HasPrefix(ref, p) {
+			return false
+		}
+	}
+	return true
+}
+
+// Forecast predicts the demand of a SKU for the next horizon periods. Every
+// model is backtested on the recent history; with ModelAuto the one with the
+// lowest MAPE is used.
+func (s *ReportService) Forecast(ctx context.Context, sku string, g Granularity, model ForecastModel, horizon int) (*ForecastDTO, error) {
+	if horizon <= 0 {
+		horizon = g.season()
+	}
+	hist, err := s.DemandHistory(ctx, sku, g, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	series := make([]float64, len(hist))
+	for i, p := range hist {
+		series[i] = p.Quantity
+	}
+	if model == ModelHoltWinters && len(series) < 2*g.season() {
+		return nil, fmt.Errorf("%w: hw needs %d periods, have %d", ErrHistoryTooShort, 2*g.season(), len(series))
+	}
+
+	out := &ForecastDTO{
+		SKU:         strings.TrimSpace(sku),
+		Granularity: g,
+		Model:       model,
+		History:     hist,
+	}
+	best := math.Inf(1)
+	for _, m := range forecastModels {
+		score := ModelScore{Model: m, MAPE: backtest(series, m, g.season())}
+		out.Scores = append(out.Scores, score)
+		if model == ModelAuto && !math.IsNaN(score.MAPE) && score.MAPE < best {
+			best = score.MAPE
+			out.Model = m
+		}
+		if model == m {
+			out.MAPE = score.MAPE
+		}
+	}
+	if out.Model == ModelAuto {
+		// No model could be backtested; the moving average needs the least data.
+		out.Model = ModelSMA
+	}
+	if model == ModelAuto {
+		out.MAPE = best
+		if math.IsInf(best, 1) {
+			out.MAPE = math.NaN()
+		}
+	}
+
+	values := predict(series, out.Model, g.season(), horizon)
+	t := g.next(hist[len(hist)-1].Start)
+	for _, v := range values {
+		out.Forecast = append(out.Forecast, DemandPoint{Start: t, Quantity: v})
+		t = g.next(t)
+	}
+	return out, nil
+}
+
+// DailyDemand forecasts the demand of a SKU over the next days with the
+// best daily model, e.g. the demand expected during a purchase lead time.
+func (s *ReportService) DailyDemand(ctx context.Context, sku string, days int) (float64, error) {
+	if days <= 0 {
+		return 0, nil
+	}
+	f, err := s.Forecast(ctx, sku, Daily, ModelAuto, days)
+	if err != nil {
+		return 0, err
+	}
+	return f.Total(), nil
+}
+
+// predict runs a model with parameters fitted to the series.
+func predict(series []float64, m ForecastModel, season, horizon int) []float64 {
+	var out []float64
+	switch m {
+	case ModelSES:
+		out = ses(series, fitSES(series), horizon)
+	case ModelHoltWinters:
+		if len(series) < 2*season {
+			return nil
+		}
+		a, b, c := fitHoltWinters(series, season)
+		out = holtWinters(series, season, a, b, c, horizon)
+	default:
+		out = sma(series, season, horizon)
+	}
+	for i, v := range out {
+		if v < 0 {
+			out[i] = 0
+		}
+	}
+	return out
+}
+
+// backtest returns the MAPE of one-step forecasts over the last periods of
+// the series. Parameters are fitted once on the part before them.
+func backtest(series []float64, m ForecastModel, season int) float64 {
+	holdout := season
+	if holdout > len(series)/4 {
+		holdout = len(series) / 4
+	}
+	train := len(series) - holdout
+	if holdout == 0 || train < 2 || (m == ModelHoltWinters && train < 2*season) {
+		return math.NaN()
+	}
+
+	var alpha, beta, gamma float64
+	switch m {
+	case ModelSES:
+		alpha = fitSES(series[:train])
+	case ModelHoltWinters:
+		alpha, beta, gamma = fitHoltWinters(series[:train], season)
+	}
+
+	sum, n := 0.0, 0
+	for t := train; t < len(series); t++ {
+		actual := series[t]
+		if actual == 0 {
+			continue // MAPE is undefined for periods without demand
+		}
+		var f []float64
+		switch m {
+		case ModelSES:
+			f = ses(series[:t], alpha, 1)
+		case ModelHoltWinters:
+			f = holtWinters(series[:t], season, alpha, beta, gamma, 1)
+		default:
+			f = sma(series[:t], season, 1)
+		}
+		sum += math.Abs(actual-math.Max(f[0], 0)) / actual
+		n++
+	}
+	if n == 0 {
+		return math.NaN()
+	}
+	return sum / float64(n) * 100
+}
+
+func sma(series []float64, window, horizon int) []float64 {
+	if window > len(series) {
+		window = len(series)
+	}
+	mean := 0.0
+	for _, v := range series[len(series)-window:] {
+		mean += v
+	}
+	mean /= float64(window)
+	return repeat(mean, horizon)
+}
+
+func ses(series []float64, alpha float64, horizon int) []float64 {
+	level := series[0]
+	for _, v := range series[1:] {
+		level = alpha*v + (1-alpha)*level
+	}
+	return repeat(level, horizon)
+}
+
+// holtWinters is the additive model; series must cover at least two seasons.
+func holtWinters(series []float64, m int, alpha, beta, gamma float64, horizon int) []float64 {
+	level, trend, seasonal := hwInit(series, m)
+	for t := m; t < len(series); t++ {
+		s := seasonal[t%m]
+		prev := level
+		level = alpha*(series[t]-s) + (1-alpha)*(level+trend)
+		trend = beta*(level-prev) + (1-beta)*trend
+		seasonal[t%m] = gamma*(series[t]-level) + (1-gamma)*s
+	}
+	out := make([]float64, horizon)
+	for k := range out {
+		out[k] = level + float64(k+1)*trend + seasonal[(len(series)+k)%m]
+	}
+	return out
+}
+
+func hwInit(series []float64, m int) (level, trend float64, seasonal []float64) {
+	first, second := 0.0, 0.0
+	for i := 0; i < m; i++ {
+		first += series[i]
+		second += series[m+i]
+	}
+	first /= float64(m)
+	second /= float64(m)
+
+	seasonal = make([]float64, m)
+	for i := 0; i < m; i++ {
+		seasonal[i] = series[i] - first
+	}
+	return first, (second - first) / float64(m), seasonal
+}
+
+// fitSES and fitHoltWinters pick the smoothing parameters with the lowest
+// squared one-step error on a coarse grid.
+func fitSES(series []float64) float64 {
+	best, bestErr := 0.3, math.Inf(1)
+	for a := 0.1; a < 0.95; a += 0.1 {
+		level, sse := series[0], 0.0
+		for _, v := range series[1:] {
+			sse += (v - level) * (v - level)
+			level = a*v + (1-a)*level
+		}
+		if sse < bestErr {
+			best, bestErr = a, sse
+		}
+	}
+	return best
+}
+
+func fitHoltWinters(series []float64, m int) (alpha, beta, gamma float64) {
+	grid := []float64{0.05, 0.1, 0.2, 0.4, 0.6}
+	alpha, beta, gamma = 0.2, 0.1, 0.1
+	bestErr := math.Inf(1)
+	for _, a := range grid {
+		for _, b := range grid {
+			for _, c := range grid {
+				level, trend, seasonal := hwInit(series, m)
+				sse := 0.0
+				for t := m; t < len(series); t++ {
+					s := seasonal[t%m]
+					e := series[t] - (level + trend + s)
+					sse += e * e
+					prev := level
+					level = a*(series[t]-s) + (1-a)*(level+trend)
+					trend = b*(level-prev) + (1-b)*trend
+					seasonal[t%m] = c*(series[t]-level) + (1-c)*s
+				}
+				if sse < bestErr {
+					alpha, beta, gamma, bestErr = a, b, c, sse
+				}
+			}
+		}
+	}
+	return alpha, beta, gamma
+}
+
+func repeat(v float64, n int) []float64 {
+	out := make([]float64, n)
+	for i := range out {
+		out[i] = v
+	}
+	return out
+}
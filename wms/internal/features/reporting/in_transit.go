@@ -0,0 +1,119 @@
+//go:build reporting && multiwarehouse
+
+package reporting
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/mxV03/wms/ent"
+	"github.com/mxV03/wms/ent/item"
+	"github.com/mxV03/wms/ent/location"
+	"github.com/mxV03/wms/ent/stockmovement"
+	"github.com/mxV03/wms/ent/warehouse"
+	"github.com/mxV03/wms/ent/warehouselocation"
+	"github.com/mxV03/wms/internal/core/inventory/stock"
+	"github.com/mxV03/wms/internal/features/multiwarehouse"
+)
+
+type InTransitRow struct {
+	FromWH    string
+	ToWH      string
+	SKU       string
+	InTransit int
+	Orders    int
+	// on-hand stock of the SKU across all locations of the destination warehouse
+	DestOnHand int
+}
+
+// InTransitReport sums shipped but not yet received transfer quantities per
+// warehouse pair and SKU. Empty warehouse codes match any warehouse.
+func InTransitReport(ctx context.Context, client *ent.Client, fromWH, toWH string) ([]InTransitRow, error) {
+	lines, err := multiwarehouse.NewTransferService(client).InTransit(ctx, fromWH, toWH)
+	if err != nil {
+		return nil, err
+	}
+
+	type key struct{ from, to, sku string }
+	rows := map[key]*InTransitRow{}
+	orderSeen := map[key]map[string]struct{}{}
+	for _, l := range lines {
+		k := key{l.FromWH, l.ToWH, l.SKU}
+		r, ok := rows[k]
+		if !ok {
+			r = &InTransitRow{FromWH: l.FromWH, ToWH: l.ToWH, SKU: l.SKU}
+			rows[k] = r
+			orderSeen[k] = map[string]struct{}{}
+		}
+		r.InTransit += l.Quantity
+		if _, ok := orderSeen[k][l.OrderNr]; !ok {
+			orderSeen[k][l.OrderNr] = struct{}{}
+			r.Orders++
+		}
+	}
+
+	out := make([]InTransitRow, 0, len(rows))
+	for _, r := range rows {
+		out = append(out, *r)
+	}
+	onHand, err := warehouseStock(ctx, client, out)
+	if err != nil {
+		return nil, err
+	}
+	for i := range out {
+		out[i].DestOnHand = onHand[[2]string{out[i].ToWH, out[i].SKU}]
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].FromWH != out[j].FromWH {
+			return out[i].FromWH < out[j].FromWH
+		}
+		if out[i].ToWH != out[j].ToWH {
+			return out[i].ToWH < out[j].ToWH
+		}
+		return out[i].SKU < out[j].SKU
+	})
+	return out, nil
+}
+
+// warehouseStock sums the movements of the report's SKUs over all locations
+// of the destination warehouses in one query, keyed by warehouse and SKU.
+func warehouseStock(ctx context.Context, client *ent.Client, rows []InTransitRow) (map[[2]string]int, error) {
+	whs, skus := []string{}, []string{}
+	for _, r := range rows {
+		whs = append(whs, r.ToWH)
+		skus = append(skus, r.SKU)
+	}
+	out := map[[2]string]int{}
+	if len(rows) == 0 {
+		return out, nil
+	}
+
+	moves, err := client.StockMovement.Query().
+		Where(
+			stockmovement.HasItemWith(item.SKUIn(skus...)),
+			stockmovement.HasLocationWith(location.HasWarehouseLinkWith(
+				warehouselocation.HasWarehouseWith(warehouse.CodeIn(whs...)),
+			)),
+		).
+		WithItem().
+		WithLocation(func(q *ent.LocationQuery) {
+			q.WithWarehouseLink(func(q *ent.WarehouseLocationQuery) {
+				q.WithWarehouse()
+			})
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query movements: %w", err)
+	}
+	for _, m := range moves {
+		k := [2]string{m.Edges.Location.Edges.WarehouseLink.Edges.Warehouse.Code, m.Edges.Item.SKU}
+		if m.Type == string(stock.MovementTypeOut) {
+			out[k] -= m.Quantity
+		} else {
+			out[k] += m.Quantity
+		}
+	}
+	return out, nil
+}
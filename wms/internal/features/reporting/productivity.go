@@ -0,0 +1,178 @@
+//go:build reporting && picking
+
+package reporting
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/mxV03/wms/ent"
+	"github.com/mxV03/wms/ent/picklist"
+	"github.com/mxV03/wms/ent/wave"
+)
+
+type PickerProductivity struct {
+	Picker     string // "-" for work nobody was assigned to
+	PickLists  int
+	Waves      int
+	Lines      int
+	Units      int
+	ShortLines int
+	Hours      float64
+
+	LinesPerHour float64
+	UnitsPerHour float64
+	// share of lines picked short
+	ErrorRate float64
+}
+
+// pickerWork is what one picker did in a picklist or wave. A line is an order
+// line; split over several bins it still counts once, and as short if any
+// part of it was.
+type pickerWork struct {
+	lines map[int]bool // order line ID, true if short
+	units int
+}
+
+func (w *pickerWork) add(lineID, picked int, short bool) {
+	w.lines[lineID] = w.lines[lineID] || short
+	w.units += picked
+}
+
+// PickerProductivity computes per-picker figures over the picklists and waves
+// finished since the given time. Tasks are credited to the user who confirmed
+// them. The time of a picklist or wave, from start to done, is split between
+// its pickers by the number of lines each confirmed.
+func (s *ReportService) PickerProductivity(ctx context.Context, since time.Time) ([]PickerProductivity, error) {
+	pls, err := s.client.PickList.Query().
+		Where(
+			picklist.Status("DONE"),
+			picklist.DoneAtGTE(since),
+		).
+		WithTasks(func(q *ent.PickTaskQuery) {
+			q.WithOrderLine()
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query picklists: %w", err)
+	}
+
+	waves, err := s.client.Wave.Query().
+		Where(
+			wave.Status("DONE"),
+			wave.DoneAtGTE(since),
+		).
+		WithTasks(func(q *ent.WaveTaskQuery) {
+			q.WithPicks(func(pq *ent.WavePickQuery) {
+				pq.WithOrderLine()
+			})
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query waves: %w", err)
+	}
+
+	byPicker := map[string]*PickerProductivity{}
+	get := func(name string) *PickerProductivity {
+		if name == "" {
+			name = "-"
+		}
+		p, ok := byPicker[name]
+		if !ok {
+			p = &PickerProductivity{Picker: name}
+			byPicker[name] = p
+		}
+		return p
+	}
+	// credit adds the work of one picklist or wave to its pickers and returns
+	// them, with the time split by lines.
+	credit := func(work map[string]*pickerWork, startedAt, doneAt *time.Time) []*PickerProductivity {
+		total := 0
+		for _, w := range work {
+			total += len(w.lines)
+		}
+		var hours float64
+		if startedAt != nil && doneAt != nil {
+			hours = doneAt.Sub(*startedAt).Hours()
+		}
+		out := make([]*PickerProductivity, 0, len(work))
+		for name, w := range work {
+			p := get(name)
+			p.Lines += len(w.lines)
+			p.Units += w.units
+			for _, short := range w.lines {
+				if short {
+					p.ShortLines++
+				}
+			}
+			p.Hours += hours * float64(len(w.lines)) / float64(total)
+			out = append(out, p)
+		}
+		return out
+	}
+	workOf := func(work map[string]*pickerWork, name string) *pickerWork {
+		w, ok := work[name]
+		if !ok {
+			w = &pickerWork{lines: map[int]bool{}}
+			work[name] = w
+		}
+		return w
+	}
+
+	for _, pl := range pls {
+		work := map[string]*pickerWork{}
+		for _, t := range pl.Edges.Tasks {
+			if t.Status == "OPEN" || t.Edges.OrderLine == nil {
+				continue
+			}
+			// whoever confirmed the task; without auth, whom it was assigned to
+			picker := t.PickedBy
+			if picker == "" {
+				picker = t.AssignedTo
+			}
+			if picker == "" {
+				picker = pl.AssignedTo
+			}
+			workOf(work, picker).add(t.Edges.OrderLine.ID, t.Picked, t.Status == "SHORT")
+		}
+		for _, p := range credit(work, pl.StartedAt, pl.DoneAt) {
+			p.PickLists++
+		}
+	}
+
+	// A wave task is picked for several orders at once; its picks, the share
+	// of each order line, are what the picker did.
+	for _, w := range waves {
+		work := map[string]*pickerWork{}
+		for _, t := range w.Edges.Tasks {
+			if t.Status == "OPEN" {
+				continue
+			}
+			for _, wp := range t.Edges.Picks {
+				if wp.Edges.OrderLine == nil {
+					continue
+				}
+				workOf(work, t.PickedBy).add(wp.Edges.OrderLine.ID, wp.Picked, wp.Picked < wp.Quantity)
+			}
+		}
+		for _, p := range credit(work, w.StartedAt, w.DoneAt) {
+			p.Waves++
+		}
+	}
+
+	out := make([]PickerProductivity, 0, len(byPicker))
+	for _, p := range byPicker {
+		if p.Hours > 0 {
+			p.LinesPerHour = float64(p.Lines) / p.Hours
+			p.UnitsPerHour = float64(p.Units) / p.Hours
+		}
+		if p.Lines > 0 {
+			p.ErrorRate = float64(p.ShortLines) / float64(p.Lines)
+		}
+		out = append(out, *p)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Picker < out[j].Picker })
+	return out, nil
+}
@@ -0,0 +1,120 @@
+//go:build reporting
+
+package reporting
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/mxV03/wms/ent"
+	"github.com/mxV03/wms/ent/order"
+	"github.com/mxV03/wms/ent/orderline"
+	"github.com/mxV03/wms/internal/core/ordermanagement/orders"
+)
+
+type ReturnLineRow struct {
+	SKU         string
+	Quantity    int
+	Condition   string
+	Disposition string
+}
+
+type ReturnDTO struct {
+	Number     string
+	OriginalNr string
+	Status     string
+	CreatedAt  time.Time
+	Lines      []ReturnLineRow
+}
+
+type ReturnsReport struct {
+	Returns []ReturnDTO
+	// units of all returns per disposition, "PENDING" for lines without one
+	UnitsByDisposition map[string]int
+	UnitsByCondition   map[string]int
+}
+
+func (s *ReportService) Returns(ctx context.Context, limit int) (*ReturnsReport, error) {
+	if limit <= 0 || limit > 200 {
+		limit = 50
+	}
+
+	rets, err := s.client.Order.Query().
+		Where(order.Type(string(orders.OrderTypeReturn))).
+		WithReturnOf().
+		WithLines(func(q *ent.OrderLineQuery) {
+			q.WithItem()
+		}).
+		Order(ent.Desc(order.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query returns: %w", err)
+	}
+
+	rep := &ReturnsReport{
+		Returns:            make([]ReturnDTO, 0, len(rets)),
+		UnitsByDisposition: map[string]int{},
+		UnitsByCondition:   map[string]int{},
+	}
+	for _, o := range rets {
+		dto := ReturnDTO{
+			Number:    o.OrderNumber,
+			Status:    o.Status,
+			CreatedAt: o.CreatedAt,
+		}
+		if o.Edges.ReturnOf != nil {
+			dto.OriginalNr = o.Edges.ReturnOf.OrderNumber
+		}
+		for _, l := range o.Edges.Lines {
+			row := ReturnLineRow{
+				Quantity:    l.Quantity,
+				Condition:   l.Condition,
+				Disposition: l.Disposition,
+			}
+			if l.Edges.Item != nil {
+				row.SKU = l.Edges.Item.SKU
+			}
+			dto.Lines = append(dto.Lines, row)
+		}
+		rep.Returns = append(rep.Returns, dto)
+	}
+
+	if err := s.returnTotals(ctx, rep); err != nil {
+		return nil, err
+	}
+	return rep, nil
+}
+
+// returnTotals sums the units of all return lines, not only those of the
+// returns listed.
+func (s *ReportService) returnTotals(ctx context.Context, rep *ReturnsReport) error {
+	var rows []struct {
+		// NULL for lines added before inspection
+		Disposition sql.NullString `json:"disposition"`
+		Condition   sql.NullString `json:"condition"`
+		Sum         int            `json:"sum"`
+	}
+	err := s.client.OrderLine.Query().
+		Where(orderline.HasOrderWith(order.Type(string(orders.OrderTypeReturn)))).
+		GroupBy(orderline.FieldDisposition, orderline.FieldCondition).
+		Aggregate(ent.Sum(orderline.FieldQuantity)).
+		Scan(ctx, &rows)
+	if err != nil {
+		return fmt.Errorf("sum return lines: %w", err)
+	}
+
+	for _, r := range rows {
+		disposition := r.Disposition.String
+		if disposition == "" {
+			disposition = "PENDING"
+		}
+		rep.UnitsByDisposition[disposition] += r.Sum
+		if r.Condition.String != "" {
+			rep.UnitsByCondition[r.Condition.String] += r.Sum
+		}
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+//go:build reporting
+
+package reporting
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/mxV03/wms/ent/order"
+	"github.com/mxV03/wms/internal/core/ordermanagement/orders"
+)
+
+type SLAKPIs struct {
+	OpenOutbound  int
+	OpenBreached  int
+	ShippedOnTime int
+	ShippedLate   int
+	// outbound orders posted without a ship-by date are not counted
+	OnTimeRate float64
+}
+
+// SLA computes ship-by KPIs for outbound orders.
+func (s *ReportService) SLA(ctx context.Context, now time.Time) (*SLAKPIs, error) {
+	outbound, err := s.client.Order.Query().
+		Where(
+			order.Type(string(orders.OrderTypeOutbound)),
+			order.StatusIn(string(orders.OrderStatusDraft), string(orders.OrderStatusPosted)),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("query outbound orders: %w", err)
+	}
+
+	k := &SLAKPIs{}
+	for _, o := range outbound {
+		if o.Status == string(orders.OrderStatusDraft) {
+			k.OpenOutbound++
+			if o.ShipBy != nil && o.ShipBy.Before(now) {
+				k.OpenBreached++
+			}
+			continue
+		}
+		if o.ShipBy == nil || o.PostedAt == nil {
+			continue
+		}
+		if o.PostedAt.After(*o.ShipBy) {
+			k.ShippedLate++
+		} else {
+			k.ShippedOnTime++
+		}
+	}
+	if shipped := k.ShippedOnTime + k.ShippedLate; shipped > 0 {
+		k.OnTimeRate = float64(k.ShippedOnTime) / float64(shipped)
+	}
+	return k, nil
+}
+
+// SLABreaches returns open outbound orders past their ship-by date.
+func (s *ReportService) SLABreaches(ctx context.Context, now time.Time) ([]orders.QueueEntryDTO, error) {
+	return orders.NewOrderService(s.client).SLABreaches(ctx, now)
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/mxV03/wms/ent"
+	"github.com/mxV03/wms/ent/carton"
 	"github.com/mxV03/wms/ent/order"
 	"github.com/mxV03/wms/ent/tracking"
 )
@@ -66,7 +67,7 @@ func (s *TrackingService) Set(ctx context.Context, orderNr, trackingID, tracking
 	}
 
 	if !exists {
-		_, err := s.client.Tracking.Create().
+		t, err := s.client.Tracking.Create().
 			SetOrder(o).
 			SetTrackingID(trackingID).
 			SetTrackingURL(trackingURL).
@@ -75,7 +76,7 @@ func (s *TrackingService) Set(ctx context.Context, orderNr, trackingID, tracking
 		if err != nil {
 			return fmt.Errorf("create tracking: %w", err)
 		}
-		return nil
+		return s.linkCartons(ctx, o, t)
 	}
 
 	t, err := s.client.Tracking.Query().
@@ -94,6 +95,18 @@ func (s *TrackingService) Set(ctx context.Context, orderNr, trackingID, tracking
 	if err := u.Exec(ctx); err != nil {
 		return fmt.Errorf("update tracking: %w", err)
 	}
+	return s.linkCartons(ctx, o, t)
+}
+
+// linkCartons attaches the packed cartons of the order to its tracking record.
+func (s *TrackingService) linkCartons(ctx context.Context, o *ent.Order, t *ent.Tracking) error {
+	err := s.client.Carton.Update().
+		Where(carton.HasOrderWith(order.ID(o.ID))).
+		SetTracking(t).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("link cartons: %w", err)
+	}
 	return nil
 }
 
@@ -0,0 +1,5 @@
+//go:build automation && !logistics
+
+package constraints
+
+import _ "github.com/mxV03/wms/internal/features/logistics"
@@ -0,0 +1,5 @@
+//go:build packing && !picking
+
+package constraints
+
+import _ "github.com/mxV03/wms/internal/features/picking"
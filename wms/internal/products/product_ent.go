@@ -8,11 +8,14 @@ var EnabledTags = []string{
 	"ent",
 	"audit",
 	"auth",
+	"automation",
 	"barcode",
 	"cli",
-	"logistics"
+	"edi",
+	"logistics",
 	"multiwarehouse",
 	"notifications",
+	"packing",
 	"picking",
 	"reporting",
 	"tracking",
@@ -0,0 +1,17 @@
+//go:build !logistics
+
+package putaway
+
+import (
+	"context"
+
+	"github.com/mxV03/wms/ent"
+)
+
+func Received(ctx context.Context, client *ent.Client, sku, locCode string, qty int, ref string) error {
+	return nil
+}
+
+func Reversed(ctx context.Context, client *ent.Client, number string) error {
+	return nil
+}
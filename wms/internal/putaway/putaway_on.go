@@ -0,0 +1,29 @@
+//go:build logistics
+
+package putaway
+
+import (
+	"context"
+
+	"github.com/mxV03/wms/ent"
+	"github.com/mxV03/wms/internal/features/logistics"
+)
+
+// Received creates a putaway task for goods booked into a location by an
+// inbound receipt. client is the client of the receiving transaction.
+func Received(ctx context.Context, client *ent.Client, sku, locCode string, qty int, ref string) error {
+	_, err := logistics.NewLocationService(client).CreatePutawayTask(ctx, sku, locCode, qty, ref)
+	return err
+}
+
+// Reversed cancels the open putaway tasks of a reversed order, whose goods
+// were booked out again, and takes the goods of the finished ones out of their
+// bins. client is the client of the reversing transaction.
+func Reversed(ctx context.Context, client *ent.Client, number string) error {
+	svc := logistics.NewLocationService(client)
+	if _, err := svc.CancelPutawayTasks(ctx, number); err != nil {
+		return err
+	}
+	_, err := svc.TakeBackPutaways(ctx, number)
+	return err
+}
@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent/dialect"
 	entsql "entgo.io/ent/dialect/sql"
 	"github.com/mxV03/wms/ent"
+	"github.com/mxV03/wms/ent/migrate"
 	_ "modernc.org/sqlite"
 )
 
@@ -20,7 +21,9 @@ func InitDB() (*ent.Client, error) {
 	}
 
 	dbPath := filepath.Join(dir, "warehouse.db")
-	dsn := "file:" + dbPath + "?_fk=1"
+	// busy_timeout lets concurrent CLI processes wait for the write lock
+	// instead of failing with SQLITE_BUSY.
+	dsn := "file:" + dbPath + "?_fk=1&_pragma=busy_timeout(5000)"
 
 	db, err := sql.Open("sqlite", dsn)
 	if err != nil {
@@ -39,8 +42,9 @@ func InitDB() (*ent.Client, error) {
 
 	client := ent.NewClient(ent.Driver(drv))
 
-	// run auto migration
-	if err := client.Schema.Create(context.Background()); err != nil {
+	// run auto migration; indexes removed from the schema are dropped so
+	// relaxed unique constraints take effect on existing databases
+	if err := client.Schema.Create(context.Background(), migrate.WithDropIndex(true)); err != nil {
 		client.Close()
 		log.Fatalf("failed creating schema resources: %v", err)
 	}